@@ -19,21 +19,39 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	AccountService_HealthCheck_FullMethodName           = "/AccountService/HealthCheck"
-	AccountService_CreateCustomer_FullMethodName        = "/AccountService/CreateCustomer"
-	AccountService_GetCustomer_FullMethodName           = "/AccountService/GetCustomer"
-	AccountService_ListCustomers_FullMethodName         = "/AccountService/ListCustomers"
-	AccountService_UpdateCustomer_FullMethodName        = "/AccountService/UpdateCustomer"
-	AccountService_DeleteCustomer_FullMethodName        = "/AccountService/DeleteCustomer"
-	AccountService_CreateAccount_FullMethodName         = "/AccountService/CreateAccount"
-	AccountService_GetAccount_FullMethodName            = "/AccountService/GetAccount"
-	AccountService_ListAccount_FullMethodName           = "/AccountService/ListAccount"
-	AccountService_GetBalance_FullMethodName            = "/AccountService/GetBalance"
-	AccountService_DeleteAccount_FullMethodName         = "/AccountService/DeleteAccount"
-	AccountService_ValidateAccounts_FullMethodName      = "/AccountService/ValidateAccounts"
-	AccountService_LockAccounts_FullMethodName          = "/AccountService/LockAccounts"
-	AccountService_UnlockAccounts_FullMethodName        = "/AccountService/UnlockAccounts"
-	AccountService_UpdateAccountsBalance_FullMethodName = "/AccountService/UpdateAccountsBalance"
+	AccountService_HealthCheck_FullMethodName                 = "/AccountService/HealthCheck"
+	AccountService_CreateCustomer_FullMethodName              = "/AccountService/CreateCustomer"
+	AccountService_GetCustomer_FullMethodName                 = "/AccountService/GetCustomer"
+	AccountService_ListCustomers_FullMethodName               = "/AccountService/ListCustomers"
+	AccountService_UpdateCustomer_FullMethodName              = "/AccountService/UpdateCustomer"
+	AccountService_DeleteCustomer_FullMethodName              = "/AccountService/DeleteCustomer"
+	AccountService_CreateAccount_FullMethodName               = "/AccountService/CreateAccount"
+	AccountService_GetAccount_FullMethodName                  = "/AccountService/GetAccount"
+	AccountService_ListAccount_FullMethodName                 = "/AccountService/ListAccount"
+	AccountService_GetBalance_FullMethodName                  = "/AccountService/GetBalance"
+	AccountService_DeleteAccount_FullMethodName               = "/AccountService/DeleteAccount"
+	AccountService_SetOverdraftLimit_FullMethodName           = "/AccountService/SetOverdraftLimit"
+	AccountService_UpdateAccountStatus_FullMethodName         = "/AccountService/UpdateAccountStatus"
+	AccountService_CloseAccount_FullMethodName                = "/AccountService/CloseAccount"
+	AccountService_PlaceHold_FullMethodName                   = "/AccountService/PlaceHold"
+	AccountService_GetHold_FullMethodName                     = "/AccountService/GetHold"
+	AccountService_ReleaseHold_FullMethodName                 = "/AccountService/ReleaseHold"
+	AccountService_ListAccountHolds_FullMethodName            = "/AccountService/ListAccountHolds"
+	AccountService_SetInterestRate_FullMethodName             = "/AccountService/SetInterestRate"
+	AccountService_ListInterestRates_FullMethodName           = "/AccountService/ListInterestRates"
+	AccountService_GetAccountInterest_FullMethodName          = "/AccountService/GetAccountInterest"
+	AccountService_ListPendingInterestPostings_FullMethodName = "/AccountService/ListPendingInterestPostings"
+	AccountService_SettleAccountInterest_FullMethodName       = "/AccountService/SettleAccountInterest"
+	AccountService_GenerateAccountStatement_FullMethodName    = "/AccountService/GenerateAccountStatement"
+	AccountService_ListAccountStatements_FullMethodName       = "/AccountService/ListAccountStatements"
+	AccountService_GetStatement_FullMethodName                = "/AccountService/GetStatement"
+	AccountService_GetAccountJournal_FullMethodName           = "/AccountService/GetAccountJournal"
+	AccountService_RecomputeAccountBalance_FullMethodName     = "/AccountService/RecomputeAccountBalance"
+	AccountService_ValidateAccounts_FullMethodName            = "/AccountService/ValidateAccounts"
+	AccountService_LockAccounts_FullMethodName                = "/AccountService/LockAccounts"
+	AccountService_UnlockAccounts_FullMethodName              = "/AccountService/UnlockAccounts"
+	AccountService_UpdateAccountsBalance_FullMethodName       = "/AccountService/UpdateAccountsBalance"
+	AccountService_GetTransactionJournalStatus_FullMethodName = "/AccountService/GetTransactionJournalStatus"
 )
 
 // AccountServiceClient is the client API for AccountService service.
@@ -58,14 +76,51 @@ type AccountServiceClient interface {
 	GetAccount(ctx context.Context, in *GetAccountRequest, opts ...grpc.CallOption) (*GetAccountResponse, error)
 	// ListAccount returns a paginated list of accounts with filtering options
 	ListAccount(ctx context.Context, in *ListAccountsRequest, opts ...grpc.CallOption) (*ListAccountsResponse, error)
-	// GetBalance queries the current available balance for a specific account
+	// GetBalance queries the ledger and available balance for a specific account
 	GetBalance(ctx context.Context, in *GetBalanceRequest, opts ...grpc.CallOption) (*GetBalanceResponse, error)
 	// DeleteAccount deletes an account from the system (soft delete)
 	DeleteAccount(ctx context.Context, in *DeleteAccountRequest, opts ...grpc.CallOption) (*DeleteAccountResponse, error)
+	// SetOverdraftLimit sets how far a current account may go below zero
+	SetOverdraftLimit(ctx context.Context, in *SetOverdraftLimitRequest, opts ...grpc.CallOption) (*SetOverdraftLimitResponse, error)
+	// UpdateAccountStatus freezes an account or unfreezes and reactivates it
+	UpdateAccountStatus(ctx context.Context, in *UpdateAccountStatusRequest, opts ...grpc.CallOption) (*UpdateAccountStatusResponse, error)
+	// CloseAccount closes an account whose balance was paid out by the transaction service
+	CloseAccount(ctx context.Context, in *CloseAccountRequest, opts ...grpc.CallOption) (*CloseAccountResponse, error)
+	// PlaceHold earmarks funds of an account, reducing its available balance until captured, released or expired
+	PlaceHold(ctx context.Context, in *PlaceHoldRequest, opts ...grpc.CallOption) (*PlaceHoldResponse, error)
+	// GetHold retrieves a hold
+	GetHold(ctx context.Context, in *GetHoldRequest, opts ...grpc.CallOption) (*GetHoldResponse, error)
+	// ReleaseHold frees the held funds without moving money
+	ReleaseHold(ctx context.Context, in *ReleaseHoldRequest, opts ...grpc.CallOption) (*ReleaseHoldResponse, error)
+	// ListAccountHolds returns a paginated list of the holds of an account
+	ListAccountHolds(ctx context.Context, in *ListAccountHoldsRequest, opts ...grpc.CallOption) (*ListAccountHoldsResponse, error)
+	// SetInterestRate creates or replaces the annual interest rate of an account type or account
+	SetInterestRate(ctx context.Context, in *SetInterestRateRequest, opts ...grpc.CallOption) (*SetInterestRateResponse, error)
+	// ListInterestRates returns every configured interest rate
+	ListInterestRates(ctx context.Context, in *ListInterestRatesRequest, opts ...grpc.CallOption) (*ListInterestRatesResponse, error)
+	// GetAccountInterest returns the rate, unposted accrued interest and interest postings of an account
+	GetAccountInterest(ctx context.Context, in *GetAccountInterestRequest, opts ...grpc.CallOption) (*GetAccountInterestResponse, error)
+	// ListPendingInterestPostings returns the closed monthly interest waiting to be credited
+	ListPendingInterestPostings(ctx context.Context, in *ListPendingInterestPostingsRequest, opts ...grpc.CallOption) (*ListPendingInterestPostingsResponse, error)
+	// SettleAccountInterest posts the interest an account accrued so far ahead of its closure and returns the pending
+	// postings of the account
+	SettleAccountInterest(ctx context.Context, in *SettleAccountInterestRequest, opts ...grpc.CallOption) (*SettleAccountInterestResponse, error)
+	// GenerateAccountStatement builds the statement of an account for a date range from its journal
+	GenerateAccountStatement(ctx context.Context, in *GenerateAccountStatementRequest, opts ...grpc.CallOption) (*GenerateAccountStatementResponse, error)
+	// ListAccountStatements returns a paginated list of the issued monthly statements of an account
+	ListAccountStatements(ctx context.Context, in *ListAccountStatementsRequest, opts ...grpc.CallOption) (*ListAccountStatementsResponse, error)
+	// GetStatement retrieves an issued statement with its lines
+	GetStatement(ctx context.Context, in *GetStatementRequest, opts ...grpc.CallOption) (*GetStatementResponse, error)
+	// GetAccountJournal returns the double-entry journal entries posted to an account
+	GetAccountJournal(ctx context.Context, in *GetAccountJournalRequest, opts ...grpc.CallOption) (*GetAccountJournalResponse, error)
+	// RecomputeAccountBalance rebuilds an account balance from its journal and compares it with the stored balance
+	RecomputeAccountBalance(ctx context.Context, in *RecomputeAccountBalanceRequest, opts ...grpc.CallOption) (*RecomputeAccountBalanceResponse, error)
 	ValidateAccounts(ctx context.Context, in *ValidateAccountsRequest, opts ...grpc.CallOption) (*ValidateAccountsResponse, error)
 	LockAccounts(ctx context.Context, in *LockAccountsRequest, opts ...grpc.CallOption) (*LockAccountsResponse, error)
 	UnlockAccounts(ctx context.Context, in *UnlockAccountsRequest, opts ...grpc.CallOption) (*UnlockAccountsResponse, error)
 	UpdateAccountsBalance(ctx context.Context, in *UpdateAccountsBalanceRequest, opts ...grpc.CallOption) (*UpdateAccountsBalanceResponse, error)
+	// GetTransactionJournalStatus reports whether the balance update and its compensation were committed for a transaction
+	GetTransactionJournalStatus(ctx context.Context, in *GetTransactionJournalStatusRequest, opts ...grpc.CallOption) (*GetTransactionJournalStatusResponse, error)
 }
 
 type accountServiceClient struct {
@@ -186,6 +241,176 @@ func (c *accountServiceClient) DeleteAccount(ctx context.Context, in *DeleteAcco
 	return out, nil
 }
 
+func (c *accountServiceClient) SetOverdraftLimit(ctx context.Context, in *SetOverdraftLimitRequest, opts ...grpc.CallOption) (*SetOverdraftLimitResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetOverdraftLimitResponse)
+	err := c.cc.Invoke(ctx, AccountService_SetOverdraftLimit_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountServiceClient) UpdateAccountStatus(ctx context.Context, in *UpdateAccountStatusRequest, opts ...grpc.CallOption) (*UpdateAccountStatusResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateAccountStatusResponse)
+	err := c.cc.Invoke(ctx, AccountService_UpdateAccountStatus_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountServiceClient) CloseAccount(ctx context.Context, in *CloseAccountRequest, opts ...grpc.CallOption) (*CloseAccountResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CloseAccountResponse)
+	err := c.cc.Invoke(ctx, AccountService_CloseAccount_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountServiceClient) PlaceHold(ctx context.Context, in *PlaceHoldRequest, opts ...grpc.CallOption) (*PlaceHoldResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PlaceHoldResponse)
+	err := c.cc.Invoke(ctx, AccountService_PlaceHold_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountServiceClient) GetHold(ctx context.Context, in *GetHoldRequest, opts ...grpc.CallOption) (*GetHoldResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetHoldResponse)
+	err := c.cc.Invoke(ctx, AccountService_GetHold_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountServiceClient) ReleaseHold(ctx context.Context, in *ReleaseHoldRequest, opts ...grpc.CallOption) (*ReleaseHoldResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReleaseHoldResponse)
+	err := c.cc.Invoke(ctx, AccountService_ReleaseHold_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountServiceClient) ListAccountHolds(ctx context.Context, in *ListAccountHoldsRequest, opts ...grpc.CallOption) (*ListAccountHoldsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListAccountHoldsResponse)
+	err := c.cc.Invoke(ctx, AccountService_ListAccountHolds_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountServiceClient) SetInterestRate(ctx context.Context, in *SetInterestRateRequest, opts ...grpc.CallOption) (*SetInterestRateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetInterestRateResponse)
+	err := c.cc.Invoke(ctx, AccountService_SetInterestRate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountServiceClient) ListInterestRates(ctx context.Context, in *ListInterestRatesRequest, opts ...grpc.CallOption) (*ListInterestRatesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListInterestRatesResponse)
+	err := c.cc.Invoke(ctx, AccountService_ListInterestRates_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountServiceClient) GetAccountInterest(ctx context.Context, in *GetAccountInterestRequest, opts ...grpc.CallOption) (*GetAccountInterestResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetAccountInterestResponse)
+	err := c.cc.Invoke(ctx, AccountService_GetAccountInterest_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountServiceClient) ListPendingInterestPostings(ctx context.Context, in *ListPendingInterestPostingsRequest, opts ...grpc.CallOption) (*ListPendingInterestPostingsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListPendingInterestPostingsResponse)
+	err := c.cc.Invoke(ctx, AccountService_ListPendingInterestPostings_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountServiceClient) SettleAccountInterest(ctx context.Context, in *SettleAccountInterestRequest, opts ...grpc.CallOption) (*SettleAccountInterestResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SettleAccountInterestResponse)
+	err := c.cc.Invoke(ctx, AccountService_SettleAccountInterest_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountServiceClient) GenerateAccountStatement(ctx context.Context, in *GenerateAccountStatementRequest, opts ...grpc.CallOption) (*GenerateAccountStatementResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GenerateAccountStatementResponse)
+	err := c.cc.Invoke(ctx, AccountService_GenerateAccountStatement_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountServiceClient) ListAccountStatements(ctx context.Context, in *ListAccountStatementsRequest, opts ...grpc.CallOption) (*ListAccountStatementsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListAccountStatementsResponse)
+	err := c.cc.Invoke(ctx, AccountService_ListAccountStatements_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountServiceClient) GetStatement(ctx context.Context, in *GetStatementRequest, opts ...grpc.CallOption) (*GetStatementResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetStatementResponse)
+	err := c.cc.Invoke(ctx, AccountService_GetStatement_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountServiceClient) GetAccountJournal(ctx context.Context, in *GetAccountJournalRequest, opts ...grpc.CallOption) (*GetAccountJournalResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetAccountJournalResponse)
+	err := c.cc.Invoke(ctx, AccountService_GetAccountJournal_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountServiceClient) RecomputeAccountBalance(ctx context.Context, in *RecomputeAccountBalanceRequest, opts ...grpc.CallOption) (*RecomputeAccountBalanceResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RecomputeAccountBalanceResponse)
+	err := c.cc.Invoke(ctx, AccountService_RecomputeAccountBalance_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *accountServiceClient) ValidateAccounts(ctx context.Context, in *ValidateAccountsRequest, opts ...grpc.CallOption) (*ValidateAccountsResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(ValidateAccountsResponse)
@@ -226,6 +451,16 @@ func (c *accountServiceClient) UpdateAccountsBalance(ctx context.Context, in *Up
 	return out, nil
 }
 
+func (c *accountServiceClient) GetTransactionJournalStatus(ctx context.Context, in *GetTransactionJournalStatusRequest, opts ...grpc.CallOption) (*GetTransactionJournalStatusResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetTransactionJournalStatusResponse)
+	err := c.cc.Invoke(ctx, AccountService_GetTransactionJournalStatus_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // AccountServiceServer is the server API for AccountService service.
 // All implementations must embed UnimplementedAccountServiceServer
 // for forward compatibility.
@@ -248,14 +483,51 @@ type AccountServiceServer interface {
 	GetAccount(context.Context, *GetAccountRequest) (*GetAccountResponse, error)
 	// ListAccount returns a paginated list of accounts with filtering options
 	ListAccount(context.Context, *ListAccountsRequest) (*ListAccountsResponse, error)
-	// GetBalance queries the current available balance for a specific account
+	// GetBalance queries the ledger and available balance for a specific account
 	GetBalance(context.Context, *GetBalanceRequest) (*GetBalanceResponse, error)
 	// DeleteAccount deletes an account from the system (soft delete)
 	DeleteAccount(context.Context, *DeleteAccountRequest) (*DeleteAccountResponse, error)
+	// SetOverdraftLimit sets how far a current account may go below zero
+	SetOverdraftLimit(context.Context, *SetOverdraftLimitRequest) (*SetOverdraftLimitResponse, error)
+	// UpdateAccountStatus freezes an account or unfreezes and reactivates it
+	UpdateAccountStatus(context.Context, *UpdateAccountStatusRequest) (*UpdateAccountStatusResponse, error)
+	// CloseAccount closes an account whose balance was paid out by the transaction service
+	CloseAccount(context.Context, *CloseAccountRequest) (*CloseAccountResponse, error)
+	// PlaceHold earmarks funds of an account, reducing its available balance until captured, released or expired
+	PlaceHold(context.Context, *PlaceHoldRequest) (*PlaceHoldResponse, error)
+	// GetHold retrieves a hold
+	GetHold(context.Context, *GetHoldRequest) (*GetHoldResponse, error)
+	// ReleaseHold frees the held funds without moving money
+	ReleaseHold(context.Context, *ReleaseHoldRequest) (*ReleaseHoldResponse, error)
+	// ListAccountHolds returns a paginated list of the holds of an account
+	ListAccountHolds(context.Context, *ListAccountHoldsRequest) (*ListAccountHoldsResponse, error)
+	// SetInterestRate creates or replaces the annual interest rate of an account type or account
+	SetInterestRate(context.Context, *SetInterestRateRequest) (*SetInterestRateResponse, error)
+	// ListInterestRates returns every configured interest rate
+	ListInterestRates(context.Context, *ListInterestRatesRequest) (*ListInterestRatesResponse, error)
+	// GetAccountInterest returns the rate, unposted accrued interest and interest postings of an account
+	GetAccountInterest(context.Context, *GetAccountInterestRequest) (*GetAccountInterestResponse, error)
+	// ListPendingInterestPostings returns the closed monthly interest waiting to be credited
+	ListPendingInterestPostings(context.Context, *ListPendingInterestPostingsRequest) (*ListPendingInterestPostingsResponse, error)
+	// SettleAccountInterest posts the interest an account accrued so far ahead of its closure and returns the pending
+	// postings of the account
+	SettleAccountInterest(context.Context, *SettleAccountInterestRequest) (*SettleAccountInterestResponse, error)
+	// GenerateAccountStatement builds the statement of an account for a date range from its journal
+	GenerateAccountStatement(context.Context, *GenerateAccountStatementRequest) (*GenerateAccountStatementResponse, error)
+	// ListAccountStatements returns a paginated list of the issued monthly statements of an account
+	ListAccountStatements(context.Context, *ListAccountStatementsRequest) (*ListAccountStatementsResponse, error)
+	// GetStatement retrieves an issued statement with its lines
+	GetStatement(context.Context, *GetStatementRequest) (*GetStatementResponse, error)
+	// GetAccountJournal returns the double-entry journal entries posted to an account
+	GetAccountJournal(context.Context, *GetAccountJournalRequest) (*GetAccountJournalResponse, error)
+	// RecomputeAccountBalance rebuilds an account balance from its journal and compares it with the stored balance
+	RecomputeAccountBalance(context.Context, *RecomputeAccountBalanceRequest) (*RecomputeAccountBalanceResponse, error)
 	ValidateAccounts(context.Context, *ValidateAccountsRequest) (*ValidateAccountsResponse, error)
 	LockAccounts(context.Context, *LockAccountsRequest) (*LockAccountsResponse, error)
 	UnlockAccounts(context.Context, *UnlockAccountsRequest) (*UnlockAccountsResponse, error)
 	UpdateAccountsBalance(context.Context, *UpdateAccountsBalanceRequest) (*UpdateAccountsBalanceResponse, error)
+	// GetTransactionJournalStatus reports whether the balance update and its compensation were committed for a transaction
+	GetTransactionJournalStatus(context.Context, *GetTransactionJournalStatusRequest) (*GetTransactionJournalStatusResponse, error)
 	mustEmbedUnimplementedAccountServiceServer()
 }
 
@@ -299,6 +571,57 @@ func (UnimplementedAccountServiceServer) GetBalance(context.Context, *GetBalance
 func (UnimplementedAccountServiceServer) DeleteAccount(context.Context, *DeleteAccountRequest) (*DeleteAccountResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteAccount not implemented")
 }
+func (UnimplementedAccountServiceServer) SetOverdraftLimit(context.Context, *SetOverdraftLimitRequest) (*SetOverdraftLimitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetOverdraftLimit not implemented")
+}
+func (UnimplementedAccountServiceServer) UpdateAccountStatus(context.Context, *UpdateAccountStatusRequest) (*UpdateAccountStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateAccountStatus not implemented")
+}
+func (UnimplementedAccountServiceServer) CloseAccount(context.Context, *CloseAccountRequest) (*CloseAccountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CloseAccount not implemented")
+}
+func (UnimplementedAccountServiceServer) PlaceHold(context.Context, *PlaceHoldRequest) (*PlaceHoldResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PlaceHold not implemented")
+}
+func (UnimplementedAccountServiceServer) GetHold(context.Context, *GetHoldRequest) (*GetHoldResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetHold not implemented")
+}
+func (UnimplementedAccountServiceServer) ReleaseHold(context.Context, *ReleaseHoldRequest) (*ReleaseHoldResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReleaseHold not implemented")
+}
+func (UnimplementedAccountServiceServer) ListAccountHolds(context.Context, *ListAccountHoldsRequest) (*ListAccountHoldsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAccountHolds not implemented")
+}
+func (UnimplementedAccountServiceServer) SetInterestRate(context.Context, *SetInterestRateRequest) (*SetInterestRateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetInterestRate not implemented")
+}
+func (UnimplementedAccountServiceServer) ListInterestRates(context.Context, *ListInterestRatesRequest) (*ListInterestRatesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListInterestRates not implemented")
+}
+func (UnimplementedAccountServiceServer) GetAccountInterest(context.Context, *GetAccountInterestRequest) (*GetAccountInterestResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAccountInterest not implemented")
+}
+func (UnimplementedAccountServiceServer) ListPendingInterestPostings(context.Context, *ListPendingInterestPostingsRequest) (*ListPendingInterestPostingsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPendingInterestPostings not implemented")
+}
+func (UnimplementedAccountServiceServer) SettleAccountInterest(context.Context, *SettleAccountInterestRequest) (*SettleAccountInterestResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SettleAccountInterest not implemented")
+}
+func (UnimplementedAccountServiceServer) GenerateAccountStatement(context.Context, *GenerateAccountStatementRequest) (*GenerateAccountStatementResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GenerateAccountStatement not implemented")
+}
+func (UnimplementedAccountServiceServer) ListAccountStatements(context.Context, *ListAccountStatementsRequest) (*ListAccountStatementsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAccountStatements not implemented")
+}
+func (UnimplementedAccountServiceServer) GetStatement(context.Context, *GetStatementRequest) (*GetStatementResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetStatement not implemented")
+}
+func (UnimplementedAccountServiceServer) GetAccountJournal(context.Context, *GetAccountJournalRequest) (*GetAccountJournalResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAccountJournal not implemented")
+}
+func (UnimplementedAccountServiceServer) RecomputeAccountBalance(context.Context, *RecomputeAccountBalanceRequest) (*RecomputeAccountBalanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RecomputeAccountBalance not implemented")
+}
 func (UnimplementedAccountServiceServer) ValidateAccounts(context.Context, *ValidateAccountsRequest) (*ValidateAccountsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ValidateAccounts not implemented")
 }
@@ -311,6 +634,9 @@ func (UnimplementedAccountServiceServer) UnlockAccounts(context.Context, *Unlock
 func (UnimplementedAccountServiceServer) UpdateAccountsBalance(context.Context, *UpdateAccountsBalanceRequest) (*UpdateAccountsBalanceResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateAccountsBalance not implemented")
 }
+func (UnimplementedAccountServiceServer) GetTransactionJournalStatus(context.Context, *GetTransactionJournalStatusRequest) (*GetTransactionJournalStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTransactionJournalStatus not implemented")
+}
 func (UnimplementedAccountServiceServer) mustEmbedUnimplementedAccountServiceServer() {}
 func (UnimplementedAccountServiceServer) testEmbeddedByValue()                        {}
 
@@ -530,6 +856,312 @@ func _AccountService_DeleteAccount_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AccountService_SetOverdraftLimit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetOverdraftLimitRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountServiceServer).SetOverdraftLimit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AccountService_SetOverdraftLimit_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountServiceServer).SetOverdraftLimit(ctx, req.(*SetOverdraftLimitRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AccountService_UpdateAccountStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateAccountStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountServiceServer).UpdateAccountStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AccountService_UpdateAccountStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountServiceServer).UpdateAccountStatus(ctx, req.(*UpdateAccountStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AccountService_CloseAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CloseAccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountServiceServer).CloseAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AccountService_CloseAccount_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountServiceServer).CloseAccount(ctx, req.(*CloseAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AccountService_PlaceHold_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PlaceHoldRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountServiceServer).PlaceHold(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AccountService_PlaceHold_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountServiceServer).PlaceHold(ctx, req.(*PlaceHoldRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AccountService_GetHold_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetHoldRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountServiceServer).GetHold(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AccountService_GetHold_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountServiceServer).GetHold(ctx, req.(*GetHoldRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AccountService_ReleaseHold_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReleaseHoldRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountServiceServer).ReleaseHold(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AccountService_ReleaseHold_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountServiceServer).ReleaseHold(ctx, req.(*ReleaseHoldRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AccountService_ListAccountHolds_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAccountHoldsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountServiceServer).ListAccountHolds(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AccountService_ListAccountHolds_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountServiceServer).ListAccountHolds(ctx, req.(*ListAccountHoldsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AccountService_SetInterestRate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetInterestRateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountServiceServer).SetInterestRate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AccountService_SetInterestRate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountServiceServer).SetInterestRate(ctx, req.(*SetInterestRateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AccountService_ListInterestRates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListInterestRatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountServiceServer).ListInterestRates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AccountService_ListInterestRates_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountServiceServer).ListInterestRates(ctx, req.(*ListInterestRatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AccountService_GetAccountInterest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAccountInterestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountServiceServer).GetAccountInterest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AccountService_GetAccountInterest_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountServiceServer).GetAccountInterest(ctx, req.(*GetAccountInterestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AccountService_ListPendingInterestPostings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPendingInterestPostingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountServiceServer).ListPendingInterestPostings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AccountService_ListPendingInterestPostings_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountServiceServer).ListPendingInterestPostings(ctx, req.(*ListPendingInterestPostingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AccountService_SettleAccountInterest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SettleAccountInterestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountServiceServer).SettleAccountInterest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AccountService_SettleAccountInterest_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountServiceServer).SettleAccountInterest(ctx, req.(*SettleAccountInterestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AccountService_GenerateAccountStatement_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GenerateAccountStatementRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountServiceServer).GenerateAccountStatement(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AccountService_GenerateAccountStatement_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountServiceServer).GenerateAccountStatement(ctx, req.(*GenerateAccountStatementRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AccountService_ListAccountStatements_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAccountStatementsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountServiceServer).ListAccountStatements(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AccountService_ListAccountStatements_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountServiceServer).ListAccountStatements(ctx, req.(*ListAccountStatementsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AccountService_GetStatement_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetStatementRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountServiceServer).GetStatement(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AccountService_GetStatement_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountServiceServer).GetStatement(ctx, req.(*GetStatementRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AccountService_GetAccountJournal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAccountJournalRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountServiceServer).GetAccountJournal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AccountService_GetAccountJournal_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountServiceServer).GetAccountJournal(ctx, req.(*GetAccountJournalRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AccountService_RecomputeAccountBalance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RecomputeAccountBalanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountServiceServer).RecomputeAccountBalance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AccountService_RecomputeAccountBalance_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountServiceServer).RecomputeAccountBalance(ctx, req.(*RecomputeAccountBalanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _AccountService_ValidateAccounts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ValidateAccountsRequest)
 	if err := dec(in); err != nil {
@@ -602,6 +1234,24 @@ func _AccountService_UpdateAccountsBalance_Handler(srv interface{}, ctx context.
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AccountService_GetTransactionJournalStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTransactionJournalStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountServiceServer).GetTransactionJournalStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AccountService_GetTransactionJournalStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountServiceServer).GetTransactionJournalStatus(ctx, req.(*GetTransactionJournalStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // AccountService_ServiceDesc is the grpc.ServiceDesc for AccountService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -653,6 +1303,74 @@ var AccountService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteAccount",
 			Handler:    _AccountService_DeleteAccount_Handler,
 		},
+		{
+			MethodName: "SetOverdraftLimit",
+			Handler:    _AccountService_SetOverdraftLimit_Handler,
+		},
+		{
+			MethodName: "UpdateAccountStatus",
+			Handler:    _AccountService_UpdateAccountStatus_Handler,
+		},
+		{
+			MethodName: "CloseAccount",
+			Handler:    _AccountService_CloseAccount_Handler,
+		},
+		{
+			MethodName: "PlaceHold",
+			Handler:    _AccountService_PlaceHold_Handler,
+		},
+		{
+			MethodName: "GetHold",
+			Handler:    _AccountService_GetHold_Handler,
+		},
+		{
+			MethodName: "ReleaseHold",
+			Handler:    _AccountService_ReleaseHold_Handler,
+		},
+		{
+			MethodName: "ListAccountHolds",
+			Handler:    _AccountService_ListAccountHolds_Handler,
+		},
+		{
+			MethodName: "SetInterestRate",
+			Handler:    _AccountService_SetInterestRate_Handler,
+		},
+		{
+			MethodName: "ListInterestRates",
+			Handler:    _AccountService_ListInterestRates_Handler,
+		},
+		{
+			MethodName: "GetAccountInterest",
+			Handler:    _AccountService_GetAccountInterest_Handler,
+		},
+		{
+			MethodName: "ListPendingInterestPostings",
+			Handler:    _AccountService_ListPendingInterestPostings_Handler,
+		},
+		{
+			MethodName: "SettleAccountInterest",
+			Handler:    _AccountService_SettleAccountInterest_Handler,
+		},
+		{
+			MethodName: "GenerateAccountStatement",
+			Handler:    _AccountService_GenerateAccountStatement_Handler,
+		},
+		{
+			MethodName: "ListAccountStatements",
+			Handler:    _AccountService_ListAccountStatements_Handler,
+		},
+		{
+			MethodName: "GetStatement",
+			Handler:    _AccountService_GetStatement_Handler,
+		},
+		{
+			MethodName: "GetAccountJournal",
+			Handler:    _AccountService_GetAccountJournal_Handler,
+		},
+		{
+			MethodName: "RecomputeAccountBalance",
+			Handler:    _AccountService_RecomputeAccountBalance_Handler,
+		},
 		{
 			MethodName: "ValidateAccounts",
 			Handler:    _AccountService_ValidateAccounts_Handler,
@@ -669,6 +1387,10 @@ var AccountService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateAccountsBalance",
 			Handler:    _AccountService_UpdateAccountsBalance_Handler,
 		},
+		{
+			MethodName: "GetTransactionJournalStatus",
+			Handler:    _AccountService_GetTransactionJournalStatus_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "account_service.proto",
@@ -393,6 +393,8 @@ type UpdateAccountsBalanceRequest struct {
 	state         protoimpl.MessageState  `protogen:"open.v1"`
 	Updates       []*AccountBalanceUpdate `protobuf:"bytes,1,rep,name=updates,proto3" json:"updates,omitempty"`
 	Metadata      *Metadata               `protobuf:"bytes,4,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	TransactionId string                  `protobuf:"bytes,5,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"` // journal reference for the balance change
+	Compensation  bool                    `protobuf:"varint,6,opt,name=compensation,proto3" json:"compensation,omitempty"`                       // true when rolling back a previous update
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -441,13 +443,30 @@ func (x *UpdateAccountsBalanceRequest) GetMetadata() *Metadata {
 	return nil
 }
 
+func (x *UpdateAccountsBalanceRequest) GetTransactionId() string {
+	if x != nil {
+		return x.TransactionId
+	}
+	return ""
+}
+
+func (x *UpdateAccountsBalanceRequest) GetCompensation() bool {
+	if x != nil {
+		return x.Compensation
+	}
+	return false
+}
+
 type AccountBalanceUpdate struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	AccountId     string                 `protobuf:"bytes,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
-	NewBalance    float64                `protobuf:"fixed64,2,opt,name=new_balance,json=newBalance,proto3" json:"new_balance,omitempty"`
-	Version       int32                  `protobuf:"varint,3,opt,name=version,proto3" json:"version,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	AccountId         string                 `protobuf:"bytes,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	Version           int32                  `protobuf:"varint,3,opt,name=version,proto3" json:"version,omitempty"`
+	NewBalance        string                 `protobuf:"bytes,4,opt,name=new_balance,json=newBalance,proto3" json:"new_balance,omitempty"`                        // decimal string, e.g. "1250.75"
+	HoldId            string                 `protobuf:"bytes,5,opt,name=hold_id,json=holdId,proto3" json:"hold_id,omitempty"`                                    // hold captured by this update, if any
+	HoldCaptureAmount string                 `protobuf:"bytes,6,opt,name=hold_capture_amount,json=holdCaptureAmount,proto3" json:"hold_capture_amount,omitempty"` // decimal string, amount of the hold captured
+	InterestPostingId string                 `protobuf:"bytes,7,opt,name=interest_posting_id,json=interestPostingId,proto3" json:"interest_posting_id,omitempty"` // interest posting credited by this update, if any
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
 }
 
 func (x *AccountBalanceUpdate) Reset() {
@@ -487,18 +506,39 @@ func (x *AccountBalanceUpdate) GetAccountId() string {
 	return ""
 }
 
-func (x *AccountBalanceUpdate) GetNewBalance() float64 {
+func (x *AccountBalanceUpdate) GetVersion() int32 {
 	if x != nil {
-		return x.NewBalance
+		return x.Version
 	}
 	return 0
 }
 
-func (x *AccountBalanceUpdate) GetVersion() int32 {
+func (x *AccountBalanceUpdate) GetNewBalance() string {
 	if x != nil {
-		return x.Version
+		return x.NewBalance
 	}
-	return 0
+	return ""
+}
+
+func (x *AccountBalanceUpdate) GetHoldId() string {
+	if x != nil {
+		return x.HoldId
+	}
+	return ""
+}
+
+func (x *AccountBalanceUpdate) GetHoldCaptureAmount() string {
+	if x != nil {
+		return x.HoldCaptureAmount
+	}
+	return ""
+}
+
+func (x *AccountBalanceUpdate) GetInterestPostingId() string {
+	if x != nil {
+		return x.InterestPostingId
+	}
+	return ""
 }
 
 type UpdateAccountsBalanceResponse struct {
@@ -621,6 +661,186 @@ func (x *AccountVersion) GetVersion() int32 {
 	return 0
 }
 
+type GetTransactionJournalStatusRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TransactionId string                 `protobuf:"bytes,1,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"`
+	Metadata      *Metadata              `protobuf:"bytes,2,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTransactionJournalStatusRequest) Reset() {
+	*x = GetTransactionJournalStatusRequest{}
+	mi := &file_transaction_saga_transaction_saga_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTransactionJournalStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTransactionJournalStatusRequest) ProtoMessage() {}
+
+func (x *GetTransactionJournalStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_transaction_saga_transaction_saga_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTransactionJournalStatusRequest.ProtoReflect.Descriptor instead.
+func (*GetTransactionJournalStatusRequest) Descriptor() ([]byte, []int) {
+	return file_transaction_saga_transaction_saga_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *GetTransactionJournalStatusRequest) GetTransactionId() string {
+	if x != nil {
+		return x.TransactionId
+	}
+	return ""
+}
+
+func (x *GetTransactionJournalStatusRequest) GetMetadata() *Metadata {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+type GetTransactionJournalStatusResponse struct {
+	state          protoimpl.MessageState  `protogen:"open.v1"`
+	BalanceApplied bool                    `protobuf:"varint,1,opt,name=balance_applied,json=balanceApplied,proto3" json:"balance_applied,omitempty"` // a transaction journal was posted
+	Compensated    bool                    `protobuf:"varint,2,opt,name=compensated,proto3" json:"compensated,omitempty"`                             // a compensation journal was posted
+	Changes        []*AccountBalanceChange `protobuf:"bytes,3,rep,name=changes,proto3" json:"changes,omitempty"`                                      // net change per account made by the transaction journal
+	Response       *Response               `protobuf:"bytes,4,opt,name=response,proto3" json:"response,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *GetTransactionJournalStatusResponse) Reset() {
+	*x = GetTransactionJournalStatusResponse{}
+	mi := &file_transaction_saga_transaction_saga_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTransactionJournalStatusResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTransactionJournalStatusResponse) ProtoMessage() {}
+
+func (x *GetTransactionJournalStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_transaction_saga_transaction_saga_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTransactionJournalStatusResponse.ProtoReflect.Descriptor instead.
+func (*GetTransactionJournalStatusResponse) Descriptor() ([]byte, []int) {
+	return file_transaction_saga_transaction_saga_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *GetTransactionJournalStatusResponse) GetBalanceApplied() bool {
+	if x != nil {
+		return x.BalanceApplied
+	}
+	return false
+}
+
+func (x *GetTransactionJournalStatusResponse) GetCompensated() bool {
+	if x != nil {
+		return x.Compensated
+	}
+	return false
+}
+
+func (x *GetTransactionJournalStatusResponse) GetChanges() []*AccountBalanceChange {
+	if x != nil {
+		return x.Changes
+	}
+	return nil
+}
+
+func (x *GetTransactionJournalStatusResponse) GetResponse() *Response {
+	if x != nil {
+		return x.Response
+	}
+	return nil
+}
+
+type AccountBalanceChange struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AccountId     string                 `protobuf:"bytes,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	Currency      string                 `protobuf:"bytes,2,opt,name=currency,proto3" json:"currency,omitempty"`
+	Delta         string                 `protobuf:"bytes,3,opt,name=delta,proto3" json:"delta,omitempty"` // signed decimal string, e.g. "-100.00"
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AccountBalanceChange) Reset() {
+	*x = AccountBalanceChange{}
+	mi := &file_transaction_saga_transaction_saga_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AccountBalanceChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AccountBalanceChange) ProtoMessage() {}
+
+func (x *AccountBalanceChange) ProtoReflect() protoreflect.Message {
+	mi := &file_transaction_saga_transaction_saga_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AccountBalanceChange.ProtoReflect.Descriptor instead.
+func (*AccountBalanceChange) Descriptor() ([]byte, []int) {
+	return file_transaction_saga_transaction_saga_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *AccountBalanceChange) GetAccountId() string {
+	if x != nil {
+		return x.AccountId
+	}
+	return ""
+}
+
+func (x *AccountBalanceChange) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *AccountBalanceChange) GetDelta() string {
+	if x != nil {
+		return x.Delta
+	}
+	return ""
+}
+
 var File_transaction_saga_transaction_saga_proto protoreflect.FileDescriptor
 
 var file_transaction_saga_transaction_saga_proto_rawDesc = string([]byte{
@@ -683,7 +903,7 @@ var file_transaction_saga_transaction_saga_proto_rawDesc = string([]byte{
 	0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x2c, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70,
 	0x6f, 0x6e, 0x73, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x63, 0x6f, 0x6d,
 	0x6d, 0x6f, 0x6e, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x08, 0x72, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x8e, 0x01, 0x0a, 0x1c, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xd9, 0x01, 0x0a, 0x1c, 0x55, 0x70, 0x64, 0x61, 0x74,
 	0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65,
 	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x40, 0x0a, 0x07, 0x75, 0x70, 0x64, 0x61, 0x74,
 	0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x74, 0x72, 0x61, 0x6e, 0x73,
@@ -692,34 +912,75 @@ var file_transaction_saga_transaction_saga_proto_rawDesc = string([]byte{
 	0x52, 0x07, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x73, 0x12, 0x2c, 0x0a, 0x08, 0x6d, 0x65, 0x74,
 	0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x63, 0x6f,
 	0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x08, 0x6d,
-	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x22, 0x70, 0x0a, 0x14, 0x41, 0x63, 0x63, 0x6f, 0x75,
-	0x6e, 0x74, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12,
+	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x25, 0x0a, 0x0e, 0x74, 0x72, 0x61, 0x6e, 0x73,
+	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0d, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x22,
+	0x0a, 0x0c, 0x63, 0x6f, 0x6d, 0x70, 0x65, 0x6e, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x63, 0x6f, 0x6d, 0x70, 0x65, 0x6e, 0x73, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x22, 0xef, 0x01, 0x0a, 0x14, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x42, 0x61,
+	0x6c, 0x61, 0x6e, 0x63, 0x65, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x61,
+	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x76, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1f, 0x0a, 0x0b, 0x6e, 0x65, 0x77, 0x5f, 0x62, 0x61, 0x6c, 0x61,
+	0x6e, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6e, 0x65, 0x77, 0x42, 0x61,
+	0x6c, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x68, 0x6f, 0x6c, 0x64, 0x5f, 0x69, 0x64,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x68, 0x6f, 0x6c, 0x64, 0x49, 0x64, 0x12, 0x2e,
+	0x0a, 0x13, 0x68, 0x6f, 0x6c, 0x64, 0x5f, 0x63, 0x61, 0x70, 0x74, 0x75, 0x72, 0x65, 0x5f, 0x61,
+	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x68, 0x6f, 0x6c,
+	0x64, 0x43, 0x61, 0x70, 0x74, 0x75, 0x72, 0x65, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x2e,
+	0x0a, 0x13, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x65, 0x73, 0x74, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x69,
+	0x6e, 0x67, 0x5f, 0x69, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x69, 0x6e, 0x74,
+	0x65, 0x72, 0x65, 0x73, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x49, 0x64, 0x4a, 0x04,
+	0x08, 0x02, 0x10, 0x03, 0x22, 0xc6, 0x01, 0x0a, 0x1d, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x41,
+	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73,
+	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73,
+	0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x2c, 0x0a, 0x08, 0x72, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x63,
+	0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x08,
+	0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x43, 0x0a, 0x0c, 0x6e, 0x65, 0x77, 0x5f,
+	0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20,
+	0x2e, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x61, 0x67,
+	0x61, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x52, 0x0b, 0x6e, 0x65, 0x77, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x49, 0x0a,
+	0x0e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12,
 	0x1d, 0x0a, 0x0a, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x09, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x1f,
-	0x0a, 0x0b, 0x6e, 0x65, 0x77, 0x5f, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x01, 0x52, 0x0a, 0x6e, 0x65, 0x77, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x12,
-	0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05,
-	0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0xc6, 0x01, 0x0a, 0x1d, 0x55, 0x70,
-	0x64, 0x61, 0x74, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x42, 0x61, 0x6c, 0x61,
-	0x6e, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73,
-	0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75,
-	0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12,
-	0x2c, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x10, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x52, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x43, 0x0a,
-	0x0c, 0x6e, 0x65, 0x77, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x04, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f,
-	0x6e, 0x5f, 0x73, 0x61, 0x67, 0x61, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x56, 0x65,
-	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x6e, 0x65, 0x77, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f,
-	0x6e, 0x73, 0x22, 0x49, 0x0a, 0x0e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x56, 0x65, 0x72,
-	0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f,
-	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e,
-	0x74, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x42, 0x1f, 0x5a,
-	0x1d, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x67, 0x65, 0x6e, 0x2f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e,
-	0x74, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x06,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x18,
+	0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x79, 0x0a, 0x22, 0x47, 0x65, 0x74, 0x54,
+	0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4a, 0x6f, 0x75, 0x72, 0x6e, 0x61,
+	0x6c, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x25,
+	0x0a, 0x0e, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x2c, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74,
+	0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e,
+	0x2e, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64,
+	0x61, 0x74, 0x61, 0x22, 0xe0, 0x01, 0x0a, 0x23, 0x47, 0x65, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73,
+	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4a, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6c, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x62,
+	0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x65, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x41, 0x70, 0x70,
+	0x6c, 0x69, 0x65, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x63, 0x6f, 0x6d, 0x70, 0x65, 0x6e, 0x73, 0x61,
+	0x74, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x63, 0x6f, 0x6d, 0x70, 0x65,
+	0x6e, 0x73, 0x61, 0x74, 0x65, 0x64, 0x12, 0x40, 0x0a, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65,
+	0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x61, 0x67, 0x61, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52,
+	0x07, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x12, 0x2c, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x63, 0x6f, 0x6d,
+	0x6d, 0x6f, 0x6e, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x08, 0x72, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x67, 0x0a, 0x14, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x1d,
+	0x0a, 0x0a, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x1a, 0x0a,
+	0x08, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x64, 0x65, 0x6c,
+	0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x64, 0x65, 0x6c, 0x74, 0x61, 0x42,
+	0x1f, 0x5a, 0x1d, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x67, 0x65, 0x6e, 0x2f, 0x61, 0x63, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 })
 
 var (
@@ -734,39 +995,45 @@ func file_transaction_saga_transaction_saga_proto_rawDescGZIP() []byte {
 	return file_transaction_saga_transaction_saga_proto_rawDescData
 }
 
-var file_transaction_saga_transaction_saga_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
+var file_transaction_saga_transaction_saga_proto_msgTypes = make([]protoimpl.MessageInfo, 13)
 var file_transaction_saga_transaction_saga_proto_goTypes = []any{
-	(*ValidateAccountsRequest)(nil),       // 0: transaction_saga.ValidateAccountsRequest
-	(*ValidateAccountsResponse)(nil),      // 1: transaction_saga.ValidateAccountsResponse
-	(*LockAccountsRequest)(nil),           // 2: transaction_saga.LockAccountsRequest
-	(*LockAccountsResponse)(nil),          // 3: transaction_saga.LockAccountsResponse
-	(*UnlockAccountsRequest)(nil),         // 4: transaction_saga.UnlockAccountsRequest
-	(*UnlockAccountsResponse)(nil),        // 5: transaction_saga.UnlockAccountsResponse
-	(*UpdateAccountsBalanceRequest)(nil),  // 6: transaction_saga.UpdateAccountsBalanceRequest
-	(*AccountBalanceUpdate)(nil),          // 7: transaction_saga.AccountBalanceUpdate
-	(*UpdateAccountsBalanceResponse)(nil), // 8: transaction_saga.UpdateAccountsBalanceResponse
-	(*AccountVersion)(nil),                // 9: transaction_saga.AccountVersion
-	(*Metadata)(nil),                      // 10: common.Metadata
-	(*Account)(nil),                       // 11: account.Account
-	(*Response)(nil),                      // 12: common.Response
+	(*ValidateAccountsRequest)(nil),             // 0: transaction_saga.ValidateAccountsRequest
+	(*ValidateAccountsResponse)(nil),            // 1: transaction_saga.ValidateAccountsResponse
+	(*LockAccountsRequest)(nil),                 // 2: transaction_saga.LockAccountsRequest
+	(*LockAccountsResponse)(nil),                // 3: transaction_saga.LockAccountsResponse
+	(*UnlockAccountsRequest)(nil),               // 4: transaction_saga.UnlockAccountsRequest
+	(*UnlockAccountsResponse)(nil),              // 5: transaction_saga.UnlockAccountsResponse
+	(*UpdateAccountsBalanceRequest)(nil),        // 6: transaction_saga.UpdateAccountsBalanceRequest
+	(*AccountBalanceUpdate)(nil),                // 7: transaction_saga.AccountBalanceUpdate
+	(*UpdateAccountsBalanceResponse)(nil),       // 8: transaction_saga.UpdateAccountsBalanceResponse
+	(*AccountVersion)(nil),                      // 9: transaction_saga.AccountVersion
+	(*GetTransactionJournalStatusRequest)(nil),  // 10: transaction_saga.GetTransactionJournalStatusRequest
+	(*GetTransactionJournalStatusResponse)(nil), // 11: transaction_saga.GetTransactionJournalStatusResponse
+	(*AccountBalanceChange)(nil),                // 12: transaction_saga.AccountBalanceChange
+	(*Metadata)(nil),                            // 13: common.Metadata
+	(*Account)(nil),                             // 14: account.Account
+	(*Response)(nil),                            // 15: common.Response
 }
 var file_transaction_saga_transaction_saga_proto_depIdxs = []int32{
-	10, // 0: transaction_saga.ValidateAccountsRequest.metadata:type_name -> common.Metadata
-	11, // 1: transaction_saga.ValidateAccountsResponse.accounts:type_name -> account.Account
-	12, // 2: transaction_saga.ValidateAccountsResponse.response:type_name -> common.Response
-	10, // 3: transaction_saga.LockAccountsRequest.metadata:type_name -> common.Metadata
-	12, // 4: transaction_saga.LockAccountsResponse.response:type_name -> common.Response
-	10, // 5: transaction_saga.UnlockAccountsRequest.metadata:type_name -> common.Metadata
-	12, // 6: transaction_saga.UnlockAccountsResponse.response:type_name -> common.Response
+	13, // 0: transaction_saga.ValidateAccountsRequest.metadata:type_name -> common.Metadata
+	14, // 1: transaction_saga.ValidateAccountsResponse.accounts:type_name -> account.Account
+	15, // 2: transaction_saga.ValidateAccountsResponse.response:type_name -> common.Response
+	13, // 3: transaction_saga.LockAccountsRequest.metadata:type_name -> common.Metadata
+	15, // 4: transaction_saga.LockAccountsResponse.response:type_name -> common.Response
+	13, // 5: transaction_saga.UnlockAccountsRequest.metadata:type_name -> common.Metadata
+	15, // 6: transaction_saga.UnlockAccountsResponse.response:type_name -> common.Response
 	7,  // 7: transaction_saga.UpdateAccountsBalanceRequest.updates:type_name -> transaction_saga.AccountBalanceUpdate
-	10, // 8: transaction_saga.UpdateAccountsBalanceRequest.metadata:type_name -> common.Metadata
-	12, // 9: transaction_saga.UpdateAccountsBalanceResponse.response:type_name -> common.Response
+	13, // 8: transaction_saga.UpdateAccountsBalanceRequest.metadata:type_name -> common.Metadata
+	15, // 9: transaction_saga.UpdateAccountsBalanceResponse.response:type_name -> common.Response
 	9,  // 10: transaction_saga.UpdateAccountsBalanceResponse.new_versions:type_name -> transaction_saga.AccountVersion
-	11, // [11:11] is the sub-list for method output_type
-	11, // [11:11] is the sub-list for method input_type
-	11, // [11:11] is the sub-list for extension type_name
-	11, // [11:11] is the sub-list for extension extendee
-	0,  // [0:11] is the sub-list for field type_name
+	13, // 11: transaction_saga.GetTransactionJournalStatusRequest.metadata:type_name -> common.Metadata
+	12, // 12: transaction_saga.GetTransactionJournalStatusResponse.changes:type_name -> transaction_saga.AccountBalanceChange
+	15, // 13: transaction_saga.GetTransactionJournalStatusResponse.response:type_name -> common.Response
+	14, // [14:14] is the sub-list for method output_type
+	14, // [14:14] is the sub-list for method input_type
+	14, // [14:14] is the sub-list for extension type_name
+	14, // [14:14] is the sub-list for extension extendee
+	0,  // [0:14] is the sub-list for field type_name
 }
 
 func init() { file_transaction_saga_transaction_saga_proto_init() }
@@ -782,7 +1049,7 @@ func file_transaction_saga_transaction_saga_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_transaction_saga_transaction_saga_proto_rawDesc), len(file_transaction_saga_transaction_saga_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   10,
+			NumMessages:   13,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
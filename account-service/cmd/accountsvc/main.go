@@ -2,10 +2,14 @@ package main
 
 import (
 	"account-service/internal/adapters/repo/sqlite"
+	appaccount "account-service/internal/app/account"
+	appinterest "account-service/internal/app/interest"
+	appstatement "account-service/internal/app/statement"
 	"account-service/internal/config"
 	"account-service/internal/db"
 	"account-service/internal/grpc"
 	httpserver "account-service/internal/http"
+	"account-service/internal/jobs"
 	"account-service/internal/logging"
 	"account-service/internal/messaging"
 	"account-service/internal/observability/metrics"
@@ -67,12 +71,40 @@ func main() {
 	ctx, stop := runtime.SignalContext(ctx)
 	defer stop()
 
+	eventRepo := sqlite.NewEventRepo(dbInstance)
+	accountRepo := sqlite.NewAccountRepo(dbInstance)
+	ledgerRepo := sqlite.NewLedgerRepo(dbInstance)
+	interestRepo := sqlite.NewInterestRepo(dbInstance)
+	statementRepo := sqlite.NewStatementRepo(dbInstance)
+
 	go grpc.StartGRPCServer(ctx, grpc.ServiceRepos{
-		CustomerRepo: sqlite.NewCustomerRepo(dbInstance),
-		AccountRepo:  sqlite.NewAccountRepo(dbInstance),
-		EventRepo:    sqlite.NewEventRepo(dbInstance),
+		CustomerRepo:  sqlite.NewCustomerRepo(dbInstance),
+		AccountRepo:   accountRepo,
+		EventRepo:     eventRepo,
+		LedgerRepo:    ledgerRepo,
+		HoldRepo:      sqlite.NewHoldRepo(dbInstance),
+		InterestRepo:  interestRepo,
+		StatementRepo: statementRepo,
 	})
 
+	// Accruing daily interest and closing monthly postings
+	if config.Current().Interest.Enabled {
+		interestJob := jobs.NewInterestAccrualJob(interestRepo, appinterest.NewAccrueInterest(interestRepo, eventRepo))
+		go interestJob.Start(ctx)
+	}
+
+	// Issuing monthly account statements
+	if config.Current().Statement.Enabled {
+		statementJob := jobs.NewStatementJob(statementRepo, appstatement.NewIssueMonthlyStatements(statementRepo, ledgerRepo, eventRepo))
+		go statementJob.Start(ctx)
+	}
+
+	// Marking accounts dormant after months without customer transactions
+	if config.Current().Dormancy.Enabled {
+		dormancyJob := jobs.NewDormancyJob(appaccount.NewMarkDormantAccounts(accountRepo, eventRepo))
+		go dormancyJob.Start(ctx)
+	}
+
 	// Creating new http server for liveness and readiness checking
 	srv := httpserver.NewServerHTTP(httpserver.ServerConfig{
 		Addr:         config.Current().HTTP.Addr,
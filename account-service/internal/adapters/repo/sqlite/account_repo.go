@@ -3,6 +3,7 @@ package sqlite
 import (
 	"account-service/internal/domain/entity"
 	custom_err "account-service/internal/domain/error"
+	"account-service/internal/domain/money"
 	"account-service/internal/grpc/types"
 	"account-service/internal/ports"
 	"errors"
@@ -26,12 +27,22 @@ func NewAccountRepo(db *gorm.DB) ports.AccountRepo {
 	return &AccountRepo{DB: db}
 }
 
-// CreateAccount creates an account
+// CreateAccount creates an account and journals its initial deposit in the same DB transaction
 func (r *AccountRepo) CreateAccount(account *entity.Account) error {
 	r.mu.Lock()
 	defer r.mu.Unlock()
 
-	return r.DB.Create(account).Error
+	return r.DB.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(account).Error; err != nil {
+			return err
+		}
+
+		return createJournal(tx, entity.JournalTypeAccountOpening, account.ID, []entity.BalanceChange{{
+			AccountID: account.ID,
+			Currency:  account.Currency,
+			Delta:     account.Balance,
+		}}, account.CreatedBy)
+	})
 }
 
 // GetAccountByID gets account by account ID
@@ -76,6 +87,21 @@ func (r *AccountRepo) GetAccountByCustomerID(customerID string) ([]*entity.Accou
 	return accounts, err
 }
 
+// GetDormancyCandidates gets the active accounts without customer transaction since the given time. Accounts
+// that never had one count from their opening.
+func (r *AccountRepo) GetDormancyCandidates(inactiveSince time.Time) ([]*entity.Account, error) {
+	// timestamps are stored in the local time zone and compared as text
+	inactiveSince = inactiveSince.Local()
+
+	var accounts []*entity.Account
+	err := r.DB.
+		Where("status = ? AND active_status = ? AND locked_for_tx = ?", entity.AccountStatusValid, entity.AccountActiveStatusActive, false).
+		Where("COALESCE(last_activity_at, created_at) < ?", inactiveSince).
+		Order("id ASC").
+		Find(&accounts).Error
+	return accounts, err
+}
+
 // UpdateAccount update account
 func (r *AccountRepo) UpdateAccount(account *entity.Account) error {
 	r.mu.Lock()
@@ -85,7 +111,11 @@ func (r *AccountRepo) UpdateAccount(account *entity.Account) error {
 		Where("id = ? AND version = ? AND status = ?", account.ID, account.Version, entity.AccountStatusValid).
 		Updates(map[string]interface{}{
 			"balance":               account.Balance,
+			"overdraft_limit":       account.OverdraftLimit,
 			"active_status":         account.ActiveStatus,
+			"freeze_mode":           account.FreezeMode,
+			"status_reason":         account.StatusReason,
+			"status_changed_at":     account.StatusChangedAt,
 			"updated_at":            account.UpdatedAt,
 			"updated_by":            account.UpdatedBy,
 			"locked_for_tx":         account.LockedForTx,
@@ -137,7 +167,9 @@ func (r *AccountRepo) DeleteAllAccountsByCustomerID(customerID, requester string
 		}).Error
 }
 
-func (r *AccountRepo) GetAccountsByFiltersWithPagination(filters map[string]interface{}, page, pageSize int, setOrder string) ([]*entity.Account, int64, error) {
+// GetAccountsByFiltersWithPagination reads a page of the matching accounts, see pageQuery. The total is only counted
+// when withTotal is set.
+func (r *AccountRepo) GetAccountsByFiltersWithPagination(filters map[string]interface{}, page, pageSize int, after *entity.PageCursor, withTotal bool, setOrder string) ([]*entity.Account, int64, error) {
 	var accounts []*entity.Account
 	var totalCount int64
 
@@ -159,22 +191,13 @@ func (r *AccountRepo) GetAccountsByFiltersWithPagination(filters map[string]inte
 		query = query.Where("locked_for_tx = ?", lockedForTx)
 	}
 
-	if err := query.Count(&totalCount).Error; err != nil {
-		return nil, 0, err
-	}
-
-	offset := (page - 1) * pageSize
-
-	order := "created_at DESC"
-	if setOrder == "asc" {
-		order = "created_at ASC"
+	if withTotal {
+		if err := query.Count(&totalCount).Error; err != nil {
+			return nil, 0, err
+		}
 	}
 
-	err := query.
-		Order(order).
-		Limit(pageSize).
-		Offset(offset).
-		Find(&accounts).Error
+	err := pageQuery(query, page, pageSize, after, setOrder).Find(&accounts).Error
 
 	if err != nil {
 		return nil, 0, err
@@ -184,7 +207,7 @@ func (r *AccountRepo) GetAccountsByFiltersWithPagination(filters map[string]inte
 }
 
 // UpdateAccountBalance update account balance by account ID
-func (r *AccountRepo) UpdateAccountBalance(id string, newBalance float64, currentVersion int, requester string) error {
+func (r *AccountRepo) UpdateAccountBalance(id string, newBalance money.Amount, currentVersion int, requester string) error {
 	r.mu.Lock()
 	defer r.mu.Unlock()
 
@@ -303,12 +326,13 @@ func (r *AccountRepo) GetCustomerAccountsInTransaction(customerID string) ([]*en
 	return accounts, err
 }
 
-// GetCustomerAccountsInTransactionOrHasBalance get all the customer accounts either in transaction or has balance
+// GetCustomerAccountsInTransactionOrHasBalance get all the customer accounts either in transaction or has balance,
+// including overdrawn accounts
 func (r *AccountRepo) GetCustomerAccountsInTransactionOrHasBalance(customerID string) ([]*entity.Account, error) {
 	var accounts []*entity.Account
 	err := r.DB.
 		Where("customer_id = ? AND status = ?", customerID, entity.AccountStatusValid).
-		Where("locked_for_tx = ? OR balance > ?", true, 0).
+		Where("locked_for_tx = ? OR balance <> ?", true, 0).
 		Find(&accounts).Error
 	return accounts, err
 }
@@ -402,7 +426,9 @@ func (r *AccountRepo) UnlockAccountsForTransaction(transactionID string) error {
 	return tx.Commit().Error
 }
 
-func (r *AccountRepo) UpdateAccountBalanceLifecycle(balanceUpdates []types.AccountBalance, requester string) ([]types.AccountBalanceResponse, error) {
+// UpdateAccountBalanceLifecycle sets the new balances and writes the journal of the changes in one DB transaction.
+// The journal is referenced by the transaction ID and typed as either a transaction or its compensation.
+func (r *AccountRepo) UpdateAccountBalanceLifecycle(balanceUpdates []types.AccountBalance, journalType, transactionID, requester string) ([]types.AccountBalanceResponse, error) {
 	tx := r.DB.Begin()
 	defer func() {
 		if r := recover(); r != nil {
@@ -416,6 +442,7 @@ func (r *AccountRepo) UpdateAccountBalanceLifecycle(balanceUpdates []types.Accou
 
 	var lastErr error
 	var accountBalanceResponseList []types.AccountBalanceResponse
+	var changes []entity.BalanceChange
 	for _, update := range balanceUpdates {
 		var account entity.Account
 		err := tx.Set("gorm:query_option", "FOR UPDATE").
@@ -423,35 +450,69 @@ func (r *AccountRepo) UpdateAccountBalanceLifecycle(balanceUpdates []types.Accou
 			First(&account).Error
 
 		if err != nil {
+			tx.Rollback()
 			return nil, err
 		}
 
 		// Check optimistic lock
 		if account.Version != update.Version {
+			tx.Rollback()
 			return nil, errors.New("version does not match")
 		}
 
+		now := time.Now()
+		values := map[string]interface{}{
+			"balance":    update.Balance,
+			"version":    update.Version + 1,
+			"updated_by": requester,
+			"updated_at": now,
+		}
+		// interest credits and compensations are not customer activity and do not keep an account from dormancy
+		if journalType == entity.JournalTypeTransaction && update.InterestPostingID == "" {
+			values["last_activity_at"] = now
+		}
+
 		err = tx.Model(&entity.Account{}).
 			Where("id = ? AND version = ? AND status = ?", update.AccountID, update.Version, entity.AccountStatusValid).
-			Updates(map[string]interface{}{
-				"balance":    update.Balance,
-				"version":    update.Version + 1,
-				"updated_by": requester,
-				"updated_at": time.Now(),
-			}).Error
+			Updates(values).Error
 
 		if err != nil {
 			lastErr = err
 			break
 		}
 
+		if update.HoldID != "" {
+			if err = settleHold(tx, update, journalType, transactionID, requester); err != nil {
+				lastErr = err
+				break
+			}
+		}
+
+		if update.InterestPostingID != "" {
+			if err = settleInterestPosting(tx, update, update.Balance.Sub(account.Balance), journalType, transactionID); err != nil {
+				lastErr = err
+				break
+			}
+		}
+
+		changes = append(changes, entity.BalanceChange{
+			AccountID: account.ID,
+			Currency:  account.Currency,
+			Delta:     update.Balance.Sub(account.Balance),
+		})
+
 		accountBalanceResponseList = append(accountBalanceResponseList, types.AccountBalanceResponse{
 			AccountID: account.ID,
 			Version:   update.Version + 1,
 		})
 	}
 
+	if lastErr == nil {
+		lastErr = createJournal(tx, journalType, transactionID, changes, requester)
+	}
+
 	if lastErr != nil {
+		tx.Rollback()
 		return nil, lastErr
 	}
 
@@ -462,3 +523,75 @@ func (r *AccountRepo) UpdateAccountBalanceLifecycle(balanceUpdates []types.Accou
 	}
 	return accountBalanceResponseList, nil
 }
+
+// settleHold captures the hold of a balance update, or makes it active again when the update is a compensation
+func settleHold(tx *gorm.DB, update types.AccountBalance, journalType, transactionID, requester string) error {
+	var hold entity.Hold
+	err := tx.Where("id = ? AND account_id = ?", update.HoldID, update.AccountID).First(&hold).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return custom_err.ErrHoldNotFound
+	}
+	if err != nil {
+		return err
+	}
+
+	if journalType == entity.JournalTypeCompensation {
+		hold.UndoCapture(transactionID, requester)
+	} else if err = hold.Capture(update.HoldCaptureAmount, transactionID, requester); err != nil {
+		return err
+	}
+
+	return tx.Model(&entity.Hold{}).
+		Where("id = ?", hold.ID).
+		Updates(map[string]interface{}{
+			"status":                 hold.Status,
+			"captured_amount":        hold.CapturedAmount,
+			"capture_transaction_id": hold.CaptureTransactionID,
+			"updated_by":             hold.UpdatedBy,
+			"updated_at":             hold.UpdatedAt,
+		}).Error
+}
+
+// settleInterestPosting marks the interest posting of a balance update as posted, or pending again when the
+// update is a compensation. A posting is credited once and only with its own amount.
+func settleInterestPosting(tx *gorm.DB, update types.AccountBalance, delta money.Amount, journalType, transactionID string) error {
+	var posting entity.InterestPosting
+	err := tx.Where("id = ? AND account_id = ?", update.InterestPostingID, update.AccountID).First(&posting).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return custom_err.ErrInterestPostingNotFound
+	}
+	if err != nil {
+		return err
+	}
+
+	if journalType == entity.JournalTypeCompensation {
+		posting.UndoPost(transactionID)
+	} else {
+		if delta != posting.Amount {
+			return custom_err.ErrInterestAmountMismatch
+		}
+		if err = posting.Post(transactionID); err != nil {
+			return err
+		}
+	}
+
+	return tx.Model(&entity.InterestPosting{}).
+		Where("id = ?", posting.ID).
+		Updates(map[string]interface{}{
+			"status":         posting.Status,
+			"transaction_id": posting.TransactionID,
+			"updated_at":     posting.UpdatedAt,
+		}).Error
+}
+
+// createJournal writes the balanced entries for the balance changes using the given DB transaction
+func createJournal(tx *gorm.DB, journalType, reference string, changes []entity.BalanceChange, requester string) error {
+	entries, err := entity.NewJournal(journalType, reference, changes, requester)
+	if err != nil {
+		return err
+	}
+	if len(entries) == 0 {
+		return nil
+	}
+	return tx.Create(&entries).Error
+}
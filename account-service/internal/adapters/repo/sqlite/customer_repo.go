@@ -7,7 +7,10 @@ import (
 	"errors"
 	_ "github.com/jinzhu/gorm/dialects/sqlite"
 	"gorm.io/gorm"
+	"strings"
 	"sync"
+	"time"
+	"unicode"
 )
 
 // CustomerRepo struct to interact with the database.
@@ -58,32 +61,114 @@ func (r *CustomerRepo) GetCustomerByName(name string) (*entity.Customer, error)
 	return &customer, nil
 }
 
-func (r *CustomerRepo) ListCustomer(page, pageSize int, setOrder string) ([]*entity.Customer, int64, error) {
+// GetCustomerByNationalID finds the valid customer holding the national ID, nil if there is none
+func (r *CustomerRepo) GetCustomerByNationalID(nationalID string) (*entity.Customer, error) {
+	var customer entity.Customer
+	err := r.DB.Where("national_id = ? AND status = ?", nationalID, entity.CustomerStatusValid).First(&customer).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &customer, nil
+}
+
+// UpdateCustomer saves the profile of the customer if it was not modified since it was read
+func (r *CustomerRepo) UpdateCustomer(customer *entity.Customer) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	// an empty model keeps GORM from writing the new version back, the caller increments it
+	result := r.DB.Model(&entity.Customer{}).
+		Where("id = ? AND version = ? AND status = ?", customer.ID, customer.Version, entity.CustomerStatusValid).
+		Updates(map[string]interface{}{
+			"name":            customer.Name,
+			"date_of_birth":   customer.DateOfBirth,
+			"national_id":     customer.NationalID,
+			"address":         customer.Address,
+			"phone":           customer.Phone,
+			"email":           customer.Email,
+			"kyc_status":      customer.KYCStatus,
+			"kyc_verified_at": customer.KYCVerifiedAt,
+			"updated_at":      customer.UpdatedAt,
+			"updated_by":      customer.UpdatedBy,
+			"version":         customer.Version + 1,
+		})
+
+	if result.Error != nil {
+		return result.Error
+	}
+
+	if result.RowsAffected == 0 {
+		return custom_err.ErrConcurrentModification
+	}
+
+	return nil
+}
+
+// ListCustomer reads a page of the valid customers matching the filters, see pageQuery. The name filter is matched
+// against the customers_fts index. The total is only counted when withTotal is set.
+func (r *CustomerRepo) ListCustomer(filters map[string]interface{}, page, pageSize int, after *entity.PageCursor, withTotal bool, setOrder string) ([]*entity.Customer, int64, error) {
 	var customers []*entity.Customer
 	var total int64
 
-	offset := (page - 1) * pageSize
+	query := r.DB.Model(&entity.Customer{}).Where("status = ?", entity.CustomerStatusValid)
+
+	if name, ok := filters["name"].(string); ok {
+		query = query.Where("id IN (SELECT customer_id FROM customers_fts WHERE name MATCH ?)", customerNameMatch(name))
+	}
+
+	if createdBy, ok := filters["created_by"]; ok {
+		query = query.Where("created_by = ?", createdBy)
+	}
+
+	// rows are stored in local time and compared as text
+	if startDate, ok := filters["start_date"].(time.Time); ok {
+		query = query.Where("created_at >= ?", startDate.Local())
+	}
+
+	if endDate, ok := filters["end_date"].(time.Time); ok {
+		query = query.Where("created_at <= ?", endDate.Local())
+	}
+
+	if activeStatus, ok := filters["active_status"]; ok {
+		query = query.Where("active_status = ?", activeStatus)
+	}
+
+	if minBalance, ok := filters["min_balance"]; ok {
+		query = query.Where("id IN (?)", r.DB.Model(&entity.Account{}).Select("customer_id").
+			Where("status = ? AND balance > ?", entity.AccountStatusValid, minBalance))
+	}
 
-	if err := r.DB.Model(&entity.Customer{}).
-		Where("status = ?", entity.CustomerStatusValid).
-		Count(&total).Error; err != nil {
-		return nil, 0, err
+	if accountID, ok := filters["account_id"]; ok {
+		query = query.Where("id IN (?)", r.DB.Model(&entity.Account{}).Select("customer_id").
+			Where("id = ? AND status = ?", accountID, entity.AccountStatusValid))
 	}
 
-	order := "created_at DESC"
-	if setOrder == "asc" {
-		order = "created_at ASC"
+	if withTotal {
+		if err := query.Count(&total).Error; err != nil {
+			return nil, 0, err
+		}
 	}
 
-	err := r.DB.Where("status = ?", entity.CustomerStatusValid).
-		Offset(offset).
-		Limit(pageSize).
-		Order(order).
-		Find(&customers).Error
+	err := pageQuery(query, page, pageSize, after, setOrder).Find(&customers).Error
 
 	return customers, total, err
 }
 
+// customerNameMatch turns a name search into a full-text query matching customers with a word starting with each
+// word of the search. Words are lower-cased so they are never read as the AND, OR and NOT operators.
+func customerNameMatch(name string) string {
+	words := strings.FieldsFunc(strings.ToLower(name), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+	for i, word := range words {
+		words[i] = word + "*"
+	}
+	return strings.Join(words, " ")
+}
+
 func (r *CustomerRepo) DeleteCustomerByID(id, requester string) error {
 	r.mu.Lock()
 	defer r.mu.Unlock()
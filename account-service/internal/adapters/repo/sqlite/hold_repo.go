@@ -0,0 +1,147 @@
+package sqlite
+
+import (
+	"account-service/internal/domain/entity"
+	custom_err "account-service/internal/domain/error"
+	"account-service/internal/domain/money"
+	"account-service/internal/ports"
+	"errors"
+	"gorm.io/gorm"
+	"sync"
+	"time"
+)
+
+// HoldRepo struct to interact with the holds in the database.
+// Holds are captured by AccountRepo inside the same DB transaction as the balance change.
+type HoldRepo struct {
+	DB *gorm.DB
+	mu sync.RWMutex
+}
+
+// NewHoldRepo creates a new HoldRepo instance with an SQLite connection.
+func NewHoldRepo(db *gorm.DB) ports.HoldRepo {
+	return &HoldRepo{DB: db}
+}
+
+// CreateHold stores the hold if the available balance of the account covers it. Holds cannot be placed
+// while the account is locked for a transaction, whose balance check did not see the hold.
+func (r *HoldRepo) CreateHold(hold *entity.Hold) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return r.DB.Transaction(func(tx *gorm.DB) error {
+		var account entity.Account
+		err := tx.Where("id = ? AND status = ?", hold.AccountID, entity.AccountStatusValid).First(&account).Error
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return custom_err.ErrAccountNotFound
+		}
+		if err != nil {
+			return err
+		}
+
+		if account.LockedForTx {
+			return custom_err.ErrAccountLocked
+		}
+
+		account.HeldAmount, err = heldAmount(tx, account.ID)
+		if err != nil {
+			return err
+		}
+		if account.AvailableBalance() < hold.Amount {
+			return custom_err.ErrInsufficientBalance
+		}
+
+		return tx.Create(hold).Error
+	})
+}
+
+// GetHoldByID gets hold by hold ID
+func (r *HoldRepo) GetHoldByID(id string) (*entity.Hold, error) {
+	var hold entity.Hold
+	err := r.DB.Where("id = ?", id).First(&hold).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &hold, nil
+}
+
+// GetHoldsByAccountID gets the holds of an account, newest first
+func (r *HoldRepo) GetHoldsByAccountID(accountID string, page, pageSize int) ([]*entity.Hold, int64, error) {
+	var holds []*entity.Hold
+	var totalCount int64
+
+	query := r.DB.Model(&entity.Hold{}).Where("account_id = ?", accountID)
+	if err := query.Count(&totalCount).Error; err != nil {
+		return nil, 0, err
+	}
+
+	offset := (page - 1) * pageSize
+	err := query.
+		Order("created_at DESC, id DESC").
+		Limit(pageSize).
+		Offset(offset).
+		Find(&holds).Error
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return holds, totalCount, nil
+}
+
+// UpdateHoldStatus stores the new status of a hold that is still in the previous status
+func (r *HoldRepo) UpdateHoldStatus(hold *entity.Hold, previousStatus string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	result := r.DB.Model(&entity.Hold{}).
+		Where("id = ? AND status = ?", hold.ID, previousStatus).
+		Updates(map[string]interface{}{
+			"status":     hold.Status,
+			"updated_by": hold.UpdatedBy,
+			"updated_at": hold.UpdatedAt,
+		})
+	if result.Error != nil {
+		return result.Error
+	}
+
+	if result.RowsAffected == 0 {
+		return custom_err.ErrConcurrentModification
+	}
+	return nil
+}
+
+// GetHeldAmounts sums the active holds of the accounts; accounts without holds are left out
+func (r *HoldRepo) GetHeldAmounts(accountIDs []string) (map[string]money.Amount, error) {
+	var rows []struct {
+		AccountID string
+		Total     money.Amount
+	}
+
+	err := r.DB.Model(&entity.Hold{}).
+		Select("account_id, SUM(amount) AS total").
+		Where("account_id IN ? AND status = ? AND expires_at > ?", accountIDs, entity.HoldStatusActive, time.Now().UTC()).
+		Group("account_id").
+		Scan(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+
+	amounts := make(map[string]money.Amount, len(rows))
+	for _, row := range rows {
+		amounts[row.AccountID] = row.Total
+	}
+	return amounts, nil
+}
+
+// heldAmount sums the active holds of the account using the given DB transaction
+func heldAmount(tx *gorm.DB, accountID string) (money.Amount, error) {
+	var total money.Amount
+	err := tx.Model(&entity.Hold{}).
+		Select("COALESCE(SUM(amount), 0)").
+		Where("account_id = ? AND status = ? AND expires_at > ?", accountID, entity.HoldStatusActive, time.Now().UTC()).
+		Scan(&total).Error
+	return total, err
+}
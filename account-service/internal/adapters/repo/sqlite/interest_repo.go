@@ -0,0 +1,284 @@
+package sqlite
+
+import (
+	"account-service/internal/domain/entity"
+	"account-service/internal/domain/money"
+	"account-service/internal/ports"
+	"errors"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"sync"
+	"time"
+)
+
+// InterestRepo struct to interact with the interest rates, accruals and postings in the database.
+// Postings are marked as posted by AccountRepo inside the same DB transaction as the interest credit.
+type InterestRepo struct {
+	DB *gorm.DB
+	mu sync.RWMutex
+}
+
+// NewInterestRepo creates a new InterestRepo instance with an SQLite connection.
+func NewInterestRepo(db *gorm.DB) ports.InterestRepo {
+	return &InterestRepo{DB: db}
+}
+
+// UpsertInterestRate creates the rate for a scope and subject or replaces the existing one
+func (r *InterestRepo) UpsertInterestRate(rate *entity.InterestRate) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return r.DB.Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "scope"}, {Name: "subject"}},
+		DoUpdates: clause.AssignmentColumns([]string{"annual_rate", "updated_by", "updated_at"}),
+	}).Create(rate).Error
+}
+
+// ListInterestRates gets every configured rate
+func (r *InterestRepo) ListInterestRates() ([]*entity.InterestRate, error) {
+	var rates []*entity.InterestRate
+	err := r.DB.Order("scope ASC, subject ASC").Find(&rates).Error
+	return rates, err
+}
+
+// GetLastAccrualRun gets the run of the latest accrued day, nil if interest never accrued
+func (r *InterestRepo) GetLastAccrualRun() (*entity.InterestAccrualRun, error) {
+	var run entity.InterestAccrualRun
+	err := r.DB.Order("accrual_date DESC").First(&run).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &run, nil
+}
+
+// GetAccountsWithBalanceAt gets the accounts opened before the given time and not closed, with their balance set
+// to the ledger balance at that time, so a day can be accrued again later with the balance it closed with
+func (r *InterestRepo) GetAccountsWithBalanceAt(at time.Time) ([]*entity.Account, error) {
+	// timestamps are stored in the local time zone and compared as text
+	at = at.Local()
+
+	var accounts []*entity.Account
+	err := r.DB.
+		Where("status = ? AND active_status <> ? AND created_at < ?", entity.AccountStatusValid, entity.AccountActiveStatusClosed, at).
+		Order("id ASC").
+		Find(&accounts).Error
+	if err != nil {
+		return nil, err
+	}
+
+	var rows []struct {
+		AccountID string
+		Balance   int64
+	}
+	err = r.DB.Model(&entity.LedgerEntry{}).
+		Select("account_id, SUM(CASE WHEN direction = ? THEN amount ELSE -amount END) AS balance", entity.LedgerDirectionCredit).
+		Where("created_at < ?", at).
+		Group("account_id").
+		Scan(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+
+	balances := make(map[string]int64, len(rows))
+	for _, row := range rows {
+		balances[row.AccountID] = row.Balance
+	}
+	for _, account := range accounts {
+		account.Balance = money.FromMinor(balances[account.ID])
+	}
+	return accounts, nil
+}
+
+// RecordAccrualRun stores the accruals of a day together with its run. Accruals already stored for an
+// account and day are kept, so a day interrupted by a restart can be accrued again.
+func (r *InterestRepo) RecordAccrualRun(run *entity.InterestAccrualRun, accruals []*entity.InterestAccrual) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return r.DB.Transaction(func(tx *gorm.DB) error {
+		if len(accruals) > 0 {
+			err := tx.Clauses(clause.OnConflict{
+				Columns:   []clause.Column{{Name: "account_id"}, {Name: "accrual_date"}},
+				DoNothing: true,
+			}).CreateInBatches(accruals, 100).Error
+			if err != nil {
+				return err
+			}
+		}
+
+		return tx.Clauses(clause.OnConflict{DoNothing: true}).Create(run).Error
+	})
+}
+
+// CloseInterestPeriod creates a pending posting per account for the accruals not yet posted up to the last
+// day of the period. Accruals rounding to less than a minor unit stay unposted and carry into the next period.
+func (r *InterestRepo) CloseInterestPeriod(period, lastAccrualDate string) ([]*entity.InterestPosting, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var postings []*entity.InterestPosting
+	err := r.DB.Transaction(func(tx *gorm.DB) error {
+		var rows []struct {
+			AccountID string
+			Currency  string
+			Total     int64
+		}
+		err := tx.Model(&entity.InterestAccrual{}).
+			Select("account_id, currency, SUM(amount) AS total").
+			Where("posting_id IS NULL AND accrual_date <= ?", lastAccrualDate).
+			Group("account_id, currency").
+			Order("account_id ASC").
+			Scan(&rows).Error
+		if err != nil {
+			return err
+		}
+
+		for _, row := range rows {
+			posting := entity.NewInterestPosting(row.AccountID, row.Currency, period, row.Total)
+			if posting == nil {
+				continue
+			}
+
+			result := tx.Clauses(clause.OnConflict{DoNothing: true}).Create(posting)
+			if result.Error != nil {
+				return result.Error
+			}
+			if result.RowsAffected == 0 {
+				// the period was already closed for the account
+				continue
+			}
+
+			err = tx.Model(&entity.InterestAccrual{}).
+				Where("account_id = ? AND posting_id IS NULL AND accrual_date <= ?", row.AccountID, lastAccrualDate).
+				Update("posting_id", posting.ID).Error
+			if err != nil {
+				return err
+			}
+			postings = append(postings, posting)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return postings, nil
+}
+
+// GetPendingInterestPostings gets the postings waiting to be credited, oldest first. Postings of closed accounts
+// are left out, since a closed account takes no further credit; an account is only closed once its interest is
+// credited.
+func (r *InterestRepo) GetPendingInterestPostings(limit int) ([]*entity.InterestPosting, error) {
+	closedAccounts := r.DB.Model(&entity.Account{}).Select("id").Where("active_status = ?", entity.AccountActiveStatusClosed)
+
+	var postings []*entity.InterestPosting
+	err := r.DB.
+		Where("status = ? AND account_id NOT IN (?)", entity.InterestPostingStatusPending, closedAccounts).
+		Order("period ASC, created_at ASC, id ASC").
+		Limit(limit).
+		Find(&postings).Error
+	return postings, err
+}
+
+// SettleAccountInterest creates a pending posting for the accruals of the account not yet posted, named by the last
+// accrued day instead of the month, and gets the pending postings of the account, oldest first. Accruals rounding
+// to less than a minor unit stay unposted.
+func (r *InterestRepo) SettleAccountInterest(accountID string) ([]*entity.InterestPosting, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var postings []*entity.InterestPosting
+	err := r.DB.Transaction(func(tx *gorm.DB) error {
+		var rows []struct {
+			Currency        string
+			Total           int64
+			LastAccrualDate string
+		}
+		err := tx.Model(&entity.InterestAccrual{}).
+			Select("currency, SUM(amount) AS total, MAX(accrual_date) AS last_accrual_date").
+			Where("account_id = ? AND posting_id IS NULL", accountID).
+			Group("currency").
+			Scan(&rows).Error
+		if err != nil {
+			return err
+		}
+
+		for _, row := range rows {
+			posting := entity.NewInterestPosting(accountID, row.Currency, row.LastAccrualDate, row.Total)
+			if posting == nil {
+				continue
+			}
+
+			result := tx.Clauses(clause.OnConflict{DoNothing: true}).Create(posting)
+			if result.Error != nil {
+				return result.Error
+			}
+			if result.RowsAffected == 0 {
+				// the interest up to the day was already settled
+				continue
+			}
+
+			err = tx.Model(&entity.InterestAccrual{}).
+				Where("account_id = ? AND currency = ? AND posting_id IS NULL AND accrual_date <= ?", accountID, row.Currency, row.LastAccrualDate).
+				Update("posting_id", posting.ID).Error
+			if err != nil {
+				return err
+			}
+		}
+
+		return pendingAccountInterestPostings(tx, accountID, &postings)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return postings, nil
+}
+
+// GetPendingAccountInterestPostings gets the postings of an account waiting to be credited, oldest first
+func (r *InterestRepo) GetPendingAccountInterestPostings(accountID string) ([]*entity.InterestPosting, error) {
+	var postings []*entity.InterestPosting
+	err := pendingAccountInterestPostings(r.DB, accountID, &postings)
+	return postings, err
+}
+
+func pendingAccountInterestPostings(db *gorm.DB, accountID string, postings *[]*entity.InterestPosting) error {
+	return db.
+		Where("account_id = ? AND status = ?", accountID, entity.InterestPostingStatusPending).
+		Order("period ASC, created_at ASC, id ASC").
+		Find(postings).Error
+}
+
+// GetInterestPostingsByAccountID gets the postings of an account, newest first
+func (r *InterestRepo) GetInterestPostingsByAccountID(accountID string, page, pageSize int) ([]*entity.InterestPosting, int64, error) {
+	var postings []*entity.InterestPosting
+	var totalCount int64
+
+	query := r.DB.Model(&entity.InterestPosting{}).Where("account_id = ?", accountID)
+	if err := query.Count(&totalCount).Error; err != nil {
+		return nil, 0, err
+	}
+
+	offset := (page - 1) * pageSize
+	err := query.
+		Order("period DESC, id DESC").
+		Limit(pageSize).
+		Offset(offset).
+		Find(&postings).Error
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return postings, totalCount, nil
+}
+
+// GetUnpostedAccrual sums the accruals of an account not yet in a posting, in millionths of a minor unit
+func (r *InterestRepo) GetUnpostedAccrual(accountID string) (int64, error) {
+	var total int64
+	err := r.DB.Model(&entity.InterestAccrual{}).
+		Select("COALESCE(SUM(amount), 0)").
+		Where("account_id = ? AND posting_id IS NULL", accountID).
+		Scan(&total).Error
+	return total, err
+}
@@ -0,0 +1,101 @@
+package sqlite
+
+import (
+	"account-service/internal/domain/entity"
+	"account-service/internal/domain/money"
+	"account-service/internal/ports"
+	"gorm.io/gorm"
+	"time"
+)
+
+// LedgerRepo struct to read the journal entries from the database.
+// Entries are written by AccountRepo inside the same DB transaction as the balance change.
+type LedgerRepo struct {
+	DB *gorm.DB
+}
+
+// NewLedgerRepo creates a new LedgerRepo instance with an SQLite connection.
+func NewLedgerRepo(db *gorm.DB) ports.LedgerRepo {
+	return &LedgerRepo{DB: db}
+}
+
+// GetEntriesByAccountID gets the journal entries of an account, newest first
+func (r *LedgerRepo) GetEntriesByAccountID(accountID string, page, pageSize int) ([]*entity.LedgerEntry, int64, error) {
+	var entries []*entity.LedgerEntry
+	var totalCount int64
+
+	query := r.DB.Model(&entity.LedgerEntry{}).Where("account_id = ?", accountID)
+	if err := query.Count(&totalCount).Error; err != nil {
+		return nil, 0, err
+	}
+
+	offset := (page - 1) * pageSize
+	err := query.
+		Order("created_at DESC, id DESC").
+		Limit(pageSize).
+		Offset(offset).
+		Find(&entries).Error
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return entries, totalCount, nil
+}
+
+// GetAccountLedgerBalance recomputes the balance of an account as credits minus debits of its entries
+func (r *LedgerRepo) GetAccountLedgerBalance(accountID string) (money.Amount, error) {
+	var balance int64
+	err := r.DB.Model(&entity.LedgerEntry{}).
+		Select("COALESCE(SUM(CASE WHEN direction = ? THEN amount ELSE -amount END), 0)", entity.LedgerDirectionCredit).
+		Where("account_id = ?", accountID).
+		Scan(&balance).Error
+	if err != nil {
+		return 0, err
+	}
+	return money.FromMinor(balance), nil
+}
+
+// GetEntriesByReference gets every journal entry posted for a reference, oldest first
+func (r *LedgerRepo) GetEntriesByReference(reference string) ([]*entity.LedgerEntry, error) {
+	var entries []*entity.LedgerEntry
+	err := r.DB.
+		Where("reference = ?", reference).
+		Order("created_at ASC, id ASC").
+		Find(&entries).Error
+	if err != nil {
+		return nil, err
+	}
+	return entries, nil
+}
+
+// GetAccountLedgerBalanceAt sums the entries of an account posted before the given time
+func (r *LedgerRepo) GetAccountLedgerBalanceAt(accountID string, at time.Time) (money.Amount, error) {
+	// timestamps are stored in the local time zone and compared as text
+	at = at.Local()
+
+	var balance int64
+	err := r.DB.Model(&entity.LedgerEntry{}).
+		Select("COALESCE(SUM(CASE WHEN direction = ? THEN amount ELSE -amount END), 0)", entity.LedgerDirectionCredit).
+		Where("account_id = ? AND created_at < ?", accountID, at).
+		Scan(&balance).Error
+	if err != nil {
+		return 0, err
+	}
+	return money.FromMinor(balance), nil
+}
+
+// GetEntriesByAccountIDBetween gets the entries of an account posted from the first time up to but not
+// including the second, oldest first
+func (r *LedgerRepo) GetEntriesByAccountIDBetween(accountID string, from, to time.Time) ([]*entity.LedgerEntry, error) {
+	from, to = from.Local(), to.Local()
+
+	var entries []*entity.LedgerEntry
+	err := r.DB.
+		Where("account_id = ? AND created_at >= ? AND created_at < ?", accountID, from, to).
+		Order("created_at ASC, id ASC").
+		Find(&entries).Error
+	if err != nil {
+		return nil, err
+	}
+	return entries, nil
+}
@@ -0,0 +1,24 @@
+package sqlite
+
+import (
+	"account-service/internal/domain/entity"
+	"gorm.io/gorm"
+)
+
+// pageQuery orders a listing by creation time and ID and limits it to one page. With a cursor the page starts after
+// it, otherwise page is used as an offset. One row more than pageSize is read so the caller can tell whether another
+// page follows.
+func pageQuery(query *gorm.DB, page, pageSize int, after *entity.PageCursor, setOrder string) *gorm.DB {
+	order, afterCond := "created_at DESC, id DESC", "created_at < ? OR (created_at = ? AND id < ?)"
+	if setOrder == "asc" {
+		order, afterCond = "created_at ASC, id ASC", "created_at > ? OR (created_at = ? AND id > ?)"
+	}
+
+	if after != nil {
+		// rows are stored in local time and compared as text
+		query = query.Where(afterCond, after.CreatedAt.Local(), after.CreatedAt.Local(), after.ID)
+	} else {
+		query = query.Offset((page - 1) * pageSize)
+	}
+	return query.Order(order).Limit(pageSize + 1)
+}
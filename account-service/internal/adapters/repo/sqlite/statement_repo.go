@@ -0,0 +1,119 @@
+package sqlite
+
+import (
+	"account-service/internal/domain/entity"
+	"account-service/internal/ports"
+	"errors"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"sync"
+	"time"
+)
+
+// StatementRepo struct to interact with the issued account statements in the database.
+type StatementRepo struct {
+	DB *gorm.DB
+	mu sync.RWMutex
+}
+
+// NewStatementRepo creates a new StatementRepo instance with an SQLite connection.
+func NewStatementRepo(db *gorm.DB) ports.StatementRepo {
+	return &StatementRepo{DB: db}
+}
+
+// GetStatementAccounts gets the valid accounts opened before the given time
+func (r *StatementRepo) GetStatementAccounts(openedBefore time.Time) ([]*entity.Account, error) {
+	// timestamps are stored in the local time zone and compared as text
+	openedBefore = openedBefore.Local()
+
+	var accounts []*entity.Account
+	err := r.DB.
+		Where("status = ? AND created_at < ?", entity.AccountStatusValid, openedBefore).
+		Order("id ASC").
+		Find(&accounts).Error
+	if err != nil {
+		return nil, err
+	}
+	return accounts, nil
+}
+
+// GetLastStatementRun gets the run of the latest issued period, nil if no statements were issued
+func (r *StatementRepo) GetLastStatementRun() (*entity.StatementRun, error) {
+	var run entity.StatementRun
+	err := r.DB.Order("period DESC").First(&run).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &run, nil
+}
+
+// RecordStatementRun stores the statements of a period with their lines together with its run. A statement
+// already stored for an account and period is kept, so a period interrupted by a restart can be issued again.
+func (r *StatementRepo) RecordStatementRun(run *entity.StatementRun, statements []*entity.Statement) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return r.DB.Transaction(func(tx *gorm.DB) error {
+		for _, statement := range statements {
+			result := tx.Clauses(clause.OnConflict{
+				Columns:   []clause.Column{{Name: "account_id"}, {Name: "period_start"}, {Name: "period_end"}},
+				DoNothing: true,
+			}).Omit("Lines").Create(statement)
+			if result.Error != nil {
+				return result.Error
+			}
+			if result.RowsAffected == 0 || len(statement.Lines) == 0 {
+				continue
+			}
+			if err := tx.CreateInBatches(statement.Lines, 100).Error; err != nil {
+				return err
+			}
+		}
+
+		return tx.Clauses(clause.OnConflict{DoNothing: true}).Create(run).Error
+	})
+}
+
+// GetStatementByID gets a statement with its lines in order, nil if it does not exist
+func (r *StatementRepo) GetStatementByID(id string) (*entity.Statement, error) {
+	var statement entity.Statement
+	err := r.DB.
+		Preload("Lines", func(db *gorm.DB) *gorm.DB {
+			return db.Order("position ASC")
+		}).
+		Where("id = ?", id).
+		First(&statement).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &statement, nil
+}
+
+// GetStatementsByAccountID gets the statements of an account without their lines, newest period first
+func (r *StatementRepo) GetStatementsByAccountID(accountID string, page, pageSize int) ([]*entity.Statement, int64, error) {
+	var statements []*entity.Statement
+	var totalCount int64
+
+	query := r.DB.Model(&entity.Statement{}).Where("account_id = ?", accountID)
+	if err := query.Count(&totalCount).Error; err != nil {
+		return nil, 0, err
+	}
+
+	offset := (page - 1) * pageSize
+	err := query.
+		Order("period_start DESC, id DESC").
+		Limit(pageSize).
+		Offset(offset).
+		Find(&statements).Error
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return statements, totalCount, nil
+}
@@ -0,0 +1,148 @@
+package account
+
+import (
+	"account-service/internal/domain/entity"
+	custom_err "account-service/internal/domain/error"
+	"account-service/internal/domain/money"
+	"account-service/internal/logging"
+	"account-service/internal/messaging"
+	"account-service/internal/observability/metrics"
+	"account-service/internal/ports"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// CloseAccount is a use-case for closing an account after its balance was paid out
+type CloseAccount struct {
+	AccountRepo  ports.AccountRepo
+	HoldRepo     ports.HoldRepo
+	InterestRepo ports.InterestRepo
+	EventRepo    ports.EventRepo
+}
+
+// NewCloseAccount creates a new CloseAccount use-case
+func NewCloseAccount(accountRepo ports.AccountRepo, holdRepo ports.HoldRepo, interestRepo ports.InterestRepo, eventRepo ports.EventRepo) *CloseAccount {
+	return &CloseAccount{
+		AccountRepo:  accountRepo,
+		HoldRepo:     holdRepo,
+		InterestRepo: interestRepo,
+		EventRepo:    eventRepo,
+	}
+}
+
+// Execute closes an active account with a zero balance, no active holds and no interest owed. The transaction
+// service credits the settled interest and pays the balance out to the nominated payout account first; the payout
+// account and transaction are recorded with the closure. A closed account takes part in no further transaction.
+func (a *CloseAccount) Execute(accountID, payoutAccountID, payoutTransactionID, reason, requester, requestId string) (*entity.Account, string, error) {
+	metrics.IncRequestActive()
+	defer metrics.DecRequestActive()
+
+	var err error
+	defer func() {
+		metrics.RecordOperation("close_account", err)
+	}()
+
+	accountID = strings.TrimSpace(accountID)
+	if accountID == "" {
+		err = fmt.Errorf("%w: account ID is required", custom_err.ErrValidationFailed)
+		logging.Logger.Error().Err(err).Msg("Required missing fields")
+		err = custom_err.ErrValidationFailed
+		return nil, fmt.Sprintf("%s: account ID is required", custom_err.ErrValidationFailed), err
+	}
+
+	if requester == "" {
+		err = fmt.Errorf("%w: requester not found", custom_err.ErrUnauthorizedRequest)
+		logging.Logger.Error().Err(err).Msg("Unknown requester")
+		err = custom_err.ErrUnauthorizedRequest
+		return nil, fmt.Sprintf("%s: requester not found", custom_err.ErrUnauthorizedRequest), err
+	}
+
+	account, err := a.AccountRepo.GetAccountByID(accountID)
+	if err != nil {
+		logging.Logger.Error().Err(err).Str("account_id", accountID).Msg("Failed to verify account")
+		err = custom_err.ErrDatabase
+		return nil, "Failed to verify account", err
+	}
+
+	if account == nil {
+		err = custom_err.ErrAccountNotFound
+		logging.Logger.Error().Err(err).Str("account_id", accountID).Msg("Account not found")
+		return nil, "Account not found", err
+	}
+
+	heldAmounts, err := a.HoldRepo.GetHeldAmounts([]string{accountID})
+	if err != nil {
+		logging.Logger.Error().Err(err).Str("account_id", accountID).Msg("Failed to get held amounts")
+		err = custom_err.ErrDatabase
+		return nil, "Failed to get held amounts", err
+	}
+	if heldAmounts[accountID].IsPositive() {
+		err = custom_err.ErrAccountHasActiveHolds
+		logging.Logger.Error().Err(err).Str("account_id", accountID).Msg("Account with active holds cannot be closed")
+		return nil, "Invalid request - " + err.Error(), err
+	}
+
+	msg, err := a.checkInterestSettled(accountID)
+	if err != nil {
+		return nil, msg, err
+	}
+
+	balance := account.Balance
+	if err = account.Close(reason, requester); err != nil {
+		logging.Logger.Error().Err(err).Str("account_id", accountID).Str("balance", balance.String()).Msg("Account cannot be closed")
+		return nil, "Invalid request - " + err.Error(), err
+	}
+
+	if err = a.AccountRepo.UpdateAccount(account); err != nil {
+		logging.Logger.Error().Err(err).Str("account_id", accountID).Msg("Failed to close account")
+		if errors.Is(err, custom_err.ErrConcurrentModification) {
+			return nil, "Account was modified concurrently, try again", err
+		}
+		err = custom_err.ErrDatabase
+		return nil, "Failed to close account", err
+	}
+	account.Version++
+
+	eventData := map[string]interface{}{
+		"account_id":            account.ID,
+		"customer_id":           account.CustomerID,
+		"payout_account_id":     payoutAccountID,
+		"payout_transaction_id": payoutTransactionID,
+		"reason":                account.StatusReason,
+		"closed_by":             requester,
+		"request_id":            requestId,
+	}
+
+	event, eventErr := entity.NewEvent(entity.EventTypeAccountClosed, account.ID, entity.EventAggregateTypeAccount, requester, eventData)
+	if eventErr == nil {
+		if createErr := a.EventRepo.CreateEvent(event); createErr != nil {
+			logging.Logger.Error().Err(createErr).Str("account_id", account.ID).Msg("Failed to create account closed event")
+		}
+	}
+	_ = messaging.GetService().PublishToDefaultTopic(messaging.Message{Content: account.ToString(), Status: true, Type: messaging.MessageTypeCloseAccount})
+	return account, "Account closed", nil
+}
+
+// checkInterestSettled refuses the closure while interest of the account waits to be credited or accrued interest
+// of at least a minor unit is not yet posted, since a closed account takes no further credit
+func (a *CloseAccount) checkInterestSettled(accountID string) (string, error) {
+	pending, err := a.InterestRepo.GetPendingAccountInterestPostings(accountID)
+	if err != nil {
+		logging.Logger.Error().Err(err).Str("account_id", accountID).Msg("Failed to get pending interest postings")
+		return "Failed to get pending interest postings", custom_err.ErrDatabase
+	}
+
+	accrued, err := a.InterestRepo.GetUnpostedAccrual(accountID)
+	if err != nil {
+		logging.Logger.Error().Err(err).Str("account_id", accountID).Msg("Failed to get accrued interest")
+		return "Failed to get accrued interest", custom_err.ErrDatabase
+	}
+
+	if len(pending) > 0 || money.FromAccrual(accrued).IsPositive() {
+		err = custom_err.ErrAccountHasPendingInterest
+		logging.Logger.Error().Err(err).Str("account_id", accountID).Int("pending_postings", len(pending)).Msg("Account with interest owed cannot be closed")
+		return "Invalid request - " + err.Error(), err
+	}
+	return "", nil
+}
@@ -0,0 +1,91 @@
+package account
+
+import (
+	"account-service/internal/domain/entity"
+	custom_err "account-service/internal/domain/error"
+	"account-service/internal/domain/money"
+	mock_repo "account-service/internal/ports/mocks/repo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"strings"
+	"testing"
+)
+
+// TestCloseAccount_Execute_Success tests closing a paid out account and recording the payout with the closure
+func TestCloseAccount_Execute_Success(t *testing.T) {
+	mockAccountRepo := new(mock_repo.MockAccountRepo)
+	mockHoldRepo := new(mock_repo.MockHoldRepo)
+	mockInterestRepo := new(mock_repo.MockInterestRepo)
+	mockEventRepo := new(mock_repo.MockEventRepo)
+	closeAccount := NewCloseAccount(mockAccountRepo, mockHoldRepo, mockInterestRepo, mockEventRepo)
+
+	account := &entity.Account{
+		ID:           "acc-123",
+		CustomerID:   "cust-123",
+		ActiveStatus: entity.AccountActiveStatusActive,
+		Balance:      money.Zero,
+		Version:      5,
+	}
+
+	mockAccountRepo.On("GetAccountByID", "acc-123").Return(account, nil)
+	mockHoldRepo.On("GetHeldAmounts", []string{"acc-123"}).Return(map[string]money.Amount{}, nil)
+	mockInterestRepo.On("GetPendingAccountInterestPostings", "acc-123").Return([]*entity.InterestPosting{}, nil)
+	// less than a minor unit is not owed
+	mockInterestRepo.On("GetUnpostedAccrual", "acc-123").Return(int64(400_000), nil)
+	mockAccountRepo.On("UpdateAccount", account).Return(nil)
+	mockEventRepo.On("CreateEvent", mock.MatchedBy(func(event *entity.Event) bool {
+		return event.Type == entity.EventTypeAccountClosed &&
+			strings.Contains(string(event.Data), `"payout_transaction_id":"tx-789"`)
+	})).Return(nil)
+
+	closed, message, err := closeAccount.Execute("acc-123", "acc-456", "tx-789", "customer request", "teller", "req-456")
+
+	assert.NoError(t, err)
+	assert.Equal(t, "Account closed", message)
+	assert.True(t, closed.IsClosed())
+	assert.False(t, closed.CanTransact())
+	assert.Equal(t, "customer request", closed.StatusReason)
+	assert.Equal(t, 6, closed.Version)
+	mockAccountRepo.AssertExpectations(t)
+	mockEventRepo.AssertExpectations(t)
+}
+
+// TestCloseAccount_Execute_Invalid tests the accounts that cannot be closed
+func TestCloseAccount_Execute_Invalid(t *testing.T) {
+	tests := []struct {
+		name    string
+		account *entity.Account
+		held    money.Amount
+		pending []*entity.InterestPosting
+		accrued int64
+		err     error
+	}{
+		{"BalanceLeft", &entity.Account{ID: "acc-123", ActiveStatus: entity.AccountActiveStatusActive, Balance: money.MustParse("0.01")}, money.Zero, nil, 0, custom_err.ErrAccountBalanceNotZero},
+		{"ActiveHolds", &entity.Account{ID: "acc-123", ActiveStatus: entity.AccountActiveStatusActive}, money.MustParse("5.00"), nil, 0, custom_err.ErrAccountHasActiveHolds},
+		{"PendingInterest", &entity.Account{ID: "acc-123", ActiveStatus: entity.AccountActiveStatusActive}, money.Zero, []*entity.InterestPosting{{ID: "posting-1", AccountID: "acc-123", Period: "2026-04", Amount: money.MustParse("4.17"), Status: entity.InterestPostingStatusPending}}, 0, custom_err.ErrAccountHasPendingInterest},
+		{"UnpostedInterest", &entity.Account{ID: "acc-123", ActiveStatus: entity.AccountActiveStatusActive}, money.Zero, nil, 12_500_000, custom_err.ErrAccountHasPendingInterest},
+		{"Frozen", &entity.Account{ID: "acc-123", ActiveStatus: entity.AccountActiveStatusLocked, FreezeMode: entity.AccountFreezeModeBoth}, money.Zero, nil, 0, custom_err.ErrInvalidAccountStatus},
+		{"AlreadyClosed", &entity.Account{ID: "acc-123", ActiveStatus: entity.AccountActiveStatusClosed}, money.Zero, nil, 0, custom_err.ErrAccountClosed},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockAccountRepo := new(mock_repo.MockAccountRepo)
+			mockHoldRepo := new(mock_repo.MockHoldRepo)
+			mockInterestRepo := new(mock_repo.MockInterestRepo)
+			closeAccount := NewCloseAccount(mockAccountRepo, mockHoldRepo, mockInterestRepo, new(mock_repo.MockEventRepo))
+
+			mockAccountRepo.On("GetAccountByID", "acc-123").Return(tt.account, nil)
+			mockHoldRepo.On("GetHeldAmounts", []string{"acc-123"}).Return(map[string]money.Amount{"acc-123": tt.held}, nil)
+			mockInterestRepo.On("GetPendingAccountInterestPostings", "acc-123").Return(tt.pending, nil)
+			mockInterestRepo.On("GetUnpostedAccrual", "acc-123").Return(tt.accrued, nil)
+
+			closed, message, err := closeAccount.Execute("acc-123", "acc-456", "", "customer request", "teller", "req-456")
+
+			assert.ErrorIs(t, err, tt.err)
+			assert.Equal(t, "Invalid request - "+tt.err.Error(), message)
+			assert.Nil(t, closed)
+			mockAccountRepo.AssertNotCalled(t, "UpdateAccount", mock.Anything)
+		})
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"account-service/internal/config"
 	"account-service/internal/domain/entity"
 	custom_err "account-service/internal/domain/error"
+	"account-service/internal/domain/money"
 	"account-service/internal/logging"
 	"account-service/internal/messaging"
 	"account-service/internal/observability/metrics"
@@ -28,8 +29,8 @@ func NewCreateAccount(accountRepo ports.AccountRepo, customerRepo ports.Customer
 	}
 }
 
-// Execute creates a new account for customer
-func (a *CreateAccount) Execute(customerID string, initialDeposit float64, requester, requestId string) (*entity.Account, string, error) {
+// Execute creates a new account for customer. Currency defaults to USD and account type to savings when empty.
+func (a *CreateAccount) Execute(customerID string, currency string, accountType string, initialDeposit money.Amount, requester, requestId string) (*entity.Account, string, error) {
 	metrics.IncRequestActive()
 	defer metrics.DecRequestActive()
 
@@ -45,16 +46,39 @@ func (a *CreateAccount) Execute(customerID string, initialDeposit float64, reque
 		err = custom_err.ErrValidationFailed
 		return nil, fmt.Sprintf("%s: customer ID is required", custom_err.ErrValidationFailed), err
 	}
-	if initialDeposit < 0 {
+	currency = strings.ToUpper(strings.TrimSpace(currency))
+	if currency == "" {
+		currency = entity.CurrencyUSD
+	}
+	if !entity.IsSupportedCurrency(currency) {
+		err = custom_err.ErrUnsupportedCurrency
+		logging.Logger.Error().Err(err).Str("currency", currency).Msg("Invalid request")
+		return nil, fmt.Sprintf("%s: supported currencies - %s", err, strings.Join(entity.SupportedCurrencies, ", ")), err
+	}
+	accountType = strings.ToLower(strings.TrimSpace(accountType))
+	if accountType == "" {
+		accountType = entity.AccountTypeSavings
+	}
+	if !entity.IsValidAccountType(accountType) {
+		err = custom_err.ErrInvalidAccountType
+		logging.Logger.Error().Err(err).Str("account_type", accountType).Msg("Invalid request")
+		return nil, fmt.Sprintf("%s: supported account types - %s, %s", err, entity.AccountTypeSavings, entity.AccountTypeCurrent), err
+	}
+	if initialDeposit.IsNegative() {
 		err = custom_err.ErrInvalidAmount
 		logging.Logger.Error().Err(err).Msg("Invalid request")
 		return nil, err.Error(), err
 	}
-	if initialDeposit < config.Current().AccountConfig.MinDepositAmount {
-		err = fmt.Errorf("%w: minimum deposit amount - %.2f", custom_err.ErrInvalidAmount, config.Current().AccountConfig.MinDepositAmount)
-		logging.Logger.Error().Err(err).Msg(fmt.Sprintf("Minimum deposit amount %.2f", config.Current().AccountConfig.MinDepositAmount))
+	minDepositAmount, parseErr := money.Parse(config.Current().AccountConfig.MinDepositAmount)
+	if parseErr != nil {
+		logging.Logger.Warn().Err(parseErr).Msg("Invalid minimum deposit amount in config, using 0")
+		minDepositAmount = money.Zero
+	}
+	if initialDeposit < minDepositAmount {
+		err = fmt.Errorf("%w: minimum deposit amount - %s", custom_err.ErrInvalidAmount, minDepositAmount)
+		logging.Logger.Error().Err(err).Msg(fmt.Sprintf("Minimum deposit amount %s", minDepositAmount))
 		err = custom_err.ErrInvalidAmount
-		return nil, fmt.Sprintf("%s: minimum deposit amount - %.2f", custom_err.ErrInvalidAmount, config.Current().AccountConfig.MinDepositAmount), err
+		return nil, fmt.Sprintf("%s: minimum deposit amount - %s", custom_err.ErrInvalidAmount, minDepositAmount), err
 	}
 
 	if requester == "" {
@@ -79,7 +103,7 @@ func (a *CreateAccount) Execute(customerID string, initialDeposit float64, reque
 		return nil, "Customer not found", err
 	}
 
-	account, err := entity.NewAccount(customerID, entity.AccountTypeSavings, initialDeposit, requester)
+	account, err := entity.NewAccount(customerID, accountType, currency, initialDeposit, requester)
 	if err != nil {
 		err = fmt.Errorf("%w", custom_err.ErrInvalidAccount)
 		logging.Logger.Error().Err(err).Msg("Failed to verify account")
@@ -107,6 +131,7 @@ func (a *CreateAccount) Execute(customerID string, initialDeposit float64, reque
 	eventData := map[string]interface{}{
 		"account_id":      account.ID,
 		"customer_id":     customerID,
+		"account_type":    accountType,
 		"initial_deposit": initialDeposit,
 		"created_by":      requester,
 		"request_id":      requestId,
@@ -3,6 +3,7 @@ package account
 import (
 	"account-service/internal/domain/entity"
 	custom_err "account-service/internal/domain/error"
+	"account-service/internal/domain/money"
 	mock_repo "account-service/internal/ports/mocks/repo"
 	"errors"
 	"github.com/stretchr/testify/assert"
@@ -19,7 +20,7 @@ func TestCreateAccount_Execute_Success(t *testing.T) {
 	createAccount := NewCreateAccount(mockAccountRepo, mockCustomerRepo, mockEventRepo)
 
 	customerID := "cust-123"
-	initialDeposit := 100.0
+	initialDeposit := money.MustParse("100.00")
 	requester := "user123"
 	requestId := "req-456"
 
@@ -27,7 +28,7 @@ func TestCreateAccount_Execute_Success(t *testing.T) {
 	mockAccountRepo.On("CreateAccount", mock.AnythingOfType("*entity.Account")).Return(nil)
 	mockEventRepo.On("CreateEvent", mock.AnythingOfType("*entity.Event")).Return(nil)
 
-	account, message, err := createAccount.Execute(customerID, initialDeposit, requester, requestId)
+	account, message, err := createAccount.Execute(customerID, "", "", initialDeposit, requester, requestId)
 
 	assert.NoError(t, err)
 	assert.Equal(t, "Account successfully created", message)
@@ -36,6 +37,7 @@ func TestCreateAccount_Execute_Success(t *testing.T) {
 	assert.Equal(t, initialDeposit, account.Balance)
 	assert.Equal(t, requester, account.CreatedBy)
 	assert.Equal(t, entity.AccountTypeSavings, account.AccountType)
+	assert.Equal(t, entity.CurrencyUSD, account.Currency)
 
 	mockCustomerRepo.AssertExpectations(t)
 	mockAccountRepo.AssertExpectations(t)
@@ -50,7 +52,7 @@ func TestCreateAccount_Execute_EmptyCustomerID(t *testing.T) {
 
 	createAccount := NewCreateAccount(mockAccountRepo, mockCustomerRepo, mockEventRepo)
 
-	account, _, err := createAccount.Execute("", 100.0, "user123", "req-456")
+	account, _, err := createAccount.Execute("", "", "", money.MustParse("100.00"), "user123", "req-456")
 
 	assert.Error(t, err)
 	assert.ErrorIs(t, err, custom_err.ErrValidationFailed)
@@ -68,7 +70,7 @@ func TestCreateAccount_Execute_NegativeInitialDeposit(t *testing.T) {
 
 	createAccount := NewCreateAccount(mockAccountRepo, mockCustomerRepo, mockEventRepo)
 
-	account, _, err := createAccount.Execute("cust-123", -50.0, "user123", "req-456")
+	account, _, err := createAccount.Execute("cust-123", "", "", money.MustParse("-50.00"), "user123", "req-456")
 
 	assert.Error(t, err)
 	assert.ErrorIs(t, err, custom_err.ErrInvalidAmount)
@@ -78,6 +80,68 @@ func TestCreateAccount_Execute_NegativeInitialDeposit(t *testing.T) {
 	mockAccountRepo.AssertNotCalled(t, "CreateAccount")
 }
 
+// TestCreateAccount_Execute_WithCurrency tests if account is created in the requested currency
+func TestCreateAccount_Execute_WithCurrency(t *testing.T) {
+	mockCustomerRepo := new(mock_repo.MockCustomerRepo)
+	mockAccountRepo := new(mock_repo.MockAccountRepo)
+	mockEventRepo := new(mock_repo.MockEventRepo)
+
+	createAccount := NewCreateAccount(mockAccountRepo, mockCustomerRepo, mockEventRepo)
+
+	mockCustomerRepo.On("Exists", "cust-123").Return(true, nil)
+	mockAccountRepo.On("CreateAccount", mock.AnythingOfType("*entity.Account")).Return(nil)
+	mockEventRepo.On("CreateEvent", mock.AnythingOfType("*entity.Event")).Return(nil)
+
+	account, _, err := createAccount.Execute("cust-123", " eur ", "", money.MustParse("100.00"), "user123", "req-456")
+
+	assert.NoError(t, err)
+	assert.NotNil(t, account)
+	assert.Equal(t, entity.CurrencyEUR, account.Currency)
+}
+
+// TestCreateAccount_Execute_UnsupportedCurrency tests if currency is not supported
+func TestCreateAccount_Execute_UnsupportedCurrency(t *testing.T) {
+	mockCustomerRepo := new(mock_repo.MockCustomerRepo)
+	mockAccountRepo := new(mock_repo.MockAccountRepo)
+	mockEventRepo := new(mock_repo.MockEventRepo)
+
+	createAccount := NewCreateAccount(mockAccountRepo, mockCustomerRepo, mockEventRepo)
+
+	account, _, err := createAccount.Execute("cust-123", "JPY", "", money.MustParse("100.00"), "user123", "req-456")
+
+	assert.Error(t, err)
+	assert.ErrorIs(t, err, custom_err.ErrUnsupportedCurrency)
+	assert.Nil(t, account)
+
+	mockCustomerRepo.AssertNotCalled(t, "Exists")
+	mockAccountRepo.AssertNotCalled(t, "CreateAccount")
+}
+
+// TestCreateAccount_Execute_CurrentAccount tests creating a current account and rejecting an unknown account type
+func TestCreateAccount_Execute_CurrentAccount(t *testing.T) {
+	mockCustomerRepo := new(mock_repo.MockCustomerRepo)
+	mockAccountRepo := new(mock_repo.MockAccountRepo)
+	mockEventRepo := new(mock_repo.MockEventRepo)
+
+	createAccount := NewCreateAccount(mockAccountRepo, mockCustomerRepo, mockEventRepo)
+
+	mockCustomerRepo.On("Exists", "cust-123").Return(true, nil)
+	mockAccountRepo.On("CreateAccount", mock.AnythingOfType("*entity.Account")).Return(nil)
+	mockEventRepo.On("CreateEvent", mock.AnythingOfType("*entity.Event")).Return(nil)
+
+	account, _, err := createAccount.Execute("cust-123", "", " Current ", money.MustParse("100.00"), "user123", "req-456")
+
+	assert.NoError(t, err)
+	assert.Equal(t, entity.AccountTypeCurrent, account.AccountType)
+	assert.True(t, account.OverdraftLimit.IsZero())
+
+	account, _, err = createAccount.Execute("cust-123", "", "checking", money.MustParse("100.00"), "user123", "req-456")
+
+	assert.ErrorIs(t, err, custom_err.ErrInvalidAccountType)
+	assert.Nil(t, account)
+	mockAccountRepo.AssertNumberOfCalls(t, "CreateAccount", 1)
+}
+
 // TestCreateAccount_Execute_EmptyRequester check if requester is empty
 func TestCreateAccount_Execute_EmptyRequester(t *testing.T) {
 	mockCustomerRepo := new(mock_repo.MockCustomerRepo)
@@ -86,7 +150,7 @@ func TestCreateAccount_Execute_EmptyRequester(t *testing.T) {
 
 	createAccount := NewCreateAccount(mockAccountRepo, mockCustomerRepo, mockEventRepo)
 
-	account, _, err := createAccount.Execute("cust-123", 100.0, "", "req-456")
+	account, _, err := createAccount.Execute("cust-123", "", "", money.MustParse("100.00"), "", "req-456")
 
 	assert.Error(t, err)
 	assert.ErrorIs(t, err, custom_err.ErrUnauthorizedRequest)
@@ -105,13 +169,13 @@ func TestCreateAccount_Execute_DatabaseError_CheckCustomer(t *testing.T) {
 	createAccount := NewCreateAccount(mockAccountRepo, mockCustomerRepo, mockEventRepo)
 
 	customerID := "cust-123"
-	initialDeposit := 100.0
+	initialDeposit := money.MustParse("100.00")
 	requester := "user123"
 	requestId := "req-456"
 
 	mockCustomerRepo.On("Exists", customerID).Return(false, errors.New("database error"))
 
-	account, _, err := createAccount.Execute(customerID, initialDeposit, requester, requestId)
+	account, _, err := createAccount.Execute(customerID, "", "", initialDeposit, requester, requestId)
 
 	assert.Error(t, err)
 	assert.ErrorIs(t, err, custom_err.ErrDatabase)
@@ -130,13 +194,13 @@ func TestCreateAccount_Execute_CustomerNotFound(t *testing.T) {
 	createAccount := NewCreateAccount(mockAccountRepo, mockCustomerRepo, mockEventRepo)
 
 	customerID := "cust-123"
-	initialDeposit := 100.0
+	initialDeposit := money.MustParse("100.00")
 	requester := "user123"
 	requestId := "req-456"
 
 	mockCustomerRepo.On("Exists", customerID).Return(false, nil)
 
-	account, _, err := createAccount.Execute(customerID, initialDeposit, requester, requestId)
+	account, _, err := createAccount.Execute(customerID, "", "", initialDeposit, requester, requestId)
 
 	assert.Error(t, err)
 	assert.ErrorIs(t, err, custom_err.ErrCustomerNotFound)
@@ -155,7 +219,7 @@ func TestCreateAccount_Execute_DatabaseError_CreateAccount(t *testing.T) {
 	createAccount := NewCreateAccount(mockAccountRepo, mockCustomerRepo, mockEventRepo)
 
 	customerID := "cust-123"
-	initialDeposit := 100.0
+	initialDeposit := money.MustParse("100.00")
 	requester := "user123"
 	requestId := "req-456"
 
@@ -164,7 +228,7 @@ func TestCreateAccount_Execute_DatabaseError_CreateAccount(t *testing.T) {
 	mockAccountRepo.On("CreateAccount", mock.AnythingOfType("*entity.Account")).Return(errors.New("database error"))
 
 	// Execute
-	account, _, err := createAccount.Execute(customerID, initialDeposit, requester, requestId)
+	account, _, err := createAccount.Execute(customerID, "", "", initialDeposit, requester, requestId)
 
 	// Assert
 	assert.Error(t, err)
@@ -117,12 +117,18 @@ func (a *DeleteAccount) Execute(scope, id, requester, requestId string) (string,
 			return "Account not found", err
 		}
 
-		if account.Balance > 0 {
-			err = fmt.Errorf("cannot delete account with positive balance: %.2f", account.Balance)
+		if account.Balance.IsPositive() {
+			err = fmt.Errorf("cannot delete account with positive balance: %s", account.Balance)
 			logging.Logger.Error().Err(err).Str("account_id", id).Msg("Account deletion blocked")
 			return "Account deletion blocked. Account has balance", err
 		}
 
+		if account.IsOverdrawn() {
+			err = fmt.Errorf("cannot delete overdrawn account: %s", account.Balance)
+			logging.Logger.Error().Err(err).Str("account_id", id).Msg("Account deletion blocked")
+			return "Account deletion blocked. Account is overdrawn", err
+		}
+
 		if err = a.AccountRepo.CheckTransactionLock(id); err != nil {
 			logging.Logger.Error().Err(err).Str("account_id", id).Msg("Failed to verify account")
 			return "Failed to verify accounts", err
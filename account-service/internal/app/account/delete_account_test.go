@@ -3,6 +3,7 @@ package account
 import (
 	"account-service/internal/domain/entity"
 	custom_err "account-service/internal/domain/error"
+	"account-service/internal/domain/money"
 	mock_repo "account-service/internal/ports/mocks/repo"
 	"errors"
 	"github.com/stretchr/testify/assert"
@@ -207,7 +208,7 @@ func TestDeleteAccount_Execute_AccountsLockedOrHasBalance_AllScope(t *testing.T)
 	requestId := "req-456"
 
 	accounts := []*entity.Account{
-		{ID: "acc-1", CustomerID: id, Balance: 100.0},
+		{ID: "acc-1", CustomerID: id, Balance: money.MustParse("100.00")},
 		{ID: "acc-2", CustomerID: id, Balance: 0.0},
 	}
 
@@ -343,7 +344,7 @@ func TestDeleteAccount_Execute_AccountHasBalance_SingleScope(t *testing.T) {
 
 	account := &entity.Account{
 		ID:      "acc-123",
-		Balance: 100.50,
+		Balance: money.MustParse("100.50"),
 	}
 
 	mockAccountRepo.On("GetAccountByID", id).Return(account, nil)
@@ -359,6 +360,32 @@ func TestDeleteAccount_Execute_AccountHasBalance_SingleScope(t *testing.T) {
 	mockAccountRepo.AssertNotCalled(t, "DeleteAccount")
 }
 
+// TestDeleteAccount_Execute_AccountOverdrawn_SingleScope tests if account is overdrawn in scope=single
+func TestDeleteAccount_Execute_AccountOverdrawn_SingleScope(t *testing.T) {
+	mockCustomerRepo := new(mock_repo.MockCustomerRepo)
+	mockAccountRepo := new(mock_repo.MockAccountRepo)
+	mockEventRepo := new(mock_repo.MockEventRepo)
+
+	deleteAccount := NewDeleteAccount(mockAccountRepo, mockCustomerRepo, mockEventRepo)
+
+	account := &entity.Account{
+		ID:             "acc-123",
+		AccountType:    entity.AccountTypeCurrent,
+		Balance:        money.MustParse("-20.00"),
+		OverdraftLimit: money.MustParse("100.00"),
+	}
+
+	mockAccountRepo.On("GetAccountByID", "acc-123").Return(account, nil)
+
+	message, err := deleteAccount.Execute("single", "acc-123", "user123", "req-456")
+
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "cannot delete overdrawn account")
+	assert.Equal(t, "Account deletion blocked. Account is overdrawn", message)
+
+	mockAccountRepo.AssertNotCalled(t, "DeleteAccount")
+}
+
 // TestDeleteAccount_Execute_AccountInTransaction_SingleScope tests if account is in transaction while delete
 func TestDeleteAccount_Execute_AccountInTransaction_SingleScope(t *testing.T) {
 	mockCustomerRepo := new(mock_repo.MockCustomerRepo)
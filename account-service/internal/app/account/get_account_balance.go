@@ -2,6 +2,7 @@ package account
 
 import (
 	custom_err "account-service/internal/domain/error"
+	"account-service/internal/domain/money"
 	"account-service/internal/logging"
 	"account-service/internal/observability/metrics"
 	"account-service/internal/ports"
@@ -12,16 +13,20 @@ import (
 // GetAccountBalance is a use-case for getting balance of an account
 type GetAccountBalance struct {
 	AccountRepo ports.AccountRepo
+	HoldRepo    ports.HoldRepo
 }
 
 // NewGetAccountBalance creates a new GetAccountBalance use-case
-func NewGetAccountBalance(accountRepo ports.AccountRepo) *GetAccountBalance {
+func NewGetAccountBalance(accountRepo ports.AccountRepo, holdRepo ports.HoldRepo) *GetAccountBalance {
 	return &GetAccountBalance{
 		AccountRepo: accountRepo,
+		HoldRepo:    holdRepo,
 	}
 }
 
-func (a *GetAccountBalance) Execute(id, requester, requestId string) (float64, int, string, error) {
+// Execute returns the ledger balance of the account and the available balance, which is the ledger
+// balance plus the overdraft limit minus the active holds
+func (a *GetAccountBalance) Execute(id, requester, requestId string) (money.Amount, money.Amount, int, string, error) {
 	metrics.IncRequestActive()
 	defer metrics.DecRequestActive()
 
@@ -33,20 +38,29 @@ func (a *GetAccountBalance) Execute(id, requester, requestId string) (float64, i
 	if strings.TrimSpace(id) == "" {
 		err = fmt.Errorf("%w: 'id' - account id required in param", custom_err.ErrValidationFailed)
 		logging.Logger.Error().Err(err).Msg("Invalid request - 'id' account id missing")
-		return 0, 0, "Invalid request - 'id' account id missing", err
+		return 0, 0, 0, "Invalid request - 'id' account id missing", err
 	}
 
 	account, err := a.AccountRepo.GetAccountByID(id)
 	if err != nil {
 		logging.Logger.Error().Err(err).Str("account_id", id).Msg("Failed to verify account")
 		err = fmt.Errorf("%v: failed to verify account", custom_err.ErrDatabase)
-		return 0, 0, "Failed to verify account", err
+		return 0, 0, 0, "Failed to verify account", err
 	}
 
 	if account == nil {
 		err = fmt.Errorf("%v", custom_err.ErrAccountNotFound)
 		logging.Logger.Error().Err(err).Str("account_id", id).Msg("Account not found")
-		return 0, 0, "Account not found", err
+		return 0, 0, 0, "Account not found", err
 	}
-	return account.Balance, account.Version, "Account balance", nil
+
+	heldAmounts, err := a.HoldRepo.GetHeldAmounts([]string{account.ID})
+	if err != nil {
+		logging.Logger.Error().Err(err).Str("account_id", id).Msg("Failed to get held amount")
+		err = fmt.Errorf("%v: failed to get held amount", custom_err.ErrDatabase)
+		return 0, 0, 0, "Failed to get held amount", err
+	}
+	account.HeldAmount = heldAmounts[account.ID]
+
+	return account.Balance, account.AvailableBalance(), account.Version, "Account balance", nil
 }
@@ -3,21 +3,25 @@ package account
 import (
 	"account-service/internal/domain/entity"
 	custom_err "account-service/internal/domain/error"
+	"account-service/internal/domain/money"
 	mock_repo "account-service/internal/ports/mocks/repo"
 	"errors"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 	"testing"
 )
 
 // TestGetAccountBalance_Execute_Success tests success response if all inputs are provided correctly
 func TestGetAccountBalance_Execute_Success(t *testing.T) {
 	mockAccountRepo := new(mock_repo.MockAccountRepo)
-	getAccountBalance := NewGetAccountBalance(mockAccountRepo)
+	mockHoldRepo := new(mock_repo.MockHoldRepo)
+	mockHoldRepo.On("GetHeldAmounts", mock.Anything).Return(map[string]money.Amount{}, nil).Maybe()
+	getAccountBalance := NewGetAccountBalance(mockAccountRepo, mockHoldRepo)
 
 	id := "acc-123"
 	requester := "user123"
 	requestId := "req-456"
-	expectedBalance := 1500.75
+	expectedBalance := money.MustParse("1500.75")
 
 	account := &entity.Account{
 		ID:      id,
@@ -26,7 +30,7 @@ func TestGetAccountBalance_Execute_Success(t *testing.T) {
 
 	mockAccountRepo.On("GetAccountByID", id).Return(account, nil)
 
-	balance, _, message, err := getAccountBalance.Execute(id, requester, requestId)
+	balance, _, _, message, err := getAccountBalance.Execute(id, requester, requestId)
 
 	assert.NoError(t, err)
 	assert.Equal(t, "Account balance", message)
@@ -38,12 +42,14 @@ func TestGetAccountBalance_Execute_Success(t *testing.T) {
 // TestGetAccountBalance_Execute_Success_ZeroBalance tests if account balance is zero
 func TestGetAccountBalance_Execute_Success_ZeroBalance(t *testing.T) {
 	mockAccountRepo := new(mock_repo.MockAccountRepo)
-	getAccountBalance := NewGetAccountBalance(mockAccountRepo)
+	mockHoldRepo := new(mock_repo.MockHoldRepo)
+	mockHoldRepo.On("GetHeldAmounts", mock.Anything).Return(map[string]money.Amount{}, nil).Maybe()
+	getAccountBalance := NewGetAccountBalance(mockAccountRepo, mockHoldRepo)
 
 	id := "acc-123"
 	requester := "user123"
 	requestId := "req-456"
-	expectedBalance := 0.0
+	expectedBalance := money.MustParse("0.00")
 
 	account := &entity.Account{
 		ID:      id,
@@ -52,7 +58,7 @@ func TestGetAccountBalance_Execute_Success_ZeroBalance(t *testing.T) {
 
 	mockAccountRepo.On("GetAccountByID", id).Return(account, nil)
 
-	balance, _, message, err := getAccountBalance.Execute(id, requester, requestId)
+	balance, _, _, message, err := getAccountBalance.Execute(id, requester, requestId)
 
 	assert.NoError(t, err)
 	assert.Equal(t, "Account balance", message)
@@ -64,12 +70,14 @@ func TestGetAccountBalance_Execute_Success_ZeroBalance(t *testing.T) {
 // TestGetAccountBalance_Execute_Success_NegativeBalance tests for negative balance
 func TestGetAccountBalance_Execute_Success_NegativeBalance(t *testing.T) {
 	mockAccountRepo := new(mock_repo.MockAccountRepo)
-	getAccountBalance := NewGetAccountBalance(mockAccountRepo)
+	mockHoldRepo := new(mock_repo.MockHoldRepo)
+	mockHoldRepo.On("GetHeldAmounts", mock.Anything).Return(map[string]money.Amount{}, nil).Maybe()
+	getAccountBalance := NewGetAccountBalance(mockAccountRepo, mockHoldRepo)
 
 	id := "acc-123"
 	requester := "user123"
 	requestId := "req-456"
-	expectedBalance := -500.25
+	expectedBalance := money.MustParse("-500.25")
 
 	account := &entity.Account{
 		ID:      id,
@@ -78,7 +86,7 @@ func TestGetAccountBalance_Execute_Success_NegativeBalance(t *testing.T) {
 
 	mockAccountRepo.On("GetAccountByID", id).Return(account, nil)
 
-	balance, _, message, err := getAccountBalance.Execute(id, requester, requestId)
+	balance, _, _, message, err := getAccountBalance.Execute(id, requester, requestId)
 
 	assert.NoError(t, err)
 	assert.Equal(t, "Account balance", message)
@@ -90,14 +98,16 @@ func TestGetAccountBalance_Execute_Success_NegativeBalance(t *testing.T) {
 // TestGetAccountBalance_Execute_EmptyAccountID tests if account id not provided
 func TestGetAccountBalance_Execute_EmptyAccountID(t *testing.T) {
 	mockAccountRepo := new(mock_repo.MockAccountRepo)
-	getAccountBalance := NewGetAccountBalance(mockAccountRepo)
+	mockHoldRepo := new(mock_repo.MockHoldRepo)
+	mockHoldRepo.On("GetHeldAmounts", mock.Anything).Return(map[string]money.Amount{}, nil).Maybe()
+	getAccountBalance := NewGetAccountBalance(mockAccountRepo, mockHoldRepo)
 
-	balance, _, message, err := getAccountBalance.Execute("", "user123", "req-456")
+	balance, _, _, message, err := getAccountBalance.Execute("", "user123", "req-456")
 
 	assert.Error(t, err)
 	assert.ErrorIs(t, err, custom_err.ErrValidationFailed)
 	assert.Equal(t, "Invalid request - 'id' account id missing", message)
-	assert.Equal(t, 0.0, balance)
+	assert.Equal(t, money.Zero, balance)
 
 	mockAccountRepo.AssertNotCalled(t, "GetAccountByID")
 }
@@ -105,7 +115,9 @@ func TestGetAccountBalance_Execute_EmptyAccountID(t *testing.T) {
 // TestGetAccountBalance_Execute_AccountNotFound tests if account not found
 func TestGetAccountBalance_Execute_AccountNotFound(t *testing.T) {
 	mockAccountRepo := new(mock_repo.MockAccountRepo)
-	getAccountBalance := NewGetAccountBalance(mockAccountRepo)
+	mockHoldRepo := new(mock_repo.MockHoldRepo)
+	mockHoldRepo.On("GetHeldAmounts", mock.Anything).Return(map[string]money.Amount{}, nil).Maybe()
+	getAccountBalance := NewGetAccountBalance(mockAccountRepo, mockHoldRepo)
 
 	id := "acc-123"
 	requester := "user123"
@@ -113,11 +125,11 @@ func TestGetAccountBalance_Execute_AccountNotFound(t *testing.T) {
 
 	mockAccountRepo.On("GetAccountByID", id).Return(nil, nil)
 
-	balance, _, message, err := getAccountBalance.Execute(id, requester, requestId)
+	balance, _, _, message, err := getAccountBalance.Execute(id, requester, requestId)
 
 	assert.Error(t, err)
 	assert.Equal(t, "Account not found", message)
-	assert.Equal(t, 0.0, balance)
+	assert.Equal(t, money.Zero, balance)
 
 	mockAccountRepo.AssertExpectations(t)
 }
@@ -125,7 +137,9 @@ func TestGetAccountBalance_Execute_AccountNotFound(t *testing.T) {
 // TestGetAccountBalance_Execute_DatabaseError if database throws an error
 func TestGetAccountBalance_Execute_DatabaseError(t *testing.T) {
 	mockAccountRepo := new(mock_repo.MockAccountRepo)
-	getAccountBalance := NewGetAccountBalance(mockAccountRepo)
+	mockHoldRepo := new(mock_repo.MockHoldRepo)
+	mockHoldRepo.On("GetHeldAmounts", mock.Anything).Return(map[string]money.Amount{}, nil).Maybe()
+	getAccountBalance := NewGetAccountBalance(mockAccountRepo, mockHoldRepo)
 
 	id := "acc-123"
 	requester := "user123"
@@ -133,12 +147,12 @@ func TestGetAccountBalance_Execute_DatabaseError(t *testing.T) {
 
 	mockAccountRepo.On("GetAccountByID", id).Return(nil, errors.New("database connection failed"))
 
-	balance, _, message, err := getAccountBalance.Execute(id, requester, requestId)
+	balance, _, _, message, err := getAccountBalance.Execute(id, requester, requestId)
 
 	assert.Error(t, err)
 	assert.Contains(t, err.Error(), "failed to verify account")
 	assert.Equal(t, "Failed to verify account", message)
-	assert.Equal(t, 0.0, balance)
+	assert.Equal(t, money.Zero, balance)
 
 	mockAccountRepo.AssertExpectations(t)
 }
@@ -146,12 +160,14 @@ func TestGetAccountBalance_Execute_DatabaseError(t *testing.T) {
 // TestGetAccountBalance_Execute_EmptyRequester tests if requester not provided
 func TestGetAccountBalance_Execute_EmptyRequester(t *testing.T) {
 	mockAccountRepo := new(mock_repo.MockAccountRepo)
-	getAccountBalance := NewGetAccountBalance(mockAccountRepo)
+	mockHoldRepo := new(mock_repo.MockHoldRepo)
+	mockHoldRepo.On("GetHeldAmounts", mock.Anything).Return(map[string]money.Amount{}, nil).Maybe()
+	getAccountBalance := NewGetAccountBalance(mockAccountRepo, mockHoldRepo)
 
 	id := "acc-123"
 	requester := ""
 	requestId := "req-456"
-	expectedBalance := 1000.0
+	expectedBalance := money.MustParse("1000.00")
 
 	account := &entity.Account{
 		ID:      id,
@@ -160,7 +176,7 @@ func TestGetAccountBalance_Execute_EmptyRequester(t *testing.T) {
 
 	mockAccountRepo.On("GetAccountByID", id).Return(account, nil)
 
-	balance, _, message, err := getAccountBalance.Execute(id, requester, requestId)
+	balance, _, _, message, err := getAccountBalance.Execute(id, requester, requestId)
 
 	assert.NoError(t, err)
 	assert.Equal(t, "Account balance", message)
@@ -172,14 +188,16 @@ func TestGetAccountBalance_Execute_EmptyRequester(t *testing.T) {
 // TestGetAccountBalance_Execute_WhitespaceAccountID tests if white space in input account id
 func TestGetAccountBalance_Execute_WhitespaceAccountID(t *testing.T) {
 	mockAccountRepo := new(mock_repo.MockAccountRepo)
-	getAccountBalance := NewGetAccountBalance(mockAccountRepo)
+	mockHoldRepo := new(mock_repo.MockHoldRepo)
+	mockHoldRepo.On("GetHeldAmounts", mock.Anything).Return(map[string]money.Amount{}, nil).Maybe()
+	getAccountBalance := NewGetAccountBalance(mockAccountRepo, mockHoldRepo)
 
-	balance, _, message, err := getAccountBalance.Execute("   ", "user123", "req-456")
+	balance, _, _, message, err := getAccountBalance.Execute("   ", "user123", "req-456")
 
 	assert.Error(t, err)
 	assert.ErrorIs(t, err, custom_err.ErrValidationFailed)
 	assert.Equal(t, "Invalid request - 'id' account id missing", message)
-	assert.Equal(t, 0.0, balance)
+	assert.Equal(t, money.Zero, balance)
 
 	mockAccountRepo.AssertNotCalled(t, "GetAccountByID")
 }
@@ -187,12 +205,14 @@ func TestGetAccountBalance_Execute_WhitespaceAccountID(t *testing.T) {
 // TestGetAccountBalance_Execute_AccountWithFullDetails tests account details
 func TestGetAccountBalance_Execute_AccountWithFullDetails(t *testing.T) {
 	mockAccountRepo := new(mock_repo.MockAccountRepo)
-	getAccountBalance := NewGetAccountBalance(mockAccountRepo)
+	mockHoldRepo := new(mock_repo.MockHoldRepo)
+	mockHoldRepo.On("GetHeldAmounts", mock.Anything).Return(map[string]money.Amount{}, nil).Maybe()
+	getAccountBalance := NewGetAccountBalance(mockAccountRepo, mockHoldRepo)
 
 	id := "acc-123"
 	requester := "user123"
 	requestId := "req-456"
-	expectedBalance := 2500.50
+	expectedBalance := money.MustParse("2500.50")
 
 	account := &entity.Account{
 		ID:           id,
@@ -206,7 +226,7 @@ func TestGetAccountBalance_Execute_AccountWithFullDetails(t *testing.T) {
 
 	mockAccountRepo.On("GetAccountByID", id).Return(account, nil)
 
-	balance, _, message, err := getAccountBalance.Execute(id, requester, requestId)
+	balance, _, _, message, err := getAccountBalance.Execute(id, requester, requestId)
 
 	assert.NoError(t, err)
 	assert.Equal(t, "Account balance", message)
@@ -218,12 +238,14 @@ func TestGetAccountBalance_Execute_AccountWithFullDetails(t *testing.T) {
 // TestGetAccountBalance_Execute_ConcurrentAccess tests concurrent access to account balance
 func TestGetAccountBalance_Execute_ConcurrentAccess(t *testing.T) {
 	mockAccountRepo := new(mock_repo.MockAccountRepo)
-	getAccountBalance := NewGetAccountBalance(mockAccountRepo)
+	mockHoldRepo := new(mock_repo.MockHoldRepo)
+	mockHoldRepo.On("GetHeldAmounts", mock.Anything).Return(map[string]money.Amount{}, nil).Maybe()
+	getAccountBalance := NewGetAccountBalance(mockAccountRepo, mockHoldRepo)
 
 	id := "acc-123"
 	requester := "user123"
 	requestId := "req-456"
-	expectedBalance := 5000.0
+	expectedBalance := money.MustParse("5000.00")
 
 	account := &entity.Account{
 		ID:      id,
@@ -233,7 +255,7 @@ func TestGetAccountBalance_Execute_ConcurrentAccess(t *testing.T) {
 	mockAccountRepo.On("GetAccountByID", id).Return(account, nil).Times(10)
 
 	results := make(chan struct {
-		balance float64
+		balance money.Amount
 		message string
 		err     error
 	}, 3)
@@ -241,9 +263,9 @@ func TestGetAccountBalance_Execute_ConcurrentAccess(t *testing.T) {
 	// Concurrent calls executed
 	for i := 0; i < 10; i++ {
 		go func() {
-			balance, _, message, err := getAccountBalance.Execute(id, requester, requestId)
+			balance, _, _, message, err := getAccountBalance.Execute(id, requester, requestId)
 			results <- struct {
-				balance float64
+				balance money.Amount
 				message string
 				err     error
 			}{balance, message, err}
@@ -263,17 +285,19 @@ func TestGetAccountBalance_Execute_ConcurrentAccess(t *testing.T) {
 // TestGetAccountBalance_Execute_AccountWithDifferentIDs tests using different  mock account ids
 func TestGetAccountBalance_Execute_AccountWithDifferentIDs(t *testing.T) {
 	mockAccountRepo := new(mock_repo.MockAccountRepo)
-	getAccountBalance := NewGetAccountBalance(mockAccountRepo)
+	mockHoldRepo := new(mock_repo.MockHoldRepo)
+	mockHoldRepo.On("GetHeldAmounts", mock.Anything).Return(map[string]money.Amount{}, nil).Maybe()
+	getAccountBalance := NewGetAccountBalance(mockAccountRepo, mockHoldRepo)
 
 	testCases := []struct {
 		accountID       string
-		expectedBalance float64
+		expectedBalance money.Amount
 	}{
-		{"acc-001", 100.0},
-		{"acc-002", 200.0},
-		{"acc-003", 300.0},
-		{"acc-004", 300.0},
-		{"acc-005", 300.0},
+		{"acc-001", money.MustParse("100.00")},
+		{"acc-002", money.MustParse("200.00")},
+		{"acc-003", money.MustParse("300.00")},
+		{"acc-004", money.MustParse("300.00")},
+		{"acc-005", money.MustParse("300.00")},
 	}
 
 	for _, tc := range testCases {
@@ -285,7 +309,7 @@ func TestGetAccountBalance_Execute_AccountWithDifferentIDs(t *testing.T) {
 
 			mockAccountRepo.On("GetAccountByID", tc.accountID).Return(account, nil).Once()
 
-			balance, _, message, err := getAccountBalance.Execute(tc.accountID, "user123", "req-456")
+			balance, _, _, message, err := getAccountBalance.Execute(tc.accountID, "user123", "req-456")
 
 			assert.NoError(t, err)
 			assert.Equal(t, "Account balance", message)
@@ -295,3 +319,32 @@ func TestGetAccountBalance_Execute_AccountWithDifferentIDs(t *testing.T) {
 
 	mockAccountRepo.AssertExpectations(t)
 }
+
+// TestGetAccountBalance_Execute_WithHolds tests that holds reduce the available balance but not the ledger balance
+func TestGetAccountBalance_Execute_WithHolds(t *testing.T) {
+	mockAccountRepo := new(mock_repo.MockAccountRepo)
+	mockHoldRepo := new(mock_repo.MockHoldRepo)
+	getAccountBalance := NewGetAccountBalance(mockAccountRepo, mockHoldRepo)
+
+	account := &entity.Account{
+		ID:             "acc-123",
+		AccountType:    entity.AccountTypeCurrent,
+		Balance:        money.MustParse("1000.00"),
+		OverdraftLimit: money.MustParse("200.00"),
+		Version:        2,
+	}
+
+	mockAccountRepo.On("GetAccountByID", "acc-123").Return(account, nil)
+	mockHoldRepo.On("GetHeldAmounts", []string{"acc-123"}).Return(map[string]money.Amount{"acc-123": money.MustParse("300.00")}, nil)
+
+	ledger, available, version, message, err := getAccountBalance.Execute("acc-123", "user123", "req-456")
+
+	assert.NoError(t, err)
+	assert.Equal(t, "Account balance", message)
+	assert.Equal(t, money.MustParse("1000.00"), ledger)
+	assert.Equal(t, money.MustParse("900.00"), available)
+	assert.Equal(t, 2, version)
+
+	mockAccountRepo.AssertExpectations(t)
+	mockHoldRepo.AssertExpectations(t)
+}
@@ -3,11 +3,11 @@ package account
 import (
 	"account-service/internal/domain/entity"
 	custom_err "account-service/internal/domain/error"
+	"account-service/internal/domain/money"
 	"account-service/internal/logging"
 	"account-service/internal/observability/metrics"
 	"account-service/internal/ports"
 	"fmt"
-	"strconv"
 	"strings"
 )
 
@@ -23,7 +23,9 @@ func NewListAccount(accountRepo ports.AccountRepo) *ListAccount {
 	}
 }
 
-func (a *ListAccount) Execute(customerID, minBalance, inTransaction string, page, pageSize int, setOrder, requester, requestId string) ([]*entity.Account, int64, int64, string, error) {
+// Execute lists a page of accounts and returns the cursor of the next page, empty on the last page. With a cursor
+// the page continues after it and page is ignored. Totals are only counted when includeTotal is set.
+func (a *ListAccount) Execute(customerID, minBalance, inTransaction string, page, pageSize int, cursor string, includeTotal bool, setOrder, requester, requestId string) ([]*entity.Account, int64, int64, string, string, error) {
 	metrics.IncRequestActive()
 	defer metrics.DecRequestActive()
 
@@ -39,20 +41,20 @@ func (a *ListAccount) Execute(customerID, minBalance, inTransaction string, page
 		pageSize = 100
 	}
 
-	var minBalanceAmount *float64
+	var minBalanceAmount *money.Amount
 	if strings.TrimSpace(minBalance) != "" {
-		amount, err := strconv.ParseFloat(strings.TrimSpace(minBalance), 64)
+		amount, err := money.Parse(strings.TrimSpace(minBalance))
 		if err != nil {
 			err = fmt.Errorf("%w: customer ID is required for customer scope", custom_err.ErrValidationFailed)
 			logging.Logger.Error().Err(err).Str("min_balance", minBalance).Msg("Invalid request - invalid balance id")
 			err = custom_err.ErrValidationFailed
-			return nil, 0, 0, "Invalid request - customer id missing", err
+			return nil, 0, 0, "", "Invalid request - customer id missing", err
 		}
 
-		if amount < 0 {
+		if amount.IsNegative() {
 			err = fmt.Errorf("%w: valid balance amount is required for has_balance scope", custom_err.ErrValidationFailed)
 			logging.Logger.Error().Err(err).Str("balance", minBalance).Msg("Invalid request - invalid balance")
-			return nil, 0, 0, "Invalid request - invalid balance", err
+			return nil, 0, 0, "", "Invalid request - invalid balance", err
 		}
 		minBalanceAmount = &amount
 	}
@@ -74,7 +76,15 @@ func (a *ListAccount) Execute(customerID, minBalance, inTransaction string, page
 		filters["locked_for_tx"] = false
 	}
 
-	accounts, totalCount, err := a.AccountRepo.GetAccountsByFiltersWithPagination(filters, page, pageSize, setOrder)
+	after, err := entity.DecodePageCursor(cursor)
+	if err != nil {
+		return nil, 0, 0, "", "Invalid request - invalid cursor", err
+	}
+
+	accounts, totalCount, err := a.AccountRepo.GetAccountsByFiltersWithPagination(filters, page, pageSize, after, includeTotal, setOrder)
+	accounts, nextCursor := entity.TrimPage(accounts, pageSize, func(account *entity.Account) entity.PageCursor {
+		return entity.PageCursor{CreatedAt: account.CreatedAt, ID: account.ID}
+	})
 
 	totalPages := int64(0)
 	if totalCount > 0 {
@@ -85,5 +95,5 @@ func (a *ListAccount) Execute(customerID, minBalance, inTransaction string, page
 		accounts = []*entity.Account{}
 	}
 
-	return accounts, totalCount, totalPages, "Account List", nil
+	return accounts, totalCount, totalPages, nextCursor, "Account List", nil
 }
@@ -3,6 +3,7 @@ package account
 import (
 	"account-service/internal/domain/entity"
 	custom_err "account-service/internal/domain/error"
+	"account-service/internal/domain/money"
 	mock_repo "account-service/internal/ports/mocks/repo"
 	"github.com/stretchr/testify/assert"
 	"testing"
@@ -23,8 +24,8 @@ func TestListAccount_Execute_SuccessWithDefaultPagination(t *testing.T) {
 	setOrder := "desc"
 
 	expectedAccounts := []*entity.Account{
-		{ID: "acc-1", CustomerID: customerID, Balance: 1000.0},
-		{ID: "acc-2", CustomerID: customerID, Balance: 2000.0},
+		{ID: "acc-1", CustomerID: customerID, Balance: money.MustParse("1000.00")},
+		{ID: "acc-2", CustomerID: customerID, Balance: money.MustParse("2000.00")},
 	}
 	expectedTotalCount := int64(2)
 
@@ -34,11 +35,11 @@ func TestListAccount_Execute_SuccessWithDefaultPagination(t *testing.T) {
 			"status":      "valid",
 			"customer_id": customerID,
 		},
-		1, 100, setOrder,
+		1, 100, (*entity.PageCursor)(nil), true, setOrder,
 	).Return(expectedAccounts, expectedTotalCount, nil)
 
-	accounts, totalCount, totalPages, message, err := listAccount.Execute(
-		customerID, minBalance, inTransaction, page, pageSize, setOrder, requester, requestId,
+	accounts, totalCount, totalPages, _, message, err := listAccount.Execute(
+		customerID, minBalance, inTransaction, page, pageSize, "", true, setOrder, requester, requestId,
 	)
 
 	assert.NoError(t, err)
@@ -64,7 +65,7 @@ func TestListAccount_Execute_SuccessWithCustomPagination(t *testing.T) {
 	setOrder := "desc"
 
 	expectedAccounts := []*entity.Account{
-		{ID: "acc-1", CustomerID: customerID, Balance: 1000.0},
+		{ID: "acc-1", CustomerID: customerID, Balance: money.MustParse("1000.00")},
 	}
 	expectedTotalCount := int64(15)
 
@@ -73,11 +74,11 @@ func TestListAccount_Execute_SuccessWithCustomPagination(t *testing.T) {
 			"status":      "valid",
 			"customer_id": customerID,
 		},
-		page, pageSize, "desc",
+		page, pageSize, (*entity.PageCursor)(nil), true, "desc",
 	).Return(expectedAccounts, expectedTotalCount, nil)
 
-	accounts, totalCount, totalPages, message, err := listAccount.Execute(
-		customerID, minBalance, inTransaction, page, pageSize, setOrder, requester, requestId,
+	accounts, totalCount, totalPages, _, message, err := listAccount.Execute(
+		customerID, minBalance, inTransaction, page, pageSize, "", true, setOrder, requester, requestId,
 	)
 
 	assert.NoError(t, err)
@@ -103,7 +104,7 @@ func TestListAccount_Execute_SuccessWithMinBalanceFilter(t *testing.T) {
 	setOrder := "desc"
 
 	expectedAccounts := []*entity.Account{
-		{ID: "acc-1", CustomerID: customerID, Balance: 1000.0},
+		{ID: "acc-1", CustomerID: customerID, Balance: money.MustParse("1000.00")},
 	}
 	expectedTotalCount := int64(1)
 
@@ -111,13 +112,13 @@ func TestListAccount_Execute_SuccessWithMinBalanceFilter(t *testing.T) {
 		map[string]interface{}{
 			"status":      "valid",
 			"customer_id": customerID,
-			"min_balance": 500.5,
+			"min_balance": money.MustParse("500.50"),
 		},
-		page, pageSize, "desc",
+		page, pageSize, (*entity.PageCursor)(nil), true, "desc",
 	).Return(expectedAccounts, expectedTotalCount, nil)
 
-	accounts, totalCount, totalPages, message, err := listAccount.Execute(
-		customerID, minBalance, inTransaction, page, pageSize, setOrder, requester, requestId,
+	accounts, totalCount, totalPages, _, message, err := listAccount.Execute(
+		customerID, minBalance, inTransaction, page, pageSize, "", true, setOrder, requester, requestId,
 	)
 
 	assert.NoError(t, err)
@@ -144,7 +145,7 @@ func TestListAccount_Execute_SuccessWithInTransactionTrue(t *testing.T) {
 	setOrder := "desc"
 
 	expectedAccounts := []*entity.Account{
-		{ID: "acc-1", CustomerID: customerID, Balance: 1000.0, LockedForTx: true},
+		{ID: "acc-1", CustomerID: customerID, Balance: money.MustParse("1000.00"), LockedForTx: true},
 	}
 	expectedTotalCount := int64(1)
 
@@ -154,11 +155,11 @@ func TestListAccount_Execute_SuccessWithInTransactionTrue(t *testing.T) {
 			"customer_id":   customerID,
 			"locked_for_tx": true,
 		},
-		page, pageSize, "desc",
+		page, pageSize, (*entity.PageCursor)(nil), true, "desc",
 	).Return(expectedAccounts, expectedTotalCount, nil)
 
-	accounts, totalCount, totalPages, message, err := listAccount.Execute(
-		customerID, minBalance, inTransaction, page, pageSize, setOrder, requester, requestId,
+	accounts, totalCount, totalPages, _, message, err := listAccount.Execute(
+		customerID, minBalance, inTransaction, page, pageSize, "", true, setOrder, requester, requestId,
 	)
 
 	assert.NoError(t, err)
@@ -184,7 +185,7 @@ func TestListAccount_Execute_SuccessWithInTransactionFalse(t *testing.T) {
 	setOrder := "desc"
 
 	expectedAccounts := []*entity.Account{
-		{ID: "acc-1", CustomerID: customerID, Balance: 1000.0, LockedForTx: false},
+		{ID: "acc-1", CustomerID: customerID, Balance: money.MustParse("1000.00"), LockedForTx: false},
 	}
 	expectedTotalCount := int64(1)
 
@@ -194,11 +195,11 @@ func TestListAccount_Execute_SuccessWithInTransactionFalse(t *testing.T) {
 			"customer_id":   customerID,
 			"locked_for_tx": false,
 		},
-		page, pageSize, "desc",
+		page, pageSize, (*entity.PageCursor)(nil), true, "desc",
 	).Return(expectedAccounts, expectedTotalCount, nil)
 
-	accounts, totalCount, totalPages, message, err := listAccount.Execute(
-		customerID, minBalance, inTransaction, page, pageSize, setOrder, requester, requestId,
+	accounts, totalCount, totalPages, _, message, err := listAccount.Execute(
+		customerID, minBalance, inTransaction, page, pageSize, "", true, setOrder, requester, requestId,
 	)
 
 	assert.NoError(t, err)
@@ -224,7 +225,7 @@ func TestListAccount_Execute_SuccessWithAllFilters(t *testing.T) {
 	setOrder := "desc"
 
 	expectedAccounts := []*entity.Account{
-		{ID: "acc-1", CustomerID: customerID, Balance: 1500.0, LockedForTx: true},
+		{ID: "acc-1", CustomerID: customerID, Balance: money.MustParse("1500.00"), LockedForTx: true},
 	}
 	expectedTotalCount := int64(1)
 
@@ -232,14 +233,14 @@ func TestListAccount_Execute_SuccessWithAllFilters(t *testing.T) {
 		map[string]interface{}{
 			"status":        "valid",
 			"customer_id":   customerID,
-			"min_balance":   1000.0,
+			"min_balance":   money.MustParse("1000.00"),
 			"locked_for_tx": true,
 		},
-		page, pageSize, "desc",
+		page, pageSize, (*entity.PageCursor)(nil), true, "desc",
 	).Return(expectedAccounts, expectedTotalCount, nil)
 
-	accounts, totalCount, totalPages, message, err := listAccount.Execute(
-		customerID, minBalance, inTransaction, page, pageSize, setOrder, requester, requestId,
+	accounts, totalCount, totalPages, _, message, err := listAccount.Execute(
+		customerID, minBalance, inTransaction, page, pageSize, "", true, setOrder, requester, requestId,
 	)
 
 	assert.NoError(t, err)
@@ -265,8 +266,8 @@ func TestListAccount_Execute_SuccessWithDefaultFilter(t *testing.T) {
 	setOrder := "desc"
 
 	expectedAccounts := []*entity.Account{
-		{ID: "acc-1", CustomerID: "cust-1", Balance: 1000.0},
-		{ID: "acc-2", CustomerID: "cust-2", Balance: 2000.0},
+		{ID: "acc-1", CustomerID: "cust-1", Balance: money.MustParse("1000.00")},
+		{ID: "acc-2", CustomerID: "cust-2", Balance: money.MustParse("2000.00")},
 	}
 	expectedTotalCount := int64(2)
 
@@ -274,11 +275,11 @@ func TestListAccount_Execute_SuccessWithDefaultFilter(t *testing.T) {
 		map[string]interface{}{
 			"status": "valid",
 		},
-		page, pageSize, "desc",
+		page, pageSize, (*entity.PageCursor)(nil), true, "desc",
 	).Return(expectedAccounts, expectedTotalCount, nil)
 
-	accounts, totalCount, totalPages, message, err := listAccount.Execute(
-		customerID, minBalance, inTransaction, page, pageSize, setOrder, requester, requestId,
+	accounts, totalCount, totalPages, _, message, err := listAccount.Execute(
+		customerID, minBalance, inTransaction, page, pageSize, "", true, setOrder, requester, requestId,
 	)
 
 	assert.NoError(t, err)
@@ -303,8 +304,8 @@ func TestListAccount_Execute_ErrorInvalidMinBalanceFormat(t *testing.T) {
 	requestId := "req-456"
 	setOrder := "desc"
 
-	accounts, totalCount, totalPages, message, err := listAccount.Execute(
-		customerID, minBalance, inTransaction, page, pageSize, setOrder, requester, requestId,
+	accounts, totalCount, totalPages, _, message, err := listAccount.Execute(
+		customerID, minBalance, inTransaction, page, pageSize, "", true, setOrder, requester, requestId,
 	)
 
 	assert.ErrorIs(t, err, custom_err.ErrValidationFailed)
@@ -329,8 +330,8 @@ func TestListAccount_Execute_ErrorNegativeMinBalance(t *testing.T) {
 	requestId := "req-456"
 	setOrder := "desc"
 
-	accounts, totalCount, totalPages, message, err := listAccount.Execute(
-		customerID, minBalance, inTransaction, page, pageSize, setOrder, requester, requestId,
+	accounts, totalCount, totalPages, _, message, err := listAccount.Execute(
+		customerID, minBalance, inTransaction, page, pageSize, "", true, setOrder, requester, requestId,
 	)
 
 	assert.Error(t, err)
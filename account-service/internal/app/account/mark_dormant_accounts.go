@@ -0,0 +1,87 @@
+package account
+
+import (
+	"account-service/internal/domain/entity"
+	custom_err "account-service/internal/domain/error"
+	"account-service/internal/logging"
+	"account-service/internal/messaging"
+	"account-service/internal/observability/metrics"
+	"account-service/internal/ports"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// MarkDormantAccounts is a use-case for making accounts dormant that went without customer transactions
+type MarkDormantAccounts struct {
+	AccountRepo ports.AccountRepo
+	EventRepo   ports.EventRepo
+}
+
+// NewMarkDormantAccounts creates a new MarkDormantAccounts use-case
+func NewMarkDormantAccounts(accountRepo ports.AccountRepo, eventRepo ports.EventRepo) *MarkDormantAccounts {
+	return &MarkDormantAccounts{
+		AccountRepo: accountRepo,
+		EventRepo:   eventRepo,
+	}
+}
+
+// Execute marks every active account dormant whose last customer transaction, or opening when it never had one,
+// lies more than the given number of months before now. Interest credits do not count as customer transactions.
+// An account that changes while it is marked is left for the next run.
+func (m *MarkDormantAccounts) Execute(now time.Time, inactiveMonths int) (int, string, error) {
+	var err error
+	defer func() {
+		metrics.RecordOperation("mark_dormant_accounts", err)
+	}()
+
+	if inactiveMonths < 1 {
+		err = fmt.Errorf("%w: inactive months must be at least 1", custom_err.ErrValidationFailed)
+		return 0, "Invalid dormancy period", err
+	}
+
+	inactiveSince := now.AddDate(0, -inactiveMonths, 0)
+	accounts, err := m.AccountRepo.GetDormancyCandidates(inactiveSince)
+	if err != nil {
+		logging.Logger.Error().Err(err).Time("inactive_since", inactiveSince).Msg("Failed to get dormancy candidates")
+		err = custom_err.ErrDatabase
+		return 0, "Failed to get dormancy candidates", err
+	}
+
+	marked := 0
+	for _, account := range accounts {
+		lastActivity := account.LastActivity()
+		if markErr := account.MarkDormant(lastActivity); markErr != nil {
+			continue
+		}
+
+		if updateErr := m.AccountRepo.UpdateAccount(account); updateErr != nil {
+			if !errors.Is(updateErr, custom_err.ErrConcurrentModification) {
+				logging.Logger.Error().Err(updateErr).Str("account_id", account.ID).Msg("Failed to mark account dormant")
+				err = custom_err.ErrDatabase
+				return marked, fmt.Sprintf("Failed to mark account %s dormant", account.ID), err
+			}
+			logging.Logger.Warn().Err(updateErr).Str("account_id", account.ID).Msg("Account changed while marking it dormant")
+			continue
+		}
+		account.Version++
+		marked++
+
+		eventData := map[string]interface{}{
+			"account_id":       account.ID,
+			"customer_id":      account.CustomerID,
+			"last_activity_at": lastActivity,
+			"inactive_months":  inactiveMonths,
+			"reason":           account.StatusReason,
+		}
+		event, eventErr := entity.NewEvent(entity.EventTypeAccountDormant, account.ID, entity.EventAggregateTypeAccount, entity.DormancyRequester, eventData)
+		if eventErr == nil {
+			if createErr := m.EventRepo.CreateEvent(event); createErr != nil {
+				logging.Logger.Error().Err(createErr).Str("account_id", account.ID).Msg("Failed to create account dormant event")
+			}
+		}
+		_ = messaging.GetService().PublishToDefaultTopic(messaging.Message{Content: account.ID, Status: true, Type: messaging.MessageTypeAccountDormant})
+	}
+
+	return marked, fmt.Sprintf("%d accounts marked dormant", marked), nil
+}
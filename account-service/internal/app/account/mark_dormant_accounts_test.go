@@ -0,0 +1,54 @@
+package account
+
+import (
+	"account-service/internal/domain/entity"
+	custom_err "account-service/internal/domain/error"
+	mock_repo "account-service/internal/ports/mocks/repo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"testing"
+	"time"
+)
+
+// TestMarkDormantAccounts_Execute_Success tests that inactive accounts are marked dormant and changed ones are skipped
+func TestMarkDormantAccounts_Execute_Success(t *testing.T) {
+	mockAccountRepo := new(mock_repo.MockAccountRepo)
+	mockEventRepo := new(mock_repo.MockEventRepo)
+	markDormantAccounts := NewMarkDormantAccounts(mockAccountRepo, mockEventRepo)
+
+	now := time.Date(2026, time.March, 15, 0, 0, 0, 0, time.UTC)
+	lastActivity := time.Date(2025, time.January, 10, 12, 0, 0, 0, time.UTC)
+	unused := &entity.Account{ID: "acc-1", ActiveStatus: entity.AccountActiveStatusActive, CreatedAt: lastActivity}
+	changed := &entity.Account{ID: "acc-2", ActiveStatus: entity.AccountActiveStatusActive, LastActivityAt: &lastActivity}
+
+	mockAccountRepo.On("GetDormancyCandidates", time.Date(2025, time.January, 15, 0, 0, 0, 0, time.UTC)).
+		Return([]*entity.Account{unused, changed}, nil)
+	mockAccountRepo.On("UpdateAccount", unused).Return(nil)
+	mockAccountRepo.On("UpdateAccount", changed).Return(custom_err.ErrConcurrentModification)
+	mockEventRepo.On("CreateEvent", mock.MatchedBy(func(event *entity.Event) bool {
+		return event.Type == entity.EventTypeAccountDormant && event.AggregateID == "acc-1" && event.CreatedBy == entity.DormancyRequester
+	})).Return(nil).Once()
+
+	count, message, err := markDormantAccounts.Execute(now, 14)
+
+	assert.NoError(t, err)
+	assert.Equal(t, 1, count)
+	assert.Equal(t, "1 accounts marked dormant", message)
+	assert.Equal(t, entity.AccountActiveStatusInactive, unused.ActiveStatus)
+	assert.Equal(t, "no customer transaction since 2025-01-10", unused.StatusReason)
+	assert.False(t, unused.AllowsDebit())
+	assert.True(t, unused.AllowsCredit())
+	mockAccountRepo.AssertExpectations(t)
+	mockEventRepo.AssertExpectations(t)
+}
+
+// TestMarkDormantAccounts_Execute_InvalidPeriod tests that a dormancy period below one month is rejected
+func TestMarkDormantAccounts_Execute_InvalidPeriod(t *testing.T) {
+	mockAccountRepo := new(mock_repo.MockAccountRepo)
+	markDormantAccounts := NewMarkDormantAccounts(mockAccountRepo, new(mock_repo.MockEventRepo))
+
+	_, _, err := markDormantAccounts.Execute(time.Now(), 0)
+
+	assert.ErrorIs(t, err, custom_err.ErrValidationFailed)
+	mockAccountRepo.AssertNotCalled(t, "GetDormancyCandidates", mock.Anything)
+}
@@ -0,0 +1,105 @@
+package account
+
+import (
+	"account-service/internal/domain/entity"
+	custom_err "account-service/internal/domain/error"
+	"account-service/internal/domain/money"
+	"account-service/internal/logging"
+	"account-service/internal/messaging"
+	"account-service/internal/observability/metrics"
+	"account-service/internal/ports"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// SetOverdraftLimit is a use-case for setting how far a current account may go below zero
+type SetOverdraftLimit struct {
+	AccountRepo ports.AccountRepo
+	EventRepo   ports.EventRepo
+}
+
+// NewSetOverdraftLimit creates a new SetOverdraftLimit use-case
+func NewSetOverdraftLimit(accountRepo ports.AccountRepo, eventRepo ports.EventRepo) *SetOverdraftLimit {
+	return &SetOverdraftLimit{
+		AccountRepo: accountRepo,
+		EventRepo:   eventRepo,
+	}
+}
+
+// Execute sets the overdraft limit of a current account. A zero limit removes the overdraft; the limit
+// cannot be lowered below the amount the account is already overdrawn by.
+func (a *SetOverdraftLimit) Execute(accountID string, limit money.Amount, requester, requestId string) (*entity.Account, string, error) {
+	metrics.IncRequestActive()
+	defer metrics.DecRequestActive()
+
+	var err error
+	defer func() {
+		metrics.RecordOperation("set_overdraft_limit", err)
+	}()
+
+	accountID = strings.TrimSpace(accountID)
+	if accountID == "" {
+		err = fmt.Errorf("%w: account ID is required", custom_err.ErrValidationFailed)
+		logging.Logger.Error().Err(err).Msg("Required missing fields")
+		err = custom_err.ErrValidationFailed
+		return nil, fmt.Sprintf("%s: account ID is required", custom_err.ErrValidationFailed), err
+	}
+
+	if requester == "" {
+		err = fmt.Errorf("%w: requester not found", custom_err.ErrUnauthorizedRequest)
+		logging.Logger.Error().Err(err).Msg("Unknown requester")
+		err = custom_err.ErrUnauthorizedRequest
+		return nil, fmt.Sprintf("%s: requester not found", custom_err.ErrUnauthorizedRequest), err
+	}
+
+	account, err := a.AccountRepo.GetAccountByID(accountID)
+	if err != nil {
+		logging.Logger.Error().Err(err).Str("account_id", accountID).Msg("Failed to verify account")
+		err = custom_err.ErrDatabase
+		return nil, "Failed to verify account", err
+	}
+
+	if account == nil {
+		err = custom_err.ErrAccountNotFound
+		logging.Logger.Error().Err(err).Str("account_id", accountID).Msg("Account not found")
+		return nil, "Account not found", err
+	}
+
+	previousLimit := account.OverdraftLimit
+	if err = account.SetOverdraftLimit(limit, requester); err != nil {
+		logging.Logger.Error().Err(err).Str("account_id", accountID).Str("overdraft_limit", limit.String()).Msg("Invalid overdraft limit")
+		if errors.Is(err, custom_err.ErrInvalidOverdraftLimit) && !limit.IsNegative() {
+			return nil, fmt.Sprintf("%s: account is overdrawn by %s", err, money.Zero.Sub(account.Balance)), err
+		}
+		return nil, err.Error(), err
+	}
+
+	if err = a.AccountRepo.UpdateAccount(account); err != nil {
+		logging.Logger.Error().Err(err).Str("account_id", accountID).Msg("Failed to update overdraft limit")
+		if errors.Is(err, custom_err.ErrConcurrentModification) {
+			return nil, "Account was modified concurrently, try again", err
+		}
+		err = custom_err.ErrDatabase
+		return nil, "Failed to update overdraft limit", err
+	}
+	account.Version++
+
+	eventData := map[string]interface{}{
+		"account_id":      account.ID,
+		"customer_id":     account.CustomerID,
+		"previous_limit":  previousLimit,
+		"overdraft_limit": account.OverdraftLimit,
+		"updated_by":      requester,
+		"request_id":      requestId,
+	}
+
+	event, eventErr := entity.NewEvent(entity.EventTypeOverdraftLimitSet, account.ID, entity.EventAggregateTypeAccount, requester, eventData)
+	if eventErr == nil {
+		if createErr := a.EventRepo.CreateEvent(event); createErr != nil {
+			logging.Logger.Error().Err(createErr).Str("account_id", account.ID).Msg("Failed to create overdraft limit event")
+		}
+	}
+	_ = messaging.GetService().PublishToDefaultTopic(messaging.Message{Content: account.ToString(), Status: true, Type: messaging.MessageTypeSetOverdraft})
+	return account, "Overdraft limit successfully set", nil
+}
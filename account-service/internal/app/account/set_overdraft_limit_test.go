@@ -0,0 +1,90 @@
+package account
+
+import (
+	"account-service/internal/domain/entity"
+	custom_err "account-service/internal/domain/error"
+	"account-service/internal/domain/money"
+	mock_repo "account-service/internal/ports/mocks/repo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"testing"
+)
+
+// TestSetOverdraftLimit_Execute_Success tests setting the overdraft limit of a current account
+func TestSetOverdraftLimit_Execute_Success(t *testing.T) {
+	mockAccountRepo := new(mock_repo.MockAccountRepo)
+	mockEventRepo := new(mock_repo.MockEventRepo)
+
+	setOverdraftLimit := NewSetOverdraftLimit(mockAccountRepo, mockEventRepo)
+
+	account := &entity.Account{
+		ID:          "acc-123",
+		CustomerID:  "cust-123",
+		AccountType: entity.AccountTypeCurrent,
+		Balance:     money.MustParse("100.00"),
+		Version:     3,
+	}
+
+	mockAccountRepo.On("GetAccountByID", "acc-123").Return(account, nil)
+	mockAccountRepo.On("UpdateAccount", account).Return(nil)
+	mockEventRepo.On("CreateEvent", mock.MatchedBy(func(event *entity.Event) bool {
+		return event.Type == entity.EventTypeOverdraftLimitSet
+	})).Return(nil)
+
+	updated, message, err := setOverdraftLimit.Execute("acc-123", money.MustParse("500.00"), "admin", "req-456")
+
+	assert.NoError(t, err)
+	assert.Equal(t, "Overdraft limit successfully set", message)
+	assert.Equal(t, money.MustParse("500.00"), updated.OverdraftLimit)
+	assert.Equal(t, money.MustParse("600.00"), updated.AvailableBalance())
+	assert.Equal(t, 4, updated.Version)
+	assert.Equal(t, "admin", updated.UpdatedBy)
+
+	mockAccountRepo.AssertExpectations(t)
+	mockEventRepo.AssertExpectations(t)
+}
+
+// TestSetOverdraftLimit_Execute_SavingsAccount tests that savings accounts cannot be overdrawn
+func TestSetOverdraftLimit_Execute_SavingsAccount(t *testing.T) {
+	mockAccountRepo := new(mock_repo.MockAccountRepo)
+	mockEventRepo := new(mock_repo.MockEventRepo)
+
+	setOverdraftLimit := NewSetOverdraftLimit(mockAccountRepo, mockEventRepo)
+
+	account := &entity.Account{
+		ID:          "acc-123",
+		AccountType: entity.AccountTypeSavings,
+		Balance:     money.MustParse("100.00"),
+	}
+	mockAccountRepo.On("GetAccountByID", "acc-123").Return(account, nil)
+
+	updated, _, err := setOverdraftLimit.Execute("acc-123", money.MustParse("500.00"), "admin", "req-456")
+
+	assert.ErrorIs(t, err, custom_err.ErrOverdraftNotAllowed)
+	assert.Nil(t, updated)
+	mockAccountRepo.AssertNotCalled(t, "UpdateAccount", mock.Anything)
+}
+
+// TestSetOverdraftLimit_Execute_BelowOverdrawnAmount tests that the limit cannot be lowered below the overdrawn amount
+func TestSetOverdraftLimit_Execute_BelowOverdrawnAmount(t *testing.T) {
+	mockAccountRepo := new(mock_repo.MockAccountRepo)
+	mockEventRepo := new(mock_repo.MockEventRepo)
+
+	setOverdraftLimit := NewSetOverdraftLimit(mockAccountRepo, mockEventRepo)
+
+	account := &entity.Account{
+		ID:             "acc-123",
+		AccountType:    entity.AccountTypeCurrent,
+		Balance:        money.MustParse("-150.00"),
+		OverdraftLimit: money.MustParse("500.00"),
+	}
+	mockAccountRepo.On("GetAccountByID", "acc-123").Return(account, nil)
+
+	updated, message, err := setOverdraftLimit.Execute("acc-123", money.MustParse("100.00"), "admin", "req-456")
+
+	assert.ErrorIs(t, err, custom_err.ErrInvalidOverdraftLimit)
+	assert.Nil(t, updated)
+	assert.Equal(t, "invalid overdraft limit: account is overdrawn by 150.00", message)
+	assert.Equal(t, money.MustParse("500.00"), account.OverdraftLimit)
+	mockAccountRepo.AssertNotCalled(t, "UpdateAccount", mock.Anything)
+}
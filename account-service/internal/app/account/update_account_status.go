@@ -0,0 +1,130 @@
+package account
+
+import (
+	"account-service/internal/domain/entity"
+	custom_err "account-service/internal/domain/error"
+	"account-service/internal/logging"
+	"account-service/internal/messaging"
+	"account-service/internal/observability/metrics"
+	"account-service/internal/ports"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// UpdateAccountStatus is a use-case for freezing, unfreezing and reactivating an account
+type UpdateAccountStatus struct {
+	AccountRepo ports.AccountRepo
+	EventRepo   ports.EventRepo
+}
+
+// NewUpdateAccountStatus creates a new UpdateAccountStatus use-case
+func NewUpdateAccountStatus(accountRepo ports.AccountRepo, eventRepo ports.EventRepo) *UpdateAccountStatus {
+	return &UpdateAccountStatus{
+		AccountRepo: accountRepo,
+		EventRepo:   eventRepo,
+	}
+}
+
+// Execute freezes the account when the status is locked, blocking the debits, credits or both of the freeze mode,
+// and unfreezes a frozen or reactivates a dormant account when the status is active. Every change needs a reason.
+// Closed accounts keep their status; accounts are closed through CloseAccount.
+func (a *UpdateAccountStatus) Execute(accountID, status, freezeMode, reason, requester, requestId string) (*entity.Account, string, error) {
+	metrics.IncRequestActive()
+	defer metrics.DecRequestActive()
+
+	var err error
+	defer func() {
+		metrics.RecordOperation("update_account_status", err)
+	}()
+
+	accountID = strings.TrimSpace(accountID)
+	if accountID == "" {
+		err = fmt.Errorf("%w: account ID is required", custom_err.ErrValidationFailed)
+		logging.Logger.Error().Err(err).Msg("Required missing fields")
+		err = custom_err.ErrValidationFailed
+		return nil, fmt.Sprintf("%s: account ID is required", custom_err.ErrValidationFailed), err
+	}
+
+	if requester == "" {
+		err = fmt.Errorf("%w: requester not found", custom_err.ErrUnauthorizedRequest)
+		logging.Logger.Error().Err(err).Msg("Unknown requester")
+		err = custom_err.ErrUnauthorizedRequest
+		return nil, fmt.Sprintf("%s: requester not found", custom_err.ErrUnauthorizedRequest), err
+	}
+
+	status = strings.ToLower(strings.TrimSpace(status))
+	if status != entity.AccountActiveStatusLocked && status != entity.AccountActiveStatusActive {
+		err = custom_err.ErrInvalidAccountStatus
+		logging.Logger.Error().Err(err).Str("account_id", accountID).Str("account_status", status).Msg("Invalid account status")
+		return nil, "Invalid request - " + err.Error(), err
+	}
+
+	account, err := a.AccountRepo.GetAccountByID(accountID)
+	if err != nil {
+		logging.Logger.Error().Err(err).Str("account_id", accountID).Msg("Failed to verify account")
+		err = custom_err.ErrDatabase
+		return nil, "Failed to verify account", err
+	}
+
+	if account == nil {
+		err = custom_err.ErrAccountNotFound
+		logging.Logger.Error().Err(err).Str("account_id", accountID).Msg("Account not found")
+		return nil, "Account not found", err
+	}
+
+	previousStatus := account.ActiveStatus
+	previousFreezeMode := account.FreezeMode
+	eventType := entity.EventTypeAccountFrozen
+	if status == entity.AccountActiveStatusLocked {
+		err = account.Freeze(freezeMode, reason, requester)
+	} else {
+		eventType = entity.EventTypeAccountUnfrozen
+		if previousStatus == entity.AccountActiveStatusInactive {
+			eventType = entity.EventTypeAccountReactivated
+		}
+		err = account.Activate(reason, requester)
+	}
+	if err != nil {
+		logging.Logger.Error().Err(err).Str("account_id", accountID).Str("account_status", status).Msg("Account status cannot be changed")
+		return nil, "Invalid request - " + err.Error(), err
+	}
+
+	if err = a.AccountRepo.UpdateAccount(account); err != nil {
+		logging.Logger.Error().Err(err).Str("account_id", accountID).Msg("Failed to update account status")
+		if errors.Is(err, custom_err.ErrConcurrentModification) {
+			return nil, "Account was modified concurrently, try again", err
+		}
+		err = custom_err.ErrDatabase
+		return nil, "Failed to update account status", err
+	}
+	account.Version++
+
+	eventData := map[string]interface{}{
+		"account_id":           account.ID,
+		"customer_id":          account.CustomerID,
+		"previous_status":      previousStatus,
+		"active_status":        account.ActiveStatus,
+		"previous_freeze_mode": previousFreezeMode,
+		"freeze_mode":          account.FreezeMode,
+		"reason":               account.StatusReason,
+		"updated_by":           requester,
+		"request_id":           requestId,
+	}
+
+	event, eventErr := entity.NewEvent(eventType, account.ID, entity.EventAggregateTypeAccount, requester, eventData)
+	if eventErr == nil {
+		if createErr := a.EventRepo.CreateEvent(event); createErr != nil {
+			logging.Logger.Error().Err(createErr).Str("account_id", account.ID).Msg("Failed to create account status event")
+		}
+	}
+	_ = messaging.GetService().PublishToDefaultTopic(messaging.Message{Content: account.ToString(), Status: true, Type: messaging.MessageTypeAccountStatus})
+
+	switch eventType {
+	case entity.EventTypeAccountFrozen:
+		return account, "Account frozen", nil
+	case entity.EventTypeAccountReactivated:
+		return account, "Account reactivated", nil
+	}
+	return account, "Account unfrozen", nil
+}
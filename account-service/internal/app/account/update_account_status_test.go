@@ -0,0 +1,112 @@
+package account
+
+import (
+	"account-service/internal/domain/entity"
+	custom_err "account-service/internal/domain/error"
+	"account-service/internal/domain/money"
+	mock_repo "account-service/internal/ports/mocks/repo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"testing"
+)
+
+// TestUpdateAccountStatus_Execute_Freeze tests freezing the debits of an account with a reason
+func TestUpdateAccountStatus_Execute_Freeze(t *testing.T) {
+	mockAccountRepo := new(mock_repo.MockAccountRepo)
+	mockEventRepo := new(mock_repo.MockEventRepo)
+
+	updateAccountStatus := NewUpdateAccountStatus(mockAccountRepo, mockEventRepo)
+
+	account := &entity.Account{
+		ID:           "acc-123",
+		CustomerID:   "cust-123",
+		ActiveStatus: entity.AccountActiveStatusActive,
+		Balance:      money.MustParse("100.00"),
+		Version:      2,
+	}
+
+	mockAccountRepo.On("GetAccountByID", "acc-123").Return(account, nil)
+	mockAccountRepo.On("UpdateAccount", account).Return(nil)
+	mockEventRepo.On("CreateEvent", mock.MatchedBy(func(event *entity.Event) bool {
+		return event.Type == entity.EventTypeAccountFrozen && event.AggregateID == "acc-123"
+	})).Return(nil)
+
+	updated, message, err := updateAccountStatus.Execute("acc-123", "locked", "Debit", " suspected fraud ", "admin", "req-456")
+
+	assert.NoError(t, err)
+	assert.Equal(t, "Account frozen", message)
+	assert.Equal(t, entity.AccountActiveStatusLocked, updated.ActiveStatus)
+	assert.Equal(t, entity.AccountFreezeModeDebit, updated.FreezeMode)
+	assert.Equal(t, "suspected fraud", updated.StatusReason)
+	assert.NotNil(t, updated.StatusChangedAt)
+	assert.False(t, updated.AllowsDebit())
+	assert.True(t, updated.AllowsCredit())
+	assert.Equal(t, 3, updated.Version)
+
+	mockAccountRepo.AssertExpectations(t)
+	mockEventRepo.AssertExpectations(t)
+}
+
+// TestUpdateAccountStatus_Execute_Reactivate tests that activating a dormant account records a reactivation
+func TestUpdateAccountStatus_Execute_Reactivate(t *testing.T) {
+	mockAccountRepo := new(mock_repo.MockAccountRepo)
+	mockEventRepo := new(mock_repo.MockEventRepo)
+
+	updateAccountStatus := NewUpdateAccountStatus(mockAccountRepo, mockEventRepo)
+
+	account := &entity.Account{
+		ID:           "acc-123",
+		ActiveStatus: entity.AccountActiveStatusInactive,
+	}
+
+	mockAccountRepo.On("GetAccountByID", "acc-123").Return(account, nil)
+	mockAccountRepo.On("UpdateAccount", account).Return(nil)
+	mockEventRepo.On("CreateEvent", mock.MatchedBy(func(event *entity.Event) bool {
+		return event.Type == entity.EventTypeAccountReactivated
+	})).Return(nil)
+
+	updated, message, err := updateAccountStatus.Execute("acc-123", "active", "", "customer identified at branch", "teller", "req-456")
+
+	assert.NoError(t, err)
+	assert.Equal(t, "Account reactivated", message)
+	assert.Equal(t, entity.AccountActiveStatusActive, updated.ActiveStatus)
+	mockEventRepo.AssertExpectations(t)
+}
+
+// TestUpdateAccountStatus_Execute_Invalid tests the status changes that are rejected before saving
+func TestUpdateAccountStatus_Execute_Invalid(t *testing.T) {
+	tests := []struct {
+		name         string
+		activeStatus string
+		status       string
+		freezeMode   string
+		reason       string
+		err          error
+	}{
+		{"UnknownStatus", entity.AccountActiveStatusActive, "closed", "", "closing", custom_err.ErrInvalidAccountStatus},
+		{"UnknownFreezeMode", entity.AccountActiveStatusActive, "locked", "withdrawals", "fraud", custom_err.ErrInvalidFreezeMode},
+		{"MissingReason", entity.AccountActiveStatusActive, "locked", "both", " ", custom_err.ErrStatusReasonRequired},
+		{"AlreadyActive", entity.AccountActiveStatusActive, "active", "", "cleared", custom_err.ErrAccountAlreadyActive},
+		{"Closed", entity.AccountActiveStatusClosed, "locked", "both", "fraud", custom_err.ErrAccountClosed},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockAccountRepo := new(mock_repo.MockAccountRepo)
+			mockEventRepo := new(mock_repo.MockEventRepo)
+
+			updateAccountStatus := NewUpdateAccountStatus(mockAccountRepo, mockEventRepo)
+
+			account := &entity.Account{ID: "acc-123", ActiveStatus: tt.activeStatus}
+			mockAccountRepo.On("GetAccountByID", "acc-123").Return(account, nil).Maybe()
+
+			updated, message, err := updateAccountStatus.Execute("acc-123", tt.status, tt.freezeMode, tt.reason, "admin", "req-456")
+
+			assert.ErrorIs(t, err, tt.err)
+			assert.Equal(t, "Invalid request - "+tt.err.Error(), message)
+			assert.Nil(t, updated)
+			assert.Equal(t, tt.activeStatus, account.ActiveStatus)
+			mockAccountRepo.AssertNotCalled(t, "UpdateAccount", mock.Anything)
+		})
+	}
+}
@@ -68,8 +68,8 @@ func (c *DeleteCustomer) Execute(id, requester, requestId string) (string, error
 	}
 
 	for _, account := range customer.Accounts {
-		if account.ActiveStatus == entity.AccountActiveStatusActive && account.Balance > 0 {
-			err = fmt.Errorf("cannot delete customer with active accounts having balance: account %s has %.2f", account.ID, account.Balance)
+		if account.ActiveStatus == entity.AccountActiveStatusActive && !account.Balance.IsZero() {
+			err = fmt.Errorf("cannot delete customer with active accounts having balance: account %s has %s", account.ID, account.Balance)
 			logging.Logger.Warn().Err(err).Str("customer_id", id).Msg("Customer deletion blocked")
 			return "Deletion Blocked: Customer has active accounts", err
 		}
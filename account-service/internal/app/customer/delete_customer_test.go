@@ -3,6 +3,7 @@ package customer
 import (
 	"account-service/internal/domain/entity"
 	custom_err "account-service/internal/domain/error"
+	"account-service/internal/domain/money"
 	mock_repo "account-service/internal/ports/mocks/repo"
 	"errors"
 	"github.com/stretchr/testify/assert"
@@ -226,7 +227,7 @@ func TestDeleteCustomer_Execute_MultipleAccounts_ActiveWithBalance(t *testing.T)
 			{
 				ID:           "acc-2",
 				ActiveStatus: entity.AccountActiveStatusActive,
-				Balance:      50.25,
+				Balance:      money.MustParse("50.25"),
 			},
 			{
 				ID:           "acc-3",
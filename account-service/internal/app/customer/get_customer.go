@@ -0,0 +1,61 @@
+package customer
+
+import (
+	"account-service/internal/domain/entity"
+	custom_err "account-service/internal/domain/error"
+	"account-service/internal/logging"
+	"account-service/internal/observability/metrics"
+	"account-service/internal/ports"
+	"errors"
+	"fmt"
+	"gorm.io/gorm"
+	"strings"
+)
+
+// GetCustomer is a use-case for getting the profile and accounts of a customer
+type GetCustomer struct {
+	CustomerRepo ports.CustomerRepo
+}
+
+// NewGetCustomer creates a new GetCustomer use-case
+func NewGetCustomer(customerRepo ports.CustomerRepo) *GetCustomer {
+	return &GetCustomer{
+		CustomerRepo: customerRepo,
+	}
+}
+
+// Execute gets a valid customer with its accounts
+func (c *GetCustomer) Execute(id, requester, requestId string) (*entity.Customer, string, error) {
+	metrics.IncRequestActive()
+	defer metrics.DecRequestActive()
+
+	var err error
+	defer func() {
+		metrics.RecordOperation("get_customer", err)
+	}()
+
+	id = strings.TrimSpace(id)
+	if id == "" {
+		err = fmt.Errorf("%w: customer ID is required", custom_err.ErrValidationFailed)
+		logging.Logger.Error().Err(err).Msg("missing required value")
+		return nil, "Missing required data", err
+	}
+
+	customer, err := c.CustomerRepo.GetCustomerByID(id)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			err = custom_err.ErrCustomerNotFound
+			return nil, "Customer not found", err
+		}
+		logging.Logger.Error().Err(err).Str("customer_id", id).Str("request_id", requestId).Msg("Failed to get customer")
+		err = custom_err.ErrDatabase
+		return nil, "Failed to get customer", err
+	}
+
+	if customer == nil {
+		err = custom_err.ErrCustomerNotFound
+		return nil, "Customer not found", err
+	}
+
+	return customer, "Customer details", nil
+}
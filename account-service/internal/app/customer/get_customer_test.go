@@ -0,0 +1,54 @@
+package customer
+
+import (
+	"account-service/internal/domain/entity"
+	custom_err "account-service/internal/domain/error"
+	mock_repo "account-service/internal/ports/mocks/repo"
+	"errors"
+	"github.com/stretchr/testify/assert"
+	"gorm.io/gorm"
+	"testing"
+)
+
+// TestGetCustomer_Execute_Success tests that the customer is returned with its profile
+func TestGetCustomer_Execute_Success(t *testing.T) {
+	mockCustomerRepo := new(mock_repo.MockCustomerRepo)
+	getCustomer := NewGetCustomer(mockCustomerRepo)
+
+	customer := &entity.Customer{ID: "cus-1", Name: "Jane Doe", KYCStatus: entity.CustomerKYCStatusVerified}
+	mockCustomerRepo.On("GetCustomerByID", "cus-1").Return(customer, nil)
+
+	result, message, err := getCustomer.Execute(" cus-1 ", "viewer", "req-1")
+
+	assert.NoError(t, err)
+	assert.Equal(t, "Customer details", message)
+	assert.Equal(t, customer, result)
+	mockCustomerRepo.AssertExpectations(t)
+}
+
+// TestGetCustomer_Execute_NotFound tests that an unknown customer is reported as not found
+func TestGetCustomer_Execute_NotFound(t *testing.T) {
+	mockCustomerRepo := new(mock_repo.MockCustomerRepo)
+	getCustomer := NewGetCustomer(mockCustomerRepo)
+
+	mockCustomerRepo.On("GetCustomerByID", "cus-x").Return(nil, gorm.ErrRecordNotFound)
+
+	result, message, err := getCustomer.Execute("cus-x", "viewer", "req-1")
+
+	assert.ErrorIs(t, err, custom_err.ErrCustomerNotFound)
+	assert.Equal(t, "Customer not found", message)
+	assert.Nil(t, result)
+}
+
+// TestGetCustomer_Execute_DatabaseError tests that a failed read is reported as a database error
+func TestGetCustomer_Execute_DatabaseError(t *testing.T) {
+	mockCustomerRepo := new(mock_repo.MockCustomerRepo)
+	getCustomer := NewGetCustomer(mockCustomerRepo)
+
+	mockCustomerRepo.On("GetCustomerByID", "cus-1").Return(nil, errors.New("disk I/O error"))
+
+	_, message, err := getCustomer.Execute("cus-1", "viewer", "req-1")
+
+	assert.ErrorIs(t, err, custom_err.ErrDatabase)
+	assert.Equal(t, "Failed to get customer", message)
+}
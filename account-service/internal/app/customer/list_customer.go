@@ -3,10 +3,13 @@ package customer
 import (
 	"account-service/internal/domain/entity"
 	custom_err "account-service/internal/domain/error"
+	"account-service/internal/domain/money"
 	"account-service/internal/logging"
 	"account-service/internal/observability/metrics"
 	"account-service/internal/ports"
 	"fmt"
+	"strings"
+	"unicode"
 )
 
 // ListCustomer is a use-case for list of customers
@@ -21,8 +24,10 @@ func NewListCustomer(customerRepo ports.CustomerRepo) *ListCustomer {
 	}
 }
 
-// Execute list customers
-func (c *ListCustomer) Execute(page, pageSize int, setOrder string, requestId string) ([]*entity.Customer, int64, int64, string, error) {
+// Execute lists a page of the customers matching the search and returns the cursor of the next page, empty on the
+// last page. With a cursor the page continues after it and page is ignored. Totals are only counted when includeTotal
+// is set.
+func (c *ListCustomer) Execute(search ports.CustomerSearchDTO, page, pageSize int, cursor string, includeTotal bool, setOrder string, requestId string) ([]*entity.Customer, int64, int64, string, string, error) {
 	metrics.IncRequestActive()
 	defer metrics.DecRequestActive()
 
@@ -38,16 +43,79 @@ func (c *ListCustomer) Execute(page, pageSize int, setOrder string, requestId st
 		pageSize = 100
 	}
 
-	customers, totalCount, err := c.CustomerRepo.ListCustomer(page, pageSize, setOrder)
+	filters, message, err := customerFilters(search)
+	if err != nil {
+		logging.Logger.Warn().Err(err).Str("request_id", requestId).Msg(message)
+		return nil, 0, 0, "", message, err
+	}
+
+	after, err := entity.DecodePageCursor(cursor)
+	if err != nil {
+		return nil, 0, 0, "", "Invalid request - invalid cursor", err
+	}
+
+	customers, totalCount, err := c.CustomerRepo.ListCustomer(filters, page, pageSize, after, includeTotal, setOrder)
 	if err != nil {
 		logging.Logger.Error().Err(err).Int("page", page).Int("page_size", pageSize).Msg("Failed to list customers")
-		return nil, 0, 0, "Failed to list customer", fmt.Errorf("%w: failed to list customers", custom_err.ErrDatabase)
+		return nil, 0, 0, "", "Failed to list customer", fmt.Errorf("%w: failed to list customers", custom_err.ErrDatabase)
 	}
 
+	customers, nextCursor := entity.TrimPage(customers, pageSize, func(customer *entity.Customer) entity.PageCursor {
+		return entity.PageCursor{CreatedAt: customer.CreatedAt, ID: customer.ID}
+	})
+
 	totalPages := int64(0)
 	if totalCount > 0 {
 		totalPages = (totalCount + int64(pageSize) - 1) / int64(pageSize)
 	}
 
-	return customers, totalCount, totalPages, "Customer List", nil
+	return customers, totalCount, totalPages, nextCursor, "Customer List", nil
+}
+
+// customerFilters validates a customer search and converts it into the filters of CustomerRepo.ListCustomer
+func customerFilters(search ports.CustomerSearchDTO) (map[string]interface{}, string, error) {
+	filters := make(map[string]interface{})
+
+	if name := strings.TrimSpace(search.Name); name != "" {
+		if strings.IndexFunc(name, func(r rune) bool { return unicode.IsLetter(r) || unicode.IsDigit(r) }) < 0 {
+			return nil, "Invalid request - name must contain a letter or digit", fmt.Errorf("%w: name has no searchable word", custom_err.ErrValidationFailed)
+		}
+		filters["name"] = name
+	}
+
+	if createdBy := strings.TrimSpace(search.CreatedBy); createdBy != "" {
+		filters["created_by"] = createdBy
+	}
+
+	if search.StartDate != nil && search.EndDate != nil && search.StartDate.After(*search.EndDate) {
+		return nil, "Invalid request - start date cannot be after end date", fmt.Errorf("%w: start date after end date", custom_err.ErrValidationFailed)
+	}
+	if search.StartDate != nil {
+		filters["start_date"] = *search.StartDate
+	}
+	if search.EndDate != nil {
+		filters["end_date"] = *search.EndDate
+	}
+
+	switch activeStatus := strings.ToLower(strings.TrimSpace(search.ActiveStatus)); activeStatus {
+	case "":
+	case entity.CustomerActiveStatusActive, entity.CustomerActiveStatusDeactivated:
+		filters["active_status"] = activeStatus
+	default:
+		return nil, "Invalid request - invalid active status", fmt.Errorf("%w: active status %q", custom_err.ErrValidationFailed, activeStatus)
+	}
+
+	if minBalance := strings.TrimSpace(search.MinBalance); minBalance != "" {
+		amount, err := money.Parse(minBalance)
+		if err != nil || amount.IsNegative() {
+			return nil, "Invalid request - invalid balance", fmt.Errorf("%w: min balance %q", custom_err.ErrValidationFailed, minBalance)
+		}
+		filters["min_balance"] = amount
+	}
+
+	if accountID := strings.TrimSpace(search.AccountID); accountID != "" {
+		filters["account_id"] = accountID
+	}
+
+	return filters, "", nil
 }
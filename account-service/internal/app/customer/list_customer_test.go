@@ -3,10 +3,14 @@ package customer
 import (
 	"account-service/internal/domain/entity"
 	custom_err "account-service/internal/domain/error"
+	"account-service/internal/domain/money"
+	"account-service/internal/ports"
 	mock_repo "account-service/internal/ports/mocks/repo"
 	"errors"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 	"testing"
+	"time"
 )
 
 // TestListCustomer_Execute_Success check for success response
@@ -25,9 +29,9 @@ func TestListCustomer_Execute_Success(t *testing.T) {
 	}
 	totalCount := int64(50)
 
-	mockCustomerRepo.On("ListCustomer", page, pageSize).Return(customers, totalCount, nil)
+	mockCustomerRepo.On("ListCustomer", map[string]interface{}{}, page, pageSize, (*entity.PageCursor)(nil), true).Return(customers, totalCount, nil)
 
-	result, resultTotalCount, totalPages, message, err := listCustomer.Execute(page, pageSize, requestId, sortOrder)
+	result, resultTotalCount, totalPages, _, message, err := listCustomer.Execute(ports.CustomerSearchDTO{}, page, pageSize, "", true, sortOrder, requestId)
 
 	assert.NoError(t, err)
 	assert.Equal(t, "Customer List", message)
@@ -51,9 +55,9 @@ func TestListCustomer_Execute_Success_EmptyResults(t *testing.T) {
 	var customers []*entity.Customer
 	totalCount := int64(0)
 
-	mockCustomerRepo.On("ListCustomer", page, pageSize).Return(customers, totalCount, nil)
+	mockCustomerRepo.On("ListCustomer", map[string]interface{}{}, page, pageSize, (*entity.PageCursor)(nil), true).Return(customers, totalCount, nil)
 
-	result, resultTotalCount, totalPages, message, err := listCustomer.Execute(page, pageSize, requestId, sortOrder)
+	result, resultTotalCount, totalPages, _, message, err := listCustomer.Execute(ports.CustomerSearchDTO{}, page, pageSize, "", true, sortOrder, requestId)
 
 	assert.NoError(t, err)
 	assert.Equal(t, "Customer List", message)
@@ -79,9 +83,9 @@ func TestListCustomer_Execute_PageBoundaryAdjustment(t *testing.T) {
 	}
 	totalCount := int64(1)
 
-	mockCustomerRepo.On("ListCustomer", 1, 100).Return(customers, totalCount, nil)
+	mockCustomerRepo.On("ListCustomer", map[string]interface{}{}, 1, 100, (*entity.PageCursor)(nil), true).Return(customers, totalCount, nil)
 
-	result, resultTotalCount, totalPages, message, err := listCustomer.Execute(page, pageSize, requestId, sortOrder)
+	result, resultTotalCount, totalPages, _, message, err := listCustomer.Execute(ports.CustomerSearchDTO{}, page, pageSize, "", true, sortOrder, requestId)
 
 	// Assert
 	assert.NoError(t, err)
@@ -104,9 +108,9 @@ func TestListCustomer_Execute_DatabaseError(t *testing.T) {
 	sortOrder := "desc"
 
 	var nilCustomers []*entity.Customer
-	mockCustomerRepo.On("ListCustomer", page, pageSize).Return(nilCustomers, int64(0), errors.New("database error"))
+	mockCustomerRepo.On("ListCustomer", map[string]interface{}{}, page, pageSize, (*entity.PageCursor)(nil), true).Return(nilCustomers, int64(0), errors.New("database error"))
 
-	result, resultTotalCount, totalPages, message, err := listCustomer.Execute(page, pageSize, requestId, sortOrder)
+	result, resultTotalCount, totalPages, _, message, err := listCustomer.Execute(ports.CustomerSearchDTO{}, page, pageSize, "", true, sortOrder, requestId)
 
 	assert.Error(t, err)
 	assert.ErrorIs(t, err, custom_err.ErrDatabase)
@@ -133,9 +137,9 @@ func TestListCustomer_Execute_InvalidPageSizeTooSmall(t *testing.T) {
 	}
 	totalCount := int64(1)
 
-	mockCustomerRepo.On("ListCustomer", page, 100).Return(customers, totalCount, nil)
+	mockCustomerRepo.On("ListCustomer", map[string]interface{}{}, page, 100, (*entity.PageCursor)(nil), true).Return(customers, totalCount, nil)
 
-	result, resultTotalCount, totalPages, message, err := listCustomer.Execute(page, pageSize, requestId, sortOrder)
+	result, resultTotalCount, totalPages, _, message, err := listCustomer.Execute(ports.CustomerSearchDTO{}, page, pageSize, "", true, sortOrder, requestId)
 
 	assert.NoError(t, err)
 	assert.Equal(t, "Customer List", message)
@@ -155,3 +159,114 @@ func TestNewListCustomer(t *testing.T) {
 	assert.NotNil(t, useCase)
 	assert.Equal(t, mockCustomerRepo, useCase.CustomerRepo)
 }
+
+// TestListCustomer_Execute_CursorPage checks that a cursor page continues after the cursor without counting totals
+func TestListCustomer_Execute_CursorPage(t *testing.T) {
+	mockCustomerRepo := new(mock_repo.MockCustomerRepo)
+	listCustomer := NewListCustomer(mockCustomerRepo)
+
+	createdAt := time.Date(2026, 3, 1, 10, 30, 0, 0, time.UTC)
+	cursor := entity.PageCursor{CreatedAt: createdAt, ID: "cus-2"}.Encode()
+	customers := []*entity.Customer{
+		{ID: "cus-3", CreatedAt: createdAt.Add(time.Minute)},
+		{ID: "cus-4", CreatedAt: createdAt.Add(2 * time.Minute)},
+		{ID: "cus-5", CreatedAt: createdAt.Add(3 * time.Minute)},
+	}
+
+	mockCustomerRepo.On("ListCustomer", map[string]interface{}{}, 1, 2, mock.MatchedBy(func(after *entity.PageCursor) bool {
+		return after != nil && after.ID == "cus-2" && after.CreatedAt.Equal(createdAt)
+	}), false).Return(customers, int64(0), nil)
+
+	result, totalCount, totalPages, nextCursor, message, err := listCustomer.Execute(ports.CustomerSearchDTO{}, 0, 2, cursor, false, "asc", "req-123")
+
+	assert.NoError(t, err)
+	assert.Equal(t, "Customer List", message)
+	assert.Equal(t, customers[:2], result)
+	assert.Equal(t, int64(0), totalCount)
+	assert.Equal(t, int64(0), totalPages)
+	assert.Equal(t, entity.PageCursor{CreatedAt: customers[1].CreatedAt, ID: "cus-4"}.Encode(), nextCursor)
+	mockCustomerRepo.AssertExpectations(t)
+}
+
+// TestListCustomer_Execute_InvalidCursor checks that a malformed cursor is rejected before reading customers
+func TestListCustomer_Execute_InvalidCursor(t *testing.T) {
+	mockCustomerRepo := new(mock_repo.MockCustomerRepo)
+	listCustomer := NewListCustomer(mockCustomerRepo)
+
+	result, _, _, _, message, err := listCustomer.Execute(ports.CustomerSearchDTO{}, 1, 10, "%%%", false, "desc", "req-123")
+
+	assert.ErrorIs(t, err, custom_err.ErrInvalidPageCursor)
+	assert.Equal(t, "Invalid request - invalid cursor", message)
+	assert.Nil(t, result)
+	mockCustomerRepo.AssertNotCalled(t, "ListCustomer")
+}
+
+// TestListCustomer_Execute_SearchFilters checks that every search field is passed to the repository as a filter
+func TestListCustomer_Execute_SearchFilters(t *testing.T) {
+	mockCustomerRepo := new(mock_repo.MockCustomerRepo)
+	listCustomer := NewListCustomer(mockCustomerRepo)
+
+	startDate := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+	endDate := time.Date(2026, 3, 31, 0, 0, 0, 0, time.UTC)
+	search := ports.CustomerSearchDTO{
+		Name:         " jo smi ",
+		CreatedBy:    "teller-1",
+		StartDate:    &startDate,
+		EndDate:      &endDate,
+		ActiveStatus: "Active",
+		MinBalance:   "100.50",
+		AccountID:    "acc-1",
+	}
+	customers := []*entity.Customer{{ID: "cus-1", Name: "John Smith"}}
+
+	mockCustomerRepo.On("ListCustomer", mock.MatchedBy(func(filters map[string]interface{}) bool {
+		return len(filters) == 7 &&
+			filters["name"] == "jo smi" &&
+			filters["created_by"] == "teller-1" &&
+			filters["start_date"] == startDate &&
+			filters["end_date"] == endDate &&
+			filters["active_status"] == entity.CustomerActiveStatusActive &&
+			filters["min_balance"] == money.MustParse("100.50") &&
+			filters["account_id"] == "acc-1"
+	}), 1, 10, (*entity.PageCursor)(nil), true).Return(customers, int64(1), nil)
+
+	result, totalCount, _, _, message, err := listCustomer.Execute(search, 1, 10, "", true, "desc", "req-123")
+
+	assert.NoError(t, err)
+	assert.Equal(t, "Customer List", message)
+	assert.Equal(t, customers, result)
+	assert.Equal(t, int64(1), totalCount)
+	mockCustomerRepo.AssertExpectations(t)
+}
+
+// TestListCustomer_Execute_InvalidSearch checks that invalid search fields are rejected before reading customers
+func TestListCustomer_Execute_InvalidSearch(t *testing.T) {
+	startDate := time.Date(2026, 3, 2, 0, 0, 0, 0, time.UTC)
+	endDate := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name    string
+		search  ports.CustomerSearchDTO
+		message string
+	}{
+		{"NameWithoutWords", ports.CustomerSearchDTO{Name: "* -"}, "Invalid request - name must contain a letter or digit"},
+		{"StartAfterEnd", ports.CustomerSearchDTO{StartDate: &startDate, EndDate: &endDate}, "Invalid request - start date cannot be after end date"},
+		{"UnknownActiveStatus", ports.CustomerSearchDTO{ActiveStatus: "frozen"}, "Invalid request - invalid active status"},
+		{"NegativeBalance", ports.CustomerSearchDTO{MinBalance: "-1"}, "Invalid request - invalid balance"},
+		{"MalformedBalance", ports.CustomerSearchDTO{MinBalance: "ten"}, "Invalid request - invalid balance"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockCustomerRepo := new(mock_repo.MockCustomerRepo)
+			listCustomer := NewListCustomer(mockCustomerRepo)
+
+			result, _, _, _, message, err := listCustomer.Execute(tt.search, 1, 10, "", true, "desc", "req-123")
+
+			assert.ErrorIs(t, err, custom_err.ErrValidationFailed)
+			assert.Equal(t, tt.message, message)
+			assert.Nil(t, result)
+			mockCustomerRepo.AssertNotCalled(t, "ListCustomer")
+		})
+	}
+}
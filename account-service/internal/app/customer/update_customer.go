@@ -0,0 +1,130 @@
+package customer
+
+import (
+	"account-service/internal/domain/entity"
+	custom_err "account-service/internal/domain/error"
+	"account-service/internal/logging"
+	"account-service/internal/messaging"
+	"account-service/internal/observability/metrics"
+	"account-service/internal/ports"
+	"errors"
+	"fmt"
+	"gorm.io/gorm"
+	"strings"
+)
+
+// UpdateCustomer is a use-case for editing the profile of a customer
+type UpdateCustomer struct {
+	CustomerRepo ports.CustomerRepo
+	EventRepo    ports.EventRepo
+}
+
+// NewUpdateCustomer creates a new UpdateCustomer use-case
+func NewUpdateCustomer(customerRepo ports.CustomerRepo, eventRepo ports.EventRepo) *UpdateCustomer {
+	return &UpdateCustomer{
+		CustomerRepo: customerRepo,
+		EventRepo:    eventRepo,
+	}
+}
+
+// Execute applies the non-empty fields of the profile to the customer and records a customer_updated event
+// with the changed fields. Names and national IDs stay unique among valid customers.
+func (c *UpdateCustomer) Execute(id string, profile entity.CustomerProfile, requester, requestId string) (*entity.Customer, string, error) {
+	metrics.IncRequestActive()
+	defer metrics.DecRequestActive()
+
+	var err error
+	defer func() {
+		metrics.RecordOperation("update_customer", err)
+	}()
+
+	id = strings.TrimSpace(id)
+	if id == "" {
+		err = fmt.Errorf("%w: customer ID is required", custom_err.ErrValidationFailed)
+		logging.Logger.Error().Err(err).Msg("missing required value")
+		return nil, "Missing required data", err
+	}
+
+	if requester == "" {
+		err = fmt.Errorf("%w: requester is required", custom_err.ErrValidationFailed)
+		logging.Logger.Error().Err(err).Msg("Unknown requester")
+		return nil, "Unknown requester", err
+	}
+
+	customer, err := c.CustomerRepo.GetCustomerByID(id)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			err = custom_err.ErrCustomerNotFound
+			return nil, "Customer not found", err
+		}
+		logging.Logger.Error().Err(err).Str("customer_id", id).Msg("Failed to get customer")
+		err = custom_err.ErrDatabase
+		return nil, "Failed to get customer", err
+	}
+
+	if customer == nil {
+		err = custom_err.ErrCustomerNotFound
+		return nil, "Customer not found", err
+	}
+
+	changes, err := customer.UpdateProfile(profile, requester)
+	if err != nil {
+		logging.Logger.Warn().Err(err).Str("customer_id", id).Msg("Invalid customer profile")
+		return nil, fmt.Sprintf("Invalid request - %s", err), err
+	}
+
+	if len(changes) == 0 {
+		return customer, "No changes to customer", nil
+	}
+
+	if _, ok := changes["name"]; ok {
+		existing, lookupErr := c.CustomerRepo.GetCustomerByName(customer.Name)
+		if lookupErr == nil && existing != nil && existing.ID != customer.ID {
+			err = custom_err.ErrCustomerExists
+			logging.Logger.Warn().Err(err).Str("customer_id", id).Msg("Customer name taken")
+			return nil, "Customer already exists with the same name", err
+		}
+	}
+
+	if _, ok := changes["national_id"]; ok {
+		existing, lookupErr := c.CustomerRepo.GetCustomerByNationalID(customer.NationalID)
+		if lookupErr != nil {
+			logging.Logger.Error().Err(lookupErr).Str("customer_id", id).Msg("Failed to check national ID")
+			err = custom_err.ErrDatabase
+			return nil, "Failed to update customer", err
+		}
+		if existing != nil && existing.ID != customer.ID {
+			err = custom_err.ErrNationalIDExists
+			logging.Logger.Warn().Err(err).Str("customer_id", id).Msg("National ID taken")
+			return nil, fmt.Sprintf("Invalid request - %s", err), err
+		}
+	}
+
+	if err = c.CustomerRepo.UpdateCustomer(customer); err != nil {
+		logging.Logger.Error().Err(err).Str("customer_id", id).Msg("Failed to update customer")
+		if errors.Is(err, custom_err.ErrConcurrentModification) {
+			return nil, "Customer was modified concurrently, try again", err
+		}
+		err = custom_err.ErrDatabase
+		return nil, "Failed to update customer", err
+	}
+	customer.Version++
+
+	eventData := map[string]interface{}{
+		"customer_id": customer.ID,
+		"changes":     changes,
+		"updated_by":  requester,
+		"request_id":  requestId,
+	}
+
+	event, eventErr := entity.NewEvent(entity.EventTypeCustomerUpdated, customer.ID, entity.EventAggregateTypeCustomer, requester, eventData)
+	if eventErr == nil {
+		if createErr := c.EventRepo.CreateEvent(event); createErr != nil {
+			logging.Logger.Error().Err(createErr).Str("customer_id", customer.ID).Msg("Failed to create customer update event")
+		}
+	}
+
+	logging.Logger.Debug().Str("customer_id", customer.ID).Str("requester", requester).Msg("Customer updated successfully")
+	_ = messaging.GetService().PublishToDefaultTopic(messaging.Message{Content: customer.ToString(), Status: true, Type: messaging.MessageTypeUpdateCustomer})
+	return customer, "Customer updated successfully", nil
+}
@@ -0,0 +1,147 @@
+package customer
+
+import (
+	"account-service/internal/domain/entity"
+	custom_err "account-service/internal/domain/error"
+	mock_repo "account-service/internal/ports/mocks/repo"
+	"encoding/json"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"gorm.io/gorm"
+	"testing"
+)
+
+// TestUpdateCustomer_Execute_Success tests that the profile is saved and the event carries the field diff
+func TestUpdateCustomer_Execute_Success(t *testing.T) {
+	mockCustomerRepo := new(mock_repo.MockCustomerRepo)
+	mockEventRepo := new(mock_repo.MockEventRepo)
+	updateCustomer := NewUpdateCustomer(mockCustomerRepo, mockEventRepo)
+
+	customer := &entity.Customer{ID: "cus-1", Name: "Jane Doe", Version: 3, KYCStatus: entity.CustomerKYCStatusPending, Status: entity.CustomerStatusValid}
+
+	mockCustomerRepo.On("GetCustomerByID", "cus-1").Return(customer, nil)
+	mockCustomerRepo.On("GetCustomerByNationalID", "AB123456").Return(nil, nil)
+	mockCustomerRepo.On("UpdateCustomer", mock.MatchedBy(func(c *entity.Customer) bool {
+		return c.Version == 3 && c.NationalID == "AB123456" && c.Phone == "+4915112345678" && c.UpdatedBy == "editor"
+	})).Return(nil)
+
+	var event *entity.Event
+	mockEventRepo.On("CreateEvent", mock.AnythingOfType("*entity.Event")).Run(func(args mock.Arguments) {
+		event = args.Get(0).(*entity.Event)
+	}).Return(nil)
+
+	result, message, err := updateCustomer.Execute("cus-1", entity.CustomerProfile{NationalID: "ab-123456", Phone: "+49 151 12345678"}, "editor", "req-1")
+
+	assert.NoError(t, err)
+	assert.Equal(t, "Customer updated successfully", message)
+	assert.Equal(t, 4, result.Version)
+
+	assert.Equal(t, entity.EventTypeCustomerUpdated, event.Type)
+	var data struct {
+		Changes map[string]entity.FieldChange `json:"changes"`
+	}
+	assert.NoError(t, json.Unmarshal(event.Data, &data))
+	assert.Equal(t, map[string]entity.FieldChange{
+		"national_id": {From: "", To: "AB123456"},
+		"phone":       {From: "", To: "+4915112345678"},
+	}, data.Changes)
+
+	mockCustomerRepo.AssertExpectations(t)
+	mockEventRepo.AssertExpectations(t)
+}
+
+// TestUpdateCustomer_Execute_NoChanges tests that an update without changes saves nothing and records no event
+func TestUpdateCustomer_Execute_NoChanges(t *testing.T) {
+	mockCustomerRepo := new(mock_repo.MockCustomerRepo)
+	mockEventRepo := new(mock_repo.MockEventRepo)
+	updateCustomer := NewUpdateCustomer(mockCustomerRepo, mockEventRepo)
+
+	customer := &entity.Customer{ID: "cus-1", Name: "Jane Doe", KYCStatus: entity.CustomerKYCStatusPending}
+	mockCustomerRepo.On("GetCustomerByID", "cus-1").Return(customer, nil)
+
+	result, message, err := updateCustomer.Execute("cus-1", entity.CustomerProfile{Name: " Jane  Doe "}, "editor", "req-1")
+
+	assert.NoError(t, err)
+	assert.Equal(t, "No changes to customer", message)
+	assert.Equal(t, customer, result)
+	mockCustomerRepo.AssertNotCalled(t, "UpdateCustomer", mock.Anything)
+	mockEventRepo.AssertNotCalled(t, "CreateEvent", mock.Anything)
+}
+
+// TestUpdateCustomer_Execute_NationalIDTaken tests that a national ID of another customer is rejected
+func TestUpdateCustomer_Execute_NationalIDTaken(t *testing.T) {
+	mockCustomerRepo := new(mock_repo.MockCustomerRepo)
+	mockEventRepo := new(mock_repo.MockEventRepo)
+	updateCustomer := NewUpdateCustomer(mockCustomerRepo, mockEventRepo)
+
+	mockCustomerRepo.On("GetCustomerByID", "cus-1").Return(&entity.Customer{ID: "cus-1", Name: "Jane Doe"}, nil)
+	mockCustomerRepo.On("GetCustomerByNationalID", "AB123456").Return(&entity.Customer{ID: "cus-2"}, nil)
+
+	result, message, err := updateCustomer.Execute("cus-1", entity.CustomerProfile{NationalID: "AB123456"}, "editor", "req-1")
+
+	assert.ErrorIs(t, err, custom_err.ErrNationalIDExists)
+	assert.Equal(t, "Invalid request - national ID belongs to another customer", message)
+	assert.Nil(t, result)
+	mockCustomerRepo.AssertNotCalled(t, "UpdateCustomer", mock.Anything)
+}
+
+// TestUpdateCustomer_Execute_NameTaken tests that a customer cannot be renamed to the name of another customer
+func TestUpdateCustomer_Execute_NameTaken(t *testing.T) {
+	mockCustomerRepo := new(mock_repo.MockCustomerRepo)
+	mockEventRepo := new(mock_repo.MockEventRepo)
+	updateCustomer := NewUpdateCustomer(mockCustomerRepo, mockEventRepo)
+
+	mockCustomerRepo.On("GetCustomerByID", "cus-1").Return(&entity.Customer{ID: "cus-1", Name: "Jane Doe"}, nil)
+	mockCustomerRepo.On("GetCustomerByName", "John Doe").Return(&entity.Customer{ID: "cus-2", Name: "John Doe"}, nil)
+
+	_, message, err := updateCustomer.Execute("cus-1", entity.CustomerProfile{Name: "John Doe"}, "editor", "req-1")
+
+	assert.ErrorIs(t, err, custom_err.ErrCustomerExists)
+	assert.Equal(t, "Customer already exists with the same name", message)
+	mockCustomerRepo.AssertNotCalled(t, "UpdateCustomer", mock.Anything)
+}
+
+// TestUpdateCustomer_Execute_InvalidProfile tests that validation errors are returned as invalid requests
+func TestUpdateCustomer_Execute_InvalidProfile(t *testing.T) {
+	mockCustomerRepo := new(mock_repo.MockCustomerRepo)
+	mockEventRepo := new(mock_repo.MockEventRepo)
+	updateCustomer := NewUpdateCustomer(mockCustomerRepo, mockEventRepo)
+
+	mockCustomerRepo.On("GetCustomerByID", "cus-1").Return(&entity.Customer{ID: "cus-1", Name: "Jane Doe"}, nil)
+
+	_, message, err := updateCustomer.Execute("cus-1", entity.CustomerProfile{KYCStatus: "verified"}, "editor", "req-1")
+
+	assert.ErrorIs(t, err, custom_err.ErrIncompleteKYCProfile)
+	assert.Equal(t, "Invalid request - date of birth, national ID and address are required to verify a customer", message)
+	mockCustomerRepo.AssertNotCalled(t, "UpdateCustomer", mock.Anything)
+}
+
+// TestUpdateCustomer_Execute_ConcurrentModification tests that a lost version race asks for a retry
+func TestUpdateCustomer_Execute_ConcurrentModification(t *testing.T) {
+	mockCustomerRepo := new(mock_repo.MockCustomerRepo)
+	mockEventRepo := new(mock_repo.MockEventRepo)
+	updateCustomer := NewUpdateCustomer(mockCustomerRepo, mockEventRepo)
+
+	mockCustomerRepo.On("GetCustomerByID", "cus-1").Return(&entity.Customer{ID: "cus-1", Name: "Jane Doe"}, nil)
+	mockCustomerRepo.On("UpdateCustomer", mock.Anything).Return(custom_err.ErrConcurrentModification)
+
+	_, message, err := updateCustomer.Execute("cus-1", entity.CustomerProfile{Email: "jane@example.com"}, "editor", "req-1")
+
+	assert.ErrorIs(t, err, custom_err.ErrConcurrentModification)
+	assert.Equal(t, "Customer was modified concurrently, try again", message)
+	mockEventRepo.AssertNotCalled(t, "CreateEvent", mock.Anything)
+}
+
+// TestUpdateCustomer_Execute_NotFound tests that an unknown customer is reported as not found
+func TestUpdateCustomer_Execute_NotFound(t *testing.T) {
+	mockCustomerRepo := new(mock_repo.MockCustomerRepo)
+	mockEventRepo := new(mock_repo.MockEventRepo)
+	updateCustomer := NewUpdateCustomer(mockCustomerRepo, mockEventRepo)
+
+	mockCustomerRepo.On("GetCustomerByID", "cus-x").Return(nil, gorm.ErrRecordNotFound)
+
+	_, message, err := updateCustomer.Execute("cus-x", entity.CustomerProfile{Email: "jane@example.com"}, "editor", "req-1")
+
+	assert.ErrorIs(t, err, custom_err.ErrCustomerNotFound)
+	assert.Equal(t, "Customer not found", message)
+}
@@ -0,0 +1,58 @@
+package hold
+
+import (
+	"account-service/internal/domain/entity"
+	custom_err "account-service/internal/domain/error"
+	"account-service/internal/logging"
+	"account-service/internal/observability/metrics"
+	"account-service/internal/ports"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// GetHold is a use-case for getting a hold
+type GetHold struct {
+	HoldRepo ports.HoldRepo
+}
+
+// NewGetHold creates a new GetHold use-case
+func NewGetHold(holdRepo ports.HoldRepo) *GetHold {
+	return &GetHold{
+		HoldRepo: holdRepo,
+	}
+}
+
+// Execute gets the hold, a hold past its expiry is reported as expired
+func (g *GetHold) Execute(holdID, requester, requestId string) (*entity.Hold, string, error) {
+	metrics.IncRequestActive()
+	defer metrics.DecRequestActive()
+
+	var err error
+	defer func() {
+		metrics.RecordOperation("get_hold", err)
+	}()
+
+	holdID = strings.TrimSpace(holdID)
+	if holdID == "" {
+		err = fmt.Errorf("%w: hold ID is required", custom_err.ErrValidationFailed)
+		logging.Logger.Error().Err(err).Msg("Required missing fields")
+		err = custom_err.ErrValidationFailed
+		return nil, fmt.Sprintf("%s: hold ID is required", custom_err.ErrValidationFailed), err
+	}
+
+	hold, err := g.HoldRepo.GetHoldByID(holdID)
+	if err != nil {
+		logging.Logger.Error().Err(err).Str("hold_id", holdID).Str("request_id", requestId).Msg("Failed to get hold")
+		err = custom_err.ErrDatabase
+		return nil, "Failed to get hold", err
+	}
+
+	if hold == nil {
+		err = custom_err.ErrHoldNotFound
+		return nil, "Hold not found", err
+	}
+
+	hold.RefreshStatus(time.Now())
+	return hold, "Hold details", nil
+}
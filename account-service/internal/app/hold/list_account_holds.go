@@ -0,0 +1,81 @@
+package hold
+
+import (
+	"account-service/internal/domain/entity"
+	custom_err "account-service/internal/domain/error"
+	"account-service/internal/logging"
+	"account-service/internal/observability/metrics"
+	"account-service/internal/ports"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// ListAccountHolds is a use-case for listing the holds of an account
+type ListAccountHolds struct {
+	AccountRepo ports.AccountRepo
+	HoldRepo    ports.HoldRepo
+}
+
+// NewListAccountHolds creates a new ListAccountHolds use-case
+func NewListAccountHolds(accountRepo ports.AccountRepo, holdRepo ports.HoldRepo) *ListAccountHolds {
+	return &ListAccountHolds{
+		AccountRepo: accountRepo,
+		HoldRepo:    holdRepo,
+	}
+}
+
+func (l *ListAccountHolds) Execute(accountID string, page, pageSize int, requester, requestId string) ([]*entity.Hold, int64, int64, string, error) {
+	metrics.IncRequestActive()
+	defer metrics.DecRequestActive()
+
+	var err error
+	defer func() {
+		metrics.RecordOperation("list_account_holds", err)
+	}()
+
+	if strings.TrimSpace(accountID) == "" {
+		err = fmt.Errorf("%w: 'id' - account id required in param", custom_err.ErrValidationFailed)
+		logging.Logger.Error().Err(err).Msg("Invalid request - 'id' account id missing")
+		return nil, 0, 0, "Invalid request - 'id' account id missing", err
+	}
+
+	if page < 1 {
+		page = 1
+	}
+	if pageSize < 1 || pageSize > 100 {
+		pageSize = 100
+	}
+
+	account, err := l.AccountRepo.GetAccountByID(accountID)
+	if err != nil {
+		logging.Logger.Error().Err(err).Str("account_id", accountID).Msg("Failed to verify account")
+		err = fmt.Errorf("%w: failed to verify account", custom_err.ErrDatabase)
+		return nil, 0, 0, "Failed to verify account", err
+	}
+
+	if account == nil {
+		err = custom_err.ErrAccountNotFound
+		logging.Logger.Error().Err(err).Str("account_id", accountID).Msg("Account not found")
+		return nil, 0, 0, "Account not found", err
+	}
+
+	holds, totalCount, err := l.HoldRepo.GetHoldsByAccountID(accountID, page, pageSize)
+	if err != nil {
+		logging.Logger.Error().Err(err).Str("account_id", accountID).Msg("Failed to get account holds")
+		err = fmt.Errorf("%w: failed to get account holds", custom_err.ErrDatabase)
+		return nil, 0, 0, "Failed to get account holds", err
+	}
+
+	now := time.Now()
+	for _, hold := range holds {
+		hold.RefreshStatus(now)
+	}
+
+	totalPages := int64(0)
+	if totalCount > 0 {
+		totalPages = (totalCount + int64(pageSize) - 1) / int64(pageSize)
+	}
+
+	return holds, totalCount, totalPages, "Account holds", nil
+}
@@ -0,0 +1,115 @@
+package hold
+
+import (
+	"account-service/internal/config"
+	"account-service/internal/domain/entity"
+	custom_err "account-service/internal/domain/error"
+	"account-service/internal/domain/money"
+	"account-service/internal/logging"
+	"account-service/internal/messaging"
+	"account-service/internal/observability/metrics"
+	"account-service/internal/ports"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// PlaceHold is a use-case for earmarking funds of an account
+type PlaceHold struct {
+	AccountRepo ports.AccountRepo
+	HoldRepo    ports.HoldRepo
+	EventRepo   ports.EventRepo
+}
+
+// NewPlaceHold creates a new PlaceHold use-case
+func NewPlaceHold(accountRepo ports.AccountRepo, holdRepo ports.HoldRepo, eventRepo ports.EventRepo) *PlaceHold {
+	return &PlaceHold{
+		AccountRepo: accountRepo,
+		HoldRepo:    holdRepo,
+		EventRepo:   eventRepo,
+	}
+}
+
+// Execute places a hold on the account for the amount. The hold expires at expiresAt, or after the
+// configured default expiry when it is zero.
+func (p *PlaceHold) Execute(accountID string, amount money.Amount, reason string, expiresAt time.Time, requester, requestId string) (*entity.Hold, string, error) {
+	metrics.IncRequestActive()
+	defer metrics.DecRequestActive()
+
+	var err error
+	defer func() {
+		metrics.RecordOperation("place_hold", err)
+	}()
+
+	accountID = strings.TrimSpace(accountID)
+	if accountID == "" {
+		err = fmt.Errorf("%w: account ID is required", custom_err.ErrValidationFailed)
+		logging.Logger.Error().Err(err).Msg("Required missing fields")
+		err = custom_err.ErrValidationFailed
+		return nil, fmt.Sprintf("%s: account ID is required", custom_err.ErrValidationFailed), err
+	}
+
+	if requester == "" {
+		err = fmt.Errorf("%w: requester not found", custom_err.ErrUnauthorizedRequest)
+		logging.Logger.Error().Err(err).Msg("Unknown requester")
+		err = custom_err.ErrUnauthorizedRequest
+		return nil, fmt.Sprintf("%s: requester not found", custom_err.ErrUnauthorizedRequest), err
+	}
+
+	if expiresAt.IsZero() {
+		expiresAt = time.Now().Add(config.Current().AccountConfig.DefaultHoldExpiry)
+	}
+
+	account, err := p.AccountRepo.GetAccountByID(accountID)
+	if err != nil {
+		logging.Logger.Error().Err(err).Str("account_id", accountID).Msg("Failed to verify account")
+		err = custom_err.ErrDatabase
+		return nil, "Failed to verify account", err
+	}
+
+	if account == nil {
+		err = custom_err.ErrAccountNotFound
+		logging.Logger.Error().Err(err).Str("account_id", accountID).Msg("Account not found")
+		return nil, "Account not found", err
+	}
+
+	hold, err := entity.NewHold(account, amount, strings.TrimSpace(reason), expiresAt, requester)
+	if err != nil {
+		logging.Logger.Error().Err(err).Str("account_id", accountID).Msg("Invalid hold")
+		return nil, err.Error(), err
+	}
+
+	if err = p.HoldRepo.CreateHold(hold); err != nil {
+		logging.Logger.Error().Err(err).Str("account_id", accountID).Str("amount", amount.String()).Msg("Failed to place hold")
+		switch {
+		case errors.Is(err, custom_err.ErrInsufficientBalance):
+			return nil, "Account has insufficient available balance", err
+		case errors.Is(err, custom_err.ErrAccountLocked):
+			return nil, "Account is in a transaction, try again", err
+		case errors.Is(err, custom_err.ErrAccountNotFound):
+			return nil, "Account not found", err
+		}
+		err = custom_err.ErrDatabase
+		return nil, "Failed to place hold", err
+	}
+
+	eventData := map[string]interface{}{
+		"hold_id":    hold.ID,
+		"account_id": hold.AccountID,
+		"amount":     hold.Amount,
+		"expires_at": hold.ExpiresAt,
+		"reason":     hold.Reason,
+		"created_by": requester,
+		"request_id": requestId,
+	}
+
+	event, eventErr := entity.NewEvent(entity.EventTypeHoldPlaced, hold.ID, entity.EventAggregateTypeHold, requester, eventData)
+	if eventErr == nil {
+		if createErr := p.EventRepo.CreateEvent(event); createErr != nil {
+			logging.Logger.Error().Err(createErr).Str("hold_id", hold.ID).Str("account_id", hold.AccountID).Msg("Failed to create hold placed event")
+		}
+	}
+	_ = messaging.GetService().PublishToDefaultTopic(messaging.Message{Content: hold.ToString(), Status: true, Type: messaging.MessageTypePlaceHold})
+	return hold, "Hold successfully placed", nil
+}
@@ -0,0 +1,85 @@
+package hold
+
+import (
+	"account-service/internal/domain/entity"
+	custom_err "account-service/internal/domain/error"
+	"account-service/internal/domain/money"
+	mock_repo "account-service/internal/ports/mocks/repo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"testing"
+	"time"
+)
+
+// TestPlaceHold_Execute_Success tests placing a hold on an account
+func TestPlaceHold_Execute_Success(t *testing.T) {
+	mockAccountRepo := new(mock_repo.MockAccountRepo)
+	mockHoldRepo := new(mock_repo.MockHoldRepo)
+	mockEventRepo := new(mock_repo.MockEventRepo)
+
+	placeHold := NewPlaceHold(mockAccountRepo, mockHoldRepo, mockEventRepo)
+
+	account := &entity.Account{ID: "acc-123", Currency: "USD", Balance: money.MustParse("500.00")}
+	expiresAt := time.Now().Add(24 * time.Hour)
+
+	mockAccountRepo.On("GetAccountByID", "acc-123").Return(account, nil)
+	mockHoldRepo.On("CreateHold", mock.MatchedBy(func(hold *entity.Hold) bool {
+		return hold.AccountID == "acc-123" && hold.Amount == money.MustParse("120.00") && hold.Status == entity.HoldStatusActive
+	})).Return(nil)
+	mockEventRepo.On("CreateEvent", mock.MatchedBy(func(event *entity.Event) bool {
+		return event.Type == entity.EventTypeHoldPlaced
+	})).Return(nil)
+
+	hold, message, err := placeHold.Execute("acc-123", money.MustParse("120.00"), " cheque 1001 ", expiresAt, "teller", "req-456")
+
+	assert.NoError(t, err)
+	assert.Equal(t, "Hold successfully placed", message)
+	assert.Equal(t, "USD", hold.Currency)
+	assert.Equal(t, "cheque 1001", hold.Reason)
+	assert.Equal(t, expiresAt.UTC(), hold.ExpiresAt)
+	assert.Equal(t, "teller", hold.CreatedBy)
+
+	mockAccountRepo.AssertExpectations(t)
+	mockHoldRepo.AssertExpectations(t)
+	mockEventRepo.AssertExpectations(t)
+}
+
+// TestPlaceHold_Execute_InsufficientAvailableBalance tests that a hold cannot exceed the available balance
+func TestPlaceHold_Execute_InsufficientAvailableBalance(t *testing.T) {
+	mockAccountRepo := new(mock_repo.MockAccountRepo)
+	mockHoldRepo := new(mock_repo.MockHoldRepo)
+	mockEventRepo := new(mock_repo.MockEventRepo)
+
+	placeHold := NewPlaceHold(mockAccountRepo, mockHoldRepo, mockEventRepo)
+
+	mockAccountRepo.On("GetAccountByID", "acc-123").Return(&entity.Account{ID: "acc-123"}, nil)
+	mockHoldRepo.On("CreateHold", mock.Anything).Return(custom_err.ErrInsufficientBalance)
+
+	hold, message, err := placeHold.Execute("acc-123", money.MustParse("120.00"), "", time.Now().Add(time.Hour), "teller", "req-456")
+
+	assert.ErrorIs(t, err, custom_err.ErrInsufficientBalance)
+	assert.Equal(t, "Account has insufficient available balance", message)
+	assert.Nil(t, hold)
+	mockEventRepo.AssertNotCalled(t, "CreateEvent", mock.Anything)
+}
+
+// TestPlaceHold_Execute_InvalidInput tests that the amount must be positive and the expiry in the future
+func TestPlaceHold_Execute_InvalidInput(t *testing.T) {
+	mockAccountRepo := new(mock_repo.MockAccountRepo)
+	mockHoldRepo := new(mock_repo.MockHoldRepo)
+
+	placeHold := NewPlaceHold(mockAccountRepo, mockHoldRepo, new(mock_repo.MockEventRepo))
+
+	mockAccountRepo.On("GetAccountByID", "acc-123").Return(&entity.Account{ID: "acc-123"}, nil)
+
+	_, _, err := placeHold.Execute("acc-123", money.Zero, "", time.Now().Add(time.Hour), "teller", "req-456")
+	assert.ErrorIs(t, err, custom_err.ErrInvalidAmount)
+
+	_, _, err = placeHold.Execute("acc-123", money.MustParse("10.00"), "", time.Now().Add(-time.Hour), "teller", "req-456")
+	assert.ErrorIs(t, err, custom_err.ErrInvalidHoldExpiry)
+
+	_, _, err = placeHold.Execute("acc-123", money.MustParse("10.00"), "", time.Now().Add(time.Hour), "", "req-456")
+	assert.ErrorIs(t, err, custom_err.ErrUnauthorizedRequest)
+
+	mockHoldRepo.AssertNotCalled(t, "CreateHold", mock.Anything)
+}
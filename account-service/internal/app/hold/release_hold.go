@@ -0,0 +1,99 @@
+package hold
+
+import (
+	"account-service/internal/domain/entity"
+	custom_err "account-service/internal/domain/error"
+	"account-service/internal/logging"
+	"account-service/internal/messaging"
+	"account-service/internal/observability/metrics"
+	"account-service/internal/ports"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// ReleaseHold is a use-case for freeing the funds of a hold without moving money
+type ReleaseHold struct {
+	HoldRepo  ports.HoldRepo
+	EventRepo ports.EventRepo
+}
+
+// NewReleaseHold creates a new ReleaseHold use-case
+func NewReleaseHold(holdRepo ports.HoldRepo, eventRepo ports.EventRepo) *ReleaseHold {
+	return &ReleaseHold{
+		HoldRepo:  holdRepo,
+		EventRepo: eventRepo,
+	}
+}
+
+// Execute releases an active hold
+func (r *ReleaseHold) Execute(holdID, requester, requestId string) (*entity.Hold, string, error) {
+	metrics.IncRequestActive()
+	defer metrics.DecRequestActive()
+
+	var err error
+	defer func() {
+		metrics.RecordOperation("release_hold", err)
+	}()
+
+	holdID = strings.TrimSpace(holdID)
+	if holdID == "" {
+		err = fmt.Errorf("%w: hold ID is required", custom_err.ErrValidationFailed)
+		logging.Logger.Error().Err(err).Msg("Required missing fields")
+		err = custom_err.ErrValidationFailed
+		return nil, fmt.Sprintf("%s: hold ID is required", custom_err.ErrValidationFailed), err
+	}
+
+	if requester == "" {
+		err = fmt.Errorf("%w: requester not found", custom_err.ErrUnauthorizedRequest)
+		logging.Logger.Error().Err(err).Msg("Unknown requester")
+		err = custom_err.ErrUnauthorizedRequest
+		return nil, fmt.Sprintf("%s: requester not found", custom_err.ErrUnauthorizedRequest), err
+	}
+
+	hold, err := r.HoldRepo.GetHoldByID(holdID)
+	if err != nil {
+		logging.Logger.Error().Err(err).Str("hold_id", holdID).Msg("Failed to get hold")
+		err = custom_err.ErrDatabase
+		return nil, "Failed to get hold", err
+	}
+
+	if hold == nil {
+		err = custom_err.ErrHoldNotFound
+		logging.Logger.Error().Err(err).Str("hold_id", holdID).Msg("Hold not found")
+		return nil, "Hold not found", err
+	}
+
+	hold.RefreshStatus(time.Now())
+	if err = hold.Release(requester); err != nil {
+		logging.Logger.Warn().Err(err).Str("hold_id", holdID).Str("hold_status", hold.Status).Msg("Hold cannot be released")
+		return nil, fmt.Sprintf("Hold cannot be released, it is %s", hold.Status), err
+	}
+
+	if err = r.HoldRepo.UpdateHoldStatus(hold, entity.HoldStatusActive); err != nil {
+		logging.Logger.Error().Err(err).Str("hold_id", holdID).Msg("Failed to release hold")
+		if errors.Is(err, custom_err.ErrConcurrentModification) {
+			return nil, "Hold was modified concurrently, try again", err
+		}
+		err = custom_err.ErrDatabase
+		return nil, "Failed to release hold", err
+	}
+
+	eventData := map[string]interface{}{
+		"hold_id":     hold.ID,
+		"account_id":  hold.AccountID,
+		"amount":      hold.Amount,
+		"released_by": requester,
+		"request_id":  requestId,
+	}
+
+	event, eventErr := entity.NewEvent(entity.EventTypeHoldReleased, hold.ID, entity.EventAggregateTypeHold, requester, eventData)
+	if eventErr == nil {
+		if createErr := r.EventRepo.CreateEvent(event); createErr != nil {
+			logging.Logger.Error().Err(createErr).Str("hold_id", hold.ID).Str("account_id", hold.AccountID).Msg("Failed to create hold released event")
+		}
+	}
+	_ = messaging.GetService().PublishToDefaultTopic(messaging.Message{Content: hold.ToString(), Status: true, Type: messaging.MessageTypeReleaseHold})
+	return hold, "Hold successfully released", nil
+}
@@ -0,0 +1,80 @@
+package hold
+
+import (
+	"account-service/internal/domain/entity"
+	custom_err "account-service/internal/domain/error"
+	"account-service/internal/domain/money"
+	mock_repo "account-service/internal/ports/mocks/repo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"testing"
+	"time"
+)
+
+// TestReleaseHold_Execute_Success tests releasing an active hold
+func TestReleaseHold_Execute_Success(t *testing.T) {
+	mockHoldRepo := new(mock_repo.MockHoldRepo)
+	mockEventRepo := new(mock_repo.MockEventRepo)
+
+	releaseHold := NewReleaseHold(mockHoldRepo, mockEventRepo)
+
+	hold := &entity.Hold{
+		ID:        "hold-1",
+		AccountID: "acc-123",
+		Amount:    money.MustParse("50.00"),
+		Status:    entity.HoldStatusActive,
+		ExpiresAt: time.Now().Add(time.Hour),
+	}
+
+	mockHoldRepo.On("GetHoldByID", "hold-1").Return(hold, nil)
+	mockHoldRepo.On("UpdateHoldStatus", hold, entity.HoldStatusActive).Return(nil)
+	mockEventRepo.On("CreateEvent", mock.MatchedBy(func(event *entity.Event) bool {
+		return event.Type == entity.EventTypeHoldReleased && event.AggregateID == "hold-1"
+	})).Return(nil)
+
+	released, message, err := releaseHold.Execute("hold-1", "teller", "req-456")
+
+	assert.NoError(t, err)
+	assert.Equal(t, "Hold successfully released", message)
+	assert.Equal(t, entity.HoldStatusReleased, released.Status)
+	assert.Equal(t, "teller", released.UpdatedBy)
+
+	mockHoldRepo.AssertExpectations(t)
+	mockEventRepo.AssertExpectations(t)
+}
+
+// TestReleaseHold_Execute_Expired tests that an expired hold cannot be released
+func TestReleaseHold_Execute_Expired(t *testing.T) {
+	mockHoldRepo := new(mock_repo.MockHoldRepo)
+
+	releaseHold := NewReleaseHold(mockHoldRepo, new(mock_repo.MockEventRepo))
+
+	hold := &entity.Hold{
+		ID:        "hold-1",
+		Status:    entity.HoldStatusActive,
+		ExpiresAt: time.Now().Add(-time.Minute),
+	}
+	mockHoldRepo.On("GetHoldByID", "hold-1").Return(hold, nil)
+
+	released, message, err := releaseHold.Execute("hold-1", "teller", "req-456")
+
+	assert.ErrorIs(t, err, custom_err.ErrHoldNotActive)
+	assert.Equal(t, "Hold cannot be released, it is expired", message)
+	assert.Nil(t, released)
+	mockHoldRepo.AssertNotCalled(t, "UpdateHoldStatus", mock.Anything, mock.Anything)
+}
+
+// TestReleaseHold_Execute_NotFound tests releasing an unknown hold
+func TestReleaseHold_Execute_NotFound(t *testing.T) {
+	mockHoldRepo := new(mock_repo.MockHoldRepo)
+
+	releaseHold := NewReleaseHold(mockHoldRepo, new(mock_repo.MockEventRepo))
+
+	mockHoldRepo.On("GetHoldByID", "hold-404").Return(nil, nil)
+
+	released, message, err := releaseHold.Execute("hold-404", "teller", "req-456")
+
+	assert.ErrorIs(t, err, custom_err.ErrHoldNotFound)
+	assert.Equal(t, "Hold not found", message)
+	assert.Nil(t, released)
+}
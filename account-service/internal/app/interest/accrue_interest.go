@@ -0,0 +1,124 @@
+package interest
+
+import (
+	"account-service/internal/config"
+	"account-service/internal/domain/entity"
+	custom_err "account-service/internal/domain/error"
+	"account-service/internal/logging"
+	"account-service/internal/messaging"
+	"account-service/internal/observability/metrics"
+	"account-service/internal/ports"
+	"fmt"
+	"time"
+)
+
+// InterestRequester is recorded as the creator of system interest events
+const InterestRequester = "system"
+
+// defaultDaysInYear is used when the day count convention is not configured
+const defaultDaysInYear = 365
+
+// AccrueInterest is a use-case for accruing one day of interest on every account and closing the month
+// on its last day
+type AccrueInterest struct {
+	InterestRepo ports.InterestRepo
+	EventRepo    ports.EventRepo
+}
+
+// NewAccrueInterest creates a new AccrueInterest use-case
+func NewAccrueInterest(interestRepo ports.InterestRepo, eventRepo ports.EventRepo) *AccrueInterest {
+	return &AccrueInterest{
+		InterestRepo: interestRepo,
+		EventRepo:    eventRepo,
+	}
+}
+
+// Execute accrues the interest of the UTC day on the balance each account closed the day with. Accruals are
+// unique per account and day, so running a day again never accrues twice. On the last day of a month the
+// accruals of the month are closed into pending postings.
+func (a *AccrueInterest) Execute(day time.Time) (int, string, error) {
+	var err error
+	defer func() {
+		metrics.RecordOperation("accrue_interest", err)
+	}()
+
+	dayStart := time.Date(day.Year(), day.Month(), day.Day(), 0, 0, 0, 0, time.UTC)
+	accrualDate := dayStart.Format(entity.InterestAccrualDateLayout)
+
+	rates, err := a.InterestRepo.ListInterestRates()
+	if err != nil {
+		logging.Logger.Error().Err(err).Str("accrual_date", accrualDate).Msg("Failed to get interest rates")
+		err = custom_err.ErrDatabase
+		return 0, "Failed to get interest rates", err
+	}
+	interestRates := entity.NewInterestRates(rates)
+
+	accounts, err := a.InterestRepo.GetAccountsWithBalanceAt(dayStart.AddDate(0, 0, 1))
+	if err != nil {
+		logging.Logger.Error().Err(err).Str("accrual_date", accrualDate).Msg("Failed to get account balances")
+		err = custom_err.ErrDatabase
+		return 0, "Failed to get account balances", err
+	}
+
+	daysInYear := config.Current().Interest.DaysInYear
+	if daysInYear <= 0 {
+		daysInYear = defaultDaysInYear
+	}
+	var accruals []*entity.InterestAccrual
+	for _, account := range accounts {
+		rate := interestRates.RateFor(account.ID, account.AccountType)
+		if accrual := entity.NewInterestAccrual(account, dayStart, account.Balance, rate, daysInYear); accrual != nil {
+			accruals = append(accruals, accrual)
+		}
+	}
+
+	run := &entity.InterestAccrualRun{
+		AccrualDate:  accrualDate,
+		AccountCount: len(accruals),
+		CreatedAt:    time.Now(),
+	}
+	if err = a.InterestRepo.RecordAccrualRun(run, accruals); err != nil {
+		logging.Logger.Error().Err(err).Str("accrual_date", accrualDate).Msg("Failed to record interest accruals")
+		err = custom_err.ErrDatabase
+		return 0, "Failed to record interest accruals", err
+	}
+
+	eventData := map[string]interface{}{
+		"accrual_date":  accrualDate,
+		"account_count": len(accruals),
+	}
+	a.createEvent(entity.EventTypeInterestAccrued, accrualDate, eventData)
+
+	if !entity.IsLastDayOfMonth(dayStart) {
+		return len(accruals), "Interest accrued", nil
+	}
+
+	period := dayStart.Format(entity.InterestPeriodLayout)
+	postings, err := a.InterestRepo.CloseInterestPeriod(period, accrualDate)
+	if err != nil {
+		logging.Logger.Error().Err(err).Str("period", period).Msg("Failed to close interest period")
+		err = custom_err.ErrDatabase
+		return len(accruals), fmt.Sprintf("Failed to close interest period %s", period), err
+	}
+
+	eventData = map[string]interface{}{
+		"period":        period,
+		"posting_count": len(postings),
+	}
+	a.createEvent(entity.EventTypeInterestPeriodClosed, period, eventData)
+	for _, posting := range postings {
+		_ = messaging.GetService().PublishToDefaultTopic(messaging.Message{Content: posting.ToString(), Status: true, Type: messaging.MessageTypeCloseInterest})
+	}
+
+	return len(accruals), fmt.Sprintf("Interest accrued and period %s closed", period), nil
+}
+
+func (a *AccrueInterest) createEvent(eventType, aggregateID string, eventData map[string]interface{}) {
+	event, eventErr := entity.NewEvent(eventType, aggregateID, entity.EventAggregateTypeInterest, InterestRequester, eventData)
+	if eventErr != nil {
+		return
+	}
+	if createErr := a.EventRepo.CreateEvent(event); createErr != nil {
+		logging.Logger.Error().Err(createErr).Str("event_type", eventType).Msg("Failed to create interest event")
+	}
+}
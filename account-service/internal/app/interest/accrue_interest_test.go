@@ -0,0 +1,71 @@
+package interest
+
+import (
+	"account-service/internal/domain/entity"
+	"account-service/internal/domain/money"
+	mock_repo "account-service/internal/ports/mocks/repo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"testing"
+	"time"
+)
+
+// TestAccrueInterest_Execute_Success tests accruing a day of interest at the rate of each account
+func TestAccrueInterest_Execute_Success(t *testing.T) {
+	mockInterestRepo := new(mock_repo.MockInterestRepo)
+	mockEventRepo := new(mock_repo.MockEventRepo)
+
+	accrueInterest := NewAccrueInterest(mockInterestRepo, mockEventRepo)
+
+	day := time.Date(2026, time.March, 10, 15, 30, 0, 0, time.UTC)
+	mockInterestRepo.On("ListInterestRates").Return([]*entity.InterestRate{
+		{Scope: entity.InterestRateScopeAccountType, Subject: entity.AccountTypeSavings, AnnualRate: money.MustParseInterestRate("3.65")},
+	}, nil)
+	mockInterestRepo.On("GetAccountsWithBalanceAt", time.Date(2026, time.March, 11, 0, 0, 0, 0, time.UTC)).Return([]*entity.Account{
+		{ID: "acc-savings", AccountType: entity.AccountTypeSavings, Currency: "USD", Balance: money.MustParse("100.00")},
+		{ID: "acc-current", AccountType: entity.AccountTypeCurrent, Currency: "USD", Balance: money.MustParse("100.00")},
+		{ID: "acc-overdrawn", AccountType: entity.AccountTypeSavings, Currency: "USD", Balance: money.MustParse("-5.00")},
+	}, nil)
+	mockInterestRepo.On("RecordAccrualRun", mock.MatchedBy(func(run *entity.InterestAccrualRun) bool {
+		return run.AccrualDate == "2026-03-10" && run.AccountCount == 1
+	}), mock.MatchedBy(func(accruals []*entity.InterestAccrual) bool {
+		return len(accruals) == 1 && accruals[0].AccountID == "acc-savings" && accruals[0].Amount == 1_000_000
+	})).Return(nil)
+	mockEventRepo.On("CreateEvent", mock.MatchedBy(func(event *entity.Event) bool {
+		return event.Type == entity.EventTypeInterestAccrued
+	})).Return(nil)
+
+	count, message, err := accrueInterest.Execute(day)
+
+	assert.NoError(t, err)
+	assert.Equal(t, 1, count)
+	assert.Equal(t, "Interest accrued", message)
+	mockInterestRepo.AssertNotCalled(t, "CloseInterestPeriod", mock.Anything, mock.Anything)
+	mockInterestRepo.AssertExpectations(t)
+	mockEventRepo.AssertExpectations(t)
+}
+
+// TestAccrueInterest_Execute_ClosesMonth tests that the last day of a month closes the period into postings
+func TestAccrueInterest_Execute_ClosesMonth(t *testing.T) {
+	mockInterestRepo := new(mock_repo.MockInterestRepo)
+	mockEventRepo := new(mock_repo.MockEventRepo)
+
+	accrueInterest := NewAccrueInterest(mockInterestRepo, mockEventRepo)
+
+	mockInterestRepo.On("ListInterestRates").Return([]*entity.InterestRate{}, nil)
+	mockInterestRepo.On("GetAccountsWithBalanceAt", mock.Anything).Return([]*entity.Account{}, nil)
+	mockInterestRepo.On("RecordAccrualRun", mock.Anything, mock.Anything).Return(nil)
+	mockInterestRepo.On("CloseInterestPeriod", "2026-04", "2026-04-30").Return([]*entity.InterestPosting{
+		entity.NewInterestPosting("acc-savings", "USD", "2026-04", 30*1_000_000),
+	}, nil)
+	mockEventRepo.On("CreateEvent", mock.Anything).Return(nil)
+
+	_, message, err := accrueInterest.Execute(time.Date(2026, time.April, 30, 0, 0, 0, 0, time.UTC))
+
+	assert.NoError(t, err)
+	assert.Equal(t, "Interest accrued and period 2026-04 closed", message)
+	mockInterestRepo.AssertExpectations(t)
+	mockEventRepo.AssertCalled(t, "CreateEvent", mock.MatchedBy(func(event *entity.Event) bool {
+		return event.Type == entity.EventTypeInterestPeriodClosed && event.AggregateID == "2026-04"
+	}))
+}
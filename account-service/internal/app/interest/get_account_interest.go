@@ -0,0 +1,105 @@
+package interest
+
+import (
+	"account-service/internal/domain/entity"
+	custom_err "account-service/internal/domain/error"
+	"account-service/internal/domain/money"
+	"account-service/internal/logging"
+	"account-service/internal/observability/metrics"
+	"account-service/internal/ports"
+	"fmt"
+	"strings"
+)
+
+// AccountInterest is the interest state of an account
+type AccountInterest struct {
+	AnnualRate      money.InterestRate
+	AccruedInterest money.Amount // accrued but not yet in a posting, rounded to minor units
+	Postings        []*entity.InterestPosting
+	TotalCount      int64
+	TotalPages      int64
+}
+
+// GetAccountInterest is a use-case for getting the rate, accrued interest and postings of an account
+type GetAccountInterest struct {
+	AccountRepo  ports.AccountRepo
+	InterestRepo ports.InterestRepo
+}
+
+// NewGetAccountInterest creates a new GetAccountInterest use-case
+func NewGetAccountInterest(accountRepo ports.AccountRepo, interestRepo ports.InterestRepo) *GetAccountInterest {
+	return &GetAccountInterest{
+		AccountRepo:  accountRepo,
+		InterestRepo: interestRepo,
+	}
+}
+
+func (g *GetAccountInterest) Execute(accountID string, page, pageSize int, requester, requestId string) (*AccountInterest, string, error) {
+	metrics.IncRequestActive()
+	defer metrics.DecRequestActive()
+
+	var err error
+	defer func() {
+		metrics.RecordOperation("get_account_interest", err)
+	}()
+
+	if strings.TrimSpace(accountID) == "" {
+		err = fmt.Errorf("%w: 'id' - account id required in param", custom_err.ErrValidationFailed)
+		logging.Logger.Error().Err(err).Msg("Invalid request - 'id' account id missing")
+		return nil, "Invalid request - 'id' account id missing", err
+	}
+
+	if page < 1 {
+		page = 1
+	}
+	if pageSize < 1 || pageSize > 100 {
+		pageSize = 100
+	}
+
+	account, err := g.AccountRepo.GetAccountByID(accountID)
+	if err != nil {
+		logging.Logger.Error().Err(err).Str("account_id", accountID).Msg("Failed to verify account")
+		err = fmt.Errorf("%w: failed to verify account", custom_err.ErrDatabase)
+		return nil, "Failed to verify account", err
+	}
+
+	if account == nil {
+		err = custom_err.ErrAccountNotFound
+		logging.Logger.Error().Err(err).Str("account_id", accountID).Msg("Account not found")
+		return nil, "Account not found", err
+	}
+
+	rates, err := g.InterestRepo.ListInterestRates()
+	if err != nil {
+		logging.Logger.Error().Err(err).Str("account_id", accountID).Msg("Failed to get interest rates")
+		err = fmt.Errorf("%w: failed to get interest rates", custom_err.ErrDatabase)
+		return nil, "Failed to get interest rates", err
+	}
+
+	accrued, err := g.InterestRepo.GetUnpostedAccrual(accountID)
+	if err != nil {
+		logging.Logger.Error().Err(err).Str("account_id", accountID).Msg("Failed to get accrued interest")
+		err = fmt.Errorf("%w: failed to get accrued interest", custom_err.ErrDatabase)
+		return nil, "Failed to get accrued interest", err
+	}
+
+	postings, totalCount, err := g.InterestRepo.GetInterestPostingsByAccountID(accountID, page, pageSize)
+	if err != nil {
+		logging.Logger.Error().Err(err).Str("account_id", accountID).Msg("Failed to get interest postings")
+		err = fmt.Errorf("%w: failed to get interest postings", custom_err.ErrDatabase)
+		return nil, "Failed to get interest postings", err
+	}
+
+	totalPages := int64(0)
+	if totalCount > 0 {
+		totalPages = (totalCount + int64(pageSize) - 1) / int64(pageSize)
+	}
+
+	return &AccountInterest{
+		AnnualRate:      entity.NewInterestRates(rates).RateFor(account.ID, account.AccountType),
+		AccruedInterest: money.FromAccrual(accrued),
+		Postings:        postings,
+		TotalCount:      totalCount,
+		TotalPages:      totalPages,
+	}, "Account interest", nil
+}
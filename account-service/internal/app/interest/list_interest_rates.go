@@ -0,0 +1,40 @@
+package interest
+
+import (
+	"account-service/internal/domain/entity"
+	custom_err "account-service/internal/domain/error"
+	"account-service/internal/logging"
+	"account-service/internal/observability/metrics"
+	"account-service/internal/ports"
+)
+
+// ListInterestRates is a use-case for listing the configured interest rates
+type ListInterestRates struct {
+	InterestRepo ports.InterestRepo
+}
+
+// NewListInterestRates creates a new ListInterestRates use-case
+func NewListInterestRates(interestRepo ports.InterestRepo) *ListInterestRates {
+	return &ListInterestRates{
+		InterestRepo: interestRepo,
+	}
+}
+
+func (l *ListInterestRates) Execute(requester, requestId string) ([]*entity.InterestRate, string, error) {
+	metrics.IncRequestActive()
+	defer metrics.DecRequestActive()
+
+	var err error
+	defer func() {
+		metrics.RecordOperation("list_interest_rates", err)
+	}()
+
+	rates, err := l.InterestRepo.ListInterestRates()
+	if err != nil {
+		logging.Logger.Error().Err(err).Str("request_id", requestId).Msg("Failed to get interest rates")
+		err = custom_err.ErrDatabase
+		return nil, "Failed to get interest rates", err
+	}
+
+	return rates, "Interest rates", nil
+}
@@ -0,0 +1,41 @@
+package interest
+
+import (
+	"account-service/internal/domain/entity"
+	custom_err "account-service/internal/domain/error"
+	"account-service/internal/logging"
+	"account-service/internal/observability/metrics"
+	"account-service/internal/ports"
+)
+
+// ListPendingInterestPostings is a use-case for listing the closed monthly interest waiting to be credited
+type ListPendingInterestPostings struct {
+	InterestRepo ports.InterestRepo
+}
+
+// NewListPendingInterestPostings creates a new ListPendingInterestPostings use-case
+func NewListPendingInterestPostings(interestRepo ports.InterestRepo) *ListPendingInterestPostings {
+	return &ListPendingInterestPostings{
+		InterestRepo: interestRepo,
+	}
+}
+
+func (l *ListPendingInterestPostings) Execute(limit int, requester, requestId string) ([]*entity.InterestPosting, string, error) {
+	var err error
+	defer func() {
+		metrics.RecordOperation("list_pending_interest_postings", err)
+	}()
+
+	if limit < 1 || limit > 100 {
+		limit = 100
+	}
+
+	postings, err := l.InterestRepo.GetPendingInterestPostings(limit)
+	if err != nil {
+		logging.Logger.Error().Err(err).Str("request_id", requestId).Msg("Failed to get pending interest postings")
+		err = custom_err.ErrDatabase
+		return nil, "Failed to get pending interest postings", err
+	}
+
+	return postings, "Pending interest postings", nil
+}
@@ -0,0 +1,98 @@
+package interest
+
+import (
+	"account-service/internal/domain/entity"
+	custom_err "account-service/internal/domain/error"
+	"account-service/internal/domain/money"
+	"account-service/internal/logging"
+	"account-service/internal/messaging"
+	"account-service/internal/observability/metrics"
+	"account-service/internal/ports"
+	"fmt"
+)
+
+// SetInterestRate is a use-case for creating or replacing the annual interest rate of an account type or account
+type SetInterestRate struct {
+	AccountRepo  ports.AccountRepo
+	InterestRepo ports.InterestRepo
+	EventRepo    ports.EventRepo
+}
+
+// NewSetInterestRate creates a new SetInterestRate use-case
+func NewSetInterestRate(accountRepo ports.AccountRepo, interestRepo ports.InterestRepo, eventRepo ports.EventRepo) *SetInterestRate {
+	return &SetInterestRate{
+		AccountRepo:  accountRepo,
+		InterestRepo: interestRepo,
+		EventRepo:    eventRepo,
+	}
+}
+
+// Execute replaces the rate of the scope and subject. The new rate applies from the next accrued day;
+// a zero rate stops the accrual.
+func (s *SetInterestRate) Execute(scope, subject, annualRate, requester, requestId string) (*entity.InterestRate, string, error) {
+	metrics.IncRequestActive()
+	defer metrics.DecRequestActive()
+
+	var err error
+	defer func() {
+		metrics.RecordOperation("set_interest_rate", err)
+	}()
+
+	if requester == "" {
+		err = fmt.Errorf("%w: requester not found", custom_err.ErrUnauthorizedRequest)
+		logging.Logger.Error().Err(err).Msg("Unknown requester")
+		err = custom_err.ErrUnauthorizedRequest
+		return nil, fmt.Sprintf("%s: requester not found", custom_err.ErrUnauthorizedRequest), err
+	}
+
+	rate, err := money.ParseInterestRate(annualRate)
+	if err != nil {
+		logging.Logger.Error().Err(err).Str("annual_rate", annualRate).Msg("Invalid interest rate")
+		err = custom_err.ErrInvalidInterestRate
+		return nil, "Invalid interest rate, the annual rate must be a percentage between 0 and 100 with at most 4 decimal places", err
+	}
+
+	interestRate, err := entity.NewInterestRate(scope, subject, rate, requester)
+	if err != nil {
+		logging.Logger.Error().Err(err).Str("scope", scope).Str("subject", subject).Msg("Invalid interest rate")
+		return nil, "Invalid interest rate, scope must be account_type (savings or current) or account", err
+	}
+
+	if interestRate.Scope == entity.InterestRateScopeAccount {
+		account, accountErr := s.AccountRepo.GetAccountByID(interestRate.Subject)
+		if accountErr != nil {
+			logging.Logger.Error().Err(accountErr).Str("account_id", interestRate.Subject).Msg("Failed to verify account")
+			err = custom_err.ErrDatabase
+			return nil, "Failed to verify account", err
+		}
+		if account == nil {
+			err = custom_err.ErrAccountNotFound
+			logging.Logger.Error().Err(err).Str("account_id", interestRate.Subject).Msg("Account not found")
+			return nil, "Account not found", err
+		}
+	}
+
+	if err = s.InterestRepo.UpsertInterestRate(interestRate); err != nil {
+		logging.Logger.Error().Err(err).Str("scope", interestRate.Scope).Str("subject", interestRate.Subject).Msg("Failed to save interest rate")
+		err = custom_err.ErrDatabase
+		return nil, "Failed to save interest rate", err
+	}
+
+	eventData := map[string]interface{}{
+		"scope":       interestRate.Scope,
+		"subject":     interestRate.Subject,
+		"annual_rate": interestRate.AnnualRate.String(),
+		"updated_by":  requester,
+		"request_id":  requestId,
+	}
+
+	event, eventErr := entity.NewEvent(entity.EventTypeInterestRateSet, interestRate.Scope+"/"+interestRate.Subject, entity.EventAggregateTypeInterest, requester, eventData)
+	if eventErr == nil {
+		if createErr := s.EventRepo.CreateEvent(event); createErr != nil {
+			logging.Logger.Error().Err(createErr).Str("event_type", event.Type).Msg("Failed to create interest rate event")
+		}
+		_ = messaging.GetService().PublishToDefaultTopic(messaging.Message{Content: event.ToString(), Status: true, Type: messaging.MessageTypeSetInterest})
+	}
+
+	return interestRate, "Interest rate successfully set", nil
+}
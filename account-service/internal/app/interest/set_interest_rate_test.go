@@ -0,0 +1,72 @@
+package interest
+
+import (
+	"account-service/internal/domain/entity"
+	custom_err "account-service/internal/domain/error"
+	"account-service/internal/domain/money"
+	mock_repo "account-service/internal/ports/mocks/repo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"testing"
+)
+
+// TestSetInterestRate_Execute_AccountType tests setting the rate of an account type
+func TestSetInterestRate_Execute_AccountType(t *testing.T) {
+	mockAccountRepo := new(mock_repo.MockAccountRepo)
+	mockInterestRepo := new(mock_repo.MockInterestRepo)
+	mockEventRepo := new(mock_repo.MockEventRepo)
+
+	setInterestRate := NewSetInterestRate(mockAccountRepo, mockInterestRepo, mockEventRepo)
+
+	mockInterestRepo.On("UpsertInterestRate", mock.MatchedBy(func(rate *entity.InterestRate) bool {
+		return rate.Scope == entity.InterestRateScopeAccountType && rate.Subject == entity.AccountTypeSavings &&
+			rate.AnnualRate == money.MustParseInterestRate("2.5")
+	})).Return(nil)
+	mockEventRepo.On("CreateEvent", mock.MatchedBy(func(event *entity.Event) bool {
+		return event.Type == entity.EventTypeInterestRateSet
+	})).Return(nil)
+
+	rate, message, err := setInterestRate.Execute("account_type", "savings", "2.5", "admin", "req-1")
+
+	assert.NoError(t, err)
+	assert.Equal(t, "Interest rate successfully set", message)
+	assert.Equal(t, "2.5000", rate.AnnualRate.String())
+	mockAccountRepo.AssertNotCalled(t, "GetAccountByID", mock.Anything)
+	mockInterestRepo.AssertExpectations(t)
+	mockEventRepo.AssertExpectations(t)
+}
+
+// TestSetInterestRate_Execute_AccountNotFound tests that an account rate needs an existing account
+func TestSetInterestRate_Execute_AccountNotFound(t *testing.T) {
+	mockAccountRepo := new(mock_repo.MockAccountRepo)
+	mockInterestRepo := new(mock_repo.MockInterestRepo)
+
+	setInterestRate := NewSetInterestRate(mockAccountRepo, mockInterestRepo, new(mock_repo.MockEventRepo))
+
+	mockAccountRepo.On("GetAccountByID", "acc-404").Return(nil, nil)
+
+	rate, message, err := setInterestRate.Execute("account", "acc-404", "1", "admin", "req-1")
+
+	assert.ErrorIs(t, err, custom_err.ErrAccountNotFound)
+	assert.Equal(t, "Account not found", message)
+	assert.Nil(t, rate)
+	mockInterestRepo.AssertNotCalled(t, "UpsertInterestRate", mock.Anything)
+}
+
+// TestSetInterestRate_Execute_InvalidRate tests that rates outside 0 to 100 percent are rejected
+func TestSetInterestRate_Execute_InvalidRate(t *testing.T) {
+	mockInterestRepo := new(mock_repo.MockInterestRepo)
+
+	setInterestRate := NewSetInterestRate(new(mock_repo.MockAccountRepo), mockInterestRepo, new(mock_repo.MockEventRepo))
+
+	_, _, err := setInterestRate.Execute("account_type", "savings", "120", "admin", "req-1")
+	assert.ErrorIs(t, err, custom_err.ErrInvalidInterestRate)
+
+	_, _, err = setInterestRate.Execute("customer", "cust-1", "1", "admin", "req-1")
+	assert.ErrorIs(t, err, custom_err.ErrInvalidInterestRate)
+
+	_, _, err = setInterestRate.Execute("account_type", "savings", "1", "", "req-1")
+	assert.ErrorIs(t, err, custom_err.ErrUnauthorizedRequest)
+
+	mockInterestRepo.AssertNotCalled(t, "UpsertInterestRate", mock.Anything)
+}
@@ -0,0 +1,78 @@
+package interest
+
+import (
+	"account-service/internal/domain/entity"
+	custom_err "account-service/internal/domain/error"
+	"account-service/internal/logging"
+	"account-service/internal/observability/metrics"
+	"account-service/internal/ports"
+	"fmt"
+	"strings"
+)
+
+// SettleAccountInterest is a use-case for posting the interest an account accrued so far ahead of its closure
+type SettleAccountInterest struct {
+	AccountRepo  ports.AccountRepo
+	InterestRepo ports.InterestRepo
+}
+
+// NewSettleAccountInterest creates a new SettleAccountInterest use-case
+func NewSettleAccountInterest(accountRepo ports.AccountRepo, interestRepo ports.InterestRepo) *SettleAccountInterest {
+	return &SettleAccountInterest{
+		AccountRepo:  accountRepo,
+		InterestRepo: interestRepo,
+	}
+}
+
+// Execute moves the accrued interest of the account not yet posted into a pending posting and returns every pending
+// posting of the account, which the transaction service credits before paying the balance out. Interest accrues up
+// to the last accrued day.
+func (s *SettleAccountInterest) Execute(accountID, requester, requestId string) ([]*entity.InterestPosting, string, error) {
+	metrics.IncRequestActive()
+	defer metrics.DecRequestActive()
+
+	var err error
+	defer func() {
+		metrics.RecordOperation("settle_account_interest", err)
+	}()
+
+	accountID = strings.TrimSpace(accountID)
+	if accountID == "" {
+		err = fmt.Errorf("%w: 'id' - account id required in param", custom_err.ErrValidationFailed)
+		logging.Logger.Error().Err(err).Msg("Invalid request - 'id' account id missing")
+		return nil, "Invalid request - 'id' account id missing", err
+	}
+
+	if requester == "" {
+		err = custom_err.ErrUnauthorizedRequest
+		logging.Logger.Error().Err(err).Msg("Unknown requester")
+		return nil, fmt.Sprintf("%s: requester not found", custom_err.ErrUnauthorizedRequest), err
+	}
+
+	account, err := s.AccountRepo.GetAccountByID(accountID)
+	if err != nil {
+		logging.Logger.Error().Err(err).Str("account_id", accountID).Msg("Failed to verify account")
+		err = fmt.Errorf("%w: failed to verify account", custom_err.ErrDatabase)
+		return nil, "Failed to verify account", err
+	}
+
+	if account == nil {
+		err = custom_err.ErrAccountNotFound
+		logging.Logger.Error().Err(err).Str("account_id", accountID).Msg("Account not found")
+		return nil, "Account not found", err
+	}
+
+	if account.IsClosed() {
+		err = custom_err.ErrAccountClosed
+		return nil, "Invalid request - " + err.Error(), err
+	}
+
+	postings, err := s.InterestRepo.SettleAccountInterest(accountID)
+	if err != nil {
+		logging.Logger.Error().Err(err).Str("account_id", accountID).Str("request_id", requestId).Msg("Failed to settle account interest")
+		err = custom_err.ErrDatabase
+		return nil, "Failed to settle account interest", err
+	}
+
+	return postings, "Account interest settled", nil
+}
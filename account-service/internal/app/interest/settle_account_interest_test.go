@@ -0,0 +1,49 @@
+package interest
+
+import (
+	"account-service/internal/domain/entity"
+	custom_err "account-service/internal/domain/error"
+	"account-service/internal/domain/money"
+	mock_repo "account-service/internal/ports/mocks/repo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"testing"
+)
+
+// TestSettleAccountInterest_Execute_Success tests that the settled interest is returned with the other pending postings
+func TestSettleAccountInterest_Execute_Success(t *testing.T) {
+	mockAccountRepo := new(mock_repo.MockAccountRepo)
+	mockInterestRepo := new(mock_repo.MockInterestRepo)
+
+	settleAccountInterest := NewSettleAccountInterest(mockAccountRepo, mockInterestRepo)
+
+	postings := []*entity.InterestPosting{
+		{ID: "posting-1", AccountID: "acc-123", Period: "2026-04", Amount: money.MustParse("4.17"), Status: entity.InterestPostingStatusPending},
+		{ID: "posting-2", AccountID: "acc-123", Period: "2026-05-09", Amount: money.MustParse("1.21"), Status: entity.InterestPostingStatusPending},
+	}
+	mockAccountRepo.On("GetAccountByID", "acc-123").Return(&entity.Account{ID: "acc-123", ActiveStatus: entity.AccountActiveStatusActive}, nil)
+	mockInterestRepo.On("SettleAccountInterest", "acc-123").Return(postings, nil)
+
+	settled, message, err := settleAccountInterest.Execute(" acc-123 ", "admin", "req-1")
+
+	assert.NoError(t, err)
+	assert.Equal(t, "Account interest settled", message)
+	assert.Equal(t, postings, settled)
+	mockInterestRepo.AssertExpectations(t)
+}
+
+// TestSettleAccountInterest_Execute_ClosedAccount tests that the interest of a closed account is not settled
+func TestSettleAccountInterest_Execute_ClosedAccount(t *testing.T) {
+	mockAccountRepo := new(mock_repo.MockAccountRepo)
+	mockInterestRepo := new(mock_repo.MockInterestRepo)
+
+	settleAccountInterest := NewSettleAccountInterest(mockAccountRepo, mockInterestRepo)
+
+	mockAccountRepo.On("GetAccountByID", "acc-123").Return(&entity.Account{ID: "acc-123", ActiveStatus: entity.AccountActiveStatusClosed}, nil)
+
+	settled, _, err := settleAccountInterest.Execute("acc-123", "admin", "req-1")
+
+	assert.ErrorIs(t, err, custom_err.ErrAccountClosed)
+	assert.Nil(t, settled)
+	mockInterestRepo.AssertNotCalled(t, "SettleAccountInterest", mock.Anything)
+}
@@ -0,0 +1,75 @@
+package ledger
+
+import (
+	"account-service/internal/domain/entity"
+	custom_err "account-service/internal/domain/error"
+	"account-service/internal/logging"
+	"account-service/internal/observability/metrics"
+	"account-service/internal/ports"
+	"fmt"
+	"strings"
+)
+
+// GetAccountJournal is a use-case for getting the ledger entries of an account
+type GetAccountJournal struct {
+	AccountRepo ports.AccountRepo
+	LedgerRepo  ports.LedgerRepo
+}
+
+// NewGetAccountJournal creates a new GetAccountJournal use-case
+func NewGetAccountJournal(accountRepo ports.AccountRepo, ledgerRepo ports.LedgerRepo) *GetAccountJournal {
+	return &GetAccountJournal{
+		AccountRepo: accountRepo,
+		LedgerRepo:  ledgerRepo,
+	}
+}
+
+func (g *GetAccountJournal) Execute(accountID string, page, pageSize int, requester, requestId string) ([]*entity.LedgerEntry, int64, int64, string, error) {
+	metrics.IncRequestActive()
+	defer metrics.DecRequestActive()
+
+	var err error
+	defer func() {
+		metrics.RecordOperation("get_account_journal", err)
+	}()
+
+	if strings.TrimSpace(accountID) == "" {
+		err = fmt.Errorf("%w: 'id' - account id required in param", custom_err.ErrValidationFailed)
+		logging.Logger.Error().Err(err).Msg("Invalid request - 'id' account id missing")
+		return nil, 0, 0, "Invalid request - 'id' account id missing", err
+	}
+
+	if page < 1 {
+		page = 1
+	}
+	if pageSize < 1 || pageSize > 100 {
+		pageSize = 100
+	}
+
+	account, err := g.AccountRepo.GetAccountByID(accountID)
+	if err != nil {
+		logging.Logger.Error().Err(err).Str("account_id", accountID).Msg("Failed to verify account")
+		err = fmt.Errorf("%w: failed to verify account", custom_err.ErrDatabase)
+		return nil, 0, 0, "Failed to verify account", err
+	}
+
+	if account == nil {
+		err = custom_err.ErrAccountNotFound
+		logging.Logger.Error().Err(err).Str("account_id", accountID).Msg("Account not found")
+		return nil, 0, 0, "Account not found", err
+	}
+
+	entries, totalCount, err := g.LedgerRepo.GetEntriesByAccountID(accountID, page, pageSize)
+	if err != nil {
+		logging.Logger.Error().Err(err).Str("account_id", accountID).Msg("Failed to get account journal")
+		err = fmt.Errorf("%w: failed to get account journal", custom_err.ErrDatabase)
+		return nil, 0, 0, "Failed to get account journal", err
+	}
+
+	totalPages := int64(0)
+	if totalCount > 0 {
+		totalPages = (totalCount + int64(pageSize) - 1) / int64(pageSize)
+	}
+
+	return entries, totalCount, totalPages, "Account journal", nil
+}
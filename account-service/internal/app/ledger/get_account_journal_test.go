@@ -0,0 +1,98 @@
+package ledger
+
+import (
+	"account-service/internal/domain/entity"
+	custom_err "account-service/internal/domain/error"
+	"account-service/internal/domain/money"
+	mock_repo "account-service/internal/ports/mocks/repo"
+	"errors"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+// TestGetAccountJournal_Execute_Success tests success response if all inputs are provided correctly
+func TestGetAccountJournal_Execute_Success(t *testing.T) {
+	mockAccountRepo := new(mock_repo.MockAccountRepo)
+	mockLedgerRepo := new(mock_repo.MockLedgerRepo)
+	getAccountJournal := NewGetAccountJournal(mockAccountRepo, mockLedgerRepo)
+
+	entries := []*entity.LedgerEntry{
+		{ID: "entry-2", AccountID: "acc-123", Direction: entity.LedgerDirectionDebit, Amount: money.MustParse("25.00"), Currency: entity.CurrencyUSD},
+		{ID: "entry-1", AccountID: "acc-123", Direction: entity.LedgerDirectionCredit, Amount: money.MustParse("100.00"), Currency: entity.CurrencyUSD},
+	}
+
+	mockAccountRepo.On("GetAccountByID", "acc-123").Return(&entity.Account{ID: "acc-123"}, nil)
+	mockLedgerRepo.On("GetEntriesByAccountID", "acc-123", 1, 10).Return(entries, int64(12), nil)
+
+	result, totalCount, totalPages, message, err := getAccountJournal.Execute("acc-123", 1, 10, "user123", "req-456")
+
+	assert.NoError(t, err)
+	assert.Equal(t, "Account journal", message)
+	assert.Equal(t, entries, result)
+	assert.Equal(t, int64(12), totalCount)
+	assert.Equal(t, int64(2), totalPages)
+
+	mockAccountRepo.AssertExpectations(t)
+	mockLedgerRepo.AssertExpectations(t)
+}
+
+// TestGetAccountJournal_Execute_DefaultPagination tests if invalid pagination falls back to defaults
+func TestGetAccountJournal_Execute_DefaultPagination(t *testing.T) {
+	mockAccountRepo := new(mock_repo.MockAccountRepo)
+	mockLedgerRepo := new(mock_repo.MockLedgerRepo)
+	getAccountJournal := NewGetAccountJournal(mockAccountRepo, mockLedgerRepo)
+
+	mockAccountRepo.On("GetAccountByID", "acc-123").Return(&entity.Account{ID: "acc-123"}, nil)
+	mockLedgerRepo.On("GetEntriesByAccountID", "acc-123", 1, 100).Return([]*entity.LedgerEntry{}, int64(0), nil)
+
+	_, _, totalPages, _, err := getAccountJournal.Execute("acc-123", -1, 500, "user123", "req-456")
+
+	assert.NoError(t, err)
+	assert.Equal(t, int64(0), totalPages)
+	mockLedgerRepo.AssertExpectations(t)
+}
+
+// TestGetAccountJournal_Execute_EmptyAccountID tests if account id is empty
+func TestGetAccountJournal_Execute_EmptyAccountID(t *testing.T) {
+	mockAccountRepo := new(mock_repo.MockAccountRepo)
+	mockLedgerRepo := new(mock_repo.MockLedgerRepo)
+	getAccountJournal := NewGetAccountJournal(mockAccountRepo, mockLedgerRepo)
+
+	result, _, _, _, err := getAccountJournal.Execute(" ", 1, 10, "user123", "req-456")
+
+	assert.ErrorIs(t, err, custom_err.ErrValidationFailed)
+	assert.Nil(t, result)
+	mockAccountRepo.AssertNotCalled(t, "GetAccountByID")
+	mockLedgerRepo.AssertNotCalled(t, "GetEntriesByAccountID")
+}
+
+// TestGetAccountJournal_Execute_AccountNotFound tests if account does not exist
+func TestGetAccountJournal_Execute_AccountNotFound(t *testing.T) {
+	mockAccountRepo := new(mock_repo.MockAccountRepo)
+	mockLedgerRepo := new(mock_repo.MockLedgerRepo)
+	getAccountJournal := NewGetAccountJournal(mockAccountRepo, mockLedgerRepo)
+
+	mockAccountRepo.On("GetAccountByID", "acc-404").Return(nil, nil)
+
+	result, _, _, message, err := getAccountJournal.Execute("acc-404", 1, 10, "user123", "req-456")
+
+	assert.ErrorIs(t, err, custom_err.ErrAccountNotFound)
+	assert.Equal(t, "Account not found", message)
+	assert.Nil(t, result)
+	mockLedgerRepo.AssertNotCalled(t, "GetEntriesByAccountID")
+}
+
+// TestGetAccountJournal_Execute_DatabaseError tests if reading the journal fails
+func TestGetAccountJournal_Execute_DatabaseError(t *testing.T) {
+	mockAccountRepo := new(mock_repo.MockAccountRepo)
+	mockLedgerRepo := new(mock_repo.MockLedgerRepo)
+	getAccountJournal := NewGetAccountJournal(mockAccountRepo, mockLedgerRepo)
+
+	mockAccountRepo.On("GetAccountByID", "acc-123").Return(&entity.Account{ID: "acc-123"}, nil)
+	mockLedgerRepo.On("GetEntriesByAccountID", "acc-123", 1, 10).Return(nil, int64(0), errors.New("database error"))
+
+	result, _, _, _, err := getAccountJournal.Execute("acc-123", 1, 10, "user123", "req-456")
+
+	assert.ErrorIs(t, err, custom_err.ErrDatabase)
+	assert.Nil(t, result)
+}
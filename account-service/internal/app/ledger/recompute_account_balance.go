@@ -0,0 +1,81 @@
+package ledger
+
+import (
+	custom_err "account-service/internal/domain/error"
+	"account-service/internal/grpc/types"
+	"account-service/internal/logging"
+	"account-service/internal/observability/metrics"
+	"account-service/internal/ports"
+	"fmt"
+	"strings"
+)
+
+// RecomputeAccountBalance is a use-case for rebuilding an account balance from its journal entries
+type RecomputeAccountBalance struct {
+	AccountRepo ports.AccountRepo
+	LedgerRepo  ports.LedgerRepo
+}
+
+// NewRecomputeAccountBalance creates a new RecomputeAccountBalance use-case
+func NewRecomputeAccountBalance(accountRepo ports.AccountRepo, ledgerRepo ports.LedgerRepo) *RecomputeAccountBalance {
+	return &RecomputeAccountBalance{
+		AccountRepo: accountRepo,
+		LedgerRepo:  ledgerRepo,
+	}
+}
+
+// Execute recomputes the balance from the journal and compares it with the stored balance.
+// A mismatch is reported but never corrected here.
+func (r *RecomputeAccountBalance) Execute(accountID, requester, requestId string) (*types.LedgerBalance, string, error) {
+	metrics.IncRequestActive()
+	defer metrics.DecRequestActive()
+
+	var err error
+	defer func() {
+		metrics.RecordOperation("recompute_account_balance", err)
+	}()
+
+	if strings.TrimSpace(accountID) == "" {
+		err = fmt.Errorf("%w: 'id' - account id required in param", custom_err.ErrValidationFailed)
+		logging.Logger.Error().Err(err).Msg("Invalid request - 'id' account id missing")
+		return nil, "Invalid request - 'id' account id missing", err
+	}
+
+	account, err := r.AccountRepo.GetAccountByID(accountID)
+	if err != nil {
+		logging.Logger.Error().Err(err).Str("account_id", accountID).Msg("Failed to verify account")
+		err = fmt.Errorf("%w: failed to verify account", custom_err.ErrDatabase)
+		return nil, "Failed to verify account", err
+	}
+
+	if account == nil {
+		err = custom_err.ErrAccountNotFound
+		logging.Logger.Error().Err(err).Str("account_id", accountID).Msg("Account not found")
+		return nil, "Account not found", err
+	}
+
+	ledgerBalance, err := r.LedgerRepo.GetAccountLedgerBalance(accountID)
+	if err != nil {
+		logging.Logger.Error().Err(err).Str("account_id", accountID).Msg("Failed to recompute account balance")
+		err = fmt.Errorf("%w: failed to recompute account balance", custom_err.ErrDatabase)
+		return nil, "Failed to recompute account balance", err
+	}
+
+	result := &types.LedgerBalance{
+		AccountID:     account.ID,
+		Currency:      account.Currency,
+		Balance:       account.Balance,
+		LedgerBalance: ledgerBalance,
+	}
+
+	if !result.IsConsistent() {
+		logging.Logger.Warn().
+			Str("account_id", account.ID).
+			Str("balance", account.Balance.String()).
+			Str("ledger_balance", ledgerBalance.String()).
+			Msg("Account balance does not match its journal")
+		return result, "Account balance does not match journal", nil
+	}
+
+	return result, "Account balance matches journal", nil
+}
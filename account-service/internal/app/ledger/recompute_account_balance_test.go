@@ -0,0 +1,82 @@
+package ledger
+
+import (
+	"account-service/internal/domain/entity"
+	custom_err "account-service/internal/domain/error"
+	"account-service/internal/domain/money"
+	mock_repo "account-service/internal/ports/mocks/repo"
+	"errors"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+// TestRecomputeAccountBalance_Execute_Consistent tests if stored balance matches the journal
+func TestRecomputeAccountBalance_Execute_Consistent(t *testing.T) {
+	mockAccountRepo := new(mock_repo.MockAccountRepo)
+	mockLedgerRepo := new(mock_repo.MockLedgerRepo)
+	recomputeAccountBalance := NewRecomputeAccountBalance(mockAccountRepo, mockLedgerRepo)
+
+	account := &entity.Account{ID: "acc-123", Balance: money.MustParse("75.00"), Currency: entity.CurrencyEUR}
+	mockAccountRepo.On("GetAccountByID", "acc-123").Return(account, nil)
+	mockLedgerRepo.On("GetAccountLedgerBalance", "acc-123").Return(money.MustParse("75.00"), nil)
+
+	result, message, err := recomputeAccountBalance.Execute("acc-123", "user123", "req-456")
+
+	assert.NoError(t, err)
+	assert.Equal(t, "Account balance matches journal", message)
+	assert.True(t, result.IsConsistent())
+	assert.Equal(t, entity.CurrencyEUR, result.Currency)
+	assert.Equal(t, money.MustParse("75.00"), result.LedgerBalance)
+
+	mockAccountRepo.AssertExpectations(t)
+	mockLedgerRepo.AssertExpectations(t)
+}
+
+// TestRecomputeAccountBalance_Execute_Mismatch tests if stored balance drifted from the journal
+func TestRecomputeAccountBalance_Execute_Mismatch(t *testing.T) {
+	mockAccountRepo := new(mock_repo.MockAccountRepo)
+	mockLedgerRepo := new(mock_repo.MockLedgerRepo)
+	recomputeAccountBalance := NewRecomputeAccountBalance(mockAccountRepo, mockLedgerRepo)
+
+	account := &entity.Account{ID: "acc-123", Balance: money.MustParse("80.00"), Currency: entity.CurrencyUSD}
+	mockAccountRepo.On("GetAccountByID", "acc-123").Return(account, nil)
+	mockLedgerRepo.On("GetAccountLedgerBalance", "acc-123").Return(money.MustParse("75.00"), nil)
+
+	result, message, err := recomputeAccountBalance.Execute("acc-123", "user123", "req-456")
+
+	assert.NoError(t, err)
+	assert.Equal(t, "Account balance does not match journal", message)
+	assert.False(t, result.IsConsistent())
+	assert.Equal(t, money.MustParse("80.00"), result.Balance)
+	assert.Equal(t, money.MustParse("75.00"), result.LedgerBalance)
+}
+
+// TestRecomputeAccountBalance_Execute_AccountNotFound tests if account does not exist
+func TestRecomputeAccountBalance_Execute_AccountNotFound(t *testing.T) {
+	mockAccountRepo := new(mock_repo.MockAccountRepo)
+	mockLedgerRepo := new(mock_repo.MockLedgerRepo)
+	recomputeAccountBalance := NewRecomputeAccountBalance(mockAccountRepo, mockLedgerRepo)
+
+	mockAccountRepo.On("GetAccountByID", "acc-404").Return(nil, nil)
+
+	result, _, err := recomputeAccountBalance.Execute("acc-404", "user123", "req-456")
+
+	assert.ErrorIs(t, err, custom_err.ErrAccountNotFound)
+	assert.Nil(t, result)
+	mockLedgerRepo.AssertNotCalled(t, "GetAccountLedgerBalance")
+}
+
+// TestRecomputeAccountBalance_Execute_DatabaseError tests if summing the journal fails
+func TestRecomputeAccountBalance_Execute_DatabaseError(t *testing.T) {
+	mockAccountRepo := new(mock_repo.MockAccountRepo)
+	mockLedgerRepo := new(mock_repo.MockLedgerRepo)
+	recomputeAccountBalance := NewRecomputeAccountBalance(mockAccountRepo, mockLedgerRepo)
+
+	mockAccountRepo.On("GetAccountByID", "acc-123").Return(&entity.Account{ID: "acc-123"}, nil)
+	mockLedgerRepo.On("GetAccountLedgerBalance", "acc-123").Return(money.Zero, errors.New("database error"))
+
+	result, _, err := recomputeAccountBalance.Execute("acc-123", "user123", "req-456")
+
+	assert.ErrorIs(t, err, custom_err.ErrDatabase)
+	assert.Nil(t, result)
+}
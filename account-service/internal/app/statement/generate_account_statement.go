@@ -0,0 +1,87 @@
+package statement
+
+import (
+	"account-service/internal/domain/entity"
+	custom_err "account-service/internal/domain/error"
+	"account-service/internal/logging"
+	"account-service/internal/observability/metrics"
+	"account-service/internal/ports"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// GenerateAccountStatement is a use-case for generating the statement of an account for a date range
+type GenerateAccountStatement struct {
+	AccountRepo ports.AccountRepo
+	LedgerRepo  ports.LedgerRepo
+}
+
+// NewGenerateAccountStatement creates a new GenerateAccountStatement use-case
+func NewGenerateAccountStatement(accountRepo ports.AccountRepo, ledgerRepo ports.LedgerRepo) *GenerateAccountStatement {
+	return &GenerateAccountStatement{
+		AccountRepo: accountRepo,
+		LedgerRepo:  ledgerRepo,
+	}
+}
+
+// Execute generates the statement of the account from the first to the last UTC day, both inclusive, from its
+// journal. The statement is not stored.
+func (g *GenerateAccountStatement) Execute(accountID, from, to, requester, requestId string) (*entity.Statement, string, error) {
+	metrics.IncRequestActive()
+	defer metrics.DecRequestActive()
+
+	var err error
+	defer func() {
+		metrics.RecordOperation("generate_account_statement", err)
+	}()
+
+	if strings.TrimSpace(accountID) == "" {
+		err = fmt.Errorf("%w: 'id' - account id required in param", custom_err.ErrValidationFailed)
+		logging.Logger.Error().Err(err).Msg("Invalid request - 'id' account id missing")
+		return nil, "Invalid request - 'id' account id missing", err
+	}
+
+	start, end, err := entity.ParseStatementPeriod(from, to)
+	if err != nil {
+		logging.Logger.Warn().Err(err).Str("from", from).Str("to", to).Msg("Invalid statement period")
+		return nil, fmt.Sprintf("Invalid statement period - 'from' and 'to' must be dates (YYYY-MM-DD) at most %d days apart", entity.StatementMaxDays), err
+	}
+
+	account, err := g.AccountRepo.GetAccountByID(accountID)
+	if err != nil {
+		logging.Logger.Error().Err(err).Str("account_id", accountID).Msg("Failed to verify account")
+		err = fmt.Errorf("%w: failed to verify account", custom_err.ErrDatabase)
+		return nil, "Failed to verify account", err
+	}
+
+	if account == nil {
+		err = custom_err.ErrAccountNotFound
+		logging.Logger.Error().Err(err).Str("account_id", accountID).Msg("Account not found")
+		return nil, "Account not found", err
+	}
+
+	statement, err := buildStatement(g.LedgerRepo, account, start, end)
+	if err != nil {
+		logging.Logger.Error().Err(err).Str("account_id", accountID).Str("request_id", requestId).Msg("Failed to generate statement")
+		err = fmt.Errorf("%w: failed to generate statement", custom_err.ErrDatabase)
+		return nil, "Failed to generate statement", err
+	}
+
+	return statement, "Account statement", nil
+}
+
+// buildStatement builds the statement of the account between start and end from its journal
+func buildStatement(ledgerRepo ports.LedgerRepo, account *entity.Account, start, end time.Time) (*entity.Statement, error) {
+	openingBalance, err := ledgerRepo.GetAccountLedgerBalanceAt(account.ID, start)
+	if err != nil {
+		return nil, err
+	}
+
+	entries, err := ledgerRepo.GetEntriesByAccountIDBetween(account.ID, start, end)
+	if err != nil {
+		return nil, err
+	}
+
+	return entity.NewStatement(account, start, end, openingBalance, entries), nil
+}
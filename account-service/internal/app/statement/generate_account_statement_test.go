@@ -0,0 +1,69 @@
+package statement
+
+import (
+	"account-service/internal/domain/entity"
+	custom_err "account-service/internal/domain/error"
+	"account-service/internal/domain/money"
+	mock_repo "account-service/internal/ports/mocks/repo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"testing"
+	"time"
+)
+
+// TestGenerateAccountStatement_Execute_Success tests generating a statement from the journal of the account
+func TestGenerateAccountStatement_Execute_Success(t *testing.T) {
+	mockAccountRepo := new(mock_repo.MockAccountRepo)
+	mockLedgerRepo := new(mock_repo.MockLedgerRepo)
+	generateStatement := NewGenerateAccountStatement(mockAccountRepo, mockLedgerRepo)
+
+	start := time.Date(2026, time.March, 1, 0, 0, 0, 0, time.UTC)
+	end := time.Date(2026, time.March, 16, 0, 0, 0, 0, time.UTC)
+	mockAccountRepo.On("GetAccountByID", "acc-123").Return(&entity.Account{ID: "acc-123", CustomerID: "cust-1", Currency: entity.CurrencyUSD}, nil)
+	mockLedgerRepo.On("GetAccountLedgerBalanceAt", "acc-123", start).Return(money.MustParse("50.00"), nil)
+	mockLedgerRepo.On("GetEntriesByAccountIDBetween", "acc-123", start, end).Return([]*entity.LedgerEntry{
+		{AccountID: "acc-123", JournalType: entity.JournalTypeTransaction, Reference: "tx-1", Direction: entity.LedgerDirectionCredit, Amount: money.MustParse("25.00")},
+	}, nil)
+
+	statement, message, err := generateStatement.Execute("acc-123", "2026-03-01", "2026-03-15", "user123", "req-456")
+
+	assert.NoError(t, err)
+	assert.Equal(t, "Account statement", message)
+	assert.Equal(t, "2026-03-15", statement.PeriodEnd)
+	assert.Equal(t, money.MustParse("50.00"), statement.OpeningBalance)
+	assert.Equal(t, money.MustParse("75.00"), statement.ClosingBalance)
+	assert.Equal(t, 1, statement.LineCount)
+	assert.Empty(t, statement.ID)
+	mockAccountRepo.AssertExpectations(t)
+	mockLedgerRepo.AssertExpectations(t)
+}
+
+// TestGenerateAccountStatement_Execute_InvalidPeriod tests that ranges that are reversed or too long are rejected
+func TestGenerateAccountStatement_Execute_InvalidPeriod(t *testing.T) {
+	mockAccountRepo := new(mock_repo.MockAccountRepo)
+	mockLedgerRepo := new(mock_repo.MockLedgerRepo)
+	generateStatement := NewGenerateAccountStatement(mockAccountRepo, mockLedgerRepo)
+
+	_, _, err := generateStatement.Execute("acc-123", "2026-03-15", "2026-03-01", "user123", "req-456")
+	assert.ErrorIs(t, err, custom_err.ErrInvalidStatementPeriod)
+
+	_, _, err = generateStatement.Execute("acc-123", "2024-01-01", "2026-03-01", "user123", "req-456")
+	assert.ErrorIs(t, err, custom_err.ErrInvalidStatementPeriod)
+
+	mockAccountRepo.AssertNotCalled(t, "GetAccountByID", mock.Anything)
+}
+
+// TestGenerateAccountStatement_Execute_AccountNotFound tests if the account does not exist
+func TestGenerateAccountStatement_Execute_AccountNotFound(t *testing.T) {
+	mockAccountRepo := new(mock_repo.MockAccountRepo)
+	mockLedgerRepo := new(mock_repo.MockLedgerRepo)
+	generateStatement := NewGenerateAccountStatement(mockAccountRepo, mockLedgerRepo)
+
+	mockAccountRepo.On("GetAccountByID", "acc-404").Return(nil, nil)
+
+	_, message, err := generateStatement.Execute("acc-404", "2026-03-01", "2026-03-31", "user123", "req-456")
+
+	assert.ErrorIs(t, err, custom_err.ErrAccountNotFound)
+	assert.Equal(t, "Account not found", message)
+	mockLedgerRepo.AssertNotCalled(t, "GetAccountLedgerBalanceAt", mock.Anything, mock.Anything)
+}
@@ -0,0 +1,55 @@
+package statement
+
+import (
+	"account-service/internal/domain/entity"
+	custom_err "account-service/internal/domain/error"
+	"account-service/internal/logging"
+	"account-service/internal/observability/metrics"
+	"account-service/internal/ports"
+	"fmt"
+	"strings"
+)
+
+// GetStatement is a use-case for getting an issued statement with its lines
+type GetStatement struct {
+	StatementRepo ports.StatementRepo
+}
+
+// NewGetStatement creates a new GetStatement use-case
+func NewGetStatement(statementRepo ports.StatementRepo) *GetStatement {
+	return &GetStatement{
+		StatementRepo: statementRepo,
+	}
+}
+
+func (g *GetStatement) Execute(statementID, requester, requestId string) (*entity.Statement, string, error) {
+	metrics.IncRequestActive()
+	defer metrics.DecRequestActive()
+
+	var err error
+	defer func() {
+		metrics.RecordOperation("get_statement", err)
+	}()
+
+	statementID = strings.TrimSpace(statementID)
+	if statementID == "" {
+		err = fmt.Errorf("%w: statement ID is required", custom_err.ErrValidationFailed)
+		logging.Logger.Error().Err(err).Msg("Required missing fields")
+		err = custom_err.ErrValidationFailed
+		return nil, fmt.Sprintf("%s: statement ID is required", custom_err.ErrValidationFailed), err
+	}
+
+	statement, err := g.StatementRepo.GetStatementByID(statementID)
+	if err != nil {
+		logging.Logger.Error().Err(err).Str("statement_id", statementID).Str("request_id", requestId).Msg("Failed to get statement")
+		err = custom_err.ErrDatabase
+		return nil, "Failed to get statement", err
+	}
+
+	if statement == nil {
+		err = custom_err.ErrStatementNotFound
+		return nil, "Statement not found", err
+	}
+
+	return statement, "Statement details", nil
+}
@@ -0,0 +1,85 @@
+package statement
+
+import (
+	"account-service/internal/domain/entity"
+	custom_err "account-service/internal/domain/error"
+	"account-service/internal/logging"
+	"account-service/internal/observability/metrics"
+	"account-service/internal/ports"
+	"fmt"
+	"time"
+)
+
+// StatementRequester is recorded as the creator of monthly statements and their events
+const StatementRequester = "system"
+
+// IssueMonthlyStatements is a use-case for issuing the statements of a month for every account
+type IssueMonthlyStatements struct {
+	StatementRepo ports.StatementRepo
+	LedgerRepo    ports.LedgerRepo
+	EventRepo     ports.EventRepo
+}
+
+// NewIssueMonthlyStatements creates a new IssueMonthlyStatements use-case
+func NewIssueMonthlyStatements(statementRepo ports.StatementRepo, ledgerRepo ports.LedgerRepo, eventRepo ports.EventRepo) *IssueMonthlyStatements {
+	return &IssueMonthlyStatements{
+		StatementRepo: statementRepo,
+		LedgerRepo:    ledgerRepo,
+		EventRepo:     eventRepo,
+	}
+}
+
+// Execute issues and stores the statement of the UTC month of the day for every valid account opened before the
+// month ended. Statements are unique per account and period, so issuing a month again never duplicates them.
+func (i *IssueMonthlyStatements) Execute(month time.Time) (int, string, error) {
+	var err error
+	defer func() {
+		metrics.RecordOperation("issue_monthly_statements", err)
+	}()
+
+	start, end := entity.MonthPeriod(month)
+	period := start.Format(entity.StatementPeriodLayout)
+
+	accounts, err := i.StatementRepo.GetStatementAccounts(end)
+	if err != nil {
+		logging.Logger.Error().Err(err).Str("period", period).Msg("Failed to get statement accounts")
+		err = custom_err.ErrDatabase
+		return 0, "Failed to get statement accounts", err
+	}
+
+	statements := make([]*entity.Statement, 0, len(accounts))
+	for _, account := range accounts {
+		statement, buildErr := buildStatement(i.LedgerRepo, account, start, end)
+		if buildErr != nil {
+			logging.Logger.Error().Err(buildErr).Str("account_id", account.ID).Str("period", period).Msg("Failed to generate statement")
+			err = custom_err.ErrDatabase
+			return 0, fmt.Sprintf("Failed to generate statement of account %s", account.ID), err
+		}
+		statement.Issue(StatementRequester)
+		statements = append(statements, statement)
+	}
+
+	run := &entity.StatementRun{
+		Period:         period,
+		StatementCount: len(statements),
+		CreatedAt:      time.Now(),
+	}
+	if err = i.StatementRepo.RecordStatementRun(run, statements); err != nil {
+		logging.Logger.Error().Err(err).Str("period", period).Msg("Failed to store statements")
+		err = custom_err.ErrDatabase
+		return 0, "Failed to store statements", err
+	}
+
+	eventData := map[string]interface{}{
+		"period":          period,
+		"statement_count": len(statements),
+	}
+	event, eventErr := entity.NewEvent(entity.EventTypeStatementsIssued, period, entity.EventAggregateTypeStatement, StatementRequester, eventData)
+	if eventErr == nil {
+		if createErr := i.EventRepo.CreateEvent(event); createErr != nil {
+			logging.Logger.Error().Err(createErr).Str("period", period).Msg("Failed to create statement event")
+		}
+	}
+
+	return len(statements), fmt.Sprintf("Statements of %s issued", period), nil
+}
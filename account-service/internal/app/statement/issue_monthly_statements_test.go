@@ -0,0 +1,51 @@
+package statement
+
+import (
+	"account-service/internal/domain/entity"
+	"account-service/internal/domain/money"
+	mock_repo "account-service/internal/ports/mocks/repo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"testing"
+	"time"
+)
+
+// TestIssueMonthlyStatements_Execute_Success tests issuing and storing the statement of the month of every account
+func TestIssueMonthlyStatements_Execute_Success(t *testing.T) {
+	mockStatementRepo := new(mock_repo.MockStatementRepo)
+	mockLedgerRepo := new(mock_repo.MockLedgerRepo)
+	mockEventRepo := new(mock_repo.MockEventRepo)
+	issueStatements := NewIssueMonthlyStatements(mockStatementRepo, mockLedgerRepo, mockEventRepo)
+
+	start := time.Date(2026, time.February, 1, 0, 0, 0, 0, time.UTC)
+	end := time.Date(2026, time.March, 1, 0, 0, 0, 0, time.UTC)
+	mockStatementRepo.On("GetStatementAccounts", end).Return([]*entity.Account{
+		{ID: "acc-1", CustomerID: "cust-1", Currency: entity.CurrencyUSD},
+		{ID: "acc-2", CustomerID: "cust-2", Currency: entity.CurrencyEUR},
+	}, nil)
+	mockLedgerRepo.On("GetAccountLedgerBalanceAt", "acc-1", start).Return(money.MustParse("10.00"), nil)
+	mockLedgerRepo.On("GetEntriesByAccountIDBetween", "acc-1", start, end).Return([]*entity.LedgerEntry{
+		{AccountID: "acc-1", JournalType: entity.JournalTypeTransaction, Reference: "tx-1", Direction: entity.LedgerDirectionDebit, Amount: money.MustParse("4.00")},
+	}, nil)
+	mockLedgerRepo.On("GetAccountLedgerBalanceAt", "acc-2", start).Return(money.Zero, nil)
+	mockLedgerRepo.On("GetEntriesByAccountIDBetween", "acc-2", start, end).Return([]*entity.LedgerEntry{}, nil)
+	mockStatementRepo.On("RecordStatementRun", mock.MatchedBy(func(run *entity.StatementRun) bool {
+		return run.Period == "2026-02" && run.StatementCount == 2
+	}), mock.MatchedBy(func(statements []*entity.Statement) bool {
+		return len(statements) == 2 && statements[0].ID != "" && statements[0].Lines[0].StatementID == statements[0].ID &&
+			statements[0].ClosingBalance == money.MustParse("6.00") && statements[0].PeriodEnd == "2026-02-28" &&
+			statements[1].LineCount == 0 && statements[1].CreatedBy == StatementRequester
+	})).Return(nil)
+	mockEventRepo.On("CreateEvent", mock.MatchedBy(func(event *entity.Event) bool {
+		return event.Type == entity.EventTypeStatementsIssued && event.AggregateID == "2026-02"
+	})).Return(nil)
+
+	count, message, err := issueStatements.Execute(time.Date(2026, time.February, 14, 9, 0, 0, 0, time.UTC))
+
+	assert.NoError(t, err)
+	assert.Equal(t, 2, count)
+	assert.Equal(t, "Statements of 2026-02 issued", message)
+	mockStatementRepo.AssertExpectations(t)
+	mockLedgerRepo.AssertExpectations(t)
+	mockEventRepo.AssertExpectations(t)
+}
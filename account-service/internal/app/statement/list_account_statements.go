@@ -0,0 +1,75 @@
+package statement
+
+import (
+	"account-service/internal/domain/entity"
+	custom_err "account-service/internal/domain/error"
+	"account-service/internal/logging"
+	"account-service/internal/observability/metrics"
+	"account-service/internal/ports"
+	"fmt"
+	"strings"
+)
+
+// ListAccountStatements is a use-case for listing the issued statements of an account
+type ListAccountStatements struct {
+	AccountRepo   ports.AccountRepo
+	StatementRepo ports.StatementRepo
+}
+
+// NewListAccountStatements creates a new ListAccountStatements use-case
+func NewListAccountStatements(accountRepo ports.AccountRepo, statementRepo ports.StatementRepo) *ListAccountStatements {
+	return &ListAccountStatements{
+		AccountRepo:   accountRepo,
+		StatementRepo: statementRepo,
+	}
+}
+
+func (l *ListAccountStatements) Execute(accountID string, page, pageSize int, requester, requestId string) ([]*entity.Statement, int64, int64, string, error) {
+	metrics.IncRequestActive()
+	defer metrics.DecRequestActive()
+
+	var err error
+	defer func() {
+		metrics.RecordOperation("list_account_statements", err)
+	}()
+
+	if strings.TrimSpace(accountID) == "" {
+		err = fmt.Errorf("%w: 'id' - account id required in param", custom_err.ErrValidationFailed)
+		logging.Logger.Error().Err(err).Msg("Invalid request - 'id' account id missing")
+		return nil, 0, 0, "Invalid request - 'id' account id missing", err
+	}
+
+	if page < 1 {
+		page = 1
+	}
+	if pageSize < 1 || pageSize > 100 {
+		pageSize = 100
+	}
+
+	account, err := l.AccountRepo.GetAccountByID(accountID)
+	if err != nil {
+		logging.Logger.Error().Err(err).Str("account_id", accountID).Msg("Failed to verify account")
+		err = fmt.Errorf("%w: failed to verify account", custom_err.ErrDatabase)
+		return nil, 0, 0, "Failed to verify account", err
+	}
+
+	if account == nil {
+		err = custom_err.ErrAccountNotFound
+		logging.Logger.Error().Err(err).Str("account_id", accountID).Msg("Account not found")
+		return nil, 0, 0, "Account not found", err
+	}
+
+	statements, totalCount, err := l.StatementRepo.GetStatementsByAccountID(accountID, page, pageSize)
+	if err != nil {
+		logging.Logger.Error().Err(err).Str("account_id", accountID).Msg("Failed to get account statements")
+		err = fmt.Errorf("%w: failed to get account statements", custom_err.ErrDatabase)
+		return nil, 0, 0, "Failed to get account statements", err
+	}
+
+	totalPages := int64(0)
+	if totalCount > 0 {
+		totalPages = (totalCount + int64(pageSize) - 1) / int64(pageSize)
+	}
+
+	return statements, totalCount, totalPages, "Account statements", nil
+}
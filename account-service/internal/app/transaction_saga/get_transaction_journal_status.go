@@ -0,0 +1,61 @@
+package transaction_saga
+
+import (
+	"account-service/internal/domain/entity"
+	custom_err "account-service/internal/domain/error"
+	"account-service/internal/grpc/types"
+	"account-service/internal/logging"
+	"account-service/internal/observability/metrics"
+	"account-service/internal/ports"
+	"strings"
+)
+
+// GetTransactionJournalStatus is a use-case for checking whether the balance update of a transaction was committed
+type GetTransactionJournalStatus struct {
+	LedgerRepo ports.LedgerRepo
+}
+
+// NewGetTransactionJournalStatus creates a new GetTransactionJournalStatus use-case
+func NewGetTransactionJournalStatus(ledgerRepo ports.LedgerRepo) *GetTransactionJournalStatus {
+	return &GetTransactionJournalStatus{
+		LedgerRepo: ledgerRepo,
+	}
+}
+
+// Execute reads the journals referenced by the transaction. Balance updates and their journals
+// commit together, so the journals are the account-side record of how far a saga got.
+func (t *GetTransactionJournalStatus) Execute(transactionID string, requester, requestId string) (*types.TransactionJournalStatus, string, error) {
+	metrics.IncRequestActive()
+	defer metrics.DecRequestActive()
+
+	var err error
+	defer func() {
+		metrics.RecordOperation("get_transaction_journal_status", err)
+	}()
+
+	if strings.TrimSpace(transactionID) == "" {
+		logging.Logger.Error().Err(custom_err.ErrTransactionIdRequired).Msg("Transaction id is required")
+		err = custom_err.ErrTransactionIdRequired
+		return nil, "transaction id is required", err
+	}
+
+	entries, err := t.LedgerRepo.GetEntriesByReference(transactionID)
+	if err != nil {
+		logging.Logger.Error().Err(err).Str("transaction_id", transactionID).Msg("Failed to get transaction journal")
+		err = custom_err.ErrDatabase
+		return nil, "failed to get transaction journal", err
+	}
+
+	status := &types.TransactionJournalStatus{TransactionID: transactionID}
+	for _, entry := range entries {
+		switch entry.JournalType {
+		case entity.JournalTypeTransaction:
+			status.BalanceApplied = true
+		case entity.JournalTypeCompensation:
+			status.Compensated = true
+		}
+	}
+	status.Changes = entity.JournalChanges(entries, entity.JournalTypeTransaction)
+
+	return status, "transaction journal status retrieved successfully", nil
+}
@@ -0,0 +1,96 @@
+package transaction_saga
+
+import (
+	"account-service/internal/domain/entity"
+	custom_err "account-service/internal/domain/error"
+	"account-service/internal/domain/money"
+	mock_repo "account-service/internal/ports/mocks/repo"
+	"errors"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+// TestGetTransactionJournalStatus_Execute_Applied tests that a posted transaction journal is reported with its changes
+func TestGetTransactionJournalStatus_Execute_Applied(t *testing.T) {
+	mockLedgerRepo := new(mock_repo.MockLedgerRepo)
+	getTransactionJournalStatus := NewGetTransactionJournalStatus(mockLedgerRepo)
+
+	entries, _ := entity.NewJournal(entity.JournalTypeTransaction, "txn-1", []entity.BalanceChange{
+		{AccountID: "acc-1", Currency: entity.CurrencyUSD, Delta: money.MustParse("-100.00")},
+		{AccountID: "acc-2", Currency: entity.CurrencyUSD, Delta: money.MustParse("100.00")},
+	}, "user123")
+	mockLedgerRepo.On("GetEntriesByReference", "txn-1").Return(entries, nil)
+
+	status, message, err := getTransactionJournalStatus.Execute("txn-1", "user123", "req-1")
+
+	assert.NoError(t, err)
+	assert.Equal(t, "transaction journal status retrieved successfully", message)
+	assert.True(t, status.BalanceApplied)
+	assert.False(t, status.Compensated)
+	assert.Len(t, status.Changes, 2)
+	assert.Equal(t, money.MustParse("-100.00"), status.Changes[0].Delta)
+
+	mockLedgerRepo.AssertExpectations(t)
+}
+
+// TestGetTransactionJournalStatus_Execute_Compensated tests that a compensation journal is reported
+func TestGetTransactionJournalStatus_Execute_Compensated(t *testing.T) {
+	mockLedgerRepo := new(mock_repo.MockLedgerRepo)
+	getTransactionJournalStatus := NewGetTransactionJournalStatus(mockLedgerRepo)
+
+	entries, _ := entity.NewJournal(entity.JournalTypeTransaction, "txn-1", []entity.BalanceChange{
+		{AccountID: "acc-1", Currency: entity.CurrencyUSD, Delta: money.MustParse("50.00")},
+	}, "user123")
+	compensation, _ := entity.NewJournal(entity.JournalTypeCompensation, "txn-1", []entity.BalanceChange{
+		{AccountID: "acc-1", Currency: entity.CurrencyUSD, Delta: money.MustParse("-50.00")},
+	}, "user123")
+	mockLedgerRepo.On("GetEntriesByReference", "txn-1").Return(append(entries, compensation...), nil)
+
+	status, _, err := getTransactionJournalStatus.Execute("txn-1", "user123", "req-1")
+
+	assert.NoError(t, err)
+	assert.True(t, status.BalanceApplied)
+	assert.True(t, status.Compensated)
+}
+
+// TestGetTransactionJournalStatus_Execute_NotApplied tests that a transaction without journals is reported as not applied
+func TestGetTransactionJournalStatus_Execute_NotApplied(t *testing.T) {
+	mockLedgerRepo := new(mock_repo.MockLedgerRepo)
+	getTransactionJournalStatus := NewGetTransactionJournalStatus(mockLedgerRepo)
+
+	mockLedgerRepo.On("GetEntriesByReference", "txn-1").Return([]*entity.LedgerEntry{}, nil)
+
+	status, _, err := getTransactionJournalStatus.Execute("txn-1", "user123", "req-1")
+
+	assert.NoError(t, err)
+	assert.False(t, status.BalanceApplied)
+	assert.False(t, status.Compensated)
+	assert.Empty(t, status.Changes)
+}
+
+// TestGetTransactionJournalStatus_Execute_MissingTransactionID tests error response when transaction id is empty
+func TestGetTransactionJournalStatus_Execute_MissingTransactionID(t *testing.T) {
+	mockLedgerRepo := new(mock_repo.MockLedgerRepo)
+	getTransactionJournalStatus := NewGetTransactionJournalStatus(mockLedgerRepo)
+
+	status, message, err := getTransactionJournalStatus.Execute(" ", "user123", "req-1")
+
+	assert.ErrorIs(t, err, custom_err.ErrTransactionIdRequired)
+	assert.Equal(t, "transaction id is required", message)
+	assert.Nil(t, status)
+	mockLedgerRepo.AssertNotCalled(t, "GetEntriesByReference")
+}
+
+// TestGetTransactionJournalStatus_Execute_DatabaseError tests error response when the journal can not be read
+func TestGetTransactionJournalStatus_Execute_DatabaseError(t *testing.T) {
+	mockLedgerRepo := new(mock_repo.MockLedgerRepo)
+	getTransactionJournalStatus := NewGetTransactionJournalStatus(mockLedgerRepo)
+
+	mockLedgerRepo.On("GetEntriesByReference", "txn-1").Return(nil, errors.New("db error"))
+
+	status, message, err := getTransactionJournalStatus.Execute("txn-1", "user123", "req-1")
+
+	assert.ErrorIs(t, err, custom_err.ErrDatabase)
+	assert.Equal(t, "failed to get transaction journal", message)
+	assert.Nil(t, status)
+}
@@ -1,26 +1,40 @@
 package transaction_saga
 
 import (
+	"account-service/internal/domain/entity"
 	custom_err "account-service/internal/domain/error"
+	"account-service/internal/domain/money"
 	"account-service/internal/grpc/types"
 	"account-service/internal/logging"
+	"account-service/internal/messaging"
 	"account-service/internal/observability/metrics"
 	"account-service/internal/ports"
+	"strings"
+	"time"
 )
 
 // UpdateAccountBalanceForTransaction is a use-case for update account balance
 type UpdateAccountBalanceForTransaction struct {
 	AccountRepo ports.AccountRepo
+	HoldRepo    ports.HoldRepo
+	EventRepo   ports.EventRepo
 }
 
 // NewUpdateAccountBalanceForTransaction creates a new UpdateAccountBalanceForTransaction use-case
-func NewUpdateAccountBalanceForTransaction(accountRepo ports.AccountRepo) *UpdateAccountBalanceForTransaction {
+func NewUpdateAccountBalanceForTransaction(accountRepo ports.AccountRepo, holdRepo ports.HoldRepo, eventRepo ports.EventRepo) *UpdateAccountBalanceForTransaction {
 	return &UpdateAccountBalanceForTransaction{
 		AccountRepo: accountRepo,
+		HoldRepo:    holdRepo,
+		EventRepo:   eventRepo,
 	}
 }
 
-func (t *UpdateAccountBalanceForTransaction) Execute(accountBalanceUpdates []types.AccountBalance, requester string) ([]types.AccountBalanceResponse, string, error) {
+// Execute applies the balance updates of a transaction and journals them. Compensation marks
+// the updates as the rollback of an earlier update for the same transaction. A balance may only go
+// below zero within the overdraft limit of the account and a debit may not use funds reserved by active holds,
+// except the hold the update captures; accounts crossing into overdraft are reported. Frozen, dormant and closed
+// accounts reject the movements their status blocks.
+func (t *UpdateAccountBalanceForTransaction) Execute(accountBalanceUpdates []types.AccountBalance, transactionID string, compensation bool, requester string) ([]types.AccountBalanceResponse, string, error) {
 	metrics.IncRequestActive()
 	defer metrics.DecRequestActive()
 
@@ -35,7 +49,14 @@ func (t *UpdateAccountBalanceForTransaction) Execute(accountBalanceUpdates []typ
 		return nil, "at least one account balance update is required", err
 	}
 
-	for _, update := range accountBalanceUpdates {
+	if strings.TrimSpace(transactionID) == "" {
+		logging.Logger.Error().Err(custom_err.ErrTransactionIdRequired).Msg("Transaction id is required")
+		err = custom_err.ErrTransactionIdRequired
+		return nil, "transaction id is required", err
+	}
+
+	accounts := make([]*entity.Account, len(accountBalanceUpdates))
+	for i, update := range accountBalanceUpdates {
 		account, err := t.AccountRepo.GetAccountByID(update.AccountID)
 		if err != nil {
 			logging.Logger.Error().Err(err).Str("account_id", update.AccountID).Msg("Failed to lock accounts for transaction")
@@ -48,14 +69,149 @@ func (t *UpdateAccountBalanceForTransaction) Execute(accountBalanceUpdates []typ
 			err = custom_err.ErrAccountNotFound
 			return nil, "Account not found", err
 		}
+
+		// compensation restores an earlier balance and must not be blocked by a lowered limit
+		if !compensation && update.Balance.Add(account.OverdraftLimit).IsNegative() {
+			logging.Logger.Error().Str("account_id", update.AccountID).Str("balance", update.Balance.String()).
+				Str("overdraft_limit", account.OverdraftLimit.String()).Msg("Balance update exceeds overdraft limit")
+			err = custom_err.ErrInsufficientBalance
+			return nil, "balance update exceeds the overdraft limit of account " + update.AccountID, err
+		}
+		if !compensation {
+			if message, err := checkAccountStatus(account, update.Balance); err != nil {
+				return nil, message, err
+			}
+		}
+		accounts[i] = account
+	}
+
+	if !compensation {
+		if message, err := t.checkHeldFunds(accountBalanceUpdates, accounts); err != nil {
+			return nil, message, err
+		}
+	}
+
+	journalType := entity.JournalTypeTransaction
+	if compensation {
+		journalType = entity.JournalTypeCompensation
 	}
 
-	resp, err := t.AccountRepo.UpdateAccountBalanceLifecycle(accountBalanceUpdates, requester)
+	resp, err := t.AccountRepo.UpdateAccountBalanceLifecycle(accountBalanceUpdates, journalType, transactionID, requester)
 	if err != nil {
 		logging.Logger.Error().Err(err).Msg("Could not update account balance")
 		err = custom_err.ErrDatabase
 		return nil, "failed to update account balance", err
 	}
 
+	for i, update := range accountBalanceUpdates {
+		if !accounts[i].IsOverdrawn() && update.Balance.IsNegative() {
+			t.reportOverdrawn(accounts[i], update.Balance, transactionID, requester)
+		}
+		if update.HoldID != "" && !compensation {
+			t.reportHoldCaptured(update, transactionID, requester)
+		}
+	}
+
 	return resp, "account balances updated successfully", nil
 }
+
+// checkAccountStatus rejects a debit of an account that is frozen for debits, dormant or closed, and a credit of
+// an account that is frozen for credits or closed. Compensations restore an earlier balance and are not checked.
+func checkAccountStatus(account *entity.Account, newBalance money.Amount) (string, error) {
+	if newBalance < account.Balance && !account.AllowsDebit() {
+		logging.Logger.Error().Str("account_id", account.ID).Str("active_status", account.ActiveStatus).
+			Str("freeze_mode", account.FreezeMode).Msg("Account does not allow debits")
+		return "account " + account.ID + " does not allow debits", custom_err.ErrAccountDebitBlocked
+	}
+	if newBalance > account.Balance && !account.AllowsCredit() {
+		logging.Logger.Error().Str("account_id", account.ID).Str("active_status", account.ActiveStatus).
+			Str("freeze_mode", account.FreezeMode).Msg("Account does not allow credits")
+		return "account " + account.ID + " does not allow credits", custom_err.ErrAccountCreditBlocked
+	}
+	return "", nil
+}
+
+// checkHeldFunds rejects debits that would use funds reserved by active holds. The hold captured by an
+// update no longer reserves funds once the update is applied.
+func (t *UpdateAccountBalanceForTransaction) checkHeldFunds(accountBalanceUpdates []types.AccountBalance, accounts []*entity.Account) (string, error) {
+	var debitedIDs []string
+	for i, update := range accountBalanceUpdates {
+		if update.Balance < accounts[i].Balance {
+			debitedIDs = append(debitedIDs, update.AccountID)
+		}
+	}
+	if len(debitedIDs) == 0 {
+		return "", nil
+	}
+
+	heldAmounts, err := t.HoldRepo.GetHeldAmounts(debitedIDs)
+	if err != nil {
+		logging.Logger.Error().Err(err).Msg("Failed to get held amounts")
+		return "failed to get held amounts", custom_err.ErrDatabase
+	}
+
+	for i, update := range accountBalanceUpdates {
+		if update.Balance >= accounts[i].Balance {
+			continue
+		}
+
+		held := heldAmounts[update.AccountID]
+		if update.HoldID != "" {
+			hold, err := t.HoldRepo.GetHoldByID(update.HoldID)
+			if err != nil {
+				logging.Logger.Error().Err(err).Str("hold_id", update.HoldID).Msg("Failed to get hold")
+				return "failed to get hold", custom_err.ErrDatabase
+			}
+			if hold == nil || hold.AccountID != update.AccountID || !hold.IsActive(time.Now()) {
+				logging.Logger.Error().Str("hold_id", update.HoldID).Str("account_id", update.AccountID).Msg("Hold cannot be captured")
+				return "hold " + update.HoldID + " is not active on account " + update.AccountID, custom_err.ErrHoldNotActive
+			}
+			held = held.Sub(hold.Amount)
+		}
+
+		if update.Balance.Add(accounts[i].OverdraftLimit).Sub(held).IsNegative() {
+			logging.Logger.Error().Str("account_id", update.AccountID).Str("balance", update.Balance.String()).
+				Str("held_amount", held.String()).Msg("Balance update uses held funds")
+			return "balance update exceeds the available balance of account " + update.AccountID, custom_err.ErrInsufficientBalance
+		}
+	}
+	return "", nil
+}
+
+// reportHoldCaptured records and publishes that a hold was captured by the transaction
+func (t *UpdateAccountBalanceForTransaction) reportHoldCaptured(update types.AccountBalance, transactionID, requester string) {
+	eventData := map[string]interface{}{
+		"hold_id":         update.HoldID,
+		"account_id":      update.AccountID,
+		"captured_amount": update.HoldCaptureAmount,
+		"transaction_id":  transactionID,
+	}
+
+	event, eventErr := entity.NewEvent(entity.EventTypeHoldCaptured, update.HoldID, entity.EventAggregateTypeHold, requester, eventData)
+	if eventErr == nil {
+		if createErr := t.EventRepo.CreateEvent(event); createErr != nil {
+			logging.Logger.Error().Err(createErr).Str("hold_id", update.HoldID).Msg("Failed to create hold captured event")
+		}
+	}
+	_ = messaging.GetService().PublishToDefaultTopic(messaging.Message{Content: update.HoldID, Status: true, Type: messaging.MessageTypeCaptureHold})
+}
+
+// reportOverdrawn records and publishes that an account crossed into overdraft
+func (t *UpdateAccountBalanceForTransaction) reportOverdrawn(account *entity.Account, balance money.Amount, transactionID, requester string) {
+	eventData := map[string]interface{}{
+		"account_id":       account.ID,
+		"customer_id":      account.CustomerID,
+		"previous_balance": account.Balance,
+		"balance":          balance,
+		"overdraft_limit":  account.OverdraftLimit,
+		"transaction_id":   transactionID,
+	}
+
+	event, eventErr := entity.NewEvent(entity.EventTypeAccountOverdrawn, account.ID, entity.EventAggregateTypeAccount, requester, eventData)
+	if eventErr == nil {
+		if createErr := t.EventRepo.CreateEvent(event); createErr != nil {
+			logging.Logger.Error().Err(createErr).Str("account_id", account.ID).Msg("Failed to create account overdrawn event")
+		}
+	}
+	_ = messaging.GetService().PublishToDefaultTopic(messaging.Message{Content: account.ID, Status: true, Type: messaging.MessageTypeOverdrawn})
+}
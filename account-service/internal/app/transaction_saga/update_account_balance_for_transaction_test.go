@@ -3,21 +3,25 @@ package transaction_saga
 import (
 	"account-service/internal/domain/entity"
 	custom_err "account-service/internal/domain/error"
+	"account-service/internal/domain/money"
 	"account-service/internal/grpc/types"
 	mock_repo "account-service/internal/ports/mocks/repo"
 	"errors"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"strings"
 	"testing"
+	"time"
 )
 
 // TestUpdateAccountBalanceForTransaction_Execute_Success tests success response if all inputs are properly provided
 func TestUpdateAccountBalanceForTransaction_Execute_Success(t *testing.T) {
 	mockAccountRepo := new(mock_repo.MockAccountRepo)
-	updateAccountBalanceForTransaction := NewUpdateAccountBalanceForTransaction(mockAccountRepo)
+	updateAccountBalanceForTransaction := NewUpdateAccountBalanceForTransaction(mockAccountRepo, new(mock_repo.MockHoldRepo), new(mock_repo.MockEventRepo))
 
 	accountBalanceUpdates := []types.AccountBalance{
-		{AccountID: "acc-1", Balance: 1500.0, Version: 1},
-		{AccountID: "acc-2", Balance: 2500.0, Version: 2},
+		{AccountID: "acc-1", Balance: money.MustParse("1500.00"), Version: 1},
+		{AccountID: "acc-2", Balance: money.MustParse("2500.00"), Version: 2},
 	}
 	requester := "user123"
 
@@ -31,9 +35,9 @@ func TestUpdateAccountBalanceForTransaction_Execute_Success(t *testing.T) {
 	mockAccountRepo.On("GetAccountByID", "acc-2").Return(&entity.Account{ID: "acc-2", Version: 2}, nil)
 
 	// Mock the actual balance update
-	mockAccountRepo.On("UpdateAccountBalanceLifecycle", accountBalanceUpdates, requester).Return(expectedResponses, nil)
+	mockAccountRepo.On("UpdateAccountBalanceLifecycle", accountBalanceUpdates, entity.JournalTypeTransaction, "txn-1", requester).Return(expectedResponses, nil)
 
-	responses, message, err := updateAccountBalanceForTransaction.Execute(accountBalanceUpdates, requester)
+	responses, message, err := updateAccountBalanceForTransaction.Execute(accountBalanceUpdates, "txn-1", false, requester)
 
 	assert.NoError(t, err)
 	assert.Equal(t, "account balances updated successfully", message)
@@ -45,12 +49,12 @@ func TestUpdateAccountBalanceForTransaction_Execute_Success(t *testing.T) {
 // TestUpdateAccountBalanceForTransaction_Execute_EmptyUpdates tests error response when account balance updates are empty
 func TestUpdateAccountBalanceForTransaction_Execute_EmptyUpdates(t *testing.T) {
 	mockAccountRepo := new(mock_repo.MockAccountRepo)
-	updateAccountBalanceForTransaction := NewUpdateAccountBalanceForTransaction(mockAccountRepo)
+	updateAccountBalanceForTransaction := NewUpdateAccountBalanceForTransaction(mockAccountRepo, new(mock_repo.MockHoldRepo), new(mock_repo.MockEventRepo))
 
 	var accountBalanceUpdates []types.AccountBalance
 	requester := "user123"
 
-	responses, message, err := updateAccountBalanceForTransaction.Execute(accountBalanceUpdates, requester)
+	responses, message, err := updateAccountBalanceForTransaction.Execute(accountBalanceUpdates, "txn-1", false, requester)
 
 	assert.Error(t, err)
 	assert.Equal(t, "at least one account balance update is required", message)
@@ -63,12 +67,12 @@ func TestUpdateAccountBalanceForTransaction_Execute_EmptyUpdates(t *testing.T) {
 // TestUpdateAccountBalanceForTransaction_Execute_NilUpdates tests error response when account balance updates is nil
 func TestUpdateAccountBalanceForTransaction_Execute_NilUpdates(t *testing.T) {
 	mockAccountRepo := new(mock_repo.MockAccountRepo)
-	updateAccountBalanceForTransaction := NewUpdateAccountBalanceForTransaction(mockAccountRepo)
+	updateAccountBalanceForTransaction := NewUpdateAccountBalanceForTransaction(mockAccountRepo, new(mock_repo.MockHoldRepo), new(mock_repo.MockEventRepo))
 
 	var accountBalanceUpdates []types.AccountBalance = nil
 	requester := "user123"
 
-	responses, message, err := updateAccountBalanceForTransaction.Execute(accountBalanceUpdates, requester)
+	responses, message, err := updateAccountBalanceForTransaction.Execute(accountBalanceUpdates, "txn-1", false, requester)
 
 	assert.Error(t, err)
 	assert.Equal(t, "at least one account balance update is required", message)
@@ -81,11 +85,11 @@ func TestUpdateAccountBalanceForTransaction_Execute_NilUpdates(t *testing.T) {
 // TestUpdateAccountBalanceForTransaction_Execute_AccountNotFound tests error response when an account is not found
 func TestUpdateAccountBalanceForTransaction_Execute_AccountNotFound(t *testing.T) {
 	mockAccountRepo := new(mock_repo.MockAccountRepo)
-	updateAccountBalanceForTransaction := NewUpdateAccountBalanceForTransaction(mockAccountRepo)
+	updateAccountBalanceForTransaction := NewUpdateAccountBalanceForTransaction(mockAccountRepo, new(mock_repo.MockHoldRepo), new(mock_repo.MockEventRepo))
 
 	accountBalanceUpdates := []types.AccountBalance{
-		{AccountID: "acc-1", Balance: 1500.0, Version: 1},
-		{AccountID: "acc-nonexistent", Balance: 2500.0, Version: 2},
+		{AccountID: "acc-1", Balance: money.MustParse("1500.00"), Version: 1},
+		{AccountID: "acc-nonexistent", Balance: money.MustParse("2500.00"), Version: 2},
 	}
 	requester := "user123"
 
@@ -93,7 +97,7 @@ func TestUpdateAccountBalanceForTransaction_Execute_AccountNotFound(t *testing.T
 	mockAccountRepo.On("GetAccountByID", "acc-1").Return(&entity.Account{ID: "acc-1", Version: 1}, nil)
 	mockAccountRepo.On("GetAccountByID", "acc-nonexistent").Return(nil, nil)
 
-	responses, message, err := updateAccountBalanceForTransaction.Execute(accountBalanceUpdates, requester)
+	responses, message, err := updateAccountBalanceForTransaction.Execute(accountBalanceUpdates, "txn-1", false, requester)
 
 	assert.Error(t, err)
 	assert.Equal(t, "Account not found", message)
@@ -107,16 +111,16 @@ func TestUpdateAccountBalanceForTransaction_Execute_AccountNotFound(t *testing.T
 // TestUpdateAccountBalanceForTransaction_Execute_GetAccountError tests error response when GetAccountByID returns an error
 func TestUpdateAccountBalanceForTransaction_Execute_GetAccountError(t *testing.T) {
 	mockAccountRepo := new(mock_repo.MockAccountRepo)
-	updateAccountBalanceForTransaction := NewUpdateAccountBalanceForTransaction(mockAccountRepo)
+	updateAccountBalanceForTransaction := NewUpdateAccountBalanceForTransaction(mockAccountRepo, new(mock_repo.MockHoldRepo), new(mock_repo.MockEventRepo))
 
 	accountBalanceUpdates := []types.AccountBalance{
-		{AccountID: "acc-1", Balance: 1500.0, Version: 1},
+		{AccountID: "acc-1", Balance: money.MustParse("1500.00"), Version: 1},
 	}
 	requester := "user123"
 
 	mockAccountRepo.On("GetAccountByID", "acc-1").Return(nil, errors.New("database error"))
 
-	responses, message, err := updateAccountBalanceForTransaction.Execute(accountBalanceUpdates, requester)
+	responses, message, err := updateAccountBalanceForTransaction.Execute(accountBalanceUpdates, "txn-1", false, requester)
 
 	assert.Error(t, err)
 	assert.Equal(t, "failed to lock accounts for transaction", message)
@@ -130,11 +134,11 @@ func TestUpdateAccountBalanceForTransaction_Execute_GetAccountError(t *testing.T
 // TestUpdateAccountBalanceForTransaction_Execute_UpdateBalanceError tests error response when UpdateAccountBalanceLifecycle returns an error
 func TestUpdateAccountBalanceForTransaction_Execute_UpdateBalanceError(t *testing.T) {
 	mockAccountRepo := new(mock_repo.MockAccountRepo)
-	updateAccountBalanceForTransaction := NewUpdateAccountBalanceForTransaction(mockAccountRepo)
+	updateAccountBalanceForTransaction := NewUpdateAccountBalanceForTransaction(mockAccountRepo, new(mock_repo.MockHoldRepo), new(mock_repo.MockEventRepo))
 
 	accountBalanceUpdates := []types.AccountBalance{
-		{AccountID: "acc-1", Balance: 1500.0, Version: 1},
-		{AccountID: "acc-2", Balance: 2500.0, Version: 2},
+		{AccountID: "acc-1", Balance: money.MustParse("1500.00"), Version: 1},
+		{AccountID: "acc-2", Balance: money.MustParse("2500.00"), Version: 2},
 	}
 	requester := "user123"
 
@@ -143,9 +147,9 @@ func TestUpdateAccountBalanceForTransaction_Execute_UpdateBalanceError(t *testin
 	mockAccountRepo.On("GetAccountByID", "acc-2").Return(&entity.Account{ID: "acc-2", Version: 2}, nil)
 
 	// Mock the actual balance update to return error
-	mockAccountRepo.On("UpdateAccountBalanceLifecycle", accountBalanceUpdates, requester).Return(nil, errors.New("update failed"))
+	mockAccountRepo.On("UpdateAccountBalanceLifecycle", accountBalanceUpdates, entity.JournalTypeTransaction, "txn-1", requester).Return(nil, errors.New("update failed"))
 
-	responses, message, err := updateAccountBalanceForTransaction.Execute(accountBalanceUpdates, requester)
+	responses, message, err := updateAccountBalanceForTransaction.Execute(accountBalanceUpdates, "txn-1", false, requester)
 
 	assert.Error(t, err)
 	assert.Equal(t, "failed to update account balance", message)
@@ -158,10 +162,10 @@ func TestUpdateAccountBalanceForTransaction_Execute_UpdateBalanceError(t *testin
 // TestUpdateAccountBalanceForTransaction_Execute_SingleUpdate tests success response with single account balance update
 func TestUpdateAccountBalanceForTransaction_Execute_SingleUpdate(t *testing.T) {
 	mockAccountRepo := new(mock_repo.MockAccountRepo)
-	updateAccountBalanceForTransaction := NewUpdateAccountBalanceForTransaction(mockAccountRepo)
+	updateAccountBalanceForTransaction := NewUpdateAccountBalanceForTransaction(mockAccountRepo, new(mock_repo.MockHoldRepo), new(mock_repo.MockEventRepo))
 
 	accountBalanceUpdates := []types.AccountBalance{
-		{AccountID: "acc-1", Balance: 1500.0, Version: 1},
+		{AccountID: "acc-1", Balance: money.MustParse("1500.00"), Version: 1},
 	}
 	requester := "user123"
 
@@ -170,9 +174,9 @@ func TestUpdateAccountBalanceForTransaction_Execute_SingleUpdate(t *testing.T) {
 	}
 
 	mockAccountRepo.On("GetAccountByID", "acc-1").Return(&entity.Account{ID: "acc-1", Version: 1}, nil)
-	mockAccountRepo.On("UpdateAccountBalanceLifecycle", accountBalanceUpdates, requester).Return(expectedResponses, nil)
+	mockAccountRepo.On("UpdateAccountBalanceLifecycle", accountBalanceUpdates, entity.JournalTypeTransaction, "txn-1", requester).Return(expectedResponses, nil)
 
-	responses, message, err := updateAccountBalanceForTransaction.Execute(accountBalanceUpdates, requester)
+	responses, message, err := updateAccountBalanceForTransaction.Execute(accountBalanceUpdates, "txn-1", false, requester)
 
 	assert.NoError(t, err)
 	assert.Equal(t, "account balances updated successfully", message)
@@ -180,3 +184,193 @@ func TestUpdateAccountBalanceForTransaction_Execute_SingleUpdate(t *testing.T) {
 
 	mockAccountRepo.AssertExpectations(t)
 }
+
+// TestUpdateAccountBalanceForTransaction_Execute_Compensation tests that rollback updates are journaled as compensation
+func TestUpdateAccountBalanceForTransaction_Execute_Compensation(t *testing.T) {
+	mockAccountRepo := new(mock_repo.MockAccountRepo)
+	updateAccountBalanceForTransaction := NewUpdateAccountBalanceForTransaction(mockAccountRepo, new(mock_repo.MockHoldRepo), new(mock_repo.MockEventRepo))
+
+	accountBalanceUpdates := []types.AccountBalance{
+		{AccountID: "acc-1", Balance: money.MustParse("1500.00"), Version: 2},
+	}
+	requester := "user123"
+
+	expectedResponses := []types.AccountBalanceResponse{
+		{AccountID: "acc-1", Version: 3},
+	}
+
+	mockAccountRepo.On("GetAccountByID", "acc-1").Return(&entity.Account{ID: "acc-1", Version: 2}, nil)
+	mockAccountRepo.On("UpdateAccountBalanceLifecycle", accountBalanceUpdates, entity.JournalTypeCompensation, "txn-1", requester).Return(expectedResponses, nil)
+
+	responses, _, err := updateAccountBalanceForTransaction.Execute(accountBalanceUpdates, "txn-1", true, requester)
+
+	assert.NoError(t, err)
+	assert.Equal(t, expectedResponses, responses)
+
+	mockAccountRepo.AssertExpectations(t)
+}
+
+// TestUpdateAccountBalanceForTransaction_Execute_MissingTransactionID tests error response when transaction id is empty
+func TestUpdateAccountBalanceForTransaction_Execute_MissingTransactionID(t *testing.T) {
+	mockAccountRepo := new(mock_repo.MockAccountRepo)
+	updateAccountBalanceForTransaction := NewUpdateAccountBalanceForTransaction(mockAccountRepo, new(mock_repo.MockHoldRepo), new(mock_repo.MockEventRepo))
+
+	accountBalanceUpdates := []types.AccountBalance{
+		{AccountID: "acc-1", Balance: money.MustParse("1500.00"), Version: 1},
+	}
+
+	responses, message, err := updateAccountBalanceForTransaction.Execute(accountBalanceUpdates, " ", false, "user123")
+
+	assert.ErrorIs(t, err, custom_err.ErrTransactionIdRequired)
+	assert.Equal(t, "transaction id is required", message)
+	assert.Nil(t, responses)
+
+	mockAccountRepo.AssertNotCalled(t, "GetAccountByID")
+	mockAccountRepo.AssertNotCalled(t, "UpdateAccountBalanceLifecycle")
+}
+
+// TestUpdateAccountBalanceForTransaction_Execute_Overdraft tests that a balance may go negative within the overdraft
+// limit and crossing into overdraft is reported
+func TestUpdateAccountBalanceForTransaction_Execute_Overdraft(t *testing.T) {
+	mockAccountRepo := new(mock_repo.MockAccountRepo)
+	mockHoldRepo := new(mock_repo.MockHoldRepo)
+	mockEventRepo := new(mock_repo.MockEventRepo)
+	updateAccountBalanceForTransaction := NewUpdateAccountBalanceForTransaction(mockAccountRepo, mockHoldRepo, mockEventRepo)
+
+	account := &entity.Account{
+		ID:             "acc-1",
+		AccountType:    entity.AccountTypeCurrent,
+		Balance:        money.MustParse("100.00"),
+		OverdraftLimit: money.MustParse("500.00"),
+		Version:        1,
+	}
+	mockAccountRepo.On("GetAccountByID", "acc-1").Return(account, nil)
+	mockHoldRepo.On("GetHeldAmounts", []string{"acc-1"}).Return(map[string]money.Amount{}, nil)
+
+	// beyond the overdraft limit
+	responses, message, err := updateAccountBalanceForTransaction.Execute([]types.AccountBalance{
+		{AccountID: "acc-1", Balance: money.MustParse("-500.01"), Version: 1},
+	}, "txn-1", false, "user123")
+
+	assert.ErrorIs(t, err, custom_err.ErrInsufficientBalance)
+	assert.Equal(t, "balance update exceeds the overdraft limit of account acc-1", message)
+	assert.Nil(t, responses)
+	mockAccountRepo.AssertNotCalled(t, "UpdateAccountBalanceLifecycle")
+
+	// within the overdraft limit
+	accountBalanceUpdates := []types.AccountBalance{
+		{AccountID: "acc-1", Balance: money.MustParse("-400.00"), Version: 1},
+	}
+	expectedResponses := []types.AccountBalanceResponse{{AccountID: "acc-1", Version: 2}}
+	mockAccountRepo.On("UpdateAccountBalanceLifecycle", accountBalanceUpdates, entity.JournalTypeTransaction, "txn-2", "user123").Return(expectedResponses, nil)
+	mockEventRepo.On("CreateEvent", mock.MatchedBy(func(event *entity.Event) bool {
+		return event.Type == entity.EventTypeAccountOverdrawn && event.AggregateID == "acc-1"
+	})).Return(nil)
+
+	responses, _, err = updateAccountBalanceForTransaction.Execute(accountBalanceUpdates, "txn-2", false, "user123")
+
+	assert.NoError(t, err)
+	assert.Equal(t, expectedResponses, responses)
+	mockEventRepo.AssertExpectations(t)
+}
+
+// TestUpdateAccountBalanceForTransaction_Execute_Holds tests that a debit cannot use held funds unless it captures the hold
+func TestUpdateAccountBalanceForTransaction_Execute_Holds(t *testing.T) {
+	mockAccountRepo := new(mock_repo.MockAccountRepo)
+	mockHoldRepo := new(mock_repo.MockHoldRepo)
+	mockEventRepo := new(mock_repo.MockEventRepo)
+	updateAccountBalanceForTransaction := NewUpdateAccountBalanceForTransaction(mockAccountRepo, mockHoldRepo, mockEventRepo)
+
+	account := &entity.Account{ID: "acc-1", Balance: money.MustParse("100.00"), Version: 1}
+	hold := &entity.Hold{
+		ID:        "hold-1",
+		AccountID: "acc-1",
+		Amount:    money.MustParse("80.00"),
+		Status:    entity.HoldStatusActive,
+		ExpiresAt: time.Now().Add(time.Hour),
+	}
+	mockAccountRepo.On("GetAccountByID", "acc-1").Return(account, nil)
+	mockHoldRepo.On("GetHeldAmounts", []string{"acc-1"}).Return(map[string]money.Amount{"acc-1": money.MustParse("80.00")}, nil)
+	mockHoldRepo.On("GetHoldByID", "hold-1").Return(hold, nil)
+
+	// only 20.00 is not held
+	responses, message, err := updateAccountBalanceForTransaction.Execute([]types.AccountBalance{
+		{AccountID: "acc-1", Balance: money.MustParse("50.00"), Version: 1},
+	}, "txn-1", false, "user123")
+
+	assert.ErrorIs(t, err, custom_err.ErrInsufficientBalance)
+	assert.Equal(t, "balance update exceeds the available balance of account acc-1", message)
+	assert.Nil(t, responses)
+	mockAccountRepo.AssertNotCalled(t, "UpdateAccountBalanceLifecycle")
+
+	// capturing the hold frees the held funds
+	accountBalanceUpdates := []types.AccountBalance{
+		{AccountID: "acc-1", Balance: money.MustParse("50.00"), Version: 1, HoldID: "hold-1", HoldCaptureAmount: money.MustParse("50.00")},
+	}
+	expectedResponses := []types.AccountBalanceResponse{{AccountID: "acc-1", Version: 2}}
+	mockAccountRepo.On("UpdateAccountBalanceLifecycle", accountBalanceUpdates, entity.JournalTypeTransaction, "txn-2", "user123").Return(expectedResponses, nil)
+	mockEventRepo.On("CreateEvent", mock.MatchedBy(func(event *entity.Event) bool {
+		return event.Type == entity.EventTypeHoldCaptured && event.AggregateID == "hold-1"
+	})).Return(nil)
+
+	responses, _, err = updateAccountBalanceForTransaction.Execute(accountBalanceUpdates, "txn-2", false, "user123")
+
+	assert.NoError(t, err)
+	assert.Equal(t, expectedResponses, responses)
+	mockHoldRepo.AssertExpectations(t)
+	mockEventRepo.AssertExpectations(t)
+}
+
+// TestUpdateAccountBalanceForTransaction_Execute_AccountStatus tests that frozen, dormant and closed accounts reject the
+// movements their status blocks while compensations still restore them
+func TestUpdateAccountBalanceForTransaction_Execute_AccountStatus(t *testing.T) {
+	tests := []struct {
+		name    string
+		account entity.Account
+		balance money.Amount
+		err     error
+	}{
+		{"FrozenDebit", entity.Account{ActiveStatus: entity.AccountActiveStatusLocked, FreezeMode: entity.AccountFreezeModeDebit}, money.MustParse("50.00"), custom_err.ErrAccountDebitBlocked},
+		{"FrozenCredit", entity.Account{ActiveStatus: entity.AccountActiveStatusLocked, FreezeMode: entity.AccountFreezeModeCredit}, money.MustParse("150.00"), custom_err.ErrAccountCreditBlocked},
+		{"FrozenBoth", entity.Account{ActiveStatus: entity.AccountActiveStatusLocked, FreezeMode: entity.AccountFreezeModeBoth}, money.MustParse("150.00"), custom_err.ErrAccountCreditBlocked},
+		{"DormantDebit", entity.Account{ActiveStatus: entity.AccountActiveStatusInactive}, money.MustParse("50.00"), custom_err.ErrAccountDebitBlocked},
+		{"DormantCredit", entity.Account{ActiveStatus: entity.AccountActiveStatusInactive}, money.MustParse("150.00"), nil},
+		{"FrozenCreditDebit", entity.Account{ActiveStatus: entity.AccountActiveStatusLocked, FreezeMode: entity.AccountFreezeModeCredit}, money.MustParse("50.00"), nil},
+		{"ClosedCredit", entity.Account{ActiveStatus: entity.AccountActiveStatusClosed}, money.MustParse("150.00"), custom_err.ErrAccountCreditBlocked},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockAccountRepo := new(mock_repo.MockAccountRepo)
+			mockHoldRepo := new(mock_repo.MockHoldRepo)
+			updateAccountBalanceForTransaction := NewUpdateAccountBalanceForTransaction(mockAccountRepo, mockHoldRepo, new(mock_repo.MockEventRepo))
+
+			account := tt.account
+			account.ID = "acc-1"
+			account.Balance = money.MustParse("100.00")
+			account.Version = 1
+			mockAccountRepo.On("GetAccountByID", "acc-1").Return(&account, nil)
+			mockHoldRepo.On("GetHeldAmounts", []string{"acc-1"}).Return(map[string]money.Amount{}, nil).Maybe()
+
+			updates := []types.AccountBalance{{AccountID: "acc-1", Balance: tt.balance, Version: 1}}
+			expectedResponses := []types.AccountBalanceResponse{{AccountID: "acc-1", Version: 2}}
+			mockAccountRepo.On("UpdateAccountBalanceLifecycle", updates, mock.Anything, "txn-1", "user123").Return(expectedResponses, nil).Maybe()
+
+			responses, message, err := updateAccountBalanceForTransaction.Execute(updates, "txn-1", false, "user123")
+
+			if tt.err == nil {
+				assert.NoError(t, err)
+				assert.Equal(t, expectedResponses, responses)
+				return
+			}
+			assert.ErrorIs(t, err, tt.err)
+			assert.Equal(t, strings.Replace(tt.err.Error(), "account", "account acc-1", 1), message)
+			mockAccountRepo.AssertNotCalled(t, "UpdateAccountBalanceLifecycle", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+
+			// the compensation of an earlier update is not blocked
+			responses, _, err = updateAccountBalanceForTransaction.Execute(updates, "txn-1", true, "user123")
+			assert.NoError(t, err)
+			assert.Equal(t, expectedResponses, responses)
+		})
+	}
+}
@@ -12,15 +12,20 @@ import (
 // ValidateAccountForTransaction is a use-case for validating accounts before transaction
 type ValidateAccountForTransaction struct {
 	AccountRepo ports.AccountRepo
+	HoldRepo    ports.HoldRepo
 }
 
 // NewValidateAccountForTransaction creates a new ValidateAccountForTransaction use-case
-func NewValidateAccountForTransaction(accountRepo ports.AccountRepo) *ValidateAccountForTransaction {
+func NewValidateAccountForTransaction(accountRepo ports.AccountRepo, holdRepo ports.HoldRepo) *ValidateAccountForTransaction {
 	return &ValidateAccountForTransaction{
 		AccountRepo: accountRepo,
+		HoldRepo:    holdRepo,
 	}
 }
 
+// Execute checks that all accounts can transact and returns them with their held amount, so the
+// caller can check the available balance
+
 func (t *ValidateAccountForTransaction) Execute(transactionId string, accountsIds []string, requester, requestId string) ([]*entity.Account, string, error) {
 	metrics.IncRequestActive()
 	defer metrics.DecRequestActive()
@@ -59,5 +64,15 @@ func (t *ValidateAccountForTransaction) Execute(transactionId string, accountsId
 		accounts = append(accounts, account)
 	}
 
+	heldAmounts, err := t.HoldRepo.GetHeldAmounts(accountsIds)
+	if err != nil {
+		logging.Logger.Error().Err(err).Msg("Failed to get held amounts")
+		err = custom_err.ErrDatabase
+		return nil, "Failed to get held amounts", err
+	}
+	for _, account := range accounts {
+		account.HeldAmount = heldAmounts[account.ID]
+	}
+
 	return accounts, "All accounts are valid for transaction", nil
 }
@@ -3,16 +3,20 @@ package transaction_saga
 import (
 	"account-service/internal/domain/entity"
 	custom_err "account-service/internal/domain/error"
+	"account-service/internal/domain/money"
 	mock_repo "account-service/internal/ports/mocks/repo"
 	"errors"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 	"testing"
 )
 
 // TestValidateAccountForTransaction_Execute_Success tests success response if all inputs are properly provided
 func TestValidateAccountForTransaction_Execute_Success(t *testing.T) {
 	mockAccountRepo := new(mock_repo.MockAccountRepo)
-	validateAccountForTransaction := NewValidateAccountForTransaction(mockAccountRepo)
+	mockHoldRepo := new(mock_repo.MockHoldRepo)
+	mockHoldRepo.On("GetHeldAmounts", mock.Anything).Return(map[string]money.Amount{}, nil).Maybe()
+	validateAccountForTransaction := NewValidateAccountForTransaction(mockAccountRepo, mockHoldRepo)
 
 	transactionID := "tx-123"
 	accountIDs := []string{"acc-1", "acc-2", "acc-3"}
@@ -27,7 +31,7 @@ func TestValidateAccountForTransaction_Execute_Success(t *testing.T) {
 			ActiveStatus:        "active",
 			LockedForTx:         false,
 			ActiveTransactionID: nil,
-			Balance:             1000.0,
+			Balance:             money.MustParse("1000.00"),
 		},
 		{
 			ID:                  "acc-2",
@@ -35,7 +39,7 @@ func TestValidateAccountForTransaction_Execute_Success(t *testing.T) {
 			ActiveStatus:        "active",
 			LockedForTx:         false,
 			ActiveTransactionID: nil,
-			Balance:             2000.0,
+			Balance:             money.MustParse("2000.00"),
 		},
 		{
 			ID:                  "acc-3",
@@ -43,7 +47,7 @@ func TestValidateAccountForTransaction_Execute_Success(t *testing.T) {
 			ActiveStatus:        "active",
 			LockedForTx:         false,
 			ActiveTransactionID: nil,
-			Balance:             3000.0,
+			Balance:             money.MustParse("3000.00"),
 		},
 	}
 
@@ -63,7 +67,9 @@ func TestValidateAccountForTransaction_Execute_Success(t *testing.T) {
 // TestValidateAccountForTransaction_Execute_EmptyAccountIDs tests error response when account IDs are empty
 func TestValidateAccountForTransaction_Execute_EmptyAccountIDs(t *testing.T) {
 	mockAccountRepo := new(mock_repo.MockAccountRepo)
-	validateAccountForTransaction := NewValidateAccountForTransaction(mockAccountRepo)
+	mockHoldRepo := new(mock_repo.MockHoldRepo)
+	mockHoldRepo.On("GetHeldAmounts", mock.Anything).Return(map[string]money.Amount{}, nil).Maybe()
+	validateAccountForTransaction := NewValidateAccountForTransaction(mockAccountRepo, mockHoldRepo)
 
 	transactionID := "tx-123"
 	accountIDs := []string{}
@@ -83,7 +89,9 @@ func TestValidateAccountForTransaction_Execute_EmptyAccountIDs(t *testing.T) {
 // TestValidateAccountForTransaction_Execute_NilAccountIDs tests error response when account IDs is nil
 func TestValidateAccountForTransaction_Execute_NilAccountIDs(t *testing.T) {
 	mockAccountRepo := new(mock_repo.MockAccountRepo)
-	validateAccountForTransaction := NewValidateAccountForTransaction(mockAccountRepo)
+	mockHoldRepo := new(mock_repo.MockHoldRepo)
+	mockHoldRepo.On("GetHeldAmounts", mock.Anything).Return(map[string]money.Amount{}, nil).Maybe()
+	validateAccountForTransaction := NewValidateAccountForTransaction(mockAccountRepo, mockHoldRepo)
 
 	transactionID := "tx-123"
 	var accountIDs []string = nil
@@ -103,7 +111,9 @@ func TestValidateAccountForTransaction_Execute_NilAccountIDs(t *testing.T) {
 // TestValidateAccountForTransaction_Execute_AccountNotFound tests error response when an account is not found
 func TestValidateAccountForTransaction_Execute_AccountNotFound(t *testing.T) {
 	mockAccountRepo := new(mock_repo.MockAccountRepo)
-	validateAccountForTransaction := NewValidateAccountForTransaction(mockAccountRepo)
+	mockHoldRepo := new(mock_repo.MockHoldRepo)
+	mockHoldRepo.On("GetHeldAmounts", mock.Anything).Return(map[string]money.Amount{}, nil).Maybe()
+	validateAccountForTransaction := NewValidateAccountForTransaction(mockAccountRepo, mockHoldRepo)
 
 	transactionID := "tx-123"
 	accountIDs := []string{"acc-1", "acc-nonexistent"}
@@ -116,7 +126,7 @@ func TestValidateAccountForTransaction_Execute_AccountNotFound(t *testing.T) {
 		ActiveStatus:        "active",
 		LockedForTx:         false,
 		ActiveTransactionID: nil,
-		Balance:             1000.0,
+		Balance:             money.MustParse("1000.00"),
 	}
 
 	// First account exists, second doesn't
@@ -136,7 +146,9 @@ func TestValidateAccountForTransaction_Execute_AccountNotFound(t *testing.T) {
 // TestValidateAccountForTransaction_Execute_GetAccountError tests error response when GetAccountByID returns an error
 func TestValidateAccountForTransaction_Execute_GetAccountError(t *testing.T) {
 	mockAccountRepo := new(mock_repo.MockAccountRepo)
-	validateAccountForTransaction := NewValidateAccountForTransaction(mockAccountRepo)
+	mockHoldRepo := new(mock_repo.MockHoldRepo)
+	mockHoldRepo.On("GetHeldAmounts", mock.Anything).Return(map[string]money.Amount{}, nil).Maybe()
+	validateAccountForTransaction := NewValidateAccountForTransaction(mockAccountRepo, mockHoldRepo)
 
 	transactionID := "tx-123"
 	accountIDs := []string{"acc-1"}
@@ -158,7 +170,9 @@ func TestValidateAccountForTransaction_Execute_GetAccountError(t *testing.T) {
 // TestValidateAccountForTransaction_Execute_AccountLockedForTx tests error response when account is locked for transaction
 func TestValidateAccountForTransaction_Execute_AccountLockedForTx(t *testing.T) {
 	mockAccountRepo := new(mock_repo.MockAccountRepo)
-	validateAccountForTransaction := NewValidateAccountForTransaction(mockAccountRepo)
+	mockHoldRepo := new(mock_repo.MockHoldRepo)
+	mockHoldRepo.On("GetHeldAmounts", mock.Anything).Return(map[string]money.Amount{}, nil).Maybe()
+	validateAccountForTransaction := NewValidateAccountForTransaction(mockAccountRepo, mockHoldRepo)
 
 	transactionID := "tx-123"
 	accountIDs := []string{"acc-1", "acc-locked"}
@@ -171,7 +185,7 @@ func TestValidateAccountForTransaction_Execute_AccountLockedForTx(t *testing.T)
 		ActiveStatus:        "active",
 		LockedForTx:         false,
 		ActiveTransactionID: nil,
-		Balance:             1000.0,
+		Balance:             money.MustParse("1000.00"),
 	}
 
 	// Create a locked account that cannot transact
@@ -181,7 +195,7 @@ func TestValidateAccountForTransaction_Execute_AccountLockedForTx(t *testing.T)
 		ActiveStatus:        "active",
 		LockedForTx:         true, // This makes CanTransact() return false
 		ActiveTransactionID: nil,
-		Balance:             2000.0,
+		Balance:             money.MustParse("2000.00"),
 	}
 
 	// First account is valid, second is locked
@@ -198,31 +212,33 @@ func TestValidateAccountForTransaction_Execute_AccountLockedForTx(t *testing.T)
 	mockAccountRepo.AssertExpectations(t)
 }
 
-// TestValidateAccountForTransaction_Execute_AccountInactiveStatus tests error response when account has inactive status
-func TestValidateAccountForTransaction_Execute_AccountInactiveStatus(t *testing.T) {
+// TestValidateAccountForTransaction_Execute_AccountClosedStatus tests error response when account is closed
+func TestValidateAccountForTransaction_Execute_AccountClosedStatus(t *testing.T) {
 	mockAccountRepo := new(mock_repo.MockAccountRepo)
-	validateAccountForTransaction := NewValidateAccountForTransaction(mockAccountRepo)
+	mockHoldRepo := new(mock_repo.MockHoldRepo)
+	mockHoldRepo.On("GetHeldAmounts", mock.Anything).Return(map[string]money.Amount{}, nil).Maybe()
+	validateAccountForTransaction := NewValidateAccountForTransaction(mockAccountRepo, mockHoldRepo)
 
 	transactionID := "tx-123"
-	accountIDs := []string{"acc-inactive"}
+	accountIDs := []string{"acc-closed"}
 	requester := "user123"
 	requestID := "req-456"
 
-	inactiveAccount := &entity.Account{
-		ID:                  "acc-inactive",
+	closedAccount := &entity.Account{
+		ID:                  "acc-closed",
 		Status:              entity.AccountStatusValid,
-		ActiveStatus:        "inactive", // This makes CanTransact() return false
+		ActiveStatus:        entity.AccountActiveStatusClosed, // This makes CanTransact() return false
 		LockedForTx:         false,
 		ActiveTransactionID: nil,
-		Balance:             1000.0,
+		Balance:             money.MustParse("1000.00"),
 	}
 
-	mockAccountRepo.On("GetAccountByID", "acc-inactive").Return(inactiveAccount, nil)
+	mockAccountRepo.On("GetAccountByID", "acc-closed").Return(closedAccount, nil)
 
 	accounts, message, err := validateAccountForTransaction.Execute(transactionID, accountIDs, requester, requestID)
 
 	assert.Error(t, err)
-	assert.Equal(t, "Account 'acc-inactive' cannot transact", message)
+	assert.Equal(t, "Account 'acc-closed' cannot transact", message)
 	assert.Equal(t, custom_err.ErrAccountLocked, err)
 	assert.Nil(t, accounts)
 
@@ -232,7 +248,9 @@ func TestValidateAccountForTransaction_Execute_AccountInactiveStatus(t *testing.
 // TestValidateAccountForTransaction_Execute_AccountWithActiveTransaction tests error response when account has active transaction
 func TestValidateAccountForTransaction_Execute_AccountWithActiveTransaction(t *testing.T) {
 	mockAccountRepo := new(mock_repo.MockAccountRepo)
-	validateAccountForTransaction := NewValidateAccountForTransaction(mockAccountRepo)
+	mockHoldRepo := new(mock_repo.MockHoldRepo)
+	mockHoldRepo.On("GetHeldAmounts", mock.Anything).Return(map[string]money.Amount{}, nil).Maybe()
+	validateAccountForTransaction := NewValidateAccountForTransaction(mockAccountRepo, mockHoldRepo)
 
 	transactionID := "tx-123"
 	accountIDs := []string{"acc-active-tx"}
@@ -246,7 +264,7 @@ func TestValidateAccountForTransaction_Execute_AccountWithActiveTransaction(t *t
 		ActiveStatus:        "active",
 		LockedForTx:         false,
 		ActiveTransactionID: &activeTxID, // This makes CanTransact() return false
-		Balance:             1000.0,
+		Balance:             money.MustParse("1000.00"),
 	}
 
 	mockAccountRepo.On("GetAccountByID", "acc-active-tx").Return(accountWithActiveTx, nil)
@@ -260,3 +278,30 @@ func TestValidateAccountForTransaction_Execute_AccountWithActiveTransaction(t *t
 
 	mockAccountRepo.AssertExpectations(t)
 }
+
+// TestValidateAccountForTransaction_Execute_WithHolds tests that the held amount of each account is returned
+func TestValidateAccountForTransaction_Execute_WithHolds(t *testing.T) {
+	mockAccountRepo := new(mock_repo.MockAccountRepo)
+	mockHoldRepo := new(mock_repo.MockHoldRepo)
+	validateAccountForTransaction := NewValidateAccountForTransaction(mockAccountRepo, mockHoldRepo)
+
+	account := &entity.Account{
+		ID:           "acc-1",
+		Status:       entity.AccountStatusValid,
+		ActiveStatus: "active",
+		Balance:      money.MustParse("1000.00"),
+	}
+
+	mockAccountRepo.On("GetAccountByID", "acc-1").Return(account, nil)
+	mockHoldRepo.On("GetHeldAmounts", []string{"acc-1"}).Return(map[string]money.Amount{"acc-1": money.MustParse("400.00")}, nil)
+
+	accounts, _, err := validateAccountForTransaction.Execute("tx-123", []string{"acc-1"}, "user123", "req-456")
+
+	assert.NoError(t, err)
+	assert.Len(t, accounts, 1)
+	assert.Equal(t, money.MustParse("400.00"), accounts[0].HeldAmount)
+	assert.Equal(t, money.MustParse("600.00"), accounts[0].AvailableBalance())
+
+	mockAccountRepo.AssertExpectations(t)
+	mockHoldRepo.AssertExpectations(t)
+}
@@ -62,10 +62,34 @@ type Config struct {
 	DB               DBConfig               `koanf:"db" validate:"required"`
 	MessagePublisher MessagePublisherConfig `koanf:"message_publisher" validate:"required"`
 	AccountConfig    AccountConfig          `koanf:"account" validate:"required"`
+	Interest         InterestConfig         `koanf:"interest" validate:"required"`
+	Statement        StatementConfig        `koanf:"statement" validate:"required"`
+	Dormancy         DormancyConfig         `koanf:"dormancy" validate:"required"`
 }
 
 type AccountConfig struct {
-	MinDepositAmount float64 `koanf:"min_deposit_amount"  validate:"gte=0"`
+	MinDepositAmount  string        `koanf:"min_deposit_amount"  validate:"numeric"` // decimal string, e.g. "100.00"
+	DefaultHoldExpiry time.Duration `koanf:"default_hold_expiry"`                    // used when a hold is placed without expiry
+}
+
+// InterestConfig controls the job that accrues daily interest and closes monthly postings
+type InterestConfig struct {
+	Enabled    bool          `koanf:"enabled"`
+	Interval   time.Duration `koanf:"interval"`
+	DaysInYear int           `koanf:"days_in_year" validate:"oneof=360 365"`
+}
+
+// StatementConfig controls the job that issues monthly account statements
+type StatementConfig struct {
+	Enabled  bool          `koanf:"enabled"`
+	Interval time.Duration `koanf:"interval"`
+}
+
+// DormancyConfig controls the job that marks accounts dormant after months without customer transactions
+type DormancyConfig struct {
+	Enabled        bool          `koanf:"enabled"`
+	Interval       time.Duration `koanf:"interval"`
+	InactiveMonths int           `koanf:"inactive_months" validate:"gte=1"`
 }
 
 type AuthConfig struct {
@@ -309,7 +333,22 @@ func defaults() map[string]any {
 			"stale_threshold": 24 * time.Hour,
 		},
 		"account": map[string]any{
-			"min_deposit_amount": 0,
+			"min_deposit_amount":  "0",
+			"default_hold_expiry": 7 * 24 * time.Hour,
+		},
+		"interest": map[string]any{
+			"enabled":      true,
+			"interval":     1 * time.Hour,
+			"days_in_year": 365,
+		},
+		"statement": map[string]any{
+			"enabled":  true,
+			"interval": 1 * time.Hour,
+		},
+		"dormancy": map[string]any{
+			"enabled":         true,
+			"interval":        24 * time.Hour,
+			"inactive_months": 12,
 		},
 		"message_publisher": map[string]any{
 			"enabled":       DefaultMessageBrokerMessageEnabled,
@@ -9,6 +9,7 @@ import (
 	"gorm.io/driver/sqlite"
 	"gorm.io/gorm"
 	"gorm.io/gorm/logger"
+	"strings"
 	"time"
 )
 
@@ -52,11 +53,168 @@ func InitDB() (*gorm.DB, error) {
 }
 
 func runMigrations(db *gorm.DB) error {
-	return db.AutoMigrate(
+	if err := migrateBalanceToMinorUnits(db); err != nil {
+		return err
+	}
+
+	// accounts opened before dormancy tracking take their last activity from the journal
+	backfillActivity := db.Migrator().HasTable(&entity.Account{}) && !db.Migrator().HasColumn(&entity.Account{}, "LastActivityAt")
+
+	if err := db.AutoMigrate(
 		&entity.Customer{},
 		&entity.Account{},
 		&entity.Event{},
-	)
+		&entity.LedgerEntry{},
+		&entity.Hold{},
+		&entity.InterestRate{},
+		&entity.InterestAccrual{},
+		&entity.InterestAccrualRun{},
+		&entity.InterestPosting{},
+		&entity.Statement{},
+		&entity.StatementLine{},
+		&entity.StatementRun{},
+	); err != nil {
+		return err
+	}
+
+	if err := dropNonNegativeBalanceCheck(db); err != nil {
+		return err
+	}
+
+	if err := createCustomerSearchIndex(db); err != nil {
+		return err
+	}
+
+	if backfillActivity {
+		if err := backfillLastActivity(db); err != nil {
+			return err
+		}
+	}
+
+	return backfillOpeningJournals(db)
+}
+
+// backfillOpeningJournals posts an opening journal for every account that holds a balance but has
+// no ledger entries yet, so balances created before the ledger existed can be recomputed from it.
+func backfillOpeningJournals(db *gorm.DB) error {
+	var accounts []*entity.Account
+	err := db.Where("balance > 0 AND id NOT IN (?)", db.Model(&entity.LedgerEntry{}).Select("account_id")).
+		Find(&accounts).Error
+	if err != nil {
+		return fmt.Errorf("failed to find accounts without journal: %w", err)
+	}
+
+	if len(accounts) == 0 {
+		return nil
+	}
+
+	logging.Logger.Info().Int("accounts", len(accounts)).Msg("backfilling opening journals")
+	return db.Transaction(func(tx *gorm.DB) error {
+		for _, account := range accounts {
+			entries, err := entity.NewJournal(entity.JournalTypeMigration, account.ID, []entity.BalanceChange{
+				{AccountID: account.ID, Currency: account.Currency, Delta: account.Balance},
+			}, "system")
+			if err != nil {
+				return fmt.Errorf("failed to build opening journal for account %s: %w", account.ID, err)
+			}
+			if err := tx.Create(&entries).Error; err != nil {
+				return fmt.Errorf("failed to backfill opening journal for account %s: %w", account.ID, err)
+			}
+		}
+		return nil
+	})
+}
+
+// backfillLastActivity sets the last activity of every account to its latest transaction journal, so the
+// dormancy job does not take accounts that were in use before it existed for unused ones.
+func backfillLastActivity(db *gorm.DB) error {
+	logging.Logger.Info().Msg("backfilling last account activity")
+	err := db.Exec(`UPDATE accounts SET last_activity_at = (
+		SELECT MAX(created_at) FROM ledger_entries
+		WHERE ledger_entries.account_id = accounts.id AND ledger_entries.journal_type = ?
+	)`, entity.JournalTypeTransaction).Error
+	if err != nil {
+		return fmt.Errorf("failed to backfill last account activity: %w", err)
+	}
+	return nil
+}
+
+// createCustomerSearchIndex creates the full-text index on customer names used by the customer search and the
+// triggers keeping it in sync with the customers table. Existing customers are indexed when the table is created.
+func createCustomerSearchIndex(db *gorm.DB) error {
+	if db.Migrator().HasTable("customers_fts") {
+		return nil
+	}
+
+	logging.Logger.Info().Msg("creating customer search index")
+	return db.Transaction(func(tx *gorm.DB) error {
+		statements := []string{
+			"CREATE VIRTUAL TABLE customers_fts USING fts4(customer_id, name, notindexed=customer_id, tokenize=unicode61)",
+			`CREATE TRIGGER customers_fts_insert AFTER INSERT ON customers BEGIN
+				INSERT INTO customers_fts(customer_id, name) VALUES (new.id, new.name);
+			END`,
+			`CREATE TRIGGER customers_fts_update AFTER UPDATE OF name ON customers BEGIN
+				DELETE FROM customers_fts WHERE customer_id = old.id;
+				INSERT INTO customers_fts(customer_id, name) VALUES (new.id, new.name);
+			END`,
+			`CREATE TRIGGER customers_fts_delete AFTER DELETE ON customers BEGIN
+				DELETE FROM customers_fts WHERE customer_id = old.id;
+			END`,
+			"INSERT INTO customers_fts(customer_id, name) SELECT id, name FROM customers",
+		}
+		for _, statement := range statements {
+			if err := tx.Exec(statement).Error; err != nil {
+				return fmt.Errorf("failed to create customer search index: %w", err)
+			}
+		}
+		return nil
+	})
+}
+
+// dropNonNegativeBalanceCheck removes the balance >= 0 check of databases created before overdrafts; the
+// balance is now checked against the overdraft limit of the account.
+func dropNonNegativeBalanceCheck(db *gorm.DB) error {
+	const constraint = "chk_accounts_balance"
+	if !db.Migrator().HasConstraint(&entity.Account{}, constraint) {
+		return nil
+	}
+
+	logging.Logger.Info().Msg("dropping non-negative balance check")
+	if err := db.Migrator().DropConstraint(&entity.Account{}, constraint); err != nil {
+		return fmt.Errorf("failed to drop balance check: %w", err)
+	}
+	return nil
+}
+
+// migrateBalanceToMinorUnits converts balances stored as floating point (REAL) into integer
+// minor units before AutoMigrate changes the column type. It is a no-op on fresh or migrated databases.
+func migrateBalanceToMinorUnits(db *gorm.DB) error {
+	if !db.Migrator().HasTable(&entity.Account{}) {
+		return nil
+	}
+
+	columnTypes, err := db.Migrator().ColumnTypes(&entity.Account{})
+	if err != nil {
+		return fmt.Errorf("failed to read account columns: %w", err)
+	}
+
+	for _, column := range columnTypes {
+		if column.Name() != "balance" || !strings.EqualFold(column.DatabaseTypeName(), "real") {
+			continue
+		}
+
+		logging.Logger.Info().Msg("migrating account balances to minor units")
+		err = db.Transaction(func(tx *gorm.DB) error {
+			if err := tx.Exec("UPDATE accounts SET balance = CAST(ROUND(balance * 100) AS INTEGER)").Error; err != nil {
+				return err
+			}
+			return tx.Migrator().AlterColumn(&entity.Account{}, "Balance")
+		})
+		if err != nil {
+			return fmt.Errorf("failed to migrate account balances: %w", err)
+		}
+	}
+	return nil
 }
 
 func prePopulateDefaultCustomer(db *gorm.DB) error {
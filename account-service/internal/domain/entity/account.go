@@ -2,6 +2,7 @@ package entity
 
 import (
 	custom_err "account-service/internal/domain/error"
+	"account-service/internal/domain/money"
 	"encoding/json"
 	"github.com/google/uuid"
 	"strings"
@@ -16,27 +17,62 @@ const (
 	AccountStatusInvalid = "invalid"
 
 	AccountActiveStatusActive   = "active"
-	AccountActiveStatusInactive = "inactive"
-	AccountActiveStatusLocked   = "locked"
+	AccountActiveStatusInactive = "inactive" // dormant, no customer transaction for a configured number of months
+	AccountActiveStatusLocked   = "locked"   // frozen, blocking the debits and/or credits of its freeze mode
+	AccountActiveStatusClosed   = "closed"
+
+	AccountFreezeModeDebit  = "debit"
+	AccountFreezeModeCredit = "credit"
+	AccountFreezeModeBoth   = "both"
+
+	CurrencyUSD = "USD"
+	CurrencyEUR = "EUR"
+	CurrencyBDT = "BDT"
 )
 
+// IsValidAccountType checks if the account type is savings or current
+func IsValidAccountType(accountType string) bool {
+	return accountType == AccountTypeSavings || accountType == AccountTypeCurrent
+}
+
+// SupportedCurrencies lists the ISO 4217 currency codes an account can be held in
+var SupportedCurrencies = []string{CurrencyUSD, CurrencyEUR, CurrencyBDT}
+
+// IsSupportedCurrency checks if the currency code is supported by the bank
+func IsSupportedCurrency(currency string) bool {
+	for _, c := range SupportedCurrencies {
+		if c == currency {
+			return true
+		}
+	}
+	return false
+}
+
 type Account struct {
-	ID                  string  `gorm:"primaryKey"`
-	CustomerID          string  `gorm:"not null;index"`
-	Balance             float64 `gorm:"not null;default:0;check:balance >= 0"`
-	AccountType         string  `gorm:"not null;default:'savings'"`
-	ActiveStatus        string  `gorm:"not null;default:'active'"`
-	LockedForTx         bool    `gorm:"default:false;index"` // locked for Transaction
-	ActiveTransactionID *string `gorm:"index"`
-	Version             int     `gorm:"default:1"` // Optimistic locking
-	Status              string  `gorm:"not null;default:valid"`
-	CreatedBy           string  `gorm:"null"`
-	UpdatedBy           string  `gorm:"null"`
-	CreatedAt           time.Time
+	ID                  string       `gorm:"primaryKey;index:idx_accounts_created_id,priority:2"`
+	CustomerID          string       `gorm:"not null;index"`
+	Balance             money.Amount `gorm:"not null;default:0;check:chk_accounts_overdraft,balance + overdraft_limit >= 0"` // minor units, negative while overdrawn
+	OverdraftLimit      money.Amount `gorm:"not null;default:0"`                                                             // minor units the balance may go below zero
+	Currency            string       `gorm:"not null;default:'USD'"`                                                         // ISO 4217 code
+	AccountType         string       `gorm:"not null;default:'savings'"`
+	ActiveStatus        string       `gorm:"not null;default:'active'"`
+	FreezeMode          string       `gorm:"null"`                // debit, credit or both while locked
+	StatusReason        string       `gorm:"null"`                // reason of the last freeze, unfreeze, dormancy or closure
+	StatusChangedAt     *time.Time   `gorm:"null"`                // time of the last active status change
+	LastActivityAt      *time.Time   `gorm:"index"`               // last customer transaction, nil before the first one
+	LockedForTx         bool         `gorm:"default:false;index"` // locked for Transaction
+	ActiveTransactionID *string      `gorm:"index"`
+	Version             int          `gorm:"default:1"` // Optimistic locking
+	Status              string       `gorm:"not null;default:valid"`
+	CreatedBy           string       `gorm:"null"`
+	UpdatedBy           string       `gorm:"null"`
+	CreatedAt           time.Time    `gorm:"index:idx_accounts_created_id,priority:1"` // keyset pagination order
 	UpdatedAt           time.Time
+
+	HeldAmount money.Amount `gorm:"-"` // sum of the active holds, loaded on demand
 }
 
-func NewAccount(customerID string, accountType string, initialDeposit float64, requester string) (*Account, error) {
+func NewAccount(customerID string, accountType string, currency string, initialDeposit money.Amount, requester string) (*Account, error) {
 	if customerID == "" {
 		return nil, custom_err.ErrInvalidCustomer
 	}
@@ -50,6 +86,7 @@ func NewAccount(customerID string, accountType string, initialDeposit float64, r
 		ID:           uuid.New().String(),
 		CustomerID:   customerID,
 		Balance:      initialDeposit,
+		Currency:     currency,
 		AccountType:  accountType,
 		ActiveStatus: AccountActiveStatusActive,
 		Version:      1,
@@ -60,9 +97,11 @@ func NewAccount(customerID string, accountType string, initialDeposit float64, r
 	}, nil
 }
 
+// CanTransact checks that the account can take part in a transaction. Frozen and dormant accounts can, whether
+// they may be debited or credited is checked with AllowsDebit and AllowsCredit when the balance changes.
 func (a *Account) CanTransact() bool {
 	return a.Status == AccountStatusValid &&
-		a.ActiveStatus == AccountActiveStatusActive &&
+		a.ActiveStatus != AccountActiveStatusClosed &&
 		!a.LockedForTx &&
 		(a.ActiveTransactionID == nil || strings.TrimSpace(*a.ActiveTransactionID) == "")
 }
@@ -86,13 +125,41 @@ func (a *Account) IncrementVersion() {
 	a.UpdatedAt = time.Now()
 }
 
+// AvailableBalance is the amount that can be debited, including the unused overdraft and excluding held funds
+func (a *Account) AvailableBalance() money.Amount {
+	return a.Balance.Add(a.OverdraftLimit).Sub(a.HeldAmount)
+}
+
+// IsOverdrawn reports whether the balance is below zero
+func (a *Account) IsOverdrawn() bool {
+	return a.Balance.IsNegative()
+}
+
+// SetOverdraftLimit allows the balance of a current account to go negative down to the limit. The limit
+// cannot be lowered below what the account is already overdrawn by.
+func (a *Account) SetOverdraftLimit(limit money.Amount, requester string) error {
+	if a.AccountType != AccountTypeCurrent {
+		return custom_err.ErrOverdraftNotAllowed
+	}
+	if limit.IsNegative() || a.Balance.Add(limit).IsNegative() {
+		return custom_err.ErrInvalidOverdraftLimit
+	}
+	a.OverdraftLimit = limit
+	a.UpdatedBy = requester
+	a.UpdatedAt = time.Now()
+	return nil
+}
+
 func (a *Account) Validate() error {
-	if a.Balance < 0 {
+	if a.AvailableBalance().IsNegative() {
 		return custom_err.ErrNegativeBalance
 	}
 	if a.CustomerID == "" {
 		return custom_err.ErrMissingCustomerID
 	}
+	if !IsSupportedCurrency(a.Currency) {
+		return custom_err.ErrUnsupportedCurrency
+	}
 	return nil
 }
 
@@ -0,0 +1,113 @@
+package entity
+
+import (
+	custom_err "account-service/internal/domain/error"
+	"strings"
+	"time"
+)
+
+// DormancyRequester is recorded as the one who marks accounts dormant
+const DormancyRequester = "system"
+
+// IsValidFreezeMode checks if the mode names the movements a freeze blocks
+func IsValidFreezeMode(mode string) bool {
+	return mode == AccountFreezeModeDebit || mode == AccountFreezeModeCredit || mode == AccountFreezeModeBoth
+}
+
+// AllowsDebit reports whether money may leave the account. Dormant and closed accounts are never debited.
+func (a *Account) AllowsDebit() bool {
+	switch a.ActiveStatus {
+	case AccountActiveStatusLocked:
+		return a.FreezeMode == AccountFreezeModeCredit
+	case AccountActiveStatusInactive, AccountActiveStatusClosed:
+		return false
+	}
+	return true
+}
+
+// AllowsCredit reports whether money may enter the account. Dormant accounts keep receiving credits.
+func (a *Account) AllowsCredit() bool {
+	switch a.ActiveStatus {
+	case AccountActiveStatusLocked:
+		return a.FreezeMode == AccountFreezeModeDebit
+	case AccountActiveStatusClosed:
+		return false
+	}
+	return true
+}
+
+// IsClosed reports whether the account was closed
+func (a *Account) IsClosed() bool {
+	return a.ActiveStatus == AccountActiveStatusClosed
+}
+
+// Freeze blocks the debits, credits or both of the account. A frozen account can be frozen again to change the
+// mode or reason.
+func (a *Account) Freeze(mode, reason, requester string) error {
+	if a.IsClosed() {
+		return custom_err.ErrAccountClosed
+	}
+	mode = strings.ToLower(strings.TrimSpace(mode))
+	if !IsValidFreezeMode(mode) {
+		return custom_err.ErrInvalidFreezeMode
+	}
+	return a.changeStatus(AccountActiveStatusLocked, mode, reason, requester)
+}
+
+// Activate unfreezes a frozen account or reactivates a dormant one
+func (a *Account) Activate(reason, requester string) error {
+	switch a.ActiveStatus {
+	case AccountActiveStatusActive:
+		return custom_err.ErrAccountAlreadyActive
+	case AccountActiveStatusClosed:
+		return custom_err.ErrAccountClosed
+	}
+	return a.changeStatus(AccountActiveStatusActive, "", reason, requester)
+}
+
+// MarkDormant makes an active account dormant after it went without customer transactions since lastActivity
+func (a *Account) MarkDormant(lastActivity time.Time) error {
+	if a.ActiveStatus != AccountActiveStatusActive {
+		return custom_err.ErrInvalidAccountStatus
+	}
+	return a.changeStatus(AccountActiveStatusInactive, "", "no customer transaction since "+lastActivity.UTC().Format(time.DateOnly), DormancyRequester)
+}
+
+// Close closes an account whose balance was paid out. Frozen and dormant accounts have to be made active first,
+// and the account may not be in a transaction. Active holds are checked by the caller.
+func (a *Account) Close(reason, requester string) error {
+	switch {
+	case a.IsClosed():
+		return custom_err.ErrAccountClosed
+	case a.ActiveStatus != AccountActiveStatusActive:
+		return custom_err.ErrInvalidAccountStatus
+	case a.HasActiveTransaction() || a.LockedForTx:
+		return custom_err.ErrAccountLocked
+	case !a.Balance.IsZero():
+		return custom_err.ErrAccountBalanceNotZero
+	}
+	return a.changeStatus(AccountActiveStatusClosed, "", reason, requester)
+}
+
+// LastActivity is the time of the last customer transaction, or the opening time before the first one
+func (a *Account) LastActivity() time.Time {
+	if a.LastActivityAt != nil {
+		return *a.LastActivityAt
+	}
+	return a.CreatedAt
+}
+
+func (a *Account) changeStatus(status, freezeMode, reason, requester string) error {
+	reason = strings.TrimSpace(reason)
+	if reason == "" {
+		return custom_err.ErrStatusReasonRequired
+	}
+	now := time.Now()
+	a.ActiveStatus = status
+	a.FreezeMode = freezeMode
+	a.StatusReason = reason
+	a.StatusChangedAt = &now
+	a.UpdatedBy = requester
+	a.UpdatedAt = now
+	return nil
+}
@@ -0,0 +1,68 @@
+package entity
+
+import (
+	custom_err "account-service/internal/domain/error"
+	"account-service/internal/domain/money"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+// TestAccount_FreezeAndActivate tests the movements a freeze blocks and that unfreezing clears the mode
+func TestAccount_FreezeAndActivate(t *testing.T) {
+	account, _ := NewAccount("cust-1", AccountTypeSavings, CurrencyUSD, money.Zero, "teller")
+
+	assert.ErrorIs(t, account.Freeze(AccountFreezeModeBoth, " ", "admin"), custom_err.ErrStatusReasonRequired)
+	assert.Equal(t, AccountActiveStatusActive, account.ActiveStatus)
+	assert.Empty(t, account.FreezeMode)
+
+	assert.NoError(t, account.Freeze("BOTH", "court order", "admin"))
+	assert.Equal(t, AccountActiveStatusLocked, account.ActiveStatus)
+	assert.False(t, account.AllowsDebit())
+	assert.False(t, account.AllowsCredit())
+	assert.True(t, account.CanTransact())
+
+	// a frozen account can be frozen again with another mode
+	assert.NoError(t, account.Freeze(AccountFreezeModeCredit, "court order lifted for debits", "admin"))
+	assert.True(t, account.AllowsDebit())
+	assert.False(t, account.AllowsCredit())
+
+	assert.NoError(t, account.Activate("cleared", "admin"))
+	assert.Equal(t, AccountActiveStatusActive, account.ActiveStatus)
+	assert.Empty(t, account.FreezeMode)
+	assert.Equal(t, "cleared", account.StatusReason)
+	assert.ErrorIs(t, account.Activate("cleared", "admin"), custom_err.ErrAccountAlreadyActive)
+}
+
+// TestAccount_MarkDormant tests that only active accounts become dormant and keep receiving credits
+func TestAccount_MarkDormant(t *testing.T) {
+	account, _ := NewAccount("cust-1", AccountTypeSavings, CurrencyUSD, money.Zero, "teller")
+	assert.Equal(t, account.CreatedAt, account.LastActivity())
+
+	assert.NoError(t, account.MarkDormant(time.Date(2025, time.January, 10, 0, 0, 0, 0, time.UTC)))
+	assert.Equal(t, AccountActiveStatusInactive, account.ActiveStatus)
+	assert.Equal(t, DormancyRequester, account.UpdatedBy)
+	assert.False(t, account.AllowsDebit())
+	assert.True(t, account.AllowsCredit())
+
+	assert.ErrorIs(t, account.MarkDormant(time.Now()), custom_err.ErrInvalidAccountStatus)
+}
+
+// TestAccount_Close tests that only active, empty accounts outside a transaction can be closed
+func TestAccount_Close(t *testing.T) {
+	account, _ := NewAccount("cust-1", AccountTypeSavings, CurrencyUSD, money.MustParse("10.00"), "teller")
+	assert.ErrorIs(t, account.Close("customer request", "teller"), custom_err.ErrAccountBalanceNotZero)
+
+	account.Balance = money.Zero
+	account.LockForTransaction("tx-1")
+	assert.ErrorIs(t, account.Close("customer request", "teller"), custom_err.ErrAccountLocked)
+
+	account.UnlockFromTransaction()
+	assert.NoError(t, account.Close("customer request", "teller"))
+	assert.True(t, account.IsClosed())
+	assert.False(t, account.CanTransact())
+	assert.False(t, account.AllowsDebit())
+	assert.False(t, account.AllowsCredit())
+	assert.ErrorIs(t, account.Freeze(AccountFreezeModeBoth, "fraud", "admin"), custom_err.ErrAccountClosed)
+	assert.ErrorIs(t, account.Activate("reopen", "admin"), custom_err.ErrAccountClosed)
+}
@@ -14,19 +14,30 @@ const (
 
 	CustomerActiveStatusActive      = "active"
 	CustomerActiveStatusDeactivated = "deactivated"
+
+	CustomerKYCStatusPending  = "pending"
+	CustomerKYCStatusVerified = "verified"
+	CustomerKYCStatusRejected = "rejected"
 )
 
 type Customer struct {
-	ID                 string    `gorm:"primaryKey"`
+	ID                 string    `gorm:"primaryKey;index:idx_customers_created_id,priority:2"`
 	Name               string    `gorm:"not null"`
 	Accounts           []Account `gorm:"foreignKey:CustomerID"`
 	ActiveStatus       string    `gorm:"not null;default:active"`
 	LockedForOperation bool      `gorm:"default:false;index"`
 	Version            int       `gorm:"default:1"`
 	Status             string    `gorm:"not null;default:valid"` // soft delete
+	DateOfBirth        string    `gorm:"size:10"`                // YYYY-MM-DD
+	NationalID         string    `gorm:"index"`
+	Address            string    `gorm:"null"`
+	Phone              string    `gorm:"null"` // E.164
+	Email              string    `gorm:"null"`
+	KYCStatus          string    `gorm:"not null;default:pending"`
+	KYCVerifiedAt      *time.Time
 	CreatedBy          string    `gorm:"null"`
 	UpdatedBy          string    `gorm:"null"`
-	CreatedAt          time.Time
+	CreatedAt          time.Time `gorm:"index:idx_customers_created_id,priority:1"` // keyset pagination order
 	UpdatedAt          time.Time
 }
 
@@ -46,6 +57,7 @@ func NewCustomer(name, requester string) (*Customer, error) {
 		ActiveStatus: CustomerActiveStatusActive,
 		Version:      1,
 		Status:       CustomerStatusValid,
+		KYCStatus:    CustomerKYCStatusPending,
 		CreatedBy:    requester,
 		CreatedAt:    now,
 		UpdatedAt:    now,
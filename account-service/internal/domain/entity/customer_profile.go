@@ -0,0 +1,157 @@
+package entity
+
+import (
+	custom_err "account-service/internal/domain/error"
+	"net/mail"
+	"regexp"
+	"strings"
+	"time"
+)
+
+const (
+	customerNameMaxLength    = 50
+	customerAddressMaxLength = 200
+	customerEmailMaxLength   = 254
+	dateOfBirthLayout        = "2006-01-02"
+)
+
+var (
+	nationalIDPattern = regexp.MustCompile(`^[A-Z0-9]{5,20}$`)
+	phonePattern      = regexp.MustCompile(`^\+[1-9][0-9]{6,14}$`)
+	whitespacePattern = regexp.MustCompile(`\s+`)
+	phoneSeparators   = strings.NewReplacer(" ", "", "-", "", "(", "", ")", "", ".", "")
+	nationalIDFillers = strings.NewReplacer(" ", "", "-", "")
+)
+
+// CustomerProfile holds the editable fields of a customer. Empty fields are left unchanged by UpdateProfile.
+type CustomerProfile struct {
+	Name        string
+	DateOfBirth string // YYYY-MM-DD
+	NationalID  string
+	Address     string
+	Phone       string
+	Email       string
+	KYCStatus   string
+}
+
+// FieldChange is the previous and new value of a changed customer field
+type FieldChange struct {
+	From interface{} `json:"from"`
+	To   interface{} `json:"to"`
+}
+
+// Normalize validates the given fields of the profile and brings them into their stored form
+func (p CustomerProfile) Normalize(now time.Time) (CustomerProfile, error) {
+	if name := strings.TrimSpace(p.Name); name != "" {
+		p.Name = whitespacePattern.ReplaceAllString(name, " ")
+		if len([]rune(p.Name)) > customerNameMaxLength {
+			return p, custom_err.ErrInvalidCustomerName
+		}
+	}
+
+	if dateOfBirth := strings.TrimSpace(p.DateOfBirth); dateOfBirth != "" {
+		born, err := time.Parse(dateOfBirthLayout, dateOfBirth)
+		if err != nil || !born.Before(now) || born.Year() < 1900 {
+			return p, custom_err.ErrInvalidDateOfBirth
+		}
+		p.DateOfBirth = born.Format(dateOfBirthLayout)
+	}
+
+	if nationalID := nationalIDFillers.Replace(strings.TrimSpace(p.NationalID)); nationalID != "" {
+		p.NationalID = strings.ToUpper(nationalID)
+		if !nationalIDPattern.MatchString(p.NationalID) {
+			return p, custom_err.ErrInvalidNationalID
+		}
+	}
+
+	if address := strings.TrimSpace(p.Address); address != "" {
+		p.Address = whitespacePattern.ReplaceAllString(address, " ")
+		if len([]rune(p.Address)) > customerAddressMaxLength {
+			return p, custom_err.ErrInvalidAddress
+		}
+	}
+
+	if phone := phoneSeparators.Replace(strings.TrimSpace(p.Phone)); phone != "" {
+		p.Phone = phone
+		if !phonePattern.MatchString(p.Phone) {
+			return p, custom_err.ErrInvalidPhone
+		}
+	}
+
+	if email := strings.TrimSpace(p.Email); email != "" {
+		// a bare address only, without display name
+		address, err := mail.ParseAddress(email)
+		if err != nil || address.Address != email || len(email) > customerEmailMaxLength {
+			return p, custom_err.ErrInvalidEmail
+		}
+		p.Email = strings.ToLower(email)
+	}
+
+	if kycStatus := strings.ToLower(strings.TrimSpace(p.KYCStatus)); kycStatus != "" {
+		p.KYCStatus = kycStatus
+		if kycStatus != CustomerKYCStatusPending && kycStatus != CustomerKYCStatusVerified && kycStatus != CustomerKYCStatusRejected {
+			return p, custom_err.ErrInvalidKYCStatus
+		}
+	}
+
+	return p, nil
+}
+
+// UpdateProfile applies the non-empty fields of the profile and returns the changed fields keyed by their JSON name.
+// Changing the name, date of birth or national ID of a verified customer returns it to pending unless the same
+// update verifies it again. A customer can only be verified with a date of birth, national ID and address.
+func (c *Customer) UpdateProfile(profile CustomerProfile, requester string) (map[string]FieldChange, error) {
+	now := time.Now()
+	profile, err := profile.Normalize(now)
+	if err != nil {
+		return nil, err
+	}
+
+	// changes are applied to a copy so a rejected update leaves the customer as it was
+	updated := *c
+	changes := make(map[string]FieldChange)
+	set := func(field string, current *string, value string) {
+		if value != "" && value != *current {
+			changes[field] = FieldChange{From: *current, To: value}
+			*current = value
+		}
+	}
+
+	set("name", &updated.Name, profile.Name)
+	set("date_of_birth", &updated.DateOfBirth, profile.DateOfBirth)
+	set("national_id", &updated.NationalID, profile.NationalID)
+	set("address", &updated.Address, profile.Address)
+	set("phone", &updated.Phone, profile.Phone)
+	set("email", &updated.Email, profile.Email)
+
+	kycStatus := profile.KYCStatus
+	if kycStatus == "" && updated.KYCStatus == CustomerKYCStatusVerified {
+		for _, field := range []string{"name", "date_of_birth", "national_id"} {
+			if _, ok := changes[field]; ok {
+				kycStatus = CustomerKYCStatusPending
+				break
+			}
+		}
+	}
+
+	if kycStatus == CustomerKYCStatusVerified && (updated.DateOfBirth == "" || updated.NationalID == "" || updated.Address == "") {
+		return nil, custom_err.ErrIncompleteKYCProfile
+	}
+
+	previousKYCStatus := updated.KYCStatus
+	set("kyc_status", &updated.KYCStatus, kycStatus)
+	if updated.KYCStatus != previousKYCStatus {
+		updated.KYCVerifiedAt = nil
+		if updated.KYCStatus == CustomerKYCStatusVerified {
+			updated.KYCVerifiedAt = &now
+		}
+		changes["kyc_verified_at"] = FieldChange{From: c.KYCVerifiedAt, To: updated.KYCVerifiedAt}
+	}
+
+	if len(changes) > 0 {
+		updated.UpdatedBy = requester
+		updated.UpdatedAt = now
+		*c = updated
+	}
+	return changes, nil
+}
@@ -0,0 +1,113 @@
+package entity
+
+import (
+	custom_err "account-service/internal/domain/error"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+// TestCustomerProfile_Normalize tests that profile fields are validated and brought into their stored form
+func TestCustomerProfile_Normalize(t *testing.T) {
+	now := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+
+	profile, err := CustomerProfile{
+		Name:        "  Jane   Doe ",
+		DateOfBirth: "1990-07-15",
+		NationalID:  "ab-123 456",
+		Address:     " 1 Main St,\n Springfield ",
+		Phone:       "+49 (151) 123-45678",
+		Email:       "Jane.Doe@Example.com",
+		KYCStatus:   " Verified",
+	}.Normalize(now)
+
+	assert.NoError(t, err)
+	assert.Equal(t, CustomerProfile{
+		Name:        "Jane Doe",
+		DateOfBirth: "1990-07-15",
+		NationalID:  "AB123456",
+		Address:     "1 Main St, Springfield",
+		Phone:       "+4915112345678",
+		Email:       "jane.doe@example.com",
+		KYCStatus:   CustomerKYCStatusVerified,
+	}, profile)
+}
+
+// TestCustomerProfile_NormalizeInvalid tests that each invalid field is rejected with its error
+func TestCustomerProfile_NormalizeInvalid(t *testing.T) {
+	now := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name    string
+		profile CustomerProfile
+		err     error
+	}{
+		{"NameTooLong", CustomerProfile{Name: "A very long customer name that does not fit in fifty"}, custom_err.ErrInvalidCustomerName},
+		{"DateOfBirthFormat", CustomerProfile{DateOfBirth: "15-07-1990"}, custom_err.ErrInvalidDateOfBirth},
+		{"DateOfBirthInFuture", CustomerProfile{DateOfBirth: "2026-03-02"}, custom_err.ErrInvalidDateOfBirth},
+		{"NationalIDTooShort", CustomerProfile{NationalID: "AB12"}, custom_err.ErrInvalidNationalID},
+		{"NationalIDSymbols", CustomerProfile{NationalID: "AB12#456"}, custom_err.ErrInvalidNationalID},
+		{"PhoneWithoutCountryCode", CustomerProfile{Phone: "0151 12345678"}, custom_err.ErrInvalidPhone},
+		{"EmailWithDisplayName", CustomerProfile{Email: "Jane <jane@example.com>"}, custom_err.ErrInvalidEmail},
+		{"EmailWithoutDomain", CustomerProfile{Email: "jane"}, custom_err.ErrInvalidEmail},
+		{"UnknownKYCStatus", CustomerProfile{KYCStatus: "approved"}, custom_err.ErrInvalidKYCStatus},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := tt.profile.Normalize(now)
+			assert.ErrorIs(t, err, tt.err)
+		})
+	}
+}
+
+// TestCustomer_UpdateProfile tests that only given fields change and the diff lists them
+func TestCustomer_UpdateProfile(t *testing.T) {
+	customer, _ := NewCustomer("Jane Doe", "teller")
+
+	changes, err := customer.UpdateProfile(CustomerProfile{Name: "Jane Doe", Phone: "+4915112345678"}, "editor")
+
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]FieldChange{"phone": {From: "", To: "+4915112345678"}}, changes)
+	assert.Equal(t, "+4915112345678", customer.Phone)
+	assert.Equal(t, CustomerKYCStatusPending, customer.KYCStatus)
+	assert.Equal(t, "editor", customer.UpdatedBy)
+
+	changes, err = customer.UpdateProfile(CustomerProfile{Phone: "+4915112345678"}, "editor")
+	assert.NoError(t, err)
+	assert.Empty(t, changes)
+}
+
+// TestCustomer_UpdateProfileVerification tests the KYC rules of verifying a customer and editing a verified one
+func TestCustomer_UpdateProfileVerification(t *testing.T) {
+	customer, _ := NewCustomer("Jane Doe", "teller")
+
+	_, err := customer.UpdateProfile(CustomerProfile{KYCStatus: CustomerKYCStatusVerified, NationalID: "AB123456"}, "editor")
+	assert.ErrorIs(t, err, custom_err.ErrIncompleteKYCProfile)
+	assert.Empty(t, customer.NationalID)
+
+	changes, err := customer.UpdateProfile(CustomerProfile{
+		KYCStatus:   CustomerKYCStatusVerified,
+		DateOfBirth: "1990-07-15",
+		NationalID:  "AB123456",
+		Address:     "1 Main St",
+	}, "editor")
+	assert.NoError(t, err)
+	assert.Equal(t, CustomerKYCStatusVerified, customer.KYCStatus)
+	assert.NotNil(t, customer.KYCVerifiedAt)
+	assert.Equal(t, FieldChange{From: CustomerKYCStatusPending, To: CustomerKYCStatusVerified}, changes["kyc_status"])
+	assert.Contains(t, changes, "kyc_verified_at")
+
+	// contact details keep the verification
+	changes, err = customer.UpdateProfile(CustomerProfile{Email: "jane@example.com"}, "editor")
+	assert.NoError(t, err)
+	assert.Len(t, changes, 1)
+	assert.Equal(t, CustomerKYCStatusVerified, customer.KYCStatus)
+
+	// identity details return the customer to pending
+	changes, err = customer.UpdateProfile(CustomerProfile{NationalID: "CD654321"}, "editor")
+	assert.NoError(t, err)
+	assert.Equal(t, CustomerKYCStatusPending, customer.KYCStatus)
+	assert.Nil(t, customer.KYCVerifiedAt)
+	assert.Equal(t, FieldChange{From: CustomerKYCStatusVerified, To: CustomerKYCStatusPending}, changes["kyc_status"])
+}
@@ -13,12 +13,29 @@ const (
 	EventTypeAccountCreated       = "account_created"
 	EventTypeAccountUpdated       = "account_updated"
 	EventTypeAccountDeleted       = "account_deleted"
+	EventTypeOverdraftLimitSet    = "account_overdraft_limit_set"
+	EventTypeAccountOverdrawn     = "account_overdrawn"
+	EventTypeAccountFrozen        = "account_frozen"
+	EventTypeAccountUnfrozen      = "account_unfrozen"
+	EventTypeAccountReactivated   = "account_reactivated"
+	EventTypeAccountDormant       = "account_dormant"
+	EventTypeAccountClosed        = "account_closed"
+	EventTypeHoldPlaced           = "hold_placed"
+	EventTypeHoldCaptured         = "hold_captured"
+	EventTypeHoldReleased         = "hold_released"
+	EventTypeInterestRateSet      = "interest_rate_set"
+	EventTypeInterestAccrued      = "interest_accrued"
+	EventTypeInterestPeriodClosed = "interest_period_closed"
+	EventTypeStatementsIssued     = "statements_issued"
 	EventTypeTransactionInit      = "transaction_init"
 	EventTypeTransactionCommit    = "transaction_commit"
 	EventTypeTransactionRollback  = "transaction_rollback"
 	EventTypeTransactionFailed    = "transaction_failed"
 	EventAggregateTypeCustomer    = "customer"
 	EventAggregateTypeAccount     = "account"
+	EventAggregateTypeHold        = "hold"
+	EventAggregateTypeInterest    = "interest"
+	EventAggregateTypeStatement   = "statement"
 	EventAggregateTypeTransaction = "transaction"
 )
 
@@ -0,0 +1,116 @@
+package entity
+
+import (
+	custom_err "account-service/internal/domain/error"
+	"account-service/internal/domain/money"
+	"encoding/json"
+	"github.com/google/uuid"
+	"time"
+)
+
+const (
+	HoldStatusActive   = "active"
+	HoldStatusCaptured = "captured"
+	HoldStatusReleased = "released"
+	HoldStatusExpired  = "expired"
+)
+
+// Hold earmarks funds of an account until it is captured into a transaction, released or expires.
+// An active hold reduces the available balance but not the ledger balance.
+type Hold struct {
+	ID                   string       `gorm:"primaryKey"`
+	AccountID            string       `gorm:"not null;index"`
+	Amount               money.Amount `gorm:"not null;check:amount > 0"` // minor units, in the account currency
+	CapturedAmount       money.Amount `gorm:"not null;default:0"`
+	Currency             string       `gorm:"not null"`
+	Reason               string       `gorm:"null"`
+	Status               string       `gorm:"not null;index"`
+	CaptureTransactionID *string      `gorm:"index"`
+	ExpiresAt            time.Time    `gorm:"not null;index"`
+	CreatedBy            string       `gorm:"not null"`
+	UpdatedBy            string       `gorm:"null"`
+	CreatedAt            time.Time
+	UpdatedAt            time.Time
+}
+
+// NewHold creates an active hold on the account
+func NewHold(account *Account, amount money.Amount, reason string, expiresAt time.Time, requester string) (*Hold, error) {
+	if !amount.IsPositive() {
+		return nil, custom_err.ErrInvalidAmount
+	}
+
+	now := time.Now()
+	if !expiresAt.After(now) {
+		return nil, custom_err.ErrInvalidHoldExpiry
+	}
+
+	return &Hold{
+		ID:        uuid.New().String(),
+		AccountID: account.ID,
+		Amount:    amount,
+		Currency:  account.Currency,
+		Reason:    reason,
+		Status:    HoldStatusActive,
+		ExpiresAt: expiresAt.UTC(),
+		CreatedBy: requester,
+		UpdatedBy: requester,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}, nil
+}
+
+// IsActive reports whether the hold still reserves funds at the given time
+func (h *Hold) IsActive(now time.Time) bool {
+	return h.Status == HoldStatusActive && h.ExpiresAt.After(now)
+}
+
+// RefreshStatus marks an active hold past its expiry as expired
+func (h *Hold) RefreshStatus(now time.Time) {
+	if h.Status == HoldStatusActive && !h.ExpiresAt.After(now) {
+		h.Status = HoldStatusExpired
+	}
+}
+
+// Capture settles the hold with a transaction for at most the held amount, the rest is released
+func (h *Hold) Capture(amount money.Amount, transactionID, requester string) error {
+	if !h.IsActive(time.Now()) {
+		return custom_err.ErrHoldNotActive
+	}
+	if !amount.IsPositive() || amount > h.Amount {
+		return custom_err.ErrInvalidHoldCaptureAmount
+	}
+	h.Status = HoldStatusCaptured
+	h.CapturedAmount = amount
+	h.CaptureTransactionID = &transactionID
+	h.UpdatedBy = requester
+	h.UpdatedAt = time.Now()
+	return nil
+}
+
+// UndoCapture makes a hold captured by the transaction active again when the transaction is compensated
+func (h *Hold) UndoCapture(transactionID, requester string) {
+	if h.Status != HoldStatusCaptured || h.CaptureTransactionID == nil || *h.CaptureTransactionID != transactionID {
+		return
+	}
+	h.Status = HoldStatusActive
+	h.CapturedAmount = money.Zero
+	h.CaptureTransactionID = nil
+	h.UpdatedBy = requester
+	h.UpdatedAt = time.Now()
+}
+
+// Release frees the held funds without moving money
+func (h *Hold) Release(requester string) error {
+	if !h.IsActive(time.Now()) {
+		return custom_err.ErrHoldNotActive
+	}
+	h.Status = HoldStatusReleased
+	h.UpdatedBy = requester
+	h.UpdatedAt = time.Now()
+	return nil
+}
+
+func (h *Hold) ToString() string {
+	data, _ := json.Marshal(h)
+	return string(data)
+}
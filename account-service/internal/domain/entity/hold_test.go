@@ -0,0 +1,44 @@
+package entity
+
+import (
+	custom_err "account-service/internal/domain/error"
+	"account-service/internal/domain/money"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+// TestHold_PartialCapture tests that a partial capture settles the hold and releases the remainder
+func TestHold_PartialCapture(t *testing.T) {
+	account := &Account{ID: "acc-1", Currency: CurrencyUSD, Balance: money.MustParse("100.00")}
+	hold, err := NewHold(account, money.MustParse("80.00"), "pre-authorization", time.Now().Add(time.Hour), "teller")
+	assert.NoError(t, err)
+
+	assert.ErrorIs(t, hold.Capture(money.MustParse("80.01"), "txn-1", "teller"), custom_err.ErrInvalidHoldCaptureAmount)
+
+	assert.NoError(t, hold.Capture(money.MustParse("30.00"), "txn-1", "teller"))
+	assert.Equal(t, HoldStatusCaptured, hold.Status)
+	assert.Equal(t, money.MustParse("30.00"), hold.CapturedAmount)
+	assert.False(t, hold.IsActive(time.Now()))
+	assert.ErrorIs(t, hold.Release("teller"), custom_err.ErrHoldNotActive)
+
+	// the compensation of another transaction leaves the hold alone
+	hold.UndoCapture("txn-2", "system")
+	assert.Equal(t, HoldStatusCaptured, hold.Status)
+
+	hold.UndoCapture("txn-1", "system")
+	assert.Equal(t, HoldStatusActive, hold.Status)
+	assert.Equal(t, money.Zero, hold.CapturedAmount)
+	assert.Nil(t, hold.CaptureTransactionID)
+}
+
+// TestHold_Expiry tests that an expired hold no longer reserves funds
+func TestHold_Expiry(t *testing.T) {
+	hold := &Hold{ID: "hold-1", Amount: money.MustParse("10.00"), Status: HoldStatusActive, ExpiresAt: time.Now().Add(-time.Second)}
+
+	assert.False(t, hold.IsActive(time.Now()))
+	assert.ErrorIs(t, hold.Capture(money.MustParse("10.00"), "txn-1", "teller"), custom_err.ErrHoldNotActive)
+
+	hold.RefreshStatus(time.Now())
+	assert.Equal(t, HoldStatusExpired, hold.Status)
+}
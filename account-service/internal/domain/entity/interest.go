@@ -0,0 +1,205 @@
+package entity
+
+import (
+	custom_err "account-service/internal/domain/error"
+	"account-service/internal/domain/money"
+	"encoding/json"
+	"github.com/google/uuid"
+	"strings"
+	"time"
+)
+
+const (
+	InterestRateScopeAccountType = "account_type" // subject is an account type, e.g. savings
+	InterestRateScopeAccount     = "account"      // subject is an account ID, replaces the rate of its account type
+
+	InterestPostingStatusPending = "pending" // waiting to be credited by the transaction service
+	InterestPostingStatusPosted  = "posted"
+
+	// InterestAccrualDateLayout formats the UTC day an accrual is for
+	InterestAccrualDateLayout = "2006-01-02"
+	// InterestPeriodLayout formats the month a posting credits
+	InterestPeriodLayout = "2006-01"
+)
+
+// InterestRate is the annual rate paid on the positive balance of an account type or of a single account
+type InterestRate struct {
+	ID         string             `gorm:"primaryKey"`
+	Scope      string             `gorm:"not null;uniqueIndex:idx_interest_rate_subject"`
+	Subject    string             `gorm:"not null;uniqueIndex:idx_interest_rate_subject"`
+	AnnualRate money.InterestRate `gorm:"not null;default:0"` // percent with 4 decimal places
+	CreatedBy  string             `gorm:"not null"`
+	UpdatedBy  string             `gorm:"null"`
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
+}
+
+// InterestAccrual is the interest one account earned on one day. An account accrues at most once a day.
+type InterestAccrual struct {
+	ID          string             `gorm:"primaryKey"`
+	AccountID   string             `gorm:"not null;uniqueIndex:idx_interest_accrual_day"`
+	AccrualDate string             `gorm:"not null;uniqueIndex:idx_interest_accrual_day;index"` // UTC day, e.g. 2026-01-31
+	Balance     money.Amount       `gorm:"not null"`                                            // end of day ledger balance
+	AnnualRate  money.InterestRate `gorm:"not null"`
+	Amount      int64              `gorm:"not null"` // millionths of a minor unit
+	Currency    string             `gorm:"not null"`
+	PostingID   *string            `gorm:"index"` // set once the month of the accrual is closed
+	CreatedAt   time.Time
+}
+
+// InterestAccrualRun records that every account accrued for the day
+type InterestAccrualRun struct {
+	AccrualDate  string `gorm:"primaryKey"`
+	AccountCount int    `gorm:"not null;default:0"`
+	CreatedAt    time.Time
+}
+
+// InterestPosting is the interest an account accrued in a month, credited as one transaction
+type InterestPosting struct {
+	ID            string       `gorm:"primaryKey"`
+	AccountID     string       `gorm:"not null;uniqueIndex:idx_interest_posting_period"`
+	Period        string       `gorm:"not null;uniqueIndex:idx_interest_posting_period"` // month, e.g. 2026-01, or the last accrued day when settled for a closure
+	Amount        money.Amount `gorm:"not null;check:amount > 0"`                        // minor units, in the account currency
+	Currency      string       `gorm:"not null"`
+	Status        string       `gorm:"not null;index"`
+	TransactionID *string      `gorm:"index"`
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
+}
+
+// NewInterestRate creates the rate of an account type or account
+func NewInterestRate(scope, subject string, annualRate money.InterestRate, createdBy string) (*InterestRate, error) {
+	scope = strings.ToLower(strings.TrimSpace(scope))
+	subject = strings.TrimSpace(subject)
+
+	switch scope {
+	case InterestRateScopeAccountType:
+		subject = strings.ToLower(subject)
+		if !IsValidAccountType(subject) {
+			return nil, custom_err.ErrInvalidInterestRate
+		}
+	case InterestRateScopeAccount:
+		if subject == "" {
+			return nil, custom_err.ErrInvalidInterestRate
+		}
+	default:
+		return nil, custom_err.ErrInvalidInterestRate
+	}
+
+	if annualRate < 0 {
+		return nil, custom_err.ErrInvalidInterestRate
+	}
+
+	now := time.Now()
+	return &InterestRate{
+		ID:         uuid.New().String(),
+		Scope:      scope,
+		Subject:    subject,
+		AnnualRate: annualRate,
+		CreatedBy:  createdBy,
+		UpdatedBy:  createdBy,
+		CreatedAt:  now,
+		UpdatedAt:  now,
+	}, nil
+}
+
+// InterestRates resolves the rate of an account: the rate of the account itself, else the rate of its type
+type InterestRates struct {
+	byAccount     map[string]money.InterestRate
+	byAccountType map[string]money.InterestRate
+}
+
+// NewInterestRates indexes the configured rates
+func NewInterestRates(rates []*InterestRate) *InterestRates {
+	r := &InterestRates{
+		byAccount:     make(map[string]money.InterestRate),
+		byAccountType: make(map[string]money.InterestRate),
+	}
+	for _, rate := range rates {
+		switch rate.Scope {
+		case InterestRateScopeAccount:
+			r.byAccount[rate.Subject] = rate.AnnualRate
+		case InterestRateScopeAccountType:
+			r.byAccountType[rate.Subject] = rate.AnnualRate
+		}
+	}
+	return r
+}
+
+// RateFor returns the annual rate of the account, zero if none is configured
+func (r *InterestRates) RateFor(accountID, accountType string) money.InterestRate {
+	if rate, ok := r.byAccount[accountID]; ok {
+		return rate
+	}
+	return r.byAccountType[accountType]
+}
+
+// NewInterestAccrual creates the accrual of the account for the day, nil if the balance earns nothing
+func NewInterestAccrual(account *Account, day time.Time, balance money.Amount, annualRate money.InterestRate, daysInYear int) *InterestAccrual {
+	amount := annualRate.DailyAccrual(balance, daysInYear)
+	if amount <= 0 {
+		return nil
+	}
+	return &InterestAccrual{
+		ID:          uuid.New().String(),
+		AccountID:   account.ID,
+		AccrualDate: day.UTC().Format(InterestAccrualDateLayout),
+		Balance:     balance,
+		AnnualRate:  annualRate,
+		Amount:      amount,
+		Currency:    account.Currency,
+		CreatedAt:   time.Now(),
+	}
+}
+
+// NewInterestPosting creates the pending posting of the interest an account accrued in the month.
+// Accrued amounts are summed in full precision and rounded once, nil if they round to zero.
+func NewInterestPosting(accountID, currency, period string, accrued int64) *InterestPosting {
+	amount := money.FromAccrual(accrued)
+	if !amount.IsPositive() {
+		return nil
+	}
+	now := time.Now()
+	return &InterestPosting{
+		ID:        uuid.New().String(),
+		AccountID: accountID,
+		Period:    period,
+		Amount:    amount,
+		Currency:  currency,
+		Status:    InterestPostingStatusPending,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+}
+
+// Post marks the posting as credited by the transaction
+func (p *InterestPosting) Post(transactionID string) error {
+	if p.Status != InterestPostingStatusPending {
+		return custom_err.ErrInterestAlreadyPosted
+	}
+	p.Status = InterestPostingStatusPosted
+	p.TransactionID = &transactionID
+	p.UpdatedAt = time.Now()
+	return nil
+}
+
+// UndoPost makes a posting credited by the transaction pending again when the transaction is compensated
+func (p *InterestPosting) UndoPost(transactionID string) {
+	if p.Status != InterestPostingStatusPosted || p.TransactionID == nil || *p.TransactionID != transactionID {
+		return
+	}
+	p.Status = InterestPostingStatusPending
+	p.TransactionID = nil
+	p.UpdatedAt = time.Now()
+}
+
+// IsLastDayOfMonth reports whether the UTC day closes its month
+func IsLastDayOfMonth(day time.Time) bool {
+	day = day.UTC()
+	return day.AddDate(0, 0, 1).Month() != day.Month()
+}
+
+func (p *InterestPosting) ToString() string {
+	data, _ := json.Marshal(p)
+	return string(data)
+}
@@ -0,0 +1,65 @@
+package entity
+
+import (
+	custom_err "account-service/internal/domain/error"
+	"account-service/internal/domain/money"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+// TestInterestRates_RateFor tests that the rate of an account replaces the rate of its account type
+func TestInterestRates_RateFor(t *testing.T) {
+	rates := NewInterestRates([]*InterestRate{
+		{Scope: InterestRateScopeAccountType, Subject: AccountTypeSavings, AnnualRate: money.MustParseInterestRate("2.5")},
+		{Scope: InterestRateScopeAccount, Subject: "acc-vip", AnnualRate: money.MustParseInterestRate("4")},
+	})
+
+	assert.Equal(t, money.MustParseInterestRate("2.5"), rates.RateFor("acc-1", AccountTypeSavings))
+	assert.Equal(t, money.MustParseInterestRate("4"), rates.RateFor("acc-vip", AccountTypeSavings))
+	assert.Equal(t, money.InterestRate(0), rates.RateFor("acc-2", AccountTypeCurrent))
+}
+
+// TestNewInterestRate_Validation tests the scopes and subjects a rate can be set for
+func TestNewInterestRate_Validation(t *testing.T) {
+	rate, err := NewInterestRate(" Account_Type ", "Savings", money.MustParseInterestRate("1"), "admin")
+	assert.NoError(t, err)
+	assert.Equal(t, InterestRateScopeAccountType, rate.Scope)
+	assert.Equal(t, AccountTypeSavings, rate.Subject)
+
+	_, err = NewInterestRate(InterestRateScopeAccountType, "loan", money.MustParseInterestRate("1"), "admin")
+	assert.ErrorIs(t, err, custom_err.ErrInvalidInterestRate)
+
+	_, err = NewInterestRate(InterestRateScopeAccount, " ", money.MustParseInterestRate("1"), "admin")
+	assert.ErrorIs(t, err, custom_err.ErrInvalidInterestRate)
+
+	_, err = NewInterestRate("customer", "cust-1", money.MustParseInterestRate("1"), "admin")
+	assert.ErrorIs(t, err, custom_err.ErrInvalidInterestRate)
+}
+
+// TestInterestPosting_PostAndUndo tests that a posting is credited once and becomes pending again on compensation
+func TestInterestPosting_PostAndUndo(t *testing.T) {
+	posting := NewInterestPosting("acc-1", "USD", "2026-01", 31*100_000)
+	assert.Equal(t, money.MustParse("0.03"), posting.Amount)
+	assert.Equal(t, InterestPostingStatusPending, posting.Status)
+
+	assert.NoError(t, posting.Post("tx-1"))
+	assert.Equal(t, InterestPostingStatusPosted, posting.Status)
+	assert.ErrorIs(t, posting.Post("tx-2"), custom_err.ErrInterestAlreadyPosted)
+
+	// only the transaction that posted it can undo the posting
+	posting.UndoPost("tx-2")
+	assert.Equal(t, InterestPostingStatusPosted, posting.Status)
+	posting.UndoPost("tx-1")
+	assert.Equal(t, InterestPostingStatusPending, posting.Status)
+	assert.Nil(t, posting.TransactionID)
+
+	assert.Nil(t, NewInterestPosting("acc-1", "USD", "2026-01", 499_999))
+}
+
+// TestIsLastDayOfMonth tests the days a period is closed on
+func TestIsLastDayOfMonth(t *testing.T) {
+	assert.True(t, IsLastDayOfMonth(time.Date(2026, time.January, 31, 0, 0, 0, 0, time.UTC)))
+	assert.True(t, IsLastDayOfMonth(time.Date(2028, time.February, 29, 0, 0, 0, 0, time.UTC)))
+	assert.False(t, IsLastDayOfMonth(time.Date(2028, time.February, 28, 0, 0, 0, 0, time.UTC)))
+}
@@ -0,0 +1,180 @@
+package entity
+
+import (
+	custom_err "account-service/internal/domain/error"
+	"account-service/internal/domain/money"
+	"encoding/json"
+	"github.com/google/uuid"
+	"sort"
+	"strings"
+	"time"
+)
+
+const (
+	LedgerDirectionDebit  = "debit"
+	LedgerDirectionCredit = "credit"
+
+	JournalTypeAccountOpening = "account_opening"
+	JournalTypeTransaction    = "transaction"
+	JournalTypeCompensation   = "compensation"
+	JournalTypeMigration      = "opening_balance_migration"
+
+	// LedgerSettlementAccountPrefix prefixes the per-currency system accounts that take the
+	// other side of money entering, leaving or changing currency inside the bank
+	LedgerSettlementAccountPrefix = "settlement:"
+)
+
+// LedgerEntry is one side of a double-entry journal. Customer accounts are liabilities of
+// the bank, so a credit increases the account balance and a debit decreases it.
+type LedgerEntry struct {
+	ID          string       `gorm:"primaryKey"`
+	JournalID   string       `gorm:"not null;index"`
+	JournalType string       `gorm:"not null"`
+	Reference   string       `gorm:"not null;index"` // transaction ID, or account ID for openings
+	AccountID   string       `gorm:"not null;index"`
+	Direction   string       `gorm:"not null"`
+	Amount      money.Amount `gorm:"not null;check:amount > 0"` // minor units
+	Currency    string       `gorm:"not null"`
+	CreatedBy   string       `gorm:"not null"`
+	CreatedAt   time.Time    `gorm:"index"`
+}
+
+// BalanceChange is the signed change applied to an account balance in its own currency
+type BalanceChange struct {
+	AccountID string
+	Currency  string
+	Delta     money.Amount
+}
+
+// SettlementAccountID returns the ledger account that balances journals in the currency
+func SettlementAccountID(currency string) string {
+	return LedgerSettlementAccountPrefix + currency
+}
+
+// IsSettlementAccount reports whether the ledger account is a system settlement account
+func IsSettlementAccount(accountID string) bool {
+	return strings.HasPrefix(accountID, LedgerSettlementAccountPrefix)
+}
+
+// JournalChanges sums the entries of the given journal type into the net change of each
+// customer account. Settlement accounts are left out. Changes are ordered by account ID.
+func JournalChanges(entries []*LedgerEntry, journalType string) []BalanceChange {
+	byAccount := make(map[string]*BalanceChange)
+	var accountIDs []string
+	for _, entry := range entries {
+		if entry.JournalType != journalType || IsSettlementAccount(entry.AccountID) {
+			continue
+		}
+		change, ok := byAccount[entry.AccountID]
+		if !ok {
+			change = &BalanceChange{AccountID: entry.AccountID, Currency: entry.Currency}
+			byAccount[entry.AccountID] = change
+			accountIDs = append(accountIDs, entry.AccountID)
+		}
+		change.Delta = change.Delta.Add(entry.SignedAmount())
+	}
+
+	sort.Strings(accountIDs)
+	changes := make([]BalanceChange, 0, len(accountIDs))
+	for _, accountID := range accountIDs {
+		changes = append(changes, *byAccount[accountID])
+	}
+	return changes
+}
+
+// NewJournal builds the balanced entries for a set of balance changes. The net change of each
+// currency is offset against that currency's settlement account, so transfers in one currency
+// net to zero there while deposits, withdrawals and FX conversions leave a settlement trail.
+func NewJournal(journalType, reference string, changes []BalanceChange, requester string) ([]*LedgerEntry, error) {
+	journalID := uuid.New().String()
+	now := time.Now()
+
+	newEntry := func(accountID, currency string, delta money.Amount) *LedgerEntry {
+		direction := LedgerDirectionCredit
+		if delta.IsNegative() {
+			direction = LedgerDirectionDebit
+			delta = money.Zero.Sub(delta)
+		}
+		return &LedgerEntry{
+			ID:          uuid.New().String(),
+			JournalID:   journalID,
+			JournalType: journalType,
+			Reference:   reference,
+			AccountID:   accountID,
+			Direction:   direction,
+			Amount:      delta,
+			Currency:    currency,
+			CreatedBy:   requester,
+			CreatedAt:   now,
+		}
+	}
+
+	var entries []*LedgerEntry
+	netByCurrency := make(map[string]money.Amount)
+	for _, change := range changes {
+		if change.Delta.IsZero() {
+			continue
+		}
+		if !IsSupportedCurrency(change.Currency) {
+			return nil, custom_err.ErrUnsupportedCurrency
+		}
+		entries = append(entries, newEntry(change.AccountID, change.Currency, change.Delta))
+		netByCurrency[change.Currency] = netByCurrency[change.Currency].Add(change.Delta)
+	}
+
+	currencies := make([]string, 0, len(netByCurrency))
+	for currency := range netByCurrency {
+		currencies = append(currencies, currency)
+	}
+	sort.Strings(currencies)
+
+	for _, currency := range currencies {
+		net := netByCurrency[currency]
+		if net.IsZero() {
+			continue
+		}
+		entries = append(entries, newEntry(SettlementAccountID(currency), currency, money.Zero.Sub(net)))
+	}
+
+	if err := ValidateJournal(entries); err != nil {
+		return nil, err
+	}
+	return entries, nil
+}
+
+// ValidateJournal checks that debits equal credits in every currency of the journal
+func ValidateJournal(entries []*LedgerEntry) error {
+	totals := make(map[string]money.Amount)
+	for _, entry := range entries {
+		if !entry.Amount.IsPositive() {
+			return custom_err.ErrUnbalancedJournal
+		}
+		switch entry.Direction {
+		case LedgerDirectionCredit:
+			totals[entry.Currency] = totals[entry.Currency].Add(entry.Amount)
+		case LedgerDirectionDebit:
+			totals[entry.Currency] = totals[entry.Currency].Sub(entry.Amount)
+		default:
+			return custom_err.ErrUnbalancedJournal
+		}
+	}
+	for _, total := range totals {
+		if !total.IsZero() {
+			return custom_err.ErrUnbalancedJournal
+		}
+	}
+	return nil
+}
+
+// SignedAmount is the effect of the entry on the account balance
+func (e *LedgerEntry) SignedAmount() money.Amount {
+	if e.Direction == LedgerDirectionDebit {
+		return money.Zero.Sub(e.Amount)
+	}
+	return e.Amount
+}
+
+func (e *LedgerEntry) ToString() string {
+	jsonData, _ := json.Marshal(&e)
+	return string(jsonData)
+}
@@ -0,0 +1,93 @@
+package entity
+
+import (
+	custom_err "account-service/internal/domain/error"
+	"account-service/internal/domain/money"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+// TestNewJournal_SameCurrencyTransfer tests that a same-currency transfer nets to zero without settlement entries
+func TestNewJournal_SameCurrencyTransfer(t *testing.T) {
+	entries, err := NewJournal(JournalTypeTransaction, "txn-1", []BalanceChange{
+		{AccountID: "acc-1", Currency: CurrencyUSD, Delta: money.MustParse("-100.00")},
+		{AccountID: "acc-2", Currency: CurrencyUSD, Delta: money.MustParse("100.00")},
+	}, "user123")
+
+	assert.NoError(t, err)
+	assert.Len(t, entries, 2)
+	assert.Equal(t, LedgerDirectionDebit, entries[0].Direction)
+	assert.Equal(t, money.MustParse("100.00"), entries[0].Amount)
+	assert.Equal(t, LedgerDirectionCredit, entries[1].Direction)
+	assert.Equal(t, entries[0].JournalID, entries[1].JournalID)
+	assert.NoError(t, ValidateJournal(entries))
+}
+
+// TestNewJournal_CrossCurrencyTransfer tests that each currency is balanced against its settlement account
+func TestNewJournal_CrossCurrencyTransfer(t *testing.T) {
+	entries, err := NewJournal(JournalTypeTransaction, "txn-1", []BalanceChange{
+		{AccountID: "acc-1", Currency: CurrencyUSD, Delta: money.MustParse("-100.00")},
+		{AccountID: "acc-2", Currency: CurrencyBDT, Delta: money.MustParse("10950.00")},
+	}, "user123")
+
+	assert.NoError(t, err)
+	assert.Len(t, entries, 4)
+	assert.Equal(t, SettlementAccountID(CurrencyBDT), entries[2].AccountID)
+	assert.Equal(t, LedgerDirectionDebit, entries[2].Direction)
+	assert.Equal(t, SettlementAccountID(CurrencyUSD), entries[3].AccountID)
+	assert.Equal(t, LedgerDirectionCredit, entries[3].Direction)
+	assert.NoError(t, ValidateJournal(entries))
+}
+
+// TestNewJournal_Deposit tests that a deposit is offset against the settlement account
+func TestNewJournal_Deposit(t *testing.T) {
+	entries, err := NewJournal(JournalTypeAccountOpening, "acc-1", []BalanceChange{
+		{AccountID: "acc-1", Currency: CurrencyEUR, Delta: money.MustParse("500.00")},
+	}, "user123")
+
+	assert.NoError(t, err)
+	assert.Len(t, entries, 2)
+	assert.Equal(t, money.MustParse("500.00"), entries[0].SignedAmount())
+	assert.Equal(t, money.MustParse("-500.00"), entries[1].SignedAmount())
+}
+
+// TestNewJournal_NoChange tests that zero deltas produce no entries
+func TestNewJournal_NoChange(t *testing.T) {
+	entries, err := NewJournal(JournalTypeAccountOpening, "acc-1", []BalanceChange{
+		{AccountID: "acc-1", Currency: CurrencyUSD, Delta: money.Zero},
+	}, "user123")
+
+	assert.NoError(t, err)
+	assert.Empty(t, entries)
+}
+
+// TestValidateJournal_Unbalanced tests that unbalanced entries are rejected
+func TestValidateJournal_Unbalanced(t *testing.T) {
+	err := ValidateJournal([]*LedgerEntry{
+		{Direction: LedgerDirectionDebit, Amount: money.MustParse("10.00"), Currency: CurrencyUSD},
+		{Direction: LedgerDirectionCredit, Amount: money.MustParse("10.00"), Currency: CurrencyEUR},
+	})
+
+	assert.ErrorIs(t, err, custom_err.ErrUnbalancedJournal)
+}
+
+// TestJournalChanges_IgnoresSettlementAndOtherJournals tests that only customer accounts of the requested journal type are summed
+func TestJournalChanges_IgnoresSettlementAndOtherJournals(t *testing.T) {
+	entries, err := NewJournal(JournalTypeTransaction, "txn-1", []BalanceChange{
+		{AccountID: "acc-2", Currency: CurrencyBDT, Delta: money.MustParse("10950.00")},
+		{AccountID: "acc-1", Currency: CurrencyUSD, Delta: money.MustParse("-100.00")},
+	}, "user123")
+	assert.NoError(t, err)
+
+	compensation, err := NewJournal(JournalTypeCompensation, "txn-1", []BalanceChange{
+		{AccountID: "acc-1", Currency: CurrencyUSD, Delta: money.MustParse("100.00")},
+	}, "user123")
+	assert.NoError(t, err)
+
+	changes := JournalChanges(append(entries, compensation...), JournalTypeTransaction)
+
+	assert.Equal(t, []BalanceChange{
+		{AccountID: "acc-1", Currency: CurrencyUSD, Delta: money.MustParse("-100.00")},
+		{AccountID: "acc-2", Currency: CurrencyBDT, Delta: money.MustParse("10950.00")},
+	}, changes)
+}
@@ -0,0 +1,51 @@
+package entity
+
+import (
+	custom_err "account-service/internal/domain/error"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+// TestPageCursor_RoundTrip tests that a decoded token points at the same creation time and ID
+func TestPageCursor_RoundTrip(t *testing.T) {
+	createdAt := time.Date(2026, 3, 1, 10, 30, 0, 123456789, time.UTC)
+	token := PageCursor{CreatedAt: createdAt, ID: "cus-1"}.Encode()
+
+	cursor, err := DecodePageCursor(token)
+
+	assert.NoError(t, err)
+	assert.Equal(t, "cus-1", cursor.ID)
+	assert.True(t, cursor.CreatedAt.Equal(createdAt))
+}
+
+// TestDecodePageCursor_Empty tests that an empty token starts at the first page
+func TestDecodePageCursor_Empty(t *testing.T) {
+	cursor, err := DecodePageCursor(" ")
+
+	assert.NoError(t, err)
+	assert.Nil(t, cursor)
+}
+
+// TestDecodePageCursor_Invalid tests that tampered tokens are rejected
+func TestDecodePageCursor_Invalid(t *testing.T) {
+	for _, token := range []string{"%%%", "bm8tc2VwYXJhdG9y", "YWJjOmN1cy0x", "MTIzOg"} {
+		_, err := DecodePageCursor(token)
+		assert.ErrorIs(t, err, custom_err.ErrInvalidPageCursor, token)
+	}
+}
+
+// TestTrimPage tests that only a page read with an extra row gets a next cursor
+func TestTrimPage(t *testing.T) {
+	createdAt := time.Date(2026, 3, 1, 10, 30, 0, 0, time.UTC)
+	rows := []*Customer{{ID: "cus-1", CreatedAt: createdAt}, {ID: "cus-2", CreatedAt: createdAt}, {ID: "cus-3", CreatedAt: createdAt}}
+	cursorOf := func(c *Customer) PageCursor { return PageCursor{CreatedAt: c.CreatedAt, ID: c.ID} }
+
+	page, next := TrimPage(rows, 2, cursorOf)
+	assert.Equal(t, rows[:2], page)
+	assert.Equal(t, PageCursor{CreatedAt: createdAt, ID: "cus-2"}.Encode(), next)
+
+	page, next = TrimPage(rows, 3, cursorOf)
+	assert.Equal(t, rows, page)
+	assert.Empty(t, next)
+}
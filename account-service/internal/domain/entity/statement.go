@@ -0,0 +1,138 @@
+package entity
+
+import (
+	custom_err "account-service/internal/domain/error"
+	"account-service/internal/domain/money"
+	"encoding/json"
+	"github.com/google/uuid"
+	"strings"
+	"time"
+)
+
+const (
+	// StatementDateLayout formats the first and last UTC day of a statement
+	StatementDateLayout = "2006-01-02"
+	// StatementPeriodLayout formats the month of a monthly statement
+	StatementPeriodLayout = "2006-01"
+
+	// StatementMaxDays bounds the date range of a statement
+	StatementMaxDays = 366
+)
+
+// Statement lists the journal entries of an account between two UTC days with the balance before the first
+// day, the balance after each entry and the balance at the end of the last day
+type Statement struct {
+	ID             string           `gorm:"primaryKey"`
+	AccountID      string           `gorm:"not null;uniqueIndex:idx_statement_period"`
+	CustomerID     string           `gorm:"not null;index"`
+	PeriodStart    string           `gorm:"not null;uniqueIndex:idx_statement_period"` // first day, e.g. 2026-01-01
+	PeriodEnd      string           `gorm:"not null;uniqueIndex:idx_statement_period"` // last day, inclusive
+	Currency       string           `gorm:"not null"`
+	OpeningBalance money.Amount     `gorm:"not null"`
+	ClosingBalance money.Amount     `gorm:"not null"`
+	TotalCredits   money.Amount     `gorm:"not null;default:0"`
+	TotalDebits    money.Amount     `gorm:"not null;default:0"`
+	LineCount      int              `gorm:"not null;default:0"`
+	Lines          []*StatementLine `gorm:"foreignKey:StatementID"`
+	CreatedBy      string           `gorm:"not null"`
+	CreatedAt      time.Time
+}
+
+// StatementLine is one journal entry of a statement with the running balance after it
+type StatementLine struct {
+	ID          string       `gorm:"primaryKey"`
+	StatementID string       `gorm:"not null;uniqueIndex:idx_statement_line_position"`
+	Position    int          `gorm:"not null;uniqueIndex:idx_statement_line_position"` // 1-based, oldest first
+	PostedAt    time.Time    `gorm:"not null"`
+	JournalType string       `gorm:"not null"`
+	Reference   string       `gorm:"not null"` // transaction ID, or account ID for openings
+	Direction   string       `gorm:"not null"`
+	Amount      money.Amount `gorm:"not null"`
+	Balance     money.Amount `gorm:"not null"`
+}
+
+// StatementRun records that the monthly statements of a period were issued
+type StatementRun struct {
+	Period         string `gorm:"primaryKey"` // e.g. 2026-01
+	StatementCount int    `gorm:"not null;default:0"`
+	CreatedAt      time.Time
+}
+
+// ParseStatementPeriod parses the first and last day of a statement into the UTC start of the first day and the
+// UTC start of the day after the last one
+func ParseStatementPeriod(from, to string) (time.Time, time.Time, error) {
+	start, err := time.Parse(StatementDateLayout, strings.TrimSpace(from))
+	if err != nil {
+		return time.Time{}, time.Time{}, custom_err.ErrInvalidStatementPeriod
+	}
+	last, err := time.Parse(StatementDateLayout, strings.TrimSpace(to))
+	if err != nil {
+		return time.Time{}, time.Time{}, custom_err.ErrInvalidStatementPeriod
+	}
+	end := last.AddDate(0, 0, 1)
+	if !end.After(start) || end.After(start.AddDate(0, 0, StatementMaxDays)) {
+		return time.Time{}, time.Time{}, custom_err.ErrInvalidStatementPeriod
+	}
+	return start, end, nil
+}
+
+// MonthPeriod returns the UTC start of the month of the day and the start of the next month
+func MonthPeriod(day time.Time) (time.Time, time.Time) {
+	day = day.UTC()
+	start := time.Date(day.Year(), day.Month(), 1, 0, 0, 0, 0, time.UTC)
+	return start, start.AddDate(0, 1, 0)
+}
+
+// NewStatement builds the statement of the account from its balance at start and its journal entries posted
+// before end, oldest first. Entries of other accounts are skipped. The statement gets an ID once it is issued.
+func NewStatement(account *Account, start, end time.Time, openingBalance money.Amount, entries []*LedgerEntry) *Statement {
+	statement := &Statement{
+		AccountID:      account.ID,
+		CustomerID:     account.CustomerID,
+		PeriodStart:    start.UTC().Format(StatementDateLayout),
+		PeriodEnd:      end.UTC().AddDate(0, 0, -1).Format(StatementDateLayout),
+		Currency:       account.Currency,
+		OpeningBalance: openingBalance,
+		ClosingBalance: openingBalance,
+		Lines:          []*StatementLine{},
+	}
+
+	for _, entry := range entries {
+		if entry.AccountID != account.ID {
+			continue
+		}
+		statement.ClosingBalance = statement.ClosingBalance.Add(entry.SignedAmount())
+		if entry.Direction == LedgerDirectionDebit {
+			statement.TotalDebits = statement.TotalDebits.Add(entry.Amount)
+		} else {
+			statement.TotalCredits = statement.TotalCredits.Add(entry.Amount)
+		}
+		statement.Lines = append(statement.Lines, &StatementLine{
+			Position:    len(statement.Lines) + 1,
+			PostedAt:    entry.CreatedAt,
+			JournalType: entry.JournalType,
+			Reference:   entry.Reference,
+			Direction:   entry.Direction,
+			Amount:      entry.Amount,
+			Balance:     statement.ClosingBalance,
+		})
+	}
+	statement.LineCount = len(statement.Lines)
+	return statement
+}
+
+// Issue gives the statement and its lines their IDs so it can be stored
+func (s *Statement) Issue(createdBy string) {
+	s.ID = uuid.New().String()
+	s.CreatedBy = createdBy
+	s.CreatedAt = time.Now()
+	for _, line := range s.Lines {
+		line.ID = uuid.New().String()
+		line.StatementID = s.ID
+	}
+}
+
+func (s *Statement) ToString() string {
+	jsonData, _ := json.Marshal(&s)
+	return string(jsonData)
+}
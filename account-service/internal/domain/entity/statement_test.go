@@ -0,0 +1,61 @@
+package entity
+
+import (
+	custom_err "account-service/internal/domain/error"
+	"account-service/internal/domain/money"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+// TestNewStatement_RunningBalance tests the opening, running and closing balances of a statement
+func TestNewStatement_RunningBalance(t *testing.T) {
+	account := &Account{ID: "acc-1", CustomerID: "cust-1", Currency: CurrencyUSD}
+	start := time.Date(2026, time.January, 1, 0, 0, 0, 0, time.UTC)
+	end := time.Date(2026, time.February, 1, 0, 0, 0, 0, time.UTC)
+
+	statement := NewStatement(account, start, end, money.MustParse("100.00"), []*LedgerEntry{
+		{AccountID: "acc-1", JournalType: JournalTypeTransaction, Reference: "tx-1", Direction: LedgerDirectionDebit, Amount: money.MustParse("30.00")},
+		{AccountID: SettlementAccountID(CurrencyUSD), JournalType: JournalTypeTransaction, Reference: "tx-1", Direction: LedgerDirectionCredit, Amount: money.MustParse("30.00")},
+		{AccountID: "acc-1", JournalType: JournalTypeTransaction, Reference: "tx-2", Direction: LedgerDirectionCredit, Amount: money.MustParse("12.50")},
+		{AccountID: "acc-1", JournalType: JournalTypeCompensation, Reference: "tx-1", Direction: LedgerDirectionCredit, Amount: money.MustParse("30.00")},
+	})
+
+	assert.Equal(t, "2026-01-01", statement.PeriodStart)
+	assert.Equal(t, "2026-01-31", statement.PeriodEnd)
+	assert.Equal(t, money.MustParse("100.00"), statement.OpeningBalance)
+	assert.Equal(t, money.MustParse("112.50"), statement.ClosingBalance)
+	assert.Equal(t, money.MustParse("42.50"), statement.TotalCredits)
+	assert.Equal(t, money.MustParse("30.00"), statement.TotalDebits)
+	assert.Equal(t, 3, statement.LineCount)
+	assert.Equal(t, money.MustParse("70.00"), statement.Lines[0].Balance)
+	assert.Equal(t, money.MustParse("82.50"), statement.Lines[1].Balance)
+	assert.Equal(t, money.MustParse("112.50"), statement.Lines[2].Balance)
+	assert.Equal(t, 3, statement.Lines[2].Position)
+	assert.Empty(t, statement.ID)
+
+	statement.Issue("system")
+	assert.NotEmpty(t, statement.ID)
+	assert.Equal(t, statement.ID, statement.Lines[0].StatementID)
+	assert.NotEmpty(t, statement.Lines[0].ID)
+}
+
+// TestParseStatementPeriod tests the date ranges a statement can be generated for
+func TestParseStatementPeriod(t *testing.T) {
+	start, end, err := ParseStatementPeriod("2026-01-01", " 2026-01-31 ")
+	assert.NoError(t, err)
+	assert.Equal(t, time.Date(2026, time.January, 1, 0, 0, 0, 0, time.UTC), start)
+	assert.Equal(t, time.Date(2026, time.February, 1, 0, 0, 0, 0, time.UTC), end)
+
+	_, _, err = ParseStatementPeriod("2026-01-01", "2026-01-01")
+	assert.NoError(t, err)
+
+	_, _, err = ParseStatementPeriod("2026-02-01", "2026-01-31")
+	assert.ErrorIs(t, err, custom_err.ErrInvalidStatementPeriod)
+
+	_, _, err = ParseStatementPeriod("2025-01-01", "2026-01-31")
+	assert.ErrorIs(t, err, custom_err.ErrInvalidStatementPeriod)
+
+	_, _, err = ParseStatementPeriod("01/01/2026", "2026-01-31")
+	assert.ErrorIs(t, err, custom_err.ErrInvalidStatementPeriod)
+}
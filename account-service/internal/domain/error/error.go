@@ -35,4 +35,39 @@ var (
 	ErrInsufficientBalance         = errors.New("insufficient balance")
 	ErrMinimumOneAccountIdRequired = errors.New("minimum one account id required")
 	ErrTransactionIdRequired       = errors.New("transaction id required")
+	ErrUnsupportedCurrency         = errors.New("unsupported currency")
+	ErrUnbalancedJournal           = errors.New("ledger journal is not balanced")
+	ErrInvalidAccountType          = errors.New("invalid account type")
+	ErrOverdraftNotAllowed         = errors.New("overdraft is only available on current accounts")
+	ErrInvalidOverdraftLimit       = errors.New("invalid overdraft limit")
+	ErrHoldNotFound                = errors.New("hold not found")
+	ErrHoldNotActive               = errors.New("hold is not active")
+	ErrInvalidHoldExpiry           = errors.New("hold expiry must be in the future")
+	ErrInvalidHoldCaptureAmount    = errors.New("capture amount must be positive and not exceed the held amount")
+	ErrInvalidInterestRate         = errors.New("invalid interest rate")
+	ErrInterestPostingNotFound     = errors.New("interest posting not found")
+	ErrInterestAlreadyPosted       = errors.New("interest is already posted")
+	ErrInterestAmountMismatch      = errors.New("credited amount does not match the interest posting")
+	ErrInvalidStatementPeriod      = errors.New("invalid statement period")
+	ErrStatementNotFound           = errors.New("statement not found")
+	ErrInvalidPageCursor           = errors.New("invalid page cursor")
+	ErrInvalidCustomerName         = errors.New("name must be at most 50 characters")
+	ErrInvalidDateOfBirth          = errors.New("date of birth must be a past date in YYYY-MM-DD format")
+	ErrInvalidNationalID           = errors.New("national ID must be 5 to 20 letters or digits")
+	ErrNationalIDExists            = errors.New("national ID belongs to another customer")
+	ErrInvalidAddress              = errors.New("address must be at most 200 characters")
+	ErrInvalidPhone                = errors.New("phone must be in international format, e.g. +4915112345678")
+	ErrInvalidEmail                = errors.New("invalid email address")
+	ErrInvalidKYCStatus            = errors.New("KYC status must be pending, verified or rejected")
+	ErrIncompleteKYCProfile        = errors.New("date of birth, national ID and address are required to verify a customer")
+	ErrInvalidAccountStatus        = errors.New("account status must be active or locked")
+	ErrInvalidFreezeMode           = errors.New("freeze mode must be debit, credit or both")
+	ErrStatusReasonRequired        = errors.New("a reason is required to change the account status")
+	ErrAccountAlreadyActive        = errors.New("account is already active")
+	ErrAccountClosed               = errors.New("account is closed")
+	ErrAccountDebitBlocked         = errors.New("account does not allow debits")
+	ErrAccountCreditBlocked        = errors.New("account does not allow credits")
+	ErrAccountBalanceNotZero       = errors.New("account balance must be paid out before closing")
+	ErrAccountHasActiveHolds       = errors.New("account has active holds")
+	ErrAccountHasPendingInterest   = errors.New("account has interest waiting to be credited")
 )
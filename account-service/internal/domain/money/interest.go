@@ -0,0 +1,108 @@
+package money
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+)
+
+// InterestRateScale is the number of decimal places kept for an annual interest rate in percent.
+const InterestRateScale = 4
+
+const interestRateUnitsPerPercent = 10_000
+
+// maxInterestRate caps annual rates at 100%
+const maxInterestRate = 100 * interestRateUnitsPerPercent
+
+// AccrualUnitsPerMinor is the precision of accrued interest: a day of interest on a small balance is
+// usually a fraction of a minor unit, so accruals are kept in millionths of a minor unit until posted.
+const AccrualUnitsPerMinor = 1_000_000
+
+// ErrInvalidInterestRate is returned when an interest rate cannot be parsed or is out of range.
+var ErrInvalidInterestRate = errors.New("invalid interest rate")
+
+// InterestRate is a fixed-point annual interest rate in percent with InterestRateScale decimal places,
+// e.g. 2.5% is stored as 25000.
+type InterestRate int64
+
+// ParseInterestRate converts a decimal percentage (e.g. "2.5", "0.125") into an InterestRate.
+// Rates must be between 0 and 100 percent.
+func ParseInterestRate(s string) (InterestRate, error) {
+	s = strings.TrimSpace(s)
+	whole, frac, _ := strings.Cut(s, ".")
+	if s == "" || (whole == "" && frac == "") || !isDigits(whole) || !isDigits(frac) {
+		return 0, ErrInvalidInterestRate
+	}
+	if len(frac) > InterestRateScale {
+		if strings.TrimRight(frac[InterestRateScale:], "0") != "" {
+			return 0, ErrInvalidInterestRate
+		}
+		frac = frac[:InterestRateScale]
+	}
+	frac += strings.Repeat("0", InterestRateScale-len(frac))
+	if whole == "" {
+		whole = "0"
+	}
+
+	percent, err := strconv.ParseInt(whole, 10, 64)
+	if err != nil || percent > 100 {
+		return 0, ErrInvalidInterestRate
+	}
+	fraction, _ := strconv.ParseInt(frac, 10, 64)
+
+	rate := InterestRate(percent*interestRateUnitsPerPercent + fraction)
+	if rate > maxInterestRate {
+		return 0, ErrInvalidInterestRate
+	}
+	return rate, nil
+}
+
+// MustParseInterestRate is like ParseInterestRate but panics if the value cannot be parsed.
+func MustParseInterestRate(s string) InterestRate {
+	r, err := ParseInterestRate(s)
+	if err != nil {
+		panic(fmt.Sprintf("money: cannot parse interest rate %q: %v", s, err))
+	}
+	return r
+}
+
+// IsPositive reports whether the rate is above zero.
+func (r InterestRate) IsPositive() bool {
+	return r > 0
+}
+
+// DailyAccrual is the interest earned by the balance in one day, in millionths of a minor unit rounded down.
+// Balances at or below zero earn nothing.
+func (r InterestRate) DailyAccrual(balance Amount, daysInYear int) int64 {
+	if balance <= 0 || r <= 0 || daysInYear <= 0 {
+		return 0
+	}
+	// balance * (r / 100 / 10^4) / daysInYear minor units, times 10^6 accrual units per minor unit
+	product := new(big.Int).Mul(big.NewInt(int64(balance)), big.NewInt(int64(r)))
+	accrual := product.Quo(product, big.NewInt(int64(daysInYear)))
+	if !accrual.IsInt64() {
+		return 0
+	}
+	return accrual.Int64()
+}
+
+// String formats the rate as a percentage with exactly InterestRateScale decimal places.
+func (r InterestRate) String() string {
+	return fmt.Sprintf("%d.%04d", int64(r)/interestRateUnitsPerPercent, int64(r)%interestRateUnitsPerPercent)
+}
+
+// MarshalJSON encodes the rate as a decimal string.
+func (r InterestRate) MarshalJSON() ([]byte, error) {
+	return json.Marshal(r.String())
+}
+
+// FromAccrual rounds accrued interest in millionths of a minor unit half up to an Amount.
+func FromAccrual(units int64) Amount {
+	if units <= 0 {
+		return Zero
+	}
+	return Amount((units + AccrualUnitsPerMinor/2) / AccrualUnitsPerMinor)
+}
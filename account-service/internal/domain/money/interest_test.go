@@ -0,0 +1,50 @@
+package money
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+// TestParseInterestRate tests parsing annual percentages into fixed-point rates
+func TestParseInterestRate(t *testing.T) {
+	testCases := []struct {
+		input    string
+		expected InterestRate
+		err      error
+	}{
+		{"2.5", 25000, nil},
+		{"0.125", 1250, nil},
+		{"0", 0, nil},
+		{"100", 1000000, nil},
+		{"3.25000", 32500, nil},
+		{"100.01", 0, ErrInvalidInterestRate},
+		{"1.23456", 0, ErrInvalidInterestRate},
+		{"-1", 0, ErrInvalidInterestRate},
+		{"", 0, ErrInvalidInterestRate},
+		{"abc", 0, ErrInvalidInterestRate},
+	}
+
+	for _, tc := range testCases {
+		rate, err := ParseInterestRate(tc.input)
+		assert.ErrorIs(t, err, tc.err, tc.input)
+		assert.Equal(t, tc.expected, rate, tc.input)
+	}
+	assert.Equal(t, "2.5000", MustParseInterestRate("2.5").String())
+}
+
+// TestInterestRate_DailyAccrual tests that daily interest keeps sub-cent precision until it is posted
+func TestInterestRate_DailyAccrual(t *testing.T) {
+	rate := MustParseInterestRate("3.65")
+
+	// 100.00 at 3.65% earns 0.01 a day
+	assert.Equal(t, int64(1_000_000), rate.DailyAccrual(MustParse("100.00"), 365))
+	// 10.00 earns a tenth of a cent a day, which would be lost if rounded daily
+	assert.Equal(t, int64(100_000), rate.DailyAccrual(MustParse("10.00"), 365))
+	assert.Equal(t, int64(0), rate.DailyAccrual(MustParse("-50.00"), 365))
+	assert.Equal(t, int64(0), InterestRate(0).DailyAccrual(MustParse("100.00"), 365))
+
+	// a 30 day month of tenths rounds to 0.03
+	assert.Equal(t, MustParse("0.03"), FromAccrual(30*100_000))
+	assert.Equal(t, MustParse("0.01"), FromAccrual(500_000))
+	assert.Equal(t, Zero, FromAccrual(499_999))
+}
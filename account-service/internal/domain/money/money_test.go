@@ -0,0 +1,74 @@
+package money
+
+import (
+	"encoding/json"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+// TestParse tests parsing decimal strings into minor units
+func TestParse(t *testing.T) {
+	testCases := []struct {
+		input    string
+		expected Amount
+		err      error
+	}{
+		{"100", 10000, nil},
+		{"100.5", 10050, nil},
+		{"0.10", 10, nil},
+		{".25", 25, nil},
+		{"-500.25", -50025, nil},
+		{"+1.00", 100, nil},
+		{"1.230", 123, nil},
+		{"1.234", 0, ErrPrecision},
+		{"", 0, ErrInvalidAmount},
+		{"abc", 0, ErrInvalidAmount},
+		{"1e3", 0, ErrInvalidAmount},
+		{"99999999999999999999", 0, ErrInvalidAmount},
+		{"92233720368547758.07", 1<<63 - 1, nil},
+		{"-92233720368547758.07", -(1<<63 - 1), nil},
+		{"92233720368547758.08", 0, ErrInvalidAmount},
+		{"92233720368547759", 0, ErrInvalidAmount},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.input, func(t *testing.T) {
+			amount, err := Parse(tc.input)
+			assert.ErrorIs(t, err, tc.err)
+			assert.Equal(t, tc.expected, amount)
+		})
+	}
+}
+
+// TestAmount_String tests formatting amounts with fixed decimal places
+func TestAmount_String(t *testing.T) {
+	assert.Equal(t, "0.00", Zero.String())
+	assert.Equal(t, "1234.56", FromMinor(123456).String())
+	assert.Equal(t, "-0.05", FromMinor(-5).String())
+}
+
+// TestAmount_Arithmetic tests that repeated additions stay exact
+func TestAmount_Arithmetic(t *testing.T) {
+	total := Zero
+	for i := 0; i < 10; i++ {
+		total = total.Add(MustParse("0.10"))
+	}
+	assert.Equal(t, MustParse("1.00"), total)
+	assert.Equal(t, MustParse("0.70"), total.Sub(MustParse("0.30")))
+}
+
+// TestAmount_JSON tests json encoding and decoding from strings and numbers
+func TestAmount_JSON(t *testing.T) {
+	data, err := json.Marshal(MustParse("10.5"))
+	assert.NoError(t, err)
+	assert.Equal(t, `"10.50"`, string(data))
+
+	var fromString, fromNumber Amount
+	assert.NoError(t, json.Unmarshal([]byte(`"42.42"`), &fromString))
+	assert.NoError(t, json.Unmarshal([]byte(`42.42`), &fromNumber))
+	assert.Equal(t, FromMinor(4242), fromString)
+	assert.Equal(t, fromString, fromNumber)
+
+	var invalid Amount
+	assert.Error(t, json.Unmarshal([]byte(`true`), &invalid))
+}
@@ -4,6 +4,10 @@ import (
 	protoacc "account-service/api/protogen/accountservice/proto"
 	appaccount "account-service/internal/app/account"
 	appcustomer "account-service/internal/app/customer"
+	apphold "account-service/internal/app/hold"
+	appinterest "account-service/internal/app/interest"
+	appledger "account-service/internal/app/ledger"
+	appstatement "account-service/internal/app/statement"
 	apptxsaga "account-service/internal/app/transaction_saga"
 )
 
@@ -12,15 +16,35 @@ type AccountHandlerService struct {
 	protoacc.UnimplementedAccountServiceServer
 	CreateCustomerService                *appcustomer.CreateCustomer
 	ListCustomerService                  *appcustomer.ListCustomer
+	GetCustomerService                   *appcustomer.GetCustomer
+	UpdateCustomerService                *appcustomer.UpdateCustomer
 	DeleteCustomerService                *appcustomer.DeleteCustomer
 	CreateAccountService                 *appaccount.CreateAccount
 	DeleteAccountService                 *appaccount.DeleteAccount
 	GetAccountBalanceService             *appaccount.GetAccountBalance
 	ListAccountService                   *appaccount.ListAccount
+	SetOverdraftLimitService             *appaccount.SetOverdraftLimit
+	UpdateAccountStatusService           *appaccount.UpdateAccountStatus
+	CloseAccountService                  *appaccount.CloseAccount
 	ValidateAccountForTransactionService *apptxsaga.ValidateAccountForTransaction
 	LockAccountForTransaction            *apptxsaga.LockAccountForTransaction
 	UnlockAccountsForTransaction         *apptxsaga.UnlockAccountsForTransaction
 	UpdateAccountBalanceForTransaction   *apptxsaga.UpdateAccountBalanceForTransaction
+	GetTransactionJournalStatusService   *apptxsaga.GetTransactionJournalStatus
+	GetAccountJournalService             *appledger.GetAccountJournal
+	RecomputeAccountBalanceService       *appledger.RecomputeAccountBalance
+	PlaceHoldService                     *apphold.PlaceHold
+	GetHoldService                       *apphold.GetHold
+	ReleaseHoldService                   *apphold.ReleaseHold
+	ListAccountHoldsService              *apphold.ListAccountHolds
+	SetInterestRateService               *appinterest.SetInterestRate
+	ListInterestRatesService             *appinterest.ListInterestRates
+	GetAccountInterestService            *appinterest.GetAccountInterest
+	ListPendingInterestPostingsService   *appinterest.ListPendingInterestPostings
+	SettleAccountInterestService         *appinterest.SettleAccountInterest
+	GenerateAccountStatementService      *appstatement.GenerateAccountStatement
+	ListAccountStatementsService         *appstatement.ListAccountStatements
+	GetStatementService                  *appstatement.GetStatement
 }
 
 // NewAggregatedHandler creates a new AccountHandler.
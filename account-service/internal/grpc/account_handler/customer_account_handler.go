@@ -2,13 +2,27 @@ package handlers
 
 import (
 	protoacc "account-service/api/protogen/accountservice/proto"
+	"account-service/internal/domain/entity"
+	"account-service/internal/domain/money"
 	"account-service/internal/logging"
 	"context"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 func (s *AccountHandlerService) CreateAccount(ctx context.Context, req *protoacc.CreateAccountRequest) (*protoacc.CreateAccountResponse, error) {
-	account, message, err := s.CreateAccountService.Execute(req.CustomerId, req.InitialDeposit, req.GetMetadata().GetRequester(), req.GetMetadata().GetRequestId())
+	initialDeposit, err := money.Parse(req.InitialDeposit)
+	if err != nil {
+		logging.Logger.Warn().Err(err).Str("customer_id", req.CustomerId).Str("initial_deposit", req.InitialDeposit).Msg("invalid initial deposit")
+		return &protoacc.CreateAccountResponse{
+			AccountId: "",
+			Response: &protoacc.Response{
+				Message: "Invalid initial deposit amount",
+				Success: false,
+			},
+		}, nil
+	}
+
+	account, message, err := s.CreateAccountService.Execute(req.CustomerId, req.Currency, req.AccountType, initialDeposit, req.GetMetadata().GetRequester(), req.GetMetadata().GetRequestId())
 	if err != nil {
 		logging.Logger.Warn().Err(err).Str("customer_id", req.CustomerId).Msg("create account failed")
 		return &protoacc.CreateAccountResponse{
@@ -50,11 +64,13 @@ func (s *AccountHandlerService) DeleteAccount(ctx context.Context, req *protoacc
 }
 
 func (s *AccountHandlerService) GetBalance(ctx context.Context, req *protoacc.GetBalanceRequest) (*protoacc.GetBalanceResponse, error) {
-	amount, version, message, err := s.GetAccountBalanceService.Execute(req.AccountId, req.GetMetadata().GetRequester(), req.GetMetadata().GetRequestId())
+	balance, available, version, message, err := s.GetAccountBalanceService.Execute(req.AccountId, req.GetMetadata().GetRequester(), req.GetMetadata().GetRequestId())
 	if err != nil {
 		logging.Logger.Warn().Err(err).Str("account_id", req.AccountId).Msg("get account failed")
 		return &protoacc.GetBalanceResponse{
-			Balance: 0,
+			Balance:          money.Zero.String(),
+			AvailableBalance: money.Zero.String(),
+			LedgerBalance:    money.Zero.String(),
 			Response: &protoacc.Response{
 				Message: message,
 				Success: false,
@@ -63,8 +79,10 @@ func (s *AccountHandlerService) GetBalance(ctx context.Context, req *protoacc.Ge
 	}
 
 	return &protoacc.GetBalanceResponse{
-		Balance: amount,
-		Version: int32(version),
+		Balance:          balance.String(),
+		AvailableBalance: available.String(),
+		LedgerBalance:    balance.String(),
+		Version:          int32(version),
 		Response: &protoacc.Response{
 			Message: message,
 			Success: true,
@@ -73,7 +91,7 @@ func (s *AccountHandlerService) GetBalance(ctx context.Context, req *protoacc.Ge
 }
 
 func (h *AccountHandlerService) ListAccount(ctx context.Context, req *protoacc.ListAccountsRequest) (*protoacc.ListAccountsResponse, error) {
-	accounts, totalCount, totalPages, message, err := h.ListAccountService.Execute(req.CustomerId, req.MinBalance, req.InTransaction, int(req.GetPagination().GetPage()), int(req.GetPagination().GetPageSize()), req.GetSortOrder(), req.GetMetadata().GetRequester(), req.GetMetadata().GetRequestId())
+	accounts, totalCount, totalPages, nextCursor, message, err := h.ListAccountService.Execute(req.CustomerId, req.MinBalance, req.InTransaction, int(req.GetPagination().GetPage()), int(req.GetPagination().GetPageSize()), req.GetPagination().GetCursor(), req.GetPagination().GetIncludeTotal(), req.GetSortOrder(), req.GetMetadata().GetRequester(), req.GetMetadata().GetRequestId())
 	if err != nil {
 		logging.Logger.Warn().Err(err).Msg("list customer failed")
 		return &protoacc.ListAccountsResponse{
@@ -93,11 +111,17 @@ func (h *AccountHandlerService) ListAccount(ctx context.Context, req *protoacc.L
 	protoAccounts := make([]*protoacc.Account, len(accounts))
 	for i, account := range accounts {
 		protoAccounts[i] = &protoacc.Account{
-			Id:           account.ID,
-			CustomerId:   account.CustomerID,
-			Balance:      account.Balance,
-			ActiveStatus: account.ActiveStatus,
-			CreatedAt:    timestamppb.New(account.CreatedAt),
+			Id:             account.ID,
+			CustomerId:     account.CustomerID,
+			Balance:        account.Balance.String(),
+			Currency:       account.Currency,
+			AccountType:    account.AccountType,
+			OverdraftLimit: account.OverdraftLimit.String(),
+			Overdrawn:      account.IsOverdrawn(),
+			ActiveStatus:   account.ActiveStatus,
+			FreezeMode:     account.FreezeMode,
+			StatusReason:   account.StatusReason,
+			CreatedAt:      timestamppb.New(account.CreatedAt),
 		}
 	}
 
@@ -108,6 +132,52 @@ func (h *AccountHandlerService) ListAccount(ctx context.Context, req *protoacc.L
 			PageSize:   req.GetPagination().GetPageSize(),
 			TotalCount: int32(totalCount),
 			TotalPages: int32(totalPages),
+			NextCursor: nextCursor,
+		},
+		Response: &protoacc.Response{
+			Message: message,
+			Success: true,
+		},
+	}, nil
+}
+
+func (s *AccountHandlerService) SetOverdraftLimit(ctx context.Context, req *protoacc.SetOverdraftLimitRequest) (*protoacc.SetOverdraftLimitResponse, error) {
+	limit, err := money.Parse(req.OverdraftLimit)
+	if err != nil {
+		logging.Logger.Warn().Err(err).Str("account_id", req.AccountId).Str("overdraft_limit", req.OverdraftLimit).Msg("invalid overdraft limit")
+		return &protoacc.SetOverdraftLimitResponse{
+			Response: &protoacc.Response{
+				Message: "Invalid overdraft limit amount",
+				Success: false,
+			},
+		}, nil
+	}
+
+	account, message, err := s.SetOverdraftLimitService.Execute(req.AccountId, limit, req.GetMetadata().GetRequester(), req.GetMetadata().GetRequestId())
+	if err != nil {
+		logging.Logger.Warn().Err(err).Str("account_id", req.AccountId).Msg("set overdraft limit failed")
+		return &protoacc.SetOverdraftLimitResponse{
+			Response: &protoacc.Response{
+				Message: message,
+				Success: false,
+			},
+		}, nil
+	}
+
+	return &protoacc.SetOverdraftLimitResponse{
+		Account: &protoacc.Account{
+			Id:             account.ID,
+			CustomerId:     account.CustomerID,
+			Balance:        account.Balance.String(),
+			Currency:       account.Currency,
+			AccountType:    account.AccountType,
+			OverdraftLimit: account.OverdraftLimit.String(),
+			Overdrawn:      account.IsOverdrawn(),
+			Version:        int32(account.Version),
+			ActiveStatus:   account.ActiveStatus,
+			CreatedAt:      timestamppb.New(account.CreatedAt),
+			UpdatedAt:      timestamppb.New(account.UpdatedAt),
+			UpdatedBy:      account.UpdatedBy,
 		},
 		Response: &protoacc.Response{
 			Message: message,
@@ -115,3 +185,73 @@ func (h *AccountHandlerService) ListAccount(ctx context.Context, req *protoacc.L
 		},
 	}, nil
 }
+
+func (s *AccountHandlerService) UpdateAccountStatus(ctx context.Context, req *protoacc.UpdateAccountStatusRequest) (*protoacc.UpdateAccountStatusResponse, error) {
+	account, message, err := s.UpdateAccountStatusService.Execute(req.AccountId, req.AccountStatus, req.FreezeMode, req.Reason, req.GetMetadata().GetRequester(), req.GetMetadata().GetRequestId())
+	if err != nil {
+		logging.Logger.Warn().Err(err).Str("account_id", req.AccountId).Str("account_status", req.AccountStatus).Msg("update account status failed")
+		return &protoacc.UpdateAccountStatusResponse{
+			Response: &protoacc.Response{
+				Message: message,
+				Success: false,
+			},
+		}, nil
+	}
+
+	return &protoacc.UpdateAccountStatusResponse{
+		Account: toProtoAccount(account),
+		Response: &protoacc.Response{
+			Message: message,
+			Success: true,
+		},
+	}, nil
+}
+
+func (s *AccountHandlerService) CloseAccount(ctx context.Context, req *protoacc.CloseAccountRequest) (*protoacc.CloseAccountResponse, error) {
+	account, message, err := s.CloseAccountService.Execute(req.AccountId, req.PayoutAccountId, req.PayoutTransactionId, req.Reason, req.GetMetadata().GetRequester(), req.GetMetadata().GetRequestId())
+	if err != nil {
+		logging.Logger.Warn().Err(err).Str("account_id", req.AccountId).Msg("close account failed")
+		return &protoacc.CloseAccountResponse{
+			Response: &protoacc.Response{
+				Message: message,
+				Success: false,
+			},
+		}, nil
+	}
+
+	return &protoacc.CloseAccountResponse{
+		Account: toProtoAccount(account),
+		Response: &protoacc.Response{
+			Message: message,
+			Success: true,
+		},
+	}, nil
+}
+
+// toProtoAccount maps an account with its lifecycle status to its proto message
+func toProtoAccount(account *entity.Account) *protoacc.Account {
+	protoAccount := &protoacc.Account{
+		Id:             account.ID,
+		CustomerId:     account.CustomerID,
+		Balance:        account.Balance.String(),
+		Currency:       account.Currency,
+		AccountType:    account.AccountType,
+		OverdraftLimit: account.OverdraftLimit.String(),
+		Overdrawn:      account.IsOverdrawn(),
+		Version:        int32(account.Version),
+		ActiveStatus:   account.ActiveStatus,
+		FreezeMode:     account.FreezeMode,
+		StatusReason:   account.StatusReason,
+		CreatedAt:      timestamppb.New(account.CreatedAt),
+		UpdatedAt:      timestamppb.New(account.UpdatedAt),
+		CreatedBy:      account.CreatedBy,
+		UpdatedBy:      account.UpdatedBy,
+	}
+	if account.StatusChangedAt != nil {
+		protoAccount.StatusChangedAt = timestamppb.New(*account.StatusChangedAt)
+	}
+	if account.LastActivityAt != nil {
+		protoAccount.LastActivityAt = timestamppb.New(*account.LastActivityAt)
+	}
+	return protoAccount
+}
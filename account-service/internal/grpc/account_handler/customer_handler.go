@@ -2,7 +2,9 @@ package handlers
 
 import (
 	protoacc "account-service/api/protogen/accountservice/proto"
+	"account-service/internal/domain/entity"
 	"account-service/internal/logging"
+	"account-service/internal/ports"
 	"context"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
@@ -31,7 +33,23 @@ func (h *AccountHandlerService) CreateCustomer(ctx context.Context, req *protoac
 }
 
 func (h *AccountHandlerService) ListCustomers(ctx context.Context, req *protoacc.ListCustomersRequest) (*protoacc.ListCustomersResponse, error) {
-	customers, totalCount, totalPage, message, err := h.ListCustomerService.Execute(int(req.GetPagination().GetPage()), int(req.GetPagination().GetPageSize()), req.GetSortOrder(), req.GetMetadata().GetRequestId())
+	search := ports.CustomerSearchDTO{
+		Name:         req.GetName(),
+		CreatedBy:    req.GetCreatedBy(),
+		ActiveStatus: req.GetActiveStatus(),
+		MinBalance:   req.GetMinBalance(),
+		AccountID:    req.GetAccountId(),
+	}
+	if req.GetStartDate() != nil {
+		startDate := req.GetStartDate().AsTime()
+		search.StartDate = &startDate
+	}
+	if req.GetEndDate() != nil {
+		endDate := req.GetEndDate().AsTime()
+		search.EndDate = &endDate
+	}
+
+	customers, totalCount, totalPage, nextCursor, message, err := h.ListCustomerService.Execute(search, int(req.GetPagination().GetPage()), int(req.GetPagination().GetPageSize()), req.GetPagination().GetCursor(), req.GetPagination().GetIncludeTotal(), req.GetSortOrder(), req.GetMetadata().GetRequestId())
 	if err != nil {
 		logging.Logger.Warn().Err(err).Msg("list customer failed")
 		return &protoacc.ListCustomersResponse{
@@ -51,24 +69,7 @@ func (h *AccountHandlerService) ListCustomers(ctx context.Context, req *protoacc
 
 	protoCustomers := make([]*protoacc.Customer, len(customers))
 	for i, customer := range customers {
-		accounts := make([]*protoacc.Account, len(customer.Accounts))
-		for j, acc := range customer.Accounts {
-			accounts[j] = &protoacc.Account{
-				Id:           acc.ID,
-				CustomerId:   acc.CustomerID,
-				Balance:      acc.Balance,
-				ActiveStatus: acc.ActiveStatus,
-				CreatedAt:    timestamppb.New(acc.CreatedAt),
-			}
-		}
-
-		protoCustomers[i] = &protoacc.Customer{
-			Id:        customer.ID,
-			Name:      customer.Name,
-			Accounts:  accounts,
-			CreatedAt: timestamppb.New(customer.CreatedAt),
-			UpdatedAt: timestamppb.New(customer.UpdatedAt),
-		}
+		protoCustomers[i] = toProtoCustomer(customer)
 	}
 
 	return &protoacc.ListCustomersResponse{
@@ -78,6 +79,7 @@ func (h *AccountHandlerService) ListCustomers(ctx context.Context, req *protoacc
 			PageSize:   req.GetPagination().GetPageSize(),
 			TotalCount: int32(totalCount),
 			TotalPages: int32(totalPage),
+			NextCursor: nextCursor,
 		},
 		Response: &protoacc.Response{
 			Message: message,
@@ -105,3 +107,102 @@ func (h *AccountHandlerService) DeleteCustomer(ctx context.Context, req *protoac
 		},
 	}, nil
 }
+
+// GetCustomer returns the profile and accounts of a customer
+func (h *AccountHandlerService) GetCustomer(ctx context.Context, req *protoacc.GetCustomerRequest) (*protoacc.GetCustomerResponse, error) {
+	customer, message, err := h.GetCustomerService.Execute(req.GetCustomerId(), req.GetMetadata().GetRequester(), req.GetMetadata().GetRequestId())
+	if err != nil {
+		logging.Logger.Warn().Err(err).Str("customer_id", req.GetCustomerId()).Msg("get customer failed")
+		return &protoacc.GetCustomerResponse{
+			Response: &protoacc.Response{
+				Message: message,
+				Success: false,
+			},
+		}, nil
+	}
+
+	protoCustomer := toProtoCustomer(customer)
+	return &protoacc.GetCustomerResponse{
+		Customer: &protoacc.CustomerWithAccounts{
+			Customer: protoCustomer,
+			Accounts: protoCustomer.Accounts,
+		},
+		Response: &protoacc.Response{
+			Message: message,
+			Success: true,
+		},
+	}, nil
+}
+
+// UpdateCustomer changes the non-empty profile fields of a customer
+func (h *AccountHandlerService) UpdateCustomer(ctx context.Context, req *protoacc.UpdateCustomerRequest) (*protoacc.UpdateCustomerResponse, error) {
+	profile := entity.CustomerProfile{
+		Name:        req.GetName(),
+		DateOfBirth: req.GetDateOfBirth(),
+		NationalID:  req.GetNationalId(),
+		Address:     req.GetAddress(),
+		Phone:       req.GetPhone(),
+		Email:       req.GetEmail(),
+		KYCStatus:   req.GetKycStatus(),
+	}
+
+	customer, message, err := h.UpdateCustomerService.Execute(req.GetCustomerId(), profile, req.GetMetadata().GetRequester(), req.GetMetadata().GetRequestId())
+	if err != nil {
+		logging.Logger.Warn().Err(err).Str("customer_id", req.GetCustomerId()).Msg("update customer failed")
+		return &protoacc.UpdateCustomerResponse{
+			Response: &protoacc.Response{
+				Message: message,
+				Success: false,
+			},
+		}, nil
+	}
+
+	return &protoacc.UpdateCustomerResponse{
+		Customer: toProtoCustomer(customer),
+		Response: &protoacc.Response{
+			Message: message,
+			Success: true,
+		},
+	}, nil
+}
+
+// toProtoCustomer maps a customer and its accounts to the proto message
+func toProtoCustomer(customer *entity.Customer) *protoacc.Customer {
+	accounts := make([]*protoacc.Account, len(customer.Accounts))
+	for i, acc := range customer.Accounts {
+		accounts[i] = &protoacc.Account{
+			Id:             acc.ID,
+			CustomerId:     acc.CustomerID,
+			Balance:        acc.Balance.String(),
+			Currency:       acc.Currency,
+			AccountType:    acc.AccountType,
+			OverdraftLimit: acc.OverdraftLimit.String(),
+			Overdrawn:      acc.IsOverdrawn(),
+			ActiveStatus:   acc.ActiveStatus,
+			CreatedAt:      timestamppb.New(acc.CreatedAt),
+		}
+	}
+
+	protoCustomer := &protoacc.Customer{
+		Id:                 customer.ID,
+		Name:               customer.Name,
+		Accounts:           accounts,
+		ActiveStatus:       customer.ActiveStatus,
+		LockedForOperation: customer.LockedForOperation,
+		Version:            int32(customer.Version),
+		DateOfBirth:        customer.DateOfBirth,
+		NationalId:         customer.NationalID,
+		Address:            customer.Address,
+		Phone:              customer.Phone,
+		Email:              customer.Email,
+		KycStatus:          customer.KYCStatus,
+		CreatedBy:          customer.CreatedBy,
+		UpdatedBy:          customer.UpdatedBy,
+		CreatedAt:          timestamppb.New(customer.CreatedAt),
+		UpdatedAt:          timestamppb.New(customer.UpdatedAt),
+	}
+	if customer.KYCVerifiedAt != nil {
+		protoCustomer.KycVerifiedAt = timestamppb.New(*customer.KYCVerifiedAt)
+	}
+	return protoCustomer
+}
@@ -0,0 +1,150 @@
+package handlers
+
+import (
+	protoacc "account-service/api/protogen/accountservice/proto"
+	"account-service/internal/domain/entity"
+	"account-service/internal/domain/money"
+	"account-service/internal/logging"
+	"context"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"time"
+)
+
+func (h *AccountHandlerService) PlaceHold(ctx context.Context, req *protoacc.PlaceHoldRequest) (*protoacc.PlaceHoldResponse, error) {
+	amount, err := money.Parse(req.Amount)
+	if err != nil {
+		logging.Logger.Warn().Err(err).Str("account_id", req.AccountId).Str("amount", req.Amount).Msg("invalid hold amount")
+		return &protoacc.PlaceHoldResponse{
+			Response: &protoacc.Response{
+				Message: "Invalid hold amount",
+				Success: false,
+			},
+		}, nil
+	}
+
+	var expiresAt time.Time
+	if req.ExpiresAt != nil {
+		expiresAt = req.ExpiresAt.AsTime()
+	}
+
+	hold, message, err := h.PlaceHoldService.Execute(req.AccountId, amount, req.Reason, expiresAt, req.GetMetadata().GetRequester(), req.GetMetadata().GetRequestId())
+	if err != nil {
+		logging.Logger.Warn().Err(err).Str("account_id", req.AccountId).Msg("place hold failed")
+		return &protoacc.PlaceHoldResponse{
+			Response: &protoacc.Response{
+				Message: message,
+				Success: false,
+			},
+		}, nil
+	}
+
+	return &protoacc.PlaceHoldResponse{
+		Hold: toProtoHold(hold),
+		Response: &protoacc.Response{
+			Message: message,
+			Success: true,
+		},
+	}, nil
+}
+
+func (h *AccountHandlerService) GetHold(ctx context.Context, req *protoacc.GetHoldRequest) (*protoacc.GetHoldResponse, error) {
+	hold, message, err := h.GetHoldService.Execute(req.HoldId, req.GetMetadata().GetRequester(), req.GetMetadata().GetRequestId())
+	if err != nil {
+		logging.Logger.Warn().Err(err).Str("hold_id", req.HoldId).Msg("get hold failed")
+		return &protoacc.GetHoldResponse{
+			Response: &protoacc.Response{
+				Message: message,
+				Success: false,
+			},
+		}, nil
+	}
+
+	return &protoacc.GetHoldResponse{
+		Hold: toProtoHold(hold),
+		Response: &protoacc.Response{
+			Message: message,
+			Success: true,
+		},
+	}, nil
+}
+
+func (h *AccountHandlerService) ReleaseHold(ctx context.Context, req *protoacc.ReleaseHoldRequest) (*protoacc.ReleaseHoldResponse, error) {
+	hold, message, err := h.ReleaseHoldService.Execute(req.HoldId, req.GetMetadata().GetRequester(), req.GetMetadata().GetRequestId())
+	if err != nil {
+		logging.Logger.Warn().Err(err).Str("hold_id", req.HoldId).Msg("release hold failed")
+		return &protoacc.ReleaseHoldResponse{
+			Response: &protoacc.Response{
+				Message: message,
+				Success: false,
+			},
+		}, nil
+	}
+
+	return &protoacc.ReleaseHoldResponse{
+		Hold: toProtoHold(hold),
+		Response: &protoacc.Response{
+			Message: message,
+			Success: true,
+		},
+	}, nil
+}
+
+func (h *AccountHandlerService) ListAccountHolds(ctx context.Context, req *protoacc.ListAccountHoldsRequest) (*protoacc.ListAccountHoldsResponse, error) {
+	holds, totalCount, totalPages, message, err := h.ListAccountHoldsService.Execute(req.AccountId, int(req.GetPagination().GetPage()), int(req.GetPagination().GetPageSize()), req.GetMetadata().GetRequester(), req.GetMetadata().GetRequestId())
+	if err != nil {
+		logging.Logger.Warn().Err(err).Str("account_id", req.AccountId).Msg("list account holds failed")
+		return &protoacc.ListAccountHoldsResponse{
+			Holds: nil,
+			Pagination: &protoacc.PaginationResponse{
+				Page:       req.GetPagination().GetPage(),
+				PageSize:   req.GetPagination().GetPageSize(),
+				TotalCount: 0,
+			},
+			Response: &protoacc.Response{
+				Message: message,
+				Success: false,
+			},
+		}, nil
+	}
+
+	protoHolds := make([]*protoacc.Hold, len(holds))
+	for i, hold := range holds {
+		protoHolds[i] = toProtoHold(hold)
+	}
+
+	return &protoacc.ListAccountHoldsResponse{
+		Holds: protoHolds,
+		Pagination: &protoacc.PaginationResponse{
+			Page:       req.GetPagination().GetPage(),
+			PageSize:   req.GetPagination().GetPageSize(),
+			TotalCount: int32(totalCount),
+			TotalPages: int32(totalPages),
+		},
+		Response: &protoacc.Response{
+			Message: message,
+			Success: true,
+		},
+	}, nil
+}
+
+// toProtoHold maps a hold to its proto message
+func toProtoHold(hold *entity.Hold) *protoacc.Hold {
+	protoHold := &protoacc.Hold{
+		Id:             hold.ID,
+		AccountId:      hold.AccountID,
+		Amount:         hold.Amount.String(),
+		CapturedAmount: hold.CapturedAmount.String(),
+		Currency:       hold.Currency,
+		Reason:         hold.Reason,
+		Status:         hold.Status,
+		ExpiresAt:      timestamppb.New(hold.ExpiresAt),
+		CreatedBy:      hold.CreatedBy,
+		UpdatedBy:      hold.UpdatedBy,
+		CreatedAt:      timestamppb.New(hold.CreatedAt),
+		UpdatedAt:      timestamppb.New(hold.UpdatedAt),
+	}
+	if hold.CaptureTransactionID != nil {
+		protoHold.CaptureTransactionId = *hold.CaptureTransactionID
+	}
+	return protoHold
+}
@@ -0,0 +1,178 @@
+package handlers
+
+import (
+	protoacc "account-service/api/protogen/accountservice/proto"
+	"account-service/internal/domain/entity"
+	"account-service/internal/logging"
+	"context"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (h *AccountHandlerService) SetInterestRate(ctx context.Context, req *protoacc.SetInterestRateRequest) (*protoacc.SetInterestRateResponse, error) {
+	rate, message, err := h.SetInterestRateService.Execute(req.Scope, req.Subject, req.AnnualRate, req.GetMetadata().GetRequester(), req.GetMetadata().GetRequestId())
+	if err != nil {
+		logging.Logger.Warn().Err(err).Str("scope", req.Scope).Str("subject", req.Subject).Msg("set interest rate failed")
+		return &protoacc.SetInterestRateResponse{
+			Response: &protoacc.Response{
+				Message: message,
+				Success: false,
+			},
+		}, nil
+	}
+
+	return &protoacc.SetInterestRateResponse{
+		InterestRate: toProtoInterestRate(rate),
+		Response: &protoacc.Response{
+			Message: message,
+			Success: true,
+		},
+	}, nil
+}
+
+func (h *AccountHandlerService) ListInterestRates(ctx context.Context, req *protoacc.ListInterestRatesRequest) (*protoacc.ListInterestRatesResponse, error) {
+	rates, message, err := h.ListInterestRatesService.Execute(req.GetMetadata().GetRequester(), req.GetMetadata().GetRequestId())
+	if err != nil {
+		logging.Logger.Warn().Err(err).Msg("list interest rates failed")
+		return &protoacc.ListInterestRatesResponse{
+			Response: &protoacc.Response{
+				Message: message,
+				Success: false,
+			},
+		}, nil
+	}
+
+	protoRates := make([]*protoacc.InterestRate, len(rates))
+	for i, rate := range rates {
+		protoRates[i] = toProtoInterestRate(rate)
+	}
+
+	return &protoacc.ListInterestRatesResponse{
+		InterestRates: protoRates,
+		Response: &protoacc.Response{
+			Message: message,
+			Success: true,
+		},
+	}, nil
+}
+
+func (h *AccountHandlerService) GetAccountInterest(ctx context.Context, req *protoacc.GetAccountInterestRequest) (*protoacc.GetAccountInterestResponse, error) {
+	interest, message, err := h.GetAccountInterestService.Execute(req.AccountId, int(req.GetPagination().GetPage()), int(req.GetPagination().GetPageSize()), req.GetMetadata().GetRequester(), req.GetMetadata().GetRequestId())
+	if err != nil {
+		logging.Logger.Warn().Err(err).Str("account_id", req.AccountId).Msg("get account interest failed")
+		return &protoacc.GetAccountInterestResponse{
+			Pagination: &protoacc.PaginationResponse{
+				Page:       req.GetPagination().GetPage(),
+				PageSize:   req.GetPagination().GetPageSize(),
+				TotalCount: 0,
+			},
+			Response: &protoacc.Response{
+				Message: message,
+				Success: false,
+			},
+		}, nil
+	}
+
+	protoPostings := make([]*protoacc.InterestPosting, len(interest.Postings))
+	for i, posting := range interest.Postings {
+		protoPostings[i] = toProtoInterestPosting(posting)
+	}
+
+	return &protoacc.GetAccountInterestResponse{
+		AnnualRate:      interest.AnnualRate.String(),
+		AccruedInterest: interest.AccruedInterest.String(),
+		Postings:        protoPostings,
+		Pagination: &protoacc.PaginationResponse{
+			Page:       req.GetPagination().GetPage(),
+			PageSize:   req.GetPagination().GetPageSize(),
+			TotalCount: int32(interest.TotalCount),
+			TotalPages: int32(interest.TotalPages),
+		},
+		Response: &protoacc.Response{
+			Message: message,
+			Success: true,
+		},
+	}, nil
+}
+
+func (h *AccountHandlerService) ListPendingInterestPostings(ctx context.Context, req *protoacc.ListPendingInterestPostingsRequest) (*protoacc.ListPendingInterestPostingsResponse, error) {
+	postings, message, err := h.ListPendingInterestPostingsService.Execute(int(req.Limit), req.GetMetadata().GetRequester(), req.GetMetadata().GetRequestId())
+	if err != nil {
+		logging.Logger.Warn().Err(err).Msg("list pending interest postings failed")
+		return &protoacc.ListPendingInterestPostingsResponse{
+			Response: &protoacc.Response{
+				Message: message,
+				Success: false,
+			},
+		}, nil
+	}
+
+	protoPostings := make([]*protoacc.InterestPosting, len(postings))
+	for i, posting := range postings {
+		protoPostings[i] = toProtoInterestPosting(posting)
+	}
+
+	return &protoacc.ListPendingInterestPostingsResponse{
+		Postings: protoPostings,
+		Response: &protoacc.Response{
+			Message: message,
+			Success: true,
+		},
+	}, nil
+}
+
+func (h *AccountHandlerService) SettleAccountInterest(ctx context.Context, req *protoacc.SettleAccountInterestRequest) (*protoacc.SettleAccountInterestResponse, error) {
+	postings, message, err := h.SettleAccountInterestService.Execute(req.AccountId, req.GetMetadata().GetRequester(), req.GetMetadata().GetRequestId())
+	if err != nil {
+		logging.Logger.Warn().Err(err).Str("account_id", req.AccountId).Msg("settle account interest failed")
+		return &protoacc.SettleAccountInterestResponse{
+			Response: &protoacc.Response{
+				Message: message,
+				Success: false,
+			},
+		}, nil
+	}
+
+	protoPostings := make([]*protoacc.InterestPosting, len(postings))
+	for i, posting := range postings {
+		protoPostings[i] = toProtoInterestPosting(posting)
+	}
+
+	return &protoacc.SettleAccountInterestResponse{
+		Postings: protoPostings,
+		Response: &protoacc.Response{
+			Message: message,
+			Success: true,
+		},
+	}, nil
+}
+
+// toProtoInterestRate maps an interest rate to its proto message
+func toProtoInterestRate(rate *entity.InterestRate) *protoacc.InterestRate {
+	return &protoacc.InterestRate{
+		Scope:      rate.Scope,
+		Subject:    rate.Subject,
+		AnnualRate: rate.AnnualRate.String(),
+		CreatedBy:  rate.CreatedBy,
+		UpdatedBy:  rate.UpdatedBy,
+		CreatedAt:  timestamppb.New(rate.CreatedAt),
+		UpdatedAt:  timestamppb.New(rate.UpdatedAt),
+	}
+}
+
+// toProtoInterestPosting maps an interest posting to its proto message
+func toProtoInterestPosting(posting *entity.InterestPosting) *protoacc.InterestPosting {
+	protoPosting := &protoacc.InterestPosting{
+		Id:        posting.ID,
+		AccountId: posting.AccountID,
+		Period:    posting.Period,
+		Amount:    posting.Amount.String(),
+		Currency:  posting.Currency,
+		Status:    posting.Status,
+		CreatedAt: timestamppb.New(posting.CreatedAt),
+		UpdatedAt: timestamppb.New(posting.UpdatedAt),
+	}
+	if posting.TransactionID != nil {
+		protoPosting.TransactionId = *posting.TransactionID
+	}
+	return protoPosting
+}
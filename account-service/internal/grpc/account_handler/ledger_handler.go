@@ -0,0 +1,83 @@
+package handlers
+
+import (
+	protoacc "account-service/api/protogen/accountservice/proto"
+	"account-service/internal/logging"
+	"context"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (h *AccountHandlerService) GetAccountJournal(ctx context.Context, req *protoacc.GetAccountJournalRequest) (*protoacc.GetAccountJournalResponse, error) {
+	entries, totalCount, totalPages, message, err := h.GetAccountJournalService.Execute(req.AccountId, int(req.GetPagination().GetPage()), int(req.GetPagination().GetPageSize()), req.GetMetadata().GetRequester(), req.GetMetadata().GetRequestId())
+	if err != nil {
+		logging.Logger.Warn().Err(err).Str("account_id", req.AccountId).Msg("get account journal failed")
+		return &protoacc.GetAccountJournalResponse{
+			Entries: nil,
+			Pagination: &protoacc.PaginationResponse{
+				Page:       req.GetPagination().GetPage(),
+				PageSize:   req.GetPagination().GetPageSize(),
+				TotalCount: 0,
+			},
+			Response: &protoacc.Response{
+				Message: message,
+				Success: false,
+			},
+		}, nil
+	}
+
+	protoEntries := make([]*protoacc.LedgerEntry, len(entries))
+	for i, entry := range entries {
+		protoEntries[i] = &protoacc.LedgerEntry{
+			Id:          entry.ID,
+			JournalId:   entry.JournalID,
+			JournalType: entry.JournalType,
+			Reference:   entry.Reference,
+			AccountId:   entry.AccountID,
+			Direction:   entry.Direction,
+			Amount:      entry.Amount.String(),
+			Currency:    entry.Currency,
+			CreatedBy:   entry.CreatedBy,
+			CreatedAt:   timestamppb.New(entry.CreatedAt),
+		}
+	}
+
+	return &protoacc.GetAccountJournalResponse{
+		Entries: protoEntries,
+		Pagination: &protoacc.PaginationResponse{
+			Page:       req.GetPagination().GetPage(),
+			PageSize:   req.GetPagination().GetPageSize(),
+			TotalCount: int32(totalCount),
+			TotalPages: int32(totalPages),
+		},
+		Response: &protoacc.Response{
+			Message: message,
+			Success: true,
+		},
+	}, nil
+}
+
+func (h *AccountHandlerService) RecomputeAccountBalance(ctx context.Context, req *protoacc.RecomputeAccountBalanceRequest) (*protoacc.RecomputeAccountBalanceResponse, error) {
+	result, message, err := h.RecomputeAccountBalanceService.Execute(req.AccountId, req.GetMetadata().GetRequester(), req.GetMetadata().GetRequestId())
+	if err != nil {
+		logging.Logger.Warn().Err(err).Str("account_id", req.AccountId).Msg("recompute account balance failed")
+		return &protoacc.RecomputeAccountBalanceResponse{
+			AccountId: req.AccountId,
+			Response: &protoacc.Response{
+				Message: message,
+				Success: false,
+			},
+		}, nil
+	}
+
+	return &protoacc.RecomputeAccountBalanceResponse{
+		AccountId:     result.AccountID,
+		Currency:      result.Currency,
+		Balance:       result.Balance.String(),
+		LedgerBalance: result.LedgerBalance.String(),
+		Consistent:    result.IsConsistent(),
+		Response: &protoacc.Response{
+			Message: message,
+			Success: true,
+		},
+	}, nil
+}
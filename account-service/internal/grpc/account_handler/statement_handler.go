@@ -0,0 +1,121 @@
+package handlers
+
+import (
+	protoacc "account-service/api/protogen/accountservice/proto"
+	"account-service/internal/domain/entity"
+	"account-service/internal/logging"
+	"context"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (h *AccountHandlerService) GenerateAccountStatement(ctx context.Context, req *protoacc.GenerateAccountStatementRequest) (*protoacc.GenerateAccountStatementResponse, error) {
+	statement, message, err := h.GenerateAccountStatementService.Execute(req.AccountId, req.From, req.To, req.GetMetadata().GetRequester(), req.GetMetadata().GetRequestId())
+	if err != nil {
+		logging.Logger.Warn().Err(err).Str("account_id", req.AccountId).Msg("generate account statement failed")
+		return &protoacc.GenerateAccountStatementResponse{
+			Response: &protoacc.Response{
+				Message: message,
+				Success: false,
+			},
+		}, nil
+	}
+
+	return &protoacc.GenerateAccountStatementResponse{
+		Statement: toProtoStatement(statement),
+		Response: &protoacc.Response{
+			Message: message,
+			Success: true,
+		},
+	}, nil
+}
+
+func (h *AccountHandlerService) ListAccountStatements(ctx context.Context, req *protoacc.ListAccountStatementsRequest) (*protoacc.ListAccountStatementsResponse, error) {
+	statements, totalCount, totalPages, message, err := h.ListAccountStatementsService.Execute(req.AccountId, int(req.GetPagination().GetPage()), int(req.GetPagination().GetPageSize()), req.GetMetadata().GetRequester(), req.GetMetadata().GetRequestId())
+	if err != nil {
+		logging.Logger.Warn().Err(err).Str("account_id", req.AccountId).Msg("list account statements failed")
+		return &protoacc.ListAccountStatementsResponse{
+			Pagination: &protoacc.PaginationResponse{
+				Page:       req.GetPagination().GetPage(),
+				PageSize:   req.GetPagination().GetPageSize(),
+				TotalCount: 0,
+			},
+			Response: &protoacc.Response{
+				Message: message,
+				Success: false,
+			},
+		}, nil
+	}
+
+	protoStatements := make([]*protoacc.Statement, len(statements))
+	for i, statement := range statements {
+		protoStatements[i] = toProtoStatement(statement)
+	}
+
+	return &protoacc.ListAccountStatementsResponse{
+		Statements: protoStatements,
+		Pagination: &protoacc.PaginationResponse{
+			Page:       req.GetPagination().GetPage(),
+			PageSize:   req.GetPagination().GetPageSize(),
+			TotalCount: int32(totalCount),
+			TotalPages: int32(totalPages),
+		},
+		Response: &protoacc.Response{
+			Message: message,
+			Success: true,
+		},
+	}, nil
+}
+
+func (h *AccountHandlerService) GetStatement(ctx context.Context, req *protoacc.GetStatementRequest) (*protoacc.GetStatementResponse, error) {
+	statement, message, err := h.GetStatementService.Execute(req.StatementId, req.GetMetadata().GetRequester(), req.GetMetadata().GetRequestId())
+	if err != nil {
+		logging.Logger.Warn().Err(err).Str("statement_id", req.StatementId).Msg("get statement failed")
+		return &protoacc.GetStatementResponse{
+			Response: &protoacc.Response{
+				Message: message,
+				Success: false,
+			},
+		}, nil
+	}
+
+	return &protoacc.GetStatementResponse{
+		Statement: toProtoStatement(statement),
+		Response: &protoacc.Response{
+			Message: message,
+			Success: true,
+		},
+	}, nil
+}
+
+// toProtoStatement maps a statement and its loaded lines to its proto message
+func toProtoStatement(statement *entity.Statement) *protoacc.Statement {
+	protoStatement := &protoacc.Statement{
+		Id:             statement.ID,
+		AccountId:      statement.AccountID,
+		CustomerId:     statement.CustomerID,
+		PeriodStart:    statement.PeriodStart,
+		PeriodEnd:      statement.PeriodEnd,
+		Currency:       statement.Currency,
+		OpeningBalance: statement.OpeningBalance.String(),
+		ClosingBalance: statement.ClosingBalance.String(),
+		TotalCredits:   statement.TotalCredits.String(),
+		TotalDebits:    statement.TotalDebits.String(),
+		LineCount:      int32(statement.LineCount),
+		Lines:          make([]*protoacc.StatementLine, len(statement.Lines)),
+	}
+	if !statement.CreatedAt.IsZero() {
+		protoStatement.CreatedAt = timestamppb.New(statement.CreatedAt)
+	}
+	for i, line := range statement.Lines {
+		protoStatement.Lines[i] = &protoacc.StatementLine{
+			Position:    int32(line.Position),
+			PostedAt:    timestamppb.New(line.PostedAt),
+			JournalType: line.JournalType,
+			Reference:   line.Reference,
+			Direction:   line.Direction,
+			Amount:      line.Amount.String(),
+			Balance:     line.Balance.String(),
+		}
+	}
+	return protoStatement
+}
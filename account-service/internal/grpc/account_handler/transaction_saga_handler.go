@@ -2,6 +2,7 @@ package handlers
 
 import (
 	protoacc "account-service/api/protogen/accountservice/proto"
+	"account-service/internal/domain/money"
 	"account-service/internal/grpc/types"
 	"context"
 	"google.golang.org/protobuf/types/known/timestamppb"
@@ -29,12 +30,19 @@ func (h *AccountHandlerService) ValidateAccounts(ctx context.Context, req *proto
 	protoAccounts := make([]*protoacc.Account, len(accounts))
 	for i, account := range accounts {
 		protoAccounts[i] = &protoacc.Account{
-			Id:           account.ID,
-			CustomerId:   account.CustomerID,
-			Balance:      account.Balance,
-			Version:      int32(account.Version),
-			ActiveStatus: account.ActiveStatus,
-			CreatedAt:    timestamppb.New(account.CreatedAt),
+			Id:               account.ID,
+			CustomerId:       account.CustomerID,
+			Balance:          account.Balance.String(),
+			Currency:         account.Currency,
+			AccountType:      account.AccountType,
+			OverdraftLimit:   account.OverdraftLimit.String(),
+			Overdrawn:        account.IsOverdrawn(),
+			HeldAmount:       account.HeldAmount.String(),
+			AvailableBalance: account.AvailableBalance().String(),
+			Version:          int32(account.Version),
+			ActiveStatus:     account.ActiveStatus,
+			FreezeMode:       account.FreezeMode,
+			CreatedAt:        timestamppb.New(account.CreatedAt),
 		}
 	}
 
@@ -120,15 +128,45 @@ func (h *AccountHandlerService) UpdateAccountsBalance(ctx context.Context, req *
 
 	var accountUpdateBalanceDetails []types.AccountBalance
 	for _, update := range req.Updates {
+		newBalance, err := money.Parse(update.NewBalance)
+		if err != nil {
+			return &protoacc.UpdateAccountsBalanceResponse{
+				Success: false,
+				Message: "invalid balance for account " + update.AccountId,
+				Response: &protoacc.Response{
+					Message: "invalid balance for account " + update.AccountId,
+					Success: false,
+				},
+			}, nil
+		}
+		var holdCaptureAmount money.Amount
+		if update.HoldId != "" {
+			holdCaptureAmount, err = money.Parse(update.HoldCaptureAmount)
+			if err != nil {
+				return &protoacc.UpdateAccountsBalanceResponse{
+					Success: false,
+					Message: "invalid hold capture amount for account " + update.AccountId,
+					Response: &protoacc.Response{
+						Message: "invalid hold capture amount for account " + update.AccountId,
+						Success: false,
+					},
+				}, nil
+			}
+		}
 		accountUpdateBalanceDetails = append(accountUpdateBalanceDetails, types.AccountBalance{
-			AccountID: update.AccountId,
-			Balance:   update.NewBalance,
-			Version:   int(update.Version),
+			AccountID:         update.AccountId,
+			Balance:           newBalance,
+			Version:           int(update.Version),
+			HoldID:            update.HoldId,
+			HoldCaptureAmount: holdCaptureAmount,
+			InterestPostingID: update.InterestPostingId,
 		})
 	}
 
 	accounts, message, err := h.UpdateAccountBalanceForTransaction.Execute(
 		accountUpdateBalanceDetails,
+		req.TransactionId,
+		req.Compensation,
 		req.GetMetadata().Requester,
 	)
 
@@ -163,3 +201,39 @@ func (h *AccountHandlerService) UpdateAccountsBalance(ctx context.Context, req *
 		},
 	}, nil
 }
+
+func (h *AccountHandlerService) GetTransactionJournalStatus(ctx context.Context, req *protoacc.GetTransactionJournalStatusRequest) (*protoacc.GetTransactionJournalStatusResponse, error) {
+	status, message, err := h.GetTransactionJournalStatusService.Execute(
+		req.TransactionId,
+		req.GetMetadata().GetRequester(),
+		req.GetMetadata().GetRequestId(),
+	)
+
+	if err != nil {
+		return &protoacc.GetTransactionJournalStatusResponse{
+			Response: &protoacc.Response{
+				Message: message,
+				Success: false,
+			},
+		}, nil
+	}
+
+	changes := make([]*protoacc.AccountBalanceChange, len(status.Changes))
+	for i, change := range status.Changes {
+		changes[i] = &protoacc.AccountBalanceChange{
+			AccountId: change.AccountID,
+			Currency:  change.Currency,
+			Delta:     change.Delta.String(),
+		}
+	}
+
+	return &protoacc.GetTransactionJournalStatusResponse{
+		BalanceApplied: status.BalanceApplied,
+		Compensated:    status.Compensated,
+		Changes:        changes,
+		Response: &protoacc.Response{
+			Message: message,
+			Success: true,
+		},
+	}, nil
+}
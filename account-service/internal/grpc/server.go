@@ -4,6 +4,10 @@ import (
 	protoacc "account-service/api/protogen/accountservice/proto"
 	appaccount "account-service/internal/app/account"
 	appcustomer "account-service/internal/app/customer"
+	apphold "account-service/internal/app/hold"
+	appinterest "account-service/internal/app/interest"
+	appledger "account-service/internal/app/ledger"
+	appstatement "account-service/internal/app/statement"
 	apptxsaga "account-service/internal/app/transaction_saga"
 	"account-service/internal/config"
 	handlers "account-service/internal/grpc/account_handler"
@@ -19,9 +23,13 @@ import (
 )
 
 type ServiceRepos struct {
-	CustomerRepo ports.CustomerRepo
-	AccountRepo  ports.AccountRepo
-	EventRepo    ports.EventRepo
+	CustomerRepo  ports.CustomerRepo
+	AccountRepo   ports.AccountRepo
+	EventRepo     ports.EventRepo
+	LedgerRepo    ports.LedgerRepo
+	HoldRepo      ports.HoldRepo
+	InterestRepo  ports.InterestRepo
+	StatementRepo ports.StatementRepo
 }
 
 func StartGRPCServer(ctx context.Context, repos ServiceRepos) {
@@ -75,14 +83,34 @@ func generateAggregatedHandlers(repos ServiceRepos) *handlers.AccountHandlerServ
 	accountAggregatedHandler := handlers.NewAggregatedHandler()
 	accountAggregatedHandler.CreateCustomerService = appcustomer.NewCreateCustomer(repos.CustomerRepo, repos.EventRepo)
 	accountAggregatedHandler.ListCustomerService = appcustomer.NewListCustomer(repos.CustomerRepo)
+	accountAggregatedHandler.GetCustomerService = appcustomer.NewGetCustomer(repos.CustomerRepo)
+	accountAggregatedHandler.UpdateCustomerService = appcustomer.NewUpdateCustomer(repos.CustomerRepo, repos.EventRepo)
 	accountAggregatedHandler.DeleteCustomerService = appcustomer.NewDeleteCustomer(repos.CustomerRepo, repos.EventRepo)
 	accountAggregatedHandler.CreateAccountService = appaccount.NewCreateAccount(repos.AccountRepo, repos.CustomerRepo, repos.EventRepo)
 	accountAggregatedHandler.DeleteAccountService = appaccount.NewDeleteAccount(repos.AccountRepo, repos.CustomerRepo, repos.EventRepo)
-	accountAggregatedHandler.GetAccountBalanceService = appaccount.NewGetAccountBalance(repos.AccountRepo)
+	accountAggregatedHandler.GetAccountBalanceService = appaccount.NewGetAccountBalance(repos.AccountRepo, repos.HoldRepo)
 	accountAggregatedHandler.ListAccountService = appaccount.NewListAccount(repos.AccountRepo)
-	accountAggregatedHandler.ValidateAccountForTransactionService = apptxsaga.NewValidateAccountForTransaction(repos.AccountRepo)
+	accountAggregatedHandler.SetOverdraftLimitService = appaccount.NewSetOverdraftLimit(repos.AccountRepo, repos.EventRepo)
+	accountAggregatedHandler.UpdateAccountStatusService = appaccount.NewUpdateAccountStatus(repos.AccountRepo, repos.EventRepo)
+	accountAggregatedHandler.CloseAccountService = appaccount.NewCloseAccount(repos.AccountRepo, repos.HoldRepo, repos.InterestRepo, repos.EventRepo)
+	accountAggregatedHandler.ValidateAccountForTransactionService = apptxsaga.NewValidateAccountForTransaction(repos.AccountRepo, repos.HoldRepo)
 	accountAggregatedHandler.LockAccountForTransaction = apptxsaga.NewLockAccountForTransaction(repos.AccountRepo)
 	accountAggregatedHandler.UnlockAccountsForTransaction = apptxsaga.NewUnlockAccountsForTransaction(repos.AccountRepo)
-	accountAggregatedHandler.UpdateAccountBalanceForTransaction = apptxsaga.NewUpdateAccountBalanceForTransaction(repos.AccountRepo)
+	accountAggregatedHandler.UpdateAccountBalanceForTransaction = apptxsaga.NewUpdateAccountBalanceForTransaction(repos.AccountRepo, repos.HoldRepo, repos.EventRepo)
+	accountAggregatedHandler.GetTransactionJournalStatusService = apptxsaga.NewGetTransactionJournalStatus(repos.LedgerRepo)
+	accountAggregatedHandler.GetAccountJournalService = appledger.NewGetAccountJournal(repos.AccountRepo, repos.LedgerRepo)
+	accountAggregatedHandler.RecomputeAccountBalanceService = appledger.NewRecomputeAccountBalance(repos.AccountRepo, repos.LedgerRepo)
+	accountAggregatedHandler.PlaceHoldService = apphold.NewPlaceHold(repos.AccountRepo, repos.HoldRepo, repos.EventRepo)
+	accountAggregatedHandler.GetHoldService = apphold.NewGetHold(repos.HoldRepo)
+	accountAggregatedHandler.ReleaseHoldService = apphold.NewReleaseHold(repos.HoldRepo, repos.EventRepo)
+	accountAggregatedHandler.ListAccountHoldsService = apphold.NewListAccountHolds(repos.AccountRepo, repos.HoldRepo)
+	accountAggregatedHandler.SetInterestRateService = appinterest.NewSetInterestRate(repos.AccountRepo, repos.InterestRepo, repos.EventRepo)
+	accountAggregatedHandler.ListInterestRatesService = appinterest.NewListInterestRates(repos.InterestRepo)
+	accountAggregatedHandler.GetAccountInterestService = appinterest.NewGetAccountInterest(repos.AccountRepo, repos.InterestRepo)
+	accountAggregatedHandler.ListPendingInterestPostingsService = appinterest.NewListPendingInterestPostings(repos.InterestRepo)
+	accountAggregatedHandler.SettleAccountInterestService = appinterest.NewSettleAccountInterest(repos.AccountRepo, repos.InterestRepo)
+	accountAggregatedHandler.GenerateAccountStatementService = appstatement.NewGenerateAccountStatement(repos.AccountRepo, repos.LedgerRepo)
+	accountAggregatedHandler.ListAccountStatementsService = appstatement.NewListAccountStatements(repos.AccountRepo, repos.StatementRepo)
+	accountAggregatedHandler.GetStatementService = appstatement.NewGetStatement(repos.StatementRepo)
 	return accountAggregatedHandler
 }
@@ -1,9 +1,14 @@
 package types
 
+import "account-service/internal/domain/money"
+
 type AccountBalance struct {
-	AccountID string
-	Balance   float64
-	Version   int
+	AccountID         string
+	Balance           money.Amount
+	Version           int
+	HoldID            string       // hold captured by the update, or released again by its compensation
+	HoldCaptureAmount money.Amount // amount of the hold the update captures
+	InterestPostingID string       // interest posting credited by the update, or made pending again by its compensation
 }
 
 type AccountBalanceResponse struct {
@@ -0,0 +1,16 @@
+package types
+
+import "account-service/internal/domain/money"
+
+// LedgerBalance compares the stored balance of an account with the balance recomputed from its journal
+type LedgerBalance struct {
+	AccountID     string
+	Currency      string
+	Balance       money.Amount
+	LedgerBalance money.Amount
+}
+
+// IsConsistent reports whether the stored balance matches the journal
+func (b LedgerBalance) IsConsistent() bool {
+	return b.Balance == b.LedgerBalance
+}
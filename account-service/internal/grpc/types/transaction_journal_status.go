@@ -0,0 +1,11 @@
+package types
+
+import "account-service/internal/domain/entity"
+
+// TransactionJournalStatus tells which balance journals have been posted for a transaction
+type TransactionJournalStatus struct {
+	TransactionID  string
+	BalanceApplied bool
+	Compensated    bool
+	Changes        []entity.BalanceChange // net change per account made by the transaction journal
+}
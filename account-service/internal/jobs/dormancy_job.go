@@ -0,0 +1,62 @@
+package jobs
+
+import (
+	"account-service/internal/config"
+	"account-service/internal/logging"
+	"context"
+	"fmt"
+	"time"
+)
+
+// DormancyMarker marks the accounts dormant that went without customer transactions for the given months
+type DormancyMarker interface {
+	Execute(now time.Time, inactiveMonths int) (int, string, error)
+}
+
+type DormancyJob struct {
+	marker DormancyMarker
+}
+
+func NewDormancyJob(marker DormancyMarker) *DormancyJob {
+	return &DormancyJob{
+		marker: marker,
+	}
+}
+
+func (j *DormancyJob) Start(ctx context.Context) {
+	if err := j.RunDormancy(time.Now()); err != nil {
+		logging.Logger.Warn().Err(err).Str("job_type", "dormancy").Msg("Marking dormant accounts failed")
+	}
+
+	ticker := time.NewTicker(config.Current().Dormancy.Interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			if err := j.RunDormancy(time.Now()); err != nil {
+				logging.Logger.Warn().Err(err).Str("job_type", "dormancy").Msg("Marking dormant accounts failed")
+			}
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// RunDormancy marks the accounts dormant whose last customer transaction lies more than the configured number
+// of months before now. Accounts are checked again on every run, so a missed run is caught up by the next one.
+func (j *DormancyJob) RunDormancy(now time.Time) error {
+	inactiveMonths := config.Current().Dormancy.InactiveMonths
+	marked, message, err := j.marker.Execute(now, inactiveMonths)
+	if err != nil {
+		return fmt.Errorf("failed to mark dormant accounts: %s: %w", message, err)
+	}
+
+	logging.Logger.Info().
+		Int("inactive_months", inactiveMonths).
+		Int("account_count", marked).
+		Str("message", message).
+		Str("job_type", "dormancy").
+		Msg("Dormant accounts marked")
+	return nil
+}
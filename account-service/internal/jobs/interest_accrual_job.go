@@ -0,0 +1,87 @@
+package jobs
+
+import (
+	"account-service/internal/config"
+	"account-service/internal/domain/entity"
+	"account-service/internal/logging"
+	"account-service/internal/ports"
+	"context"
+	"fmt"
+	"time"
+)
+
+// InterestAccruer accrues the interest of one day
+type InterestAccruer interface {
+	Execute(day time.Time) (int, string, error)
+}
+
+type InterestAccrualJob struct {
+	interestRepo ports.InterestRepo
+	accruer      InterestAccruer
+}
+
+func NewInterestAccrualJob(interestRepo ports.InterestRepo, accruer InterestAccruer) *InterestAccrualJob {
+	return &InterestAccrualJob{
+		interestRepo: interestRepo,
+		accruer:      accruer,
+	}
+}
+
+func (j *InterestAccrualJob) Start(ctx context.Context) {
+	if err := j.RunDueAccruals(ctx, time.Now()); err != nil {
+		logging.Logger.Warn().Err(err).Str("job_type", "interest_accrual").Msg("Interest accrual failed")
+	}
+
+	ticker := time.NewTicker(config.Current().Interest.Interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			if err := j.RunDueAccruals(ctx, time.Now()); err != nil {
+				logging.Logger.Warn().Err(err).Str("job_type", "interest_accrual").Msg("Interest accrual failed")
+			}
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// RunDueAccruals accrues every completed UTC day after the last accrued one, oldest first. Interest
+// starts accruing with the day before the first run, and days missed while the service was down are caught up.
+func (j *InterestAccrualJob) RunDueAccruals(ctx context.Context, now time.Time) error {
+	now = now.UTC()
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+
+	day := today.AddDate(0, 0, -1)
+	lastRun, err := j.interestRepo.GetLastAccrualRun()
+	if err != nil {
+		return fmt.Errorf("failed to get last interest accrual run: %w", err)
+	}
+	if lastRun != nil {
+		lastDay, err := time.Parse(entity.InterestAccrualDateLayout, lastRun.AccrualDate)
+		if err != nil {
+			return fmt.Errorf("invalid last interest accrual date %q: %w", lastRun.AccrualDate, err)
+		}
+		day = lastDay.AddDate(0, 0, 1)
+	}
+
+	for ; day.Before(today); day = day.AddDate(0, 0, 1) {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+
+		accrued, message, err := j.accruer.Execute(day)
+		if err != nil {
+			return fmt.Errorf("failed to accrue interest for %s: %s: %w", day.Format(entity.InterestAccrualDateLayout), message, err)
+		}
+
+		logging.Logger.Info().
+			Str("accrual_date", day.Format(entity.InterestAccrualDateLayout)).
+			Int("account_count", accrued).
+			Str("message", message).
+			Str("job_type", "interest_accrual").
+			Msg("Interest accrued")
+	}
+	return nil
+}
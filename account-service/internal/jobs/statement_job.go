@@ -0,0 +1,86 @@
+package jobs
+
+import (
+	"account-service/internal/config"
+	"account-service/internal/domain/entity"
+	"account-service/internal/logging"
+	"account-service/internal/ports"
+	"context"
+	"fmt"
+	"time"
+)
+
+// StatementIssuer issues the statements of one month
+type StatementIssuer interface {
+	Execute(month time.Time) (int, string, error)
+}
+
+type StatementJob struct {
+	statementRepo ports.StatementRepo
+	issuer        StatementIssuer
+}
+
+func NewStatementJob(statementRepo ports.StatementRepo, issuer StatementIssuer) *StatementJob {
+	return &StatementJob{
+		statementRepo: statementRepo,
+		issuer:        issuer,
+	}
+}
+
+func (j *StatementJob) Start(ctx context.Context) {
+	if err := j.RunDueStatements(ctx, time.Now()); err != nil {
+		logging.Logger.Warn().Err(err).Str("job_type", "statement").Msg("Issuing statements failed")
+	}
+
+	ticker := time.NewTicker(config.Current().Statement.Interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			if err := j.RunDueStatements(ctx, time.Now()); err != nil {
+				logging.Logger.Warn().Err(err).Str("job_type", "statement").Msg("Issuing statements failed")
+			}
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// RunDueStatements issues the statements of every completed UTC month after the last issued one, oldest first.
+// Statements start with the month before the first run, and months missed while the service was down are caught up.
+func (j *StatementJob) RunDueStatements(ctx context.Context, now time.Time) error {
+	currentMonth, _ := entity.MonthPeriod(now)
+
+	month := currentMonth.AddDate(0, -1, 0)
+	lastRun, err := j.statementRepo.GetLastStatementRun()
+	if err != nil {
+		return fmt.Errorf("failed to get last statement run: %w", err)
+	}
+	if lastRun != nil {
+		lastMonth, err := time.Parse(entity.StatementPeriodLayout, lastRun.Period)
+		if err != nil {
+			return fmt.Errorf("invalid last statement period %q: %w", lastRun.Period, err)
+		}
+		month = lastMonth.AddDate(0, 1, 0)
+	}
+
+	for ; month.Before(currentMonth); month = month.AddDate(0, 1, 0) {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+
+		issued, message, err := j.issuer.Execute(month)
+		if err != nil {
+			return fmt.Errorf("failed to issue statements for %s: %s: %w", month.Format(entity.StatementPeriodLayout), message, err)
+		}
+
+		logging.Logger.Info().
+			Str("period", month.Format(entity.StatementPeriodLayout)).
+			Int("statement_count", issued).
+			Str("message", message).
+			Str("job_type", "statement").
+			Msg("Statements issued")
+	}
+	return nil
+}
@@ -20,7 +20,18 @@ const (
 
 	MessageTypeCreateAccount  = "CreateAccount"
 	MessageTypeDeleteAccount  = "DeleteAccount"
+	MessageTypeSetOverdraft   = "SetOverdraftLimit"
+	MessageTypeOverdrawn      = "AccountOverdrawn"
+	MessageTypeAccountStatus  = "UpdateAccountStatus"
+	MessageTypeAccountDormant = "AccountDormant"
+	MessageTypeCloseAccount   = "CloseAccount"
+	MessageTypePlaceHold      = "PlaceHold"
+	MessageTypeCaptureHold    = "CaptureHold"
+	MessageTypeReleaseHold    = "ReleaseHold"
+	MessageTypeSetInterest    = "SetInterestRate"
+	MessageTypeCloseInterest  = "CloseInterestPeriod"
 	MessageTypeCreateCustomer = "CreateCustomer"
+	MessageTypeUpdateCustomer = "UpdateCustomer"
 	MessageTypeDeleteCustomer = "DeleteCustomer"
 )
 
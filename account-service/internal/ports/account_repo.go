@@ -2,7 +2,9 @@ package ports
 
 import (
 	"account-service/internal/domain/entity"
+	"account-service/internal/domain/money"
 	"account-service/internal/grpc/types"
+	"time"
 )
 
 type AccountRepo interface {
@@ -10,8 +12,9 @@ type AccountRepo interface {
 	GetAccountByID(id string) (*entity.Account, error)
 	GetAccountByIDForUpdate(id string) (*entity.Account, error)
 	GetAccountByCustomerID(customerID string) ([]*entity.Account, error)
+	GetDormancyCandidates(inactiveSince time.Time) ([]*entity.Account, error)
 	UpdateAccount(account *entity.Account) error
-	UpdateAccountBalance(id string, newBalance float64, currentVersion int, requester string) error
+	UpdateAccountBalance(id string, newBalance money.Amount, currentVersion int, requester string) error
 	LockAccountForTransaction(id string, transactionID string) error
 	UnlockAccountFromTransaction(id string) error
 	CheckTransactionLock(id string) error
@@ -21,9 +24,9 @@ type AccountRepo interface {
 	GetCustomerAccountsInTransaction(customerID string) ([]*entity.Account, error)
 	GetCustomerAccountsInTransactionOrHasBalance(customerID string) ([]*entity.Account, error)
 	DeleteAccount(id, requester string) error
-	GetAccountsByFiltersWithPagination(filters map[string]interface{}, page, pageSize int, setOrder string) ([]*entity.Account, int64, error)
+	GetAccountsByFiltersWithPagination(filters map[string]interface{}, page, pageSize int, after *entity.PageCursor, withTotal bool, setOrder string) ([]*entity.Account, int64, error)
 	DeleteAllAccountsByCustomerID(customerID, requester string) error
 	LockAccountsForTransaction(transactionID string, accountIDs []string) error
 	UnlockAccountsForTransaction(transactionID string) error
-	UpdateAccountBalanceLifecycle(balanceUpdates []types.AccountBalance, requester string) ([]types.AccountBalanceResponse, error)
+	UpdateAccountBalanceLifecycle(balanceUpdates []types.AccountBalance, journalType, transactionID, requester string) ([]types.AccountBalanceResponse, error)
 }
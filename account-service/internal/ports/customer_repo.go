@@ -1,17 +1,33 @@
 package ports
 
-import "account-service/internal/domain/entity"
+import (
+	"account-service/internal/domain/entity"
+	"time"
+)
 
 type CustomerDTO struct {
 	CustomerName string
 	Requester    string
 }
 
+// CustomerSearchDTO holds the optional filters of a customer listing, empty fields are not filtered on
+type CustomerSearchDTO struct {
+	Name         string
+	CreatedBy    string
+	StartDate    *time.Time
+	EndDate      *time.Time
+	ActiveStatus string
+	MinBalance   string
+	AccountID    string
+}
+
 type CustomerRepo interface {
 	CreateCustomer(customer *entity.Customer) (*entity.Customer, error)
 	GetCustomerByName(name string) (*entity.Customer, error)
 	GetCustomerByID(id string) (*entity.Customer, error)
-	ListCustomer(page, pageSize int, setOrder string) ([]*entity.Customer, int64, error)
+	GetCustomerByNationalID(nationalID string) (*entity.Customer, error)
+	UpdateCustomer(customer *entity.Customer) error
+	ListCustomer(filters map[string]interface{}, page, pageSize int, after *entity.PageCursor, withTotal bool, setOrder string) ([]*entity.Customer, int64, error)
 	DeleteCustomerByID(id, requester string) error
 	CheckModificationAllowed(id string) error
 	Exists(id string) (bool, error)
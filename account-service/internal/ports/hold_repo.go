@@ -0,0 +1,14 @@
+package ports
+
+import (
+	"account-service/internal/domain/entity"
+	"account-service/internal/domain/money"
+)
+
+type HoldRepo interface {
+	CreateHold(hold *entity.Hold) error
+	GetHoldByID(id string) (*entity.Hold, error)
+	GetHoldsByAccountID(accountID string, page, pageSize int) ([]*entity.Hold, int64, error)
+	UpdateHoldStatus(hold *entity.Hold, previousStatus string) error
+	GetHeldAmounts(accountIDs []string) (map[string]money.Amount, error)
+}
@@ -0,0 +1,20 @@
+package ports
+
+import (
+	"account-service/internal/domain/entity"
+	"time"
+)
+
+type InterestRepo interface {
+	UpsertInterestRate(rate *entity.InterestRate) error
+	ListInterestRates() ([]*entity.InterestRate, error)
+	GetLastAccrualRun() (*entity.InterestAccrualRun, error)
+	GetAccountsWithBalanceAt(at time.Time) ([]*entity.Account, error)
+	RecordAccrualRun(run *entity.InterestAccrualRun, accruals []*entity.InterestAccrual) error
+	CloseInterestPeriod(period, lastAccrualDate string) ([]*entity.InterestPosting, error)
+	GetPendingInterestPostings(limit int) ([]*entity.InterestPosting, error)
+	SettleAccountInterest(accountID string) ([]*entity.InterestPosting, error)
+	GetPendingAccountInterestPostings(accountID string) ([]*entity.InterestPosting, error)
+	GetInterestPostingsByAccountID(accountID string, page, pageSize int) ([]*entity.InterestPosting, int64, error)
+	GetUnpostedAccrual(accountID string) (int64, error)
+}
@@ -0,0 +1,15 @@
+package ports
+
+import (
+	"account-service/internal/domain/entity"
+	"account-service/internal/domain/money"
+	"time"
+)
+
+type LedgerRepo interface {
+	GetEntriesByAccountID(accountID string, page, pageSize int) ([]*entity.LedgerEntry, int64, error)
+	GetAccountLedgerBalance(accountID string) (money.Amount, error)
+	GetEntriesByReference(reference string) ([]*entity.LedgerEntry, error)
+	GetAccountLedgerBalanceAt(accountID string, at time.Time) (money.Amount, error)
+	GetEntriesByAccountIDBetween(accountID string, from, to time.Time) ([]*entity.LedgerEntry, error)
+}
@@ -2,8 +2,10 @@ package repo
 
 import (
 	"account-service/internal/domain/entity"
+	"account-service/internal/domain/money"
 	"account-service/internal/grpc/types"
 	"github.com/stretchr/testify/mock"
+	"time"
 )
 
 type MockAccountRepo struct {
@@ -36,7 +38,7 @@ func (m *MockAccountRepo) UpdateAccount(account *entity.Account) error {
 	return args.Error(0)
 }
 
-func (m *MockAccountRepo) UpdateAccountBalance(id string, newBalance float64, currentVersion int, requester string) error {
+func (m *MockAccountRepo) UpdateAccountBalance(id string, newBalance money.Amount, currentVersion int, requester string) error {
 	args := m.Called(id, newBalance, currentVersion, requester)
 	return args.Error(0)
 }
@@ -84,6 +86,14 @@ func (m *MockAccountRepo) GetAccountByCustomerID(customerID string) ([]*entity.A
 	return args.Get(0).([]*entity.Account), args.Error(1)
 }
 
+func (m *MockAccountRepo) GetDormancyCandidates(inactiveSince time.Time) ([]*entity.Account, error) {
+	args := m.Called(inactiveSince)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*entity.Account), args.Error(1)
+}
+
 func (m *MockAccountRepo) GetAccountsInTransaction(transactionID string) ([]*entity.Account, error) {
 	args := m.Called(transactionID)
 	if args.Get(0) == nil {
@@ -100,8 +110,8 @@ func (m *MockAccountRepo) GetCustomerAccountsInTransaction(customerID string) ([
 	return args.Get(0).([]*entity.Account), args.Error(1)
 }
 
-func (m *MockAccountRepo) GetAccountsByFiltersWithPagination(filters map[string]interface{}, page, pageSize int, setOrder string) ([]*entity.Account, int64, error) {
-	args := m.Called(filters, page, pageSize, setOrder) // Make sure all 4 parameters are passed to Called()
+func (m *MockAccountRepo) GetAccountsByFiltersWithPagination(filters map[string]interface{}, page, pageSize int, after *entity.PageCursor, withTotal bool, setOrder string) ([]*entity.Account, int64, error) {
+	args := m.Called(filters, page, pageSize, after, withTotal, setOrder)
 
 	var accounts []*entity.Account
 	if args.Get(0) != nil {
@@ -136,8 +146,8 @@ func (m *MockAccountRepo) UnlockAccountsForTransaction(transactionID string) err
 	return args.Error(0)
 }
 
-func (m *MockAccountRepo) UpdateAccountBalanceLifecycle(balanceUpdates []types.AccountBalance, requester string) ([]types.AccountBalanceResponse, error) {
-	args := m.Called(balanceUpdates, requester)
+func (m *MockAccountRepo) UpdateAccountBalanceLifecycle(balanceUpdates []types.AccountBalance, journalType, transactionID, requester string) ([]types.AccountBalanceResponse, error) {
+	args := m.Called(balanceUpdates, journalType, transactionID, requester)
 	if args.Get(0) == nil {
 		return nil, args.Error(1) // Return nil slice, not a typed nil
 	}
@@ -34,8 +34,21 @@ func (m *MockCustomerRepo) GetCustomerByID(id string) (*entity.Customer, error)
 	return args.Get(0).(*entity.Customer), args.Error(1)
 }
 
-func (m *MockCustomerRepo) ListCustomer(page, pageSize int, setOrder string) ([]*entity.Customer, int64, error) {
-	args := m.Called(page, pageSize)
+func (m *MockCustomerRepo) GetCustomerByNationalID(nationalID string) (*entity.Customer, error) {
+	args := m.Called(nationalID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.Customer), args.Error(1)
+}
+
+func (m *MockCustomerRepo) UpdateCustomer(customer *entity.Customer) error {
+	args := m.Called(customer)
+	return args.Error(0)
+}
+
+func (m *MockCustomerRepo) ListCustomer(filters map[string]interface{}, page, pageSize int, after *entity.PageCursor, withTotal bool, setOrder string) ([]*entity.Customer, int64, error) {
+	args := m.Called(filters, page, pageSize, after, withTotal)
 	return args.Get(0).([]*entity.Customer), args.Get(1).(int64), args.Error(2)
 }
 
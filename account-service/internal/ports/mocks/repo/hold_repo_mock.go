@@ -0,0 +1,45 @@
+package repo
+
+import (
+	"account-service/internal/domain/entity"
+	"account-service/internal/domain/money"
+	"github.com/stretchr/testify/mock"
+)
+
+type MockHoldRepo struct {
+	mock.Mock
+}
+
+func (m *MockHoldRepo) CreateHold(hold *entity.Hold) error {
+	args := m.Called(hold)
+	return args.Error(0)
+}
+
+func (m *MockHoldRepo) GetHoldByID(id string) (*entity.Hold, error) {
+	args := m.Called(id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.Hold), args.Error(1)
+}
+
+func (m *MockHoldRepo) GetHoldsByAccountID(accountID string, page, pageSize int) ([]*entity.Hold, int64, error) {
+	args := m.Called(accountID, page, pageSize)
+	if args.Get(0) == nil {
+		return nil, args.Get(1).(int64), args.Error(2)
+	}
+	return args.Get(0).([]*entity.Hold), args.Get(1).(int64), args.Error(2)
+}
+
+func (m *MockHoldRepo) UpdateHoldStatus(hold *entity.Hold, previousStatus string) error {
+	args := m.Called(hold, previousStatus)
+	return args.Error(0)
+}
+
+func (m *MockHoldRepo) GetHeldAmounts(accountIDs []string) (map[string]money.Amount, error) {
+	args := m.Called(accountIDs)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(map[string]money.Amount), args.Error(1)
+}
@@ -0,0 +1,90 @@
+package repo
+
+import (
+	"account-service/internal/domain/entity"
+	"github.com/stretchr/testify/mock"
+	"time"
+)
+
+type MockInterestRepo struct {
+	mock.Mock
+}
+
+func (m *MockInterestRepo) UpsertInterestRate(rate *entity.InterestRate) error {
+	args := m.Called(rate)
+	return args.Error(0)
+}
+
+func (m *MockInterestRepo) ListInterestRates() ([]*entity.InterestRate, error) {
+	args := m.Called()
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*entity.InterestRate), args.Error(1)
+}
+
+func (m *MockInterestRepo) GetLastAccrualRun() (*entity.InterestAccrualRun, error) {
+	args := m.Called()
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.InterestAccrualRun), args.Error(1)
+}
+
+func (m *MockInterestRepo) GetAccountsWithBalanceAt(at time.Time) ([]*entity.Account, error) {
+	args := m.Called(at)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*entity.Account), args.Error(1)
+}
+
+func (m *MockInterestRepo) RecordAccrualRun(run *entity.InterestAccrualRun, accruals []*entity.InterestAccrual) error {
+	args := m.Called(run, accruals)
+	return args.Error(0)
+}
+
+func (m *MockInterestRepo) CloseInterestPeriod(period, lastAccrualDate string) ([]*entity.InterestPosting, error) {
+	args := m.Called(period, lastAccrualDate)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*entity.InterestPosting), args.Error(1)
+}
+
+func (m *MockInterestRepo) GetPendingInterestPostings(limit int) ([]*entity.InterestPosting, error) {
+	args := m.Called(limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*entity.InterestPosting), args.Error(1)
+}
+
+func (m *MockInterestRepo) SettleAccountInterest(accountID string) ([]*entity.InterestPosting, error) {
+	args := m.Called(accountID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*entity.InterestPosting), args.Error(1)
+}
+
+func (m *MockInterestRepo) GetPendingAccountInterestPostings(accountID string) ([]*entity.InterestPosting, error) {
+	args := m.Called(accountID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*entity.InterestPosting), args.Error(1)
+}
+
+func (m *MockInterestRepo) GetInterestPostingsByAccountID(accountID string, page, pageSize int) ([]*entity.InterestPosting, int64, error) {
+	args := m.Called(accountID, page, pageSize)
+	if args.Get(0) == nil {
+		return nil, args.Get(1).(int64), args.Error(2)
+	}
+	return args.Get(0).([]*entity.InterestPosting), args.Get(1).(int64), args.Error(2)
+}
+
+func (m *MockInterestRepo) GetUnpostedAccrual(accountID string) (int64, error) {
+	args := m.Called(accountID)
+	return args.Get(0).(int64), args.Error(1)
+}
@@ -0,0 +1,46 @@
+package repo
+
+import (
+	"account-service/internal/domain/entity"
+	"account-service/internal/domain/money"
+	"github.com/stretchr/testify/mock"
+	"time"
+)
+
+type MockLedgerRepo struct {
+	mock.Mock
+}
+
+func (m *MockLedgerRepo) GetEntriesByAccountID(accountID string, page, pageSize int) ([]*entity.LedgerEntry, int64, error) {
+	args := m.Called(accountID, page, pageSize)
+	if args.Get(0) == nil {
+		return nil, args.Get(1).(int64), args.Error(2)
+	}
+	return args.Get(0).([]*entity.LedgerEntry), args.Get(1).(int64), args.Error(2)
+}
+
+func (m *MockLedgerRepo) GetAccountLedgerBalance(accountID string) (money.Amount, error) {
+	args := m.Called(accountID)
+	return args.Get(0).(money.Amount), args.Error(1)
+}
+
+func (m *MockLedgerRepo) GetEntriesByReference(reference string) ([]*entity.LedgerEntry, error) {
+	args := m.Called(reference)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*entity.LedgerEntry), args.Error(1)
+}
+
+func (m *MockLedgerRepo) GetAccountLedgerBalanceAt(accountID string, at time.Time) (money.Amount, error) {
+	args := m.Called(accountID, at)
+	return args.Get(0).(money.Amount), args.Error(1)
+}
+
+func (m *MockLedgerRepo) GetEntriesByAccountIDBetween(accountID string, from, to time.Time) ([]*entity.LedgerEntry, error) {
+	args := m.Called(accountID, from, to)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*entity.LedgerEntry), args.Error(1)
+}
@@ -0,0 +1,48 @@
+package repo
+
+import (
+	"account-service/internal/domain/entity"
+	"github.com/stretchr/testify/mock"
+	"time"
+)
+
+type MockStatementRepo struct {
+	mock.Mock
+}
+
+func (m *MockStatementRepo) GetStatementAccounts(openedBefore time.Time) ([]*entity.Account, error) {
+	args := m.Called(openedBefore)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*entity.Account), args.Error(1)
+}
+
+func (m *MockStatementRepo) GetLastStatementRun() (*entity.StatementRun, error) {
+	args := m.Called()
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.StatementRun), args.Error(1)
+}
+
+func (m *MockStatementRepo) RecordStatementRun(run *entity.StatementRun, statements []*entity.Statement) error {
+	args := m.Called(run, statements)
+	return args.Error(0)
+}
+
+func (m *MockStatementRepo) GetStatementByID(id string) (*entity.Statement, error) {
+	args := m.Called(id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.Statement), args.Error(1)
+}
+
+func (m *MockStatementRepo) GetStatementsByAccountID(accountID string, page, pageSize int) ([]*entity.Statement, int64, error) {
+	args := m.Called(accountID, page, pageSize)
+	if args.Get(0) == nil {
+		return nil, args.Get(1).(int64), args.Error(2)
+	}
+	return args.Get(0).([]*entity.Statement), args.Get(1).(int64), args.Error(2)
+}
@@ -0,0 +1,14 @@
+package ports
+
+import (
+	"account-service/internal/domain/entity"
+	"time"
+)
+
+type StatementRepo interface {
+	GetStatementAccounts(openedBefore time.Time) ([]*entity.Account, error)
+	GetLastStatementRun() (*entity.StatementRun, error)
+	RecordStatementRun(run *entity.StatementRun, statements []*entity.Statement) error
+	GetStatementByID(id string) (*entity.Statement, error)
+	GetStatementsByAccountID(accountID string, page, pageSize int) ([]*entity.Statement, int64, error)
+}
@@ -63,16 +63,24 @@ func main() {
 		}
 	}()
 
-	// JWT Token Signer
-	tokenSigner := auth.NewTokenSigner(config.Current().Auth.JWTSecret)
-	hashing := auth.NewHashing(config.Current().Auth.HashKey)
+	// JWT Token Signer, a retired key has to verify the tokens it signed until they expire
+	authCfg := config.Current().Auth
+	tokenSigner, err := auth.NewTokenSigner(sqlite.NewSigningKeyRepo(dbInstance), authCfg.SigningKeyRotationInterval, authCfg.SigningKeyPublishAhead, max(authCfg.SigningKeyOverlap, authCfg.JWTTokentDuration))
+	if err != nil {
+		logging.Logger.Fatal().Err(err).Msg("failed to initialize token signer")
+		os.Exit(1)
+	}
+	hashing := auth.NewHashing(authCfg.HashKey)
+	totp := auth.NewTOTP(authCfg.MFAIssuer)
 
 	// Getting context for receiving OS signals for initiate graceful shutdown.
 	ctx := context.Background()
 	ctx, stop := runtime.SignalContext(ctx)
 	defer stop()
 
-	go grpc.StartGRPCServer(ctx, sqlite.NewEmployeeRepo(dbInstance), tokenSigner, hashing)
+	go tokenSigner.StartKeyRotation(ctx)
+
+	go grpc.StartGRPCServer(ctx, sqlite.NewEmployeeRepo(dbInstance), sqlite.NewRefreshTokenRepo(dbInstance), sqlite.NewTokenRevocationRepo(dbInstance), sqlite.NewMFARepo(dbInstance), sqlite.NewMFAPolicyRepo(dbInstance), tokenSigner, hashing, totp)
 
 	// Creating new http server for liveness and readiness checking
 	srv := httpserver.NewServerHTTP(httpserver.ServerConfig{
@@ -80,7 +88,7 @@ func main() {
 		ReadTimeout:  time.Duration(config.Current().HTTP.ReadTimeoutSeconds) * time.Second,
 		WriteTimeout: time.Duration(config.Current().HTTP.WriteTimeoutSeconds) * time.Second,
 		IdleTimeout:  time.Duration(config.Current().HTTP.IdleTimeoutSeconds) * time.Second,
-	})
+	}, tokenSigner)
 
 	// Listener for test
 	listener, err := net.Listen("tcp", config.Current().HTTP.Addr)
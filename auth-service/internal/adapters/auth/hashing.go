@@ -3,12 +3,32 @@ package auth
 import (
 	"auth-service/internal/ports"
 	"crypto/hmac"
+	"crypto/rand"
 	"crypto/sha256"
+	"crypto/subtle"
 	"encoding/base64"
+	"errors"
+	"fmt"
+	"golang.org/x/crypto/argon2"
+	"strings"
 )
 
+const (
+	// argon2id parameters, the second recommended option of RFC 9106
+	argon2Memory      = 64 * 1024 // KiB
+	argon2Iterations  = 3
+	argon2Parallelism = 4
+	argon2SaltLength  = 16
+	argon2KeyLength   = 32
+
+	argon2Prefix = "$argon2id$"
+)
+
+var errMalformedHash = errors.New("malformed password hash")
+
 // Hashing implements ports.Hashing.
 type Hashing struct {
+	// HashKey is the key of the legacy unsalted HMAC-SHA256 hashes, only used to verify them
 	HashKey string
 }
 
@@ -19,12 +39,105 @@ func NewHashing(hashKey string) ports.Hashing {
 	}
 }
 
-// HashData generates a hashed data using HMAC-SHA256 and a key from config
+// HashData hashes the data with argon2id and a random salt. The result is stored in the PHC string format
+// $argon2id$v=19$m=<memory>,t=<iterations>,p=<parallelism>$<salt>$<hash>, so it can be verified with the
+// parameters it was created with after they change.
 func (a *Hashing) HashData(data string) (string, error) {
-	// Create a new HMAC SHA256 hash using the key from the config
+	salt := make([]byte, argon2SaltLength)
+	if _, err := rand.Read(salt); err != nil {
+		return "", fmt.Errorf("failed to generate salt: %w", err)
+	}
+
+	key := argon2.IDKey([]byte(data), salt, argon2Iterations, argon2Memory, argon2Parallelism, argon2KeyLength)
+
+	return fmt.Sprintf("%sv=%d$m=%d,t=%d,p=%d$%s$%s",
+		argon2Prefix,
+		argon2.Version,
+		argon2Memory,
+		argon2Iterations,
+		argon2Parallelism,
+		base64.RawStdEncoding.EncodeToString(salt),
+		base64.RawStdEncoding.EncodeToString(key),
+	), nil
+}
+
+// VerifyData checks the data against an argon2id hash or a legacy HMAC-SHA256 hash in constant time
+func (a *Hashing) VerifyData(data, hashed string) (bool, error) {
+	if !strings.HasPrefix(hashed, argon2Prefix) {
+		return subtle.ConstantTimeCompare([]byte(a.legacyHash(data)), []byte(hashed)) == 1, nil
+	}
+
+	params, salt, key, err := decodeArgon2Hash(hashed)
+	if err != nil {
+		return false, err
+	}
+
+	computed := argon2.IDKey([]byte(data), salt, params.iterations, params.memory, params.parallelism, uint32(len(key)))
+	return subtle.ConstantTimeCompare(computed, key) == 1, nil
+}
+
+// NeedsRehash reports whether the hash is a legacy HMAC-SHA256 hash or uses other argon2id parameters than
+// new hashes
+func (a *Hashing) NeedsRehash(hashed string) bool {
+	if !strings.HasPrefix(hashed, argon2Prefix) {
+		return true
+	}
+
+	params, _, key, err := decodeArgon2Hash(hashed)
+	if err != nil {
+		return true
+	}
+
+	return params.memory != argon2Memory ||
+		params.iterations != argon2Iterations ||
+		params.parallelism != argon2Parallelism ||
+		len(key) != argon2KeyLength
+}
+
+// legacyHash is the unsalted HMAC-SHA256 hash passwords were stored with before argon2id
+func (a *Hashing) legacyHash(data string) string {
 	hmacHash := hmac.New(sha256.New, []byte(a.HashKey))
 	hmacHash.Write([]byte(data))
-	hashedPassword := base64.URLEncoding.EncodeToString(hmacHash.Sum(nil))
+	return base64.URLEncoding.EncodeToString(hmacHash.Sum(nil))
+}
+
+type argon2Params struct {
+	memory      uint32
+	iterations  uint32
+	parallelism uint8
+}
+
+// decodeArgon2Hash reads the parameters, salt and key of an argon2id hash in the PHC string format
+func decodeArgon2Hash(hashed string) (argon2Params, []byte, []byte, error) {
+	var params argon2Params
+
+	// "", "argon2id", "v=19", "m=65536,t=3,p=4", salt, key
+	parts := strings.Split(hashed, "$")
+	if len(parts) != 6 {
+		return params, nil, nil, errMalformedHash
+	}
+
+	var version int
+	if _, err := fmt.Sscanf(parts[2], "v=%d", &version); err != nil || version != argon2.Version {
+		return params, nil, nil, fmt.Errorf("%w: unsupported argon2 version", errMalformedHash)
+	}
+
+	if _, err := fmt.Sscanf(parts[3], "m=%d,t=%d,p=%d", &params.memory, &params.iterations, &params.parallelism); err != nil {
+		return params, nil, nil, fmt.Errorf("%w: %v", errMalformedHash, err)
+	}
+	if params.iterations == 0 || params.parallelism == 0 {
+		return params, nil, nil, errMalformedHash
+	}
+
+	salt, err := base64.RawStdEncoding.DecodeString(parts[4])
+	if err != nil {
+		return params, nil, nil, fmt.Errorf("%w: %v", errMalformedHash, err)
+	}
+
+	key, err := base64.RawStdEncoding.DecodeString(parts[5])
+	if err != nil || len(key) == 0 {
+		return params, nil, nil, errMalformedHash
+	}
 
-	return hashedPassword, nil
+	return params, salt, key, nil
 }
@@ -0,0 +1,103 @@
+package auth
+
+import (
+	"encoding/base64"
+	"fmt"
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/crypto/argon2"
+	"strings"
+	"testing"
+)
+
+// TestHashing_RoundTrip tests that a new hash verifies its password and uses the current parameters
+func TestHashing_RoundTrip(t *testing.T) {
+	hashing := NewHashing("hash-key")
+
+	hashed, err := hashing.HashData("s3cret!")
+	assert.NoError(t, err)
+	assert.True(t, strings.HasPrefix(hashed, fmt.Sprintf("$argon2id$v=%d$m=%d,t=%d,p=%d$", argon2.Version, argon2Memory, argon2Iterations, argon2Parallelism)))
+
+	ok, err := hashing.VerifyData("s3cret!", hashed)
+	assert.NoError(t, err)
+	assert.True(t, ok)
+	assert.False(t, hashing.NeedsRehash(hashed))
+
+	// the salt is random, so the same password hashes differently
+	other, err := hashing.HashData("s3cret!")
+	assert.NoError(t, err)
+	assert.NotEqual(t, hashed, other)
+}
+
+// TestHashing_WrongPassword tests that another password does not verify
+func TestHashing_WrongPassword(t *testing.T) {
+	hashing := NewHashing("hash-key")
+
+	hashed, err := hashing.HashData("s3cret!")
+	assert.NoError(t, err)
+
+	ok, err := hashing.VerifyData("s3cret?", hashed)
+	assert.NoError(t, err)
+	assert.False(t, ok)
+}
+
+// TestHashing_Legacy tests that legacy HMAC-SHA256 hashes verify with the hash key and need a rehash
+func TestHashing_Legacy(t *testing.T) {
+	hashing := &Hashing{HashKey: "hash-key"}
+	legacy := hashing.legacyHash("s3cret!")
+
+	ok, err := hashing.VerifyData("s3cret!", legacy)
+	assert.NoError(t, err)
+	assert.True(t, ok)
+	assert.True(t, hashing.NeedsRehash(legacy))
+
+	ok, err = hashing.VerifyData("s3cret?", legacy)
+	assert.NoError(t, err)
+	assert.False(t, ok)
+
+	ok, err = NewHashing("other-key").VerifyData("s3cret!", legacy)
+	assert.NoError(t, err)
+	assert.False(t, ok)
+}
+
+// TestHashing_ChangedParameters tests that hashes of other parameters still verify but need a rehash
+func TestHashing_ChangedParameters(t *testing.T) {
+	hashing := NewHashing("hash-key")
+	salt := []byte("0123456789abcdef")
+	key := argon2.IDKey([]byte("s3cret!"), salt, 2, 19*1024, 1, argon2KeyLength)
+	hashed := fmt.Sprintf("$argon2id$v=%d$m=%d,t=%d,p=%d$%s$%s", argon2.Version, 19*1024, 2, 1,
+		base64.RawStdEncoding.EncodeToString(salt), base64.RawStdEncoding.EncodeToString(key))
+
+	ok, err := hashing.VerifyData("s3cret!", hashed)
+	assert.NoError(t, err)
+	assert.True(t, ok)
+	assert.True(t, hashing.NeedsRehash(hashed))
+}
+
+// TestHashing_MalformedHash tests that corrupt PHC strings are rejected and need a rehash
+func TestHashing_MalformedHash(t *testing.T) {
+	hashing := NewHashing("hash-key")
+
+	tests := []struct {
+		name   string
+		hashed string
+	}{
+		{"MissingParts", "$argon2id$v=19$m=65536,t=3,p=4$c2FsdHNhbHQ"},
+		{"UnsupportedVersion", "$argon2id$v=16$m=65536,t=3,p=4$c2FsdHNhbHQ$a2V5a2V5"},
+		{"BadParameters", "$argon2id$v=19$m=abc,t=3,p=4$c2FsdHNhbHQ$a2V5a2V5"},
+		{"ZeroIterations", "$argon2id$v=19$m=65536,t=0,p=4$c2FsdHNhbHQ$a2V5a2V5"},
+		{"ZeroParallelism", "$argon2id$v=19$m=65536,t=3,p=0$c2FsdHNhbHQ$a2V5a2V5"},
+		{"BadSalt", "$argon2id$v=19$m=65536,t=3,p=4$not-base64!$a2V5a2V5"},
+		{"BadKey", "$argon2id$v=19$m=65536,t=3,p=4$c2FsdHNhbHQ$not-base64!"},
+		{"EmptyKey", "$argon2id$v=19$m=65536,t=3,p=4$c2FsdHNhbHQ$"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ok, err := hashing.VerifyData("s3cret!", tt.hashed)
+
+			assert.ErrorIs(t, err, errMalformedHash)
+			assert.False(t, ok)
+			assert.True(t, hashing.NeedsRehash(tt.hashed))
+		})
+	}
+}
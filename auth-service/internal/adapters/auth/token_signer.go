@@ -1,15 +1,33 @@
 package auth
 
 import (
+	"auth-service/internal/domain/entity"
+	"auth-service/internal/logging"
 	"auth-service/internal/ports"
+	"context"
+	"errors"
 	"fmt"
 	"github.com/golang-jwt/jwt/v4"
+	"github.com/google/uuid"
+	"sync"
 	"time"
 )
 
-// TokenSignerRepo implements ports.TokenSignerRepo.
+// keyRotationCheckInterval is how often the signing keys are reloaded and checked for a due rotation
+const keyRotationCheckInterval = time.Minute
+
+// TokenSigner implements ports.TokenSigner. It signs with the newest active signing key stored in the database and
+// replaces it with a new key every rotationInterval. The next key is published publishAhead before it starts
+// signing, so verifiers that refresh the published keys more often than that know it before its first token. A
+// retired key stays published for the overlap, so the tokens it signed can be verified until they expire.
 type TokenSigner struct {
-	secretKey string
+	keyRepo          ports.SigningKeyRepo
+	rotationInterval time.Duration
+	publishAhead     time.Duration
+	overlap          time.Duration
+
+	mu   sync.RWMutex
+	keys []*entity.SigningKey
 }
 
 // Claims defines the structure of JWT claims.
@@ -19,27 +37,48 @@ type Claims struct {
 	jwt.RegisteredClaims
 }
 
-// NewTokenSigner creates a new instance of TokenSigner.
-func NewTokenSigner(secretKey string) ports.TokenSigner {
-	return &TokenSigner{
-		secretKey: secretKey,
+// NewTokenSigner creates a new instance of TokenSigner and creates the first signing key if there is none. The
+// overlap has to be at least the lifetime of the tokens, publishAhead has to be shorter than the rotationInterval.
+func NewTokenSigner(keyRepo ports.SigningKeyRepo, rotationInterval, publishAhead, overlap time.Duration) (ports.TokenSigner, error) {
+	signer := &TokenSigner{
+		keyRepo:          keyRepo,
+		rotationInterval: rotationInterval,
+		publishAhead:     publishAhead,
+		overlap:          overlap,
+	}
+
+	if err := signer.rotateIfDue(time.Now()); err != nil {
+		return nil, err
 	}
+
+	return signer, nil
 }
 
-// SignJWT generates a JWT token for the user.
-func (a *TokenSigner) SignJWT(username, role, secretKey string, expiryTime time.Duration) (string, error) {
+// SignJWT generates a JWT token for the user, signed with the current key named by the kid header. Every token gets
+// a unique ID (jti), by which it can be revoked.
+func (a *TokenSigner) SignJWT(username, role string, expiryTime time.Duration) (string, error) {
+	a.mu.RLock()
+	key := currentSigningKey(a.keys, time.Now())
+	a.mu.RUnlock()
+
+	if key == nil {
+		return "", errors.New("no signing key available")
+	}
+
 	expirationTime := time.Now().Add(expiryTime)
 	claims := &Claims{
 		Username: username,
 		Role:     role,
 		RegisteredClaims: jwt.RegisteredClaims{
+			ID:        uuid.New().String(),
 			ExpiresAt: jwt.NewNumericDate(expirationTime),
 			IssuedAt:  jwt.NewNumericDate(time.Now()),
 		},
 	}
 
-	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
-	signedToken, err := token.SignedString([]byte(secretKey))
+	token := jwt.NewWithClaims(jwt.SigningMethodEdDSA, claims)
+	token.Header["kid"] = key.ID
+	signedToken, err := token.SignedString(key.Signer())
 	if err != nil {
 		return "", fmt.Errorf("failed to sign the token: %w", err)
 	}
@@ -47,22 +86,135 @@ func (a *TokenSigner) SignJWT(username, role, secretKey string, expiryTime time.
 	return signedToken, nil
 }
 
-// SignJWTRefreshToken generates a refresh token for the user.
-func (a *TokenSigner) SignJWTRefreshToken(username, secretKey string) (string, error) {
-	expirationTime := time.Now().Add(7 * 24 * time.Hour)
-	claims := &Claims{
-		Username: username,
-		RegisteredClaims: jwt.RegisteredClaims{
-			ExpiresAt: jwt.NewNumericDate(expirationTime),
-			IssuedAt:  jwt.NewNumericDate(time.Now()),
-		},
+// VerificationKeys returns the current key, the next key once it is published and the retired keys within their
+// overlap
+func (a *TokenSigner) VerificationKeys() []*entity.SigningKey {
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+
+	keys := make([]*entity.SigningKey, len(a.keys))
+	copy(keys, a.keys)
+	return keys
+}
+
+// StartKeyRotation rotates the signing key on schedule until the context is done. Keys are reloaded on every check,
+// so keys rotated by another instance sharing the database are picked up as well.
+func (a *TokenSigner) StartKeyRotation(ctx context.Context) {
+	ticker := time.NewTicker(keyRotationCheckInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := a.rotateIfDue(time.Now()); err != nil {
+				logging.Logger.Error().Err(err).Msg("Failed to rotate signing key")
+			}
+		}
 	}
+}
 
-	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
-	signedToken, err := token.SignedString([]byte(secretKey))
+// rotateIfDue reloads the keys, creates the first key if there is none and publishes the next key publishAhead
+// before the current one is due for rotation. Once the next key signs, the keys it replaced are retired and expire
+// after the overlap.
+func (a *TokenSigner) rotateIfDue(now time.Time) error {
+	keys, err := a.keyRepo.ListSigningKeys()
 	if err != nil {
-		return "", fmt.Errorf("failed to sign the refresh token: %w", err)
+		return fmt.Errorf("failed to load signing keys: %w", err)
 	}
 
-	return signedToken, nil
+	current := currentSigningKey(keys, now)
+	switch {
+	case current == nil:
+		// no token has been signed yet, so the first key signs right away
+		if keys, err = a.createKey(now); err != nil {
+			return err
+		}
+		current = currentSigningKey(keys, now)
+	case !hasNextSigningKey(keys, now):
+		rotatesAt := current.ActiveFrom().Add(a.rotationInterval)
+		if !now.Before(rotatesAt.Add(-a.publishAhead)) {
+			if keys, err = a.createKey(maxTime(rotatesAt, now.Add(a.publishAhead))); err != nil {
+				return err
+			}
+		}
+	}
+
+	if hasReplacedSigningKey(keys, current, now) {
+		if err = a.keyRepo.RetireSigningKeys(current.ID, now, now.Add(a.overlap)); err != nil {
+			return fmt.Errorf("failed to retire signing keys: %w", err)
+		}
+		if keys, err = a.keyRepo.ListSigningKeys(); err != nil {
+			return fmt.Errorf("failed to load signing keys: %w", err)
+		}
+		logging.Logger.Info().Str("kid", current.ID).Msg("Rotated signing key")
+	}
+
+	a.mu.Lock()
+	a.keys = keys
+	a.mu.Unlock()
+
+	return nil
+}
+
+// createKey stores a new key that signs from activatesAt and returns the reloaded keys
+func (a *TokenSigner) createKey(activatesAt time.Time) ([]*entity.SigningKey, error) {
+	key, err := entity.NewSigningKey(activatesAt)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = a.keyRepo.CreateSigningKey(key); err != nil {
+		return nil, fmt.Errorf("failed to store signing key: %w", err)
+	}
+
+	logging.Logger.Info().Str("kid", key.ID).Time("activates_at", activatesAt).Msg("Published signing key")
+
+	keys, err := a.keyRepo.ListSigningKeys()
+	if err != nil {
+		return nil, fmt.Errorf("failed to load signing keys: %w", err)
+	}
+	return keys, nil
+}
+
+// currentSigningKey returns the newest key that is active at the given time, nil if there is none
+func currentSigningKey(keys []*entity.SigningKey, at time.Time) *entity.SigningKey {
+	var current *entity.SigningKey
+	for _, key := range keys {
+		if !key.IsActive(at) {
+			continue
+		}
+		if current == nil || key.ActiveFrom().After(current.ActiveFrom()) {
+			current = key
+		}
+	}
+	return current
+}
+
+// hasNextSigningKey reports whether a published key has yet to start signing
+func hasNextSigningKey(keys []*entity.SigningKey, at time.Time) bool {
+	for _, key := range keys {
+		if !key.IsRetired() && at.Before(key.ActiveFrom()) {
+			return true
+		}
+	}
+	return false
+}
+
+// hasReplacedSigningKey reports whether an active key other than the current one is not retired yet
+func hasReplacedSigningKey(keys []*entity.SigningKey, current *entity.SigningKey, at time.Time) bool {
+	for _, key := range keys {
+		if key.ID != current.ID && key.IsActive(at) {
+			return true
+		}
+	}
+	return false
+}
+
+func maxTime(a, b time.Time) time.Time {
+	if a.After(b) {
+		return a
+	}
+	return b
 }
@@ -0,0 +1,152 @@
+package auth
+
+import (
+	"auth-service/internal/domain/entity"
+	"github.com/golang-jwt/jwt/v4"
+	"github.com/stretchr/testify/assert"
+	"sync"
+	"testing"
+	"time"
+)
+
+const (
+	testRotationInterval = 24 * time.Hour
+	testPublishAhead     = 15 * time.Minute
+	testOverlap          = time.Hour
+)
+
+// testSigningKeyRepo keeps the signing keys in memory
+type testSigningKeyRepo struct {
+	mu   sync.Mutex
+	keys []*entity.SigningKey
+}
+
+func (r *testSigningKeyRepo) ListSigningKeys() ([]*entity.SigningKey, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	keys := make([]*entity.SigningKey, len(r.keys))
+	for i, key := range r.keys {
+		copied := *key
+		keys[i] = &copied
+	}
+	return keys, nil
+}
+
+func (r *testSigningKeyRepo) CreateSigningKey(key *entity.SigningKey) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.keys = append(r.keys, key)
+	return nil
+}
+
+func (r *testSigningKeyRepo) RetireSigningKeys(currentID string, retiredAt, expiresAt time.Time) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for _, key := range r.keys {
+		if key.ID != currentID && !key.IsRetired() && !key.ActiveFrom().After(retiredAt) {
+			key.RetiredAt = &retiredAt
+			key.ExpiresAt = &expiresAt
+		}
+	}
+	return nil
+}
+
+// shift moves the activation of the key into the past, as if time had passed
+func (r *testSigningKeyRepo) shift(kid string, by time.Duration) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for _, key := range r.keys {
+		if key.ID == kid {
+			activatesAt := key.ActiveFrom().Add(-by)
+			key.ActivatesAt = &activatesAt
+		}
+	}
+}
+
+func signedKid(t *testing.T, signer *TokenSigner) string {
+	signed, err := signer.SignJWT("testuser", "admin", time.Minute)
+	assert.NoError(t, err)
+
+	token, _, err := jwt.NewParser().ParseUnverified(signed, &Claims{})
+	assert.NoError(t, err)
+	return token.Header["kid"].(string)
+}
+
+func kids(keys []*entity.SigningKey) []string {
+	ids := make([]string, len(keys))
+	for i, key := range keys {
+		ids[i] = key.ID
+	}
+	return ids
+}
+
+// TestTokenSigner_FirstKey tests that the first key signs right away
+func TestTokenSigner_FirstKey(t *testing.T) {
+	repo := &testSigningKeyRepo{}
+	signer, err := NewTokenSigner(repo, testRotationInterval, testPublishAhead, testOverlap)
+	assert.NoError(t, err)
+
+	assert.Len(t, repo.keys, 1)
+	assert.Equal(t, repo.keys[0].ID, signedKid(t, signer.(*TokenSigner)))
+}
+
+// TestTokenSigner_PublishesNextKeyAhead tests that the next key is published before it signs, and that the key it
+// replaces is retired once it signs
+func TestTokenSigner_PublishesNextKeyAhead(t *testing.T) {
+	repo := &testSigningKeyRepo{}
+	created, err := NewTokenSigner(repo, testRotationInterval, testPublishAhead, testOverlap)
+	assert.NoError(t, err)
+	signer := created.(*TokenSigner)
+	first := repo.keys[0].ID
+
+	// not due yet
+	repo.shift(first, testRotationInterval-testPublishAhead-time.Minute)
+	assert.NoError(t, signer.rotateIfDue(time.Now()))
+	assert.Len(t, signer.VerificationKeys(), 1)
+
+	// the rotation is due within publishAhead: the next key is published but does not sign yet
+	repo.shift(first, 2*time.Minute)
+	assert.NoError(t, signer.rotateIfDue(time.Now()))
+	assert.Len(t, repo.keys, 2)
+	next := repo.keys[1]
+	assert.Equal(t, []string{first, next.ID}, kids(signer.VerificationKeys()))
+	assert.True(t, next.ActiveFrom().After(time.Now()))
+	assert.Equal(t, first, signedKid(t, signer))
+
+	// checks before the activation publish no further key
+	assert.NoError(t, signer.rotateIfDue(time.Now()))
+	assert.Len(t, repo.keys, 2)
+
+	// the next key signs from its activation and the first one is retired for the overlap
+	repo.shift(next.ID, time.Until(next.ActiveFrom())+time.Second)
+	assert.NoError(t, signer.rotateIfDue(time.Now()))
+	assert.Equal(t, next.ID, signedKid(t, signer))
+
+	keys := signer.VerificationKeys()
+	assert.Equal(t, []string{first, next.ID}, kids(keys))
+	assert.True(t, keys[0].IsRetired())
+	assert.WithinDuration(t, time.Now().Add(testOverlap), *keys[0].ExpiresAt, time.Minute)
+	assert.False(t, keys[1].IsRetired())
+}
+
+// TestTokenSigner_OverdueRotation tests that an overdue rotation still publishes the next key ahead of its use, e.g.
+// after the service was down
+func TestTokenSigner_OverdueRotation(t *testing.T) {
+	repo := &testSigningKeyRepo{}
+	created, err := NewTokenSigner(repo, testRotationInterval, testPublishAhead, testOverlap)
+	assert.NoError(t, err)
+	signer := created.(*TokenSigner)
+	first := repo.keys[0].ID
+
+	repo.shift(first, 2*testRotationInterval)
+	now := time.Now()
+	assert.NoError(t, signer.rotateIfDue(now))
+
+	assert.Len(t, repo.keys, 2)
+	assert.Equal(t, now.Add(testPublishAhead), repo.keys[1].ActiveFrom())
+	assert.Equal(t, first, signedKid(t, signer))
+}
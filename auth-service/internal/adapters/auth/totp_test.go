@@ -0,0 +1,91 @@
+package auth
+
+import (
+	"encoding/base32"
+	"github.com/stretchr/testify/assert"
+	"net/url"
+	"testing"
+	"time"
+)
+
+// rfc6238Secret is the SHA1 seed of the test vectors of RFC 6238, appendix B
+var rfc6238Secret = base32.StdEncoding.EncodeToString([]byte("12345678901234567890"))
+
+// TestTOTP_Validate_RFC6238 tests the test vectors of RFC 6238, truncated to 6 digits
+func TestTOTP_Validate_RFC6238(t *testing.T) {
+	tests := []struct {
+		unix int64
+		code string
+	}{
+		{59, "287082"},
+		{1111111109, "081804"},
+		{1111111111, "050471"},
+		{1234567890, "005924"},
+		{2000000000, "279037"},
+	}
+
+	totp := NewTOTP("BankOps")
+	for _, tt := range tests {
+		step, ok := totp.Validate(rfc6238Secret, tt.code, time.Unix(tt.unix, 0))
+
+		assert.True(t, ok, tt.code)
+		assert.Equal(t, tt.unix/totpPeriod, step)
+	}
+}
+
+// TestTOTP_Validate_Skew tests that codes of the neighbouring time steps are accepted and older ones are not
+func TestTOTP_Validate_Skew(t *testing.T) {
+	totp := NewTOTP("BankOps")
+	at := time.Unix(1111111111, 0)
+
+	_, ok := totp.Validate(rfc6238Secret, "050471", at.Add(totpPeriod*time.Second))
+	assert.True(t, ok)
+
+	_, ok = totp.Validate(rfc6238Secret, "050471", at.Add(3*totpPeriod*time.Second))
+	assert.False(t, ok)
+}
+
+// TestTOTP_Validate_Invalid tests malformed codes and secrets
+func TestTOTP_Validate_Invalid(t *testing.T) {
+	totp := NewTOTP("BankOps")
+	at := time.Unix(59, 0)
+
+	for _, code := range []string{"", "28708", "2870820", "abcdef", "287083"} {
+		_, ok := totp.Validate(rfc6238Secret, code, at)
+		assert.False(t, ok, code)
+	}
+
+	_, ok := totp.Validate("not base32!", "287082", at)
+	assert.False(t, ok)
+}
+
+// TestTOTP_GenerateSecret tests that a generated secret validates its own codes
+func TestTOTP_GenerateSecret(t *testing.T) {
+	totp := NewTOTP("BankOps")
+
+	secret, err := totp.GenerateSecret()
+	assert.NoError(t, err)
+	assert.Len(t, secret, 32)
+
+	key, err := totpEncoding.DecodeString(secret)
+	assert.NoError(t, err)
+
+	at := time.Now()
+	step, ok := totp.Validate(secret, hotp(key, at.Unix()/totpPeriod), at)
+	assert.True(t, ok)
+	assert.Equal(t, at.Unix()/totpPeriod, step)
+}
+
+// TestTOTP_ProvisioningURI tests the key uri format read by authenticator apps
+func TestTOTP_ProvisioningURI(t *testing.T) {
+	uri, err := url.Parse(NewTOTP("BankOps Core").ProvisioningURI("jane.doe", "JBSWY3DPEHPK3PXP"))
+
+	assert.NoError(t, err)
+	assert.Equal(t, "otpauth", uri.Scheme)
+	assert.Equal(t, "totp", uri.Host)
+	assert.Equal(t, "/BankOps Core:jane.doe", uri.Path)
+	assert.Equal(t, "JBSWY3DPEHPK3PXP", uri.Query().Get("secret"))
+	assert.Equal(t, "BankOps Core", uri.Query().Get("issuer"))
+	assert.Equal(t, "6", uri.Query().Get("digits"))
+	assert.Equal(t, "30", uri.Query().Get("period"))
+}
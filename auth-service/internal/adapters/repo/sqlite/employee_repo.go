@@ -58,6 +58,8 @@ func (r *EmployeeRepo) UpdateEmployee(input *entity.Employee) (*entity.Employee,
 		return nil, err
 	}
 
+	employee.Role = input.Role
+	employee.UpdatedBy = input.UpdatedBy
 	if err := r.DB.Save(&employee).Error; err != nil {
 		return nil, err
 	}
@@ -65,6 +67,24 @@ func (r *EmployeeRepo) UpdateEmployee(input *entity.Employee) (*entity.Employee,
 	return &employee, nil
 }
 
+// UpdatePassword replaces the password hash of a valid employee
+func (r *EmployeeRepo) UpdatePassword(username, hashedPassword string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	result := r.DB.Model(&entity.Employee{}).
+		Where("username = ? AND status = ?", username, entity.EmployeeStatusValid).
+		Update("password", hashedPassword)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+
+	return nil
+}
+
 // DeleteEmployee marks an employee as invalid (soft delete)
 func (r *EmployeeRepo) DeleteEmployee(username, requester string) error {
 	r.mu.Lock()
@@ -86,28 +106,34 @@ func (r *EmployeeRepo) DeleteEmployee(username, requester string) error {
 	return nil
 }
 
-func (r *EmployeeRepo) ListEmployee(page, pageSize int, sortOrder string) ([]*entity.Employee, int64, error) {
+// ListEmployee reads a page of the valid employees ordered by creation time and ID. With a cursor the page starts
+// after it, otherwise page is used as an offset. One row more than pageSize is read so the caller can tell whether
+// another page follows. The total is only counted when withTotal is set.
+func (r *EmployeeRepo) ListEmployee(page, pageSize int, after *entity.PageCursor, withTotal bool, sortOrder string) ([]*entity.Employee, int64, error) {
 	var employees []*entity.Employee
 	var total int64
 
-	offset := (page - 1) * pageSize
+	query := r.DB.Model(&entity.Employee{}).Where("status = ?", entity.EmployeeStatusValid)
 
-	if err := r.DB.Model(&entity.Employee{}).
-		Where("status = ?", entity.EmployeeStatusValid).
-		Count(&total).Error; err != nil {
-		return nil, 0, err
+	if withTotal {
+		if err := query.Count(&total).Error; err != nil {
+			return nil, 0, err
+		}
 	}
 
-	order := "created_at DESC"
+	order, afterCond := "created_at DESC, id DESC", "created_at < ? OR (created_at = ? AND id < ?)"
 	if sortOrder == "asc" {
-		order = "created_at ASC"
+		order, afterCond = "created_at ASC, id ASC", "created_at > ? OR (created_at = ? AND id > ?)"
+	}
+
+	if after != nil {
+		// rows are stored in local time and compared as text
+		query = query.Where(afterCond, after.CreatedAt.Local(), after.CreatedAt.Local(), after.ID)
+	} else {
+		query = query.Offset((page - 1) * pageSize)
 	}
 
-	err := r.DB.Where("status = ?", entity.EmployeeStatusValid).
-		Offset(offset).
-		Limit(pageSize).
-		Order(order).
-		Find(&employees).Error
+	err := query.Limit(pageSize + 1).Order(order).Find(&employees).Error
 
 	return employees, total, err
 }
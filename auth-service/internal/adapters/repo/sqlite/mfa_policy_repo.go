@@ -0,0 +1,49 @@
+package sqlite
+
+import (
+	"auth-service/internal/domain/entity"
+	"auth-service/internal/ports"
+	"errors"
+	"gorm.io/gorm"
+	"sync"
+)
+
+// MFAPolicyRepo struct to interact with the database.
+type MFAPolicyRepo struct {
+	DB *gorm.DB
+	mu sync.Mutex
+}
+
+// NewMFAPolicyRepo creates a new MFAPolicyRepo instance with an SQLite connection.
+func NewMFAPolicyRepo(db *gorm.DB) ports.MFAPolicyRepo {
+	return &MFAPolicyRepo{DB: db}
+}
+
+// GetMFAPolicy returns the MFA policy of the role, nil if there is none
+func (r *MFAPolicyRepo) GetMFAPolicy(role string) (*entity.MFAPolicy, error) {
+	var policy entity.MFAPolicy
+	if err := r.DB.Where("role = ?", role).First(&policy).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &policy, nil
+}
+
+// SaveMFAPolicy stores the MFA policy, replacing the former one of the role
+func (r *MFAPolicyRepo) SaveMFAPolicy(policy *entity.MFAPolicy) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return r.DB.Save(policy).Error
+}
+
+// ListMFAPolicies returns the MFA policies of all roles that have one
+func (r *MFAPolicyRepo) ListMFAPolicies() ([]*entity.MFAPolicy, error) {
+	var policies []*entity.MFAPolicy
+	if err := r.DB.Order("role ASC").Find(&policies).Error; err != nil {
+		return nil, err
+	}
+	return policies, nil
+}
@@ -0,0 +1,222 @@
+package sqlite
+
+import (
+	"auth-service/internal/domain/entity"
+	custom_err "auth-service/internal/domain/error"
+	"auth-service/internal/ports"
+	"errors"
+	"gorm.io/gorm"
+	"sync"
+	"time"
+)
+
+// MFARepo struct to interact with the database.
+type MFARepo struct {
+	DB *gorm.DB
+	mu sync.Mutex
+}
+
+// NewMFARepo creates a new MFARepo instance with an SQLite connection.
+func NewMFARepo(db *gorm.DB) ports.MFARepo {
+	return &MFARepo{DB: db}
+}
+
+// GetMFA returns the MFA enrollment of the employee, nil if there is none
+func (r *MFARepo) GetMFA(username string) (*entity.EmployeeMFA, error) {
+	var mfa entity.EmployeeMFA
+	if err := r.DB.Where("username = ?", username).First(&mfa).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &mfa, nil
+}
+
+// SaveMFA stores the MFA enrollment, replacing the former one of the employee
+func (r *MFARepo) SaveMFA(mfa *entity.EmployeeMFA) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return r.DB.Save(mfa).Error
+}
+
+// EnableMFA enables the pending MFA of the employee with the time step of the verified code and replaces the
+// recovery codes in one transaction. It returns ErrMFAAlreadyEnabled when MFA is no longer pending, e.g. because a
+// concurrent request enabled it.
+func (r *MFARepo) EnableMFA(username string, step int64, recoveryCodes []*entity.MFARecoveryCode) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return r.DB.Transaction(func(tx *gorm.DB) error {
+		result := tx.Model(&entity.EmployeeMFA{}).
+			Where("username = ? AND status = ?", username, entity.MFAStatusPending).
+			Updates(map[string]interface{}{
+				"status":         entity.MFAStatusEnabled,
+				"last_used_step": step,
+				"enabled_at":     time.Now(),
+			})
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return custom_err.ErrMFAAlreadyEnabled
+		}
+
+		if err := tx.Where("username = ?", username).Delete(&entity.MFARecoveryCode{}).Error; err != nil {
+			return err
+		}
+		return tx.Create(recoveryCodes).Error
+	})
+}
+
+// UseMFAStep records the time step of an accepted code. It returns ErrInvalidMFACode when a code of the same or a
+// later step has been used already, so a code cannot be replayed.
+func (r *MFARepo) UseMFAStep(username string, step int64) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	result := r.DB.Model(&entity.EmployeeMFA{}).
+		Where("username = ? AND status = ? AND last_used_step < ?", username, entity.MFAStatusEnabled, step).
+		Update("last_used_step", step)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return custom_err.ErrInvalidMFACode
+	}
+	return nil
+}
+
+// UseRecoveryCode marks the unused recovery code of the employee with the hash as used. It returns
+// ErrInvalidMFACode when there is no such code.
+func (r *MFARepo) UseRecoveryCode(username, codeHash string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	result := r.DB.Model(&entity.MFARecoveryCode{}).
+		Where("username = ? AND code_hash = ? AND used_at IS NULL", username, codeHash).
+		Update("used_at", time.Now())
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return custom_err.ErrInvalidMFACode
+	}
+	return nil
+}
+
+// DeleteMFA removes the MFA enrollment and recovery codes of the employee in one transaction
+func (r *MFARepo) DeleteMFA(username string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return r.DB.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("username = ?", username).Delete(&entity.MFARecoveryCode{}).Error; err != nil {
+			return err
+		}
+		return tx.Where("username = ?", username).Delete(&entity.EmployeeMFA{}).Error
+	})
+}
+
+// ClaimMFAAttempt counts a verification of the employee before the code is checked. The claim that reaches
+// maxFailures starts a lockout, which is lifted by ResetMFAAttempts when that verification succeeds. It returns
+// ErrMFALocked while the employee is locked out; after lockout the count starts over.
+func (r *MFARepo) ClaimMFAAttempt(username string, maxFailures int, lockout time.Duration) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	now := time.Now()
+	return r.DB.Transaction(func(tx *gorm.DB) error {
+		err := tx.Model(&entity.EmployeeMFA{}).
+			Where("username = ? AND locked_until <= ?", username, now).
+			Updates(map[string]interface{}{"failed_attempts": 0, "locked_until": nil}).Error
+		if err != nil {
+			return err
+		}
+
+		result := tx.Model(&entity.EmployeeMFA{}).
+			Where("username = ? AND failed_attempts < ? AND locked_until IS NULL", username, maxFailures).
+			Update("failed_attempts", gorm.Expr("failed_attempts + 1"))
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return custom_err.ErrMFALocked
+		}
+
+		return tx.Model(&entity.EmployeeMFA{}).
+			Where("username = ? AND failed_attempts >= ?", username, maxFailures).
+			Update("locked_until", now.Add(lockout)).Error
+	})
+}
+
+// ResetMFAAttempts clears the failed verifications and the lockout of the employee after a successful verification
+func (r *MFARepo) ResetMFAAttempts(username string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return r.DB.Model(&entity.EmployeeMFA{}).
+		Where("username = ?", username).
+		Updates(map[string]interface{}{"failed_attempts": 0, "locked_until": nil}).Error
+}
+
+// CreateMFAChallenge stores a new challenge. Expired challenges are removed along the way.
+func (r *MFARepo) CreateMFAChallenge(challenge *entity.MFAChallenge) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return r.DB.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("expires_at <= ?", time.Now()).Delete(&entity.MFAChallenge{}).Error; err != nil {
+			return err
+		}
+		return tx.Create(challenge).Error
+	})
+}
+
+// GetMFAChallengeByHash returns the challenge with the token hash, nil if there is none
+func (r *MFARepo) GetMFAChallengeByHash(tokenHash string) (*entity.MFAChallenge, error) {
+	var challenge entity.MFAChallenge
+	if err := r.DB.Where("token_hash = ?", tokenHash).First(&challenge).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &challenge, nil
+}
+
+// ClaimMFAChallengeAttempt counts a verification of the challenge before its code is checked. It returns
+// ErrInvalidMFAChallenge when the challenge is gone, expired or has used up its attempts, so concurrent requests
+// cannot try more codes than allowed.
+func (r *MFARepo) ClaimMFAChallengeAttempt(id string, maxAttempts int) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	result := r.DB.Model(&entity.MFAChallenge{}).
+		Where("id = ? AND attempts < ? AND expires_at > ?", id, maxAttempts, time.Now()).
+		Update("attempts", gorm.Expr("attempts + 1"))
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return custom_err.ErrInvalidMFAChallenge
+	}
+	return nil
+}
+
+// ConsumeMFAChallenge removes the challenge once it has been verified. It returns ErrInvalidMFAChallenge when the
+// challenge is gone, e.g. because a concurrent request consumed it.
+func (r *MFARepo) ConsumeMFAChallenge(id string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	result := r.DB.Where("id = ?", id).Delete(&entity.MFAChallenge{})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return custom_err.ErrInvalidMFAChallenge
+	}
+	return nil
+}
@@ -0,0 +1,115 @@
+package sqlite
+
+import (
+	"auth-service/internal/domain/entity"
+	custom_err "auth-service/internal/domain/error"
+	"github.com/stretchr/testify/assert"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+	"sync"
+	"testing"
+	"time"
+)
+
+func newTestDB(t *testing.T) *gorm.DB {
+	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{Logger: logger.Default.LogMode(logger.Silent)})
+	assert.NoError(t, err)
+
+	sqlDB, err := db.DB()
+	assert.NoError(t, err)
+	// every connection would open its own in-memory database
+	sqlDB.SetMaxOpenConns(1)
+	t.Cleanup(func() { _ = sqlDB.Close() })
+
+	assert.NoError(t, db.AutoMigrate(&entity.EmployeeMFA{}, &entity.MFARecoveryCode{}, &entity.MFAChallenge{}))
+	return db
+}
+
+// TestMFARepo_ClaimMFAChallengeAttempt_Concurrent tests that concurrent requests cannot claim more attempts than
+// allowed
+func TestMFARepo_ClaimMFAChallengeAttempt_Concurrent(t *testing.T) {
+	repo := NewMFARepo(newTestDB(t))
+
+	challenge, _, err := entity.NewMFAChallenge("testuser", false, time.Minute)
+	assert.NoError(t, err)
+	assert.NoError(t, repo.CreateMFAChallenge(challenge))
+
+	var wg sync.WaitGroup
+	var mu sync.Mutex
+	claimed := 0
+	for i := 0; i < 20; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if err := repo.ClaimMFAChallengeAttempt(challenge.ID, 5); err == nil {
+				mu.Lock()
+				claimed++
+				mu.Unlock()
+			} else {
+				assert.ErrorIs(t, err, custom_err.ErrInvalidMFAChallenge)
+			}
+		}()
+	}
+	wg.Wait()
+
+	assert.Equal(t, 5, claimed)
+}
+
+// TestMFARepo_ClaimMFAChallengeAttempt_Expired tests that an expired challenge cannot be claimed
+func TestMFARepo_ClaimMFAChallengeAttempt_Expired(t *testing.T) {
+	repo := NewMFARepo(newTestDB(t))
+
+	challenge, _, err := entity.NewMFAChallenge("testuser", false, time.Minute)
+	assert.NoError(t, err)
+	challenge.ExpiresAt = time.Now().Add(-time.Second)
+	assert.NoError(t, repo.(*MFARepo).DB.Create(challenge).Error)
+
+	assert.ErrorIs(t, repo.ClaimMFAChallengeAttempt(challenge.ID, 5), custom_err.ErrInvalidMFAChallenge)
+	assert.ErrorIs(t, repo.ClaimMFAChallengeAttempt("unknown", 5), custom_err.ErrInvalidMFAChallenge)
+}
+
+// TestMFARepo_ClaimMFAAttempt_Lockout tests that the employee is locked out over all challenges after too many
+// failed attempts, and that the lockout ends after its duration
+func TestMFARepo_ClaimMFAAttempt_Lockout(t *testing.T) {
+	db := newTestDB(t)
+	repo := NewMFARepo(db)
+	assert.NoError(t, repo.SaveMFA(entity.NewEmployeeMFA("testuser", "SECRET")))
+
+	for i := 0; i < 3; i++ {
+		assert.NoError(t, repo.ClaimMFAAttempt("testuser", 3, time.Hour))
+	}
+	assert.ErrorIs(t, repo.ClaimMFAAttempt("testuser", 3, time.Hour), custom_err.ErrMFALocked)
+
+	mfa, err := repo.GetMFA("testuser")
+	assert.NoError(t, err)
+	assert.Equal(t, 3, mfa.FailedAttempts)
+	assert.NotNil(t, mfa.LockedUntil)
+
+	// the lockout is over
+	assert.NoError(t, db.Model(&entity.EmployeeMFA{}).Where("username = ?", "testuser").Update("locked_until", time.Now().Add(-time.Second)).Error)
+	assert.NoError(t, repo.ClaimMFAAttempt("testuser", 3, time.Hour))
+
+	mfa, err = repo.GetMFA("testuser")
+	assert.NoError(t, err)
+	assert.Equal(t, 1, mfa.FailedAttempts)
+	assert.Nil(t, mfa.LockedUntil)
+}
+
+// TestMFARepo_ResetMFAAttempts tests that a successful verification clears the failed attempts and the lockout the
+// last allowed attempt started
+func TestMFARepo_ResetMFAAttempts(t *testing.T) {
+	repo := NewMFARepo(newTestDB(t))
+	assert.NoError(t, repo.SaveMFA(entity.NewEmployeeMFA("testuser", "SECRET")))
+
+	for i := 0; i < 3; i++ {
+		assert.NoError(t, repo.ClaimMFAAttempt("testuser", 3, time.Hour))
+	}
+	assert.NoError(t, repo.ResetMFAAttempts("testuser"))
+
+	assert.NoError(t, repo.ClaimMFAAttempt("testuser", 3, time.Hour))
+	mfa, err := repo.GetMFA("testuser")
+	assert.NoError(t, err)
+	assert.Equal(t, 1, mfa.FailedAttempts)
+	assert.Nil(t, mfa.LockedUntil)
+}
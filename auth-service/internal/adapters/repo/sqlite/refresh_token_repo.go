@@ -0,0 +1,86 @@
+package sqlite
+
+import (
+	"auth-service/internal/domain/entity"
+	custom_err "auth-service/internal/domain/error"
+	"auth-service/internal/ports"
+	"errors"
+	"gorm.io/gorm"
+	"sync"
+	"time"
+)
+
+// RefreshTokenRepo struct to interact with the database.
+type RefreshTokenRepo struct {
+	DB *gorm.DB
+	mu sync.Mutex
+}
+
+// NewRefreshTokenRepo creates a new RefreshTokenRepo instance with an SQLite connection.
+func NewRefreshTokenRepo(db *gorm.DB) ports.RefreshTokenRepo {
+	return &RefreshTokenRepo{DB: db}
+}
+
+// CreateRefreshToken stores a new refresh token
+func (r *RefreshTokenRepo) CreateRefreshToken(token *entity.RefreshToken) error {
+	return r.DB.Create(token).Error
+}
+
+// GetRefreshTokenByHash returns the refresh token with the hash, nil if there is none
+func (r *RefreshTokenRepo) GetRefreshTokenByHash(tokenHash string) (*entity.RefreshToken, error) {
+	var token entity.RefreshToken
+	if err := r.DB.Where("token_hash = ?", tokenHash).First(&token).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &token, nil
+}
+
+// RotateRefreshToken marks the current token as rotated and stores the next one in one transaction. It returns
+// ErrRefreshTokenReused when the current token is no longer active, e.g. because a concurrent refresh rotated it.
+func (r *RefreshTokenRepo) RotateRefreshToken(current, next *entity.RefreshToken) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return r.DB.Transaction(func(tx *gorm.DB) error {
+		now := time.Now()
+		result := tx.Model(&entity.RefreshToken{}).
+			Where("id = ? AND status = ?", current.ID, entity.RefreshTokenStatusActive).
+			Updates(map[string]interface{}{
+				"status":         entity.RefreshTokenStatusRotated,
+				"replaced_by_id": next.ID,
+				"rotated_at":     now,
+			})
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return custom_err.ErrRefreshTokenReused
+		}
+
+		if err := tx.Create(next).Error; err != nil {
+			return err
+		}
+
+		current.Status = entity.RefreshTokenStatusRotated
+		current.ReplacedByID = next.ID
+		current.RotatedAt = &now
+		return nil
+	})
+}
+
+// RevokeTokenFamily revokes every token of the family that is not revoked yet
+func (r *RefreshTokenRepo) RevokeTokenFamily(familyID, reason string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return r.DB.Model(&entity.RefreshToken{}).
+		Where("family_id = ? AND status <> ?", familyID, entity.RefreshTokenStatusRevoked).
+		Updates(map[string]interface{}{
+			"status":         entity.RefreshTokenStatusRevoked,
+			"revoked_reason": reason,
+			"revoked_at":     time.Now(),
+		}).Error
+}
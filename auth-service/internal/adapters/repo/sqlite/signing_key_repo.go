@@ -0,0 +1,59 @@
+package sqlite
+
+import (
+	"auth-service/internal/domain/entity"
+	"auth-service/internal/ports"
+	"gorm.io/gorm"
+	"sync"
+	"time"
+)
+
+// SigningKeyRepo struct to interact with the database.
+type SigningKeyRepo struct {
+	DB *gorm.DB
+	mu sync.Mutex
+}
+
+// NewSigningKeyRepo creates a new SigningKeyRepo instance with an SQLite connection.
+func NewSigningKeyRepo(db *gorm.DB) ports.SigningKeyRepo {
+	return &SigningKeyRepo{DB: db}
+}
+
+// ListSigningKeys returns the keys that have not expired, oldest first
+func (r *SigningKeyRepo) ListSigningKeys() ([]*entity.SigningKey, error) {
+	var keys []*entity.SigningKey
+	err := r.DB.Where("expires_at IS NULL OR expires_at > ?", time.Now()).
+		Order("created_at ASC").
+		Find(&keys).Error
+	if err != nil {
+		return nil, err
+	}
+	return keys, nil
+}
+
+// CreateSigningKey stores a new key. Expired keys are removed along the way.
+func (r *SigningKeyRepo) CreateSigningKey(key *entity.SigningKey) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return r.DB.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("expires_at <= ?", time.Now()).Delete(&entity.SigningKey{}).Error; err != nil {
+			return err
+		}
+		return tx.Create(key).Error
+	})
+}
+
+// RetireSigningKeys retires the keys replaced by the current key at retiredAt, to expire at expiresAt. Keys that
+// activate later than retiredAt are kept.
+func (r *SigningKeyRepo) RetireSigningKeys(currentID string, retiredAt, expiresAt time.Time) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return r.DB.Model(&entity.SigningKey{}).
+		Where("retired_at IS NULL AND id <> ? AND (activates_at IS NULL OR activates_at <= ?)", currentID, retiredAt).
+		Updates(map[string]interface{}{
+			"retired_at": retiredAt,
+			"expires_at": expiresAt,
+		}).Error
+}
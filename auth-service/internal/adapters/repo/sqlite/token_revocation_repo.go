@@ -0,0 +1,47 @@
+package sqlite
+
+import (
+	"auth-service/internal/domain/entity"
+	"auth-service/internal/ports"
+	"gorm.io/gorm"
+	"sync"
+	"time"
+)
+
+// TokenRevocationRepo struct to interact with the database.
+type TokenRevocationRepo struct {
+	DB *gorm.DB
+	mu sync.Mutex
+}
+
+// NewTokenRevocationRepo creates a new TokenRevocationRepo instance with an SQLite connection.
+func NewTokenRevocationRepo(db *gorm.DB) ports.TokenRevocationRepo {
+	return &TokenRevocationRepo{DB: db}
+}
+
+// CreateTokenRevocation stores a revocation, which gets the next sequence of the feed. Expired revocations are
+// removed along the way, since no token they revoke can be used anymore.
+func (r *TokenRevocationRepo) CreateTokenRevocation(revocation *entity.TokenRevocation) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return r.DB.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("expires_at <= ?", time.Now()).Delete(&entity.TokenRevocation{}).Error; err != nil {
+			return err
+		}
+		return tx.Create(revocation).Error
+	})
+}
+
+// ListTokenRevocations reads up to limit unexpired revocations with a sequence after afterSequence in feed order
+func (r *TokenRevocationRepo) ListTokenRevocations(afterSequence uint64, limit int) ([]*entity.TokenRevocation, error) {
+	var revocations []*entity.TokenRevocation
+	err := r.DB.Where("sequence > ? AND expires_at > ?", afterSequence, time.Now()).
+		Order("sequence ASC").
+		Limit(limit).
+		Find(&revocations).Error
+	if err != nil {
+		return nil, err
+	}
+	return revocations, nil
+}
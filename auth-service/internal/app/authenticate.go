@@ -13,56 +13,153 @@ import (
 
 // Authenticate is the use case for validating user credentials and generating a JWT.
 type Authenticate struct {
-	EmployeeRepo ports.EmployeeRepo
-	TokenSigner  ports.TokenSigner
-	Hashing      ports.Hashing
+	EmployeeRepo     ports.EmployeeRepo
+	TokenSigner      ports.TokenSigner
+	Hashing          ports.Hashing
+	RefreshTokenRepo ports.RefreshTokenRepo
+	MFARepo          ports.MFARepo
+	MFAPolicyRepo    ports.MFAPolicyRepo
+}
+
+// AuthenticateResult holds either the tokens of the login or, when the employee has to pass MFA, the challenge
+// token to exchange for them with VerifyMFA.
+type AuthenticateResult struct {
+	Token                 string
+	RefreshToken          string
+	MFAChallengeToken     string
+	MFAEnrollmentRequired bool // MFA is mandatory for the role, the employee has to enroll with the challenge token
 }
 
 // NewAuthenticate creates a new Authenticate use-case instance.
-func NewAuthenticate(employeeRepo ports.EmployeeRepo, tokenSigner ports.TokenSigner, hashing ports.Hashing) *Authenticate {
+func NewAuthenticate(employeeRepo ports.EmployeeRepo, tokenSigner ports.TokenSigner, hashing ports.Hashing, refreshTokenRepo ports.RefreshTokenRepo, mfaRepo ports.MFARepo, mfaPolicyRepo ports.MFAPolicyRepo) *Authenticate {
 	return &Authenticate{
-		EmployeeRepo: employeeRepo,
-		TokenSigner:  tokenSigner,
-		Hashing:      hashing,
+		EmployeeRepo:     employeeRepo,
+		TokenSigner:      tokenSigner,
+		Hashing:          hashing,
+		RefreshTokenRepo: refreshTokenRepo,
+		MFARepo:          mfaRepo,
+		MFAPolicyRepo:    mfaPolicyRepo,
 	}
 }
 
 // Execute validates the user's credentials (username/password) and returns the JWT token and refresh token.
-func (a *Authenticate) Execute(username, password string) (string, string, error) {
+// The refresh token is an opaque token stored server-side that starts a new token family. Employees who enabled
+// MFA, or whose role requires it, get an MFA challenge token instead.
+func (a *Authenticate) Execute(username, password string) (*AuthenticateResult, error) {
 	if strings.TrimSpace(username) == "" {
 		logging.Logger.Warn().Err(custom_err.ErrInvalidUsername).Str("username", username).Msg("Invalid username")
-		return "", "", custom_err.ErrInvalidUsername
+		return nil, custom_err.ErrInvalidUsername
 	}
 
 	employee, err := a.EmployeeRepo.GetEmployeeByUsername(username)
 	if err != nil {
 		logging.Logger.Warn().Err(err).Str("username", username).Msg("user not found")
-		return "", "", errors.New("invalid credentials or user not found")
+		return nil, errors.New("invalid credentials or user not found")
 	}
 
-	hashedPassword, err := a.Hashing.HashData(password)
+	// Validate password
+	valid, err := a.Hashing.VerifyData(password, employee.Password)
 	if err != nil {
-		logging.Logger.Warn().Err(err).Str("username", username).Msg("unable to hashed secret")
-		return "", "", err
+		logging.Logger.Warn().Err(err).Str("username", username).Msg("unable to verify secret")
+		return nil, errors.New("invalid credentials")
 	}
 
-	// Validate password
-	if employee.Status != entity.EmployeeStatusValid || employee.Password != hashedPassword {
+	if employee.Status != entity.EmployeeStatusValid || !valid {
 		logging.Logger.Warn().Str("username", username).Msg("invalid password or user status invalid")
-		return "", "", errors.New("invalid credentials")
+		return nil, errors.New("invalid credentials")
+	}
+
+	if a.Hashing.NeedsRehash(employee.Password) {
+		a.rehashPassword(employee.Username, password)
+	}
+
+	mfaRequired, enrollmentRequired, err := a.mfaRequired(employee)
+	if err != nil {
+		logging.Logger.Warn().Err(err).Str("username", username).Msg("failed to get MFA status")
+		return nil, fmt.Errorf("failed to check MFA: %w", err)
+	}
+	if mfaRequired {
+		return a.challenge(employee.Username, enrollmentRequired)
 	}
 
-	token, err := a.TokenSigner.SignJWT(employee.Username, employee.Role, config.Current().Auth.JWTSecret, config.Current().Auth.JWTTokentDuration)
+	token, refreshToken, err := issueTokens(a.TokenSigner, a.RefreshTokenRepo, employee)
+	if err != nil {
+		return nil, err
+	}
+
+	return &AuthenticateResult{Token: token, RefreshToken: refreshToken}, nil
+}
+
+// mfaRequired reports whether the login needs a second factor, and whether the employee has to enroll first since
+// MFA is mandatory for their role
+func (a *Authenticate) mfaRequired(employee *entity.Employee) (bool, bool, error) {
+	mfa, err := a.MFARepo.GetMFA(employee.Username)
+	if err != nil {
+		return false, false, err
+	}
+	if mfa != nil && mfa.IsEnabled() {
+		return true, false, nil
+	}
+
+	policy, err := a.MFAPolicyRepo.GetMFAPolicy(employee.Role)
+	if err != nil {
+		return false, false, err
+	}
+	if policy != nil && policy.Required {
+		return true, true, nil
+	}
+
+	return false, false, nil
+}
+
+// challenge starts the second step of the login
+func (a *Authenticate) challenge(username string, enrollmentRequired bool) (*AuthenticateResult, error) {
+	challenge, challengeToken, err := entity.NewMFAChallenge(username, enrollmentRequired, config.Current().Auth.MFAChallengeDuration)
+	if err != nil {
+		logging.Logger.Warn().Err(err).Msg("failed to generate MFA challenge")
+		return nil, fmt.Errorf("failed to generate MFA challenge: %w", err)
+	}
+
+	if err = a.MFARepo.CreateMFAChallenge(challenge); err != nil {
+		logging.Logger.Warn().Err(err).Str("username", username).Msg("failed to store MFA challenge")
+		return nil, fmt.Errorf("failed to generate MFA challenge: %w", err)
+	}
+
+	return &AuthenticateResult{MFAChallengeToken: challengeToken, MFAEnrollmentRequired: enrollmentRequired}, nil
+}
+
+// issueTokens signs a JWT token for the employee and starts a new refresh token family
+func issueTokens(tokenSigner ports.TokenSigner, refreshTokenRepo ports.RefreshTokenRepo, employee *entity.Employee) (string, string, error) {
+	token, err := tokenSigner.SignJWT(employee.Username, employee.Role, config.Current().Auth.JWTTokentDuration)
 	if err != nil {
 		logging.Logger.Warn().Err(err).Msg("failed to generate JWT token")
 		return "", "", fmt.Errorf("failed to generate token: %w", err)
 	}
 
-	refreshToken, err := a.TokenSigner.SignJWTRefreshToken(employee.Username, config.Current().Auth.JWTSecret)
+	refresh, refreshToken, err := entity.NewRefreshToken(employee.Username, "", config.Current().Auth.RefreshTokenDuration)
 	if err != nil {
 		logging.Logger.Warn().Err(err).Msg("failed to generate refresh token")
 		return "", "", fmt.Errorf("failed to generate refresh token: %w", err)
 	}
 
+	if err = refreshTokenRepo.CreateRefreshToken(refresh); err != nil {
+		logging.Logger.Warn().Err(err).Str("username", employee.Username).Msg("failed to store refresh token")
+		return "", "", fmt.Errorf("failed to generate refresh token: %w", err)
+	}
+
 	return token, refreshToken, nil
 }
+
+// rehashPassword replaces a legacy or outdated password hash after a successful login, the only time the plain
+// password is known. A failure is logged and retried on the next login.
+func (a *Authenticate) rehashPassword(username, password string) {
+	hashedPassword, err := a.Hashing.HashData(password)
+	if err != nil {
+		logging.Logger.Warn().Err(err).Str("username", username).Msg("unable to rehash secret")
+		return
+	}
+
+	if err = a.EmployeeRepo.UpdatePassword(username, hashedPassword); err != nil {
+		logging.Logger.Warn().Err(err).Str("username", username).Msg("failed to store rehashed secret")
+	}
+}
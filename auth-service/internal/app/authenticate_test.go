@@ -11,13 +11,28 @@ import (
 	"testing"
 )
 
+// newTestMFARepo returns an MFA repo without enrollments
+func newTestMFARepo() *mock_repo.MockMFARepo {
+	mockMFARepo := new(mock_repo.MockMFARepo)
+	mockMFARepo.On("GetMFA", mock.Anything).Return(nil, nil).Maybe()
+	return mockMFARepo
+}
+
+// newTestMFAPolicyRepo returns an MFA policy repo without policies
+func newTestMFAPolicyRepo() *mock_repo.MockMFAPolicyRepo {
+	mockMFAPolicyRepo := new(mock_repo.MockMFAPolicyRepo)
+	mockMFAPolicyRepo.On("GetMFAPolicy", mock.Anything).Return(nil, nil).Maybe()
+	return mockMFAPolicyRepo
+}
+
 // TestAuthenticate_Execute_Success tests success authentication for correct input
 func TestAuthenticate_Execute_Success(t *testing.T) {
 	mockEmployeeRepo := new(mock_repo.MockEmployeeRepo)
 	mockTokenSigner := new(mock_auth.MockTokenSigner)
 	mockHashing := new(mock_auth.MockHashing)
+	mockRefreshTokenRepo := new(mock_repo.MockRefreshTokenRepo)
 
-	authenticate := NewAuthenticate(mockEmployeeRepo, mockTokenSigner, mockHashing)
+	authenticate := NewAuthenticate(mockEmployeeRepo, mockTokenSigner, mockHashing, mockRefreshTokenRepo, newTestMFARepo(), newTestMFAPolicyRepo())
 
 	username := "testuser"
 	password := "password123"
@@ -30,18 +45,21 @@ func TestAuthenticate_Execute_Success(t *testing.T) {
 		Status:   entity.EmployeeStatusValid,
 	}
 	mockEmployeeRepo.On("GetEmployeeByUsername", username).Return(employee, nil)
-	mockHashing.On("HashData", password).Return(hashedPassword, nil)
+	mockHashing.On("VerifyData", password, hashedPassword).Return(true, nil)
+	mockHashing.On("NeedsRehash", hashedPassword).Return(false)
 
 	expectedToken := "jwt-token-123"
-	expectedRefreshToken := "refresh-token-456"
-	mockTokenSigner.On("SignJWT", username, employee.Role, mock.Anything, mock.Anything).Return(expectedToken, nil)
-	mockTokenSigner.On("SignJWTRefreshToken", username, mock.Anything).Return(expectedRefreshToken, nil)
+	mockTokenSigner.On("SignJWT", username, employee.Role, mock.Anything).Return(expectedToken, nil)
+	mockRefreshTokenRepo.On("CreateRefreshToken", mock.MatchedBy(func(token *entity.RefreshToken) bool {
+		return token.Username == username && token.Status == entity.RefreshTokenStatusActive
+	})).Return(nil)
 
-	token, refreshToken, err := authenticate.Execute(username, password)
+	result, err := authenticate.Execute(username, password)
 
 	assert.NoError(t, err)
-	assert.Equal(t, expectedToken, token)
-	assert.Equal(t, expectedRefreshToken, refreshToken)
+	assert.Equal(t, expectedToken, result.Token)
+	assert.NotEmpty(t, result.RefreshToken)
+	mockRefreshTokenRepo.AssertExpectations(t)
 	mockEmployeeRepo.AssertExpectations(t)
 	mockTokenSigner.AssertExpectations(t)
 	mockHashing.AssertExpectations(t)
@@ -52,23 +70,23 @@ func TestAuthenticate_Execute_ErrorUserNotFound(t *testing.T) {
 	mockEmployeeRepo := new(mock_repo.MockEmployeeRepo)
 	mockTokenSigner := new(mock_auth.MockTokenSigner)
 	mockHashing := new(mock_auth.MockHashing)
+	mockRefreshTokenRepo := new(mock_repo.MockRefreshTokenRepo)
 
-	authenticate := NewAuthenticate(mockEmployeeRepo, mockTokenSigner, mockHashing)
+	authenticate := NewAuthenticate(mockEmployeeRepo, mockTokenSigner, mockHashing, mockRefreshTokenRepo, newTestMFARepo(), newTestMFAPolicyRepo())
 
 	username := "nonexistent"
 	password := "password123"
 
 	mockEmployeeRepo.On("GetEmployeeByUsername", username).Return(nil, fmt.Errorf("user not found"))
 
-	token, refreshToken, err := authenticate.Execute(username, password)
+	result, err := authenticate.Execute(username, password)
 
 	assert.Error(t, err)
 	assert.Equal(t, "invalid credentials or user not found", err.Error())
-	assert.Empty(t, token)
-	assert.Empty(t, refreshToken)
+	assert.Nil(t, result)
 	mockEmployeeRepo.AssertExpectations(t)
 	mockTokenSigner.AssertNotCalled(t, "SignJWT")
-	mockHashing.AssertNotCalled(t, "HashData")
+	mockHashing.AssertNotCalled(t, "VerifyData")
 }
 
 // TestAuthenticate_Execute_ErrorEmptyUsername tests if empty username is provided
@@ -76,18 +94,18 @@ func TestAuthenticate_Execute_ErrorEmptyUsername(t *testing.T) {
 	mockEmployeeRepo := new(mock_repo.MockEmployeeRepo)
 	mockTokenSigner := new(mock_auth.MockTokenSigner)
 	mockHashing := new(mock_auth.MockHashing)
+	mockRefreshTokenRepo := new(mock_repo.MockRefreshTokenRepo)
 
-	authenticate := NewAuthenticate(mockEmployeeRepo, mockTokenSigner, mockHashing)
+	authenticate := NewAuthenticate(mockEmployeeRepo, mockTokenSigner, mockHashing, mockRefreshTokenRepo, newTestMFARepo(), newTestMFAPolicyRepo())
 
-	token, refreshToken, err := authenticate.Execute("", "password123")
+	result, err := authenticate.Execute("", "password123")
 
 	assert.Error(t, err)
 	assert.ErrorIs(t, err, custom_err.ErrInvalidUsername)
-	assert.Empty(t, token)
-	assert.Empty(t, refreshToken)
+	assert.Nil(t, result)
 	mockEmployeeRepo.AssertNotCalled(t, "GetEmployeeByUsername")
 	mockTokenSigner.AssertNotCalled(t, "SignJWT")
-	mockHashing.AssertNotCalled(t, "HashData")
+	mockHashing.AssertNotCalled(t, "VerifyData")
 }
 
 // TestAuthenticate_Execute_ErrorDatabaseFailure tests if database throws error
@@ -95,23 +113,23 @@ func TestAuthenticate_Execute_ErrorDatabaseFailure(t *testing.T) {
 	mockEmployeeRepo := new(mock_repo.MockEmployeeRepo)
 	mockTokenSigner := new(mock_auth.MockTokenSigner)
 	mockHashing := new(mock_auth.MockHashing)
+	mockRefreshTokenRepo := new(mock_repo.MockRefreshTokenRepo)
 
-	authenticate := NewAuthenticate(mockEmployeeRepo, mockTokenSigner, mockHashing)
+	authenticate := NewAuthenticate(mockEmployeeRepo, mockTokenSigner, mockHashing, mockRefreshTokenRepo, newTestMFARepo(), newTestMFAPolicyRepo())
 
 	username := "testuser"
 	password := "password123"
 
 	mockEmployeeRepo.On("GetEmployeeByUsername", username).Return(nil, fmt.Errorf("database connection failed"))
 
-	token, refreshToken, err := authenticate.Execute(username, password)
+	result, err := authenticate.Execute(username, password)
 
 	assert.Error(t, err)
 	assert.Equal(t, "invalid credentials or user not found", err.Error())
-	assert.Empty(t, token)
-	assert.Empty(t, refreshToken)
+	assert.Nil(t, result)
 	mockEmployeeRepo.AssertExpectations(t)
 	mockTokenSigner.AssertNotCalled(t, "SignJWT")
-	mockHashing.AssertNotCalled(t, "HashData")
+	mockHashing.AssertNotCalled(t, "VerifyData")
 }
 
 // TestAuthenticate_Execute_ErrorInvalidPassword if password is inavlid
@@ -119,13 +137,13 @@ func TestAuthenticate_Execute_ErrorInvalidPassword(t *testing.T) {
 	mockEmployeeRepo := new(mock_repo.MockEmployeeRepo)
 	mockTokenSigner := new(mock_auth.MockTokenSigner)
 	mockHashing := new(mock_auth.MockHashing)
+	mockRefreshTokenRepo := new(mock_repo.MockRefreshTokenRepo)
 
-	authenticate := NewAuthenticate(mockEmployeeRepo, mockTokenSigner, mockHashing)
+	authenticate := NewAuthenticate(mockEmployeeRepo, mockTokenSigner, mockHashing, mockRefreshTokenRepo, newTestMFARepo(), newTestMFAPolicyRepo())
 
 	username := "testuser"
 	password := "wrongpassword"
 	storedHashedPassword := "stored_hashed_password"
-	inputHashedPassword := "input_hashed_password" // Different from stored
 
 	employee := &entity.Employee{
 		Username: username,
@@ -134,14 +152,13 @@ func TestAuthenticate_Execute_ErrorInvalidPassword(t *testing.T) {
 		Status:   entity.EmployeeStatusValid,
 	}
 	mockEmployeeRepo.On("GetEmployeeByUsername", username).Return(employee, nil)
-	mockHashing.On("HashData", password).Return(inputHashedPassword, nil)
+	mockHashing.On("VerifyData", password, storedHashedPassword).Return(false, nil)
 
-	token, refreshToken, err := authenticate.Execute(username, password)
+	result, err := authenticate.Execute(username, password)
 
 	assert.Error(t, err)
 	assert.Equal(t, "invalid credentials", err.Error())
-	assert.Empty(t, token)
-	assert.Empty(t, refreshToken)
+	assert.Nil(t, result)
 	mockEmployeeRepo.AssertExpectations(t)
 	mockTokenSigner.AssertNotCalled(t, "SignJWT")
 	mockHashing.AssertExpectations(t)
@@ -152,8 +169,9 @@ func TestAuthenticate_Execute_ErrorUserInvalidStatus(t *testing.T) {
 	mockEmployeeRepo := new(mock_repo.MockEmployeeRepo)
 	mockTokenSigner := new(mock_auth.MockTokenSigner)
 	mockHashing := new(mock_auth.MockHashing)
+	mockRefreshTokenRepo := new(mock_repo.MockRefreshTokenRepo)
 
-	authenticate := NewAuthenticate(mockEmployeeRepo, mockTokenSigner, mockHashing)
+	authenticate := NewAuthenticate(mockEmployeeRepo, mockTokenSigner, mockHashing, mockRefreshTokenRepo, newTestMFARepo(), newTestMFAPolicyRepo())
 
 	username := "testuser"
 	password := "password123"
@@ -166,26 +184,26 @@ func TestAuthenticate_Execute_ErrorUserInvalidStatus(t *testing.T) {
 		Status:   entity.EmployeeActiveStatusDeactivated,
 	}
 	mockEmployeeRepo.On("GetEmployeeByUsername", username).Return(employee, nil)
-	mockHashing.On("HashData", password).Return(hashedPassword, nil)
+	mockHashing.On("VerifyData", password, hashedPassword).Return(true, nil)
 
-	token, refreshToken, err := authenticate.Execute(username, password)
+	result, err := authenticate.Execute(username, password)
 
 	assert.Error(t, err)
 	assert.Equal(t, "invalid credentials", err.Error())
-	assert.Empty(t, token)
-	assert.Empty(t, refreshToken)
+	assert.Nil(t, result)
 	mockEmployeeRepo.AssertExpectations(t)
 	mockTokenSigner.AssertNotCalled(t, "SignJWT")
 	mockHashing.AssertExpectations(t)
 }
 
-// TestAuthenticate_Execute_ErrorUserInvalidStatus tests hashing failure
+// TestAuthenticate_Execute_ErrorHashingFailure tests that a stored hash which cannot be verified is rejected
 func TestAuthenticate_Execute_ErrorHashingFailure(t *testing.T) {
 	mockEmployeeRepo := new(mock_repo.MockEmployeeRepo)
 	mockTokenSigner := new(mock_auth.MockTokenSigner)
 	mockHashing := new(mock_auth.MockHashing)
+	mockRefreshTokenRepo := new(mock_repo.MockRefreshTokenRepo)
 
-	authenticate := NewAuthenticate(mockEmployeeRepo, mockTokenSigner, mockHashing)
+	authenticate := NewAuthenticate(mockEmployeeRepo, mockTokenSigner, mockHashing, mockRefreshTokenRepo, newTestMFARepo(), newTestMFAPolicyRepo())
 
 	username := "testuser"
 	password := "password123"
@@ -197,14 +215,13 @@ func TestAuthenticate_Execute_ErrorHashingFailure(t *testing.T) {
 		Status:   entity.EmployeeStatusValid,
 	}
 	mockEmployeeRepo.On("GetEmployeeByUsername", username).Return(employee, nil)
-	mockHashing.On("HashData", password).Return("", fmt.Errorf("hashing error"))
+	mockHashing.On("VerifyData", password, "hashed_password").Return(false, fmt.Errorf("malformed password hash"))
 
-	token, refreshToken, err := authenticate.Execute(username, password)
+	result, err := authenticate.Execute(username, password)
 
 	assert.Error(t, err)
-	assert.Equal(t, "hashing error", err.Error())
-	assert.Empty(t, token)
-	assert.Empty(t, refreshToken)
+	assert.Equal(t, "invalid credentials", err.Error())
+	assert.Nil(t, result)
 	mockEmployeeRepo.AssertExpectations(t)
 	mockTokenSigner.AssertNotCalled(t, "SignJWT")
 	mockHashing.AssertExpectations(t)
@@ -215,8 +232,9 @@ func TestAuthenticate_Execute_ErrorJWTSigningFailure(t *testing.T) {
 	mockEmployeeRepo := new(mock_repo.MockEmployeeRepo)
 	mockTokenSigner := new(mock_auth.MockTokenSigner)
 	mockHashing := new(mock_auth.MockHashing)
+	mockRefreshTokenRepo := new(mock_repo.MockRefreshTokenRepo)
 
-	authenticate := NewAuthenticate(mockEmployeeRepo, mockTokenSigner, mockHashing)
+	authenticate := NewAuthenticate(mockEmployeeRepo, mockTokenSigner, mockHashing, mockRefreshTokenRepo, newTestMFARepo(), newTestMFAPolicyRepo())
 
 	username := "testuser"
 	password := "password123"
@@ -229,30 +247,31 @@ func TestAuthenticate_Execute_ErrorJWTSigningFailure(t *testing.T) {
 		Status:   entity.EmployeeStatusValid,
 	}
 	mockEmployeeRepo.On("GetEmployeeByUsername", username).Return(employee, nil)
-	mockHashing.On("HashData", password).Return(hashedPassword, nil)
+	mockHashing.On("VerifyData", password, hashedPassword).Return(true, nil)
+	mockHashing.On("NeedsRehash", hashedPassword).Return(false)
 
 	// Mock JWT signing to fail
-	mockTokenSigner.On("SignJWT", username, employee.Role, mock.Anything, mock.Anything).Return("", fmt.Errorf("jwt signing error"))
+	mockTokenSigner.On("SignJWT", username, employee.Role, mock.Anything).Return("", fmt.Errorf("jwt signing error"))
 
-	token, refreshToken, err := authenticate.Execute(username, password)
+	result, err := authenticate.Execute(username, password)
 
 	assert.Error(t, err)
 	assert.Contains(t, err.Error(), "failed to generate token")
-	assert.Empty(t, token)
-	assert.Empty(t, refreshToken)
+	assert.Nil(t, result)
 	mockEmployeeRepo.AssertExpectations(t)
 	mockTokenSigner.AssertExpectations(t)
 	mockHashing.AssertExpectations(t)
-	mockTokenSigner.AssertNotCalled(t, "SignJWTRefreshToken")
+	mockRefreshTokenRepo.AssertNotCalled(t, "CreateRefreshToken", mock.Anything)
 }
 
-// TestAuthenticate_Execute_ErrorRefreshTokenSigningFailure test refresh token signing failure
-func TestAuthenticate_Execute_ErrorRefreshTokenSigningFailure(t *testing.T) {
+// TestAuthenticate_Execute_ErrorRefreshTokenStoreFailure test refresh token store failure
+func TestAuthenticate_Execute_ErrorRefreshTokenStoreFailure(t *testing.T) {
 	mockEmployeeRepo := new(mock_repo.MockEmployeeRepo)
 	mockTokenSigner := new(mock_auth.MockTokenSigner)
 	mockHashing := new(mock_auth.MockHashing)
+	mockRefreshTokenRepo := new(mock_repo.MockRefreshTokenRepo)
 
-	authenticate := NewAuthenticate(mockEmployeeRepo, mockTokenSigner, mockHashing)
+	authenticate := NewAuthenticate(mockEmployeeRepo, mockTokenSigner, mockHashing, mockRefreshTokenRepo, newTestMFARepo(), newTestMFAPolicyRepo())
 
 	username := "testuser"
 	password := "password123"
@@ -265,19 +284,19 @@ func TestAuthenticate_Execute_ErrorRefreshTokenSigningFailure(t *testing.T) {
 		Status:   entity.EmployeeStatusValid,
 	}
 	mockEmployeeRepo.On("GetEmployeeByUsername", username).Return(employee, nil)
-	mockHashing.On("HashData", password).Return(hashedPassword, nil)
+	mockHashing.On("VerifyData", password, hashedPassword).Return(true, nil)
+	mockHashing.On("NeedsRehash", hashedPassword).Return(false)
 
 	// Mock JWT signing success but refresh token failure
 	expectedToken := "jwt-token-123"
-	mockTokenSigner.On("SignJWT", username, employee.Role, mock.Anything, mock.Anything).Return(expectedToken, nil)
-	mockTokenSigner.On("SignJWTRefreshToken", username, mock.Anything).Return("", fmt.Errorf("refresh token error"))
+	mockTokenSigner.On("SignJWT", username, employee.Role, mock.Anything).Return(expectedToken, nil)
+	mockRefreshTokenRepo.On("CreateRefreshToken", mock.AnythingOfType("*entity.RefreshToken")).Return(fmt.Errorf("refresh token error"))
 
-	token, refreshToken, err := authenticate.Execute(username, password)
+	result, err := authenticate.Execute(username, password)
 
 	assert.Error(t, err)
 	assert.Contains(t, err.Error(), "failed to generate refresh token")
-	assert.Empty(t, token) // Even though JWT was generated, we return error so both should be empty
-	assert.Empty(t, refreshToken)
+	assert.Nil(t, result) // Even though JWT was generated, we return error so no tokens are handed out
 	mockEmployeeRepo.AssertExpectations(t)
 	mockTokenSigner.AssertExpectations(t)
 	mockHashing.AssertExpectations(t)
@@ -292,8 +311,9 @@ func TestAuthenticate_Execute_SuccessDifferentRoles(t *testing.T) {
 			mockEmployeeRepo := new(mock_repo.MockEmployeeRepo)
 			mockTokenSigner := new(mock_auth.MockTokenSigner)
 			mockHashing := new(mock_auth.MockHashing)
+			mockRefreshTokenRepo := new(mock_repo.MockRefreshTokenRepo)
 
-			authenticate := NewAuthenticate(mockEmployeeRepo, mockTokenSigner, mockHashing)
+			authenticate := NewAuthenticate(mockEmployeeRepo, mockTokenSigner, mockHashing, mockRefreshTokenRepo, newTestMFARepo(), newTestMFAPolicyRepo())
 
 			username := "testuser"
 			password := "password123"
@@ -306,18 +326,21 @@ func TestAuthenticate_Execute_SuccessDifferentRoles(t *testing.T) {
 				Status:   entity.EmployeeStatusValid,
 			}
 			mockEmployeeRepo.On("GetEmployeeByUsername", username).Return(employee, nil)
-			mockHashing.On("HashData", password).Return(hashedPassword, nil)
+			mockHashing.On("VerifyData", password, hashedPassword).Return(true, nil)
+			mockHashing.On("NeedsRehash", hashedPassword).Return(false)
 
 			expectedToken := "jwt-token-" + role
-			expectedRefreshToken := "refresh-token-" + role
-			mockTokenSigner.On("SignJWT", username, role, mock.Anything, mock.Anything).Return(expectedToken, nil)
-			mockTokenSigner.On("SignJWTRefreshToken", username, mock.Anything).Return(expectedRefreshToken, nil)
+			mockTokenSigner.On("SignJWT", username, role, mock.Anything).Return(expectedToken, nil)
+			mockRefreshTokenRepo.On("CreateRefreshToken", mock.MatchedBy(func(token *entity.RefreshToken) bool {
+				return token.Username == username && token.Status == entity.RefreshTokenStatusActive
+			})).Return(nil)
 
-			token, refreshToken, err := authenticate.Execute(username, password)
+			result, err := authenticate.Execute(username, password)
 
 			assert.NoError(t, err)
-			assert.Equal(t, expectedToken, token)
-			assert.Equal(t, expectedRefreshToken, refreshToken)
+			assert.Equal(t, expectedToken, result.Token)
+			assert.NotEmpty(t, result.RefreshToken)
+			mockRefreshTokenRepo.AssertExpectations(t)
 			mockEmployeeRepo.AssertExpectations(t)
 			mockTokenSigner.AssertExpectations(t)
 			mockHashing.AssertExpectations(t)
@@ -330,27 +353,26 @@ func TestAuthenticate_Execute_ErrorEmptyPassword(t *testing.T) {
 	mockEmployeeRepo := new(mock_repo.MockEmployeeRepo)
 	mockTokenSigner := new(mock_auth.MockTokenSigner)
 	mockHashing := new(mock_auth.MockHashing)
+	mockRefreshTokenRepo := new(mock_repo.MockRefreshTokenRepo)
 
-	authenticate := NewAuthenticate(mockEmployeeRepo, mockTokenSigner, mockHashing)
+	authenticate := NewAuthenticate(mockEmployeeRepo, mockTokenSigner, mockHashing, mockRefreshTokenRepo, newTestMFARepo(), newTestMFAPolicyRepo())
 
 	username := "testuser"
-	emptyPasswordHash := "hashed_empty_string"
 
 	employee := &entity.Employee{
 		Username: username,
-		Password: "different_hashed_password", // Different from empty password hash
+		Password: "different_hashed_password",
 		Role:     "admin",
 		Status:   entity.EmployeeStatusValid,
 	}
 	mockEmployeeRepo.On("GetEmployeeByUsername", username).Return(employee, nil)
-	mockHashing.On("HashData", "").Return(emptyPasswordHash, nil)
+	mockHashing.On("VerifyData", "", "different_hashed_password").Return(false, nil)
 
-	token, refreshToken, err := authenticate.Execute(username, "")
+	result, err := authenticate.Execute(username, "")
 
 	assert.Error(t, err)
 	assert.Equal(t, "invalid credentials", err.Error())
-	assert.Empty(t, token)
-	assert.Empty(t, refreshToken)
+	assert.Nil(t, result)
 	mockEmployeeRepo.AssertExpectations(t)
 	mockTokenSigner.AssertNotCalled(t, "SignJWT")
 	mockHashing.AssertExpectations(t)
@@ -361,8 +383,9 @@ func TestAuthenticate_Execute_SuccessWithSpecialCharacters(t *testing.T) {
 	mockEmployeeRepo := new(mock_repo.MockEmployeeRepo)
 	mockTokenSigner := new(mock_auth.MockTokenSigner)
 	mockHashing := new(mock_auth.MockHashing)
+	mockRefreshTokenRepo := new(mock_repo.MockRefreshTokenRepo)
 
-	authenticate := NewAuthenticate(mockEmployeeRepo, mockTokenSigner, mockHashing)
+	authenticate := NewAuthenticate(mockEmployeeRepo, mockTokenSigner, mockHashing, mockRefreshTokenRepo, newTestMFARepo(), newTestMFAPolicyRepo())
 
 	username := "user@domain.com"
 	password := "p@ssw0rd!@#$%^&*()"
@@ -375,19 +398,178 @@ func TestAuthenticate_Execute_SuccessWithSpecialCharacters(t *testing.T) {
 		Status:   entity.EmployeeStatusValid,
 	}
 	mockEmployeeRepo.On("GetEmployeeByUsername", username).Return(employee, nil)
-	mockHashing.On("HashData", password).Return(hashedPassword, nil)
+	mockHashing.On("VerifyData", password, hashedPassword).Return(true, nil)
+	mockHashing.On("NeedsRehash", hashedPassword).Return(false)
 
 	expectedToken := "jwt-token-complex"
-	expectedRefreshToken := "refresh-token-complex"
-	mockTokenSigner.On("SignJWT", username, employee.Role, mock.Anything, mock.Anything).Return(expectedToken, nil)
-	mockTokenSigner.On("SignJWTRefreshToken", username, mock.Anything).Return(expectedRefreshToken, nil)
+	mockTokenSigner.On("SignJWT", username, employee.Role, mock.Anything).Return(expectedToken, nil)
+	mockRefreshTokenRepo.On("CreateRefreshToken", mock.MatchedBy(func(token *entity.RefreshToken) bool {
+		return token.Username == username && token.Status == entity.RefreshTokenStatusActive
+	})).Return(nil)
 
-	token, refreshToken, err := authenticate.Execute(username, password)
+	result, err := authenticate.Execute(username, password)
 
 	assert.NoError(t, err)
-	assert.Equal(t, expectedToken, token)
-	assert.Equal(t, expectedRefreshToken, refreshToken)
+	assert.Equal(t, expectedToken, result.Token)
+	assert.NotEmpty(t, result.RefreshToken)
+	mockRefreshTokenRepo.AssertExpectations(t)
 	mockEmployeeRepo.AssertExpectations(t)
 	mockTokenSigner.AssertExpectations(t)
 	mockHashing.AssertExpectations(t)
 }
+
+// TestAuthenticate_Execute_RehashesLegacyPassword tests that a legacy hash is replaced after a successful login
+func TestAuthenticate_Execute_RehashesLegacyPassword(t *testing.T) {
+	mockEmployeeRepo := new(mock_repo.MockEmployeeRepo)
+	mockTokenSigner := new(mock_auth.MockTokenSigner)
+	mockHashing := new(mock_auth.MockHashing)
+	mockRefreshTokenRepo := new(mock_repo.MockRefreshTokenRepo)
+
+	authenticate := NewAuthenticate(mockEmployeeRepo, mockTokenSigner, mockHashing, mockRefreshTokenRepo, newTestMFARepo(), newTestMFAPolicyRepo())
+
+	username := "testuser"
+	password := "password123"
+	legacyHash := "legacy_hmac_hash"
+	upgradedHash := "$argon2id$v=19$m=65536,t=3,p=4$salt$hash"
+
+	employee := &entity.Employee{
+		Username: username,
+		Password: legacyHash,
+		Role:     "admin",
+		Status:   entity.EmployeeStatusValid,
+	}
+	mockEmployeeRepo.On("GetEmployeeByUsername", username).Return(employee, nil)
+	mockHashing.On("VerifyData", password, legacyHash).Return(true, nil)
+	mockHashing.On("NeedsRehash", legacyHash).Return(true)
+	mockHashing.On("HashData", password).Return(upgradedHash, nil)
+	mockEmployeeRepo.On("UpdatePassword", username, upgradedHash).Return(nil)
+	mockTokenSigner.On("SignJWT", username, employee.Role, mock.Anything).Return("jwt-token-123", nil)
+	mockRefreshTokenRepo.On("CreateRefreshToken", mock.AnythingOfType("*entity.RefreshToken")).Return(nil)
+
+	result, err := authenticate.Execute(username, password)
+
+	assert.NoError(t, err)
+	assert.Equal(t, "jwt-token-123", result.Token)
+	mockEmployeeRepo.AssertExpectations(t)
+	mockHashing.AssertExpectations(t)
+}
+
+// TestAuthenticate_Execute_RehashFailureStillLogsIn tests that a failed upgrade does not fail the login
+func TestAuthenticate_Execute_RehashFailureStillLogsIn(t *testing.T) {
+	mockEmployeeRepo := new(mock_repo.MockEmployeeRepo)
+	mockTokenSigner := new(mock_auth.MockTokenSigner)
+	mockHashing := new(mock_auth.MockHashing)
+	mockRefreshTokenRepo := new(mock_repo.MockRefreshTokenRepo)
+
+	authenticate := NewAuthenticate(mockEmployeeRepo, mockTokenSigner, mockHashing, mockRefreshTokenRepo, newTestMFARepo(), newTestMFAPolicyRepo())
+
+	username := "testuser"
+	password := "password123"
+	legacyHash := "legacy_hmac_hash"
+
+	employee := &entity.Employee{
+		Username: username,
+		Password: legacyHash,
+		Role:     "admin",
+		Status:   entity.EmployeeStatusValid,
+	}
+	mockEmployeeRepo.On("GetEmployeeByUsername", username).Return(employee, nil)
+	mockHashing.On("VerifyData", password, legacyHash).Return(true, nil)
+	mockHashing.On("NeedsRehash", legacyHash).Return(true)
+	mockHashing.On("HashData", password).Return("upgraded_hash", nil)
+	mockEmployeeRepo.On("UpdatePassword", username, "upgraded_hash").Return(fmt.Errorf("database locked"))
+	mockTokenSigner.On("SignJWT", username, employee.Role, mock.Anything).Return("jwt-token-123", nil)
+	mockRefreshTokenRepo.On("CreateRefreshToken", mock.AnythingOfType("*entity.RefreshToken")).Return(nil)
+
+	result, err := authenticate.Execute(username, password)
+
+	assert.NoError(t, err)
+	assert.Equal(t, "jwt-token-123", result.Token)
+	assert.NotEmpty(t, result.RefreshToken)
+	mockEmployeeRepo.AssertExpectations(t)
+}
+
+// TestAuthenticate_Execute_MFAChallenge tests that an employee with enabled MFA gets a challenge instead of tokens
+func TestAuthenticate_Execute_MFAChallenge(t *testing.T) {
+	mockEmployeeRepo := new(mock_repo.MockEmployeeRepo)
+	mockTokenSigner := new(mock_auth.MockTokenSigner)
+	mockHashing := new(mock_auth.MockHashing)
+	mockRefreshTokenRepo := new(mock_repo.MockRefreshTokenRepo)
+	mockMFARepo := new(mock_repo.MockMFARepo)
+
+	authenticate := NewAuthenticate(mockEmployeeRepo, mockTokenSigner, mockHashing, mockRefreshTokenRepo, mockMFARepo, newTestMFAPolicyRepo())
+
+	employee := &entity.Employee{Username: "testuser", Password: "hashed", Role: "admin", Status: entity.EmployeeStatusValid}
+	mockEmployeeRepo.On("GetEmployeeByUsername", "testuser").Return(employee, nil)
+	mockHashing.On("VerifyData", "password123", "hashed").Return(true, nil)
+	mockHashing.On("NeedsRehash", "hashed").Return(false)
+	mockMFARepo.On("GetMFA", "testuser").Return(&entity.EmployeeMFA{Username: "testuser", Status: entity.MFAStatusEnabled}, nil)
+	mockMFARepo.On("CreateMFAChallenge", mock.MatchedBy(func(challenge *entity.MFAChallenge) bool {
+		return challenge.Username == "testuser" && !challenge.EnrollmentRequired
+	})).Return(nil)
+
+	result, err := authenticate.Execute("testuser", "password123")
+
+	assert.NoError(t, err)
+	assert.Empty(t, result.Token)
+	assert.Empty(t, result.RefreshToken)
+	assert.NotEmpty(t, result.MFAChallengeToken)
+	assert.False(t, result.MFAEnrollmentRequired)
+	mockMFARepo.AssertExpectations(t)
+	mockTokenSigner.AssertNotCalled(t, "SignJWT", mock.Anything, mock.Anything, mock.Anything)
+	mockRefreshTokenRepo.AssertNotCalled(t, "CreateRefreshToken", mock.Anything)
+}
+
+// TestAuthenticate_Execute_MFAEnrollmentRequired tests that an employee of a role requiring MFA has to enroll
+func TestAuthenticate_Execute_MFAEnrollmentRequired(t *testing.T) {
+	mockEmployeeRepo := new(mock_repo.MockEmployeeRepo)
+	mockTokenSigner := new(mock_auth.MockTokenSigner)
+	mockHashing := new(mock_auth.MockHashing)
+	mockMFARepo := new(mock_repo.MockMFARepo)
+	mockMFAPolicyRepo := new(mock_repo.MockMFAPolicyRepo)
+
+	authenticate := NewAuthenticate(mockEmployeeRepo, mockTokenSigner, mockHashing, new(mock_repo.MockRefreshTokenRepo), mockMFARepo, mockMFAPolicyRepo)
+
+	employee := &entity.Employee{Username: "testuser", Password: "hashed", Role: "admin", Status: entity.EmployeeStatusValid}
+	mockEmployeeRepo.On("GetEmployeeByUsername", "testuser").Return(employee, nil)
+	mockHashing.On("VerifyData", "password123", "hashed").Return(true, nil)
+	mockHashing.On("NeedsRehash", "hashed").Return(false)
+	// a pending enrollment does not count as enabled MFA
+	mockMFARepo.On("GetMFA", "testuser").Return(&entity.EmployeeMFA{Username: "testuser", Status: entity.MFAStatusPending}, nil)
+	mockMFAPolicyRepo.On("GetMFAPolicy", "admin").Return(&entity.MFAPolicy{Role: "admin", Required: true}, nil)
+	mockMFARepo.On("CreateMFAChallenge", mock.MatchedBy(func(challenge *entity.MFAChallenge) bool {
+		return challenge.Username == "testuser" && challenge.EnrollmentRequired
+	})).Return(nil)
+
+	result, err := authenticate.Execute("testuser", "password123")
+
+	assert.NoError(t, err)
+	assert.Empty(t, result.Token)
+	assert.NotEmpty(t, result.MFAChallengeToken)
+	assert.True(t, result.MFAEnrollmentRequired)
+	mockMFARepo.AssertExpectations(t)
+	mockMFAPolicyRepo.AssertExpectations(t)
+	mockTokenSigner.AssertNotCalled(t, "SignJWT", mock.Anything, mock.Anything, mock.Anything)
+}
+
+// TestAuthenticate_Execute_MFAStatusFailure tests that a login fails when the MFA status cannot be read
+func TestAuthenticate_Execute_MFAStatusFailure(t *testing.T) {
+	mockEmployeeRepo := new(mock_repo.MockEmployeeRepo)
+	mockTokenSigner := new(mock_auth.MockTokenSigner)
+	mockHashing := new(mock_auth.MockHashing)
+	mockMFARepo := new(mock_repo.MockMFARepo)
+
+	authenticate := NewAuthenticate(mockEmployeeRepo, mockTokenSigner, mockHashing, new(mock_repo.MockRefreshTokenRepo), mockMFARepo, newTestMFAPolicyRepo())
+
+	employee := &entity.Employee{Username: "testuser", Password: "hashed", Role: "admin", Status: entity.EmployeeStatusValid}
+	mockEmployeeRepo.On("GetEmployeeByUsername", "testuser").Return(employee, nil)
+	mockHashing.On("VerifyData", "password123", "hashed").Return(true, nil)
+	mockHashing.On("NeedsRehash", "hashed").Return(false)
+	mockMFARepo.On("GetMFA", "testuser").Return(nil, fmt.Errorf("database error"))
+
+	result, err := authenticate.Execute("testuser", "password123")
+
+	assert.Error(t, err)
+	assert.Nil(t, result)
+	mockTokenSigner.AssertNotCalled(t, "SignJWT", mock.Anything, mock.Anything, mock.Anything)
+}
@@ -0,0 +1,73 @@
+package app
+
+import (
+	custom_err "auth-service/internal/domain/error"
+	"auth-service/internal/logging"
+	"auth-service/internal/observability/metrics"
+	"auth-service/internal/ports"
+	"errors"
+	"strings"
+)
+
+// ConfirmMFA is the use case for enabling an enrolled authenticator app of a logged-in employee.
+type ConfirmMFA struct {
+	MFARepo ports.MFARepo
+	TOTP    ports.TOTP
+}
+
+// NewConfirmMFA creates a new ConfirmMFA use-case instance.
+func NewConfirmMFA(mfaRepo ports.MFARepo, totp ports.TOTP) *ConfirmMFA {
+	return &ConfirmMFA{
+		MFARepo: mfaRepo,
+		TOTP:    totp,
+	}
+}
+
+// Execute enables the pending MFA of the employee with the first code of the authenticator app and returns the
+// recovery codes, which are only shown this once.
+func (c *ConfirmMFA) Execute(username, code string) ([]string, string, error) {
+	metrics.IncRequestActive()
+	defer metrics.DecRequestActive()
+
+	var err error
+	defer func() {
+		metrics.RecordOperation("confirm_mfa", err)
+	}()
+
+	username = strings.TrimSpace(username)
+	code = strings.TrimSpace(code)
+	if username == "" || code == "" {
+		logging.Logger.Warn().Msg("Invalid request")
+		err = custom_err.ErrMissingRequiredData
+		return nil, "Missing required data username and code", err
+	}
+
+	mfa, err := c.MFARepo.GetMFA(username)
+	if err != nil {
+		logging.Logger.Error().Err(err).Str("username", username).Msg("failed to get MFA")
+		err = custom_err.ErrDatabase
+		return nil, "Failed to enable MFA", err
+	}
+	if mfa == nil {
+		err = custom_err.ErrMFANotEnrolled
+		return nil, "MFA is not enrolled", err
+	}
+	if mfa.IsEnabled() {
+		err = custom_err.ErrMFAAlreadyEnabled
+		return nil, "MFA is already enabled", err
+	}
+
+	recoveryCodes, err := activateMFA(c.MFARepo, c.TOTP, mfa, code)
+	if err != nil {
+		logging.Logger.Warn().Err(err).Str("username", username).Msg("failed to enable MFA")
+		switch {
+		case errors.Is(err, custom_err.ErrInvalidMFACode):
+			return nil, "Invalid code", err
+		case errors.Is(err, custom_err.ErrMFAAlreadyEnabled):
+			return nil, "MFA is already enabled", err
+		}
+		return nil, "Failed to enable MFA", err
+	}
+
+	return recoveryCodes, "MFA enabled successfully", nil
+}
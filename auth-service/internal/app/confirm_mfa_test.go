@@ -0,0 +1,80 @@
+package app
+
+import (
+	"auth-service/internal/domain/entity"
+	custom_err "auth-service/internal/domain/error"
+	mock_auth "auth-service/internal/ports/mocks/auth"
+	mock_repo "auth-service/internal/ports/mocks/repo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"testing"
+)
+
+// TestConfirmMFA_Execute_Success tests that the first code enables MFA and returns the recovery codes
+func TestConfirmMFA_Execute_Success(t *testing.T) {
+	mockMFARepo := new(mock_repo.MockMFARepo)
+	mockTOTP := new(mock_auth.MockTOTP)
+
+	confirm := NewConfirmMFA(mockMFARepo, mockTOTP)
+
+	mockMFARepo.On("GetMFA", "testuser").Return(&entity.EmployeeMFA{Username: "testuser", Secret: "SECRET", Status: entity.MFAStatusPending}, nil)
+	mockTOTP.On("Validate", "SECRET", "123456", mock.Anything).Return(int64(42), true)
+	mockMFARepo.On("EnableMFA", "testuser", int64(42), mock.MatchedBy(func(codes []*entity.MFARecoveryCode) bool {
+		return len(codes) == entity.MFARecoveryCodeCount && codes[0].Username == "testuser" && codes[0].UsedAt == nil
+	})).Return(nil)
+
+	recoveryCodes, message, err := confirm.Execute("testuser", "123456")
+
+	assert.NoError(t, err)
+	assert.Equal(t, "MFA enabled successfully", message)
+	assert.Len(t, recoveryCodes, entity.MFARecoveryCodeCount)
+	assert.Regexp(t, `^[a-z2-7]{5}-[a-z2-7]{5}$`, recoveryCodes[0])
+	mockMFARepo.AssertExpectations(t)
+}
+
+// TestConfirmMFA_Execute_InvalidCode tests that a wrong code keeps MFA pending
+func TestConfirmMFA_Execute_InvalidCode(t *testing.T) {
+	mockMFARepo := new(mock_repo.MockMFARepo)
+	mockTOTP := new(mock_auth.MockTOTP)
+
+	confirm := NewConfirmMFA(mockMFARepo, mockTOTP)
+
+	mockMFARepo.On("GetMFA", "testuser").Return(&entity.EmployeeMFA{Username: "testuser", Secret: "SECRET", Status: entity.MFAStatusPending}, nil)
+	mockTOTP.On("Validate", "SECRET", "000000", mock.Anything).Return(int64(0), false)
+
+	recoveryCodes, message, err := confirm.Execute("testuser", "000000")
+
+	assert.ErrorIs(t, err, custom_err.ErrInvalidMFACode)
+	assert.Equal(t, "Invalid code", message)
+	assert.Nil(t, recoveryCodes)
+	mockMFARepo.AssertNotCalled(t, "EnableMFA", mock.Anything, mock.Anything, mock.Anything)
+}
+
+// TestConfirmMFA_Execute_NotPending tests employees without a pending enrollment
+func TestConfirmMFA_Execute_NotPending(t *testing.T) {
+	tests := []struct {
+		name    string
+		mfa     *entity.EmployeeMFA
+		err     error
+		message string
+	}{
+		{"NotEnrolled", nil, custom_err.ErrMFANotEnrolled, "MFA is not enrolled"},
+		{"AlreadyEnabled", &entity.EmployeeMFA{Username: "testuser", Status: entity.MFAStatusEnabled}, custom_err.ErrMFAAlreadyEnabled, "MFA is already enabled"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockMFARepo := new(mock_repo.MockMFARepo)
+			mockTOTP := new(mock_auth.MockTOTP)
+			confirm := NewConfirmMFA(mockMFARepo, mockTOTP)
+
+			mockMFARepo.On("GetMFA", "testuser").Return(tt.mfa, nil)
+
+			_, message, err := confirm.Execute("testuser", "123456")
+
+			assert.ErrorIs(t, err, tt.err)
+			assert.Equal(t, tt.message, message)
+			mockTOTP.AssertNotCalled(t, "Validate", mock.Anything, mock.Anything, mock.Anything)
+		})
+	}
+}
@@ -1,6 +1,8 @@
 package app
 
 import (
+	"auth-service/internal/config"
+	"auth-service/internal/domain/entity"
 	custom_err "auth-service/internal/domain/error"
 	"auth-service/internal/logging"
 	"auth-service/internal/messaging"
@@ -13,17 +15,19 @@ import (
 
 // DeleteEmployee is the use-case for deleting an employee (marking as invalid).
 type DeleteEmployee struct {
-	EmployeeRepo ports.EmployeeRepo
+	EmployeeRepo        ports.EmployeeRepo
+	TokenRevocationRepo ports.TokenRevocationRepo
 }
 
 // NewDeleteEmployee creates a new DeleteEmployee use-case instance.
-func NewDeleteEmployee(employeeRepo ports.EmployeeRepo) *DeleteEmployee {
+func NewDeleteEmployee(employeeRepo ports.EmployeeRepo, tokenRevocationRepo ports.TokenRevocationRepo) *DeleteEmployee {
 	return &DeleteEmployee{
-		EmployeeRepo: employeeRepo,
+		EmployeeRepo:        employeeRepo,
+		TokenRevocationRepo: tokenRevocationRepo,
 	}
 }
 
-// Execute marks an employee as invalid (soft delete).
+// Execute marks an employee as invalid (soft delete) and revokes the access tokens issued to them.
 func (a *DeleteEmployee) Execute(username, requester string) (string, error) {
 	metrics.IncRequestActive()
 	defer metrics.DecRequestActive()
@@ -73,6 +77,17 @@ func (a *DeleteEmployee) Execute(username, requester string) (string, error) {
 		return "Failed to delete employee", err
 	}
 
+	revokeEmployeeTokens(a.TokenRevocationRepo, username, entity.TokenRevocationReasonEmployeeDeleted)
+
 	_ = messaging.GetService().PublishToDefaultTopic(messaging.Message{Content: username, Status: true, Type: messaging.MessageTypeEmployeeDeleted})
 	return "Employee deleted successfully", nil
 }
+
+// revokeEmployeeTokens revokes every access token issued to the employee so far. A failure is logged, the tokens
+// then stay valid until they expire.
+func revokeEmployeeTokens(tokenRevocationRepo ports.TokenRevocationRepo, username, reason string) {
+	revocation := entity.NewEmployeeTokenRevocation(username, reason, config.Current().Auth.JWTTokentDuration)
+	if err := tokenRevocationRepo.CreateTokenRevocation(revocation); err != nil {
+		logging.Logger.Error().Err(err).Str("username", username).Str("reason", reason).Msg("failed to revoke access tokens of employee")
+	}
+}
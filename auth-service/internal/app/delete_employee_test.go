@@ -6,15 +6,23 @@ import (
 	mock_repo "auth-service/internal/ports/mocks/repo"
 	"fmt"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 	"gorm.io/gorm"
 	"testing"
 )
 
+// newTestTokenRevocationRepo returns a revocation repo that accepts any revocation
+func newTestTokenRevocationRepo() *mock_repo.MockTokenRevocationRepo {
+	mockTokenRevocationRepo := new(mock_repo.MockTokenRevocationRepo)
+	mockTokenRevocationRepo.On("CreateTokenRevocation", mock.AnythingOfType("*entity.TokenRevocation")).Return(nil).Maybe()
+	return mockTokenRevocationRepo
+}
+
 // TestDeleteEmployee_Execute_Success tests success for employee delete
 func TestDeleteEmployee_Execute_Success(t *testing.T) {
 	mockEmployeeRepo := new(mock_repo.MockEmployeeRepo)
 
-	deleteEmployee := NewDeleteEmployee(mockEmployeeRepo)
+	deleteEmployee := NewDeleteEmployee(mockEmployeeRepo, newTestTokenRevocationRepo())
 
 	username := "john_doe"
 	requester := "admin_user"
@@ -39,7 +47,7 @@ func TestDeleteEmployee_Execute_Success(t *testing.T) {
 func TestDeleteEmployee_Execute_SuccessWithDifferentUsernames(t *testing.T) {
 	mockEmployeeRepo := new(mock_repo.MockEmployeeRepo)
 
-	deleteEmployee := NewDeleteEmployee(mockEmployeeRepo)
+	deleteEmployee := NewDeleteEmployee(mockEmployeeRepo, newTestTokenRevocationRepo())
 
 	testCases := []struct {
 		name     string
@@ -78,7 +86,7 @@ func TestDeleteEmployee_Execute_SuccessWithDifferentUsernames(t *testing.T) {
 func TestDeleteEmployee_Execute_SuccessWithDifferentEmployeeStatuses(t *testing.T) {
 	mockEmployeeRepo := new(mock_repo.MockEmployeeRepo)
 
-	deleteEmployee := NewDeleteEmployee(mockEmployeeRepo)
+	deleteEmployee := NewDeleteEmployee(mockEmployeeRepo, newTestTokenRevocationRepo())
 
 	testCases := []struct {
 		name   string
@@ -115,7 +123,7 @@ func TestDeleteEmployee_Execute_SuccessWithDifferentEmployeeStatuses(t *testing.
 func TestDeleteEmployee_Execute_ErrorEmptyUsername(t *testing.T) {
 	mockEmployeeRepo := new(mock_repo.MockEmployeeRepo)
 
-	deleteEmployee := NewDeleteEmployee(mockEmployeeRepo)
+	deleteEmployee := NewDeleteEmployee(mockEmployeeRepo, newTestTokenRevocationRepo())
 
 	testCases := []struct {
 		name     string
@@ -144,7 +152,7 @@ func TestDeleteEmployee_Execute_ErrorEmptyUsername(t *testing.T) {
 func TestDeleteEmployee_Execute_ErrorEmployeeNotFound(t *testing.T) {
 	mockEmployeeRepo := new(mock_repo.MockEmployeeRepo)
 
-	deleteEmployee := NewDeleteEmployee(mockEmployeeRepo)
+	deleteEmployee := NewDeleteEmployee(mockEmployeeRepo, newTestTokenRevocationRepo())
 
 	username := "nonexistent_user"
 	requester := "admin_user"
@@ -164,7 +172,7 @@ func TestDeleteEmployee_Execute_ErrorEmployeeNotFound(t *testing.T) {
 func TestDeleteEmployee_Execute_ErrorEmployeeNotFoundWithGormError(t *testing.T) {
 	mockEmployeeRepo := new(mock_repo.MockEmployeeRepo)
 
-	deleteEmployee := NewDeleteEmployee(mockEmployeeRepo)
+	deleteEmployee := NewDeleteEmployee(mockEmployeeRepo, newTestTokenRevocationRepo())
 
 	username := "nonexistent_user"
 	requester := "admin_user"
@@ -184,7 +192,7 @@ func TestDeleteEmployee_Execute_ErrorEmployeeNotFoundWithGormError(t *testing.T)
 func TestDeleteEmployee_Execute_ErrorDatabaseGetEmployee(t *testing.T) {
 	mockEmployeeRepo := new(mock_repo.MockEmployeeRepo)
 
-	deleteEmployee := NewDeleteEmployee(mockEmployeeRepo)
+	deleteEmployee := NewDeleteEmployee(mockEmployeeRepo, newTestTokenRevocationRepo())
 
 	username := "test_user"
 	requester := "admin_user"
@@ -204,7 +212,7 @@ func TestDeleteEmployee_Execute_ErrorDatabaseGetEmployee(t *testing.T) {
 func TestDeleteEmployee_Execute_ErrorDeleteEmployeeFailure(t *testing.T) {
 	mockEmployeeRepo := new(mock_repo.MockEmployeeRepo)
 
-	deleteEmployee := NewDeleteEmployee(mockEmployeeRepo)
+	deleteEmployee := NewDeleteEmployee(mockEmployeeRepo, newTestTokenRevocationRepo())
 
 	username := "john_doe"
 	requester := "admin_user"
@@ -230,7 +238,7 @@ func TestDeleteEmployee_Execute_ErrorDeleteEmployeeFailure(t *testing.T) {
 func TestDeleteEmployee_Execute_SuccessWithDifferentRequesters(t *testing.T) {
 	mockEmployeeRepo := new(mock_repo.MockEmployeeRepo)
 
-	deleteEmployee := NewDeleteEmployee(mockEmployeeRepo)
+	deleteEmployee := NewDeleteEmployee(mockEmployeeRepo, newTestTokenRevocationRepo())
 
 	testCases := []struct {
 		name      string
@@ -268,7 +276,7 @@ func TestDeleteEmployee_Execute_SuccessWithDifferentRequesters(t *testing.T) {
 func TestDeleteEmployee_Execute_ErrorSelfDeletion(t *testing.T) {
 	mockEmployeeRepo := new(mock_repo.MockEmployeeRepo)
 
-	deleteEmployee := NewDeleteEmployee(mockEmployeeRepo)
+	deleteEmployee := NewDeleteEmployee(mockEmployeeRepo, newTestTokenRevocationRepo())
 
 	username := "admin_user"
 	requester := "admin_user"
@@ -285,7 +293,7 @@ func TestDeleteEmployee_Execute_ErrorSelfDeletion(t *testing.T) {
 func TestDeleteEmployee_Execute_ErrorConcurrentDeletion(t *testing.T) {
 	mockEmployeeRepo := new(mock_repo.MockEmployeeRepo)
 
-	deleteEmployee := NewDeleteEmployee(mockEmployeeRepo)
+	deleteEmployee := NewDeleteEmployee(mockEmployeeRepo, newTestTokenRevocationRepo())
 
 	username := "john_doe"
 	requester := "admin_user"
@@ -312,7 +320,7 @@ func TestDeleteEmployee_Execute_ErrorConcurrentDeletion(t *testing.T) {
 func TestDeleteEmployee_Execute_ErrorEmployeeAlreadyDeleted(t *testing.T) {
 	mockEmployeeRepo := new(mock_repo.MockEmployeeRepo)
 
-	deleteEmployee := NewDeleteEmployee(mockEmployeeRepo)
+	deleteEmployee := NewDeleteEmployee(mockEmployeeRepo, newTestTokenRevocationRepo())
 
 	username := "already_deleted"
 	requester := "admin_user"
@@ -333,3 +341,46 @@ func TestDeleteEmployee_Execute_ErrorEmployeeAlreadyDeleted(t *testing.T) {
 	assert.Equal(t, "Employee deleted successfully", message)
 	mockEmployeeRepo.AssertExpectations(t)
 }
+
+// TestDeleteEmployee_Execute_RevokesTokens tests that deleting an employee revokes all their access tokens
+func TestDeleteEmployee_Execute_RevokesTokens(t *testing.T) {
+	mockEmployeeRepo := new(mock_repo.MockEmployeeRepo)
+	mockTokenRevocationRepo := new(mock_repo.MockTokenRevocationRepo)
+
+	deleteEmployee := NewDeleteEmployee(mockEmployeeRepo, mockTokenRevocationRepo)
+
+	employee := &entity.Employee{Username: "john_doe", Role: "editor", Status: entity.EmployeeStatusValid}
+	mockEmployeeRepo.On("GetEmployeeByUsername", "john_doe").Return(employee, nil)
+	mockEmployeeRepo.On("DeleteEmployee", "john_doe", "admin_user").Return(nil)
+	mockTokenRevocationRepo.On("CreateTokenRevocation", mock.MatchedBy(func(revocation *entity.TokenRevocation) bool {
+		return revocation.Username == "john_doe" &&
+			revocation.TokenID == "" &&
+			revocation.RevokedBefore != nil &&
+			revocation.Reason == entity.TokenRevocationReasonEmployeeDeleted
+	})).Return(nil)
+
+	message, err := deleteEmployee.Execute("john_doe", "admin_user")
+
+	assert.NoError(t, err)
+	assert.Equal(t, "Employee deleted successfully", message)
+	mockTokenRevocationRepo.AssertExpectations(t)
+}
+
+// TestDeleteEmployee_Execute_RevocationFailure tests that a failed revocation does not fail the deletion
+func TestDeleteEmployee_Execute_RevocationFailure(t *testing.T) {
+	mockEmployeeRepo := new(mock_repo.MockEmployeeRepo)
+	mockTokenRevocationRepo := new(mock_repo.MockTokenRevocationRepo)
+
+	deleteEmployee := NewDeleteEmployee(mockEmployeeRepo, mockTokenRevocationRepo)
+
+	employee := &entity.Employee{Username: "john_doe", Role: "editor", Status: entity.EmployeeStatusValid}
+	mockEmployeeRepo.On("GetEmployeeByUsername", "john_doe").Return(employee, nil)
+	mockEmployeeRepo.On("DeleteEmployee", "john_doe", "admin_user").Return(nil)
+	mockTokenRevocationRepo.On("CreateTokenRevocation", mock.AnythingOfType("*entity.TokenRevocation")).Return(fmt.Errorf("database locked"))
+
+	message, err := deleteEmployee.Execute("john_doe", "admin_user")
+
+	assert.NoError(t, err)
+	assert.Equal(t, "Employee deleted successfully", message)
+	mockTokenRevocationRepo.AssertExpectations(t)
+}
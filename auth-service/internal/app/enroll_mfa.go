@@ -0,0 +1,89 @@
+package app
+
+import (
+	"auth-service/internal/domain/entity"
+	custom_err "auth-service/internal/domain/error"
+	"auth-service/internal/logging"
+	"auth-service/internal/observability/metrics"
+	"auth-service/internal/ports"
+	"strings"
+)
+
+// EnrollMFA is the use case for enrolling an authenticator app as second factor.
+type EnrollMFA struct {
+	EmployeeRepo ports.EmployeeRepo
+	MFARepo      ports.MFARepo
+	TOTP         ports.TOTP
+}
+
+// NewEnrollMFA creates a new EnrollMFA use-case instance.
+func NewEnrollMFA(employeeRepo ports.EmployeeRepo, mfaRepo ports.MFARepo, totp ports.TOTP) *EnrollMFA {
+	return &EnrollMFA{
+		EmployeeRepo: employeeRepo,
+		MFARepo:      mfaRepo,
+		TOTP:         totp,
+	}
+}
+
+// Execute creates a pending MFA enrollment with a new TOTP secret and returns the secret and its provisioning URI
+// to show as QR code. The employee is either the logged-in one, or the one of a login challenge that requires
+// enrollment, who cannot log in without MFA. Enrolling again replaces a pending enrollment; enabled MFA has to be
+// reset by an admin first. MFA is enabled by the first verified code.
+func (e *EnrollMFA) Execute(username, challengeToken string) (string, string, string, error) {
+	metrics.IncRequestActive()
+	defer metrics.DecRequestActive()
+
+	var err error
+	defer func() {
+		metrics.RecordOperation("enroll_mfa", err)
+	}()
+
+	username = strings.TrimSpace(username)
+	if strings.TrimSpace(challengeToken) != "" {
+		var challenge *entity.MFAChallenge
+		challenge, err = findMFAChallenge(e.MFARepo, challengeToken)
+		if err != nil || !challenge.EnrollmentRequired {
+			err = custom_err.ErrInvalidMFAChallenge
+			return "", "", "Invalid or expired challenge token", err
+		}
+		username = challenge.Username
+	}
+
+	if username == "" {
+		logging.Logger.Warn().Msg("Invalid request")
+		err = custom_err.ErrMissingRequiredData
+		return "", "", "Missing required data username or challenge token", err
+	}
+
+	employee, err := e.EmployeeRepo.GetEmployeeByUsername(username)
+	if err != nil || employee.Status != entity.EmployeeStatusValid {
+		logging.Logger.Warn().Err(err).Str("username", username).Msg("employee not found")
+		err = custom_err.ErrEmployeeNotFound
+		return "", "", "Employee not found", err
+	}
+
+	current, err := e.MFARepo.GetMFA(username)
+	if err != nil {
+		logging.Logger.Error().Err(err).Str("username", username).Msg("failed to get MFA")
+		err = custom_err.ErrDatabase
+		return "", "", "Failed to enroll MFA", err
+	}
+	if current != nil && current.IsEnabled() {
+		err = custom_err.ErrMFAAlreadyEnabled
+		return "", "", "MFA is already enabled", err
+	}
+
+	secret, err := e.TOTP.GenerateSecret()
+	if err != nil {
+		logging.Logger.Error().Err(err).Str("username", username).Msg("failed to generate TOTP secret")
+		return "", "", "Failed to enroll MFA", err
+	}
+
+	if err = e.MFARepo.SaveMFA(entity.NewEmployeeMFA(username, secret)); err != nil {
+		logging.Logger.Error().Err(err).Str("username", username).Msg("failed to store MFA")
+		err = custom_err.ErrDatabase
+		return "", "", "Failed to enroll MFA", err
+	}
+
+	return secret, e.TOTP.ProvisioningURI(username, secret), "MFA enrollment started, verify a code to enable it", nil
+}
@@ -0,0 +1,127 @@
+package app
+
+import (
+	"auth-service/internal/domain/entity"
+	custom_err "auth-service/internal/domain/error"
+	mock_auth "auth-service/internal/ports/mocks/auth"
+	mock_repo "auth-service/internal/ports/mocks/repo"
+	"fmt"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"testing"
+	"time"
+)
+
+// TestEnrollMFA_Execute_Success tests that a logged-in employee gets a pending enrollment with a provisioning URI
+func TestEnrollMFA_Execute_Success(t *testing.T) {
+	mockEmployeeRepo := new(mock_repo.MockEmployeeRepo)
+	mockMFARepo := new(mock_repo.MockMFARepo)
+	mockTOTP := new(mock_auth.MockTOTP)
+
+	enroll := NewEnrollMFA(mockEmployeeRepo, mockMFARepo, mockTOTP)
+
+	mockEmployeeRepo.On("GetEmployeeByUsername", "testuser").Return(&entity.Employee{Username: "testuser", Status: entity.EmployeeStatusValid}, nil)
+	mockMFARepo.On("GetMFA", "testuser").Return(nil, nil)
+	mockTOTP.On("GenerateSecret").Return("SECRET", nil)
+	mockTOTP.On("ProvisioningURI", "testuser", "SECRET").Return("otpauth://totp/BankOps:testuser?secret=SECRET")
+	mockMFARepo.On("SaveMFA", mock.MatchedBy(func(mfa *entity.EmployeeMFA) bool {
+		return mfa.Username == "testuser" && mfa.Secret == "SECRET" && mfa.Status == entity.MFAStatusPending
+	})).Return(nil)
+
+	secret, uri, message, err := enroll.Execute("testuser", "")
+
+	assert.NoError(t, err)
+	assert.Equal(t, "SECRET", secret)
+	assert.Equal(t, "otpauth://totp/BankOps:testuser?secret=SECRET", uri)
+	assert.Equal(t, "MFA enrollment started, verify a code to enable it", message)
+	mockMFARepo.AssertExpectations(t)
+}
+
+// TestEnrollMFA_Execute_WithChallenge tests that the challenge of a login requiring enrollment names the employee
+func TestEnrollMFA_Execute_WithChallenge(t *testing.T) {
+	mockEmployeeRepo := new(mock_repo.MockEmployeeRepo)
+	mockMFARepo := new(mock_repo.MockMFARepo)
+	mockTOTP := new(mock_auth.MockTOTP)
+
+	enroll := NewEnrollMFA(mockEmployeeRepo, mockMFARepo, mockTOTP)
+
+	challenge, token, err := entity.NewMFAChallenge("testuser", true, time.Minute)
+	assert.NoError(t, err)
+
+	mockMFARepo.On("GetMFAChallengeByHash", entity.HashMFAToken(token)).Return(challenge, nil)
+	mockEmployeeRepo.On("GetEmployeeByUsername", "testuser").Return(&entity.Employee{Username: "testuser", Status: entity.EmployeeStatusValid}, nil)
+	mockMFARepo.On("GetMFA", "testuser").Return(&entity.EmployeeMFA{Username: "testuser", Status: entity.MFAStatusPending}, nil)
+	mockTOTP.On("GenerateSecret").Return("SECRET", nil)
+	mockTOTP.On("ProvisioningURI", "testuser", "SECRET").Return("otpauth://totp/BankOps:testuser?secret=SECRET")
+	mockMFARepo.On("SaveMFA", mock.AnythingOfType("*entity.EmployeeMFA")).Return(nil)
+
+	// the username of the request is ignored
+	secret, _, _, err := enroll.Execute("otheruser", token)
+
+	assert.NoError(t, err)
+	assert.Equal(t, "SECRET", secret)
+	mockEmployeeRepo.AssertExpectations(t)
+}
+
+// TestEnrollMFA_Execute_ChallengeWithoutEnrollment tests that the challenge of a login with enabled MFA cannot
+// enroll another authenticator app
+func TestEnrollMFA_Execute_ChallengeWithoutEnrollment(t *testing.T) {
+	mockMFARepo := new(mock_repo.MockMFARepo)
+	enroll := NewEnrollMFA(new(mock_repo.MockEmployeeRepo), mockMFARepo, new(mock_auth.MockTOTP))
+
+	challenge, token, err := entity.NewMFAChallenge("testuser", false, time.Minute)
+	assert.NoError(t, err)
+	mockMFARepo.On("GetMFAChallengeByHash", entity.HashMFAToken(token)).Return(challenge, nil)
+
+	_, _, message, err := enroll.Execute("", token)
+
+	assert.ErrorIs(t, err, custom_err.ErrInvalidMFAChallenge)
+	assert.Equal(t, "Invalid or expired challenge token", message)
+	mockMFARepo.AssertNotCalled(t, "SaveMFA", mock.Anything)
+}
+
+// TestEnrollMFA_Execute_AlreadyEnabled tests that enabled MFA is not replaced
+func TestEnrollMFA_Execute_AlreadyEnabled(t *testing.T) {
+	mockEmployeeRepo := new(mock_repo.MockEmployeeRepo)
+	mockMFARepo := new(mock_repo.MockMFARepo)
+	mockTOTP := new(mock_auth.MockTOTP)
+
+	enroll := NewEnrollMFA(mockEmployeeRepo, mockMFARepo, mockTOTP)
+
+	mockEmployeeRepo.On("GetEmployeeByUsername", "testuser").Return(&entity.Employee{Username: "testuser", Status: entity.EmployeeStatusValid}, nil)
+	mockMFARepo.On("GetMFA", "testuser").Return(&entity.EmployeeMFA{Username: "testuser", Status: entity.MFAStatusEnabled}, nil)
+
+	_, _, message, err := enroll.Execute("testuser", "")
+
+	assert.ErrorIs(t, err, custom_err.ErrMFAAlreadyEnabled)
+	assert.Equal(t, "MFA is already enabled", message)
+	mockTOTP.AssertNotCalled(t, "GenerateSecret")
+	mockMFARepo.AssertNotCalled(t, "SaveMFA", mock.Anything)
+}
+
+// TestEnrollMFA_Execute_EmployeeNotFound tests that unknown employees cannot enroll
+func TestEnrollMFA_Execute_EmployeeNotFound(t *testing.T) {
+	mockEmployeeRepo := new(mock_repo.MockEmployeeRepo)
+	mockMFARepo := new(mock_repo.MockMFARepo)
+
+	enroll := NewEnrollMFA(mockEmployeeRepo, mockMFARepo, new(mock_auth.MockTOTP))
+
+	mockEmployeeRepo.On("GetEmployeeByUsername", "ghost").Return(nil, fmt.Errorf("record not found"))
+
+	_, _, message, err := enroll.Execute("ghost", "")
+
+	assert.ErrorIs(t, err, custom_err.ErrEmployeeNotFound)
+	assert.Equal(t, "Employee not found", message)
+	mockMFARepo.AssertNotCalled(t, "SaveMFA", mock.Anything)
+}
+
+// TestEnrollMFA_Execute_MissingUsername tests that either a username or a challenge token is required
+func TestEnrollMFA_Execute_MissingUsername(t *testing.T) {
+	mockEmployeeRepo := new(mock_repo.MockEmployeeRepo)
+	enroll := NewEnrollMFA(mockEmployeeRepo, new(mock_repo.MockMFARepo), new(mock_auth.MockTOTP))
+
+	_, _, _, err := enroll.Execute(" ", "")
+
+	assert.ErrorIs(t, err, custom_err.ErrMissingRequiredData)
+	mockEmployeeRepo.AssertNotCalled(t, "GetEmployeeByUsername", mock.Anything)
+}
@@ -18,7 +18,9 @@ func NewListEmployee(employeeRepo ports.EmployeeRepo) *ListEmployee {
 	}
 }
 
-func (a *ListEmployee) Execute(page, pageSize int, sortOrder string) ([]*entity.Employee, int64, int64, string, error) {
+// Execute lists a page of employees and returns the cursor of the next page, empty on the last page. With a cursor
+// the page continues after it and page is ignored. Totals are only counted when includeTotal is set.
+func (a *ListEmployee) Execute(page, pageSize int, cursor string, includeTotal bool, sortOrder string) ([]*entity.Employee, int64, int64, string, string, error) {
 	metrics.IncRequestActive()
 	defer metrics.DecRequestActive()
 
@@ -34,12 +36,20 @@ func (a *ListEmployee) Execute(page, pageSize int, sortOrder string) ([]*entity.
 		pageSize = 50
 	}
 
-	employee, totalCount, err := a.EmployeeRepo.ListEmployee(page, pageSize, sortOrder)
+	after, err := entity.DecodePageCursor(cursor)
+	if err != nil {
+		return nil, 0, 0, "", "Invalid request - invalid cursor", err
+	}
+
+	employee, totalCount, err := a.EmployeeRepo.ListEmployee(page, pageSize, after, includeTotal, sortOrder)
+	employee, nextCursor := entity.TrimPage(employee, pageSize, func(e *entity.Employee) entity.PageCursor {
+		return entity.PageCursor{CreatedAt: e.CreatedAt, ID: e.ID}
+	})
 
 	totalPages := int64(0)
 	if totalCount > 0 {
 		totalPages = (totalCount + int64(pageSize) - 1) / int64(pageSize)
 	}
 
-	return employee, totalCount, totalPages, "Employee List", nil
+	return employee, totalCount, totalPages, nextCursor, "Employee List", nil
 }
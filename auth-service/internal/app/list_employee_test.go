@@ -2,10 +2,12 @@ package app
 
 import (
 	"auth-service/internal/domain/entity"
+	custom_err "auth-service/internal/domain/error"
 	mock_repo "auth-service/internal/ports/mocks/repo"
 	"fmt"
 	"github.com/stretchr/testify/assert"
 	"testing"
+	"time"
 )
 
 // TestListEmployee_Execute_Success tests success if inputs are provided correctly
@@ -24,9 +26,9 @@ func TestListEmployee_Execute_Success(t *testing.T) {
 	}
 	totalCount := int64(2)
 
-	mockEmployeeRepo.On("ListEmployee", page, pageSize, sortOrder).Return(employees, totalCount, nil)
+	mockEmployeeRepo.On("ListEmployee", page, pageSize, (*entity.PageCursor)(nil), true, sortOrder).Return(employees, totalCount, nil)
 
-	result, resultTotal, totalPages, message, err := listEmployee.Execute(page, pageSize, sortOrder)
+	result, resultTotal, totalPages, _, message, err := listEmployee.Execute(page, pageSize, "", true, sortOrder)
 
 	assert.NoError(t, err)
 	assert.Equal(t, employees, result)
@@ -52,9 +54,9 @@ func TestListEmployee_Execute_SuccessWithPagination(t *testing.T) {
 	}
 	totalCount := int64(12)
 
-	mockEmployeeRepo.On("ListEmployee", page, pageSize, sortOrder).Return(employees, totalCount, nil)
+	mockEmployeeRepo.On("ListEmployee", page, pageSize, (*entity.PageCursor)(nil), true, sortOrder).Return(employees, totalCount, nil)
 
-	result, resultTotal, totalPages, message, err := listEmployee.Execute(page, pageSize, sortOrder)
+	result, resultTotal, totalPages, _, message, err := listEmployee.Execute(page, pageSize, "", true, sortOrder)
 
 	assert.NoError(t, err)
 	assert.Equal(t, employees, result)
@@ -77,9 +79,9 @@ func TestListEmployee_Execute_SuccessEmptyResult(t *testing.T) {
 	employees := []*entity.Employee{}
 	totalCount := int64(0)
 
-	mockEmployeeRepo.On("ListEmployee", page, pageSize, sortOrder).Return(employees, totalCount, nil)
+	mockEmployeeRepo.On("ListEmployee", page, pageSize, (*entity.PageCursor)(nil), true, sortOrder).Return(employees, totalCount, nil)
 
-	result, resultTotal, totalPages, message, err := listEmployee.Execute(page, pageSize, sortOrder)
+	result, resultTotal, totalPages, _, message, err := listEmployee.Execute(page, pageSize, "", true, sortOrder)
 
 	assert.NoError(t, err)
 	assert.Equal(t, employees, result)
@@ -114,9 +116,9 @@ func TestListEmployee_Execute_PageBoundaryValues(t *testing.T) {
 			employees := []*entity.Employee{}
 			totalCount := int64(0)
 
-			mockEmployeeRepo.On("ListEmployee", tc.expectedPage, pageSize, sortOrder).Return(employees, totalCount, nil)
+			mockEmployeeRepo.On("ListEmployee", tc.expectedPage, pageSize, (*entity.PageCursor)(nil), true, sortOrder).Return(employees, totalCount, nil)
 
-			result, resultTotal, totalPages, message, err := listEmployee.Execute(tc.page, pageSize, sortOrder)
+			result, resultTotal, totalPages, _, message, err := listEmployee.Execute(tc.page, pageSize, "", true, sortOrder)
 
 			assert.NoError(t, err)
 			assert.Equal(t, employees, result)
@@ -156,9 +158,9 @@ func TestListEmployee_Execute_PageSizeBoundaryValues(t *testing.T) {
 			employees := []*entity.Employee{}
 			totalCount := int64(0)
 
-			mockEmployeeRepo.On("ListEmployee", page, tc.expectedSize, sortOrder).Return(employees, totalCount, nil)
+			mockEmployeeRepo.On("ListEmployee", page, tc.expectedSize, (*entity.PageCursor)(nil), true, sortOrder).Return(employees, totalCount, nil)
 
-			result, resultTotal, totalPages, message, err := listEmployee.Execute(page, tc.pageSize, sortOrder)
+			result, resultTotal, totalPages, _, message, err := listEmployee.Execute(page, tc.pageSize, "", true, sortOrder)
 
 			assert.NoError(t, err)
 			assert.Equal(t, employees, result)
@@ -200,9 +202,9 @@ func TestListEmployee_Execute_TotalPagesCalculation(t *testing.T) {
 				employees = []*entity.Employee{{ID: "emp-1", Username: "test"}}
 			}
 
-			mockEmployeeRepo.On("ListEmployee", page, tc.pageSize, sortOrder).Return(employees, tc.totalCount, nil)
+			mockEmployeeRepo.On("ListEmployee", page, tc.pageSize, (*entity.PageCursor)(nil), true, sortOrder).Return(employees, tc.totalCount, nil)
 
-			_, resultTotal, totalPages, message, err := listEmployee.Execute(page, tc.pageSize, sortOrder)
+			_, resultTotal, totalPages, _, message, err := listEmployee.Execute(page, tc.pageSize, "", true, sortOrder)
 
 			assert.NoError(t, err)
 			assert.Equal(t, tc.totalCount, resultTotal, "Total count mismatch for test case: %s", tc.name)
@@ -240,9 +242,9 @@ func TestListEmployee_Execute_SuccessWithSortOrders(t *testing.T) {
 			}
 			totalCount := int64(1)
 
-			mockEmployeeRepo.On("ListEmployee", page, pageSize, tc.sortOrder).Return(employees, totalCount, nil)
+			mockEmployeeRepo.On("ListEmployee", page, pageSize, (*entity.PageCursor)(nil), true, tc.sortOrder).Return(employees, totalCount, nil)
 
-			result, resultTotal, totalPages, message, err := listEmployee.Execute(page, pageSize, tc.sortOrder)
+			result, resultTotal, totalPages, _, message, err := listEmployee.Execute(page, pageSize, "", true, tc.sortOrder)
 
 			assert.NoError(t, err)
 			assert.Equal(t, employees, result)
@@ -274,9 +276,9 @@ func TestListEmployee_Execute_SuccessLargeDataset(t *testing.T) {
 	}
 	totalCount := int64(10000)
 
-	mockEmployeeRepo.On("ListEmployee", page, pageSize, sortOrder).Return(employees, totalCount, nil)
+	mockEmployeeRepo.On("ListEmployee", page, pageSize, (*entity.PageCursor)(nil), true, sortOrder).Return(employees, totalCount, nil)
 
-	result, resultTotal, totalPages, message, err := listEmployee.Execute(page, pageSize, sortOrder)
+	result, resultTotal, totalPages, _, message, err := listEmployee.Execute(page, pageSize, "", true, sortOrder)
 
 	assert.NoError(t, err)
 	assert.Equal(t, employees, result)
@@ -285,3 +287,41 @@ func TestListEmployee_Execute_SuccessLargeDataset(t *testing.T) {
 	assert.Equal(t, "Employee List", message)
 	mockEmployeeRepo.AssertExpectations(t)
 }
+
+// TestListEmployee_Execute_CursorPage tests that a cursor page continues after the cursor and returns the next one
+func TestListEmployee_Execute_CursorPage(t *testing.T) {
+	mockEmployeeRepo := new(mock_repo.MockEmployeeRepo)
+	listEmployee := NewListEmployee(mockEmployeeRepo)
+
+	createdAt := time.Date(2026, 3, 1, 10, 30, 0, 0, time.UTC)
+	after := &entity.PageCursor{CreatedAt: time.Unix(0, createdAt.UnixNano()), ID: "emp-1"}
+	employees := []*entity.Employee{
+		{ID: "emp-2", CreatedAt: createdAt.Add(time.Minute)},
+		{ID: "emp-3", CreatedAt: createdAt.Add(2 * time.Minute)},
+	}
+
+	mockEmployeeRepo.On("ListEmployee", 1, 1, after, false, "asc").Return(employees, int64(0), nil)
+
+	result, resultTotal, totalPages, nextCursor, message, err := listEmployee.Execute(1, 1, after.Encode(), false, "asc")
+
+	assert.NoError(t, err)
+	assert.Equal(t, "Employee List", message)
+	assert.Equal(t, employees[:1], result)
+	assert.Equal(t, int64(0), resultTotal)
+	assert.Equal(t, int64(0), totalPages)
+	assert.Equal(t, entity.PageCursor{CreatedAt: employees[0].CreatedAt, ID: "emp-2"}.Encode(), nextCursor)
+	mockEmployeeRepo.AssertExpectations(t)
+}
+
+// TestListEmployee_Execute_InvalidCursor tests that a malformed cursor is rejected
+func TestListEmployee_Execute_InvalidCursor(t *testing.T) {
+	mockEmployeeRepo := new(mock_repo.MockEmployeeRepo)
+	listEmployee := NewListEmployee(mockEmployeeRepo)
+
+	result, _, _, _, message, err := listEmployee.Execute(1, 10, "%%%", false, "desc")
+
+	assert.ErrorIs(t, err, custom_err.ErrInvalidPageCursor)
+	assert.Equal(t, "Invalid request - invalid cursor", message)
+	assert.Nil(t, result)
+	mockEmployeeRepo.AssertNotCalled(t, "ListEmployee")
+}
@@ -0,0 +1,46 @@
+package app
+
+import (
+	"auth-service/internal/domain/entity"
+	custom_err "auth-service/internal/domain/error"
+	"auth-service/internal/logging"
+	"auth-service/internal/ports"
+)
+
+// ListMFAPolicies is the use case for listing the MFA policy of every role.
+type ListMFAPolicies struct {
+	MFAPolicyRepo ports.MFAPolicyRepo
+}
+
+// NewListMFAPolicies creates a new ListMFAPolicies use-case instance.
+func NewListMFAPolicies(mfaPolicyRepo ports.MFAPolicyRepo) *ListMFAPolicies {
+	return &ListMFAPolicies{
+		MFAPolicyRepo: mfaPolicyRepo,
+	}
+}
+
+// Execute returns the MFA policy of every role, MFA is optional for a role without a stored policy
+func (l *ListMFAPolicies) Execute() ([]*entity.MFAPolicy, error) {
+	stored, err := l.MFAPolicyRepo.ListMFAPolicies()
+	if err != nil {
+		logging.Logger.Error().Err(err).Msg("failed to list MFA policies")
+		return nil, custom_err.ErrDatabase
+	}
+
+	byRole := make(map[string]*entity.MFAPolicy, len(stored))
+	for _, policy := range stored {
+		byRole[policy.Role] = policy
+	}
+
+	roles := []string{entity.EmployeeRoleAdmin, entity.EmployeeRoleEditor, entity.EmployeeRoleViewer}
+	policies := make([]*entity.MFAPolicy, len(roles))
+	for i, role := range roles {
+		if policy, ok := byRole[role]; ok {
+			policies[i] = policy
+		} else {
+			policies[i] = &entity.MFAPolicy{Role: role}
+		}
+	}
+
+	return policies, nil
+}
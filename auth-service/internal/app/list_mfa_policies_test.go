@@ -0,0 +1,46 @@
+package app
+
+import (
+	"auth-service/internal/domain/entity"
+	custom_err "auth-service/internal/domain/error"
+	mock_repo "auth-service/internal/ports/mocks/repo"
+	"fmt"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+// TestListMFAPolicies_Execute_Success tests that every role is listed, optional unless a policy is stored
+func TestListMFAPolicies_Execute_Success(t *testing.T) {
+	mockMFAPolicyRepo := new(mock_repo.MockMFAPolicyRepo)
+
+	listPolicies := NewListMFAPolicies(mockMFAPolicyRepo)
+
+	mockMFAPolicyRepo.On("ListMFAPolicies").Return([]*entity.MFAPolicy{
+		{Role: entity.EmployeeRoleAdmin, Required: true, UpdatedBy: "root"},
+	}, nil)
+
+	policies, err := listPolicies.Execute()
+
+	assert.NoError(t, err)
+	assert.Len(t, policies, 3)
+	assert.Equal(t, entity.EmployeeRoleAdmin, policies[0].Role)
+	assert.True(t, policies[0].Required)
+	assert.Equal(t, entity.EmployeeRoleEditor, policies[1].Role)
+	assert.False(t, policies[1].Required)
+	assert.Equal(t, entity.EmployeeRoleViewer, policies[2].Role)
+	assert.False(t, policies[2].Required)
+}
+
+// TestListMFAPolicies_Execute_DatabaseFailure tests a failing read
+func TestListMFAPolicies_Execute_DatabaseFailure(t *testing.T) {
+	mockMFAPolicyRepo := new(mock_repo.MockMFAPolicyRepo)
+
+	listPolicies := NewListMFAPolicies(mockMFAPolicyRepo)
+
+	mockMFAPolicyRepo.On("ListMFAPolicies").Return(nil, fmt.Errorf("database locked"))
+
+	policies, err := listPolicies.Execute()
+
+	assert.ErrorIs(t, err, custom_err.ErrDatabase)
+	assert.Nil(t, policies)
+}
@@ -0,0 +1,38 @@
+package app
+
+import (
+	"auth-service/internal/domain/entity"
+	custom_err "auth-service/internal/domain/error"
+	"auth-service/internal/logging"
+	"auth-service/internal/ports"
+)
+
+const maxTokenRevocationsPageSize = 500
+
+// ListTokenRevocations is the use case for reading the feed of access token revocations.
+type ListTokenRevocations struct {
+	TokenRevocationRepo ports.TokenRevocationRepo
+}
+
+// NewListTokenRevocations creates a new ListTokenRevocations use-case instance.
+func NewListTokenRevocations(tokenRevocationRepo ports.TokenRevocationRepo) *ListTokenRevocations {
+	return &ListTokenRevocations{
+		TokenRevocationRepo: tokenRevocationRepo,
+	}
+}
+
+// Execute returns the unexpired revocations after the sequence in feed order. Followers pass the highest sequence
+// they have seen; fewer revocations than the limit mean they are up to date.
+func (a *ListTokenRevocations) Execute(afterSequence uint64, limit int) ([]*entity.TokenRevocation, error) {
+	if limit < 1 || limit > maxTokenRevocationsPageSize {
+		limit = maxTokenRevocationsPageSize
+	}
+
+	revocations, err := a.TokenRevocationRepo.ListTokenRevocations(afterSequence, limit)
+	if err != nil {
+		logging.Logger.Error().Err(err).Uint64("after_sequence", afterSequence).Msg("failed to list token revocations")
+		return nil, custom_err.ErrDatabase
+	}
+
+	return revocations, nil
+}
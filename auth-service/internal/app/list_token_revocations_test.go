@@ -0,0 +1,55 @@
+package app
+
+import (
+	"auth-service/internal/domain/entity"
+	custom_err "auth-service/internal/domain/error"
+	mock_repo "auth-service/internal/ports/mocks/repo"
+	"fmt"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+// TestListTokenRevocations_Execute_Success tests reading the feed after a sequence
+func TestListTokenRevocations_Execute_Success(t *testing.T) {
+	mockTokenRevocationRepo := new(mock_repo.MockTokenRevocationRepo)
+	listTokenRevocations := NewListTokenRevocations(mockTokenRevocationRepo)
+
+	revocations := []*entity.TokenRevocation{
+		{Sequence: 8, TokenID: "jti-1", Username: "testuser", ExpiresAt: time.Now().Add(time.Minute)},
+	}
+	mockTokenRevocationRepo.On("ListTokenRevocations", uint64(7), 100).Return(revocations, nil)
+
+	result, err := listTokenRevocations.Execute(7, 100)
+
+	assert.NoError(t, err)
+	assert.Equal(t, revocations, result)
+	mockTokenRevocationRepo.AssertExpectations(t)
+}
+
+// TestListTokenRevocations_Execute_LimitBounds tests that a missing or too large limit falls back to the maximum
+func TestListTokenRevocations_Execute_LimitBounds(t *testing.T) {
+	for _, limit := range []int{0, -1, maxTokenRevocationsPageSize + 1} {
+		mockTokenRevocationRepo := new(mock_repo.MockTokenRevocationRepo)
+		listTokenRevocations := NewListTokenRevocations(mockTokenRevocationRepo)
+
+		mockTokenRevocationRepo.On("ListTokenRevocations", uint64(0), maxTokenRevocationsPageSize).Return([]*entity.TokenRevocation{}, nil)
+
+		_, err := listTokenRevocations.Execute(0, limit)
+
+		assert.NoError(t, err)
+		mockTokenRevocationRepo.AssertExpectations(t)
+	}
+}
+
+// TestListTokenRevocations_Execute_DatabaseFailure tests a failing repo
+func TestListTokenRevocations_Execute_DatabaseFailure(t *testing.T) {
+	mockTokenRevocationRepo := new(mock_repo.MockTokenRevocationRepo)
+	listTokenRevocations := NewListTokenRevocations(mockTokenRevocationRepo)
+
+	mockTokenRevocationRepo.On("ListTokenRevocations", uint64(0), 10).Return(nil, fmt.Errorf("database locked"))
+
+	_, err := listTokenRevocations.Execute(0, 10)
+
+	assert.ErrorIs(t, err, custom_err.ErrDatabase)
+}
@@ -0,0 +1,84 @@
+package app
+
+import (
+	"auth-service/internal/domain/entity"
+	custom_err "auth-service/internal/domain/error"
+	"auth-service/internal/logging"
+	"auth-service/internal/observability/metrics"
+	"auth-service/internal/ports"
+	"strings"
+	"time"
+)
+
+const refreshTokenRevokedLogout = "logout"
+
+// Logout is the use case for revoking the access token and refresh token family of a session.
+type Logout struct {
+	RefreshTokenRepo    ports.RefreshTokenRepo
+	TokenRevocationRepo ports.TokenRevocationRepo
+}
+
+// NewLogout creates a new Logout use-case instance.
+func NewLogout(refreshTokenRepo ports.RefreshTokenRepo, tokenRevocationRepo ports.TokenRevocationRepo) *Logout {
+	return &Logout{
+		RefreshTokenRepo:    refreshTokenRepo,
+		TokenRevocationRepo: tokenRevocationRepo,
+	}
+}
+
+// Execute revokes the access token with the ID until it expires. The token has already been validated by the
+// gateway. When the refresh token of the session is given, its family is revoked as well, so the session cannot be
+// renewed; a refresh token of another employee is ignored.
+func (l *Logout) Execute(tokenID, username string, expiresAt time.Time, refreshToken string) (string, error) {
+	metrics.IncRequestActive()
+	defer metrics.DecRequestActive()
+
+	var err error
+	defer func() {
+		metrics.RecordOperation("logout", err)
+	}()
+
+	tokenID = strings.TrimSpace(tokenID)
+	username = strings.TrimSpace(username)
+
+	if tokenID == "" || username == "" {
+		logging.Logger.Warn().Msg("Invalid request")
+		err = custom_err.ErrMissingRequiredData
+		return "Missing required data token ID and username", err
+	}
+
+	// an expired token cannot be used anymore and needs no revocation
+	if expiresAt.After(time.Now()) {
+		err = l.TokenRevocationRepo.CreateTokenRevocation(entity.NewTokenRevocation(tokenID, username, entity.TokenRevocationReasonLogout, expiresAt))
+		if err != nil {
+			logging.Logger.Error().Err(err).Str("username", username).Msg("failed to revoke access token")
+			err = custom_err.ErrDatabase
+			return "Failed to log out", err
+		}
+	}
+
+	l.revokeRefreshToken(username, strings.TrimSpace(refreshToken))
+
+	return "Logged out successfully", nil
+}
+
+// revokeRefreshToken revokes the family of the refresh token if it belongs to the employee
+func (l *Logout) revokeRefreshToken(username, refreshToken string) {
+	if refreshToken == "" {
+		return
+	}
+
+	token, err := l.RefreshTokenRepo.GetRefreshTokenByHash(entity.HashRefreshToken(refreshToken))
+	if err != nil {
+		logging.Logger.Error().Err(err).Str("username", username).Msg("failed to get refresh token")
+		return
+	}
+	if token == nil || token.Username != username {
+		logging.Logger.Warn().Str("username", username).Msg("unknown refresh token on logout")
+		return
+	}
+
+	if err = l.RefreshTokenRepo.RevokeTokenFamily(token.FamilyID, refreshTokenRevokedLogout); err != nil {
+		logging.Logger.Error().Err(err).Str("family_id", token.FamilyID).Msg("failed to revoke refresh token family")
+	}
+}
@@ -0,0 +1,96 @@
+package app
+
+import (
+	"auth-service/internal/domain/entity"
+	custom_err "auth-service/internal/domain/error"
+	mock_repo "auth-service/internal/ports/mocks/repo"
+	"fmt"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"testing"
+	"time"
+)
+
+// TestLogout_Execute_Success tests that logout revokes the access token and the refresh token family
+func TestLogout_Execute_Success(t *testing.T) {
+	mockRefreshTokenRepo := new(mock_repo.MockRefreshTokenRepo)
+	mockTokenRevocationRepo := new(mock_repo.MockTokenRevocationRepo)
+
+	logout := NewLogout(mockRefreshTokenRepo, mockTokenRevocationRepo)
+
+	expiresAt := time.Now().Add(10 * time.Minute)
+	stored, presented := newStoredRefreshToken(t, entity.RefreshTokenStatusActive)
+
+	mockTokenRevocationRepo.On("CreateTokenRevocation", mock.MatchedBy(func(revocation *entity.TokenRevocation) bool {
+		return revocation.TokenID == "jti-1" &&
+			revocation.Username == "testuser" &&
+			revocation.RevokedBefore == nil &&
+			revocation.ExpiresAt.Equal(expiresAt) &&
+			revocation.Reason == entity.TokenRevocationReasonLogout
+	})).Return(nil)
+	mockRefreshTokenRepo.On("GetRefreshTokenByHash", entity.HashRefreshToken(presented)).Return(stored, nil)
+	mockRefreshTokenRepo.On("RevokeTokenFamily", "family-1", refreshTokenRevokedLogout).Return(nil)
+
+	message, err := logout.Execute("jti-1", "testuser", expiresAt, presented)
+
+	assert.NoError(t, err)
+	assert.Equal(t, "Logged out successfully", message)
+	mockTokenRevocationRepo.AssertExpectations(t)
+	mockRefreshTokenRepo.AssertExpectations(t)
+}
+
+// TestLogout_Execute_ForeignRefreshToken tests that the refresh token of another employee is not revoked
+func TestLogout_Execute_ForeignRefreshToken(t *testing.T) {
+	mockRefreshTokenRepo := new(mock_repo.MockRefreshTokenRepo)
+	mockTokenRevocationRepo := new(mock_repo.MockTokenRevocationRepo)
+
+	logout := NewLogout(mockRefreshTokenRepo, mockTokenRevocationRepo)
+
+	stored, presented := newStoredRefreshToken(t, entity.RefreshTokenStatusActive)
+	mockTokenRevocationRepo.On("CreateTokenRevocation", mock.AnythingOfType("*entity.TokenRevocation")).Return(nil)
+	mockRefreshTokenRepo.On("GetRefreshTokenByHash", entity.HashRefreshToken(presented)).Return(stored, nil)
+
+	_, err := logout.Execute("jti-1", "otheruser", time.Now().Add(time.Minute), presented)
+
+	assert.NoError(t, err)
+	mockRefreshTokenRepo.AssertNotCalled(t, "RevokeTokenFamily", mock.Anything, mock.Anything)
+}
+
+// TestLogout_Execute_ExpiredToken tests that an expired access token is not stored as revoked
+func TestLogout_Execute_ExpiredToken(t *testing.T) {
+	mockTokenRevocationRepo := new(mock_repo.MockTokenRevocationRepo)
+	logout := NewLogout(new(mock_repo.MockRefreshTokenRepo), mockTokenRevocationRepo)
+
+	message, err := logout.Execute("jti-1", "testuser", time.Now().Add(-time.Minute), "")
+
+	assert.NoError(t, err)
+	assert.Equal(t, "Logged out successfully", message)
+	mockTokenRevocationRepo.AssertNotCalled(t, "CreateTokenRevocation", mock.Anything)
+}
+
+// TestLogout_Execute_MissingTokenID tests that a token without jti cannot be revoked
+func TestLogout_Execute_MissingTokenID(t *testing.T) {
+	mockTokenRevocationRepo := new(mock_repo.MockTokenRevocationRepo)
+	logout := NewLogout(new(mock_repo.MockRefreshTokenRepo), mockTokenRevocationRepo)
+
+	_, err := logout.Execute(" ", "testuser", time.Now().Add(time.Minute), "")
+
+	assert.ErrorIs(t, err, custom_err.ErrMissingRequiredData)
+	mockTokenRevocationRepo.AssertNotCalled(t, "CreateTokenRevocation", mock.Anything)
+}
+
+// TestLogout_Execute_StoreFailure tests that a failed revocation fails the logout
+func TestLogout_Execute_StoreFailure(t *testing.T) {
+	mockRefreshTokenRepo := new(mock_repo.MockRefreshTokenRepo)
+	mockTokenRevocationRepo := new(mock_repo.MockTokenRevocationRepo)
+
+	logout := NewLogout(mockRefreshTokenRepo, mockTokenRevocationRepo)
+
+	mockTokenRevocationRepo.On("CreateTokenRevocation", mock.AnythingOfType("*entity.TokenRevocation")).Return(fmt.Errorf("database locked"))
+
+	message, err := logout.Execute("jti-1", "testuser", time.Now().Add(time.Minute), "refresh-token")
+
+	assert.ErrorIs(t, err, custom_err.ErrDatabase)
+	assert.Equal(t, "Failed to log out", message)
+	mockRefreshTokenRepo.AssertNotCalled(t, "GetRefreshTokenByHash", mock.Anything)
+}
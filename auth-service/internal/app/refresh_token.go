@@ -0,0 +1,105 @@
+package app
+
+import (
+	"auth-service/internal/config"
+	"auth-service/internal/domain/entity"
+	custom_err "auth-service/internal/domain/error"
+	"auth-service/internal/logging"
+	"auth-service/internal/ports"
+	"errors"
+	"fmt"
+	"gorm.io/gorm"
+	"strings"
+	"time"
+)
+
+const refreshTokenRevokedReuse = "rotated token reused"
+
+// RefreshToken is the use case for exchanging a refresh token for a new JWT and refresh token.
+type RefreshToken struct {
+	EmployeeRepo     ports.EmployeeRepo
+	TokenSigner      ports.TokenSigner
+	RefreshTokenRepo ports.RefreshTokenRepo
+}
+
+// NewRefreshToken creates a new RefreshToken use-case instance.
+func NewRefreshToken(employeeRepo ports.EmployeeRepo, tokenSigner ports.TokenSigner, refreshTokenRepo ports.RefreshTokenRepo) *RefreshToken {
+	return &RefreshToken{
+		EmployeeRepo:     employeeRepo,
+		TokenSigner:      tokenSigner,
+		RefreshTokenRepo: refreshTokenRepo,
+	}
+}
+
+// Execute rotates the refresh token into a new one of the same family and returns it with a JWT carrying the
+// current role of the employee. A rotated token presented again was either stolen or replayed, so the whole family
+// is revoked and its holder has to log in again. Other failures only reject the request, so an outage of the
+// database does not log employees out.
+func (r *RefreshToken) Execute(refreshToken string) (string, string, error) {
+	refreshToken = strings.TrimSpace(refreshToken)
+	if refreshToken == "" {
+		return "", "", custom_err.ErrInvalidRefreshToken
+	}
+
+	current, err := r.RefreshTokenRepo.GetRefreshTokenByHash(entity.HashRefreshToken(refreshToken))
+	if err != nil {
+		logging.Logger.Warn().Err(err).Msg("failed to get refresh token")
+		return "", "", custom_err.ErrDatabase
+	}
+	if current == nil {
+		logging.Logger.Warn().Msg("unknown refresh token")
+		return "", "", custom_err.ErrInvalidRefreshToken
+	}
+
+	switch {
+	case current.Status == entity.RefreshTokenStatusRotated:
+		r.revokeFamily(current, refreshTokenRevokedReuse)
+		return "", "", custom_err.ErrRefreshTokenReused
+	case current.Status != entity.RefreshTokenStatusActive || current.IsExpired(time.Now()):
+		logging.Logger.Warn().Str("username", current.Username).Str("status", current.Status).Msg("refresh token no longer valid")
+		return "", "", custom_err.ErrInvalidRefreshToken
+	}
+
+	employee, err := r.EmployeeRepo.GetEmployeeByUsername(current.Username)
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		logging.Logger.Warn().Err(err).Str("username", current.Username).Msg("failed to get employee")
+		return "", "", custom_err.ErrDatabase
+	}
+	if employee == nil || employee.Status != entity.EmployeeStatusValid {
+		// deleted employees cannot come back, their refresh tokens are rejected until they expire
+		logging.Logger.Warn().Str("username", current.Username).Msg("refresh token of unknown or invalid employee")
+		return "", "", custom_err.ErrInvalidRefreshToken
+	}
+
+	next, nextToken, err := entity.NewRefreshToken(employee.Username, current.FamilyID, config.Current().Auth.RefreshTokenDuration)
+	if err != nil {
+		logging.Logger.Warn().Err(err).Msg("failed to generate refresh token")
+		return "", "", fmt.Errorf("failed to generate refresh token: %w", err)
+	}
+
+	if err = r.RefreshTokenRepo.RotateRefreshToken(current, next); err != nil {
+		if errors.Is(err, custom_err.ErrRefreshTokenReused) {
+			// a concurrent refresh rotated the token first
+			r.revokeFamily(current, refreshTokenRevokedReuse)
+			return "", "", err
+		}
+		logging.Logger.Warn().Err(err).Str("username", employee.Username).Msg("failed to rotate refresh token")
+		return "", "", custom_err.ErrDatabase
+	}
+
+	token, err := r.TokenSigner.SignJWT(employee.Username, employee.Role, config.Current().Auth.JWTTokentDuration)
+	if err != nil {
+		logging.Logger.Warn().Err(err).Msg("failed to generate JWT token")
+		return "", "", fmt.Errorf("failed to generate token: %w", err)
+	}
+
+	return token, nextToken, nil
+}
+
+// revokeFamily revokes every refresh token of the family of the token
+func (r *RefreshToken) revokeFamily(token *entity.RefreshToken, reason string) {
+	logging.Logger.Warn().Str("username", token.Username).Str("family_id", token.FamilyID).Str("reason", reason).Msg("revoking refresh token family")
+	if err := r.RefreshTokenRepo.RevokeTokenFamily(token.FamilyID, reason); err != nil {
+		logging.Logger.Error().Err(err).Str("family_id", token.FamilyID).Msg("failed to revoke refresh token family")
+	}
+}
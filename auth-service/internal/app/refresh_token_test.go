@@ -0,0 +1,180 @@
+package app
+
+import (
+	"auth-service/internal/domain/entity"
+	custom_err "auth-service/internal/domain/error"
+	mock_auth "auth-service/internal/ports/mocks/auth"
+	mock_repo "auth-service/internal/ports/mocks/repo"
+	"fmt"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"gorm.io/gorm"
+	"testing"
+	"time"
+)
+
+func newStoredRefreshToken(t *testing.T, status string) (*entity.RefreshToken, string) {
+	stored, token, err := entity.NewRefreshToken("testuser", "family-1", time.Hour)
+	assert.NoError(t, err)
+	stored.Status = status
+	return stored, token
+}
+
+// TestRefreshToken_Execute_Success tests that a refresh rotates the token within its family
+func TestRefreshToken_Execute_Success(t *testing.T) {
+	mockEmployeeRepo := new(mock_repo.MockEmployeeRepo)
+	mockTokenSigner := new(mock_auth.MockTokenSigner)
+	mockRefreshTokenRepo := new(mock_repo.MockRefreshTokenRepo)
+
+	refresh := NewRefreshToken(mockEmployeeRepo, mockTokenSigner, mockRefreshTokenRepo)
+
+	current, presented := newStoredRefreshToken(t, entity.RefreshTokenStatusActive)
+	employee := &entity.Employee{Username: "testuser", Role: "editor", Status: entity.EmployeeStatusValid}
+
+	mockRefreshTokenRepo.On("GetRefreshTokenByHash", entity.HashRefreshToken(presented)).Return(current, nil)
+	mockEmployeeRepo.On("GetEmployeeByUsername", "testuser").Return(employee, nil)
+	mockRefreshTokenRepo.On("RotateRefreshToken", current, mock.MatchedBy(func(next *entity.RefreshToken) bool {
+		return next.FamilyID == "family-1" && next.Username == "testuser" && next.TokenHash != current.TokenHash
+	})).Return(nil)
+	mockTokenSigner.On("SignJWT", "testuser", "editor", mock.Anything).Return("jwt-token-123", nil)
+
+	token, refreshToken, err := refresh.Execute(presented)
+
+	assert.NoError(t, err)
+	assert.Equal(t, "jwt-token-123", token)
+	assert.NotEmpty(t, refreshToken)
+	assert.NotEqual(t, presented, refreshToken)
+	mockRefreshTokenRepo.AssertExpectations(t)
+	mockTokenSigner.AssertExpectations(t)
+}
+
+// TestRefreshToken_Execute_ReuseRevokesFamily tests that presenting a rotated token revokes its whole family
+func TestRefreshToken_Execute_ReuseRevokesFamily(t *testing.T) {
+	mockEmployeeRepo := new(mock_repo.MockEmployeeRepo)
+	mockTokenSigner := new(mock_auth.MockTokenSigner)
+	mockRefreshTokenRepo := new(mock_repo.MockRefreshTokenRepo)
+
+	refresh := NewRefreshToken(mockEmployeeRepo, mockTokenSigner, mockRefreshTokenRepo)
+
+	rotated, presented := newStoredRefreshToken(t, entity.RefreshTokenStatusRotated)
+	mockRefreshTokenRepo.On("GetRefreshTokenByHash", entity.HashRefreshToken(presented)).Return(rotated, nil)
+	mockRefreshTokenRepo.On("RevokeTokenFamily", "family-1", refreshTokenRevokedReuse).Return(nil)
+
+	token, refreshToken, err := refresh.Execute(presented)
+
+	assert.ErrorIs(t, err, custom_err.ErrRefreshTokenReused)
+	assert.Empty(t, token)
+	assert.Empty(t, refreshToken)
+	mockRefreshTokenRepo.AssertExpectations(t)
+	mockRefreshTokenRepo.AssertNotCalled(t, "RotateRefreshToken", mock.Anything, mock.Anything)
+	mockTokenSigner.AssertNotCalled(t, "SignJWT", mock.Anything, mock.Anything, mock.Anything)
+}
+
+// TestRefreshToken_Execute_ConcurrentRotation tests that losing a race to rotate the token revokes the family
+func TestRefreshToken_Execute_ConcurrentRotation(t *testing.T) {
+	mockEmployeeRepo := new(mock_repo.MockEmployeeRepo)
+	mockTokenSigner := new(mock_auth.MockTokenSigner)
+	mockRefreshTokenRepo := new(mock_repo.MockRefreshTokenRepo)
+
+	refresh := NewRefreshToken(mockEmployeeRepo, mockTokenSigner, mockRefreshTokenRepo)
+
+	current, presented := newStoredRefreshToken(t, entity.RefreshTokenStatusActive)
+	employee := &entity.Employee{Username: "testuser", Role: "editor", Status: entity.EmployeeStatusValid}
+
+	mockRefreshTokenRepo.On("GetRefreshTokenByHash", entity.HashRefreshToken(presented)).Return(current, nil)
+	mockEmployeeRepo.On("GetEmployeeByUsername", "testuser").Return(employee, nil)
+	mockRefreshTokenRepo.On("RotateRefreshToken", current, mock.AnythingOfType("*entity.RefreshToken")).Return(custom_err.ErrRefreshTokenReused)
+	mockRefreshTokenRepo.On("RevokeTokenFamily", "family-1", refreshTokenRevokedReuse).Return(nil)
+
+	_, _, err := refresh.Execute(presented)
+
+	assert.ErrorIs(t, err, custom_err.ErrRefreshTokenReused)
+	mockRefreshTokenRepo.AssertExpectations(t)
+	mockTokenSigner.AssertNotCalled(t, "SignJWT", mock.Anything, mock.Anything, mock.Anything)
+}
+
+// TestRefreshToken_Execute_InvalidToken tests unknown, revoked and expired refresh tokens
+func TestRefreshToken_Execute_InvalidToken(t *testing.T) {
+	revoked, revokedToken := newStoredRefreshToken(t, entity.RefreshTokenStatusRevoked)
+	expired, expiredToken := newStoredRefreshToken(t, entity.RefreshTokenStatusActive)
+	expired.ExpiresAt = time.Now().Add(-time.Minute)
+
+	tests := []struct {
+		name   string
+		token  string
+		stored *entity.RefreshToken
+	}{
+		{"Unknown", "unknown-token", nil},
+		{"Revoked", revokedToken, revoked},
+		{"Expired", expiredToken, expired},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRefreshTokenRepo := new(mock_repo.MockRefreshTokenRepo)
+			refresh := NewRefreshToken(new(mock_repo.MockEmployeeRepo), new(mock_auth.MockTokenSigner), mockRefreshTokenRepo)
+
+			mockRefreshTokenRepo.On("GetRefreshTokenByHash", entity.HashRefreshToken(tt.token)).Return(tt.stored, nil)
+
+			_, _, err := refresh.Execute(tt.token)
+
+			assert.ErrorIs(t, err, custom_err.ErrInvalidRefreshToken)
+			mockRefreshTokenRepo.AssertNotCalled(t, "RotateRefreshToken", mock.Anything, mock.Anything)
+			mockRefreshTokenRepo.AssertNotCalled(t, "RevokeTokenFamily", mock.Anything, mock.Anything)
+		})
+	}
+}
+
+// TestRefreshToken_Execute_EmployeeDeleted tests that the refresh token of a deleted employee is rejected
+func TestRefreshToken_Execute_EmployeeDeleted(t *testing.T) {
+	mockEmployeeRepo := new(mock_repo.MockEmployeeRepo)
+	mockRefreshTokenRepo := new(mock_repo.MockRefreshTokenRepo)
+
+	refresh := NewRefreshToken(mockEmployeeRepo, new(mock_auth.MockTokenSigner), mockRefreshTokenRepo)
+
+	current, presented := newStoredRefreshToken(t, entity.RefreshTokenStatusActive)
+	mockRefreshTokenRepo.On("GetRefreshTokenByHash", entity.HashRefreshToken(presented)).Return(current, nil)
+	mockEmployeeRepo.On("GetEmployeeByUsername", "testuser").Return(nil, gorm.ErrRecordNotFound)
+
+	_, _, err := refresh.Execute(presented)
+
+	assert.ErrorIs(t, err, custom_err.ErrInvalidRefreshToken)
+	mockRefreshTokenRepo.AssertNotCalled(t, "RotateRefreshToken", mock.Anything, mock.Anything)
+	mockRefreshTokenRepo.AssertNotCalled(t, "RevokeTokenFamily", mock.Anything, mock.Anything)
+}
+
+// TestRefreshToken_Execute_DatabaseError tests that database failures do not revoke the family
+func TestRefreshToken_Execute_DatabaseError(t *testing.T) {
+	t.Run("EmployeeLookup", func(t *testing.T) {
+		mockEmployeeRepo := new(mock_repo.MockEmployeeRepo)
+		mockRefreshTokenRepo := new(mock_repo.MockRefreshTokenRepo)
+
+		refresh := NewRefreshToken(mockEmployeeRepo, new(mock_auth.MockTokenSigner), mockRefreshTokenRepo)
+
+		current, presented := newStoredRefreshToken(t, entity.RefreshTokenStatusActive)
+		mockRefreshTokenRepo.On("GetRefreshTokenByHash", entity.HashRefreshToken(presented)).Return(current, nil)
+		mockEmployeeRepo.On("GetEmployeeByUsername", "testuser").Return(nil, fmt.Errorf("database is locked"))
+
+		_, _, err := refresh.Execute(presented)
+
+		assert.ErrorIs(t, err, custom_err.ErrDatabase)
+		mockRefreshTokenRepo.AssertNotCalled(t, "RevokeTokenFamily", mock.Anything, mock.Anything)
+	})
+
+	t.Run("Rotation", func(t *testing.T) {
+		mockEmployeeRepo := new(mock_repo.MockEmployeeRepo)
+		mockRefreshTokenRepo := new(mock_repo.MockRefreshTokenRepo)
+
+		refresh := NewRefreshToken(mockEmployeeRepo, new(mock_auth.MockTokenSigner), mockRefreshTokenRepo)
+
+		current, presented := newStoredRefreshToken(t, entity.RefreshTokenStatusActive)
+		mockRefreshTokenRepo.On("GetRefreshTokenByHash", entity.HashRefreshToken(presented)).Return(current, nil)
+		mockEmployeeRepo.On("GetEmployeeByUsername", "testuser").Return(&entity.Employee{Username: "testuser", Role: "admin", Status: entity.EmployeeStatusValid}, nil)
+		mockRefreshTokenRepo.On("RotateRefreshToken", current, mock.Anything).Return(fmt.Errorf("database is locked"))
+
+		_, _, err := refresh.Execute(presented)
+
+		assert.ErrorIs(t, err, custom_err.ErrDatabase)
+		mockRefreshTokenRepo.AssertNotCalled(t, "RevokeTokenFamily", mock.Anything, mock.Anything)
+	})
+}
@@ -0,0 +1,57 @@
+package app
+
+import (
+	custom_err "auth-service/internal/domain/error"
+	"auth-service/internal/logging"
+	"auth-service/internal/observability/metrics"
+	"auth-service/internal/ports"
+	"strings"
+)
+
+// ResetMFA is the use case for removing the second factor of an employee who lost it.
+type ResetMFA struct {
+	EmployeeRepo ports.EmployeeRepo
+	MFARepo      ports.MFARepo
+}
+
+// NewResetMFA creates a new ResetMFA use-case instance.
+func NewResetMFA(employeeRepo ports.EmployeeRepo, mfaRepo ports.MFARepo) *ResetMFA {
+	return &ResetMFA{
+		EmployeeRepo: employeeRepo,
+		MFARepo:      mfaRepo,
+	}
+}
+
+// Execute removes the MFA enrollment and recovery codes of the employee. The employee logs in with the password
+// alone afterwards, or enrolls again on the next login if MFA is mandatory for their role.
+func (r *ResetMFA) Execute(username, requester string) (string, error) {
+	metrics.IncRequestActive()
+	defer metrics.DecRequestActive()
+
+	var err error
+	defer func() {
+		metrics.RecordOperation("reset_mfa", err)
+	}()
+
+	username = strings.TrimSpace(username)
+	if username == "" {
+		logging.Logger.Warn().Msg("Invalid request")
+		err = custom_err.ErrMissingRequiredData
+		return "Missing required data username", err
+	}
+
+	if _, err = r.EmployeeRepo.GetEmployeeByUsername(username); err != nil {
+		logging.Logger.Warn().Err(err).Str("username", username).Msg("employee not found")
+		err = custom_err.ErrEmployeeNotFound
+		return "Employee not found", err
+	}
+
+	if err = r.MFARepo.DeleteMFA(username); err != nil {
+		logging.Logger.Error().Err(err).Str("username", username).Msg("failed to reset MFA")
+		err = custom_err.ErrDatabase
+		return "Failed to reset MFA", err
+	}
+
+	logging.Logger.Info().Str("username", username).Str("requester", requester).Msg("MFA reset")
+	return "MFA reset successfully", nil
+}
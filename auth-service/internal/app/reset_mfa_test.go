@@ -0,0 +1,60 @@
+package app
+
+import (
+	"auth-service/internal/domain/entity"
+	custom_err "auth-service/internal/domain/error"
+	mock_repo "auth-service/internal/ports/mocks/repo"
+	"fmt"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"testing"
+)
+
+// TestResetMFA_Execute_Success tests that the MFA of the employee is removed
+func TestResetMFA_Execute_Success(t *testing.T) {
+	mockEmployeeRepo := new(mock_repo.MockEmployeeRepo)
+	mockMFARepo := new(mock_repo.MockMFARepo)
+
+	reset := NewResetMFA(mockEmployeeRepo, mockMFARepo)
+
+	mockEmployeeRepo.On("GetEmployeeByUsername", "testuser").Return(&entity.Employee{Username: "testuser"}, nil)
+	mockMFARepo.On("DeleteMFA", "testuser").Return(nil)
+
+	message, err := reset.Execute("testuser", "admin")
+
+	assert.NoError(t, err)
+	assert.Equal(t, "MFA reset successfully", message)
+	mockMFARepo.AssertExpectations(t)
+}
+
+// TestResetMFA_Execute_EmployeeNotFound tests the reset of an unknown employee
+func TestResetMFA_Execute_EmployeeNotFound(t *testing.T) {
+	mockEmployeeRepo := new(mock_repo.MockEmployeeRepo)
+	mockMFARepo := new(mock_repo.MockMFARepo)
+
+	reset := NewResetMFA(mockEmployeeRepo, mockMFARepo)
+
+	mockEmployeeRepo.On("GetEmployeeByUsername", "ghost").Return(nil, fmt.Errorf("record not found"))
+
+	message, err := reset.Execute("ghost", "admin")
+
+	assert.ErrorIs(t, err, custom_err.ErrEmployeeNotFound)
+	assert.Equal(t, "Employee not found", message)
+	mockMFARepo.AssertNotCalled(t, "DeleteMFA", mock.Anything)
+}
+
+// TestResetMFA_Execute_DatabaseFailure tests a failing removal
+func TestResetMFA_Execute_DatabaseFailure(t *testing.T) {
+	mockEmployeeRepo := new(mock_repo.MockEmployeeRepo)
+	mockMFARepo := new(mock_repo.MockMFARepo)
+
+	reset := NewResetMFA(mockEmployeeRepo, mockMFARepo)
+
+	mockEmployeeRepo.On("GetEmployeeByUsername", "testuser").Return(&entity.Employee{Username: "testuser"}, nil)
+	mockMFARepo.On("DeleteMFA", "testuser").Return(fmt.Errorf("database locked"))
+
+	message, err := reset.Execute("testuser", "admin")
+
+	assert.ErrorIs(t, err, custom_err.ErrDatabase)
+	assert.Equal(t, "Failed to reset MFA", message)
+}
@@ -0,0 +1,56 @@
+package app
+
+import (
+	"auth-service/internal/domain/entity"
+	custom_err "auth-service/internal/domain/error"
+	"auth-service/internal/logging"
+	"auth-service/internal/observability/metrics"
+	"auth-service/internal/ports"
+	"strings"
+	"time"
+)
+
+// SetMFAPolicy is the use case for making MFA mandatory or optional for a role.
+type SetMFAPolicy struct {
+	MFAPolicyRepo ports.MFAPolicyRepo
+}
+
+// NewSetMFAPolicy creates a new SetMFAPolicy use-case instance.
+func NewSetMFAPolicy(mfaPolicyRepo ports.MFAPolicyRepo) *SetMFAPolicy {
+	return &SetMFAPolicy{
+		MFAPolicyRepo: mfaPolicyRepo,
+	}
+}
+
+// Execute sets whether employees of the role need MFA. It applies from their next login; those who have not
+// enrolled yet have to enroll before they get tokens.
+func (s *SetMFAPolicy) Execute(role string, required bool, requester string) (string, error) {
+	metrics.IncRequestActive()
+	defer metrics.DecRequestActive()
+
+	var err error
+	defer func() {
+		metrics.RecordOperation("set_mfa_policy", err)
+	}()
+
+	role = strings.TrimSpace(role)
+	if role != entity.EmployeeRoleAdmin && role != entity.EmployeeRoleViewer && role != entity.EmployeeRoleEditor {
+		logging.Logger.Warn().Err(custom_err.ErrInvalidRole).Str("role", role).Msg("Invalid request")
+		err = custom_err.ErrInvalidRole
+		return "Invalid role", err
+	}
+
+	err = s.MFAPolicyRepo.SaveMFAPolicy(&entity.MFAPolicy{
+		Role:      role,
+		Required:  required,
+		UpdatedBy: requester,
+		UpdatedAt: time.Now(),
+	})
+	if err != nil {
+		logging.Logger.Error().Err(err).Str("role", role).Msg("failed to store MFA policy")
+		err = custom_err.ErrDatabase
+		return "Failed to update MFA policy", err
+	}
+
+	return "MFA policy updated successfully", nil
+}
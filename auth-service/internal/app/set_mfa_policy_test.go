@@ -0,0 +1,40 @@
+package app
+
+import (
+	"auth-service/internal/domain/entity"
+	custom_err "auth-service/internal/domain/error"
+	mock_repo "auth-service/internal/ports/mocks/repo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"testing"
+)
+
+// TestSetMFAPolicy_Execute_Success tests that the policy of the role is stored
+func TestSetMFAPolicy_Execute_Success(t *testing.T) {
+	mockMFAPolicyRepo := new(mock_repo.MockMFAPolicyRepo)
+
+	setPolicy := NewSetMFAPolicy(mockMFAPolicyRepo)
+
+	mockMFAPolicyRepo.On("SaveMFAPolicy", mock.MatchedBy(func(policy *entity.MFAPolicy) bool {
+		return policy.Role == entity.EmployeeRoleAdmin && policy.Required && policy.UpdatedBy == "root"
+	})).Return(nil)
+
+	message, err := setPolicy.Execute("admin", true, "root")
+
+	assert.NoError(t, err)
+	assert.Equal(t, "MFA policy updated successfully", message)
+	mockMFAPolicyRepo.AssertExpectations(t)
+}
+
+// TestSetMFAPolicy_Execute_InvalidRole tests that only known roles get a policy
+func TestSetMFAPolicy_Execute_InvalidRole(t *testing.T) {
+	mockMFAPolicyRepo := new(mock_repo.MockMFAPolicyRepo)
+
+	setPolicy := NewSetMFAPolicy(mockMFAPolicyRepo)
+
+	message, err := setPolicy.Execute("superuser", true, "root")
+
+	assert.ErrorIs(t, err, custom_err.ErrInvalidRole)
+	assert.Equal(t, "Invalid role", message)
+	mockMFAPolicyRepo.AssertNotCalled(t, "SaveMFAPolicy", mock.Anything)
+}
@@ -13,17 +13,19 @@ import (
 
 // UpdateEmployee is the use-case for updating an employee's role.
 type UpdateEmployee struct {
-	EmployeeRepo ports.EmployeeRepo
+	EmployeeRepo        ports.EmployeeRepo
+	TokenRevocationRepo ports.TokenRevocationRepo
 }
 
 // NewUpdateEmployee creates a new UpdateEmployee use-case instance.
-func NewUpdateEmployee(employeeRepo ports.EmployeeRepo) *UpdateEmployee {
+func NewUpdateEmployee(employeeRepo ports.EmployeeRepo, tokenRevocationRepo ports.TokenRevocationRepo) *UpdateEmployee {
 	return &UpdateEmployee{
-		EmployeeRepo: employeeRepo,
+		EmployeeRepo:        employeeRepo,
+		TokenRevocationRepo: tokenRevocationRepo,
 	}
 }
 
-// Execute updates an employee's role.
+// Execute updates an employee's role. A changed role revokes the access tokens issued with the former role.
 func (a *UpdateEmployee) Execute(username, role, requester string) (string, error) {
 	metrics.IncRequestActive()
 	defer metrics.DecRequestActive()
@@ -55,6 +57,7 @@ func (a *UpdateEmployee) Execute(username, role, requester string) (string, erro
 		return "Employee not found", err
 	}
 
+	roleChanged := employee.Role != role
 	employee.Role = role
 	employee.UpdatedBy = requester
 
@@ -64,6 +67,10 @@ func (a *UpdateEmployee) Execute(username, role, requester string) (string, erro
 		return "Failed to update employee role", err
 	}
 
+	if roleChanged {
+		revokeEmployeeTokens(a.TokenRevocationRepo, username, entity.TokenRevocationReasonRoleChanged)
+	}
+
 	_ = messaging.GetService().PublishToDefaultTopic(messaging.Message{Content: employee.ToString(), Status: true, Type: messaging.MessageTypeEmployeeUpdated})
 	return "Employee role updated successfully", nil
 }
@@ -12,7 +12,7 @@ import (
 func TestUpdateEmployee_Execute_Success(t *testing.T) {
 	mockEmployeeRepo := new(mock_repo.MockEmployeeRepo)
 
-	updateEmployee := NewUpdateEmployee(mockEmployeeRepo)
+	updateEmployee := NewUpdateEmployee(mockEmployeeRepo, newTestTokenRevocationRepo())
 
 	username := "john_doe"
 	role := "admin"
@@ -58,7 +58,7 @@ func TestUpdateEmployee_Execute_SuccessDifferentRoles(t *testing.T) {
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
 			mockEmployeeRepo := new(mock_repo.MockEmployeeRepo)
-			updateEmployee := NewUpdateEmployee(mockEmployeeRepo)
+			updateEmployee := NewUpdateEmployee(mockEmployeeRepo, newTestTokenRevocationRepo())
 
 			username := "test_user"
 			requester := "admin_user"
@@ -80,3 +80,43 @@ func TestUpdateEmployee_Execute_SuccessDifferentRoles(t *testing.T) {
 		})
 	}
 }
+
+// TestUpdateEmployee_Execute_RoleChangeRevokesTokens tests that a changed role revokes the tokens of the former role
+func TestUpdateEmployee_Execute_RoleChangeRevokesTokens(t *testing.T) {
+	mockEmployeeRepo := new(mock_repo.MockEmployeeRepo)
+	mockTokenRevocationRepo := new(mock_repo.MockTokenRevocationRepo)
+
+	updateEmployee := NewUpdateEmployee(mockEmployeeRepo, mockTokenRevocationRepo)
+
+	employee := &entity.Employee{Username: "john_doe", Role: "admin", Status: entity.EmployeeStatusValid}
+	mockEmployeeRepo.On("GetEmployeeByUsername", "john_doe").Return(employee, nil)
+	mockEmployeeRepo.On("UpdateEmployee", mock.AnythingOfType("*entity.Employee")).Return(employee, nil)
+	mockTokenRevocationRepo.On("CreateTokenRevocation", mock.MatchedBy(func(revocation *entity.TokenRevocation) bool {
+		return revocation.Username == "john_doe" &&
+			revocation.RevokedBefore != nil &&
+			revocation.Reason == entity.TokenRevocationReasonRoleChanged
+	})).Return(nil)
+
+	message, err := updateEmployee.Execute("john_doe", "viewer", "admin_user")
+
+	assert.NoError(t, err)
+	assert.Equal(t, "Employee role updated successfully", message)
+	mockTokenRevocationRepo.AssertExpectations(t)
+}
+
+// TestUpdateEmployee_Execute_SameRoleKeepsTokens tests that setting the current role again revokes nothing
+func TestUpdateEmployee_Execute_SameRoleKeepsTokens(t *testing.T) {
+	mockEmployeeRepo := new(mock_repo.MockEmployeeRepo)
+	mockTokenRevocationRepo := new(mock_repo.MockTokenRevocationRepo)
+
+	updateEmployee := NewUpdateEmployee(mockEmployeeRepo, mockTokenRevocationRepo)
+
+	employee := &entity.Employee{Username: "john_doe", Role: "editor", Status: entity.EmployeeStatusValid}
+	mockEmployeeRepo.On("GetEmployeeByUsername", "john_doe").Return(employee, nil)
+	mockEmployeeRepo.On("UpdateEmployee", mock.AnythingOfType("*entity.Employee")).Return(employee, nil)
+
+	_, err := updateEmployee.Execute("john_doe", "editor", "admin_user")
+
+	assert.NoError(t, err)
+	mockTokenRevocationRepo.AssertNotCalled(t, "CreateTokenRevocation", mock.Anything)
+}
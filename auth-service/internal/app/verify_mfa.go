@@ -0,0 +1,182 @@
+package app
+
+import (
+	"auth-service/internal/domain/entity"
+	custom_err "auth-service/internal/domain/error"
+	"auth-service/internal/logging"
+	"auth-service/internal/ports"
+	"errors"
+	"strings"
+	"time"
+)
+
+const (
+	// mfaChallengeMaxAttempts is the number of codes that can be tried with a challenge
+	mfaChallengeMaxAttempts = 5
+	// mfaMaxFailedAttempts is the number of wrong codes in a row over all challenges of an employee after which
+	// verification is locked for mfaLockoutDuration, so new password logins do not allow more guesses
+	mfaMaxFailedAttempts = 10
+	mfaLockoutDuration   = 15 * time.Minute
+)
+
+// VerifyMFA is the use case for completing a login with a TOTP or recovery code.
+type VerifyMFA struct {
+	EmployeeRepo     ports.EmployeeRepo
+	TokenSigner      ports.TokenSigner
+	RefreshTokenRepo ports.RefreshTokenRepo
+	MFARepo          ports.MFARepo
+	TOTP             ports.TOTP
+}
+
+// NewVerifyMFA creates a new VerifyMFA use-case instance.
+func NewVerifyMFA(employeeRepo ports.EmployeeRepo, tokenSigner ports.TokenSigner, refreshTokenRepo ports.RefreshTokenRepo, mfaRepo ports.MFARepo, totp ports.TOTP) *VerifyMFA {
+	return &VerifyMFA{
+		EmployeeRepo:     employeeRepo,
+		TokenSigner:      tokenSigner,
+		RefreshTokenRepo: refreshTokenRepo,
+		MFARepo:          mfaRepo,
+		TOTP:             totp,
+	}
+}
+
+// Execute exchanges the challenge token of a login for the JWT token and refresh token, verified with either a
+// TOTP code or an unused recovery code. A TOTP code is accepted once. When the challenge requires enrollment, the
+// code of the enrolled authenticator app enables MFA and the recovery codes are returned as well. The challenge is
+// single use. Every verification is counted before the code is checked, against the challenge and against the
+// employee, who is locked out for a while after too many wrong codes.
+func (v *VerifyMFA) Execute(challengeToken, code, recoveryCode string) (string, string, []string, error) {
+	code = strings.TrimSpace(code)
+	recoveryCode = strings.TrimSpace(recoveryCode)
+	if code == "" && recoveryCode == "" {
+		return "", "", nil, custom_err.ErrMissingRequiredData
+	}
+
+	challenge, err := findMFAChallenge(v.MFARepo, challengeToken)
+	if err != nil {
+		return "", "", nil, err
+	}
+
+	employee, err := v.EmployeeRepo.GetEmployeeByUsername(challenge.Username)
+	if err != nil || employee.Status != entity.EmployeeStatusValid {
+		logging.Logger.Warn().Err(err).Str("username", challenge.Username).Msg("MFA challenge of unknown or invalid employee")
+		return "", "", nil, custom_err.ErrInvalidMFAChallenge
+	}
+
+	mfa, err := v.MFARepo.GetMFA(employee.Username)
+	if err != nil {
+		logging.Logger.Warn().Err(err).Str("username", employee.Username).Msg("failed to get MFA")
+		return "", "", nil, custom_err.ErrDatabase
+	}
+	if mfa == nil || (!mfa.IsEnabled() && !challenge.EnrollmentRequired) {
+		return "", "", nil, custom_err.ErrMFANotEnrolled
+	}
+
+	if err = v.claimAttempt(challenge); err != nil {
+		return "", "", nil, err
+	}
+
+	var recoveryCodes []string
+	switch {
+	case code != "" && !mfa.IsEnabled():
+		recoveryCodes, err = activateMFA(v.MFARepo, v.TOTP, mfa, code)
+	case code != "":
+		err = v.useCode(mfa, code)
+	case mfa.IsEnabled():
+		err = v.MFARepo.UseRecoveryCode(employee.Username, entity.HashMFAToken(entity.NormalizeRecoveryCode(recoveryCode)))
+	default:
+		err = custom_err.ErrMFANotEnrolled
+	}
+	if err != nil {
+		logging.Logger.Warn().Err(err).Str("username", employee.Username).Msg("MFA verification failed")
+		return "", "", nil, err
+	}
+
+	if err = v.MFARepo.ResetMFAAttempts(employee.Username); err != nil {
+		logging.Logger.Error().Err(err).Str("username", employee.Username).Msg("failed to reset MFA attempts")
+	}
+
+	if err = v.MFARepo.ConsumeMFAChallenge(challenge.ID); err != nil {
+		logging.Logger.Warn().Err(err).Str("username", employee.Username).Msg("failed to consume MFA challenge")
+		return "", "", nil, custom_err.ErrInvalidMFAChallenge
+	}
+
+	token, refreshToken, err := issueTokens(v.TokenSigner, v.RefreshTokenRepo, employee)
+	if err != nil {
+		return "", "", nil, err
+	}
+
+	return token, refreshToken, recoveryCodes, nil
+}
+
+// useCode accepts a TOTP code of the enabled MFA, unless a code of the same time step was accepted before
+func (v *VerifyMFA) useCode(mfa *entity.EmployeeMFA, code string) error {
+	step, ok := v.TOTP.Validate(mfa.Secret, code, time.Now())
+	if !ok {
+		return custom_err.ErrInvalidMFACode
+	}
+	return v.MFARepo.UseMFAStep(mfa.Username, step)
+}
+
+// claimAttempt counts the verification against the challenge and the employee before the code is checked
+func (v *VerifyMFA) claimAttempt(challenge *entity.MFAChallenge) error {
+	err := v.MFARepo.ClaimMFAChallengeAttempt(challenge.ID, mfaChallengeMaxAttempts)
+	if err != nil {
+		if errors.Is(err, custom_err.ErrInvalidMFAChallenge) {
+			logging.Logger.Warn().Str("username", challenge.Username).Msg("MFA challenge exhausted")
+			return err
+		}
+		logging.Logger.Warn().Err(err).Str("username", challenge.Username).Msg("failed to count MFA challenge attempt")
+		return custom_err.ErrDatabase
+	}
+
+	err = v.MFARepo.ClaimMFAAttempt(challenge.Username, mfaMaxFailedAttempts, mfaLockoutDuration)
+	if err != nil {
+		if errors.Is(err, custom_err.ErrMFALocked) {
+			logging.Logger.Warn().Str("username", challenge.Username).Msg("MFA locked after too many failed attempts")
+			return err
+		}
+		logging.Logger.Warn().Err(err).Str("username", challenge.Username).Msg("failed to count MFA attempt")
+		return custom_err.ErrDatabase
+	}
+	return nil
+}
+
+// findMFAChallenge returns the challenge of the token if it can still be verified
+func findMFAChallenge(mfaRepo ports.MFARepo, challengeToken string) (*entity.MFAChallenge, error) {
+	challengeToken = strings.TrimSpace(challengeToken)
+	if challengeToken == "" {
+		return nil, custom_err.ErrInvalidMFAChallenge
+	}
+
+	challenge, err := mfaRepo.GetMFAChallengeByHash(entity.HashMFAToken(challengeToken))
+	if err != nil {
+		logging.Logger.Warn().Err(err).Msg("failed to get MFA challenge")
+		return nil, custom_err.ErrDatabase
+	}
+	if challenge == nil || challenge.IsExpired(time.Now()) || challenge.Attempts >= mfaChallengeMaxAttempts {
+		logging.Logger.Warn().Msg("unknown, expired or exhausted MFA challenge")
+		return nil, custom_err.ErrInvalidMFAChallenge
+	}
+
+	return challenge, nil
+}
+
+// activateMFA enables the pending MFA with the first code of the enrolled authenticator app and returns the new
+// recovery codes
+func activateMFA(mfaRepo ports.MFARepo, totp ports.TOTP, mfa *entity.EmployeeMFA, code string) ([]string, error) {
+	step, ok := totp.Validate(mfa.Secret, code, time.Now())
+	if !ok {
+		return nil, custom_err.ErrInvalidMFACode
+	}
+
+	stored, recoveryCodes, err := entity.NewMFARecoveryCodes(mfa.Username)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = mfaRepo.EnableMFA(mfa.Username, step, stored); err != nil {
+		return nil, err
+	}
+
+	return recoveryCodes, nil
+}
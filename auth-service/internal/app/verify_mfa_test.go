@@ -0,0 +1,250 @@
+package app
+
+import (
+	"auth-service/internal/domain/entity"
+	custom_err "auth-service/internal/domain/error"
+	mock_auth "auth-service/internal/ports/mocks/auth"
+	mock_repo "auth-service/internal/ports/mocks/repo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"testing"
+	"time"
+)
+
+type verifyMFAMocks struct {
+	employeeRepo     *mock_repo.MockEmployeeRepo
+	tokenSigner      *mock_auth.MockTokenSigner
+	refreshTokenRepo *mock_repo.MockRefreshTokenRepo
+	mfaRepo          *mock_repo.MockMFARepo
+	totp             *mock_auth.MockTOTP
+}
+
+// newVerifyMFATest returns the use case with a stored challenge of testuser and the token to present
+func newVerifyMFATest(t *testing.T, enrollmentRequired bool) (*VerifyMFA, *verifyMFAMocks, *entity.MFAChallenge, string) {
+	m := &verifyMFAMocks{
+		employeeRepo:     new(mock_repo.MockEmployeeRepo),
+		tokenSigner:      new(mock_auth.MockTokenSigner),
+		refreshTokenRepo: new(mock_repo.MockRefreshTokenRepo),
+		mfaRepo:          new(mock_repo.MockMFARepo),
+		totp:             new(mock_auth.MockTOTP),
+	}
+
+	challenge, token, err := entity.NewMFAChallenge("testuser", enrollmentRequired, time.Minute)
+	assert.NoError(t, err)
+
+	employee := &entity.Employee{Username: "testuser", Role: "admin", Status: entity.EmployeeStatusValid}
+	m.mfaRepo.On("GetMFAChallengeByHash", entity.HashMFAToken(token)).Return(challenge, nil)
+	m.employeeRepo.On("GetEmployeeByUsername", "testuser").Return(employee, nil)
+
+	return NewVerifyMFA(m.employeeRepo, m.tokenSigner, m.refreshTokenRepo, m.mfaRepo, m.totp), m, challenge, token
+}
+
+// expectAttempt expects the verification to be counted against the challenge and the employee
+func (m *verifyMFAMocks) expectAttempt(challenge *entity.MFAChallenge) {
+	m.mfaRepo.On("ClaimMFAChallengeAttempt", challenge.ID, mfaChallengeMaxAttempts).Return(nil)
+	m.mfaRepo.On("ClaimMFAAttempt", "testuser", mfaMaxFailedAttempts, mfaLockoutDuration).Return(nil)
+}
+
+func (m *verifyMFAMocks) expectTokens() {
+	m.tokenSigner.On("SignJWT", "testuser", "admin", mock.Anything).Return("jwt-token-123", nil)
+	m.refreshTokenRepo.On("CreateRefreshToken", mock.AnythingOfType("*entity.RefreshToken")).Return(nil)
+}
+
+// TestVerifyMFA_Execute_Success tests that a valid TOTP code completes the login
+func TestVerifyMFA_Execute_Success(t *testing.T) {
+	verify, m, challenge, token := newVerifyMFATest(t, false)
+
+	mfa := &entity.EmployeeMFA{Username: "testuser", Secret: "SECRET", Status: entity.MFAStatusEnabled}
+	m.mfaRepo.On("GetMFA", "testuser").Return(mfa, nil)
+	m.totp.On("Validate", "SECRET", "123456", mock.Anything).Return(int64(42), true)
+	m.mfaRepo.On("UseMFAStep", "testuser", int64(42)).Return(nil)
+	m.expectAttempt(challenge)
+	m.mfaRepo.On("ResetMFAAttempts", "testuser").Return(nil)
+	m.mfaRepo.On("ConsumeMFAChallenge", challenge.ID).Return(nil)
+	m.expectTokens()
+
+	jwt, refreshToken, recoveryCodes, err := verify.Execute(token, "123456", "")
+
+	assert.NoError(t, err)
+	assert.Equal(t, "jwt-token-123", jwt)
+	assert.NotEmpty(t, refreshToken)
+	assert.Empty(t, recoveryCodes)
+	m.mfaRepo.AssertExpectations(t)
+	m.refreshTokenRepo.AssertExpectations(t)
+}
+
+// TestVerifyMFA_Execute_InvalidCode tests that a wrong code stays counted as attempt and keeps the challenge
+func TestVerifyMFA_Execute_InvalidCode(t *testing.T) {
+	verify, m, challenge, token := newVerifyMFATest(t, false)
+
+	mfa := &entity.EmployeeMFA{Username: "testuser", Secret: "SECRET", Status: entity.MFAStatusEnabled}
+	m.mfaRepo.On("GetMFA", "testuser").Return(mfa, nil)
+	m.totp.On("Validate", "SECRET", "000000", mock.Anything).Return(int64(0), false)
+	m.expectAttempt(challenge)
+
+	_, _, _, err := verify.Execute(token, "000000", "")
+
+	assert.ErrorIs(t, err, custom_err.ErrInvalidMFACode)
+	m.mfaRepo.AssertExpectations(t)
+	m.mfaRepo.AssertNotCalled(t, "ResetMFAAttempts", mock.Anything)
+	m.mfaRepo.AssertNotCalled(t, "ConsumeMFAChallenge", mock.Anything)
+	m.tokenSigner.AssertNotCalled(t, "SignJWT", mock.Anything, mock.Anything, mock.Anything)
+}
+
+// TestVerifyMFA_Execute_ReplayedCode tests that a code of an already used time step is rejected
+func TestVerifyMFA_Execute_ReplayedCode(t *testing.T) {
+	verify, m, challenge, token := newVerifyMFATest(t, false)
+
+	mfa := &entity.EmployeeMFA{Username: "testuser", Secret: "SECRET", Status: entity.MFAStatusEnabled, LastUsedStep: 42}
+	m.mfaRepo.On("GetMFA", "testuser").Return(mfa, nil)
+	m.totp.On("Validate", "SECRET", "123456", mock.Anything).Return(int64(42), true)
+	m.mfaRepo.On("UseMFAStep", "testuser", int64(42)).Return(custom_err.ErrInvalidMFACode)
+	m.expectAttempt(challenge)
+
+	_, _, _, err := verify.Execute(token, "123456", "")
+
+	assert.ErrorIs(t, err, custom_err.ErrInvalidMFACode)
+	m.mfaRepo.AssertNotCalled(t, "ConsumeMFAChallenge", mock.Anything)
+}
+
+// TestVerifyMFA_Execute_ChallengeExhausted tests that no code is checked once the attempts of the challenge are
+// used up, e.g. by concurrent requests after the challenge was read
+func TestVerifyMFA_Execute_ChallengeExhausted(t *testing.T) {
+	verify, m, challenge, token := newVerifyMFATest(t, false)
+
+	m.mfaRepo.On("GetMFA", "testuser").Return(&entity.EmployeeMFA{Username: "testuser", Secret: "SECRET", Status: entity.MFAStatusEnabled}, nil)
+	m.mfaRepo.On("ClaimMFAChallengeAttempt", challenge.ID, mfaChallengeMaxAttempts).Return(custom_err.ErrInvalidMFAChallenge)
+
+	_, _, _, err := verify.Execute(token, "123456", "")
+
+	assert.ErrorIs(t, err, custom_err.ErrInvalidMFAChallenge)
+	m.mfaRepo.AssertNotCalled(t, "ClaimMFAAttempt", mock.Anything, mock.Anything, mock.Anything)
+	m.totp.AssertNotCalled(t, "Validate", mock.Anything, mock.Anything, mock.Anything)
+}
+
+// TestVerifyMFA_Execute_Locked tests that no code is checked while the employee is locked out, whatever challenge
+// is presented
+func TestVerifyMFA_Execute_Locked(t *testing.T) {
+	verify, m, challenge, token := newVerifyMFATest(t, false)
+
+	m.mfaRepo.On("GetMFA", "testuser").Return(&entity.EmployeeMFA{Username: "testuser", Secret: "SECRET", Status: entity.MFAStatusEnabled}, nil)
+	m.mfaRepo.On("ClaimMFAChallengeAttempt", challenge.ID, mfaChallengeMaxAttempts).Return(nil)
+	m.mfaRepo.On("ClaimMFAAttempt", "testuser", mfaMaxFailedAttempts, mfaLockoutDuration).Return(custom_err.ErrMFALocked)
+
+	_, _, _, err := verify.Execute(token, "", "abcde-fghij")
+
+	assert.ErrorIs(t, err, custom_err.ErrMFALocked)
+	m.totp.AssertNotCalled(t, "Validate", mock.Anything, mock.Anything, mock.Anything)
+	m.mfaRepo.AssertNotCalled(t, "UseRecoveryCode", mock.Anything, mock.Anything)
+}
+
+// TestVerifyMFA_Execute_RecoveryCode tests that an unused recovery code completes the login
+func TestVerifyMFA_Execute_RecoveryCode(t *testing.T) {
+	verify, m, challenge, token := newVerifyMFATest(t, false)
+
+	mfa := &entity.EmployeeMFA{Username: "testuser", Secret: "SECRET", Status: entity.MFAStatusEnabled}
+	m.mfaRepo.On("GetMFA", "testuser").Return(mfa, nil)
+	m.mfaRepo.On("UseRecoveryCode", "testuser", entity.HashMFAToken("abcdefghij")).Return(nil)
+	m.expectAttempt(challenge)
+	m.mfaRepo.On("ResetMFAAttempts", "testuser").Return(nil)
+	m.mfaRepo.On("ConsumeMFAChallenge", challenge.ID).Return(nil)
+	m.expectTokens()
+
+	jwt, _, _, err := verify.Execute(token, "", " ABCDE-fghij ")
+
+	assert.NoError(t, err)
+	assert.Equal(t, "jwt-token-123", jwt)
+	m.mfaRepo.AssertExpectations(t)
+	m.totp.AssertNotCalled(t, "Validate", mock.Anything, mock.Anything, mock.Anything)
+}
+
+// TestVerifyMFA_Execute_CompletesEnrollment tests that the first code of an enrollment required by the role
+// enables MFA and returns the recovery codes with the tokens
+func TestVerifyMFA_Execute_CompletesEnrollment(t *testing.T) {
+	verify, m, challenge, token := newVerifyMFATest(t, true)
+
+	mfa := &entity.EmployeeMFA{Username: "testuser", Secret: "SECRET", Status: entity.MFAStatusPending}
+	m.mfaRepo.On("GetMFA", "testuser").Return(mfa, nil)
+	m.totp.On("Validate", "SECRET", "123456", mock.Anything).Return(int64(42), true)
+	m.mfaRepo.On("EnableMFA", "testuser", int64(42), mock.MatchedBy(func(codes []*entity.MFARecoveryCode) bool {
+		return len(codes) == entity.MFARecoveryCodeCount
+	})).Return(nil)
+	m.expectAttempt(challenge)
+	m.mfaRepo.On("ResetMFAAttempts", "testuser").Return(nil)
+	m.mfaRepo.On("ConsumeMFAChallenge", challenge.ID).Return(nil)
+	m.expectTokens()
+
+	jwt, _, recoveryCodes, err := verify.Execute(token, "123456", "")
+
+	assert.NoError(t, err)
+	assert.Equal(t, "jwt-token-123", jwt)
+	assert.Len(t, recoveryCodes, entity.MFARecoveryCodeCount)
+	m.mfaRepo.AssertExpectations(t)
+}
+
+// TestVerifyMFA_Execute_NotEnrolled tests that a challenge requiring enrollment needs EnrollMFA first
+func TestVerifyMFA_Execute_NotEnrolled(t *testing.T) {
+	verify, m, _, token := newVerifyMFATest(t, true)
+	m.mfaRepo.On("GetMFA", "testuser").Return(nil, nil)
+
+	_, _, _, err := verify.Execute(token, "123456", "")
+
+	assert.ErrorIs(t, err, custom_err.ErrMFANotEnrolled)
+	m.mfaRepo.AssertNotCalled(t, "ConsumeMFAChallenge", mock.Anything)
+}
+
+// TestVerifyMFA_Execute_PendingWithoutEnrollment tests that a pending enrollment cannot be completed by the
+// challenge of a login that does not require enrollment
+func TestVerifyMFA_Execute_PendingWithoutEnrollment(t *testing.T) {
+	verify, m, _, token := newVerifyMFATest(t, false)
+	m.mfaRepo.On("GetMFA", "testuser").Return(&entity.EmployeeMFA{Username: "testuser", Status: entity.MFAStatusPending}, nil)
+
+	_, _, _, err := verify.Execute(token, "123456", "")
+
+	assert.ErrorIs(t, err, custom_err.ErrMFANotEnrolled)
+	m.mfaRepo.AssertNotCalled(t, "EnableMFA", mock.Anything, mock.Anything, mock.Anything)
+}
+
+// TestVerifyMFA_Execute_InvalidChallenge tests unknown, expired and exhausted challenges
+func TestVerifyMFA_Execute_InvalidChallenge(t *testing.T) {
+	expired, expiredToken, _ := entity.NewMFAChallenge("testuser", false, time.Minute)
+	expired.ExpiresAt = time.Now().Add(-time.Second)
+	exhausted, exhaustedToken, _ := entity.NewMFAChallenge("testuser", false, time.Minute)
+	exhausted.Attempts = mfaChallengeMaxAttempts
+
+	tests := []struct {
+		name      string
+		token     string
+		challenge *entity.MFAChallenge
+	}{
+		{"Unknown", "unknown-token", nil},
+		{"Expired", expiredToken, expired},
+		{"Exhausted", exhaustedToken, exhausted},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockMFARepo := new(mock_repo.MockMFARepo)
+			mockEmployeeRepo := new(mock_repo.MockEmployeeRepo)
+			verify := NewVerifyMFA(mockEmployeeRepo, new(mock_auth.MockTokenSigner), new(mock_repo.MockRefreshTokenRepo), mockMFARepo, new(mock_auth.MockTOTP))
+
+			mockMFARepo.On("GetMFAChallengeByHash", entity.HashMFAToken(tt.token)).Return(tt.challenge, nil)
+
+			_, _, _, err := verify.Execute(tt.token, "123456", "")
+
+			assert.ErrorIs(t, err, custom_err.ErrInvalidMFAChallenge)
+			mockEmployeeRepo.AssertNotCalled(t, "GetEmployeeByUsername", mock.Anything)
+		})
+	}
+}
+
+// TestVerifyMFA_Execute_MissingCode tests that either a code or a recovery code is required
+func TestVerifyMFA_Execute_MissingCode(t *testing.T) {
+	mockMFARepo := new(mock_repo.MockMFARepo)
+	verify := NewVerifyMFA(new(mock_repo.MockEmployeeRepo), new(mock_auth.MockTokenSigner), new(mock_repo.MockRefreshTokenRepo), mockMFARepo, new(mock_auth.MockTOTP))
+
+	_, _, _, err := verify.Execute("challenge-token", " ", "")
+
+	assert.ErrorIs(t, err, custom_err.ErrMissingRequiredData)
+	mockMFARepo.AssertNotCalled(t, "GetMFAChallengeByHash", mock.Anything)
+}
@@ -63,9 +63,14 @@ type Config struct {
 }
 
 type AuthConfig struct {
-	HashKey           string        `koanf:"hash_key"`
-	JWTSecret         string        `koanf:"jwt_secret"`
-	JWTTokentDuration time.Duration `koanf:"jwt_token_duration"`
+	HashKey                    string        `koanf:"hash_key"`
+	JWTTokentDuration          time.Duration `koanf:"jwt_token_duration"`
+	RefreshTokenDuration       time.Duration `koanf:"refresh_token_duration"` // renewed on every refresh
+	SigningKeyRotationInterval time.Duration `koanf:"signing_key_rotation_interval" validate:"gt=0"`
+	SigningKeyPublishAhead     time.Duration `koanf:"signing_key_publish_ahead" validate:"gt=0,ltfield=SigningKeyRotationInterval"`
+	SigningKeyOverlap          time.Duration `koanf:"signing_key_overlap"`            // retired keys still verify, at least jwt_token_duration
+	MFAIssuer                  string        `koanf:"mfa_issuer" validate:"required"` // shown in authenticator apps
+	MFAChallengeDuration       time.Duration `koanf:"mfa_challenge_duration" validate:"gt=0"`
 }
 
 type MessagePublisherConfig struct {
@@ -289,9 +294,16 @@ func defaults() map[string]any {
 			"admin_password": "admin",
 		},
 		"auth": map[string]any{
-			"hash_key":           "fc5c6816998c7173ba5bc7a3c53bfabf",
-			"jwt_secret":         "fc5c6816998c7173ba5bc7a3c53bfabf",
-			"jwt_token_duration": 15 * time.Minute,
+			"hash_key":               "fc5c6816998c7173ba5bc7a3c53bfabf",
+			"jwt_token_duration":     15 * time.Minute,
+			"refresh_token_duration": 7 * 24 * time.Hour,
+
+			"signing_key_rotation_interval": 7 * 24 * time.Hour,
+			"signing_key_publish_ahead":     15 * time.Minute,
+			"signing_key_overlap":           time.Hour,
+
+			"mfa_issuer":             "BankOps Core",
+			"mfa_challenge_duration": 5 * time.Minute,
 		},
 		"message_publisher": map[string]any{
 			"enabled":       DefaultMessageBrokerMessageEnabled,
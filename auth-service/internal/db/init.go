@@ -59,6 +59,13 @@ func InitDB() (*gorm.DB, error) {
 func runMigrations(db *gorm.DB) error {
 	return db.AutoMigrate(
 		&entity.Employee{},
+		&entity.RefreshToken{},
+		&entity.TokenRevocation{},
+		&entity.SigningKey{},
+		&entity.EmployeeMFA{},
+		&entity.MFARecoveryCode{},
+		&entity.MFAChallenge{},
+		&entity.MFAPolicy{},
 	)
 }
 
@@ -29,16 +29,16 @@ var (
 
 // Employee entity
 type Employee struct {
-	ID           string `gorm:"primaryKey"`
-	Username     string `gorm:"not null"`
-	AuthMethod   string `gorm:"not null"`
-	Password     string `gorm:"null"`
-	Role         string `gorm:"not null"`
-	ActiveStatus string `gorm:"not null"`
-	Status       string `gorm:"default:valid;not null"`
-	CreatedBy    string `gorm:"null"`
-	UpdatedBy    string `gorm:"null"`
-	CreatedAt    time.Time
+	ID           string    `gorm:"primaryKey;index:idx_employees_created_id,priority:2"`
+	Username     string    `gorm:"not null"`
+	AuthMethod   string    `gorm:"not null"`
+	Password     string    `gorm:"null"`
+	Role         string    `gorm:"not null"`
+	ActiveStatus string    `gorm:"not null"`
+	Status       string    `gorm:"default:valid;not null"`
+	CreatedBy    string    `gorm:"null"`
+	UpdatedBy    string    `gorm:"null"`
+	CreatedAt    time.Time `gorm:"index:idx_employees_created_id,priority:1"` // keyset pagination order
 	UpdatedAt    time.Time
 }
 
@@ -0,0 +1,152 @@
+package entity
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base32"
+	"encoding/base64"
+	"fmt"
+	"github.com/google/uuid"
+	"strings"
+	"time"
+)
+
+const (
+	MFAStatusPending = "pending"
+	MFAStatusEnabled = "enabled"
+
+	// MFARecoveryCodeCount is the number of recovery codes handed out when MFA is enabled
+	MFARecoveryCodeCount = 10
+
+	// mfaChallengeTokenBytes is the entropy of an MFA challenge token
+	mfaChallengeTokenBytes = 32
+	// mfaRecoveryCodeLength is the number of base32 characters of a recovery code, 50 bits of entropy
+	mfaRecoveryCodeLength = 10
+)
+
+// EmployeeMFA is the TOTP (RFC 6238) second factor of an employee. It is pending after enrollment until the first
+// code from the authenticator app is verified. LastUsedStep is the time step of the last accepted code, a code is
+// only accepted once. FailedAttempts counts the verifications since the last successful one over all challenges of
+// the employee; once too many failed, verification is locked until LockedUntil.
+type EmployeeMFA struct {
+	Username       string     `gorm:"primaryKey"`
+	Secret         string     `gorm:"not null"` // base32 TOTP secret
+	Status         string     `gorm:"not null"`
+	LastUsedStep   int64      `gorm:"not null;default:0"`
+	FailedAttempts int        `gorm:"not null;default:0"`
+	LockedUntil    *time.Time `gorm:"null"`
+	EnabledAt      *time.Time `gorm:"null"`
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+}
+
+// MFARecoveryCode is a single-use code that replaces a TOTP code when the authenticator is lost. Only the hash of
+// the code is stored.
+type MFARecoveryCode struct {
+	ID        string     `gorm:"primaryKey"`
+	Username  string     `gorm:"not null;index"`
+	CodeHash  string     `gorm:"not null;index"`
+	UsedAt    *time.Time `gorm:"null"`
+	CreatedAt time.Time
+}
+
+// MFAChallenge is the first step of a login with MFA: the password has been verified and the challenge token is
+// exchanged for access and refresh tokens with a TOTP or recovery code. Only the hash of the token is stored. When
+// EnrollmentRequired is set, MFA is mandatory for the role of the employee who has not enrolled yet, so the token
+// also authorizes the enrollment.
+type MFAChallenge struct {
+	ID                 string    `gorm:"primaryKey"`
+	Username           string    `gorm:"not null;index"`
+	TokenHash          string    `gorm:"not null;uniqueIndex"`
+	EnrollmentRequired bool      `gorm:"not null;default:false"`
+	Attempts           int       `gorm:"not null;default:0"`
+	ExpiresAt          time.Time `gorm:"not null;index"`
+	CreatedAt          time.Time
+}
+
+// MFAPolicy makes MFA mandatory for the employees of a role
+type MFAPolicy struct {
+	Role      string `gorm:"primaryKey"`
+	Required  bool   `gorm:"not null;default:false"`
+	UpdatedBy string `gorm:"null"`
+	UpdatedAt time.Time
+}
+
+// NewEmployeeMFA creates a pending MFA enrollment of the employee with the TOTP secret
+func NewEmployeeMFA(username, secret string) *EmployeeMFA {
+	now := time.Now()
+	return &EmployeeMFA{
+		Username:  username,
+		Secret:    secret,
+		Status:    MFAStatusPending,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+}
+
+// IsEnabled reports whether logins of the employee require a second factor
+func (m *EmployeeMFA) IsEnabled() bool {
+	return m.Status == MFAStatusEnabled
+}
+
+// NewMFAChallenge creates a challenge for the employee and returns it with the token to hand out
+func NewMFAChallenge(username string, enrollmentRequired bool, ttl time.Duration) (*MFAChallenge, string, error) {
+	raw := make([]byte, mfaChallengeTokenBytes)
+	if _, err := rand.Read(raw); err != nil {
+		return nil, "", fmt.Errorf("failed to generate MFA challenge: %w", err)
+	}
+	token := base64.RawURLEncoding.EncodeToString(raw)
+
+	now := time.Now()
+	return &MFAChallenge{
+		ID:                 uuid.New().String(),
+		Username:           username,
+		TokenHash:          HashMFAToken(token),
+		EnrollmentRequired: enrollmentRequired,
+		ExpiresAt:          now.Add(ttl),
+		CreatedAt:          now,
+	}, token, nil
+}
+
+// IsExpired reports whether the challenge can no longer be used at the given time
+func (c *MFAChallenge) IsExpired(at time.Time) bool {
+	return !at.Before(c.ExpiresAt)
+}
+
+// NewMFARecoveryCodes creates a set of recovery codes for the employee and returns them with the codes to hand out,
+// formatted as xxxxx-xxxxx
+func NewMFARecoveryCodes(username string) ([]*MFARecoveryCode, []string, error) {
+	stored := make([]*MFARecoveryCode, MFARecoveryCodeCount)
+	codes := make([]string, MFARecoveryCodeCount)
+
+	for i := range codes {
+		raw := make([]byte, (mfaRecoveryCodeLength*5+7)/8)
+		if _, err := rand.Read(raw); err != nil {
+			return nil, nil, fmt.Errorf("failed to generate recovery code: %w", err)
+		}
+		code := strings.ToLower(base32.StdEncoding.EncodeToString(raw))[:mfaRecoveryCodeLength]
+		codes[i] = code[:5] + "-" + code[5:]
+
+		stored[i] = &MFARecoveryCode{
+			ID:        uuid.New().String(),
+			Username:  username,
+			CodeHash:  HashMFAToken(NormalizeRecoveryCode(codes[i])),
+			CreatedAt: time.Now(),
+		}
+	}
+
+	return stored, codes, nil
+}
+
+// NormalizeRecoveryCode removes the formatting of a recovery code as entered by the employee
+func NormalizeRecoveryCode(code string) string {
+	code = strings.ToLower(strings.TrimSpace(code))
+	return strings.NewReplacer("-", "", " ", "").Replace(code)
+}
+
+// HashMFAToken hashes a challenge token or recovery code for storage and lookup. Both are random and guessing is
+// limited by the attempts of a challenge, so a plain SHA-256 suffices.
+func HashMFAToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return base64.RawURLEncoding.EncodeToString(sum[:])
+}
@@ -0,0 +1,55 @@
+package entity
+
+import (
+	custom_err "auth-service/internal/domain/error"
+	"encoding/base64"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// PageCursor points at the last row of a page in a listing ordered by creation time and ID. The next page starts
+// right after it, so rows created while paging neither shift nor repeat the following pages.
+type PageCursor struct {
+	CreatedAt time.Time
+	ID        string
+}
+
+// Encode turns the cursor into the opaque token handed to clients
+func (c PageCursor) Encode() string {
+	return base64.RawURLEncoding.EncodeToString([]byte(strconv.FormatInt(c.CreatedAt.UnixNano(), 10) + ":" + c.ID))
+}
+
+// DecodePageCursor parses a token returned by Encode. An empty token means the first page and gives a nil cursor.
+func DecodePageCursor(token string) (*PageCursor, error) {
+	token = strings.TrimSpace(token)
+	if token == "" {
+		return nil, nil
+	}
+
+	raw, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return nil, custom_err.ErrInvalidPageCursor
+	}
+	nanos, id, found := strings.Cut(string(raw), ":")
+	if !found || id == "" {
+		return nil, custom_err.ErrInvalidPageCursor
+	}
+	unixNano, err := strconv.ParseInt(nanos, 10, 64)
+	if err != nil {
+		return nil, custom_err.ErrInvalidPageCursor
+	}
+
+	// rows are stored in local time and compared as text, so the cursor is too
+	return &PageCursor{CreatedAt: time.Unix(0, unixNano), ID: id}, nil
+}
+
+// TrimPage cuts rows, read with one row more than pageSize, down to the page and returns the token of the next
+// page, or an empty token when this is the last page
+func TrimPage[T any](rows []T, pageSize int, cursor func(T) PageCursor) ([]T, string) {
+	if len(rows) <= pageSize {
+		return rows, ""
+	}
+	rows = rows[:pageSize]
+	return rows, cursor(rows[len(rows)-1]).Encode()
+}
@@ -0,0 +1,72 @@
+package entity
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"fmt"
+	"github.com/google/uuid"
+	"time"
+)
+
+const (
+	RefreshTokenStatusActive  = "active"
+	RefreshTokenStatusRotated = "rotated"
+	RefreshTokenStatusRevoked = "revoked"
+
+	// refreshTokenBytes is the entropy of a refresh token
+	refreshTokenBytes = 32
+)
+
+// RefreshToken is a server-side refresh token. Only the hash of the token is stored. Every refresh rotates the
+// token into a new one of the same family, which starts with a login; presenting a rotated token again means it
+// leaked, so the whole family is revoked.
+type RefreshToken struct {
+	ID            string     `gorm:"primaryKey"`
+	FamilyID      string     `gorm:"not null;index"`
+	Username      string     `gorm:"not null;index"`
+	TokenHash     string     `gorm:"not null;uniqueIndex"`
+	Status        string     `gorm:"not null"`
+	ReplacedByID  string     `gorm:"null"`
+	ExpiresAt     time.Time  `gorm:"not null"`
+	RevokedReason string     `gorm:"null"`
+	RotatedAt     *time.Time `gorm:"null"`
+	RevokedAt     *time.Time `gorm:"null"`
+	CreatedAt     time.Time
+}
+
+// NewRefreshToken creates a refresh token of the family, a new family when familyID is empty, and returns it
+// with the token to hand out
+func NewRefreshToken(username, familyID string, ttl time.Duration) (*RefreshToken, string, error) {
+	raw := make([]byte, refreshTokenBytes)
+	if _, err := rand.Read(raw); err != nil {
+		return nil, "", fmt.Errorf("failed to generate refresh token: %w", err)
+	}
+	token := base64.RawURLEncoding.EncodeToString(raw)
+
+	if familyID == "" {
+		familyID = uuid.New().String()
+	}
+
+	now := time.Now()
+	return &RefreshToken{
+		ID:        uuid.New().String(),
+		FamilyID:  familyID,
+		Username:  username,
+		TokenHash: HashRefreshToken(token),
+		Status:    RefreshTokenStatusActive,
+		ExpiresAt: now.Add(ttl),
+		CreatedAt: now,
+	}, token, nil
+}
+
+// HashRefreshToken hashes a refresh token for storage and lookup. Tokens are random, so a plain SHA-256 suffices.
+func HashRefreshToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return base64.RawURLEncoding.EncodeToString(sum[:])
+}
+
+// IsExpired reports whether the token can no longer be used at the given time
+func (t *RefreshToken) IsExpired(at time.Time) bool {
+	return !at.Before(t.ExpiresAt)
+}
@@ -0,0 +1,91 @@
+package entity
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"encoding/base64"
+	"fmt"
+	"github.com/google/uuid"
+	"time"
+)
+
+const (
+	SigningKeyAlgorithmEdDSA = "EdDSA"
+)
+
+// SigningKey is a key pair that signs JWT tokens. The ID is the kid header of the tokens it signs. A key is
+// published as soon as it is created but only signs from ActivatesAt, so verifiers can fetch it before the first
+// token it signs. The newest active key signs until it is retired by a rotation; a retired key is still published for
+// verification until ExpiresAt, when the tokens it signed have expired.
+type SigningKey struct {
+	ID          string     `gorm:"primaryKey"`
+	Algorithm   string     `gorm:"not null"`
+	PrivateKey  []byte     `gorm:"not null"` // ed25519 seed
+	PublicKey   []byte     `gorm:"not null"`
+	ActivatesAt *time.Time `gorm:"null"` // unset for keys that signed from their creation
+	RetiredAt   *time.Time `gorm:"null"`
+	ExpiresAt   *time.Time `gorm:"null;index"`
+	CreatedAt   time.Time
+}
+
+// JSONWebKey is the public part of a signing key as published in a JWKS document (RFC 7517, RFC 8037)
+type JSONWebKey struct {
+	KeyType   string `json:"kty"`
+	Curve     string `json:"crv"`
+	X         string `json:"x"`
+	KeyID     string `json:"kid"`
+	Algorithm string `json:"alg"`
+	Use       string `json:"use"`
+}
+
+// NewSigningKey generates a new Ed25519 signing key that signs from activatesAt
+func NewSigningKey(activatesAt time.Time) (*SigningKey, error) {
+	publicKey, privateKey, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate signing key: %w", err)
+	}
+
+	return &SigningKey{
+		ID:          uuid.New().String(),
+		Algorithm:   SigningKeyAlgorithmEdDSA,
+		PrivateKey:  privateKey.Seed(),
+		PublicKey:   publicKey,
+		ActivatesAt: &activatesAt,
+		CreatedAt:   time.Now(),
+	}, nil
+}
+
+// Signer returns the private key to sign with
+func (k *SigningKey) Signer() ed25519.PrivateKey {
+	return ed25519.NewKeyFromSeed(k.PrivateKey)
+}
+
+// ActiveFrom returns the time from which the key signs
+func (k *SigningKey) ActiveFrom() time.Time {
+	if k.ActivatesAt == nil {
+		return k.CreatedAt
+	}
+	return *k.ActivatesAt
+}
+
+// IsActive reports whether the key signs at the given time, unless a newer active key replaced it
+func (k *SigningKey) IsActive(at time.Time) bool {
+	return !k.IsRetired() && !at.Before(k.ActiveFrom())
+}
+
+// IsRetired reports whether the key no longer signs new tokens
+func (k *SigningKey) IsRetired() bool {
+	return k.RetiredAt != nil
+}
+
+// JWK returns the public key to publish
+func (k *SigningKey) JWK() JSONWebKey {
+	return JSONWebKey{
+		KeyType:   "OKP",
+		Curve:     "Ed25519",
+		X:         base64.RawURLEncoding.EncodeToString(k.PublicKey),
+		KeyID:     k.ID,
+		Algorithm: k.Algorithm,
+		Use:       "sig",
+	}
+}
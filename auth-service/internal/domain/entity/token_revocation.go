@@ -0,0 +1,48 @@
+package entity
+
+import (
+	"time"
+)
+
+const (
+	TokenRevocationReasonLogout          = "logout"
+	TokenRevocationReasonEmployeeDeleted = "employee_deleted"
+	TokenRevocationReasonRoleChanged     = "role_changed"
+)
+
+// TokenRevocation revokes access tokens before they expire, either a single token by its ID (jti) or every token of
+// an employee issued before RevokedBefore. Revocations form a feed ordered by Sequence, which the gateway follows to
+// reject revoked tokens. A revocation is only of interest until ExpiresAt, when the tokens it revokes have expired.
+type TokenRevocation struct {
+	Sequence      uint64     `gorm:"primaryKey;autoIncrement"`
+	TokenID       string     `gorm:"null;index"` // empty when all tokens of the employee are revoked
+	Username      string     `gorm:"not null;index"`
+	RevokedBefore *time.Time `gorm:"null"` // tokens of the employee issued before are revoked, nil for a single token
+	Reason        string     `gorm:"not null"`
+	ExpiresAt     time.Time  `gorm:"not null;index"`
+	CreatedAt     time.Time
+}
+
+// NewTokenRevocation revokes the access token with the ID until it expires
+func NewTokenRevocation(tokenID, username, reason string, expiresAt time.Time) *TokenRevocation {
+	return &TokenRevocation{
+		TokenID:   tokenID,
+		Username:  username,
+		Reason:    reason,
+		ExpiresAt: expiresAt,
+		CreatedAt: time.Now(),
+	}
+}
+
+// NewEmployeeTokenRevocation revokes every access token issued to the employee so far. tokenTTL is the lifetime of
+// access tokens, after which all of them have expired.
+func NewEmployeeTokenRevocation(username, reason string, tokenTTL time.Duration) *TokenRevocation {
+	now := time.Now()
+	return &TokenRevocation{
+		Username:      username,
+		RevokedBefore: &now,
+		Reason:        reason,
+		ExpiresAt:     now.Add(tokenTTL),
+		CreatedAt:     now,
+	}
+}
@@ -12,4 +12,12 @@ var (
 	ErrEmployeeAlreadyExists = errors.New("employee already exists")
 	ErrEmployeeNotFound      = errors.New("employee not found")
 	ErrDatabase              = errors.New("database error")
+	ErrInvalidPageCursor     = errors.New("invalid page cursor")
+	ErrInvalidRefreshToken   = errors.New("invalid refresh token")
+	ErrRefreshTokenReused    = errors.New("refresh token reused")
+	ErrInvalidMFAChallenge   = errors.New("invalid MFA challenge")
+	ErrInvalidMFACode        = errors.New("invalid MFA code")
+	ErrMFANotEnrolled        = errors.New("MFA not enrolled")
+	ErrMFAAlreadyEnabled     = errors.New("MFA already enabled")
+	ErrMFALocked             = errors.New("MFA locked after too many failed attempts")
 )
@@ -12,40 +12,251 @@ import (
 // AuthHandler implements the AuthServiceServer interface.
 type AuthHandler struct {
 	proto.UnimplementedAuthServiceServer
-	authenticate   *app.Authenticate
-	createEmployee *app.CreateEmployee
-	updateEmployee *app.UpdateEmployee
-	deleteEmployee *app.DeleteEmployee
-	listEmployee   *app.ListEmployee
+	authenticate         *app.Authenticate
+	verifyMFA            *app.VerifyMFA
+	refreshToken         *app.RefreshToken
+	logout               *app.Logout
+	listTokenRevocations *app.ListTokenRevocations
+	enrollMFA            *app.EnrollMFA
+	confirmMFA           *app.ConfirmMFA
+	resetMFA             *app.ResetMFA
+	setMFAPolicy         *app.SetMFAPolicy
+	listMFAPolicies      *app.ListMFAPolicies
+	createEmployee       *app.CreateEmployee
+	updateEmployee       *app.UpdateEmployee
+	deleteEmployee       *app.DeleteEmployee
+	listEmployee         *app.ListEmployee
 }
 
 // NewAuthHandler creates a new AuthHandler.
 func NewAuthHandler(authenticate *app.Authenticate,
+	verifyMFA *app.VerifyMFA,
+	refreshToken *app.RefreshToken,
+	logout *app.Logout,
+	listTokenRevocations *app.ListTokenRevocations,
+	enrollMFA *app.EnrollMFA,
+	confirmMFA *app.ConfirmMFA,
+	resetMFA *app.ResetMFA,
+	setMFAPolicy *app.SetMFAPolicy,
+	listMFAPolicies *app.ListMFAPolicies,
 	createEmployee *app.CreateEmployee,
 	updateEmployee *app.UpdateEmployee,
 	deleteEmployee *app.DeleteEmployee,
 	listEmployeeRepo *app.ListEmployee) *AuthHandler {
 
 	return &AuthHandler{
-		authenticate:   authenticate,
-		createEmployee: createEmployee,
-		updateEmployee: updateEmployee,
-		deleteEmployee: deleteEmployee,
-		listEmployee:   listEmployeeRepo,
+		authenticate:         authenticate,
+		verifyMFA:            verifyMFA,
+		refreshToken:         refreshToken,
+		logout:               logout,
+		listTokenRevocations: listTokenRevocations,
+		enrollMFA:            enrollMFA,
+		confirmMFA:           confirmMFA,
+		resetMFA:             resetMFA,
+		setMFAPolicy:         setMFAPolicy,
+		listMFAPolicies:      listMFAPolicies,
+		createEmployee:       createEmployee,
+		updateEmployee:       updateEmployee,
+		deleteEmployee:       deleteEmployee,
+		listEmployee:         listEmployeeRepo,
 	}
 }
 
 // Authenticate handles the authentication and JWT token generation.
 func (h *AuthHandler) Authenticate(ctx context.Context, req *proto.AuthenticateRequest) (*proto.AuthenticateResponse, error) {
-	token, refreshToken, err := h.authenticate.Execute(req.GetUsername(), req.GetPassword())
+	result, err := h.authenticate.Execute(req.GetUsername(), req.GetPassword())
 	if err != nil {
 		return nil, fmt.Errorf("authentication failed: %v", err)
 	}
 
+	if result.MFAChallengeToken != "" {
+		message := "MFA code required"
+		if result.MFAEnrollmentRequired {
+			message = "MFA enrollment required"
+		}
+		return &proto.AuthenticateResponse{
+			Message:               message,
+			MfaRequired:           true,
+			MfaChallengeToken:     result.MFAChallengeToken,
+			MfaEnrollmentRequired: result.MFAEnrollmentRequired,
+		}, nil
+	}
+
 	return &proto.AuthenticateResponse{
+		Token:        result.Token,
+		RefreshToken: result.RefreshToken,
+		Message:      "Authentication successful",
+	}, nil
+}
+
+// VerifyMFA handles the second step of a login with MFA.
+func (h *AuthHandler) VerifyMFA(ctx context.Context, req *proto.VerifyMFARequest) (*proto.VerifyMFAResponse, error) {
+	token, refreshToken, recoveryCodes, err := h.verifyMFA.Execute(req.GetChallengeToken(), req.GetCode(), req.GetRecoveryCode())
+	if err != nil {
+		return nil, fmt.Errorf("MFA verification failed: %v", err)
+	}
+
+	return &proto.VerifyMFAResponse{
+		Token:         token,
+		RefreshToken:  refreshToken,
+		RecoveryCodes: recoveryCodes,
+		Message:       "Authentication successful",
+	}, nil
+}
+
+// RefreshToken handles the exchange of a refresh token for new tokens.
+func (h *AuthHandler) RefreshToken(ctx context.Context, req *proto.RefreshTokenRequest) (*proto.RefreshTokenResponse, error) {
+	token, refreshToken, err := h.refreshToken.Execute(req.GetRefreshToken())
+	if err != nil {
+		return nil, fmt.Errorf("token refresh failed: %v", err)
+	}
+
+	return &proto.RefreshTokenResponse{
 		Token:        token,
 		RefreshToken: refreshToken,
-		Message:      "Authentication successful",
+		Message:      "Token refreshed",
+	}, nil
+}
+
+// Logout handles the revocation of an access token and its refresh token family.
+func (h *AuthHandler) Logout(ctx context.Context, req *proto.LogoutRequest) (*proto.LogoutResponse, error) {
+	message, err := h.logout.Execute(req.GetTokenId(), req.GetUsername(), req.GetExpiresAt().AsTime(), req.GetRefreshToken())
+	if err != nil {
+		logging.Logger.Warn().Err(err).Str("username", req.GetUsername()).Msg("logout failed")
+		return &proto.LogoutResponse{
+			Message: message,
+			Success: false,
+		}, nil
+	}
+	return &proto.LogoutResponse{
+		Message: message,
+		Success: true,
+	}, nil
+}
+
+// ListTokenRevocations handles the feed of access token revocations.
+func (h *AuthHandler) ListTokenRevocations(ctx context.Context, req *proto.ListTokenRevocationsRequest) (*proto.ListTokenRevocationsResponse, error) {
+	revocations, err := h.listTokenRevocations.Execute(req.GetAfterSequence(), int(req.GetLimit()))
+	if err != nil {
+		return &proto.ListTokenRevocationsResponse{
+			Message: "Failed to list token revocations",
+			Success: false,
+		}, nil
+	}
+
+	protoRevocations := make([]*proto.TokenRevocation, len(revocations))
+	for i, revocation := range revocations {
+		protoRevocations[i] = &proto.TokenRevocation{
+			Sequence:  revocation.Sequence,
+			TokenId:   revocation.TokenID,
+			Username:  revocation.Username,
+			ExpiresAt: timestamppb.New(revocation.ExpiresAt),
+		}
+		if revocation.RevokedBefore != nil {
+			protoRevocations[i].RevokedBefore = timestamppb.New(*revocation.RevokedBefore)
+		}
+	}
+
+	return &proto.ListTokenRevocationsResponse{
+		Revocations: protoRevocations,
+		Message:     "Token revocations listed",
+		Success:     true,
+	}, nil
+}
+
+// EnrollMFA handles the enrollment of an authenticator app.
+func (h *AuthHandler) EnrollMFA(ctx context.Context, req *proto.EnrollMFARequest) (*proto.EnrollMFAResponse, error) {
+	secret, provisioningURI, message, err := h.enrollMFA.Execute(req.GetUsername(), req.GetChallengeToken())
+	if err != nil {
+		logging.Logger.Warn().Err(err).Str("username", req.GetUsername()).Msg("enroll MFA failed")
+		return &proto.EnrollMFAResponse{
+			Message: message,
+			Success: false,
+		}, nil
+	}
+	return &proto.EnrollMFAResponse{
+		Secret:          secret,
+		ProvisioningUri: provisioningURI,
+		Message:         message,
+		Success:         true,
+	}, nil
+}
+
+// ConfirmMFA handles enabling an enrolled authenticator app.
+func (h *AuthHandler) ConfirmMFA(ctx context.Context, req *proto.ConfirmMFARequest) (*proto.ConfirmMFAResponse, error) {
+	recoveryCodes, message, err := h.confirmMFA.Execute(req.GetUsername(), req.GetCode())
+	if err != nil {
+		logging.Logger.Warn().Err(err).Str("username", req.GetUsername()).Msg("confirm MFA failed")
+		return &proto.ConfirmMFAResponse{
+			Message: message,
+			Success: false,
+		}, nil
+	}
+	return &proto.ConfirmMFAResponse{
+		RecoveryCodes: recoveryCodes,
+		Message:       message,
+		Success:       true,
+	}, nil
+}
+
+// ResetMFA handles the removal of the second factor of an employee by admin.
+func (h *AuthHandler) ResetMFA(ctx context.Context, req *proto.ResetMFARequest) (*proto.ResetMFAResponse, error) {
+	message, err := h.resetMFA.Execute(req.GetUsername(), req.GetRequester())
+	if err != nil {
+		logging.Logger.Warn().Err(err).Str("username", req.GetUsername()).Msg("reset MFA failed")
+		return &proto.ResetMFAResponse{
+			Message: message,
+			Success: false,
+		}, nil
+	}
+	return &proto.ResetMFAResponse{
+		Message: message,
+		Success: true,
+	}, nil
+}
+
+// SetMFAPolicy handles making MFA mandatory or optional for a role by admin.
+func (h *AuthHandler) SetMFAPolicy(ctx context.Context, req *proto.SetMFAPolicyRequest) (*proto.SetMFAPolicyResponse, error) {
+	message, err := h.setMFAPolicy.Execute(req.GetRole(), req.GetRequired(), req.GetRequester())
+	if err != nil {
+		logging.Logger.Warn().Err(err).Str("role", req.GetRole()).Msg("set MFA policy failed")
+		return &proto.SetMFAPolicyResponse{
+			Message: message,
+			Success: false,
+		}, nil
+	}
+	return &proto.SetMFAPolicyResponse{
+		Message: message,
+		Success: true,
+	}, nil
+}
+
+// ListMFAPolicies handles the list of MFA policies.
+func (h *AuthHandler) ListMFAPolicies(ctx context.Context, req *proto.ListMFAPoliciesRequest) (*proto.ListMFAPoliciesResponse, error) {
+	policies, err := h.listMFAPolicies.Execute()
+	if err != nil {
+		return &proto.ListMFAPoliciesResponse{
+			Message: "Failed to list MFA policies",
+			Success: false,
+		}, nil
+	}
+
+	protoPolicies := make([]*proto.MFAPolicy, len(policies))
+	for i, policy := range policies {
+		protoPolicies[i] = &proto.MFAPolicy{
+			Role:      policy.Role,
+			Required:  policy.Required,
+			UpdatedBy: policy.UpdatedBy,
+		}
+		if !policy.UpdatedAt.IsZero() {
+			protoPolicies[i].UpdatedAt = timestamppb.New(policy.UpdatedAt)
+		}
+	}
+
+	return &proto.ListMFAPoliciesResponse{
+		Policies: protoPolicies,
+		Message:  "MFA policies listed",
+		Success:  true,
 	}, nil
 }
 
@@ -99,7 +310,7 @@ func (h *AuthHandler) DeleteEmployee(ctx context.Context, req *proto.DeleteEmplo
 
 // ListEmployee handles the list of employees.
 func (h *AuthHandler) ListEmployee(ctx context.Context, req *proto.ListEmployeeRequest) (*proto.ListEmployeeResponse, error) {
-	employees, totalCount, totalPage, message, err := h.listEmployee.Execute(int(req.GetPage()), int(req.GetPageSize()), req.GetSortOrder())
+	employees, totalCount, totalPage, nextCursor, message, err := h.listEmployee.Execute(int(req.GetPage()), int(req.GetPageSize()), req.GetCursor(), req.GetIncludeTotal(), req.GetSortOrder())
 	if err != nil {
 		logging.Logger.Warn().Err(err).Msg("list employee failed")
 		return &proto.ListEmployeeResponse{
@@ -130,6 +341,7 @@ func (h *AuthHandler) ListEmployee(ctx context.Context, req *proto.ListEmployeeR
 		PageSize:   req.GetPageSize(),
 		TotalCount: int32(totalCount),
 		TotalPages: int32(totalPage),
+		NextCursor: nextCursor,
 		Message:    message,
 		Success:    true,
 	}, nil
@@ -16,7 +16,7 @@ import (
 	"net"
 )
 
-func StartGRPCServer(ctx context.Context, employeeRepo ports.EmployeeRepo, tokenSigner ports.TokenSigner, hashing ports.Hashing) {
+func StartGRPCServer(ctx context.Context, employeeRepo ports.EmployeeRepo, refreshTokenRepo ports.RefreshTokenRepo, tokenRevocationRepo ports.TokenRevocationRepo, mfaRepo ports.MFARepo, mfaPolicyRepo ports.MFAPolicyRepo, tokenSigner ports.TokenSigner, hashing ports.Hashing, totp ports.TOTP) {
 	var unaryInterceptors []grpc.UnaryServerInterceptor
 
 	if config.Current().Observability.MetricsConfig.Enabled {
@@ -38,10 +38,19 @@ func StartGRPCServer(ctx context.Context, employeeRepo ports.EmployeeRepo, token
 
 	// Register gRPC services
 	authHandler := handlers.NewAuthHandler(
-		app.NewAuthenticate(employeeRepo, tokenSigner, hashing),
+		app.NewAuthenticate(employeeRepo, tokenSigner, hashing, refreshTokenRepo, mfaRepo, mfaPolicyRepo),
+		app.NewVerifyMFA(employeeRepo, tokenSigner, refreshTokenRepo, mfaRepo, totp),
+		app.NewRefreshToken(employeeRepo, tokenSigner, refreshTokenRepo),
+		app.NewLogout(refreshTokenRepo, tokenRevocationRepo),
+		app.NewListTokenRevocations(tokenRevocationRepo),
+		app.NewEnrollMFA(employeeRepo, mfaRepo, totp),
+		app.NewConfirmMFA(mfaRepo, totp),
+		app.NewResetMFA(employeeRepo, mfaRepo),
+		app.NewSetMFAPolicy(mfaPolicyRepo),
+		app.NewListMFAPolicies(mfaPolicyRepo),
 		app.NewCreateEmployee(employeeRepo, hashing),
-		app.NewUpdateEmployee(employeeRepo),
-		app.NewDeleteEmployee(employeeRepo),
+		app.NewUpdateEmployee(employeeRepo, tokenRevocationRepo),
+		app.NewDeleteEmployee(employeeRepo, tokenRevocationRepo),
 		app.NewListEmployee(employeeRepo),
 	)
 
@@ -0,0 +1,32 @@
+package handlers
+
+import (
+	"auth-service/internal/domain/entity"
+	"auth-service/internal/ports"
+	"encoding/json"
+	"net/http"
+)
+
+// jwksMaxAge is how long clients may cache the key set, well below the overlap of a retired key
+const jwksMaxAge = "max-age=60"
+
+type jwksResponse struct {
+	Keys []entity.JSONWebKey `json:"keys"`
+}
+
+// JWKS serves the public keys that verify the issued JWT tokens as a JSON Web Key Set
+func JWKS(tokenSigner ports.TokenSigner) http.HandlerFunc {
+	return func(w http.ResponseWriter, _ *http.Request) {
+		keys := tokenSigner.VerificationKeys()
+
+		resp := jwksResponse{Keys: make([]entity.JSONWebKey, 0, len(keys))}
+		for _, key := range keys {
+			resp.Keys = append(resp.Keys, key.JWK())
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("Cache-Control", jwksMaxAge)
+		w.WriteHeader(http.StatusOK)
+		_ = json.NewEncoder(w).Encode(resp)
+	}
+}
@@ -4,13 +4,15 @@ import (
 	"auth-service/internal/config"
 	"auth-service/internal/http/handlers"
 	"auth-service/internal/http/middleware"
+	"auth-service/internal/ports"
 	"net/http"
 )
 
-func routes() *http.ServeMux {
+func routes(tokenSigner ports.TokenSigner) *http.ServeMux {
 	mux := http.NewServeMux()
 	mux.HandleFunc("/health", handlers.HealthCheck)
 	mux.HandleFunc("/ready", handlers.ReadyCheck)
+	mux.HandleFunc("GET /.well-known/jwks.json", handlers.JWKS(tokenSigner))
 	//mux.HandleFunc("/trace", handlers.Tracer)
 
 	if config.Current().Observability.MetricsConfig.Enabled {
@@ -2,6 +2,7 @@ package httpserver
 
 import (
 	"auth-service/internal/http/middleware"
+	"auth-service/internal/ports"
 	"net/http"
 	"time"
 )
@@ -21,9 +22,9 @@ func chain(h http.Handler, mws ...func(http.Handler) http.Handler) http.Handler
 	return h
 }
 
-// NewServerHTTP generates a new http server
-func NewServerHTTP(cfg ServerConfig) *http.Server {
-	base := routes()
+// NewServerHTTP generates a new http server, publishing the verification keys of the token signer
+func NewServerHTTP(cfg ServerConfig, tokenSigner ports.TokenSigner) *http.Server {
+	base := routes(tokenSigner)
 
 	h := chain(
 		base,
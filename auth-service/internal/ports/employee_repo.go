@@ -7,6 +7,7 @@ type EmployeeRepo interface {
 	CreateEmployee(input *entity.Employee) (*entity.Employee, error)
 	GetEmployeeByUsername(username string) (*entity.Employee, error)
 	UpdateEmployee(employee *entity.Employee) (*entity.Employee, error)
+	UpdatePassword(username, hashedPassword string) error
 	DeleteEmployee(username, requester string) error
-	ListEmployee(page, pageSize int, sortOrder string) ([]*entity.Employee, int64, error)
+	ListEmployee(page, pageSize int, after *entity.PageCursor, withTotal bool, sortOrder string) ([]*entity.Employee, int64, error)
 }
@@ -1,6 +1,11 @@
 package ports
 
-// Hashing is responsible for signing hashing Data tokens.
+// Hashing is responsible for hashing and verifying secrets such as passwords.
 type Hashing interface {
+	// HashData hashes the data with a random salt, the result records the algorithm and its parameters
 	HashData(data string) (string, error)
+	// VerifyData checks the data against a hash created by HashData or a legacy hash in constant time
+	VerifyData(data, hashed string) (bool, error)
+	// NeedsRehash reports whether the hash should be replaced with a HashData hash of the same data
+	NeedsRehash(hashed string) bool
 }
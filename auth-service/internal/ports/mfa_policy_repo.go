@@ -0,0 +1,10 @@
+package ports
+
+import "auth-service/internal/domain/entity"
+
+// MFAPolicyRepo defines the interface for MFA policy database operations
+type MFAPolicyRepo interface {
+	GetMFAPolicy(role string) (*entity.MFAPolicy, error)
+	SaveMFAPolicy(policy *entity.MFAPolicy) error
+	ListMFAPolicies() ([]*entity.MFAPolicy, error)
+}
@@ -0,0 +1,23 @@
+package ports
+
+import (
+	"auth-service/internal/domain/entity"
+	"time"
+)
+
+// MFARepo defines the interface for MFA enrollment, recovery code and challenge database operations
+type MFARepo interface {
+	GetMFA(username string) (*entity.EmployeeMFA, error)
+	SaveMFA(mfa *entity.EmployeeMFA) error
+	EnableMFA(username string, step int64, recoveryCodes []*entity.MFARecoveryCode) error
+	UseMFAStep(username string, step int64) error
+	UseRecoveryCode(username, codeHash string) error
+	DeleteMFA(username string) error
+	ClaimMFAAttempt(username string, maxFailures int, lockout time.Duration) error
+	ResetMFAAttempts(username string) error
+
+	CreateMFAChallenge(challenge *entity.MFAChallenge) error
+	GetMFAChallengeByHash(tokenHash string) (*entity.MFAChallenge, error)
+	ClaimMFAChallengeAttempt(id string, maxAttempts int) error
+	ConsumeMFAChallenge(id string) error
+}
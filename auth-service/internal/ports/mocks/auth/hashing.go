@@ -12,3 +12,13 @@ func (m *MockHashing) HashData(data string) (string, error) {
 	args := m.Called(data)
 	return args.String(0), args.Error(1)
 }
+
+func (m *MockHashing) VerifyData(data, hashed string) (bool, error) {
+	args := m.Called(data, hashed)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *MockHashing) NeedsRehash(hashed string) bool {
+	args := m.Called(hashed)
+	return args.Bool(0)
+}
@@ -1,6 +1,8 @@
 package auth
 
 import (
+	"auth-service/internal/domain/entity"
+	"context"
 	"github.com/stretchr/testify/mock"
 	"time"
 )
@@ -9,12 +11,19 @@ type MockTokenSigner struct {
 	mock.Mock
 }
 
-func (m *MockTokenSigner) SignJWT(username, role, secretKey string, expiryTime time.Duration) (string, error) {
-	args := m.Called(username, role, secretKey, expiryTime)
+func (m *MockTokenSigner) SignJWT(username, role string, expiryTime time.Duration) (string, error) {
+	args := m.Called(username, role, expiryTime)
 	return args.String(0), args.Error(1)
 }
 
-func (m *MockTokenSigner) SignJWTRefreshToken(username, secretKey string) (string, error) {
-	args := m.Called(username, secretKey)
-	return args.String(0), args.Error(1)
+func (m *MockTokenSigner) VerificationKeys() []*entity.SigningKey {
+	args := m.Called()
+	if args.Get(0) == nil {
+		return nil
+	}
+	return args.Get(0).([]*entity.SigningKey)
+}
+
+func (m *MockTokenSigner) StartKeyRotation(ctx context.Context) {
+	m.Called(ctx)
 }
@@ -0,0 +1,25 @@
+package auth
+
+import (
+	"github.com/stretchr/testify/mock"
+	"time"
+)
+
+type MockTOTP struct {
+	mock.Mock
+}
+
+func (m *MockTOTP) GenerateSecret() (string, error) {
+	args := m.Called()
+	return args.String(0), args.Error(1)
+}
+
+func (m *MockTOTP) ProvisioningURI(username, secret string) string {
+	args := m.Called(username, secret)
+	return args.String(0)
+}
+
+func (m *MockTOTP) Validate(secret, code string, at time.Time) (int64, bool) {
+	args := m.Called(secret, code, at)
+	return args.Get(0).(int64), args.Bool(1)
+}
@@ -33,13 +33,18 @@ func (m *MockEmployeeRepo) UpdateEmployee(employee *entity.Employee) (*entity.Em
 	return args.Get(0).(*entity.Employee), args.Error(1)
 }
 
+func (m *MockEmployeeRepo) UpdatePassword(username, hashedPassword string) error {
+	args := m.Called(username, hashedPassword)
+	return args.Error(0)
+}
+
 func (m *MockEmployeeRepo) DeleteEmployee(username, requester string) error {
 	args := m.Called(username, requester)
 	return args.Error(0)
 }
 
-func (m *MockEmployeeRepo) ListEmployee(page, pageSize int, sortOrder string) ([]*entity.Employee, int64, error) {
-	args := m.Called(page, pageSize, sortOrder)
+func (m *MockEmployeeRepo) ListEmployee(page, pageSize int, after *entity.PageCursor, withTotal bool, sortOrder string) ([]*entity.Employee, int64, error) {
+	args := m.Called(page, pageSize, after, withTotal, sortOrder)
 	if args.Get(0) == nil {
 		return []*entity.Employee{}, args.Get(1).(int64), args.Error(2)
 	}
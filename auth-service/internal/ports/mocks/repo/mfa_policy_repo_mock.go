@@ -0,0 +1,31 @@
+package repo
+
+import (
+	"auth-service/internal/domain/entity"
+	"github.com/stretchr/testify/mock"
+)
+
+type MockMFAPolicyRepo struct {
+	mock.Mock
+}
+
+func (m *MockMFAPolicyRepo) GetMFAPolicy(role string) (*entity.MFAPolicy, error) {
+	args := m.Called(role)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.MFAPolicy), args.Error(1)
+}
+
+func (m *MockMFAPolicyRepo) SaveMFAPolicy(policy *entity.MFAPolicy) error {
+	args := m.Called(policy)
+	return args.Error(0)
+}
+
+func (m *MockMFAPolicyRepo) ListMFAPolicies() ([]*entity.MFAPolicy, error) {
+	args := m.Called()
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*entity.MFAPolicy), args.Error(1)
+}
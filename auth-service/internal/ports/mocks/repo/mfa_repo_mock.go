@@ -0,0 +1,77 @@
+package repo
+
+import (
+	"auth-service/internal/domain/entity"
+	"github.com/stretchr/testify/mock"
+	"time"
+)
+
+type MockMFARepo struct {
+	mock.Mock
+}
+
+func (m *MockMFARepo) GetMFA(username string) (*entity.EmployeeMFA, error) {
+	args := m.Called(username)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.EmployeeMFA), args.Error(1)
+}
+
+func (m *MockMFARepo) SaveMFA(mfa *entity.EmployeeMFA) error {
+	args := m.Called(mfa)
+	return args.Error(0)
+}
+
+func (m *MockMFARepo) EnableMFA(username string, step int64, recoveryCodes []*entity.MFARecoveryCode) error {
+	args := m.Called(username, step, recoveryCodes)
+	return args.Error(0)
+}
+
+func (m *MockMFARepo) UseMFAStep(username string, step int64) error {
+	args := m.Called(username, step)
+	return args.Error(0)
+}
+
+func (m *MockMFARepo) UseRecoveryCode(username, codeHash string) error {
+	args := m.Called(username, codeHash)
+	return args.Error(0)
+}
+
+func (m *MockMFARepo) DeleteMFA(username string) error {
+	args := m.Called(username)
+	return args.Error(0)
+}
+
+func (m *MockMFARepo) ClaimMFAAttempt(username string, maxFailures int, lockout time.Duration) error {
+	args := m.Called(username, maxFailures, lockout)
+	return args.Error(0)
+}
+
+func (m *MockMFARepo) ResetMFAAttempts(username string) error {
+	args := m.Called(username)
+	return args.Error(0)
+}
+
+func (m *MockMFARepo) CreateMFAChallenge(challenge *entity.MFAChallenge) error {
+	args := m.Called(challenge)
+	return args.Error(0)
+}
+
+func (m *MockMFARepo) GetMFAChallengeByHash(tokenHash string) (*entity.MFAChallenge, error) {
+	args := m.Called(tokenHash)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.MFAChallenge), args.Error(1)
+}
+
+func (m *MockMFARepo) ClaimMFAChallengeAttempt(id string, maxAttempts int) error {
+	args := m.Called(id, maxAttempts)
+	return args.Error(0)
+}
+
+func (m *MockMFARepo) ConsumeMFAChallenge(id string) error {
+	args := m.Called(id)
+	return args.Error(0)
+}
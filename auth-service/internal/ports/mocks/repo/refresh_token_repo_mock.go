@@ -0,0 +1,33 @@
+package repo
+
+import (
+	"auth-service/internal/domain/entity"
+	"github.com/stretchr/testify/mock"
+)
+
+type MockRefreshTokenRepo struct {
+	mock.Mock
+}
+
+func (m *MockRefreshTokenRepo) CreateRefreshToken(token *entity.RefreshToken) error {
+	args := m.Called(token)
+	return args.Error(0)
+}
+
+func (m *MockRefreshTokenRepo) GetRefreshTokenByHash(tokenHash string) (*entity.RefreshToken, error) {
+	args := m.Called(tokenHash)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.RefreshToken), args.Error(1)
+}
+
+func (m *MockRefreshTokenRepo) RotateRefreshToken(current, next *entity.RefreshToken) error {
+	args := m.Called(current, next)
+	return args.Error(0)
+}
+
+func (m *MockRefreshTokenRepo) RevokeTokenFamily(familyID, reason string) error {
+	args := m.Called(familyID, reason)
+	return args.Error(0)
+}
@@ -0,0 +1,23 @@
+package repo
+
+import (
+	"auth-service/internal/domain/entity"
+	"github.com/stretchr/testify/mock"
+)
+
+type MockTokenRevocationRepo struct {
+	mock.Mock
+}
+
+func (m *MockTokenRevocationRepo) CreateTokenRevocation(revocation *entity.TokenRevocation) error {
+	args := m.Called(revocation)
+	return args.Error(0)
+}
+
+func (m *MockTokenRevocationRepo) ListTokenRevocations(afterSequence uint64, limit int) ([]*entity.TokenRevocation, error) {
+	args := m.Called(afterSequence, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*entity.TokenRevocation), args.Error(1)
+}
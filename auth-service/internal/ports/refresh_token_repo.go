@@ -0,0 +1,11 @@
+package ports
+
+import "auth-service/internal/domain/entity"
+
+// RefreshTokenRepo defines the interface for refresh token database operations
+type RefreshTokenRepo interface {
+	CreateRefreshToken(token *entity.RefreshToken) error
+	GetRefreshTokenByHash(tokenHash string) (*entity.RefreshToken, error)
+	RotateRefreshToken(current, next *entity.RefreshToken) error
+	RevokeTokenFamily(familyID, reason string) error
+}
@@ -0,0 +1,13 @@
+package ports
+
+import (
+	"auth-service/internal/domain/entity"
+	"time"
+)
+
+// SigningKeyRepo defines the interface for JWT signing key database operations
+type SigningKeyRepo interface {
+	ListSigningKeys() ([]*entity.SigningKey, error)
+	CreateSigningKey(key *entity.SigningKey) error
+	RetireSigningKeys(currentID string, retiredAt, expiresAt time.Time) error
+}
@@ -0,0 +1,9 @@
+package ports
+
+import "auth-service/internal/domain/entity"
+
+// TokenRevocationRepo defines the interface for access token revocation database operations
+type TokenRevocationRepo interface {
+	CreateTokenRevocation(revocation *entity.TokenRevocation) error
+	ListTokenRevocations(afterSequence uint64, limit int) ([]*entity.TokenRevocation, error)
+}
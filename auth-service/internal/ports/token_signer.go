@@ -1,9 +1,17 @@
 package ports
 
-import "time"
+import (
+	"auth-service/internal/domain/entity"
+	"context"
+	"time"
+)
 
 // TokenSigner is responsible for signing JWT tokens.
 type TokenSigner interface {
-	SignJWT(username, role, secretKey string, expiryTime time.Duration) (string, error)
-	SignJWTRefreshToken(username, secretKey string) (string, error)
+	// SignJWT signs a token for the employee with the current signing key
+	SignJWT(username, role string, expiryTime time.Duration) (string, error)
+	// VerificationKeys returns the keys tokens may currently be signed with, to be published as JWKS
+	VerificationKeys() []*entity.SigningKey
+	// StartKeyRotation rotates the signing key on schedule until the context is done
+	StartKeyRotation(ctx context.Context)
 }
@@ -0,0 +1,13 @@
+package ports
+
+import "time"
+
+// TOTP generates and verifies time-based one-time passwords (RFC 6238) of authenticator apps.
+type TOTP interface {
+	// GenerateSecret returns a new random secret, base32 encoded as authenticator apps expect it
+	GenerateSecret() (string, error)
+	// ProvisioningURI returns the otpauth:// URI of the secret, shown as QR code to enroll an authenticator app
+	ProvisioningURI(username, secret string) string
+	// Validate checks the code at the given time, allowing for clock drift, and returns the time step it belongs to
+	Validate(secret, code string, at time.Time) (int64, bool)
+}
@@ -1,8 +1,11 @@
 package integration
 
 import (
+	"auth-service/internal/domain/entity"
+	mock_auth "auth-service/internal/ports/mocks/auth"
 	"auth-service/internal/runtime"
 	"context"
+	"encoding/json"
 	"io"
 	"net"
 	"net/http"
@@ -21,13 +24,21 @@ func TestHttpHealthEndpoints(t *testing.T) {
 	}
 	addr := "http://" + ln.Addr().String()
 
+	// Token signer publishing a single key
+	signingKey, err := entity.NewSigningKey(time.Now())
+	if err != nil {
+		t.Fatalf("signing key: %v", err)
+	}
+	tokenSigner := new(mock_auth.MockTokenSigner)
+	tokenSigner.On("VerificationKeys").Return([]*entity.SigningKey{signingKey})
+
 	// Generating new server and context
 	srv := httpserver.NewServerHTTP(httpserver.ServerConfig{
 		Addr:         ln.Addr().String(),
 		ReadTimeout:  2 * time.Second,
 		WriteTimeout: 2 * time.Second,
 		IdleTimeout:  5 * time.Second,
-	})
+	}, tokenSigner)
 
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
@@ -85,6 +96,26 @@ func TestHttpHealthEndpoints(t *testing.T) {
 		t.Fatalf("ready status = %d", readinessResp.StatusCode)
 	}
 
+	// Check the published verification keys
+	jwksResp, err := http.Get(addr + "/.well-known/jwks.json")
+	if err != nil {
+		t.Fatalf("GET /.well-known/jwks.json: %v", err)
+	}
+	defer jwksResp.Body.Close()
+	if jwksResp.StatusCode != http.StatusOK {
+		t.Fatalf("jwks status = %d", jwksResp.StatusCode)
+	}
+
+	var jwks struct {
+		Keys []entity.JSONWebKey `json:"keys"`
+	}
+	if err := json.NewDecoder(jwksResp.Body).Decode(&jwks); err != nil {
+		t.Fatalf("jwks body: %v", err)
+	}
+	if len(jwks.Keys) != 1 || jwks.Keys[0].KeyID != signingKey.ID || jwks.Keys[0].Curve != "Ed25519" {
+		t.Fatalf("jwks keys = %+v", jwks.Keys)
+	}
+
 	// Shutdown and assert the server exits cleanly
 	cancel()
 	select {
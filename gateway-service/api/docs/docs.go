@@ -17,7 +17,7 @@ const docTemplate = `{
     "paths": {
         "/api/v1/account": {
             "get": {
-                "description": "**Query Parameters:**\n\ncustomer_id:\n- Optional\n- Filter by Customer ID\n\nin_transaction:\n- Optional\n- Filter accounts currently in transaction\n- Values: true/false\n- Default: no filter applied\n\nmin_balance:\n- Optional\n- Filter by minimum balance\n\npage:\n- Optional\n- Page number for pagination\n- Default: 1\n\npagesize:\n- Optional\n- Number of accounts per page\n- Default: 50\n\norder:\n- Optional\n- Sort order (asc/desc)\n- Default: desc\n\n**Header:**\n\nAuthorization:\n- Required\n- Format: Bearer token",
+                "description": "**Query Parameters:**\n\ncustomer_id:\n- Optional\n- Filter by Customer ID\n\nin_transaction:\n- Optional\n- Filter accounts currently in transaction\n- Values: true/false\n- Default: no filter applied\n\nmin_balance:\n- Optional\n- Filter by minimum balance\n\npage:\n- Optional\n- Page number for pagination\n- Default: 1\n\npagesize:\n- Optional\n- Number of accounts per page\n- Default: 50\n\ncursor:\n- Optional\n- next_cursor of the previous page; the page continues after it and page is ignored\n- Unlike page, a cursor does not skip or repeat rows added while paging\n\ninclude_total:\n- Optional\n- Count totalCount and totalPages, which are 0 otherwise\n- Default: true without cursor, false with cursor\n\norder:\n- Optional\n- Sort order (asc/desc)\n- Default: desc\n\n**Header:**\n\nAuthorization:\n- Required\n- Format: Bearer token",
                 "consumes": [
                     "application/json"
                 ],
@@ -69,6 +69,18 @@ const docTemplate = `{
                         "name": "pagesize",
                         "in": "query"
                     },
+                    {
+                        "type": "string",
+                        "description": "next_cursor of the previous page",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Count totalCount and totalPages (default: true without cursor, false with cursor)",
+                        "name": "include_total",
+                        "in": "query"
+                    },
                     {
                         "type": "string",
                         "default": "desc",
@@ -99,7 +111,7 @@ const docTemplate = `{
                 }
             },
             "post": {
-                "description": "**Request Body:**\n\nCustomer ID:\n- Required\n\nDeposit Amount:\n- Required\n- Must be greater than zero\n\n**Header:**\n\nAuthorization:\n- Required\n- Format: Bearer token",
+                "description": "**Request Body:**\n\nCustomer ID:\n- Required\n\nDeposit Amount:\n- Required\n- Must be greater than zero\n- Decimal number or string with at most 2 decimal places (e.g. \"100.50\")\n\nCurrency:\n- Optional\n- Options: **USD**, **EUR**, **BDT**\n- Default: USD\n\nAccount Type:\n- Optional\n- Options: **savings**, **current**\n- Default: savings\n- Only current accounts can have an overdraft\n\n**Header:**\n\nAuthorization:\n- Required\n- Format: Bearer token",
                 "consumes": [
                     "application/json"
                 ],
@@ -211,7 +223,7 @@ const docTemplate = `{
         },
         "/api/v1/account/{id}/balance": {
             "get": {
-                "description": "**Path Parameter:**\n\nid:\n- Required\n- AccountID of a customer account\n\n**Header:**\n\nAuthorization:\n- Required\n- Format: Bearer token",
+                "description": "**Path Parameter:**\n\nid:\n- Required\n- AccountID of a customer account\n\n**ledger_balance** is the booked balance. **available_balance** is what can be debited:\nthe ledger balance plus the overdraft limit, minus the funds reserved by active holds.\n\n**Header:**\n\nAuthorization:\n- Required\n- Format: Bearer token",
                 "consumes": [
                     "application/json"
                 ],
@@ -261,9 +273,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/auth/login": {
+        "/api/v1/account/{id}/close": {
             "post": {
-                "description": "**Request Body:**\n\nusername:\n- Required\n\npassword:\n- Required",
+                "description": "**Path Parameter:**\n\nid:\n- Required\n- AccountID, the account must be **active**, without active holds and not overdrawn\n\n**Request Body:**\n\nPayout Account ID:\n- Required when the balance, including the accrued interest, is not zero\n- The full balance is transferred to this account before closing\n\nReason:\n- Required\n\nThe payout is a regular transfer with the reference **account-closure:{account id}:{id}**. The interest accrued up to the last accrued day is credited first and paid out with the balance. If the account receives funds before it is closed, the closure fails and can be repeated to pay out the rest. A closed account cannot be reopened.\n\n**Header:**\n\nAuthorization:\n- Required\n- Format: Bearer token\n- Admin only",
                 "consumes": [
                     "application/json"
                 ],
@@ -271,17 +283,32 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Authentication"
+                    "Account"
                 ],
-                "summary": "Login API",
+                "summary": "Close Account",
                 "parameters": [
                     {
-                        "description": "Login credentials",
-                        "name": "login",
+                        "type": "string",
+                        "default": "Bearer",
+                        "description": "Bearer token",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "AccountID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Closure details",
+                        "name": "close",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/handlers.LoginRequest"
+                            "$ref": "#/definitions/handlers.CloseAccountRequest"
                         }
                     }
                 ],
@@ -289,7 +316,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/handlers.LoginResponse"
+                            "$ref": "#/definitions/handlers.CloseAccountResponse"
                         }
                     },
                     "400": {
@@ -307,9 +334,76 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/customer": {
+        "/api/v1/account/{id}/freeze": {
+            "post": {
+                "description": "**Path Parameter:**\n\nid:\n- Required\n- AccountID, the account must not be closed\n\n**Request Body:**\n\nFreeze Mode:\n- Optional\n- Allowed values: **debit**, **credit**, **both**\n- Default: both\n- A frozen account can be frozen again to change the mode\n\nReason:\n- Required\n\nCompensations of failed transactions are still applied to a frozen account.\n\n**Header:**\n\nAuthorization:\n- Required\n- Format: Bearer token\n- Admin only",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Account"
+                ],
+                "summary": "Freeze Account",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "default": "Bearer",
+                        "description": "Bearer token",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "AccountID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Freeze details",
+                        "name": "freeze",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handlers.FreezeAccountRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.AccountStatusResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/account/{id}/hold": {
             "get": {
-                "description": "**Query Parameters:**\n\npage:\n- Optional\n- Page number for pagination\n- Default: 1\n\npagesize:\n- Optional\n- Number of customers per page\n- Default: 50\n\norder:\n- Optional\n- Sort order (asc/desc)\n- Default: desc\n\n**Header:**\n\nAuthorization:\n- Required\n- Format: Bearer token",
+                "description": "**Path Parameter:**\n\nid:\n- Required\n- AccountID\n\n**Query Parameters:**\n\npage:\n- Optional\n- Page number for pagination\n- Default: 1\n\npagesize:\n- Optional\n- Number of holds per page\n- Default: 50\n\nHolds of every status are listed, newest first.\n\n**Header:**\n\nAuthorization:\n- Required\n- Format: Bearer token",
                 "consumes": [
                     "application/json"
                 ],
@@ -317,9 +411,9 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Customer"
+                    "Hold"
                 ],
-                "summary": "Get Customer List",
+                "summary": "List Account Holds",
                 "parameters": [
                     {
                         "type": "string",
@@ -329,6 +423,13 @@ const docTemplate = `{
                         "in": "header",
                         "required": true
                     },
+                    {
+                        "type": "string",
+                        "description": "AccountID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
                     {
                         "type": "integer",
                         "default": 1,
@@ -339,23 +440,16 @@ const docTemplate = `{
                     {
                         "type": "integer",
                         "default": 50,
-                        "description": "Number of customers per page",
+                        "description": "Number of holds per page",
                         "name": "pagesize",
                         "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "default": "desc",
-                        "description": "Sort order (asc/desc)",
-                        "name": "order",
-                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/handlers.ListCustomerResponse"
+                            "$ref": "#/definitions/handlers.ListHoldsResponse"
                         }
                     },
                     "400": {
@@ -369,11 +463,17 @@ const docTemplate = `{
                         "schema": {
                             "$ref": "#/definitions/handlers.ErrorResponse"
                         }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.ErrorResponse"
+                        }
                     }
                 }
             },
             "post": {
-                "description": "**Request Body:**\n\nName:\n- Required\n- Max 50 characters\n\n**Header:**\n\nAuthorization:\n- Required\n- Format: Bearer token",
+                "description": "**Path Parameter:**\n\nid:\n- Required\n- AccountID\n\n**Request Body:**\n\nAmount:\n- Required\n- Must be greater than zero and covered by the available balance\n- Decimal number or string with at most 2 decimal places (e.g. \"120.00\")\n\nReason:\n- Optional\n\nExpires At:\n- Optional\n- RFC 3339 time, must be in the future (e.g. \"2026-01-31T09:00:00Z\")\n- Default: 7 days from now\n\nAn active hold reduces the available balance but not the ledger balance.\n\n**Header:**\n\nAuthorization:\n- Required\n- Format: Bearer token",
                 "consumes": [
                     "application/json"
                 ],
@@ -381,9 +481,9 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Customer"
+                    "Hold"
                 ],
-                "summary": "Create Customer",
+                "summary": "Place Hold",
                 "parameters": [
                     {
                         "type": "string",
@@ -394,12 +494,19 @@ const docTemplate = `{
                         "required": true
                     },
                     {
-                        "description": "Customer details",
-                        "name": "customer",
+                        "type": "string",
+                        "description": "AccountID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Hold details",
+                        "name": "hold",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/handlers.CreateCustomerRequest"
+                            "$ref": "#/definitions/handlers.PlaceHoldRequest"
                         }
                     }
                 ],
@@ -407,7 +514,7 @@ const docTemplate = `{
                     "201": {
                         "description": "Created",
                         "schema": {
-                            "$ref": "#/definitions/handlers.CreateCustomerResponse"
+                            "$ref": "#/definitions/handlers.HoldResponse"
                         }
                     },
                     "400": {
@@ -421,13 +528,19 @@ const docTemplate = `{
                         "schema": {
                             "$ref": "#/definitions/handlers.ErrorResponse"
                         }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.ErrorResponse"
+                        }
                     }
                 }
             }
         },
-        "/api/v1/customer/{id}": {
-            "delete": {
-                "description": "**Header:**\n\nAuthorization:\n- Required\n- Format: Bearer token\n\n**Path Parameter:**\n\nid:\n- Required\n- CustomerID of the customer to delete",
+        "/api/v1/account/{id}/interest": {
+            "get": {
+                "description": "**Path Parameter:**\n\nid:\n- Required\n- AccountID\n\n**Query Parameters:**\n\npage:\n- Optional\n- Page number for pagination\n- Default: 1\n\npagesize:\n- Optional\n- Number of postings per page\n- Default: 50\n\nAccrued interest is the interest not yet closed into a monthly posting. A posting is **pending** until\nits **interest_credit** transaction completes, then **posted**. Postings are listed newest first.\n\n**Header:**\n\nAuthorization:\n- Required\n- Format: Bearer token",
                 "consumes": [
                     "application/json"
                 ],
@@ -435,9 +548,9 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Customer"
+                    "Interest"
                 ],
-                "summary": "Delete Customer",
+                "summary": "Get Account Interest",
                 "parameters": [
                     {
                         "type": "string",
@@ -449,17 +562,31 @@ const docTemplate = `{
                     },
                     {
                         "type": "string",
-                        "description": "CustomerID of the customer",
+                        "description": "AccountID",
                         "name": "id",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "default": 1,
+                        "description": "Page number for pagination",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 50,
+                        "description": "Number of postings per page",
+                        "name": "pagesize",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/handlers.DeleteCustomerResponse"
+                            "$ref": "#/definitions/handlers.GetAccountInterestResponse"
                         }
                     },
                     "400": {
@@ -473,13 +600,19 @@ const docTemplate = `{
                         "schema": {
                             "$ref": "#/definitions/handlers.ErrorResponse"
                         }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.ErrorResponse"
+                        }
                     }
                 }
             }
         },
-        "/api/v1/customer/{id}/account": {
+        "/api/v1/account/{id}/journal": {
             "get": {
-                "description": "**Path Parameter:**\n\nid:\n- Required\n- CustomerID of the customer\n\n**Query Parameters:**\n\npage:\n- Optional\n- Page number for pagination\n- Default: 1\n\npagesize:\n- Optional\n- Number of accounts per page\n- Default: 50\n\n**Header:**\n\nAuthorization:\n- Required\n- Format: Bearer token",
+                "description": "**Path Parameter:**\n\nid:\n- Required\n- AccountID of a customer account\n\n**Query Parameters:**\n\npage:\n- Optional\n- Page number for pagination\n- Default: 1\n\npagesize:\n- Optional\n- Number of entries per page\n- Default: 100\n\n**Header:**\n\nAuthorization:\n- Required\n- Format: Bearer token",
                 "consumes": [
                     "application/json"
                 ],
@@ -487,9 +620,9 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Customer"
+                    "Account"
                 ],
-                "summary": "Get Account List of customer",
+                "summary": "Get Account Journal",
                 "parameters": [
                     {
                         "type": "string",
@@ -501,7 +634,7 @@ const docTemplate = `{
                     },
                     {
                         "type": "string",
-                        "description": "CustomerID of the customer",
+                        "description": "AccountID of a customer account",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -515,17 +648,17 @@ const docTemplate = `{
                     },
                     {
                         "type": "integer",
-                        "default": 50,
-                        "description": "Number of accounts per page",
+                        "default": 100,
+                        "description": "Number of entries per page",
                         "name": "pagesize",
                         "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Successfully retrieved account list",
+                        "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/handlers.ListAccountResponse"
+                            "$ref": "#/definitions/handlers.GetAccountJournalResponse"
                         }
                     },
                     "400": {
@@ -543,9 +676,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/employee": {
+        "/api/v1/account/{id}/ledger-balance": {
             "get": {
-                "description": "**Query Parameters:**\n\npage:\n- Optional\n- Page number for pagination\n- Default: 1\n\npagesize:\n- Optional\n- Number of employees per page\n- Default: 50\n\norder:\n- Optional\n- Sort order (asc/desc)\n- Default: desc\n\n**Header:**\n\nAuthorization:\n- Required\n- Format: Bearer token",
+                "description": "**Path Parameter:**\n\nid:\n- Required\n- AccountID of a customer account\n\n**Header:**\n\nAuthorization:\n- Required\n- Format: Bearer token",
                 "consumes": [
                     "application/json"
                 ],
@@ -553,9 +686,9 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Employee"
+                    "Account"
                 ],
-                "summary": "Get Employee List",
+                "summary": "Recompute Account Balance From Journal",
                 "parameters": [
                     {
                         "type": "string",
@@ -565,33 +698,19 @@ const docTemplate = `{
                         "in": "header",
                         "required": true
                     },
-                    {
-                        "type": "integer",
-                        "default": 1,
-                        "description": "Page number for pagination",
-                        "name": "page",
-                        "in": "query"
-                    },
-                    {
-                        "type": "integer",
-                        "default": 50,
-                        "description": "Number of employee per page",
-                        "name": "pagesize",
-                        "in": "query"
-                    },
                     {
                         "type": "string",
-                        "default": "desc",
-                        "description": "Sort order (asc/desc)",
-                        "name": "order",
-                        "in": "query"
+                        "description": "AccountID of a customer account",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Successfully retrieved employee list",
+                        "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/handlers.ListEmployeeResponse"
+                            "$ref": "#/definitions/handlers.RecomputeAccountBalanceResponse"
                         }
                     },
                     "400": {
@@ -607,9 +726,11 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "post": {
-                "description": "**Request Body:**\n\nUsername:\n- Required\n- Max 50 characters\n- Lowercase letters only\n- Underscores allowed only in middle\n\nPassword:\n- Required\n- Max 50 characters\n- Supports only A-Z, a-z, 0-9, and these special characters: ! - _ \u0026 $ @ # [ ]\n\nRole:\n- Required\n- Options: **admin**, **viewer**, **editor**\n\n**Header:**\n\nAuthorization:\n- Required\n- Format: Bearer token",
+            }
+        },
+        "/api/v1/account/{id}/overdraft": {
+            "put": {
+                "description": "**Path Parameter:**\n\nid:\n- Required\n- AccountID of a current account\n\n**Request Body:**\n\nOverdraft Limit:\n- Required\n- Decimal number or string with at most 2 decimal places (e.g. \"500.00\")\n- 0 removes the overdraft\n- Cannot be lower than the amount the account is overdrawn by\n\n**Header:**\n\nAuthorization:\n- Required\n- Format: Bearer token\n- Admin only",
                 "consumes": [
                     "application/json"
                 ],
@@ -617,9 +738,9 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Employee"
+                    "Account"
                 ],
-                "summary": "Create Employee",
+                "summary": "Set Overdraft Limit",
                 "parameters": [
                     {
                         "type": "string",
@@ -630,30 +751,43 @@ const docTemplate = `{
                         "required": true
                     },
                     {
-                        "description": "Employee creation data",
-                        "name": "employee",
+                        "type": "string",
+                        "description": "AccountID of a current account",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Overdraft limit",
+                        "name": "overdraft",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/handlers.CreateEmployeeRequest"
+                            "$ref": "#/definitions/handlers.SetOverdraftLimitRequest"
                         }
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "Employee created successfully",
+                    "200": {
+                        "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/handlers.CreateEmployeeResponse"
+                            "$ref": "#/definitions/handlers.SetOverdraftLimitResponse"
                         }
                     },
                     "400": {
-                        "description": "Invalid input data",
+                        "description": "Bad Request",
                         "schema": {
                             "$ref": "#/definitions/handlers.ErrorResponse"
                         }
                     },
                     "401": {
-                        "description": "Unauthorized - Missing or invalid token",
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
                         "schema": {
                             "$ref": "#/definitions/handlers.ErrorResponse"
                         }
@@ -661,19 +795,21 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/employee/{username}": {
-            "delete": {
-                "description": "**Header:**\n\nAuthorization:\n- Required\n- Format: Bearer token\n\n**Path Parameter:**\n\nusername:\n- Required\n- Username of the employee to delete",
+        "/api/v1/account/{id}/statement": {
+            "get": {
+                "description": "**Path Parameter:**\n\nid:\n- Required\n- AccountID\n\n**Query Parameters:**\n\nfrom, to:\n- Required\n- First and last UTC day of the statement, both inclusive (e.g. 2026-01-01 and 2026-01-31)\n- At most 366 days apart\n\nformat:\n- Optional\n- Options: **json**, **csv**, **pdf**\n- Default: json\n\nThe statement lists every journal entry of the account in the range, oldest first, with the balance after\nit, between the opening balance before the first day and the closing balance at the end of the last day.\nGenerated statements are not stored; monthly statements are issued automatically.\n\n**Header:**\n\nAuthorization:\n- Required\n- Format: Bearer token",
                 "consumes": [
                     "application/json"
                 ],
                 "produces": [
-                    "application/json"
+                    "application/json",
+                    "text/csv",
+                    "application/pdf"
                 ],
                 "tags": [
-                    "Employee"
+                    "Statement"
                 ],
-                "summary": "Delete Employee",
+                "summary": "Generate Account Statement",
                 "parameters": [
                     {
                         "type": "string",
@@ -685,17 +821,38 @@ const docTemplate = `{
                     },
                     {
                         "type": "string",
-                        "description": "Username of the employee",
-                        "name": "username",
+                        "description": "AccountID",
+                        "name": "id",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "First day (YYYY-MM-DD)",
+                        "name": "from",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Last day (YYYY-MM-DD)",
+                        "name": "to",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "default": "json",
+                        "description": "json, csv or pdf",
+                        "name": "format",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/handlers.DeleteEmployeeResponse"
+                            "$ref": "#/definitions/handlers.StatementResponse"
                         }
                     },
                     "400": {
@@ -709,13 +866,19 @@ const docTemplate = `{
                         "schema": {
                             "$ref": "#/definitions/handlers.ErrorResponse"
                         }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.ErrorResponse"
+                        }
                     }
                 }
             }
         },
-        "/api/v1/transaction": {
+        "/api/v1/account/{id}/statements": {
             "get": {
-                "description": "**Query Parameters:**\n\naccount_id:\n- Optional\n- Filter by account ID\n\ncustomer_id:\n- Optional\n- Filter by customer ID\n\ntypes:\n- Optional\n- Filter by transaction types\n- Comma separated values: **transfer**, **withdraw_full**, **withdraw_amount**, **add_amount**\n\nstart_date:\n- Optional\n- Start date for filtering\n- Format: DD-MM-YYYY\n\nend_date:\n- Optional\n- End date for filtering\n- Format: DD-MM-YYYY\n\npage:\n- Optional\n- Page number for pagination\n- Default: 1\n\npagesize:\n- Optional\n- Number of transactions per page\n- Default: 50\n\norder:\n- Optional\n- Sort order (asc/desc)\n- Default: desc\n\n**Header:**\n\nAuthorization:\n- Required\n- Format: Bearer token",
+                "description": "**Path Parameter:**\n\nid:\n- Required\n- AccountID\n\n**Query Parameters:**\n\npage:\n- Optional\n- Page number for pagination\n- Default: 1\n\npagesize:\n- Optional\n- Number of statements per page\n- Default: 50\n\nA statement is issued for every active account after each UTC month ends. Statements are listed newest\nfirst without their lines; fetch one through /api/v1/statement/{id}.\n\n**Header:**\n\nAuthorization:\n- Required\n- Format: Bearer token",
                 "consumes": [
                     "application/json"
                 ],
@@ -723,9 +886,9 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Transaction"
+                    "Statement"
                 ],
-                "summary": "Get Transaction History",
+                "summary": "List Account Statements",
                 "parameters": [
                     {
                         "type": "string",
@@ -737,61 +900,203 @@ const docTemplate = `{
                     },
                     {
                         "type": "string",
-                        "description": "Transaction history by account id",
-                        "name": "account_id",
-                        "in": "query"
+                        "description": "AccountID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
                     },
                     {
-                        "type": "string",
-                        "description": "Transaction history by customer id",
-                        "name": "customer_id",
+                        "type": "integer",
+                        "default": 1,
+                        "description": "Page number for pagination",
+                        "name": "page",
                         "in": "query"
                     },
                     {
-                        "type": "string",
-                        "description": "Comma separated transaction types (transfer/withdraw_full/withdraw_amount/add_amount)",
-                        "name": "types",
+                        "type": "integer",
+                        "default": 50,
+                        "description": "Number of statements per page",
+                        "name": "pagesize",
                         "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.ListAccountStatementsResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.ErrorResponse"
+                        }
                     },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/account/{id}/unfreeze": {
+            "post": {
+                "description": "**Path Parameter:**\n\nid:\n- Required\n- AccountID, the account must be **frozen** or **dormant**\n\n**Request Body:**\n\nReason:\n- Required\n\n**Header:**\n\nAuthorization:\n- Required\n- Format: Bearer token\n- Admin only",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Account"
+                ],
+                "summary": "Unfreeze Account",
+                "parameters": [
                     {
                         "type": "string",
-                        "description": "Start date for filtering (format: DD-MM-YYYY)",
-                        "name": "start_date",
-                        "in": "query"
+                        "default": "Bearer",
+                        "description": "Bearer token",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
                     },
                     {
                         "type": "string",
-                        "description": "End date for filtering (format: DD-MM-YYYY)",
-                        "name": "end_date",
-                        "in": "query"
+                        "description": "AccountID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
                     },
                     {
-                        "type": "integer",
-                        "default": 1,
-                        "description": "Page number for pagination",
-                        "name": "page",
-                        "in": "query"
+                        "description": "Unfreeze details",
+                        "name": "unfreeze",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handlers.UnfreezeAccountRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.AccountStatusResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.ErrorResponse"
+                        }
                     },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/auth/login": {
+            "post": {
+                "description": "**Request Body:**\n\nusername:\n- Required\n\npassword:\n- Required\n\nWhen the employee has enabled MFA or MFA is required for their role, no tokens are issued. The response\ncarries **mfa_required** and an **mfa_challenge_token** instead, which is exchanged for the tokens with a\ncode of the authenticator app at /api/v1/auth/mfa/verify. With **mfa_enrollment_required** the employee\nhas to enroll an authenticator app with /api/v1/auth/mfa/challenge/enroll first.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Authentication"
+                ],
+                "summary": "Login API",
+                "parameters": [
                     {
-                        "type": "integer",
-                        "default": 50,
-                        "description": "Number of transactions per page",
-                        "name": "pagesize",
-                        "in": "query"
+                        "description": "Login credentials",
+                        "name": "login",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handlers.LoginRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.LoginResponse"
+                        }
                     },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/auth/logout": {
+            "post": {
+                "description": "**Request Body:**\n\nrefresh_token:\n- Optional\n- The refresh token of the session; it and every refresh token of its login are revoked\n\nThe access token is rejected from now on, even though it has not expired yet.\n\n**Header:**\n\nAuthorization:\n- Required\n- Format: Bearer token",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Authentication"
+                ],
+                "summary": "Logout API",
+                "parameters": [
                     {
                         "type": "string",
-                        "default": "desc",
-                        "description": "Sort order (asc/desc)",
-                        "name": "order",
-                        "in": "query"
+                        "default": "Bearer",
+                        "description": "Bearer token",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "description": "Refresh token",
+                        "name": "logout",
+                        "in": "body",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.LogoutRequest"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/handlers.ListTransactionResponse"
+                            "$ref": "#/definitions/handlers.LogoutResponse"
                         }
                     },
                     "400": {
@@ -809,9 +1114,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/transaction/init": {
+        "/api/v1/auth/mfa/challenge/enroll": {
             "post": {
-                "description": "**Request Body:**\n\nTransaction Type:\n- Required\n- Options: **transfer**, **withdraw_full**, **withdraw_amount**, **add_amount**\n\nAmount:\n- Required for all types except **withdraw_full**\n- Must be greater than zero\n\nDestination Account ID:\n- Required only for **transfer** type\n\nReference:\n- Required for all transactions\n\n**Header:**\n\nAuthorization:\n- Required\n- Format: Bearer token",
+                "description": "**Request Body:**\n\nchallenge_token:\n- Required\n- The mfa_challenge_token of a login response with mfa_enrollment_required\n\nThe response carries the TOTP secret and its otpauth:// URI to show as QR code. The login is completed\nat /api/v1/auth/mfa/verify with the same challenge token and the first code of the authenticator app.",
                 "consumes": [
                     "application/json"
                 ],
@@ -819,9 +1124,55 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Transaction"
+                    "Authentication"
                 ],
-                "summary": "Create new transaction",
+                "summary": "Enroll MFA During Login API",
+                "parameters": [
+                    {
+                        "description": "MFA challenge",
+                        "name": "enroll",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handlers.EnrollMFAChallengeRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.EnrollMFAResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/auth/mfa/confirm": {
+            "post": {
+                "description": "**Request Body:**\n\ncode:\n- Required\n- 6 digit code of the enrolled authenticator app\n\nFrom the next login on, a code of the authenticator app is required. The response carries the\nrecovery codes, which are not shown again.\n\n**Header:**\n\nAuthorization:\n- Required\n- Format: Bearer token",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Authentication"
+                ],
+                "summary": "Confirm MFA API",
                 "parameters": [
                     {
                         "type": "string",
@@ -832,20 +1183,20 @@ const docTemplate = `{
                         "required": true
                     },
                     {
-                        "description": "Transaction details",
-                        "name": "transaction",
+                        "description": "Code of the authenticator app",
+                        "name": "confirm",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/handlers.InitTransactionRequest"
+                            "$ref": "#/definitions/handlers.ConfirmMFARequest"
                         }
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "Created",
+                    "200": {
+                        "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/handlers.InitTransactionResponse"
+                            "$ref": "#/definitions/handlers.ConfirmMFAResponse"
                         }
                     },
                     "400": {
@@ -862,121 +1213,3499 @@ const docTemplate = `{
                     }
                 }
             }
-        }
-    },
-    "definitions": {
-        "handlers.CreateAccountRequest": {
+        },
+        "/api/v1/auth/mfa/enroll": {
+            "post": {
+                "description": "The response carries the TOTP secret and its otpauth:// URI to show as QR code. MFA is enabled once\nthe first code of the authenticator app is confirmed at /api/v1/auth/mfa/confirm. Enrolling again\nreplaces an unconfirmed enrollment; enabled MFA has to be reset by an admin first.\n\n**Header:**\n\nAuthorization:\n- Required\n- Format: Bearer token",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Authentication"
+                ],
+                "summary": "Enroll MFA API",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "default": "Bearer",
+                        "description": "Bearer token",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.EnrollMFAResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/auth/mfa/policies": {
+            "get": {
+                "description": "**Header:**\n\nAuthorization:\n- Required\n- Format: Bearer token",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Authentication"
+                ],
+                "summary": "List MFA Policies",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "default": "Bearer",
+                        "description": "Bearer token",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.ListMFAPoliciesResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/auth/mfa/policies/{role}": {
+            "put": {
+                "description": "**Path Parameter:**\n\nrole:\n- Required\n- Options: **admin**, **editor**, **viewer**\n\n**Request Body:**\n\nrequired:\n- Required\n- Whether employees of the role need MFA\n\nThe policy applies from the next login. Employees of the role who have not enrolled yet have to enroll\nan authenticator app before they get tokens.\n\n**Header:**\n\nAuthorization:\n- Required\n- Format: Bearer token",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Authentication"
+                ],
+                "summary": "Set MFA Policy",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "default": "Bearer",
+                        "description": "Bearer token",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Role",
+                        "name": "role",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "MFA policy",
+                        "name": "policy",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handlers.SetMFAPolicyRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.SetMFAPolicyResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/auth/mfa/verify": {
+            "post": {
+                "description": "**Request Body:**\n\nchallenge_token:\n- Required\n- The mfa_challenge_token of the login response\n\ncode:\n- 6 digit code of the authenticator app\n\nrecovery_code:\n- Used instead of a code when the authenticator app is lost\n- Every recovery code can be used once\n\nEither code or recovery_code is required. A challenge expires after a few minutes and after 5 codes;\nthe employee has to log in again then. After 10 wrong codes in a row the employee is locked out for\n15 minutes. When the login required enrollment, the code enables MFA and the response carries the\nrecovery codes, which are not shown again.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Authentication"
+                ],
+                "summary": "Verify MFA API",
+                "parameters": [
+                    {
+                        "description": "MFA challenge and code",
+                        "name": "verify",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handlers.VerifyMFARequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.VerifyMFAResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/auth/refresh": {
+            "post": {
+                "description": "**Request Body:**\n\nrefresh_token:\n- Required\n- The refresh token of the login or of the previous refresh\n\nEvery refresh token can be used once; the response carries the one to use next. Using a refresh token\nagain revokes all refresh tokens of its login, so its holder has to log in again.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Authentication"
+                ],
+                "summary": "Refresh Token API",
+                "parameters": [
+                    {
+                        "description": "Refresh token",
+                        "name": "refresh",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handlers.RefreshRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.LoginResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/customer": {
+            "get": {
+                "description": "**Query Parameters:**\n\npage:\n- Optional\n- Page number for pagination\n- Default: 1\n\npagesize:\n- Optional\n- Number of customers per page\n- Default: 50\n\ncursor:\n- Optional\n- next_cursor of the previous page; the page continues after it and page is ignored\n- Unlike page, a cursor does not skip or repeat rows added while paging\n\ninclude_total:\n- Optional\n- Count totalCount and totalPages, which are 0 otherwise\n- Default: true without cursor, false with cursor\n\norder:\n- Optional\n- Sort order (asc/desc)\n- Default: desc\n\nname:\n- Optional\n- Case-insensitive search, matches customers with a word of the name starting with each word given\n- Example: **jo sm** matches **John Smith**\n\ncreated_by:\n- Optional\n- Username of the employee who created the customer\n\nstart_date, end_date:\n- Optional\n- Creation date range, both days included\n- Format: DD-MM-YYYY\n\nactive_status:\n- Optional\n- Options: **active**, **deactivated**\n\nmin_balance:\n- Optional\n- Customers having a valid account with a balance above it\n\naccount_id:\n- Optional\n- Finds the customer owning the account\n\n**Header:**\n\nAuthorization:\n- Required\n- Format: Bearer token",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Customer"
+                ],
+                "summary": "Get Customer List",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "default": "Bearer",
+                        "description": "Bearer token",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "default": 1,
+                        "description": "Page number for pagination",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 50,
+                        "description": "Number of customers per page",
+                        "name": "pagesize",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Name search",
+                        "name": "name",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Created by username",
+                        "name": "created_by",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Created on or after (format: DD-MM-YYYY)",
+                        "name": "start_date",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Created on or before (format: DD-MM-YYYY)",
+                        "name": "end_date",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Active status (active/deactivated)",
+                        "name": "active_status",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Has an account with a balance above it",
+                        "name": "min_balance",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Owns the account",
+                        "name": "account_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "next_cursor of the previous page",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Count totalCount and totalPages (default: true without cursor, false with cursor)",
+                        "name": "include_total",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "default": "desc",
+                        "description": "Sort order (asc/desc)",
+                        "name": "order",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.ListCustomerResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "**Request Body:**\n\nName:\n- Required\n- Max 50 characters\n\n**Header:**\n\nAuthorization:\n- Required\n- Format: Bearer token",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Customer"
+                ],
+                "summary": "Create Customer",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "default": "Bearer",
+                        "description": "Bearer token",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "description": "Customer details",
+                        "name": "customer",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handlers.CreateCustomerRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.CreateCustomerResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/customer/{id}": {
+            "get": {
+                "description": "**Path Parameter:**\n\nid:\n- Required\n- CustomerID of the customer\n\n**Header:**\n\nAuthorization:\n- Required\n- Format: Bearer token",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Customer"
+                ],
+                "summary": "Get Customer",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "default": "Bearer",
+                        "description": "Bearer token",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "CustomerID of the customer",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.GetCustomerResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "description": "**Path Parameter:**\n\nid:\n- Required\n- CustomerID of the customer to update\n\n**Request Body:**\n\nEvery field is optional, fields left empty keep their value. Each change is recorded in a\n**customer_updated** event with the previous and new value of the changed fields.\n\nname:\n- Max 50 characters, unique among customers\n\ndate_of_birth:\n- Format: YYYY-MM-DD, in the past\n\nnational_id:\n- 5 to 20 letters or digits, spaces and dashes are removed\n- Unique among customers\n\naddress:\n- Max 200 characters\n\nphone:\n- International format, e.g. **+4915112345678**\n\nemail:\n- Email address without display name\n\nkyc_status:\n- Options: **pending**, **verified**, **rejected**\n- Verifying requires date_of_birth, national_id and address and sets kyc_verified_at\n- Changing the name, date_of_birth or national_id of a verified customer returns it to **pending**\n\n**Header:**\n\nAuthorization:\n- Required\n- Format: Bearer token",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Customer"
+                ],
+                "summary": "Update Customer",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "default": "Bearer",
+                        "description": "Bearer token",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "CustomerID of the customer",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Customer profile fields to change",
+                        "name": "customer",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handlers.UpdateCustomerRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.UpdateCustomerResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "**Header:**\n\nAuthorization:\n- Required\n- Format: Bearer token\n\n**Path Parameter:**\n\nid:\n- Required\n- CustomerID of the customer to delete",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Customer"
+                ],
+                "summary": "Delete Customer",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "default": "Bearer",
+                        "description": "Bearer token",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "CustomerID of the customer",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.DeleteCustomerResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/customer/{id}/account": {
+            "get": {
+                "description": "**Path Parameter:**\n\nid:\n- Required\n- CustomerID of the customer\n\n**Query Parameters:**\n\npage:\n- Optional\n- Page number for pagination\n- Default: 1\n\npagesize:\n- Optional\n- Number of accounts per page\n- Default: 50\n\n**Header:**\n\nAuthorization:\n- Required\n- Format: Bearer token",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Customer"
+                ],
+                "summary": "Get Account List of customer",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "default": "Bearer",
+                        "description": "Bearer token",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "CustomerID of the customer",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "default": 1,
+                        "description": "Page number for pagination",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 50,
+                        "description": "Number of accounts per page",
+                        "name": "pagesize",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Successfully retrieved account list",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.ListAccountResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/employee": {
+            "get": {
+                "description": "**Query Parameters:**\n\npage:\n- Optional\n- Page number for pagination\n- Default: 1\n\npagesize:\n- Optional\n- Number of employees per page\n- Default: 50\n\ncursor:\n- Optional\n- next_cursor of the previous page; the page continues after it and page is ignored\n- Unlike page, a cursor does not skip or repeat rows added while paging\n\ninclude_total:\n- Optional\n- Count totalCount and totalPages, which are 0 otherwise\n- Default: true without cursor, false with cursor\n\norder:\n- Optional\n- Sort order (asc/desc)\n- Default: desc\n\n**Header:**\n\nAuthorization:\n- Required\n- Format: Bearer token",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Employee"
+                ],
+                "summary": "Get Employee List",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "default": "Bearer",
+                        "description": "Bearer token",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "default": 1,
+                        "description": "Page number for pagination",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 50,
+                        "description": "Number of employee per page",
+                        "name": "pagesize",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "next_cursor of the previous page",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Count totalCount and totalPages (default: true without cursor, false with cursor)",
+                        "name": "include_total",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "default": "desc",
+                        "description": "Sort order (asc/desc)",
+                        "name": "order",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Successfully retrieved employee list",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.ListEmployeeResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "**Request Body:**\n\nUsername:\n- Required\n- Max 50 characters\n- Lowercase letters only\n- Underscores allowed only in middle\n\nPassword:\n- Required\n- Max 50 characters\n- Supports only A-Z, a-z, 0-9, and these special characters: ! - _ \u0026 $ @ # [ ]\n\nRole:\n- Required\n- Options: **admin**, **viewer**, **editor**\n\n**Header:**\n\nAuthorization:\n- Required\n- Format: Bearer token",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Employee"
+                ],
+                "summary": "Create Employee",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "default": "Bearer",
+                        "description": "Bearer token",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "description": "Employee creation data",
+                        "name": "employee",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handlers.CreateEmployeeRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Employee created successfully",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.CreateEmployeeResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid input data",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized - Missing or invalid token",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/employee/{username}": {
+            "delete": {
+                "description": "**Header:**\n\nAuthorization:\n- Required\n- Format: Bearer token\n\n**Path Parameter:**\n\nusername:\n- Required\n- Username of the employee to delete",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Employee"
+                ],
+                "summary": "Delete Employee",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "default": "Bearer",
+                        "description": "Bearer token",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Username of the employee",
+                        "name": "username",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.DeleteEmployeeResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/employee/{username}/mfa": {
+            "delete": {
+                "description": "Removes the authenticator app and the recovery codes of the employee, e.g. when both are lost. When MFA\nis required for the role of the employee, the next login requires a new enrollment.\n\n**Header:**\n\nAuthorization:\n- Required\n- Format: Bearer token\n\n**Path Parameter:**\n\nusername:\n- Required\n- Username of the employee",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Employee"
+                ],
+                "summary": "Reset Employee MFA",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "default": "Bearer",
+                        "description": "Bearer token",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Username of the employee",
+                        "name": "username",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.ResetMFAResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/exchange-rate": {
+            "get": {
+                "description": "**Header:**\n\nAuthorization:\n- Required\n- Format: Bearer token",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Exchange Rate"
+                ],
+                "summary": "List Exchange Rates",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "default": "Bearer",
+                        "description": "Bearer token",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.ListExchangeRatesResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "description": "**Request Body:**\n\nBase Currency:\n- Required\n- Options: **USD**, **EUR**, **BDT**\n\nQuote Currency:\n- Required\n- Options: **USD**, **EUR**, **BDT**\n- Must differ from base currency\n\nRate:\n- Required\n- Units of quote currency per one unit of base currency\n- Positive decimal string with at most 6 decimal places (e.g. \"109.50\")\n\n**Header:**\n\nAuthorization:\n- Required\n- Format: Bearer token",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Exchange Rate"
+                ],
+                "summary": "Set Exchange Rate",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "default": "Bearer",
+                        "description": "Bearer token",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "description": "Exchange rate details",
+                        "name": "exchange_rate",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handlers.SetExchangeRateRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.SetExchangeRateResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/fee-rule": {
+            "get": {
+                "description": "**Header:**\n\nAuthorization:\n- Required\n- Format: Bearer token",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Fee Rule"
+                ],
+                "summary": "List Fee Rules",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "default": "Bearer",
+                        "description": "Bearer token",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.ListFeeRulesResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "description": "**Request Body:**\n\nTransaction Type:\n- Required\n- Options: **transfer**, **withdraw_amount**\n\nAccount Type:\n- Optional\n- Options: **savings**, **current**; omitted matches every account type\n- A rule of the source account type wins over a rule for every account type\n\nMin Amount, Max Amount:\n- Optional\n- The inclusive amount band the rule applies to, in the source account currency\n- Omitted min amount starts the band at zero, omitted or zero max amount means no upper bound\n- A rule replaces the rule with the same transaction type, account type and min amount\n- When bands overlap the band with the highest min amount wins\n\nFee Type:\n- Required\n- Options: **flat**, **percentage**\n\nFlat Amount:\n- The fee of a **flat** rule\n\nPercentage:\n- The percent of the amount charged by a **percentage** rule, at most 4 decimal places\n\nMin Fee, Max Fee:\n- Optional\n- Bounds of the fee; omitted or zero max fee means no cap\n\nAmounts are decimal numbers or strings with at most 2 decimal places. The fee is debited from the\nsource account on top of the amount and credited to the bank income account in the same transaction.\nFull withdrawals, deposits, hold captures and reversals carry no fee, and a reversal does not refund the fee.\n\n**Header:**\n\nAuthorization:\n- Required\n- Format: Bearer token",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Fee Rule"
+                ],
+                "summary": "Set Fee Rule",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "default": "Bearer",
+                        "description": "Bearer token",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "description": "Fee rule details",
+                        "name": "fee_rule",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handlers.SetFeeRuleRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.SetFeeRuleResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/fee-rule/{id}": {
+            "delete": {
+                "description": "Debits the deleted rule matched are charged by the next matching rule, or carry no fee if none matches.\n\n**Header:**\n\nAuthorization:\n- Required\n- Format: Bearer token",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Fee Rule"
+                ],
+                "summary": "Delete Fee Rule",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "default": "Bearer",
+                        "description": "Bearer token",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "ID of the fee rule",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.DeleteFeeRuleResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/hold/{id}": {
+            "get": {
+                "description": "**Path Parameter:**\n\nid:\n- Required\n- Hold ID\n\nA hold is **active**, **captured**, **released** or **expired**.\n\n**Header:**\n\nAuthorization:\n- Required\n- Format: Bearer token",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Hold"
+                ],
+                "summary": "Get Hold",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "default": "Bearer",
+                        "description": "Bearer token",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Hold ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.HoldResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/hold/{id}/capture": {
+            "post": {
+                "description": "**Path Parameter:**\n\nid:\n- Required\n- Hold ID, the hold must be **active**\n\n**Request Body:**\n\nAmount:\n- Optional\n- Must be greater than zero and not exceed the held amount\n- Decimal number or string with at most 2 decimal places (e.g. \"80.00\")\n- Default: the held amount; the rest of a partial capture is released\n\nDestination Account ID:\n- Optional\n- The captured funds are transferred to this account\n- Default: the captured funds are withdrawn\n\nThe capture is a regular transaction with the reference **hold-capture:{hold id}:{id}**. If it fails, the hold stays active.\n\n**Header:**\n\nAuthorization:\n- Required\n- Format: Bearer token",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Hold"
+                ],
+                "summary": "Capture Hold",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "default": "Bearer",
+                        "description": "Bearer token",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Hold ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Capture details",
+                        "name": "capture",
+                        "in": "body",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.CaptureHoldRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.CaptureHoldResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/hold/{id}/release": {
+            "post": {
+                "description": "**Path Parameter:**\n\nid:\n- Required\n- Hold ID, the hold must be **active**\n\n**Header:**\n\nAuthorization:\n- Required\n- Format: Bearer token",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Hold"
+                ],
+                "summary": "Release Hold",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "default": "Bearer",
+                        "description": "Bearer token",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Hold ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.HoldResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/interest-rate": {
+            "get": {
+                "description": "**Header:**\n\nAuthorization:\n- Required\n- Format: Bearer token",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Interest"
+                ],
+                "summary": "List Interest Rates",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "default": "Bearer",
+                        "description": "Bearer token",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.ListInterestRatesResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "description": "**Request Body:**\n\nScope:\n- Required\n- Options: **account_type**, **account**\n- An **account** rate replaces the rate of the account type for that account\n\nSubject:\n- Required\n- **savings** or **current** for account_type, an account ID for account\n\nAnnual Rate:\n- Required\n- Percent between 0 and 100 with at most 4 decimal places (e.g. \"2.5\")\n- 0 stops the accrual\n\nInterest accrues daily on the end of day balance and is credited monthly as an **interest_credit** transaction.\nA new rate applies from the next accrued day.\n\n**Header:**\n\nAuthorization:\n- Required\n- Format: Bearer token\n- Admin only",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Interest"
+                ],
+                "summary": "Set Interest Rate",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "default": "Bearer",
+                        "description": "Bearer token",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "description": "Interest rate details",
+                        "name": "interest_rate",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handlers.SetInterestRateRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.SetInterestRateResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/standing-order": {
+            "get": {
+                "description": "**Query Parameters:**\n\naccount_id:\n- Required\n- Source account of the standing orders\n\n**Header:**\n\nAuthorization:\n- Required\n- Format: Bearer token",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Standing Order"
+                ],
+                "summary": "List Standing Orders",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "default": "Bearer",
+                        "description": "Bearer token",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Account ID",
+                        "name": "account_id",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.ListStandingOrdersResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "**Request Body:**\n\nSource Account ID / Destination Account ID:\n- Required\n- Must be different accounts\n\nAmount:\n- Required\n- Must be greater than zero\n- Decimal number or string with at most 2 decimal places (e.g. \"1200.00\")\n\nFrequency:\n- Required\n- Options: **once**, **daily**, **weekly**, **monthly**\n- Monthly runs keep the start day, falling back to the last day of shorter months\n\nStart At:\n- Optional\n- RFC 3339 time of the first run (e.g. \"2026-01-31T09:00:00Z\")\n- Must not be in the past\n- Default: now\n\nEnd At:\n- Optional\n- RFC 3339 time, no run is scheduled after it\n\nMax Runs:\n- Optional\n- Number of runs after which the order completes\n- Default: 0, no limit\n\nEach run initiates a transfer with the reference **standing-order:{id}:{run number}** on behalf of the creator.\n\n**Header:**\n\nAuthorization:\n- Required\n- Format: Bearer token",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Standing Order"
+                ],
+                "summary": "Create Standing Order",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "default": "Bearer",
+                        "description": "Bearer token",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "description": "Standing order details",
+                        "name": "standing_order",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handlers.CreateStandingOrderRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.StandingOrderResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/standing-order/{id}": {
+            "get": {
+                "description": "**Path Parameter:**\n\nid:\n- Required\n- Standing order ID\n\nRuns are listed newest first, each with the initiated transaction ID and whether it succeeded.\n\n**Header:**\n\nAuthorization:\n- Required\n- Format: Bearer token",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Standing Order"
+                ],
+                "summary": "Get Standing Order",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "default": "Bearer",
+                        "description": "Bearer token",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Standing order ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.GetStandingOrderResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/standing-order/{id}/cancel": {
+            "post": {
+                "description": "**Path Parameter:**\n\nid:\n- Required\n- Standing order ID, the order must be **active** or **paused**\n\n**Header:**\n\nAuthorization:\n- Required\n- Format: Bearer token",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Standing Order"
+                ],
+                "summary": "Cancel Standing Order",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "default": "Bearer",
+                        "description": "Bearer token",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Standing order ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.StandingOrderResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/standing-order/{id}/pause": {
+            "post": {
+                "description": "**Path Parameter:**\n\nid:\n- Required\n- Standing order ID, the order must be **active**\n\n**Header:**\n\nAuthorization:\n- Required\n- Format: Bearer token",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Standing Order"
+                ],
+                "summary": "Pause Standing Order",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "default": "Bearer",
+                        "description": "Bearer token",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Standing order ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.StandingOrderResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/standing-order/{id}/resume": {
+            "post": {
+                "description": "**Path Parameter:**\n\nid:\n- Required\n- Standing order ID, the order must be **paused**\n\nRecurring runs that fell due while the order was paused are skipped, the order continues with its next occurrence.\n\n**Header:**\n\nAuthorization:\n- Required\n- Format: Bearer token",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Standing Order"
+                ],
+                "summary": "Resume Standing Order",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "default": "Bearer",
+                        "description": "Bearer token",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Standing order ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.StandingOrderResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/statement/{id}": {
+            "get": {
+                "description": "**Path Parameter:**\n\nid:\n- Required\n- Statement ID\n\n**Query Parameters:**\n\nformat:\n- Optional\n- Options: **json**, **csv**, **pdf**\n- Default: json\n\n**Header:**\n\nAuthorization:\n- Required\n- Format: Bearer token",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json",
+                    "text/csv",
+                    "application/pdf"
+                ],
+                "tags": [
+                    "Statement"
+                ],
+                "summary": "Get Statement",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "default": "Bearer",
+                        "description": "Bearer token",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Statement ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "default": "json",
+                        "description": "json, csv or pdf",
+                        "name": "format",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.StatementResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/transaction": {
+            "get": {
+                "description": "**Query Parameters:**\n\naccount_id:\n- Optional\n- Filter by account ID\n\ncustomer_id:\n- Optional\n- Filter by customer ID\n\ntypes:\n- Optional\n- Filter by transaction types\n- Comma separated values: **transfer**, **withdraw_full**, **withdraw_amount**, **add_amount**, **interest_credit**\n\nstart_date:\n- Optional\n- Start date for filtering\n- Format: DD-MM-YYYY\n\nend_date:\n- Optional\n- End date for filtering\n- Format: DD-MM-YYYY\n\npage:\n- Optional\n- Page number for pagination\n- Default: 1\n\npagesize:\n- Optional\n- Number of transactions per page\n- Default: 50\n\ncursor:\n- Optional\n- next_cursor of the previous page; the page continues after it and page is ignored\n- Unlike page, a cursor does not skip or repeat rows added while paging\n\ninclude_total:\n- Optional\n- Count totalCount and totalPages, which are 0 otherwise\n- Default: true without cursor, false with cursor\n\norder:\n- Optional\n- Sort order (asc/desc)\n- Default: desc\n\n**Header:**\n\nAuthorization:\n- Required\n- Format: Bearer token",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Transaction"
+                ],
+                "summary": "Get Transaction History",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "default": "Bearer",
+                        "description": "Bearer token",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Transaction history by account id",
+                        "name": "account_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Transaction history by customer id",
+                        "name": "customer_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Comma separated transaction types (transfer/withdraw_full/withdraw_amount/add_amount/interest_credit)",
+                        "name": "types",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Start date for filtering (format: DD-MM-YYYY)",
+                        "name": "start_date",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "End date for filtering (format: DD-MM-YYYY)",
+                        "name": "end_date",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 1,
+                        "description": "Page number for pagination",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 50,
+                        "description": "Number of transactions per page",
+                        "name": "pagesize",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "next_cursor of the previous page",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Count totalCount and totalPages (default: true without cursor, false with cursor)",
+                        "name": "include_total",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "default": "desc",
+                        "description": "Sort order (asc/desc)",
+                        "name": "order",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.ListTransactionResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/transaction-batch": {
+            "post": {
+                "description": "**Request Body:**\n\nSource Account ID:\n- Required\n- Account debited by every line\n\nMode:\n- Optional\n- Options: **all_or_nothing**, **best_effort**\n- all_or_nothing: a failed line stops the batch, lines not yet run are skipped and successful lines are reversed\n- best_effort: every line succeeds or fails on its own\n- Default: best_effort\n\nReference ID:\n- Required\n- Resubmitting a reference returns the existing batch\n\nLines:\n- Required\n- Each line needs a destination account ID and an amount greater than zero with at most 2 decimal places\n- Reference ID of a line is optional and returned in the results\n\nEvery line is validated before the batch is accepted, an invalid line rejects the whole batch.\nThe batch is processed in the background, poll **/api/v1/transaction-batch/{id}** for the outcome.\n\n**Header:**\n\nAuthorization:\n- Required\n- Format: Bearer token",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Transaction Batch"
+                ],
+                "summary": "Create Transaction Batch",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "default": "Bearer",
+                        "description": "Bearer token",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "description": "Transaction batch",
+                        "name": "batch",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handlers.CreateTransactionBatchRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "202": {
+                        "description": "Accepted",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.TransactionBatchResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/transaction-batch/upload": {
+            "post": {
+                "description": "**Form Data:**\n\nFile:\n- Required\n- CSV with a header row and the columns **destination_account_id**, **amount** and optionally **reference_id**, in any order\n- Amount is a decimal with at most 2 decimal places (e.g. \"1200.00\")\n\nSource Account ID, Mode and Reference ID:\n- As for **/api/v1/transaction-batch**\n\nLine numbers in errors and results count the rows after the header.\n\n**Header:**\n\nAuthorization:\n- Required\n- Format: Bearer token",
+                "consumes": [
+                    "multipart/form-data"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Transaction Batch"
+                ],
+                "summary": "Upload Transaction Batch",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "default": "Bearer",
+                        "description": "Bearer token",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "file",
+                        "description": "CSV file of transfers",
+                        "name": "file",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Source account ID",
+                        "name": "source_account_id",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "all_or_nothing or best_effort",
+                        "name": "mode",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Batch reference ID",
+                        "name": "reference_id",
+                        "in": "formData",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "202": {
+                        "description": "Accepted",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.TransactionBatchResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/transaction-batch/{id}": {
+            "get": {
+                "description": "**Path Parameter:**\n\nid:\n- Required\n- Transaction batch ID\n\nBatch status is **pending** or **processing** until every line has run, then **completed**, **partially_completed** or **failed**.\nLine status is **pending**, **successful**, **failed**, **skipped** or **reversed**.\n\n**Header:**\n\nAuthorization:\n- Required\n- Format: Bearer token",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Transaction Batch"
+                ],
+                "summary": "Get Transaction Batch",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "default": "Bearer",
+                        "description": "Bearer token",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Transaction batch ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.TransactionBatchResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/transaction-batch/{id}/results": {
+            "get": {
+                "description": "**Path Parameter:**\n\nid:\n- Required\n- Transaction batch ID\n\nThe CSV has a header row and one row per line in file order with the columns\n**line_number**, **destination_account_id**, **amount**, **reference_id**, **status**, **transaction_id**, **reversal_transaction_id** and **error_reason**.\n\n**Header:**\n\nAuthorization:\n- Required\n- Format: Bearer token",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "text/csv"
+                ],
+                "tags": [
+                    "Transaction Batch"
+                ],
+                "summary": "Download Transaction Batch Results",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "default": "Bearer",
+                        "description": "Bearer token",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Transaction batch ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "CSV file",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/transaction-limit": {
+            "get": {
+                "description": "**Header:**\n\nAuthorization:\n- Required\n- Format: Bearer token",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Transaction Limit"
+                ],
+                "summary": "List Transaction Limits",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "default": "Bearer",
+                        "description": "Bearer token",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.ListTransactionLimitsResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "description": "**Request Body:**\n\nScope:\n- Required\n- Options: **account_type**, **account**, **role**\n- An **account** limit replaces the limit of the account type for that account\n- A **role** limit applies to the transactions each employee of the role initiates\n\nSubject:\n- Required\n- **savings** or **current** for account_type, an account ID for account, **admin**, **editor** or **viewer** for role\n\nPer Transaction Amount, Daily Amount, Monthly Amount:\n- Optional\n- Decimal number or string with at most 2 decimal places, in the source account currency\n- Omitted or zero means no limit\n- Daily and monthly usage counts the debits in the same currency, including their fees\n\nDaily Count, Monthly Count:\n- Optional\n- Maximum number of debits; zero means no limit\n\nDays and months start at midnight server time. Deposits and reversals are not limited.\n\n**Header:**\n\nAuthorization:\n- Required\n- Format: Bearer token",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Transaction Limit"
+                ],
+                "summary": "Set Transaction Limit",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "default": "Bearer",
+                        "description": "Bearer token",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "description": "Transaction limit details",
+                        "name": "transaction_limit",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handlers.SetTransactionLimitRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.SetTransactionLimitResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/transaction/export": {
+            "get": {
+                "description": "**Query Parameters:**\n\nformat:\n- Optional\n- Options: **csv**, **ndjson**\n- Default: csv\n\naccount_id, customer_id, types, start_date, end_date, order:\n- Optional\n- Same filters as Get Transaction History, without pagination\n\nThe body is streamed while the transaction service reads the history, so its size is not limited.\nThe CSV has a header row and the columns\n**id**, **type**, **transaction_status**, **source_account_id**, **destination_account_id**, **amount**, **source_currency**,\n**destination_amount**, **destination_currency**, **exchange_rate**, **fee_amount**, **fee_rule_id**, **reference**,\n**reversal_of_transaction_id**, **reversed_by_transaction_id**, **reversed_amount**, **hold_id**, **interest_posting_id**,\n**error_reason**, **created_by**, **created_at** and **updated_at**, with RFC 3339 UTC times. NDJSON has one object per line\nwith the columns as keys in the same order and the same string values, empty when not set.\n\nA failure after the body started cannot change the status code, so the response ends with the trailers\n**X-Export-Status** (**completed** or **failed**) and **X-Export-Count** (the number of transactions written).\n\n**Header:**\n\nAuthorization:\n- Required\n- Format: Bearer token",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "text/csv",
+                    "application/x-ndjson"
+                ],
+                "tags": [
+                    "Transaction"
+                ],
+                "summary": "Export Transaction History",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "default": "Bearer",
+                        "description": "Bearer token",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "default": "csv",
+                        "description": "Export format (csv/ndjson)",
+                        "name": "format",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Transaction history by account id",
+                        "name": "account_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Transaction history by customer id",
+                        "name": "customer_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Comma separated transaction types (transfer/withdraw_full/withdraw_amount/add_amount/interest_credit)",
+                        "name": "types",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Start date for filtering (format: DD-MM-YYYY)",
+                        "name": "start_date",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "End date for filtering (format: DD-MM-YYYY)",
+                        "name": "end_date",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "default": "desc",
+                        "description": "Sort order (asc/desc)",
+                        "name": "order",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "CSV or NDJSON file",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/transaction/init": {
+            "post": {
+                "description": "**Request Body:**\n\nTransaction Type:\n- Required\n- Options: **transfer**, **withdraw_full**, **withdraw_amount**, **add_amount**\n\nAmount:\n- Required for all types except **withdraw_full**\n- Must be greater than zero\n- Decimal number or string with at most 2 decimal places (e.g. \"100.50\")\n\nDestination Account ID:\n- Required only for **transfer** type\n\nReference:\n- Required for all transactions\n- Unique per requester; retrying with the same reference and payload returns the original transaction\n- Reusing a reference with a different payload is rejected\n\nAsync:\n- Optional\n- When true the transaction is queued and **202** is returned with the pending transaction ID\n- The status moves from pending to processing once a worker picks the transaction up\n- Poll **GET /api/v1/transaction/{id}** for the final status\n- Default: false\n\nLimits:\n- Debits are checked against the per transaction, daily and monthly limits of the source account and of the requester's role\n- A breach returns **422** with the limit that was exceeded\n\nFees:\n- **transfer** and **withdraw_amount** debits are charged the fee of the matching fee rule on top of the amount\n- The source account must cover the amount and the fee; the fee is shown in **fee_amount** of the transaction\n\n**Header:**\n\nAuthorization:\n- Required\n- Format: Bearer token",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Transaction"
+                ],
+                "summary": "Create new transaction",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "default": "Bearer",
+                        "description": "Bearer token",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "description": "Transaction details",
+                        "name": "transaction",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handlers.InitTransactionRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.InitTransactionResponse"
+                        }
+                    },
+                    "202": {
+                        "description": "Accepted",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.InitTransactionResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.ErrorResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable Entity",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/transaction/{id}": {
+            "get": {
+                "description": "**Path Parameter:**\n\nid:\n- Required\n- Transaction ID returned when the transaction was initiated\n\nEmployees read the transactions they initiated, admins any transaction; others get **404**.\n\n**Header:**\n\nAuthorization:\n- Required\n- Format: Bearer token",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Transaction"
+                ],
+                "summary": "Get Transaction",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "default": "Bearer",
+                        "description": "Bearer token",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Transaction ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.GetTransactionResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/transaction/{id}/reverse": {
+            "post": {
+                "description": "**Path Parameter:**\n\nid:\n- Required\n- ID of a **completed** transaction that has not been fully reversed\n\n**Request Body:**\n\nAmount:\n- Optional, the rest not yet reversed is reversed if omitted\n- Must not exceed the amount the original transaction moved less the amount already reversed\n- In the currency of the account the reversal debits, for a transfer that is the destination account\n- Decimal number or string with at most 2 decimal places (e.g. \"40.00\")\n\nReason:\n- Required\n\nA transfer is sent back from its destination, a withdrawal is added back and an addition is withdrawn again.\nA cross-currency transfer is converted back at the rate of the original, so its source is credited the share of the amount it was debited; a full reversal returns exactly that amount.\nThe reversal is rejected if the debited account no longer holds the amount.\nA transaction can be reversed partially several times until its reversals have moved back the full amount; after that it is already reversed.\nThe original transaction shows its latest reversal in **reversed_by_transaction_id** and the amount moved back in **reversed_amount**; a failed reversal does not count.\nA fee charged on the original transaction is refunded from the income account, a partial reversal refunds the share of the fee its amount has in the original, rounded down; the reversal of the rest refunds the rest of the fee.\nThe refunded fee is shown in **fee_amount** of the reversal.\n\n**Header:**\n\nAuthorization:\n- Required\n- Format: Bearer token",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Transaction"
+                ],
+                "summary": "Reverse Transaction",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "default": "Bearer",
+                        "description": "Bearer token",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Transaction ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Reversal details",
+                        "name": "reversal",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handlers.ReverseTransactionRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.ReverseTransactionResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        }
+    },
+    "definitions": {
+        "handlers.AccountStatusResponse": {
+            "type": "object",
+            "properties": {
+                "account": {},
+                "message": {
+                    "type": "string"
+                }
+            }
+        },
+        "handlers.CaptureHoldRequest": {
+            "type": "object",
+            "properties": {
+                "amount": {
+                    "description": "decimal, full capture if empty",
+                    "type": "string"
+                },
+                "destination_account_id": {
+                    "description": "the captured funds are withdrawn if empty",
+                    "type": "string"
+                }
+            }
+        },
+        "handlers.CaptureHoldResponse": {
+            "type": "object",
+            "properties": {
+                "message": {
+                    "type": "string"
+                },
+                "transaction": {}
+            }
+        },
+        "handlers.CloseAccountRequest": {
+            "type": "object",
+            "required": [
+                "reason"
+            ],
+            "properties": {
+                "payout_account_id": {
+                    "description": "receives the balance, optional when the balance is zero",
+                    "type": "string"
+                },
+                "reason": {
+                    "type": "string"
+                }
+            }
+        },
+        "handlers.CloseAccountResponse": {
+            "type": "object",
+            "properties": {
+                "message": {
+                    "type": "string"
+                },
+                "transaction": {
+                    "description": "the payout, null when there was no balance to pay out"
+                }
+            }
+        },
+        "handlers.ConfirmMFARequest": {
+            "type": "object",
+            "required": [
+                "code"
+            ],
+            "properties": {
+                "code": {
+                    "type": "string"
+                }
+            }
+        },
+        "handlers.ConfirmMFAResponse": {
+            "type": "object",
+            "properties": {
+                "message": {
+                    "type": "string"
+                },
+                "recovery_codes": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "handlers.CreateAccountRequest": {
+            "type": "object",
+            "required": [
+                "customer_id",
+                "deposit_amount"
+            ],
+            "properties": {
+                "account_type": {
+                    "description": "savings or current",
+                    "type": "string"
+                },
+                "currency": {
+                    "description": "ISO 4217 code, e.g. \"USD\"",
+                    "type": "string"
+                },
+                "customer_id": {
+                    "type": "string"
+                },
+                "deposit_amount": {
+                    "description": "decimal, e.g. \"100.50\"",
+                    "type": "string"
+                }
+            }
+        },
+        "handlers.CreateAccountResponse": {
+            "type": "object",
+            "required": [
+                "account_id"
+            ],
+            "properties": {
+                "account_id": {
+                    "type": "string"
+                },
+                "message": {
+                    "type": "string"
+                }
+            }
+        },
+        "handlers.CreateCustomerRequest": {
+            "type": "object",
+            "required": [
+                "name"
+            ],
+            "properties": {
+                "name": {
+                    "type": "string",
+                    "maxLength": 50
+                }
+            }
+        },
+        "handlers.CreateCustomerResponse": {
+            "type": "object",
+            "required": [
+                "customer_id"
+            ],
+            "properties": {
+                "customer_id": {
+                    "type": "string"
+                },
+                "message": {
+                    "type": "string"
+                }
+            }
+        },
+        "handlers.CreateEmployeeRequest": {
+            "type": "object",
+            "required": [
+                "password",
+                "role",
+                "username"
+            ],
+            "properties": {
+                "password": {
+                    "type": "string",
+                    "maxLength": 50
+                },
+                "role": {
+                    "type": "string",
+                    "enum": [
+                        "admin",
+                        "viewer",
+                        "editor"
+                    ]
+                },
+                "username": {
+                    "type": "string",
+                    "maxLength": 50
+                }
+            }
+        },
+        "handlers.CreateEmployeeResponse": {
+            "type": "object",
+            "required": [
+                "username"
+            ],
+            "properties": {
+                "message": {
+                    "type": "string"
+                },
+                "username": {
+                    "type": "string"
+                }
+            }
+        },
+        "handlers.CreateStandingOrderRequest": {
+            "type": "object",
+            "required": [
+                "amount",
+                "destination_account_id",
+                "frequency",
+                "source_account_id"
+            ],
+            "properties": {
+                "amount": {
+                    "description": "decimal, e.g. \"1200.00\"",
+                    "type": "string"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "destination_account_id": {
+                    "type": "string"
+                },
+                "end_at": {
+                    "description": "RFC 3339",
+                    "type": "string"
+                },
+                "frequency": {
+                    "type": "string"
+                },
+                "max_runs": {
+                    "type": "integer"
+                },
+                "source_account_id": {
+                    "type": "string"
+                },
+                "start_at": {
+                    "description": "RFC 3339, defaults to now",
+                    "type": "string"
+                }
+            }
+        },
+        "handlers.CreateTransactionBatchRequest": {
+            "type": "object",
+            "required": [
+                "lines",
+                "reference_id",
+                "source_account_id"
+            ],
+            "properties": {
+                "lines": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/handlers.TransactionBatchLineReq"
+                    }
+                },
+                "mode": {
+                    "description": "all_or_nothing or best_effort",
+                    "type": "string"
+                },
+                "reference_id": {
+                    "type": "string"
+                },
+                "source_account_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "handlers.DeleteAccountResponse": {
+            "type": "object",
+            "properties": {
+                "message": {
+                    "type": "string"
+                }
+            }
+        },
+        "handlers.DeleteCustomerResponse": {
+            "type": "object",
+            "properties": {
+                "message": {
+                    "type": "string"
+                }
+            }
+        },
+        "handlers.DeleteEmployeeResponse": {
+            "type": "object",
+            "properties": {
+                "message": {
+                    "type": "string"
+                }
+            }
+        },
+        "handlers.DeleteFeeRuleResponse": {
+            "type": "object",
+            "properties": {
+                "message": {
+                    "type": "string"
+                }
+            }
+        },
+        "handlers.EnrollMFAChallengeRequest": {
+            "type": "object",
+            "required": [
+                "challenge_token"
+            ],
+            "properties": {
+                "challenge_token": {
+                    "type": "string"
+                }
+            }
+        },
+        "handlers.EnrollMFAResponse": {
+            "type": "object",
+            "properties": {
+                "message": {
+                    "type": "string"
+                },
+                "provisioning_uri": {
+                    "type": "string"
+                },
+                "secret": {
+                    "type": "string"
+                }
+            }
+        },
+        "handlers.ErrorResponse": {
+            "type": "object",
+            "properties": {
+                "error": {
+                    "type": "string"
+                }
+            }
+        },
+        "handlers.FreezeAccountRequest": {
+            "type": "object",
+            "required": [
+                "reason"
+            ],
+            "properties": {
+                "freeze_mode": {
+                    "description": "debit, credit or both, defaults to both",
+                    "type": "string"
+                },
+                "reason": {
+                    "type": "string"
+                }
+            }
+        },
+        "handlers.GetAccountInterestResponse": {
+            "type": "object",
+            "properties": {
+                "accrued_interest": {
+                    "description": "accrued and not yet closed into a posting",
+                    "type": "string"
+                },
+                "annual_rate": {
+                    "description": "rate the account accrues at, in percent",
+                    "type": "string"
+                },
+                "message": {
+                    "type": "string"
+                },
+                "page": {
+                    "type": "integer"
+                },
+                "pageSize": {
+                    "type": "integer"
+                },
+                "postings": {},
+                "totalCount": {
+                    "type": "integer"
+                },
+                "totalPages": {
+                    "type": "integer"
+                }
+            }
+        },
+        "handlers.GetAccountJournalResponse": {
+            "type": "object",
+            "properties": {
+                "entries": {},
+                "message": {
+                    "type": "string"
+                },
+                "page": {
+                    "type": "integer"
+                },
+                "pageSize": {
+                    "type": "integer"
+                },
+                "totalCount": {
+                    "type": "integer"
+                },
+                "totalPages": {
+                    "type": "integer"
+                }
+            }
+        },
+        "handlers.GetBalanceResponse": {
+            "type": "object",
+            "properties": {
+                "available_balance": {
+                    "description": "ledger balance + overdraft limit - active holds",
+                    "type": "string"
+                },
+                "balance": {
+                    "description": "same as ledger_balance",
+                    "type": "string"
+                },
+                "ledger_balance": {
+                    "description": "the booked balance",
+                    "type": "string"
+                },
+                "message": {
+                    "type": "string"
+                }
+            }
+        },
+        "handlers.GetCustomerResponse": {
+            "type": "object",
+            "properties": {
+                "customer": {},
+                "message": {
+                    "type": "string"
+                }
+            }
+        },
+        "handlers.GetStandingOrderResponse": {
+            "type": "object",
+            "properties": {
+                "message": {
+                    "type": "string"
+                },
+                "runs": {},
+                "standing_order": {}
+            }
+        },
+        "handlers.GetTransactionResponse": {
+            "type": "object",
+            "properties": {
+                "message": {
+                    "type": "string"
+                },
+                "transaction": {}
+            }
+        },
+        "handlers.HoldResponse": {
+            "type": "object",
+            "properties": {
+                "hold": {},
+                "message": {
+                    "type": "string"
+                }
+            }
+        },
+        "handlers.InitTransactionRequest": {
+            "type": "object",
+            "required": [
+                "reference",
+                "source_account_id",
+                "transaction_type"
+            ],
+            "properties": {
+                "amount": {
+                    "description": "decimal, e.g. \"100.50\"",
+                    "type": "string"
+                },
+                "async": {
+                    "description": "return 202 as soon as the transaction is queued",
+                    "type": "boolean"
+                },
+                "destination_account_id": {
+                    "type": "string"
+                },
+                "reference": {
+                    "type": "string"
+                },
+                "source_account_id": {
+                    "type": "string"
+                },
+                "transaction_type": {
+                    "type": "string"
+                }
+            }
+        },
+        "handlers.InitTransactionResponse": {
+            "type": "object",
+            "properties": {
+                "message": {
+                    "type": "string"
+                },
+                "transaction_id": {
+                    "type": "string"
+                },
+                "transaction_status": {
+                    "type": "string"
+                }
+            }
+        },
+        "handlers.ListAccountResponse": {
+            "type": "object",
+            "properties": {
+                "accounts": {},
+                "message": {
+                    "type": "string"
+                },
+                "next_cursor": {
+                    "description": "empty on the last page",
+                    "type": "string"
+                },
+                "page": {
+                    "type": "integer"
+                },
+                "pageSize": {
+                    "type": "integer"
+                },
+                "totalCount": {
+                    "type": "integer"
+                },
+                "totalPages": {
+                    "type": "integer"
+                }
+            }
+        },
+        "handlers.ListAccountStatementsResponse": {
+            "type": "object",
+            "properties": {
+                "message": {
+                    "type": "string"
+                },
+                "page": {
+                    "type": "integer"
+                },
+                "pageSize": {
+                    "type": "integer"
+                },
+                "statements": {},
+                "totalCount": {
+                    "type": "integer"
+                },
+                "totalPages": {
+                    "type": "integer"
+                }
+            }
+        },
+        "handlers.ListCustomerResponse": {
+            "type": "object",
+            "properties": {
+                "customers": {},
+                "message": {
+                    "type": "string"
+                },
+                "next_cursor": {
+                    "description": "empty on the last page",
+                    "type": "string"
+                },
+                "page": {
+                    "type": "integer"
+                },
+                "pageSize": {
+                    "type": "integer"
+                },
+                "totalCount": {
+                    "type": "integer"
+                },
+                "totalPages": {
+                    "type": "integer"
+                }
+            }
+        },
+        "handlers.ListEmployeeResponse": {
+            "type": "object",
+            "properties": {
+                "employees": {},
+                "message": {
+                    "type": "string"
+                },
+                "next_cursor": {
+                    "description": "empty on the last page",
+                    "type": "string"
+                },
+                "page": {
+                    "type": "integer"
+                },
+                "pageSize": {
+                    "type": "integer"
+                },
+                "totalCount": {
+                    "type": "integer"
+                },
+                "totalPages": {
+                    "type": "integer"
+                }
+            }
+        },
+        "handlers.ListExchangeRatesResponse": {
+            "type": "object",
+            "properties": {
+                "exchange_rates": {},
+                "message": {
+                    "type": "string"
+                }
+            }
+        },
+        "handlers.ListFeeRulesResponse": {
+            "type": "object",
+            "properties": {
+                "message": {
+                    "type": "string"
+                },
+                "rules": {}
+            }
+        },
+        "handlers.ListHoldsResponse": {
+            "type": "object",
+            "properties": {
+                "holds": {},
+                "message": {
+                    "type": "string"
+                },
+                "page": {
+                    "type": "integer"
+                },
+                "pageSize": {
+                    "type": "integer"
+                },
+                "totalCount": {
+                    "type": "integer"
+                },
+                "totalPages": {
+                    "type": "integer"
+                }
+            }
+        },
+        "handlers.ListInterestRatesResponse": {
+            "type": "object",
+            "properties": {
+                "interest_rates": {},
+                "message": {
+                    "type": "string"
+                }
+            }
+        },
+        "handlers.ListMFAPoliciesResponse": {
+            "type": "object",
+            "properties": {
+                "message": {
+                    "type": "string"
+                },
+                "policies": {}
+            }
+        },
+        "handlers.ListStandingOrdersResponse": {
+            "type": "object",
+            "properties": {
+                "message": {
+                    "type": "string"
+                },
+                "standing_orders": {}
+            }
+        },
+        "handlers.ListTransactionLimitsResponse": {
+            "type": "object",
+            "properties": {
+                "limits": {},
+                "message": {
+                    "type": "string"
+                }
+            }
+        },
+        "handlers.ListTransactionResponse": {
+            "type": "object",
+            "properties": {
+                "message": {
+                    "type": "string"
+                },
+                "next_cursor": {
+                    "description": "empty on the last page",
+                    "type": "string"
+                },
+                "page": {
+                    "type": "integer"
+                },
+                "pageSize": {
+                    "type": "integer"
+                },
+                "totalCount": {
+                    "type": "integer"
+                },
+                "totalPages": {
+                    "type": "integer"
+                },
+                "transactions": {}
+            }
+        },
+        "handlers.LoginRequest": {
+            "type": "object",
+            "required": [
+                "password",
+                "username"
+            ],
+            "properties": {
+                "password": {
+                    "type": "string"
+                },
+                "username": {
+                    "type": "string"
+                }
+            }
+        },
+        "handlers.LoginResponse": {
+            "type": "object",
+            "properties": {
+                "access_token": {
+                    "type": "string"
+                },
+                "mfa_challenge_token": {
+                    "description": "valid for a few minutes",
+                    "type": "string"
+                },
+                "mfa_enrollment_required": {
+                    "description": "enroll with /auth/mfa/challenge/enroll first",
+                    "type": "boolean"
+                },
+                "mfa_required": {
+                    "description": "tokens are issued by /auth/mfa/verify",
+                    "type": "boolean"
+                },
+                "refresh_token": {
+                    "type": "string"
+                }
+            }
+        },
+        "handlers.LogoutRequest": {
+            "type": "object",
+            "properties": {
+                "refresh_token": {
+                    "description": "optional, revoked as well",
+                    "type": "string"
+                }
+            }
+        },
+        "handlers.LogoutResponse": {
+            "type": "object",
+            "properties": {
+                "message": {
+                    "type": "string"
+                }
+            }
+        },
+        "handlers.PlaceHoldRequest": {
             "type": "object",
             "required": [
-                "customer_id",
-                "deposit_amount"
+                "amount"
             ],
             "properties": {
-                "customer_id": {
+                "amount": {
+                    "description": "decimal, e.g. \"120.00\"",
                     "type": "string"
                 },
-                "deposit_amount": {
-                    "type": "number"
+                "expires_at": {
+                    "description": "RFC 3339, defaults to the configured hold expiry",
+                    "type": "string"
+                },
+                "reason": {
+                    "type": "string"
                 }
             }
         },
-        "handlers.CreateAccountResponse": {
+        "handlers.RecomputeAccountBalanceResponse": {
             "type": "object",
-            "required": [
-                "account_id"
-            ],
             "properties": {
                 "account_id": {
                     "type": "string"
                 },
+                "balance": {
+                    "type": "string"
+                },
+                "consistent": {
+                    "type": "boolean"
+                },
+                "currency": {
+                    "type": "string"
+                },
+                "ledger_balance": {
+                    "type": "string"
+                },
                 "message": {
                     "type": "string"
                 }
             }
         },
-        "handlers.CreateCustomerRequest": {
+        "handlers.RefreshRequest": {
             "type": "object",
             "required": [
-                "name"
+                "refresh_token"
             ],
             "properties": {
-                "name": {
-                    "type": "string",
-                    "maxLength": 50
+                "refresh_token": {
+                    "type": "string"
                 }
             }
         },
-        "handlers.CreateCustomerResponse": {
+        "handlers.ResetMFAResponse": {
+            "type": "object",
+            "properties": {
+                "message": {
+                    "type": "string"
+                }
+            }
+        },
+        "handlers.ReverseTransactionRequest": {
             "type": "object",
             "required": [
-                "customer_id"
+                "reason"
             ],
             "properties": {
-                "customer_id": {
+                "amount": {
+                    "description": "decimal, e.g. \"40.00\"; the rest not yet reversed if omitted",
                     "type": "string"
                 },
-                "message": {
+                "reason": {
                     "type": "string"
                 }
             }
         },
-        "handlers.CreateEmployeeRequest": {
+        "handlers.ReverseTransactionResponse": {
+            "type": "object",
+            "properties": {
+                "message": {
+                    "type": "string"
+                },
+                "transaction": {}
+            }
+        },
+        "handlers.SetExchangeRateRequest": {
             "type": "object",
             "required": [
-                "password",
-                "role",
-                "username"
+                "base_currency",
+                "quote_currency",
+                "rate"
             ],
             "properties": {
-                "password": {
-                    "type": "string",
-                    "maxLength": 50
+                "base_currency": {
+                    "type": "string"
                 },
-                "role": {
-                    "type": "string",
-                    "enum": [
-                        "admin",
-                        "viewer",
-                        "editor"
-                    ]
+                "quote_currency": {
+                    "type": "string"
                 },
-                "username": {
-                    "type": "string",
-                    "maxLength": 50
+                "rate": {
+                    "description": "decimal, e.g. \"109.50\"",
+                    "type": "string"
                 }
             }
         },
-        "handlers.CreateEmployeeResponse": {
+        "handlers.SetExchangeRateResponse": {
+            "type": "object",
+            "properties": {
+                "exchange_rate": {},
+                "message": {
+                    "type": "string"
+                }
+            }
+        },
+        "handlers.SetFeeRuleRequest": {
             "type": "object",
             "required": [
-                "username"
+                "fee_type",
+                "transaction_type"
             ],
             "properties": {
-                "message": {
+                "account_type": {
                     "type": "string"
                 },
-                "username": {
+                "fee_type": {
+                    "type": "string"
+                },
+                "flat_amount": {
+                    "type": "string"
+                },
+                "max_amount": {
+                    "type": "string"
+                },
+                "max_fee": {
+                    "type": "string"
+                },
+                "min_amount": {
+                    "description": "decimal, e.g. \"1000.00\"",
+                    "type": "string"
+                },
+                "min_fee": {
+                    "type": "string"
+                },
+                "percentage": {
+                    "description": "percent, e.g. \"1.25\"",
+                    "type": "string"
+                },
+                "transaction_type": {
                     "type": "string"
                 }
             }
         },
-        "handlers.DeleteAccountResponse": {
+        "handlers.SetFeeRuleResponse": {
             "type": "object",
             "properties": {
                 "message": {
                     "type": "string"
+                },
+                "rule": {}
+            }
+        },
+        "handlers.SetInterestRateRequest": {
+            "type": "object",
+            "required": [
+                "annual_rate",
+                "scope",
+                "subject"
+            ],
+            "properties": {
+                "annual_rate": {
+                    "description": "percent, e.g. \"2.5\"",
+                    "type": "string"
+                },
+                "scope": {
+                    "type": "string"
+                },
+                "subject": {
+                    "type": "string"
                 }
             }
         },
-        "handlers.DeleteCustomerResponse": {
+        "handlers.SetInterestRateResponse": {
             "type": "object",
             "properties": {
+                "interest_rate": {},
                 "message": {
                     "type": "string"
                 }
             }
         },
-        "handlers.DeleteEmployeeResponse": {
+        "handlers.SetMFAPolicyRequest": {
+            "type": "object",
+            "required": [
+                "required"
+            ],
+            "properties": {
+                "required": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "handlers.SetMFAPolicyResponse": {
             "type": "object",
             "properties": {
                 "message": {
@@ -984,168 +4713,193 @@ const docTemplate = `{
                 }
             }
         },
-        "handlers.ErrorResponse": {
+        "handlers.SetOverdraftLimitRequest": {
             "type": "object",
+            "required": [
+                "overdraft_limit"
+            ],
             "properties": {
-                "error": {
+                "overdraft_limit": {
+                    "description": "decimal, e.g. \"500.00\"",
                     "type": "string"
                 }
             }
         },
-        "handlers.GetBalanceResponse": {
+        "handlers.SetOverdraftLimitResponse": {
             "type": "object",
             "properties": {
-                "balance": {
-                    "type": "number"
-                },
+                "account": {},
                 "message": {
                     "type": "string"
                 }
             }
         },
-        "handlers.InitTransactionRequest": {
+        "handlers.SetTransactionLimitRequest": {
             "type": "object",
             "required": [
-                "reference",
-                "source_account_id",
-                "transaction_type"
+                "scope",
+                "subject"
             ],
             "properties": {
-                "amount": {
-                    "type": "number"
+                "daily_amount": {
+                    "type": "string"
                 },
-                "destination_account_id": {
+                "daily_count": {
+                    "type": "integer"
+                },
+                "monthly_amount": {
                     "type": "string"
                 },
-                "reference": {
+                "monthly_count": {
+                    "type": "integer"
+                },
+                "per_transaction_amount": {
+                    "description": "decimal, e.g. \"5000.00\"",
                     "type": "string"
                 },
-                "source_account_id": {
+                "scope": {
                     "type": "string"
                 },
-                "transaction_type": {
+                "subject": {
                     "type": "string"
                 }
             }
         },
-        "handlers.InitTransactionResponse": {
+        "handlers.SetTransactionLimitResponse": {
             "type": "object",
             "properties": {
+                "limit": {},
                 "message": {
                     "type": "string"
-                },
-                "transaction_id": {
-                    "type": "string"
-                },
-                "transaction_status": {
-                    "type": "string"
                 }
             }
         },
-        "handlers.ListAccountResponse": {
+        "handlers.StandingOrderResponse": {
             "type": "object",
             "properties": {
-                "accounts": {},
                 "message": {
                     "type": "string"
                 },
-                "page": {
-                    "type": "integer"
-                },
-                "pageSize": {
-                    "type": "integer"
-                },
-                "totalCount": {
-                    "type": "integer"
-                },
-                "totalPages": {
-                    "type": "integer"
-                }
+                "standing_order": {}
             }
         },
-        "handlers.ListCustomerResponse": {
+        "handlers.StatementResponse": {
             "type": "object",
             "properties": {
-                "customers": {},
                 "message": {
                     "type": "string"
                 },
-                "page": {
-                    "type": "integer"
-                },
-                "pageSize": {
-                    "type": "integer"
+                "statement": {}
+            }
+        },
+        "handlers.TransactionBatchLineReq": {
+            "type": "object",
+            "properties": {
+                "amount": {
+                    "description": "decimal, e.g. \"1200.00\"",
+                    "type": "string"
                 },
-                "totalCount": {
-                    "type": "integer"
+                "destination_account_id": {
+                    "type": "string"
                 },
-                "totalPages": {
-                    "type": "integer"
+                "reference_id": {
+                    "type": "string"
                 }
             }
         },
-        "handlers.ListEmployeeResponse": {
+        "handlers.TransactionBatchResponse": {
             "type": "object",
             "properties": {
-                "employees": {},
+                "batch": {},
+                "lines": {},
                 "message": {
                     "type": "string"
-                },
-                "page": {
-                    "type": "integer"
-                },
-                "pageSize": {
-                    "type": "integer"
-                },
-                "totalCount": {
-                    "type": "integer"
-                },
-                "totalPages": {
-                    "type": "integer"
                 }
             }
         },
-        "handlers.ListTransactionResponse": {
+        "handlers.UnfreezeAccountRequest": {
             "type": "object",
+            "required": [
+                "reason"
+            ],
             "properties": {
-                "message": {
+                "reason": {
                     "type": "string"
+                }
+            }
+        },
+        "handlers.UpdateCustomerRequest": {
+            "type": "object",
+            "properties": {
+                "address": {
+                    "type": "string",
+                    "maxLength": 200
                 },
-                "page": {
-                    "type": "integer"
+                "date_of_birth": {
+                    "description": "YYYY-MM-DD",
+                    "type": "string"
                 },
-                "pageSize": {
-                    "type": "integer"
+                "email": {
+                    "type": "string"
                 },
-                "totalCount": {
-                    "type": "integer"
+                "kyc_status": {
+                    "description": "pending, verified or rejected",
+                    "type": "string"
                 },
-                "totalPages": {
-                    "type": "integer"
+                "name": {
+                    "type": "string",
+                    "maxLength": 50
                 },
-                "transactions": {}
+                "national_id": {
+                    "type": "string"
+                },
+                "phone": {
+                    "description": "international format, e.g. +4915112345678",
+                    "type": "string"
+                }
             }
         },
-        "handlers.LoginRequest": {
+        "handlers.UpdateCustomerResponse": {
+            "type": "object",
+            "properties": {
+                "customer": {},
+                "message": {
+                    "type": "string"
+                }
+            }
+        },
+        "handlers.VerifyMFARequest": {
             "type": "object",
             "required": [
-                "password",
-                "username"
+                "challenge_token"
             ],
             "properties": {
-                "password": {
+                "challenge_token": {
                     "type": "string"
                 },
-                "username": {
+                "code": {
+                    "type": "string"
+                },
+                "recovery_code": {
                     "type": "string"
                 }
             }
         },
-        "handlers.LoginResponse": {
+        "handlers.VerifyMFAResponse": {
             "type": "object",
             "properties": {
                 "access_token": {
                     "type": "string"
+                },
+                "recovery_codes": {
+                    "description": "only when the verification completed an enrollment",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "refresh_token": {
+                    "type": "string"
                 }
             }
         }
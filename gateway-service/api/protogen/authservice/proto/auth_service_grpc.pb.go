@@ -19,13 +19,22 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	AuthService_HealthCheck_FullMethodName    = "/AuthService/HealthCheck"
-	AuthService_Authenticate_FullMethodName   = "/AuthService/Authenticate"
-	AuthService_CreateEmployee_FullMethodName = "/AuthService/CreateEmployee"
-	AuthService_UpdateRole_FullMethodName     = "/AuthService/UpdateRole"
-	AuthService_GetEmployee_FullMethodName    = "/AuthService/GetEmployee"
-	AuthService_ListEmployee_FullMethodName   = "/AuthService/ListEmployee"
-	AuthService_DeleteEmployee_FullMethodName = "/AuthService/DeleteEmployee"
+	AuthService_HealthCheck_FullMethodName          = "/AuthService/HealthCheck"
+	AuthService_Authenticate_FullMethodName         = "/AuthService/Authenticate"
+	AuthService_VerifyMFA_FullMethodName            = "/AuthService/VerifyMFA"
+	AuthService_RefreshToken_FullMethodName         = "/AuthService/RefreshToken"
+	AuthService_Logout_FullMethodName               = "/AuthService/Logout"
+	AuthService_ListTokenRevocations_FullMethodName = "/AuthService/ListTokenRevocations"
+	AuthService_EnrollMFA_FullMethodName            = "/AuthService/EnrollMFA"
+	AuthService_ConfirmMFA_FullMethodName           = "/AuthService/ConfirmMFA"
+	AuthService_ResetMFA_FullMethodName             = "/AuthService/ResetMFA"
+	AuthService_SetMFAPolicy_FullMethodName         = "/AuthService/SetMFAPolicy"
+	AuthService_ListMFAPolicies_FullMethodName      = "/AuthService/ListMFAPolicies"
+	AuthService_CreateEmployee_FullMethodName       = "/AuthService/CreateEmployee"
+	AuthService_UpdateRole_FullMethodName           = "/AuthService/UpdateRole"
+	AuthService_GetEmployee_FullMethodName          = "/AuthService/GetEmployee"
+	AuthService_ListEmployee_FullMethodName         = "/AuthService/ListEmployee"
+	AuthService_DeleteEmployee_FullMethodName       = "/AuthService/DeleteEmployee"
 )
 
 // AuthServiceClient is the client API for AuthService service.
@@ -34,8 +43,27 @@ const (
 type AuthServiceClient interface {
 	// HealthCheck sends the health status of the auth service
 	HealthCheck(ctx context.Context, in *HealthCheckRequest, opts ...grpc.CallOption) (*HealthCheckResponse, error)
-	// Authenticate validates user credentials and returns an JWT auth token
+	// Authenticate validates user credentials and returns an JWT auth token, or an MFA challenge token when the
+	// employee has to pass MFA
 	Authenticate(ctx context.Context, in *AuthenticateRequest, opts ...grpc.CallOption) (*AuthenticateResponse, error)
+	// VerifyMFA exchanges the MFA challenge token of a login and a TOTP or recovery code for an JWT auth token
+	VerifyMFA(ctx context.Context, in *VerifyMFARequest, opts ...grpc.CallOption) (*VerifyMFAResponse, error)
+	// RefreshToken exchanges a refresh token for a new JWT auth token and a rotated refresh token
+	RefreshToken(ctx context.Context, in *RefreshTokenRequest, opts ...grpc.CallOption) (*RefreshTokenResponse, error)
+	// Logout revokes an access token and the refresh token family of its session
+	Logout(ctx context.Context, in *LogoutRequest, opts ...grpc.CallOption) (*LogoutResponse, error)
+	// ListTokenRevocations returns the feed of access token revocations the gateway follows to reject revoked tokens
+	ListTokenRevocations(ctx context.Context, in *ListTokenRevocationsRequest, opts ...grpc.CallOption) (*ListTokenRevocationsResponse, error)
+	// EnrollMFA starts the enrollment of an authenticator app and returns its TOTP provisioning URI
+	EnrollMFA(ctx context.Context, in *EnrollMFARequest, opts ...grpc.CallOption) (*EnrollMFAResponse, error)
+	// ConfirmMFA enables an enrolled authenticator app with its first code and returns the recovery codes
+	ConfirmMFA(ctx context.Context, in *ConfirmMFARequest, opts ...grpc.CallOption) (*ConfirmMFAResponse, error)
+	// ResetMFA removes the second factor of an employee who lost it
+	ResetMFA(ctx context.Context, in *ResetMFARequest, opts ...grpc.CallOption) (*ResetMFAResponse, error)
+	// SetMFAPolicy makes MFA mandatory or optional for the employees of a role
+	SetMFAPolicy(ctx context.Context, in *SetMFAPolicyRequest, opts ...grpc.CallOption) (*SetMFAPolicyResponse, error)
+	// ListMFAPolicies returns the MFA policy of every role
+	ListMFAPolicies(ctx context.Context, in *ListMFAPoliciesRequest, opts ...grpc.CallOption) (*ListMFAPoliciesResponse, error)
 	// CreateEmployee registers a new employee account in the system
 	CreateEmployee(ctx context.Context, in *CreateEmployeeRequest, opts ...grpc.CallOption) (*CreateEmployeeResponse, error)
 	// UpdateRole modifies the access permissions and role assignments for an employee
@@ -76,6 +104,96 @@ func (c *authServiceClient) Authenticate(ctx context.Context, in *AuthenticateRe
 	return out, nil
 }
 
+func (c *authServiceClient) VerifyMFA(ctx context.Context, in *VerifyMFARequest, opts ...grpc.CallOption) (*VerifyMFAResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(VerifyMFAResponse)
+	err := c.cc.Invoke(ctx, AuthService_VerifyMFA_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) RefreshToken(ctx context.Context, in *RefreshTokenRequest, opts ...grpc.CallOption) (*RefreshTokenResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RefreshTokenResponse)
+	err := c.cc.Invoke(ctx, AuthService_RefreshToken_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) Logout(ctx context.Context, in *LogoutRequest, opts ...grpc.CallOption) (*LogoutResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(LogoutResponse)
+	err := c.cc.Invoke(ctx, AuthService_Logout_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) ListTokenRevocations(ctx context.Context, in *ListTokenRevocationsRequest, opts ...grpc.CallOption) (*ListTokenRevocationsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListTokenRevocationsResponse)
+	err := c.cc.Invoke(ctx, AuthService_ListTokenRevocations_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) EnrollMFA(ctx context.Context, in *EnrollMFARequest, opts ...grpc.CallOption) (*EnrollMFAResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(EnrollMFAResponse)
+	err := c.cc.Invoke(ctx, AuthService_EnrollMFA_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) ConfirmMFA(ctx context.Context, in *ConfirmMFARequest, opts ...grpc.CallOption) (*ConfirmMFAResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ConfirmMFAResponse)
+	err := c.cc.Invoke(ctx, AuthService_ConfirmMFA_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) ResetMFA(ctx context.Context, in *ResetMFARequest, opts ...grpc.CallOption) (*ResetMFAResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ResetMFAResponse)
+	err := c.cc.Invoke(ctx, AuthService_ResetMFA_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) SetMFAPolicy(ctx context.Context, in *SetMFAPolicyRequest, opts ...grpc.CallOption) (*SetMFAPolicyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetMFAPolicyResponse)
+	err := c.cc.Invoke(ctx, AuthService_SetMFAPolicy_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) ListMFAPolicies(ctx context.Context, in *ListMFAPoliciesRequest, opts ...grpc.CallOption) (*ListMFAPoliciesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListMFAPoliciesResponse)
+	err := c.cc.Invoke(ctx, AuthService_ListMFAPolicies_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *authServiceClient) CreateEmployee(ctx context.Context, in *CreateEmployeeRequest, opts ...grpc.CallOption) (*CreateEmployeeResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(CreateEmployeeResponse)
@@ -132,8 +250,27 @@ func (c *authServiceClient) DeleteEmployee(ctx context.Context, in *DeleteEmploy
 type AuthServiceServer interface {
 	// HealthCheck sends the health status of the auth service
 	HealthCheck(context.Context, *HealthCheckRequest) (*HealthCheckResponse, error)
-	// Authenticate validates user credentials and returns an JWT auth token
+	// Authenticate validates user credentials and returns an JWT auth token, or an MFA challenge token when the
+	// employee has to pass MFA
 	Authenticate(context.Context, *AuthenticateRequest) (*AuthenticateResponse, error)
+	// VerifyMFA exchanges the MFA challenge token of a login and a TOTP or recovery code for an JWT auth token
+	VerifyMFA(context.Context, *VerifyMFARequest) (*VerifyMFAResponse, error)
+	// RefreshToken exchanges a refresh token for a new JWT auth token and a rotated refresh token
+	RefreshToken(context.Context, *RefreshTokenRequest) (*RefreshTokenResponse, error)
+	// Logout revokes an access token and the refresh token family of its session
+	Logout(context.Context, *LogoutRequest) (*LogoutResponse, error)
+	// ListTokenRevocations returns the feed of access token revocations the gateway follows to reject revoked tokens
+	ListTokenRevocations(context.Context, *ListTokenRevocationsRequest) (*ListTokenRevocationsResponse, error)
+	// EnrollMFA starts the enrollment of an authenticator app and returns its TOTP provisioning URI
+	EnrollMFA(context.Context, *EnrollMFARequest) (*EnrollMFAResponse, error)
+	// ConfirmMFA enables an enrolled authenticator app with its first code and returns the recovery codes
+	ConfirmMFA(context.Context, *ConfirmMFARequest) (*ConfirmMFAResponse, error)
+	// ResetMFA removes the second factor of an employee who lost it
+	ResetMFA(context.Context, *ResetMFARequest) (*ResetMFAResponse, error)
+	// SetMFAPolicy makes MFA mandatory or optional for the employees of a role
+	SetMFAPolicy(context.Context, *SetMFAPolicyRequest) (*SetMFAPolicyResponse, error)
+	// ListMFAPolicies returns the MFA policy of every role
+	ListMFAPolicies(context.Context, *ListMFAPoliciesRequest) (*ListMFAPoliciesResponse, error)
 	// CreateEmployee registers a new employee account in the system
 	CreateEmployee(context.Context, *CreateEmployeeRequest) (*CreateEmployeeResponse, error)
 	// UpdateRole modifies the access permissions and role assignments for an employee
@@ -160,6 +297,33 @@ func (UnimplementedAuthServiceServer) HealthCheck(context.Context, *HealthCheckR
 func (UnimplementedAuthServiceServer) Authenticate(context.Context, *AuthenticateRequest) (*AuthenticateResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Authenticate not implemented")
 }
+func (UnimplementedAuthServiceServer) VerifyMFA(context.Context, *VerifyMFARequest) (*VerifyMFAResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VerifyMFA not implemented")
+}
+func (UnimplementedAuthServiceServer) RefreshToken(context.Context, *RefreshTokenRequest) (*RefreshTokenResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RefreshToken not implemented")
+}
+func (UnimplementedAuthServiceServer) Logout(context.Context, *LogoutRequest) (*LogoutResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Logout not implemented")
+}
+func (UnimplementedAuthServiceServer) ListTokenRevocations(context.Context, *ListTokenRevocationsRequest) (*ListTokenRevocationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTokenRevocations not implemented")
+}
+func (UnimplementedAuthServiceServer) EnrollMFA(context.Context, *EnrollMFARequest) (*EnrollMFAResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EnrollMFA not implemented")
+}
+func (UnimplementedAuthServiceServer) ConfirmMFA(context.Context, *ConfirmMFARequest) (*ConfirmMFAResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConfirmMFA not implemented")
+}
+func (UnimplementedAuthServiceServer) ResetMFA(context.Context, *ResetMFARequest) (*ResetMFAResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResetMFA not implemented")
+}
+func (UnimplementedAuthServiceServer) SetMFAPolicy(context.Context, *SetMFAPolicyRequest) (*SetMFAPolicyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetMFAPolicy not implemented")
+}
+func (UnimplementedAuthServiceServer) ListMFAPolicies(context.Context, *ListMFAPoliciesRequest) (*ListMFAPoliciesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListMFAPolicies not implemented")
+}
 func (UnimplementedAuthServiceServer) CreateEmployee(context.Context, *CreateEmployeeRequest) (*CreateEmployeeResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateEmployee not implemented")
 }
@@ -232,6 +396,168 @@ func _AuthService_Authenticate_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AuthService_VerifyMFA_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VerifyMFARequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).VerifyMFA(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_VerifyMFA_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).VerifyMFA(ctx, req.(*VerifyMFARequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_RefreshToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RefreshTokenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).RefreshToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_RefreshToken_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).RefreshToken(ctx, req.(*RefreshTokenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_Logout_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LogoutRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).Logout(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_Logout_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).Logout(ctx, req.(*LogoutRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_ListTokenRevocations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTokenRevocationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).ListTokenRevocations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_ListTokenRevocations_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).ListTokenRevocations(ctx, req.(*ListTokenRevocationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_EnrollMFA_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EnrollMFARequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).EnrollMFA(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_EnrollMFA_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).EnrollMFA(ctx, req.(*EnrollMFARequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_ConfirmMFA_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConfirmMFARequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).ConfirmMFA(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_ConfirmMFA_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).ConfirmMFA(ctx, req.(*ConfirmMFARequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_ResetMFA_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResetMFARequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).ResetMFA(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_ResetMFA_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).ResetMFA(ctx, req.(*ResetMFARequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_SetMFAPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetMFAPolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).SetMFAPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_SetMFAPolicy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).SetMFAPolicy(ctx, req.(*SetMFAPolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_ListMFAPolicies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListMFAPoliciesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).ListMFAPolicies(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_ListMFAPolicies_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).ListMFAPolicies(ctx, req.(*ListMFAPoliciesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _AuthService_CreateEmployee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(CreateEmployeeRequest)
 	if err := dec(in); err != nil {
@@ -337,6 +663,42 @@ var AuthService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Authenticate",
 			Handler:    _AuthService_Authenticate_Handler,
 		},
+		{
+			MethodName: "VerifyMFA",
+			Handler:    _AuthService_VerifyMFA_Handler,
+		},
+		{
+			MethodName: "RefreshToken",
+			Handler:    _AuthService_RefreshToken_Handler,
+		},
+		{
+			MethodName: "Logout",
+			Handler:    _AuthService_Logout_Handler,
+		},
+		{
+			MethodName: "ListTokenRevocations",
+			Handler:    _AuthService_ListTokenRevocations_Handler,
+		},
+		{
+			MethodName: "EnrollMFA",
+			Handler:    _AuthService_EnrollMFA_Handler,
+		},
+		{
+			MethodName: "ConfirmMFA",
+			Handler:    _AuthService_ConfirmMFA_Handler,
+		},
+		{
+			MethodName: "ResetMFA",
+			Handler:    _AuthService_ResetMFA_Handler,
+		},
+		{
+			MethodName: "SetMFAPolicy",
+			Handler:    _AuthService_SetMFAPolicy_Handler,
+		},
+		{
+			MethodName: "ListMFAPolicies",
+			Handler:    _AuthService_ListMFAPolicies_Handler,
+		},
 		{
 			MethodName: "CreateEmployee",
 			Handler:    _AuthService_CreateEmployee_Handler,
@@ -19,9 +19,27 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	TransactionService_HealthCheck_FullMethodName           = "/transaction.TransactionService/HealthCheck"
-	TransactionService_InitTransaction_FullMethodName       = "/transaction.TransactionService/InitTransaction"
-	TransactionService_GetTransactionHistory_FullMethodName = "/transaction.TransactionService/GetTransactionHistory"
+	TransactionService_HealthCheck_FullMethodName               = "/transaction.TransactionService/HealthCheck"
+	TransactionService_InitTransaction_FullMethodName           = "/transaction.TransactionService/InitTransaction"
+	TransactionService_GetTransaction_FullMethodName            = "/transaction.TransactionService/GetTransaction"
+	TransactionService_GetTransactionHistory_FullMethodName     = "/transaction.TransactionService/GetTransactionHistory"
+	TransactionService_ExportTransactionHistory_FullMethodName  = "/transaction.TransactionService/ExportTransactionHistory"
+	TransactionService_ReverseTransaction_FullMethodName        = "/transaction.TransactionService/ReverseTransaction"
+	TransactionService_CaptureHold_FullMethodName               = "/transaction.TransactionService/CaptureHold"
+	TransactionService_CloseAccount_FullMethodName              = "/transaction.TransactionService/CloseAccount"
+	TransactionService_SetExchangeRate_FullMethodName           = "/transaction.TransactionService/SetExchangeRate"
+	TransactionService_ListExchangeRates_FullMethodName         = "/transaction.TransactionService/ListExchangeRates"
+	TransactionService_CreateStandingOrder_FullMethodName       = "/transaction.TransactionService/CreateStandingOrder"
+	TransactionService_GetStandingOrder_FullMethodName          = "/transaction.TransactionService/GetStandingOrder"
+	TransactionService_ListStandingOrders_FullMethodName        = "/transaction.TransactionService/ListStandingOrders"
+	TransactionService_UpdateStandingOrderStatus_FullMethodName = "/transaction.TransactionService/UpdateStandingOrderStatus"
+	TransactionService_CreateTransactionBatch_FullMethodName    = "/transaction.TransactionService/CreateTransactionBatch"
+	TransactionService_GetTransactionBatch_FullMethodName       = "/transaction.TransactionService/GetTransactionBatch"
+	TransactionService_SetTransactionLimit_FullMethodName       = "/transaction.TransactionService/SetTransactionLimit"
+	TransactionService_ListTransactionLimits_FullMethodName     = "/transaction.TransactionService/ListTransactionLimits"
+	TransactionService_SetFeeRule_FullMethodName                = "/transaction.TransactionService/SetFeeRule"
+	TransactionService_ListFeeRules_FullMethodName              = "/transaction.TransactionService/ListFeeRules"
+	TransactionService_DeleteFeeRule_FullMethodName             = "/transaction.TransactionService/DeleteFeeRule"
 )
 
 // TransactionServiceClient is the client API for TransactionService service.
@@ -36,8 +54,46 @@ type TransactionServiceClient interface {
 	// type=withdraw_amount -> withdraws specific amount from the source account
 	// type=add_amount -> adds specific amount from the source account
 	InitTransaction(ctx context.Context, in *InitTransactionRequest, opts ...grpc.CallOption) (*InitTransactionResponse, error)
+	// GetTransaction returns a single transaction, used to poll the status of asynchronous transactions
+	GetTransaction(ctx context.Context, in *GetTransactionRequest, opts ...grpc.CallOption) (*GetTransactionResponse, error)
 	// GetTransactionHistory returns a paginated list of transaction records
 	GetTransactionHistory(ctx context.Context, in *GetTransactionHistoryRequest, opts ...grpc.CallOption) (*GetTransactionHistoryResponse, error)
+	// ExportTransactionHistory streams every transaction record matching the history filters in chunks
+	ExportTransactionHistory(ctx context.Context, in *ExportTransactionHistoryRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ExportTransactionHistoryResponse], error)
+	// ReverseTransaction moves the money of a completed transaction back through a linked reversal transaction.
+	// A transaction can be reversed partially several times until its reversals have moved back the full amount,
+	// after that it is already reversed.
+	ReverseTransaction(ctx context.Context, in *ReverseTransactionRequest, opts ...grpc.CallOption) (*ReverseTransactionResponse, error)
+	// CaptureHold settles a hold placed in the account service with a withdrawal or a transfer
+	CaptureHold(ctx context.Context, in *CaptureHoldRequest, opts ...grpc.CallOption) (*CaptureHoldResponse, error)
+	// CloseAccount pays the balance of an account out to a payout account and closes the account
+	CloseAccount(ctx context.Context, in *CloseAccountRequest, opts ...grpc.CallOption) (*CloseAccountResponse, error)
+	// SetExchangeRate creates or updates the rate for converting base_currency into quote_currency
+	SetExchangeRate(ctx context.Context, in *SetExchangeRateRequest, opts ...grpc.CallOption) (*SetExchangeRateResponse, error)
+	// ListExchangeRates returns all configured exchange rates
+	ListExchangeRates(ctx context.Context, in *ListExchangeRatesRequest, opts ...grpc.CallOption) (*ListExchangeRatesResponse, error)
+	// CreateStandingOrder schedules a one-off or recurring transfer
+	CreateStandingOrder(ctx context.Context, in *CreateStandingOrderRequest, opts ...grpc.CallOption) (*CreateStandingOrderResponse, error)
+	// GetStandingOrder returns a standing order with the outcome of its runs
+	GetStandingOrder(ctx context.Context, in *GetStandingOrderRequest, opts ...grpc.CallOption) (*GetStandingOrderResponse, error)
+	// ListStandingOrders returns the standing orders debiting an account
+	ListStandingOrders(ctx context.Context, in *ListStandingOrdersRequest, opts ...grpc.CallOption) (*ListStandingOrdersResponse, error)
+	// UpdateStandingOrderStatus pauses, resumes or cancels a standing order
+	UpdateStandingOrderStatus(ctx context.Context, in *UpdateStandingOrderStatusRequest, opts ...grpc.CallOption) (*UpdateStandingOrderStatusResponse, error)
+	// CreateTransactionBatch validates a list of transfers from one account and queues it for processing
+	CreateTransactionBatch(ctx context.Context, in *CreateTransactionBatchRequest, opts ...grpc.CallOption) (*CreateTransactionBatchResponse, error)
+	// GetTransactionBatch returns a batch with the outcome of each line
+	GetTransactionBatch(ctx context.Context, in *GetTransactionBatchRequest, opts ...grpc.CallOption) (*GetTransactionBatchResponse, error)
+	// SetTransactionLimit creates or overrides the limit of an account type, account or employee role
+	SetTransactionLimit(ctx context.Context, in *SetTransactionLimitRequest, opts ...grpc.CallOption) (*SetTransactionLimitResponse, error)
+	// ListTransactionLimits returns all configured transaction limits
+	ListTransactionLimits(ctx context.Context, in *ListTransactionLimitsRequest, opts ...grpc.CallOption) (*ListTransactionLimitsResponse, error)
+	// SetFeeRule creates or overrides the fee rule of a transaction type, account type and amount band
+	SetFeeRule(ctx context.Context, in *SetFeeRuleRequest, opts ...grpc.CallOption) (*SetFeeRuleResponse, error)
+	// ListFeeRules returns all configured fee rules
+	ListFeeRules(ctx context.Context, in *ListFeeRulesRequest, opts ...grpc.CallOption) (*ListFeeRulesResponse, error)
+	// DeleteFeeRule removes a fee rule
+	DeleteFeeRule(ctx context.Context, in *DeleteFeeRuleRequest, opts ...grpc.CallOption) (*DeleteFeeRuleResponse, error)
 }
 
 type transactionServiceClient struct {
@@ -68,6 +124,16 @@ func (c *transactionServiceClient) InitTransaction(ctx context.Context, in *Init
 	return out, nil
 }
 
+func (c *transactionServiceClient) GetTransaction(ctx context.Context, in *GetTransactionRequest, opts ...grpc.CallOption) (*GetTransactionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetTransactionResponse)
+	err := c.cc.Invoke(ctx, TransactionService_GetTransaction_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *transactionServiceClient) GetTransactionHistory(ctx context.Context, in *GetTransactionHistoryRequest, opts ...grpc.CallOption) (*GetTransactionHistoryResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(GetTransactionHistoryResponse)
@@ -78,6 +144,185 @@ func (c *transactionServiceClient) GetTransactionHistory(ctx context.Context, in
 	return out, nil
 }
 
+func (c *transactionServiceClient) ExportTransactionHistory(ctx context.Context, in *ExportTransactionHistoryRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ExportTransactionHistoryResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &TransactionService_ServiceDesc.Streams[0], TransactionService_ExportTransactionHistory_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ExportTransactionHistoryRequest, ExportTransactionHistoryResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type TransactionService_ExportTransactionHistoryClient = grpc.ServerStreamingClient[ExportTransactionHistoryResponse]
+
+func (c *transactionServiceClient) ReverseTransaction(ctx context.Context, in *ReverseTransactionRequest, opts ...grpc.CallOption) (*ReverseTransactionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReverseTransactionResponse)
+	err := c.cc.Invoke(ctx, TransactionService_ReverseTransaction_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *transactionServiceClient) CaptureHold(ctx context.Context, in *CaptureHoldRequest, opts ...grpc.CallOption) (*CaptureHoldResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CaptureHoldResponse)
+	err := c.cc.Invoke(ctx, TransactionService_CaptureHold_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *transactionServiceClient) CloseAccount(ctx context.Context, in *CloseAccountRequest, opts ...grpc.CallOption) (*CloseAccountResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CloseAccountResponse)
+	err := c.cc.Invoke(ctx, TransactionService_CloseAccount_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *transactionServiceClient) SetExchangeRate(ctx context.Context, in *SetExchangeRateRequest, opts ...grpc.CallOption) (*SetExchangeRateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetExchangeRateResponse)
+	err := c.cc.Invoke(ctx, TransactionService_SetExchangeRate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *transactionServiceClient) ListExchangeRates(ctx context.Context, in *ListExchangeRatesRequest, opts ...grpc.CallOption) (*ListExchangeRatesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListExchangeRatesResponse)
+	err := c.cc.Invoke(ctx, TransactionService_ListExchangeRates_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *transactionServiceClient) CreateStandingOrder(ctx context.Context, in *CreateStandingOrderRequest, opts ...grpc.CallOption) (*CreateStandingOrderResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateStandingOrderResponse)
+	err := c.cc.Invoke(ctx, TransactionService_CreateStandingOrder_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *transactionServiceClient) GetStandingOrder(ctx context.Context, in *GetStandingOrderRequest, opts ...grpc.CallOption) (*GetStandingOrderResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetStandingOrderResponse)
+	err := c.cc.Invoke(ctx, TransactionService_GetStandingOrder_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *transactionServiceClient) ListStandingOrders(ctx context.Context, in *ListStandingOrdersRequest, opts ...grpc.CallOption) (*ListStandingOrdersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListStandingOrdersResponse)
+	err := c.cc.Invoke(ctx, TransactionService_ListStandingOrders_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *transactionServiceClient) UpdateStandingOrderStatus(ctx context.Context, in *UpdateStandingOrderStatusRequest, opts ...grpc.CallOption) (*UpdateStandingOrderStatusResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateStandingOrderStatusResponse)
+	err := c.cc.Invoke(ctx, TransactionService_UpdateStandingOrderStatus_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *transactionServiceClient) CreateTransactionBatch(ctx context.Context, in *CreateTransactionBatchRequest, opts ...grpc.CallOption) (*CreateTransactionBatchResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateTransactionBatchResponse)
+	err := c.cc.Invoke(ctx, TransactionService_CreateTransactionBatch_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *transactionServiceClient) GetTransactionBatch(ctx context.Context, in *GetTransactionBatchRequest, opts ...grpc.CallOption) (*GetTransactionBatchResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetTransactionBatchResponse)
+	err := c.cc.Invoke(ctx, TransactionService_GetTransactionBatch_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *transactionServiceClient) SetTransactionLimit(ctx context.Context, in *SetTransactionLimitRequest, opts ...grpc.CallOption) (*SetTransactionLimitResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetTransactionLimitResponse)
+	err := c.cc.Invoke(ctx, TransactionService_SetTransactionLimit_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *transactionServiceClient) ListTransactionLimits(ctx context.Context, in *ListTransactionLimitsRequest, opts ...grpc.CallOption) (*ListTransactionLimitsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListTransactionLimitsResponse)
+	err := c.cc.Invoke(ctx, TransactionService_ListTransactionLimits_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *transactionServiceClient) SetFeeRule(ctx context.Context, in *SetFeeRuleRequest, opts ...grpc.CallOption) (*SetFeeRuleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetFeeRuleResponse)
+	err := c.cc.Invoke(ctx, TransactionService_SetFeeRule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *transactionServiceClient) ListFeeRules(ctx context.Context, in *ListFeeRulesRequest, opts ...grpc.CallOption) (*ListFeeRulesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListFeeRulesResponse)
+	err := c.cc.Invoke(ctx, TransactionService_ListFeeRules_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *transactionServiceClient) DeleteFeeRule(ctx context.Context, in *DeleteFeeRuleRequest, opts ...grpc.CallOption) (*DeleteFeeRuleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteFeeRuleResponse)
+	err := c.cc.Invoke(ctx, TransactionService_DeleteFeeRule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // TransactionServiceServer is the server API for TransactionService service.
 // All implementations must embed UnimplementedTransactionServiceServer
 // for forward compatibility.
@@ -90,8 +335,46 @@ type TransactionServiceServer interface {
 	// type=withdraw_amount -> withdraws specific amount from the source account
 	// type=add_amount -> adds specific amount from the source account
 	InitTransaction(context.Context, *InitTransactionRequest) (*InitTransactionResponse, error)
+	// GetTransaction returns a single transaction, used to poll the status of asynchronous transactions
+	GetTransaction(context.Context, *GetTransactionRequest) (*GetTransactionResponse, error)
 	// GetTransactionHistory returns a paginated list of transaction records
 	GetTransactionHistory(context.Context, *GetTransactionHistoryRequest) (*GetTransactionHistoryResponse, error)
+	// ExportTransactionHistory streams every transaction record matching the history filters in chunks
+	ExportTransactionHistory(*ExportTransactionHistoryRequest, grpc.ServerStreamingServer[ExportTransactionHistoryResponse]) error
+	// ReverseTransaction moves the money of a completed transaction back through a linked reversal transaction.
+	// A transaction can be reversed partially several times until its reversals have moved back the full amount,
+	// after that it is already reversed.
+	ReverseTransaction(context.Context, *ReverseTransactionRequest) (*ReverseTransactionResponse, error)
+	// CaptureHold settles a hold placed in the account service with a withdrawal or a transfer
+	CaptureHold(context.Context, *CaptureHoldRequest) (*CaptureHoldResponse, error)
+	// CloseAccount pays the balance of an account out to a payout account and closes the account
+	CloseAccount(context.Context, *CloseAccountRequest) (*CloseAccountResponse, error)
+	// SetExchangeRate creates or updates the rate for converting base_currency into quote_currency
+	SetExchangeRate(context.Context, *SetExchangeRateRequest) (*SetExchangeRateResponse, error)
+	// ListExchangeRates returns all configured exchange rates
+	ListExchangeRates(context.Context, *ListExchangeRatesRequest) (*ListExchangeRatesResponse, error)
+	// CreateStandingOrder schedules a one-off or recurring transfer
+	CreateStandingOrder(context.Context, *CreateStandingOrderRequest) (*CreateStandingOrderResponse, error)
+	// GetStandingOrder returns a standing order with the outcome of its runs
+	GetStandingOrder(context.Context, *GetStandingOrderRequest) (*GetStandingOrderResponse, error)
+	// ListStandingOrders returns the standing orders debiting an account
+	ListStandingOrders(context.Context, *ListStandingOrdersRequest) (*ListStandingOrdersResponse, error)
+	// UpdateStandingOrderStatus pauses, resumes or cancels a standing order
+	UpdateStandingOrderStatus(context.Context, *UpdateStandingOrderStatusRequest) (*UpdateStandingOrderStatusResponse, error)
+	// CreateTransactionBatch validates a list of transfers from one account and queues it for processing
+	CreateTransactionBatch(context.Context, *CreateTransactionBatchRequest) (*CreateTransactionBatchResponse, error)
+	// GetTransactionBatch returns a batch with the outcome of each line
+	GetTransactionBatch(context.Context, *GetTransactionBatchRequest) (*GetTransactionBatchResponse, error)
+	// SetTransactionLimit creates or overrides the limit of an account type, account or employee role
+	SetTransactionLimit(context.Context, *SetTransactionLimitRequest) (*SetTransactionLimitResponse, error)
+	// ListTransactionLimits returns all configured transaction limits
+	ListTransactionLimits(context.Context, *ListTransactionLimitsRequest) (*ListTransactionLimitsResponse, error)
+	// SetFeeRule creates or overrides the fee rule of a transaction type, account type and amount band
+	SetFeeRule(context.Context, *SetFeeRuleRequest) (*SetFeeRuleResponse, error)
+	// ListFeeRules returns all configured fee rules
+	ListFeeRules(context.Context, *ListFeeRulesRequest) (*ListFeeRulesResponse, error)
+	// DeleteFeeRule removes a fee rule
+	DeleteFeeRule(context.Context, *DeleteFeeRuleRequest) (*DeleteFeeRuleResponse, error)
 	mustEmbedUnimplementedTransactionServiceServer()
 }
 
@@ -108,9 +391,63 @@ func (UnimplementedTransactionServiceServer) HealthCheck(context.Context, *Healt
 func (UnimplementedTransactionServiceServer) InitTransaction(context.Context, *InitTransactionRequest) (*InitTransactionResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method InitTransaction not implemented")
 }
+func (UnimplementedTransactionServiceServer) GetTransaction(context.Context, *GetTransactionRequest) (*GetTransactionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTransaction not implemented")
+}
 func (UnimplementedTransactionServiceServer) GetTransactionHistory(context.Context, *GetTransactionHistoryRequest) (*GetTransactionHistoryResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetTransactionHistory not implemented")
 }
+func (UnimplementedTransactionServiceServer) ExportTransactionHistory(*ExportTransactionHistoryRequest, grpc.ServerStreamingServer[ExportTransactionHistoryResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method ExportTransactionHistory not implemented")
+}
+func (UnimplementedTransactionServiceServer) ReverseTransaction(context.Context, *ReverseTransactionRequest) (*ReverseTransactionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReverseTransaction not implemented")
+}
+func (UnimplementedTransactionServiceServer) CaptureHold(context.Context, *CaptureHoldRequest) (*CaptureHoldResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CaptureHold not implemented")
+}
+func (UnimplementedTransactionServiceServer) CloseAccount(context.Context, *CloseAccountRequest) (*CloseAccountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CloseAccount not implemented")
+}
+func (UnimplementedTransactionServiceServer) SetExchangeRate(context.Context, *SetExchangeRateRequest) (*SetExchangeRateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetExchangeRate not implemented")
+}
+func (UnimplementedTransactionServiceServer) ListExchangeRates(context.Context, *ListExchangeRatesRequest) (*ListExchangeRatesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListExchangeRates not implemented")
+}
+func (UnimplementedTransactionServiceServer) CreateStandingOrder(context.Context, *CreateStandingOrderRequest) (*CreateStandingOrderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateStandingOrder not implemented")
+}
+func (UnimplementedTransactionServiceServer) GetStandingOrder(context.Context, *GetStandingOrderRequest) (*GetStandingOrderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetStandingOrder not implemented")
+}
+func (UnimplementedTransactionServiceServer) ListStandingOrders(context.Context, *ListStandingOrdersRequest) (*ListStandingOrdersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListStandingOrders not implemented")
+}
+func (UnimplementedTransactionServiceServer) UpdateStandingOrderStatus(context.Context, *UpdateStandingOrderStatusRequest) (*UpdateStandingOrderStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateStandingOrderStatus not implemented")
+}
+func (UnimplementedTransactionServiceServer) CreateTransactionBatch(context.Context, *CreateTransactionBatchRequest) (*CreateTransactionBatchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateTransactionBatch not implemented")
+}
+func (UnimplementedTransactionServiceServer) GetTransactionBatch(context.Context, *GetTransactionBatchRequest) (*GetTransactionBatchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTransactionBatch not implemented")
+}
+func (UnimplementedTransactionServiceServer) SetTransactionLimit(context.Context, *SetTransactionLimitRequest) (*SetTransactionLimitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetTransactionLimit not implemented")
+}
+func (UnimplementedTransactionServiceServer) ListTransactionLimits(context.Context, *ListTransactionLimitsRequest) (*ListTransactionLimitsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTransactionLimits not implemented")
+}
+func (UnimplementedTransactionServiceServer) SetFeeRule(context.Context, *SetFeeRuleRequest) (*SetFeeRuleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetFeeRule not implemented")
+}
+func (UnimplementedTransactionServiceServer) ListFeeRules(context.Context, *ListFeeRulesRequest) (*ListFeeRulesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListFeeRules not implemented")
+}
+func (UnimplementedTransactionServiceServer) DeleteFeeRule(context.Context, *DeleteFeeRuleRequest) (*DeleteFeeRuleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteFeeRule not implemented")
+}
 func (UnimplementedTransactionServiceServer) mustEmbedUnimplementedTransactionServiceServer() {}
 func (UnimplementedTransactionServiceServer) testEmbeddedByValue()                            {}
 
@@ -168,6 +505,24 @@ func _TransactionService_InitTransaction_Handler(srv interface{}, ctx context.Co
 	return interceptor(ctx, in, info, handler)
 }
 
+func _TransactionService_GetTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTransactionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransactionServiceServer).GetTransaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TransactionService_GetTransaction_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransactionServiceServer).GetTransaction(ctx, req.(*GetTransactionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _TransactionService_GetTransactionHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetTransactionHistoryRequest)
 	if err := dec(in); err != nil {
@@ -186,6 +541,305 @@ func _TransactionService_GetTransactionHistory_Handler(srv interface{}, ctx cont
 	return interceptor(ctx, in, info, handler)
 }
 
+func _TransactionService_ExportTransactionHistory_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ExportTransactionHistoryRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(TransactionServiceServer).ExportTransactionHistory(m, &grpc.GenericServerStream[ExportTransactionHistoryRequest, ExportTransactionHistoryResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type TransactionService_ExportTransactionHistoryServer = grpc.ServerStreamingServer[ExportTransactionHistoryResponse]
+
+func _TransactionService_ReverseTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReverseTransactionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransactionServiceServer).ReverseTransaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TransactionService_ReverseTransaction_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransactionServiceServer).ReverseTransaction(ctx, req.(*ReverseTransactionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TransactionService_CaptureHold_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CaptureHoldRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransactionServiceServer).CaptureHold(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TransactionService_CaptureHold_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransactionServiceServer).CaptureHold(ctx, req.(*CaptureHoldRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TransactionService_CloseAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CloseAccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransactionServiceServer).CloseAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TransactionService_CloseAccount_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransactionServiceServer).CloseAccount(ctx, req.(*CloseAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TransactionService_SetExchangeRate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetExchangeRateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransactionServiceServer).SetExchangeRate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TransactionService_SetExchangeRate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransactionServiceServer).SetExchangeRate(ctx, req.(*SetExchangeRateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TransactionService_ListExchangeRates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListExchangeRatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransactionServiceServer).ListExchangeRates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TransactionService_ListExchangeRates_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransactionServiceServer).ListExchangeRates(ctx, req.(*ListExchangeRatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TransactionService_CreateStandingOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateStandingOrderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransactionServiceServer).CreateStandingOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TransactionService_CreateStandingOrder_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransactionServiceServer).CreateStandingOrder(ctx, req.(*CreateStandingOrderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TransactionService_GetStandingOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetStandingOrderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransactionServiceServer).GetStandingOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TransactionService_GetStandingOrder_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransactionServiceServer).GetStandingOrder(ctx, req.(*GetStandingOrderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TransactionService_ListStandingOrders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListStandingOrdersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransactionServiceServer).ListStandingOrders(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TransactionService_ListStandingOrders_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransactionServiceServer).ListStandingOrders(ctx, req.(*ListStandingOrdersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TransactionService_UpdateStandingOrderStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateStandingOrderStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransactionServiceServer).UpdateStandingOrderStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TransactionService_UpdateStandingOrderStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransactionServiceServer).UpdateStandingOrderStatus(ctx, req.(*UpdateStandingOrderStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TransactionService_CreateTransactionBatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateTransactionBatchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransactionServiceServer).CreateTransactionBatch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TransactionService_CreateTransactionBatch_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransactionServiceServer).CreateTransactionBatch(ctx, req.(*CreateTransactionBatchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TransactionService_GetTransactionBatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTransactionBatchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransactionServiceServer).GetTransactionBatch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TransactionService_GetTransactionBatch_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransactionServiceServer).GetTransactionBatch(ctx, req.(*GetTransactionBatchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TransactionService_SetTransactionLimit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetTransactionLimitRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransactionServiceServer).SetTransactionLimit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TransactionService_SetTransactionLimit_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransactionServiceServer).SetTransactionLimit(ctx, req.(*SetTransactionLimitRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TransactionService_ListTransactionLimits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTransactionLimitsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransactionServiceServer).ListTransactionLimits(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TransactionService_ListTransactionLimits_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransactionServiceServer).ListTransactionLimits(ctx, req.(*ListTransactionLimitsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TransactionService_SetFeeRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetFeeRuleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransactionServiceServer).SetFeeRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TransactionService_SetFeeRule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransactionServiceServer).SetFeeRule(ctx, req.(*SetFeeRuleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TransactionService_ListFeeRules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListFeeRulesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransactionServiceServer).ListFeeRules(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TransactionService_ListFeeRules_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransactionServiceServer).ListFeeRules(ctx, req.(*ListFeeRulesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TransactionService_DeleteFeeRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteFeeRuleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransactionServiceServer).DeleteFeeRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TransactionService_DeleteFeeRule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransactionServiceServer).DeleteFeeRule(ctx, req.(*DeleteFeeRuleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // TransactionService_ServiceDesc is the grpc.ServiceDesc for TransactionService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -201,11 +855,85 @@ var TransactionService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "InitTransaction",
 			Handler:    _TransactionService_InitTransaction_Handler,
 		},
+		{
+			MethodName: "GetTransaction",
+			Handler:    _TransactionService_GetTransaction_Handler,
+		},
 		{
 			MethodName: "GetTransactionHistory",
 			Handler:    _TransactionService_GetTransactionHistory_Handler,
 		},
+		{
+			MethodName: "ReverseTransaction",
+			Handler:    _TransactionService_ReverseTransaction_Handler,
+		},
+		{
+			MethodName: "CaptureHold",
+			Handler:    _TransactionService_CaptureHold_Handler,
+		},
+		{
+			MethodName: "CloseAccount",
+			Handler:    _TransactionService_CloseAccount_Handler,
+		},
+		{
+			MethodName: "SetExchangeRate",
+			Handler:    _TransactionService_SetExchangeRate_Handler,
+		},
+		{
+			MethodName: "ListExchangeRates",
+			Handler:    _TransactionService_ListExchangeRates_Handler,
+		},
+		{
+			MethodName: "CreateStandingOrder",
+			Handler:    _TransactionService_CreateStandingOrder_Handler,
+		},
+		{
+			MethodName: "GetStandingOrder",
+			Handler:    _TransactionService_GetStandingOrder_Handler,
+		},
+		{
+			MethodName: "ListStandingOrders",
+			Handler:    _TransactionService_ListStandingOrders_Handler,
+		},
+		{
+			MethodName: "UpdateStandingOrderStatus",
+			Handler:    _TransactionService_UpdateStandingOrderStatus_Handler,
+		},
+		{
+			MethodName: "CreateTransactionBatch",
+			Handler:    _TransactionService_CreateTransactionBatch_Handler,
+		},
+		{
+			MethodName: "GetTransactionBatch",
+			Handler:    _TransactionService_GetTransactionBatch_Handler,
+		},
+		{
+			MethodName: "SetTransactionLimit",
+			Handler:    _TransactionService_SetTransactionLimit_Handler,
+		},
+		{
+			MethodName: "ListTransactionLimits",
+			Handler:    _TransactionService_ListTransactionLimits_Handler,
+		},
+		{
+			MethodName: "SetFeeRule",
+			Handler:    _TransactionService_SetFeeRule_Handler,
+		},
+		{
+			MethodName: "ListFeeRules",
+			Handler:    _TransactionService_ListFeeRules_Handler,
+		},
+		{
+			MethodName: "DeleteFeeRule",
+			Handler:    _TransactionService_DeleteFeeRule_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "ExportTransactionHistory",
+			Handler:       _TransactionService_ExportTransactionHistory_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "transaction_service.proto",
 }
@@ -4,6 +4,7 @@ import (
 	"context"
 	"gateway-service/internal/adapter/grpc/clients"
 	clients2 "gateway-service/internal/adapter/grpc/clients"
+	"gateway-service/internal/auth"
 	"gateway-service/internal/config"
 	"gateway-service/internal/http"
 	"gateway-service/internal/logging"
@@ -65,9 +66,21 @@ func main() {
 	go authClient.StartConnectionMonitor(ctx)
 	go transactionClient.StartConnectionMonitor(ctx)
 
+	// Verify access tokens with the keys published by the auth service, tokens signed with an unknown key
+	// trigger a fetch, so a failed first fetch is not fatal
+	keys := auth.NewKeySet(config.Current().Auth.JWKSURL)
+	if err := keys.Refresh(ctx); err != nil {
+		logging.Logger.Warn().Err(err).Msg("failed to fetch JWT verification keys from auth service")
+	}
+	go keys.StartRefresh(ctx, config.Current().Auth.JWKSRefreshInterval)
+
+	// Follow the access tokens revoked by the auth service
+	revocations := auth.NewRevocationList()
+	go revocations.StartSync(ctx, authClient, config.Current().Auth.RevocationSyncInterval)
+
 	http.StartServer(http.GrpcClients{
 		AuthClient:        &authClient,
 		AccountClient:     &accountClient,
 		TransactionClient: &transactionClient,
-	})
+	}, keys, revocations)
 }
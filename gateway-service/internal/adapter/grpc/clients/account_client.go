@@ -248,3 +248,213 @@ func (c *GRPCAccountClient) GetBalance(ctx context.Context, req *protoacc.GetBal
 
 	return client.GetBalance(ctx, req)
 }
+
+func (c *GRPCAccountClient) GetAccountJournal(ctx context.Context, req *protoacc.GetAccountJournalRequest) (*protoacc.GetAccountJournalResponse, error) {
+	if err := c.EnsureConnection(); err != nil {
+		return nil, err
+	}
+
+	c.mutex.RLock()
+	client := c.client
+	c.mutex.RUnlock()
+
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+
+	return client.GetAccountJournal(ctx, req)
+}
+
+func (c *GRPCAccountClient) RecomputeAccountBalance(ctx context.Context, req *protoacc.RecomputeAccountBalanceRequest) (*protoacc.RecomputeAccountBalanceResponse, error) {
+	if err := c.EnsureConnection(); err != nil {
+		return nil, err
+	}
+
+	c.mutex.RLock()
+	client := c.client
+	c.mutex.RUnlock()
+
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+
+	return client.RecomputeAccountBalance(ctx, req)
+}
+
+func (c *GRPCAccountClient) SetOverdraftLimit(ctx context.Context, req *protoacc.SetOverdraftLimitRequest) (*protoacc.SetOverdraftLimitResponse, error) {
+	if err := c.EnsureConnection(); err != nil {
+		return nil, err
+	}
+
+	c.mutex.RLock()
+	client := c.client
+	c.mutex.RUnlock()
+
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+
+	return client.SetOverdraftLimit(ctx, req)
+}
+
+func (c *GRPCAccountClient) UpdateAccountStatus(ctx context.Context, req *protoacc.UpdateAccountStatusRequest) (*protoacc.UpdateAccountStatusResponse, error) {
+	if err := c.EnsureConnection(); err != nil {
+		return nil, err
+	}
+
+	c.mutex.RLock()
+	client := c.client
+	c.mutex.RUnlock()
+
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+
+	return client.UpdateAccountStatus(ctx, req)
+}
+
+func (c *GRPCAccountClient) PlaceHold(ctx context.Context, req *protoacc.PlaceHoldRequest) (*protoacc.PlaceHoldResponse, error) {
+	if err := c.EnsureConnection(); err != nil {
+		return nil, err
+	}
+
+	c.mutex.RLock()
+	client := c.client
+	c.mutex.RUnlock()
+
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+
+	return client.PlaceHold(ctx, req)
+}
+
+func (c *GRPCAccountClient) GetHold(ctx context.Context, req *protoacc.GetHoldRequest) (*protoacc.GetHoldResponse, error) {
+	if err := c.EnsureConnection(); err != nil {
+		return nil, err
+	}
+
+	c.mutex.RLock()
+	client := c.client
+	c.mutex.RUnlock()
+
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+
+	return client.GetHold(ctx, req)
+}
+
+func (c *GRPCAccountClient) ReleaseHold(ctx context.Context, req *protoacc.ReleaseHoldRequest) (*protoacc.ReleaseHoldResponse, error) {
+	if err := c.EnsureConnection(); err != nil {
+		return nil, err
+	}
+
+	c.mutex.RLock()
+	client := c.client
+	c.mutex.RUnlock()
+
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+
+	return client.ReleaseHold(ctx, req)
+}
+
+func (c *GRPCAccountClient) ListAccountHolds(ctx context.Context, req *protoacc.ListAccountHoldsRequest) (*protoacc.ListAccountHoldsResponse, error) {
+	if err := c.EnsureConnection(); err != nil {
+		return nil, err
+	}
+
+	c.mutex.RLock()
+	client := c.client
+	c.mutex.RUnlock()
+
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+
+	return client.ListAccountHolds(ctx, req)
+}
+
+func (c *GRPCAccountClient) SetInterestRate(ctx context.Context, req *protoacc.SetInterestRateRequest) (*protoacc.SetInterestRateResponse, error) {
+	if err := c.EnsureConnection(); err != nil {
+		return nil, err
+	}
+
+	c.mutex.RLock()
+	client := c.client
+	c.mutex.RUnlock()
+
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+
+	return client.SetInterestRate(ctx, req)
+}
+
+func (c *GRPCAccountClient) ListInterestRates(ctx context.Context, req *protoacc.ListInterestRatesRequest) (*protoacc.ListInterestRatesResponse, error) {
+	if err := c.EnsureConnection(); err != nil {
+		return nil, err
+	}
+
+	c.mutex.RLock()
+	client := c.client
+	c.mutex.RUnlock()
+
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+
+	return client.ListInterestRates(ctx, req)
+}
+
+func (c *GRPCAccountClient) GetAccountInterest(ctx context.Context, req *protoacc.GetAccountInterestRequest) (*protoacc.GetAccountInterestResponse, error) {
+	if err := c.EnsureConnection(); err != nil {
+		return nil, err
+	}
+
+	c.mutex.RLock()
+	client := c.client
+	c.mutex.RUnlock()
+
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+
+	return client.GetAccountInterest(ctx, req)
+}
+
+func (c *GRPCAccountClient) GenerateAccountStatement(ctx context.Context, req *protoacc.GenerateAccountStatementRequest) (*protoacc.GenerateAccountStatementResponse, error) {
+	if err := c.EnsureConnection(); err != nil {
+		return nil, err
+	}
+
+	c.mutex.RLock()
+	client := c.client
+	c.mutex.RUnlock()
+
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+
+	return client.GenerateAccountStatement(ctx, req)
+}
+
+func (c *GRPCAccountClient) ListAccountStatements(ctx context.Context, req *protoacc.ListAccountStatementsRequest) (*protoacc.ListAccountStatementsResponse, error) {
+	if err := c.EnsureConnection(); err != nil {
+		return nil, err
+	}
+
+	c.mutex.RLock()
+	client := c.client
+	c.mutex.RUnlock()
+
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+
+	return client.ListAccountStatements(ctx, req)
+}
+
+func (c *GRPCAccountClient) GetStatement(ctx context.Context, req *protoacc.GetStatementRequest) (*protoacc.GetStatementResponse, error) {
+	if err := c.EnsureConnection(); err != nil {
+		return nil, err
+	}
+
+	c.mutex.RLock()
+	client := c.client
+	c.mutex.RUnlock()
+
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+
+	return client.GetStatement(ctx, req)
+}
@@ -101,6 +101,141 @@ func (c *GRPCAuthClient) Authenticate(ctx context.Context, req *protoauth.Authen
 	return client.Authenticate(ctx, req)
 }
 
+func (c *GRPCAuthClient) VerifyMFA(ctx context.Context, req *protoauth.VerifyMFARequest) (*protoauth.VerifyMFAResponse, error) {
+	if err := c.EnsureConnection(); err != nil {
+		return nil, err
+	}
+
+	c.mutex.RLock()
+	client := c.client
+	c.mutex.RUnlock()
+
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+
+	return client.VerifyMFA(ctx, req)
+}
+
+func (c *GRPCAuthClient) RefreshToken(ctx context.Context, req *protoauth.RefreshTokenRequest) (*protoauth.RefreshTokenResponse, error) {
+	if err := c.EnsureConnection(); err != nil {
+		return nil, err
+	}
+
+	c.mutex.RLock()
+	client := c.client
+	c.mutex.RUnlock()
+
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+
+	return client.RefreshToken(ctx, req)
+}
+
+func (c *GRPCAuthClient) Logout(ctx context.Context, req *protoauth.LogoutRequest) (*protoauth.LogoutResponse, error) {
+	if err := c.EnsureConnection(); err != nil {
+		return nil, err
+	}
+
+	c.mutex.RLock()
+	client := c.client
+	c.mutex.RUnlock()
+
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+
+	return client.Logout(ctx, req)
+}
+
+func (c *GRPCAuthClient) ListTokenRevocations(ctx context.Context, req *protoauth.ListTokenRevocationsRequest) (*protoauth.ListTokenRevocationsResponse, error) {
+	if err := c.EnsureConnection(); err != nil {
+		return nil, err
+	}
+
+	c.mutex.RLock()
+	client := c.client
+	c.mutex.RUnlock()
+
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+
+	return client.ListTokenRevocations(ctx, req)
+}
+
+func (c *GRPCAuthClient) EnrollMFA(ctx context.Context, req *protoauth.EnrollMFARequest) (*protoauth.EnrollMFAResponse, error) {
+	if err := c.EnsureConnection(); err != nil {
+		return nil, err
+	}
+
+	c.mutex.RLock()
+	client := c.client
+	c.mutex.RUnlock()
+
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+
+	return client.EnrollMFA(ctx, req)
+}
+
+func (c *GRPCAuthClient) ConfirmMFA(ctx context.Context, req *protoauth.ConfirmMFARequest) (*protoauth.ConfirmMFAResponse, error) {
+	if err := c.EnsureConnection(); err != nil {
+		return nil, err
+	}
+
+	c.mutex.RLock()
+	client := c.client
+	c.mutex.RUnlock()
+
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+
+	return client.ConfirmMFA(ctx, req)
+}
+
+func (c *GRPCAuthClient) ResetMFA(ctx context.Context, req *protoauth.ResetMFARequest) (*protoauth.ResetMFAResponse, error) {
+	if err := c.EnsureConnection(); err != nil {
+		return nil, err
+	}
+
+	c.mutex.RLock()
+	client := c.client
+	c.mutex.RUnlock()
+
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+
+	return client.ResetMFA(ctx, req)
+}
+
+func (c *GRPCAuthClient) SetMFAPolicy(ctx context.Context, req *protoauth.SetMFAPolicyRequest) (*protoauth.SetMFAPolicyResponse, error) {
+	if err := c.EnsureConnection(); err != nil {
+		return nil, err
+	}
+
+	c.mutex.RLock()
+	client := c.client
+	c.mutex.RUnlock()
+
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+
+	return client.SetMFAPolicy(ctx, req)
+}
+
+func (c *GRPCAuthClient) ListMFAPolicies(ctx context.Context, req *protoauth.ListMFAPoliciesRequest) (*protoauth.ListMFAPoliciesResponse, error) {
+	if err := c.EnsureConnection(); err != nil {
+		return nil, err
+	}
+
+	c.mutex.RLock()
+	client := c.client
+	c.mutex.RUnlock()
+
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+
+	return client.ListMFAPolicies(ctx, req)
+}
+
 func (c *GRPCAuthClient) CreateEmployee(ctx context.Context, req *protoauth.CreateEmployeeRequest) (*protoauth.CreateEmployeeResponse, error) {
 	if err := c.EnsureConnection(); err != nil {
 		return nil, err
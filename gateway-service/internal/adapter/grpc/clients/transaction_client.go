@@ -2,6 +2,7 @@ package clients
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	prototx "gateway-service/api/protogen/txservice/proto"
 	"gateway-service/internal/logging"
@@ -9,6 +10,7 @@ import (
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/connectivity"
 	"google.golang.org/grpc/credentials/insecure"
+	"io"
 	"sync"
 	"time"
 )
@@ -142,3 +144,290 @@ func (c *GRPCTransactionClient) GetTransactionHistory(ctx context.Context, req *
 
 	return client.GetTransactionHistory(ctx, req)
 }
+
+// ExportTransactionHistory hands each received chunk to fn until the stream ends. The export is bounded by
+// the request context rather than the client timeout, since it may run for a long time.
+func (c *GRPCTransactionClient) ExportTransactionHistory(ctx context.Context, req *prototx.ExportTransactionHistoryRequest, fn func(*prototx.ExportTransactionHistoryResponse) error) error {
+	if err := c.EnsureConnection(); err != nil {
+		return err
+	}
+
+	c.mutex.RLock()
+	client := c.client
+	c.mutex.RUnlock()
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	stream, err := client.ExportTransactionHistory(ctx, req)
+	if err != nil {
+		return err
+	}
+	for {
+		chunk, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if err := fn(chunk); err != nil {
+			return err
+		}
+	}
+}
+
+func (c *GRPCTransactionClient) ReverseTransaction(ctx context.Context, req *prototx.ReverseTransactionRequest) (*prototx.ReverseTransactionResponse, error) {
+	if err := c.EnsureConnection(); err != nil {
+		return nil, err
+	}
+
+	c.mutex.RLock()
+	client := c.client
+	c.mutex.RUnlock()
+
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+
+	return client.ReverseTransaction(ctx, req)
+}
+
+func (c *GRPCTransactionClient) CaptureHold(ctx context.Context, req *prototx.CaptureHoldRequest) (*prototx.CaptureHoldResponse, error) {
+	if err := c.EnsureConnection(); err != nil {
+		return nil, err
+	}
+
+	c.mutex.RLock()
+	client := c.client
+	c.mutex.RUnlock()
+
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+
+	return client.CaptureHold(ctx, req)
+}
+
+func (c *GRPCTransactionClient) CloseAccount(ctx context.Context, req *prototx.CloseAccountRequest) (*prototx.CloseAccountResponse, error) {
+	if err := c.EnsureConnection(); err != nil {
+		return nil, err
+	}
+
+	c.mutex.RLock()
+	client := c.client
+	c.mutex.RUnlock()
+
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+
+	return client.CloseAccount(ctx, req)
+}
+
+func (c *GRPCTransactionClient) SetExchangeRate(ctx context.Context, req *prototx.SetExchangeRateRequest) (*prototx.SetExchangeRateResponse, error) {
+	if err := c.EnsureConnection(); err != nil {
+		return nil, err
+	}
+
+	c.mutex.RLock()
+	client := c.client
+	c.mutex.RUnlock()
+
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+
+	return client.SetExchangeRate(ctx, req)
+}
+
+func (c *GRPCTransactionClient) ListExchangeRates(ctx context.Context, req *prototx.ListExchangeRatesRequest) (*prototx.ListExchangeRatesResponse, error) {
+	if err := c.EnsureConnection(); err != nil {
+		return nil, err
+	}
+
+	c.mutex.RLock()
+	client := c.client
+	c.mutex.RUnlock()
+
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+
+	return client.ListExchangeRates(ctx, req)
+}
+
+func (c *GRPCTransactionClient) GetTransaction(ctx context.Context, req *prototx.GetTransactionRequest) (*prototx.GetTransactionResponse, error) {
+	if err := c.EnsureConnection(); err != nil {
+		return nil, err
+	}
+
+	c.mutex.RLock()
+	client := c.client
+	c.mutex.RUnlock()
+
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+
+	return client.GetTransaction(ctx, req)
+}
+
+func (c *GRPCTransactionClient) CreateStandingOrder(ctx context.Context, req *prototx.CreateStandingOrderRequest) (*prototx.CreateStandingOrderResponse, error) {
+	if err := c.EnsureConnection(); err != nil {
+		return nil, err
+	}
+
+	c.mutex.RLock()
+	client := c.client
+	c.mutex.RUnlock()
+
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+
+	return client.CreateStandingOrder(ctx, req)
+}
+
+func (c *GRPCTransactionClient) GetStandingOrder(ctx context.Context, req *prototx.GetStandingOrderRequest) (*prototx.GetStandingOrderResponse, error) {
+	if err := c.EnsureConnection(); err != nil {
+		return nil, err
+	}
+
+	c.mutex.RLock()
+	client := c.client
+	c.mutex.RUnlock()
+
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+
+	return client.GetStandingOrder(ctx, req)
+}
+
+func (c *GRPCTransactionClient) ListStandingOrders(ctx context.Context, req *prototx.ListStandingOrdersRequest) (*prototx.ListStandingOrdersResponse, error) {
+	if err := c.EnsureConnection(); err != nil {
+		return nil, err
+	}
+
+	c.mutex.RLock()
+	client := c.client
+	c.mutex.RUnlock()
+
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+
+	return client.ListStandingOrders(ctx, req)
+}
+
+func (c *GRPCTransactionClient) UpdateStandingOrderStatus(ctx context.Context, req *prototx.UpdateStandingOrderStatusRequest) (*prototx.UpdateStandingOrderStatusResponse, error) {
+	if err := c.EnsureConnection(); err != nil {
+		return nil, err
+	}
+
+	c.mutex.RLock()
+	client := c.client
+	c.mutex.RUnlock()
+
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+
+	return client.UpdateStandingOrderStatus(ctx, req)
+}
+
+func (c *GRPCTransactionClient) CreateTransactionBatch(ctx context.Context, req *prototx.CreateTransactionBatchRequest) (*prototx.CreateTransactionBatchResponse, error) {
+	if err := c.EnsureConnection(); err != nil {
+		return nil, err
+	}
+
+	c.mutex.RLock()
+	client := c.client
+	c.mutex.RUnlock()
+
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+
+	return client.CreateTransactionBatch(ctx, req)
+}
+
+func (c *GRPCTransactionClient) GetTransactionBatch(ctx context.Context, req *prototx.GetTransactionBatchRequest) (*prototx.GetTransactionBatchResponse, error) {
+	if err := c.EnsureConnection(); err != nil {
+		return nil, err
+	}
+
+	c.mutex.RLock()
+	client := c.client
+	c.mutex.RUnlock()
+
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+
+	return client.GetTransactionBatch(ctx, req)
+}
+
+func (c *GRPCTransactionClient) SetTransactionLimit(ctx context.Context, req *prototx.SetTransactionLimitRequest) (*prototx.SetTransactionLimitResponse, error) {
+	if err := c.EnsureConnection(); err != nil {
+		return nil, err
+	}
+
+	c.mutex.RLock()
+	client := c.client
+	c.mutex.RUnlock()
+
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+
+	return client.SetTransactionLimit(ctx, req)
+}
+
+func (c *GRPCTransactionClient) ListTransactionLimits(ctx context.Context, req *prototx.ListTransactionLimitsRequest) (*prototx.ListTransactionLimitsResponse, error) {
+	if err := c.EnsureConnection(); err != nil {
+		return nil, err
+	}
+
+	c.mutex.RLock()
+	client := c.client
+	c.mutex.RUnlock()
+
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+
+	return client.ListTransactionLimits(ctx, req)
+}
+
+func (c *GRPCTransactionClient) SetFeeRule(ctx context.Context, req *prototx.SetFeeRuleRequest) (*prototx.SetFeeRuleResponse, error) {
+	if err := c.EnsureConnection(); err != nil {
+		return nil, err
+	}
+
+	c.mutex.RLock()
+	client := c.client
+	c.mutex.RUnlock()
+
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+
+	return client.SetFeeRule(ctx, req)
+}
+
+func (c *GRPCTransactionClient) ListFeeRules(ctx context.Context, req *prototx.ListFeeRulesRequest) (*prototx.ListFeeRulesResponse, error) {
+	if err := c.EnsureConnection(); err != nil {
+		return nil, err
+	}
+
+	c.mutex.RLock()
+	client := c.client
+	c.mutex.RUnlock()
+
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+
+	return client.ListFeeRules(ctx, req)
+}
+
+func (c *GRPCTransactionClient) DeleteFeeRule(ctx context.Context, req *prototx.DeleteFeeRuleRequest) (*prototx.DeleteFeeRuleResponse, error) {
+	if err := c.EnsureConnection(); err != nil {
+		return nil, err
+	}
+
+	c.mutex.RLock()
+	client := c.client
+	c.mutex.RUnlock()
+
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+
+	return client.DeleteFeeRule(ctx, req)
+}
@@ -0,0 +1,185 @@
+package auth
+
+import (
+	"context"
+	"crypto/ed25519"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"gateway-service/internal/logging"
+	"net/http"
+	"sync"
+	"time"
+)
+
+const (
+	// keySetFetchTimeout bounds a single request to the JWKS endpoint
+	keySetFetchTimeout = 5 * time.Second
+	// keySetMinRefetchInterval limits the fetches triggered by tokens with an unknown kid
+	keySetMinRefetchInterval = 10 * time.Second
+)
+
+var ErrUnknownKey = errors.New("unknown signing key")
+
+// KeySet caches the public keys the auth service publishes as a JWKS document, by their key ID (kid). A token signed
+// with a key the set has not seen yet triggers a fetch, so a rotated key is accepted before the next scheduled
+// refresh. Keys the auth service no longer publishes are dropped on refresh.
+type KeySet struct {
+	url    string
+	client *http.Client
+
+	refreshMu sync.Mutex // serializes fetches
+	mu        sync.RWMutex
+	keys      map[string]ed25519.PublicKey
+	// lastKidFetch is the last fetch triggered by an unknown kid; scheduled refreshes do not count, so they cannot
+	// delay fetching a key that just started signing
+	lastKidFetch time.Time
+}
+
+type jsonWebKeySet struct {
+	Keys []jsonWebKey `json:"keys"`
+}
+
+type jsonWebKey struct {
+	KeyType   string `json:"kty"`
+	Curve     string `json:"crv"`
+	X         string `json:"x"`
+	KeyID     string `json:"kid"`
+	Algorithm string `json:"alg"`
+	Use       string `json:"use"`
+}
+
+// NewKeySet creates an empty KeySet reading from the JWKS endpoint at url.
+func NewKeySet(url string) *KeySet {
+	return &KeySet{
+		url:    url,
+		client: &http.Client{Timeout: keySetFetchTimeout},
+		keys:   make(map[string]ed25519.PublicKey),
+	}
+}
+
+// Key returns the public key with the ID, fetching the key set if it is unknown
+func (s *KeySet) Key(kid string) (ed25519.PublicKey, error) {
+	if key, ok := s.lookup(kid); ok {
+		return key, nil
+	}
+
+	s.refreshMu.Lock()
+	defer s.refreshMu.Unlock()
+
+	// Another fetch may have loaded the key in the meantime
+	if key, ok := s.lookup(kid); ok {
+		return key, nil
+	}
+
+	if time.Since(s.lastKidFetch) < keySetMinRefetchInterval {
+		return nil, ErrUnknownKey
+	}
+
+	s.lastKidFetch = time.Now()
+	if err := s.fetch(context.Background()); err != nil {
+		return nil, err
+	}
+
+	if key, ok := s.lookup(kid); ok {
+		return key, nil
+	}
+	return nil, ErrUnknownKey
+}
+
+// Refresh replaces the cached keys with the keys currently published by the auth service. The cached keys are kept
+// if the fetch fails.
+func (s *KeySet) Refresh(ctx context.Context) error {
+	s.refreshMu.Lock()
+	defer s.refreshMu.Unlock()
+
+	return s.fetch(ctx)
+}
+
+// StartRefresh refreshes the keys on the interval until the context is done
+func (s *KeySet) StartRefresh(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := s.Refresh(ctx); err != nil {
+				logging.Logger.Error().Err(err).Msg("Failed to refresh JWT verification keys from auth service")
+			}
+		}
+	}
+}
+
+func (s *KeySet) lookup(kid string) (ed25519.PublicKey, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	key, ok := s.keys[kid]
+	return key, ok
+}
+
+// fetch reads the JWKS document, the caller holds refreshMu
+func (s *KeySet) fetch(ctx context.Context) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, s.url, nil)
+	if err != nil {
+		return fmt.Errorf("failed to create JWKS request: %w", err)
+	}
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to fetch JWKS: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("failed to fetch JWKS: unexpected status %d", resp.StatusCode)
+	}
+
+	var set jsonWebKeySet
+	if err := json.NewDecoder(resp.Body).Decode(&set); err != nil {
+		return fmt.Errorf("failed to decode JWKS: %w", err)
+	}
+
+	keys := make(map[string]ed25519.PublicKey, len(set.Keys))
+	for _, jwk := range set.Keys {
+		key, err := jwk.publicKey()
+		if err != nil {
+			logging.Logger.Warn().Err(err).Str("kid", jwk.KeyID).Msg("Skipping unsupported JWT verification key")
+			continue
+		}
+		keys[jwk.KeyID] = key
+	}
+
+	s.mu.Lock()
+	s.keys = keys
+	s.mu.Unlock()
+
+	return nil
+}
+
+// publicKey decodes an Ed25519 signature key (RFC 8037), the only kind the auth service signs with
+func (k jsonWebKey) publicKey() (ed25519.PublicKey, error) {
+	if k.KeyType != "OKP" || k.Curve != "Ed25519" {
+		return nil, fmt.Errorf("unsupported key type %s/%s", k.KeyType, k.Curve)
+	}
+	if k.Use != "" && k.Use != "sig" {
+		return nil, fmt.Errorf("unsupported key use %s", k.Use)
+	}
+	if k.KeyID == "" {
+		return nil, errors.New("missing key ID")
+	}
+
+	x, err := base64.RawURLEncoding.DecodeString(k.X)
+	if err != nil {
+		return nil, fmt.Errorf("invalid public key: %w", err)
+	}
+	if len(x) != ed25519.PublicKeySize {
+		return nil, fmt.Errorf("invalid public key size %d", len(x))
+	}
+
+	return ed25519.PublicKey(x), nil
+}
@@ -0,0 +1,171 @@
+package auth
+
+import (
+	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/json"
+	"github.com/golang-jwt/jwt/v4"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+)
+
+// testJWKS serves the public keys of its signing keys as the auth service does
+type testJWKS struct {
+	mu       sync.Mutex
+	keys     map[string]ed25519.PrivateKey
+	requests int
+}
+
+func newTestJWKS(t *testing.T) (*testJWKS, *httptest.Server) {
+	jwks := &testJWKS{keys: make(map[string]ed25519.PrivateKey)}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		jwks.mu.Lock()
+		defer jwks.mu.Unlock()
+
+		jwks.requests++
+		set := jsonWebKeySet{Keys: []jsonWebKey{}}
+		for kid, key := range jwks.keys {
+			set.Keys = append(set.Keys, jsonWebKey{
+				KeyType:   "OKP",
+				Curve:     "Ed25519",
+				X:         base64.RawURLEncoding.EncodeToString(key.Public().(ed25519.PublicKey)),
+				KeyID:     kid,
+				Algorithm: "EdDSA",
+				Use:       "sig",
+			})
+		}
+		_ = json.NewEncoder(w).Encode(set)
+	}))
+	t.Cleanup(server.Close)
+	return jwks, server
+}
+
+func (j *testJWKS) addKey(t *testing.T, kid string) ed25519.PrivateKey {
+	_, key, err := ed25519.GenerateKey(rand.Reader)
+	assert.NoError(t, err)
+
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	j.keys[kid] = key
+	return key
+}
+
+func (j *testJWKS) removeKey(kid string) {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	delete(j.keys, kid)
+}
+
+func (j *testJWKS) requestCount() int {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	return j.requests
+}
+
+func signTestToken(t *testing.T, key ed25519.PrivateKey, kid, username string, expiresAt time.Time) string {
+	token := jwt.NewWithClaims(jwt.SigningMethodEdDSA, &Claims{
+		Username: username,
+		Role:     "admin",
+		RegisteredClaims: jwt.RegisteredClaims{
+			ID:        "jti-1",
+			ExpiresAt: jwt.NewNumericDate(expiresAt),
+			IssuedAt:  jwt.NewNumericDate(time.Now()),
+		},
+	})
+	token.Header["kid"] = kid
+	signed, err := token.SignedString(key)
+	assert.NoError(t, err)
+	return signed
+}
+
+// TestKeySet_Refresh tests that a refresh replaces the cached keys with the published keys
+func TestKeySet_Refresh(t *testing.T) {
+	jwks, server := newTestJWKS(t)
+	key := jwks.addKey(t, "kid-1")
+	jwks.addKey(t, "kid-2")
+
+	keys := NewKeySet(server.URL)
+	assert.NoError(t, keys.Refresh(context.Background()))
+
+	got, err := keys.Key("kid-1")
+	assert.NoError(t, err)
+	assert.Equal(t, key.Public(), got)
+
+	jwks.removeKey("kid-2")
+	assert.NoError(t, keys.Refresh(context.Background()))
+
+	_, ok := keys.lookup("kid-2")
+	assert.False(t, ok)
+}
+
+// TestKeySet_Refresh_Failure tests that the cached keys are kept while the JWKS endpoint fails
+func TestKeySet_Refresh_Failure(t *testing.T) {
+	jwks, server := newTestJWKS(t)
+	jwks.addKey(t, "kid-1")
+
+	keys := NewKeySet(server.URL)
+	assert.NoError(t, keys.Refresh(context.Background()))
+
+	server.Close()
+	assert.Error(t, keys.Refresh(context.Background()))
+
+	_, ok := keys.lookup("kid-1")
+	assert.True(t, ok)
+}
+
+// TestKeySet_Key_UnknownKidFetches tests that a rotated key is fetched on first use, right after a scheduled
+// refresh, and that unknown keys do not fetch more than once per interval
+func TestKeySet_Key_UnknownKidFetches(t *testing.T) {
+	jwks, server := newTestJWKS(t)
+	jwks.addKey(t, "kid-1")
+
+	keys := NewKeySet(server.URL)
+	assert.NoError(t, keys.Refresh(context.Background()))
+	assert.Equal(t, 1, jwks.requestCount())
+
+	// the auth service rotated after the last refresh
+	jwks.addKey(t, "kid-2")
+
+	_, err := keys.Key("kid-2")
+	assert.NoError(t, err)
+	assert.Equal(t, 2, jwks.requestCount())
+
+	_, err = keys.Key("kid-unknown")
+	assert.ErrorIs(t, err, ErrUnknownKey)
+	assert.Equal(t, 2, jwks.requestCount())
+
+	// a scheduled refresh neither resets nor extends the limit
+	assert.NoError(t, keys.Refresh(context.Background()))
+	jwks.addKey(t, "kid-3")
+	keys.lastKidFetch = time.Now().Add(-keySetMinRefetchInterval)
+
+	_, err = keys.Key("kid-3")
+	assert.NoError(t, err)
+	assert.Equal(t, 4, jwks.requestCount())
+}
+
+// TestKeySet_SkipsUnsupportedKeys tests that keys other than Ed25519 signature keys are ignored
+func TestKeySet_SkipsUnsupportedKeys(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte(`{"keys":[
+			{"kty":"RSA","kid":"rsa","n":"AQAB","e":"AQAB"},
+			{"kty":"OKP","crv":"Ed25519","kid":"short","x":"AAAA","use":"sig"},
+			{"kty":"OKP","crv":"Ed25519","kid":"enc","x":"11qYAYKxCrfVS_7TyWQHOg7hcvPapiMlrwIaaPcHURo","use":"enc"},
+			{"kty":"OKP","crv":"Ed25519","kid":"ok","x":"11qYAYKxCrfVS_7TyWQHOg7hcvPapiMlrwIaaPcHURo","use":"sig"}
+		]}`))
+	}))
+	defer server.Close()
+
+	keys := NewKeySet(server.URL)
+	assert.NoError(t, keys.Refresh(context.Background()))
+
+	assert.Len(t, keys.keys, 1)
+	_, ok := keys.lookup("ok")
+	assert.True(t, ok)
+}
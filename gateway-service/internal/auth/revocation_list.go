@@ -0,0 +1,164 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	protoauth "gateway-service/api/protogen/authservice/proto"
+	"gateway-service/internal/logging"
+	"gateway-service/internal/ports"
+	"sync"
+	"time"
+)
+
+// revocationSyncPageSize is the number of revocations read from the auth service per request
+const revocationSyncPageSize = 500
+
+// RevocationList keeps the access tokens revoked by the auth service before they expire, so the gateway can reject
+// them without calling the auth service on every request. It follows the revocation feed of the auth service by its
+// sequence. Tokens are revoked one by one on logout, or all tokens issued to an employee before a point in time when
+// the employee is deleted or their role changes.
+type RevocationList struct {
+	mu        sync.RWMutex
+	tokens    map[string]time.Time // token ID (jti) -> expiry
+	employees map[string]employeeRevocation
+	sequence  uint64
+}
+
+type employeeRevocation struct {
+	revokedBefore time.Time
+	expiresAt     time.Time
+}
+
+// NewRevocationList creates an empty RevocationList.
+func NewRevocationList() *RevocationList {
+	return &RevocationList{
+		tokens:    make(map[string]time.Time),
+		employees: make(map[string]employeeRevocation),
+	}
+}
+
+// RevokeToken revokes the token with the ID until it expires
+func (l *RevocationList) RevokeToken(tokenID string, expiresAt time.Time) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.tokens[tokenID] = expiresAt
+}
+
+// RevokeEmployee revokes the tokens of the employee issued before revokedBefore. The revocation is kept until
+// expiresAt, when all of these tokens have expired.
+func (l *RevocationList) RevokeEmployee(username string, revokedBefore, expiresAt time.Time) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	current, ok := l.employees[username]
+	if ok && current.revokedBefore.After(revokedBefore) {
+		revokedBefore = current.revokedBefore
+	}
+	if ok && current.expiresAt.After(expiresAt) {
+		expiresAt = current.expiresAt
+	}
+	l.employees[username] = employeeRevocation{revokedBefore: revokedBefore, expiresAt: expiresAt}
+}
+
+// IsRevoked reports whether the token of the claims has been revoked. The issued at claim only has a precision of
+// seconds, so a revocation of an employee also covers the tokens issued in the same second after it.
+func (l *RevocationList) IsRevoked(claims *Claims) bool {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+
+	if claims.ID != "" {
+		if _, ok := l.tokens[claims.ID]; ok {
+			return true
+		}
+	}
+
+	revocation, ok := l.employees[claims.Username]
+	if !ok {
+		return false
+	}
+	if claims.IssuedAt == nil {
+		return true
+	}
+	return !claims.IssuedAt.Time.After(revocation.revokedBefore.Truncate(time.Second))
+}
+
+// Sync reads the revocations the list has not seen yet from the auth service
+func (l *RevocationList) Sync(ctx context.Context, authClient ports.AuthClient) error {
+	for {
+		l.mu.RLock()
+		sequence := l.sequence
+		l.mu.RUnlock()
+
+		resp, err := authClient.ListTokenRevocations(ctx, &protoauth.ListTokenRevocationsRequest{
+			AfterSequence: sequence,
+			Limit:         revocationSyncPageSize,
+		})
+		if err != nil {
+			return err
+		}
+		if !resp.Success {
+			return errors.New(resp.Message)
+		}
+
+		l.apply(resp.Revocations)
+
+		if len(resp.Revocations) < revocationSyncPageSize {
+			return nil
+		}
+	}
+}
+
+// StartSync keeps the list in sync with the auth service until the context is done and forgets expired
+// revocations. While the auth service cannot be reached, the revocations known so far stay in force.
+func (l *RevocationList) StartSync(ctx context.Context, authClient ports.AuthClient, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		if err := l.Sync(ctx, authClient); err != nil {
+			logging.Logger.Error().Err(err).Msg("Failed to sync token revocations from auth service")
+		}
+		l.prune(time.Now())
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (l *RevocationList) apply(revocations []*protoauth.TokenRevocation) {
+	for _, revocation := range revocations {
+		expiresAt := revocation.GetExpiresAt().AsTime()
+		if revocation.GetTokenId() != "" {
+			l.RevokeToken(revocation.GetTokenId(), expiresAt)
+		} else {
+			l.RevokeEmployee(revocation.GetUsername(), revocation.GetRevokedBefore().AsTime(), expiresAt)
+		}
+
+		l.mu.Lock()
+		if revocation.GetSequence() > l.sequence {
+			l.sequence = revocation.GetSequence()
+		}
+		l.mu.Unlock()
+	}
+}
+
+// prune forgets the revocations of tokens that have expired at the given time
+func (l *RevocationList) prune(at time.Time) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	for tokenID, expiresAt := range l.tokens {
+		if !at.Before(expiresAt) {
+			delete(l.tokens, tokenID)
+		}
+	}
+	for username, revocation := range l.employees {
+		if !at.Before(revocation.expiresAt) {
+			delete(l.employees, username)
+		}
+	}
+}
@@ -0,0 +1,101 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	protoauth "gateway-service/api/protogen/authservice/proto"
+	mock_client "gateway-service/internal/ports/mocks/grpc_client"
+	"github.com/golang-jwt/jwt/v4"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"testing"
+	"time"
+)
+
+func newClaims(tokenID, username string, issuedAt time.Time) *Claims {
+	return &Claims{
+		Username: username,
+		RegisteredClaims: jwt.RegisteredClaims{
+			ID:       tokenID,
+			IssuedAt: jwt.NewNumericDate(issuedAt),
+		},
+	}
+}
+
+// TestRevocationList_RevokeToken tests that only the revoked token is rejected
+func TestRevocationList_RevokeToken(t *testing.T) {
+	list := NewRevocationList()
+	list.RevokeToken("jti-1", time.Now().Add(time.Minute))
+
+	assert.True(t, list.IsRevoked(newClaims("jti-1", "testuser", time.Now())))
+	assert.False(t, list.IsRevoked(newClaims("jti-2", "testuser", time.Now())))
+	assert.False(t, list.IsRevoked(newClaims("", "testuser", time.Now())))
+}
+
+// TestRevocationList_RevokeEmployee tests that the tokens of an employee issued before the revocation are rejected
+func TestRevocationList_RevokeEmployee(t *testing.T) {
+	list := NewRevocationList()
+	revokedBefore := time.Now()
+	list.RevokeEmployee("testuser", revokedBefore, revokedBefore.Add(time.Minute))
+
+	assert.True(t, list.IsRevoked(newClaims("jti-1", "testuser", revokedBefore.Add(-time.Minute))))
+	assert.True(t, list.IsRevoked(newClaims("jti-2", "testuser", revokedBefore)))
+	assert.False(t, list.IsRevoked(newClaims("jti-3", "testuser", revokedBefore.Add(2*time.Second))))
+	assert.False(t, list.IsRevoked(newClaims("jti-4", "otheruser", revokedBefore.Add(-time.Minute))))
+
+	// an earlier revocation arriving late does not shorten the later one
+	list.RevokeEmployee("testuser", revokedBefore.Add(-time.Hour), revokedBefore.Add(time.Second))
+	assert.True(t, list.IsRevoked(newClaims("jti-1", "testuser", revokedBefore.Add(-time.Minute))))
+}
+
+// TestRevocationList_Prune tests that revocations are forgotten once their tokens have expired
+func TestRevocationList_Prune(t *testing.T) {
+	list := NewRevocationList()
+	now := time.Now()
+	list.RevokeToken("jti-1", now.Add(time.Minute))
+	list.RevokeEmployee("testuser", now, now.Add(time.Minute))
+
+	list.prune(now)
+	assert.True(t, list.IsRevoked(newClaims("jti-1", "otheruser", now)))
+	assert.True(t, list.IsRevoked(newClaims("jti-2", "testuser", now.Add(-time.Minute))))
+
+	list.prune(now.Add(time.Minute))
+	assert.False(t, list.IsRevoked(newClaims("jti-1", "otheruser", now)))
+	assert.False(t, list.IsRevoked(newClaims("jti-2", "testuser", now.Add(-time.Minute))))
+}
+
+// TestRevocationList_Sync tests following the revocation feed of the auth service page by page
+func TestRevocationList_Sync(t *testing.T) {
+	mockClient := new(mock_client.MockAuthClient)
+	list := NewRevocationList()
+	ctx := context.Background()
+	expiresAt := timestamppb.New(time.Now().Add(time.Minute))
+
+	page := make([]*protoauth.TokenRevocation, revocationSyncPageSize)
+	for i := range page {
+		page[i] = &protoauth.TokenRevocation{Sequence: uint64(i + 1), TokenId: "jti-page", Username: "testuser", ExpiresAt: expiresAt}
+	}
+	revokedBefore := time.Now()
+	mockClient.On("ListTokenRevocations", ctx, &protoauth.ListTokenRevocationsRequest{AfterSequence: 0, Limit: revocationSyncPageSize}).
+		Return(&protoauth.ListTokenRevocationsResponse{Revocations: page, Success: true}, nil).Once()
+	mockClient.On("ListTokenRevocations", ctx, &protoauth.ListTokenRevocationsRequest{AfterSequence: revocationSyncPageSize, Limit: revocationSyncPageSize}).
+		Return(&protoauth.ListTokenRevocationsResponse{Revocations: []*protoauth.TokenRevocation{
+			{Sequence: revocationSyncPageSize + 1, Username: "otheruser", RevokedBefore: timestamppb.New(revokedBefore), ExpiresAt: expiresAt},
+		}, Success: true}, nil).Once()
+
+	err := list.Sync(ctx, mockClient)
+
+	assert.NoError(t, err)
+	assert.True(t, list.IsRevoked(newClaims("jti-page", "testuser", time.Now())))
+	assert.True(t, list.IsRevoked(newClaims("jti-other", "otheruser", revokedBefore.Add(-time.Minute))))
+	mockClient.AssertExpectations(t)
+
+	// the next sync continues after the last sequence
+	mockClient.On("ListTokenRevocations", ctx, &protoauth.ListTokenRevocationsRequest{AfterSequence: revocationSyncPageSize + 1, Limit: revocationSyncPageSize}).
+		Return(nil, errors.New("connection refused")).Once()
+
+	err = list.Sync(ctx, mockClient)
+
+	assert.Error(t, err)
+	assert.True(t, list.IsRevoked(newClaims("jti-page", "testuser", time.Now())))
+}
@@ -5,9 +5,9 @@ import (
 	"github.com/golang-jwt/jwt/v4"
 )
 
-// TokenSigner is responsible for signing JWT tokens.
+// TokenSigner is responsible for verifying JWT tokens signed by the auth service.
 type TokenSigner struct {
-	secretKey string
+	keys *KeySet
 }
 
 // Claims defines the structure of JWT claims.
@@ -17,20 +17,24 @@ type Claims struct {
 	jwt.RegisteredClaims
 }
 
-// NewTokenSigner creates a new instance of TokenSigner.
-func NewTokenSigner(secretKey string) *TokenSigner {
+// NewTokenSigner creates a new instance of TokenSigner verifying with the keys of the key set.
+func NewTokenSigner(keys *KeySet) *TokenSigner {
 	return &TokenSigner{
-		secretKey: secretKey,
+		keys: keys,
 	}
 }
 
 func (t *TokenSigner) ParseJWT(tokenString string) (*Claims, error) {
 	token, err := jwt.ParseWithClaims(tokenString, &Claims{}, func(token *jwt.Token) (interface{}, error) {
-		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
+		if _, ok := token.Method.(*jwt.SigningMethodEd25519); !ok {
 			return nil, fmt.Errorf("unexpected signing method: %v", token.Header["alg"])
 		}
-		// Return the secret key for validation
-		return []byte(t.secretKey), nil
+		// Return the public key named by the kid header for validation
+		kid, _ := token.Header["kid"].(string)
+		if kid == "" {
+			return nil, fmt.Errorf("missing kid header")
+		}
+		return t.keys.Key(kid)
 	})
 
 	if err != nil {
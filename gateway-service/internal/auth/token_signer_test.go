@@ -0,0 +1,91 @@
+package auth
+
+import (
+	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"github.com/golang-jwt/jwt/v4"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+// TestTokenSigner_ParseJWT tests that a token signed with a published key is accepted
+func TestTokenSigner_ParseJWT(t *testing.T) {
+	jwks, server := newTestJWKS(t)
+	key := jwks.addKey(t, "kid-1")
+
+	keys := NewKeySet(server.URL)
+	assert.NoError(t, keys.Refresh(context.Background()))
+
+	claims, err := NewTokenSigner(keys).ParseJWT(signTestToken(t, key, "kid-1", "testuser", time.Now().Add(time.Minute)))
+
+	assert.NoError(t, err)
+	assert.Equal(t, "testuser", claims.Username)
+	assert.Equal(t, "admin", claims.Role)
+	assert.Equal(t, "jti-1", claims.ID)
+}
+
+// TestTokenSigner_ParseJWT_RotationOverlap tests that tokens of the retired and the new key are both accepted
+func TestTokenSigner_ParseJWT_RotationOverlap(t *testing.T) {
+	jwks, server := newTestJWKS(t)
+	retired := jwks.addKey(t, "kid-1")
+
+	keys := NewKeySet(server.URL)
+	assert.NoError(t, keys.Refresh(context.Background()))
+	signer := NewTokenSigner(keys)
+
+	current := jwks.addKey(t, "kid-2")
+
+	_, err := signer.ParseJWT(signTestToken(t, current, "kid-2", "testuser", time.Now().Add(time.Minute)))
+	assert.NoError(t, err)
+	_, err = signer.ParseJWT(signTestToken(t, retired, "kid-1", "testuser", time.Now().Add(time.Minute)))
+	assert.NoError(t, err)
+
+	// once the overlap is over the retired key is no longer published
+	jwks.removeKey("kid-1")
+	assert.NoError(t, keys.Refresh(context.Background()))
+	_, err = signer.ParseJWT(signTestToken(t, retired, "kid-1", "testuser", time.Now().Add(time.Minute)))
+	assert.Error(t, err)
+}
+
+// TestTokenSigner_ParseJWT_Invalid tests tokens that must be rejected
+func TestTokenSigner_ParseJWT_Invalid(t *testing.T) {
+	jwks, server := newTestJWKS(t)
+	key := jwks.addKey(t, "kid-1")
+
+	keys := NewKeySet(server.URL)
+	assert.NoError(t, keys.Refresh(context.Background()))
+
+	_, otherKey, err := ed25519.GenerateKey(rand.Reader)
+	assert.NoError(t, err)
+
+	hmacToken := jwt.NewWithClaims(jwt.SigningMethodHS256, &Claims{Username: "testuser"})
+	hmacToken.Header["kid"] = "kid-1"
+	hmacSigned, err := hmacToken.SignedString([]byte("shared-secret"))
+	assert.NoError(t, err)
+
+	noKidToken := jwt.NewWithClaims(jwt.SigningMethodEdDSA, &Claims{Username: "testuser"})
+	noKidSigned, err := noKidToken.SignedString(key)
+	assert.NoError(t, err)
+
+	tests := []struct {
+		name  string
+		token string
+	}{
+		{"HMAC", hmacSigned},
+		{"MissingKid", noKidSigned},
+		{"UnknownKid", signTestToken(t, key, "kid-unknown", "testuser", time.Now().Add(time.Minute))},
+		{"WrongKey", signTestToken(t, otherKey, "kid-1", "testuser", time.Now().Add(time.Minute))},
+		{"Expired", signTestToken(t, key, "kid-1", "testuser", time.Now().Add(-time.Minute))},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			claims, err := NewTokenSigner(keys).ParseJWT(tt.token)
+
+			assert.Error(t, err)
+			assert.Nil(t, claims)
+		})
+	}
+}
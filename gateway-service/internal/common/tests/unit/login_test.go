@@ -20,7 +20,7 @@ func TestLoginAPI_Success(t *testing.T) {
 	gin.SetMode(gin.TestMode)
 
 	mockClient := &mock_grpc_client.MockAuthClient{}
-	handler := handlers.NewAuthHandler(mockClient)
+	handler := handlers.NewAuthHandler(mockClient, nil)
 
 	// Test request
 	loginRequest := map[string]string{
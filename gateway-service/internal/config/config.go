@@ -27,6 +27,7 @@ import (
 	"path/filepath"
 	"strings"
 	"sync"
+	"time"
 )
 
 const (
@@ -54,8 +55,10 @@ type Config struct {
 }
 
 type AuthConfig struct {
-	HashKey   string `koanf:"hash_key"`
-	JWTSecret string `koanf:"jwt_secret"`
+	HashKey                string        `koanf:"hash_key"`
+	RevocationSyncInterval time.Duration `koanf:"revocation_sync_interval" validate:"gt=0"` // polling of revoked tokens
+	JWKSURL                string        `koanf:"jwks_url" validate:"required,url"`         // JWT verification keys
+	JWKSRefreshInterval    time.Duration `koanf:"jwks_refresh_interval" validate:"gt=0"`
 }
 
 type GrpcConfig struct {
@@ -254,8 +257,10 @@ func defaults() map[string]any {
 			},
 		},
 		"auth": map[string]any{
-			"hash_key":   "fc5c6816998c7173ba5bc7a3c53bfabf",
-			"jwt_secret": "fc5c6816998c7173ba5bc7a3c53bfabf",
+			"hash_key":                 "fc5c6816998c7173ba5bc7a3c53bfabf",
+			"revocation_sync_interval": 5 * time.Second,
+			"jwks_url":                 "http://localhost:8081/.well-known/jwks.json",
+			"jwks_refresh_interval":    5 * time.Minute,
 		},
 	}
 }
@@ -0,0 +1,263 @@
+package handlers
+
+import (
+	"errors"
+	protoacc "gateway-service/api/protogen/accountservice/proto"
+	prototx "gateway-service/api/protogen/txservice/proto"
+	"gateway-service/internal/logging"
+	"github.com/gin-gonic/gin"
+	"net/http"
+	"strings"
+)
+
+const (
+	accountStatusActive = "active"
+	accountStatusLocked = "locked"
+)
+
+type FreezeAccountRequest struct {
+	FreezeMode string `json:"freeze_mode"` // debit, credit or both, defaults to both
+	Reason     string `json:"reason" binding:"required"`
+}
+
+type UnfreezeAccountRequest struct {
+	Reason string `json:"reason" binding:"required"`
+}
+
+type CloseAccountRequest struct {
+	PayoutAccountID string `json:"payout_account_id"` // receives the balance, optional when the balance is zero
+	Reason          string `json:"reason" binding:"required"`
+}
+
+type AccountStatusResponse struct {
+	Account interface{} `json:"account"`
+	Message string      `json:"message" binding:"message"`
+}
+
+type CloseAccountResponse struct {
+	Transaction interface{} `json:"transaction"` // the payout, null when there was no balance to pay out
+	Message     string      `json:"message" binding:"message"`
+}
+
+// FreezeAccount blocks debits, credits or both on an account
+// @Tags Account
+// @Summary Freeze Account
+// @Description
+// @Description **Path Parameter:**
+// @Description
+// @Description id:
+// @Description - Required
+// @Description - AccountID, the account must not be closed
+// @Description
+// @Description **Request Body:**
+// @Description
+// @Description Freeze Mode:
+// @Description - Optional
+// @Description - Allowed values: **debit**, **credit**, **both**
+// @Description - Default: both
+// @Description - A frozen account can be frozen again to change the mode
+// @Description
+// @Description Reason:
+// @Description - Required
+// @Description
+// @Description Compensations of failed transactions are still applied to a frozen account.
+// @Description
+// @Description **Header:**
+// @Description
+// @Description Authorization:
+// @Description - Required
+// @Description - Format: Bearer token
+// @Description - Admin only
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer token" default(Bearer )
+// @Param id path string true "AccountID"
+// @Param freeze body FreezeAccountRequest true "Freeze details"
+// @Success 200 {object} AccountStatusResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Router /api/v1/account/{id}/freeze [post]
+func (h *AccountHandler) FreezeAccount(c *gin.Context) {
+	var req FreezeAccountRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		logging.Logger.Warn().Err(err).Msg("invalid request param")
+		c.JSON(http.StatusBadRequest, ErrorResponse{Error: "Invalid request payload"})
+		return
+	}
+
+	freezeMode := strings.TrimSpace(req.FreezeMode)
+	if freezeMode == "" {
+		freezeMode = "both"
+	}
+	h.updateAccountStatus(c, accountStatusLocked, freezeMode, req.Reason)
+}
+
+// UnfreezeAccount makes a frozen or dormant account active again
+// @Tags Account
+// @Summary Unfreeze Account
+// @Description
+// @Description **Path Parameter:**
+// @Description
+// @Description id:
+// @Description - Required
+// @Description - AccountID, the account must be **frozen** or **dormant**
+// @Description
+// @Description **Request Body:**
+// @Description
+// @Description Reason:
+// @Description - Required
+// @Description
+// @Description **Header:**
+// @Description
+// @Description Authorization:
+// @Description - Required
+// @Description - Format: Bearer token
+// @Description - Admin only
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer token" default(Bearer )
+// @Param id path string true "AccountID"
+// @Param unfreeze body UnfreezeAccountRequest true "Unfreeze details"
+// @Success 200 {object} AccountStatusResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Router /api/v1/account/{id}/unfreeze [post]
+func (h *AccountHandler) UnfreezeAccount(c *gin.Context) {
+	var req UnfreezeAccountRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		logging.Logger.Warn().Err(err).Msg("invalid request param")
+		c.JSON(http.StatusBadRequest, ErrorResponse{Error: "Invalid request payload"})
+		return
+	}
+
+	h.updateAccountStatus(c, accountStatusActive, "", req.Reason)
+}
+
+func (h *AccountHandler) updateAccountStatus(c *gin.Context, status, freezeMode, reason string) {
+	un, _ := c.Get("username") // middleware
+	requester, ok := un.(string)
+	if !ok {
+		logging.Logger.Warn().Err(errors.New("unable to get requester username")).Msg("requester: " + requester)
+	}
+
+	grpcReq := &protoacc.UpdateAccountStatusRequest{
+		AccountId:     strings.TrimSpace(c.Param("id")),
+		AccountStatus: status,
+		FreezeMode:    freezeMode,
+		Reason:        reason,
+		Metadata: &protoacc.Metadata{
+			RequestId: c.GetHeader("X-Request-ID"),
+			Requester: requester,
+		},
+	}
+
+	resp, err := h.AccountClient.UpdateAccountStatus(c.Request.Context(), grpcReq)
+	if err != nil || resp == nil {
+		logging.Logger.Error().Err(err).Str("account_status", status).Msg("failed to update account status")
+		c.JSON(http.StatusUnauthorized, ErrorResponse{Error: "Invalid request"})
+		return
+	}
+
+	if !resp.Response.Success {
+		logging.Logger.Error().Err(errors.New(resp.Response.Message)).Str("account_status", status).Msg("unable to update account status")
+		c.JSON(accountStatusErrorStatus(resp.Response.Message), ErrorResponse{Error: resp.Response.Message})
+		return
+	}
+
+	res := AccountStatusResponse{
+		Account: resp.Account,
+		Message: resp.Response.Message,
+	}
+
+	c.JSON(http.StatusOK, res)
+}
+
+// CloseAccount pays out the balance of an account and closes it
+// @Tags Account
+// @Summary Close Account
+// @Description
+// @Description **Path Parameter:**
+// @Description
+// @Description id:
+// @Description - Required
+// @Description - AccountID, the account must be **active**, without active holds and not overdrawn
+// @Description
+// @Description **Request Body:**
+// @Description
+// @Description Payout Account ID:
+// @Description - Required when the balance, including the accrued interest, is not zero
+// @Description - The full balance is transferred to this account before closing
+// @Description
+// @Description Reason:
+// @Description - Required
+// @Description
+// @Description The payout is a regular transfer with the reference **account-closure:{account id}:{id}**. The interest accrued up to the last accrued day is credited first and paid out with the balance. If the account receives funds before it is closed, the closure fails and can be repeated to pay out the rest. A closed account cannot be reopened.
+// @Description
+// @Description **Header:**
+// @Description
+// @Description Authorization:
+// @Description - Required
+// @Description - Format: Bearer token
+// @Description - Admin only
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer token" default(Bearer )
+// @Param id path string true "AccountID"
+// @Param close body CloseAccountRequest true "Closure details"
+// @Success 200 {object} CloseAccountResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Router /api/v1/account/{id}/close [post]
+func (h *TransactionHandler) CloseAccount(c *gin.Context) {
+	var req CloseAccountRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		logging.Logger.Warn().Err(err).Msg("invalid request param")
+		c.JSON(http.StatusBadRequest, ErrorResponse{Error: "Invalid request payload"})
+		return
+	}
+
+	un, _ := c.Get("username") // middleware
+	requester, ok := un.(string)
+	if !ok {
+		logging.Logger.Warn().Err(errors.New("unable to get requester username")).Msg("requester: " + requester)
+	}
+
+	grpcReq := &prototx.CloseAccountRequest{
+		AccountId:       strings.TrimSpace(c.Param("id")),
+		PayoutAccountId: strings.TrimSpace(req.PayoutAccountID),
+		Reason:          req.Reason,
+		Metadata: &prototx.Metadata{
+			RequestId: c.GetHeader("X-Request-ID"),
+			Requester: requester,
+		},
+	}
+
+	resp, err := h.TransactionClient.CloseAccount(c.Request.Context(), grpcReq)
+	if err != nil || resp == nil {
+		logging.Logger.Error().Err(err).Msg("failed to close account")
+		c.JSON(http.StatusUnauthorized, ErrorResponse{Error: "Invalid request"})
+		return
+	}
+
+	if !resp.Response.Success {
+		logging.Logger.Error().Err(errors.New(resp.Response.Message)).Msg("unable to close account")
+		c.JSON(http.StatusBadRequest, ErrorResponse{Error: resp.Response.Message})
+		return
+	}
+
+	res := CloseAccountResponse{
+		Transaction: resp.Transaction,
+		Message:     resp.Response.Message,
+	}
+
+	c.JSON(http.StatusOK, res)
+}
+
+func accountStatusErrorStatus(message string) int {
+	if message == "Account not found" {
+		return http.StatusNotFound
+	}
+	return http.StatusBadRequest
+}
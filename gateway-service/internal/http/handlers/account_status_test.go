@@ -0,0 +1,182 @@
+package handlers
+
+import (
+	"bytes"
+	"encoding/json"
+	protoacc "gateway-service/api/protogen/accountservice/proto"
+	prototx "gateway-service/api/protogen/txservice/proto"
+	mock_client "gateway-service/internal/ports/mocks/grpc_client"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func setupAccountStatusRoutes(accountHandler *AccountHandler, txHandler *TransactionHandler) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+
+	router.Use(func(c *gin.Context) {
+		c.Set("username", "test-admin")
+		c.Next()
+	})
+
+	router.POST("/api/v1/account/:id/freeze", accountHandler.FreezeAccount)
+	router.POST("/api/v1/account/:id/unfreeze", accountHandler.UnfreezeAccount)
+	router.POST("/api/v1/account/:id/close", txHandler.CloseAccount)
+
+	return router
+}
+
+// TestFreezeAccount_Success tests that freezing locks the account with the given mode
+func TestFreezeAccount_Success(t *testing.T) {
+	mockClient := new(mock_client.MockAccountClient)
+	router := setupAccountStatusRoutes(&AccountHandler{AccountClient: mockClient}, &TransactionHandler{})
+
+	mockClient.On("UpdateAccountStatus", mock.Anything, mock.MatchedBy(func(req *protoacc.UpdateAccountStatusRequest) bool {
+		return req.AccountId == "acc-123" &&
+			req.AccountStatus == "locked" &&
+			req.FreezeMode == "debit" &&
+			req.Reason == "suspected fraud" &&
+			req.Metadata.Requester == "test-admin"
+	})).Return(&protoacc.UpdateAccountStatusResponse{
+		Account: &protoacc.Account{Id: "acc-123", ActiveStatus: "locked", FreezeMode: "debit"},
+		Response: &protoacc.Response{
+			Success: true,
+			Message: "Account frozen",
+		},
+	}, nil)
+
+	body := []byte(`{"freeze_mode": "debit", "reason": "suspected fraud"}`)
+	req, _ := http.NewRequest("POST", "/api/v1/account/acc-123/freeze", bytes.NewBuffer(body))
+	req.Header.Set("Content-Type", "application/json")
+
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	var response map[string]interface{}
+	err := json.Unmarshal(w.Body.Bytes(), &response)
+	assert.NoError(t, err)
+	assert.Equal(t, "Account frozen", response["message"])
+	account := response["account"].(map[string]interface{})
+	assert.Equal(t, "debit", account["freeze_mode"])
+
+	mockClient.AssertExpectations(t)
+}
+
+// TestFreezeAccount_DefaultsToBoth tests that a freeze without a mode blocks debits and credits
+func TestFreezeAccount_DefaultsToBoth(t *testing.T) {
+	mockClient := new(mock_client.MockAccountClient)
+	router := setupAccountStatusRoutes(&AccountHandler{AccountClient: mockClient}, &TransactionHandler{})
+
+	mockClient.On("UpdateAccountStatus", mock.Anything, mock.MatchedBy(func(req *protoacc.UpdateAccountStatusRequest) bool {
+		return req.AccountStatus == "locked" && req.FreezeMode == "both"
+	})).Return(&protoacc.UpdateAccountStatusResponse{
+		Response: &protoacc.Response{Success: true, Message: "Account frozen"},
+	}, nil)
+
+	req, _ := http.NewRequest("POST", "/api/v1/account/acc-123/freeze", bytes.NewBuffer([]byte(`{"reason": "court order"}`)))
+	req.Header.Set("Content-Type", "application/json")
+
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	mockClient.AssertExpectations(t)
+}
+
+// TestFreezeAccount_MissingReason tests that a freeze needs a reason
+func TestFreezeAccount_MissingReason(t *testing.T) {
+	mockClient := new(mock_client.MockAccountClient)
+	router := setupAccountStatusRoutes(&AccountHandler{AccountClient: mockClient}, &TransactionHandler{})
+
+	req, _ := http.NewRequest("POST", "/api/v1/account/acc-123/freeze", bytes.NewBuffer([]byte(`{"freeze_mode": "both"}`)))
+	req.Header.Set("Content-Type", "application/json")
+
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	mockClient.AssertNotCalled(t, "UpdateAccountStatus", mock.Anything, mock.Anything)
+}
+
+// TestUnfreezeAccount_NotFound tests that unfreezing an unknown account returns not found
+func TestUnfreezeAccount_NotFound(t *testing.T) {
+	mockClient := new(mock_client.MockAccountClient)
+	router := setupAccountStatusRoutes(&AccountHandler{AccountClient: mockClient}, &TransactionHandler{})
+
+	mockClient.On("UpdateAccountStatus", mock.Anything, mock.MatchedBy(func(req *protoacc.UpdateAccountStatusRequest) bool {
+		return req.AccountId == "acc-404" && req.AccountStatus == "active" && req.FreezeMode == "" && req.Reason == "cleared"
+	})).Return(&protoacc.UpdateAccountStatusResponse{
+		Response: &protoacc.Response{Success: false, Message: "Account not found"},
+	}, nil)
+
+	req, _ := http.NewRequest("POST", "/api/v1/account/acc-404/unfreeze", bytes.NewBuffer([]byte(`{"reason": "cleared"}`)))
+	req.Header.Set("Content-Type", "application/json")
+
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusNotFound, w.Code)
+	mockClient.AssertExpectations(t)
+}
+
+// TestCloseAccount_Success tests closing an account with a payout of its balance
+func TestCloseAccount_Success(t *testing.T) {
+	mockClient := new(mock_client.MockTransactionClient)
+	router := setupAccountStatusRoutes(&AccountHandler{}, &TransactionHandler{TransactionClient: mockClient})
+
+	mockClient.On("CloseAccount", mock.Anything, mock.MatchedBy(func(req *prototx.CloseAccountRequest) bool {
+		return req.AccountId == "acc-123" &&
+			req.PayoutAccountId == "acc-456" &&
+			req.Reason == "customer request" &&
+			req.Metadata.Requester == "test-admin"
+	})).Return(&prototx.CloseAccountResponse{
+		Transaction: &prototx.Transaction{Id: "tx-1", Amount: "42.50", Reference: "account-closure:acc-123:tx-1"},
+		Response: &prototx.Response{
+			Success: true,
+			Message: "Account closed successfully",
+		},
+	}, nil)
+
+	body := []byte(`{"payout_account_id": "acc-456", "reason": "customer request"}`)
+	req, _ := http.NewRequest("POST", "/api/v1/account/acc-123/close", bytes.NewBuffer(body))
+	req.Header.Set("Content-Type", "application/json")
+
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	var response map[string]interface{}
+	err := json.Unmarshal(w.Body.Bytes(), &response)
+	assert.NoError(t, err)
+	assert.Equal(t, "Account closed successfully", response["message"])
+	transaction := response["transaction"].(map[string]interface{})
+	assert.Equal(t, "42.50", transaction["amount"])
+
+	mockClient.AssertExpectations(t)
+}
+
+// TestCloseAccount_NotClosable tests that a refused closure returns bad request
+func TestCloseAccount_NotClosable(t *testing.T) {
+	mockClient := new(mock_client.MockTransactionClient)
+	router := setupAccountStatusRoutes(&AccountHandler{}, &TransactionHandler{TransactionClient: mockClient})
+
+	mockClient.On("CloseAccount", mock.Anything, mock.Anything).Return(&prototx.CloseAccountResponse{
+		Response: &prototx.Response{Success: false, Message: "Accounts with active holds cannot be closed"},
+	}, nil)
+
+	req, _ := http.NewRequest("POST", "/api/v1/account/acc-123/close", bytes.NewBuffer([]byte(`{"reason": "customer request"}`)))
+	req.Header.Set("Content-Type", "application/json")
+
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	assert.Contains(t, w.Body.String(), "Accounts with active holds cannot be closed")
+}
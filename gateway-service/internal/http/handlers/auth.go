@@ -1,15 +1,21 @@
 package handlers
 
 import (
+	"errors"
 	protoauth "gateway-service/api/protogen/authservice/proto"
+	"gateway-service/internal/auth"
 	"gateway-service/internal/logging"
 	"gateway-service/internal/ports"
 	"github.com/gin-gonic/gin"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"io"
 	"net/http"
+	"time"
 )
 
 type AuthHandler struct {
-	AuthClient ports.AuthClient
+	AuthClient  ports.AuthClient
+	Revocations *auth.RevocationList
 }
 
 type LoginRequest struct {
@@ -18,12 +24,29 @@ type LoginRequest struct {
 }
 
 type LoginResponse struct {
-	AccessToken string `json:"access_token"`
+	AccessToken           string `json:"access_token"`
+	RefreshToken          string `json:"refresh_token"`
+	MFARequired           bool   `json:"mfa_required,omitempty"`            // tokens are issued by /auth/mfa/verify
+	MFAChallengeToken     string `json:"mfa_challenge_token,omitempty"`     // valid for a few minutes
+	MFAEnrollmentRequired bool   `json:"mfa_enrollment_required,omitempty"` // enroll with /auth/mfa/challenge/enroll first
 }
 
-func NewAuthHandler(authClient ports.AuthClient) *AuthHandler {
+type RefreshRequest struct {
+	RefreshToken string `json:"refresh_token" binding:"required"`
+}
+
+type LogoutRequest struct {
+	RefreshToken string `json:"refresh_token"` // optional, revoked as well
+}
+
+type LogoutResponse struct {
+	Message string `json:"message" binding:"message"`
+}
+
+func NewAuthHandler(authClient ports.AuthClient, revocations *auth.RevocationList) *AuthHandler {
 	return &AuthHandler{
-		AuthClient: authClient,
+		AuthClient:  authClient,
+		Revocations: revocations,
 	}
 }
 
@@ -38,6 +61,11 @@ func NewAuthHandler(authClient ports.AuthClient) *AuthHandler {
 // @Description
 // @Description password:
 // @Description - Required
+// @Description
+// @Description When the employee has enabled MFA or MFA is required for their role, no tokens are issued. The response
+// @Description carries **mfa_required** and an **mfa_challenge_token** instead, which is exchanged for the tokens with a
+// @Description code of the authenticator app at /api/v1/auth/mfa/verify. With **mfa_enrollment_required** the employee
+// @Description has to enroll an authenticator app with /api/v1/auth/mfa/challenge/enroll first.
 // @Accept json
 // @Produce json
 // @Param login body LoginRequest true "Login credentials"
@@ -66,8 +94,133 @@ func (h *AuthHandler) Login(c *gin.Context) {
 	}
 
 	loginResp := LoginResponse{
-		AccessToken: resp.Token,
+		AccessToken:           resp.Token,
+		RefreshToken:          resp.RefreshToken,
+		MFARequired:           resp.MfaRequired,
+		MFAChallengeToken:     resp.MfaChallengeToken,
+		MFAEnrollmentRequired: resp.MfaEnrollmentRequired,
 	}
 
 	c.JSON(http.StatusOK, loginResp)
 }
+
+// Refresh exchanges a refresh token for a new access token and refresh token
+// @Tags Authentication
+// @Summary Refresh Token API
+// @Description
+// @Description **Request Body:**
+// @Description
+// @Description refresh_token:
+// @Description - Required
+// @Description - The refresh token of the login or of the previous refresh
+// @Description
+// @Description Every refresh token can be used once; the response carries the one to use next. Using a refresh token
+// @Description again revokes all refresh tokens of its login, so its holder has to log in again.
+// @Accept json
+// @Produce json
+// @Param refresh body RefreshRequest true "Refresh token"
+// @Success 200 {object} LoginResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Router /api/v1/auth/refresh [post]
+func (h *AuthHandler) Refresh(c *gin.Context) {
+	var req RefreshRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		logging.Logger.Warn().Err(err).Msg("invalid request param")
+		c.JSON(http.StatusBadRequest, ErrorResponse{Error: "Invalid request payload"})
+		return
+	}
+
+	grpcReq := &protoauth.RefreshTokenRequest{
+		RefreshToken: req.RefreshToken,
+	}
+
+	resp, err := h.AuthClient.RefreshToken(c.Request.Context(), grpcReq)
+	if err != nil {
+		logging.Logger.Error().Err(err).Msg("token refresh failed")
+		c.JSON(http.StatusUnauthorized, ErrorResponse{Error: "Invalid or expired refresh token"})
+		return
+	}
+
+	refreshResp := LoginResponse{
+		AccessToken:  resp.Token,
+		RefreshToken: resp.RefreshToken,
+	}
+
+	c.JSON(http.StatusOK, refreshResp)
+}
+
+// Logout revokes the access token of the request and optionally the refresh token of the session
+// @Tags Authentication
+// @Summary Logout API
+// @Description
+// @Description **Request Body:**
+// @Description
+// @Description refresh_token:
+// @Description - Optional
+// @Description - The refresh token of the session; it and every refresh token of its login are revoked
+// @Description
+// @Description The access token is rejected from now on, even though it has not expired yet.
+// @Description
+// @Description **Header:**
+// @Description
+// @Description Authorization:
+// @Description - Required
+// @Description - Format: Bearer token
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer token" default(Bearer )
+// @Param logout body LogoutRequest false "Refresh token"
+// @Success 200 {object} LogoutResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Router /api/v1/auth/logout [post]
+func (h *AuthHandler) Logout(c *gin.Context) {
+	var req LogoutRequest
+	if c.Request.ContentLength != 0 { // the body is optional
+		if err := c.ShouldBindJSON(&req); err != nil && !errors.Is(err, io.EOF) {
+			logging.Logger.Warn().Err(err).Msg("invalid request param")
+			c.JSON(http.StatusBadRequest, ErrorResponse{Error: "Invalid request payload"})
+			return
+		}
+	}
+
+	// middleware
+	username := c.GetString("username")
+	tokenID := c.GetString("token_id")
+	expiresAt := c.GetTime("token_expires_at")
+
+	if tokenID == "" {
+		// tokens issued before revocation was introduced carry no ID
+		logging.Logger.Warn().Str("username", username).Msg("logout of token without ID")
+		c.JSON(http.StatusBadRequest, ErrorResponse{Error: "Token cannot be revoked, it expires at " + expiresAt.Format(time.RFC3339)})
+		return
+	}
+
+	grpcReq := &protoauth.LogoutRequest{
+		TokenId:      tokenID,
+		Username:     username,
+		ExpiresAt:    timestamppb.New(expiresAt),
+		RefreshToken: req.RefreshToken,
+	}
+
+	resp, err := h.AuthClient.Logout(c.Request.Context(), grpcReq)
+	if err != nil || resp == nil {
+		logging.Logger.Error().Err(err).Msg("logout failed")
+		c.JSON(http.StatusUnauthorized, ErrorResponse{Error: "Invalid request"})
+		return
+	}
+
+	if !resp.Success {
+		logging.Logger.Error().Err(errors.New(resp.Message)).Msg("unable to log out")
+		c.JSON(http.StatusBadRequest, ErrorResponse{Error: resp.Message})
+		return
+	}
+
+	// reject the token right away instead of after the next sync
+	if h.Revocations != nil {
+		h.Revocations.RevokeToken(tokenID, expiresAt)
+	}
+
+	c.JSON(http.StatusOK, LogoutResponse{Message: resp.Message})
+}
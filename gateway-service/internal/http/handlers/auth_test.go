@@ -5,13 +5,16 @@ import (
 	"encoding/json"
 	"errors"
 	protoauth "gateway-service/api/protogen/authservice/proto"
+	"gateway-service/internal/auth"
 	mock_client "gateway-service/internal/ports/mocks/grpc_client"
 	"github.com/gin-gonic/gin"
+	"github.com/golang-jwt/jwt/v4"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
 )
 
 func setupAuthRoutes(authHandler *AuthHandler) *gin.Engine {
@@ -24,13 +27,29 @@ func setupAuthRoutes(authHandler *AuthHandler) *gin.Engine {
 	})
 
 	router.POST("/api/v1/auth/login", authHandler.Login)
+	router.POST("/api/v1/auth/refresh", authHandler.Refresh)
+	return router
+}
+
+func setupLogoutRoutes(authHandler *AuthHandler, tokenID string, expiresAt time.Time) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+
+	router.Use(func(c *gin.Context) {
+		c.Set("username", "test-admin")
+		c.Set("token_id", tokenID)
+		c.Set("token_expires_at", expiresAt)
+		c.Next()
+	})
+
+	router.POST("/api/v1/auth/logout", authHandler.Logout)
 	return router
 }
 
 // TestLogin_Success tests successful login
 func TestLogin_Success(t *testing.T) {
 	mockClient := new(mock_client.MockAuthClient)
-	authHandler := NewAuthHandler(mockClient)
+	authHandler := NewAuthHandler(mockClient, nil)
 	router := setupAuthRoutes(authHandler)
 
 	loginReq := LoginRequest{
@@ -39,7 +58,8 @@ func TestLogin_Success(t *testing.T) {
 	}
 
 	expectedResponse := &protoauth.AuthenticateResponse{
-		Token: "jwt-token-here",
+		Token:        "jwt-token-here",
+		RefreshToken: "refresh-token-here",
 	}
 
 	mockClient.On("Authenticate", mock.Anything, &protoauth.AuthenticateRequest{
@@ -60,14 +80,43 @@ func TestLogin_Success(t *testing.T) {
 	err := json.Unmarshal(w.Body.Bytes(), &response)
 	assert.NoError(t, err)
 	assert.Equal(t, "jwt-token-here", response.AccessToken)
+	assert.Equal(t, "refresh-token-here", response.RefreshToken)
 
 	mockClient.AssertExpectations(t)
 }
 
+// TestLogin_MFARequired tests that a login with MFA returns the challenge instead of tokens
+func TestLogin_MFARequired(t *testing.T) {
+	mockClient := new(mock_client.MockAuthClient)
+	router := setupAuthRoutes(NewAuthHandler(mockClient, nil))
+
+	mockClient.On("Authenticate", mock.Anything, mock.Anything).Return(&protoauth.AuthenticateResponse{
+		MfaRequired:           true,
+		MfaChallengeToken:     "challenge-token",
+		MfaEnrollmentRequired: true,
+	}, nil)
+
+	req, _ := http.NewRequest("POST", "/api/v1/auth/login", bytes.NewBufferString(`{"username": "testuser", "password": "password123"}`))
+	req.Header.Set("Content-Type", "application/json")
+
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	var response LoginResponse
+	err := json.Unmarshal(w.Body.Bytes(), &response)
+	assert.NoError(t, err)
+	assert.Empty(t, response.AccessToken)
+	assert.True(t, response.MFARequired)
+	assert.Equal(t, "challenge-token", response.MFAChallengeToken)
+	assert.True(t, response.MFAEnrollmentRequired)
+}
+
 // TestLogin_InvalidPayload tests login with invalid JSON payload
 func TestLogin_InvalidPayload(t *testing.T) {
 	mockClient := new(mock_client.MockAuthClient)
-	authHandler := NewAuthHandler(mockClient)
+	authHandler := NewAuthHandler(mockClient, nil)
 	router := setupAuthRoutes(authHandler)
 
 	invalidJSON := `{"username": "test", "password": 123}`
@@ -89,7 +138,7 @@ func TestLogin_InvalidPayload(t *testing.T) {
 // TestLogin_InvalidCredentials tests login with invalid credentials
 func TestLogin_InvalidCredentials(t *testing.T) {
 	mockClient := new(mock_client.MockAuthClient)
-	authHandler := NewAuthHandler(mockClient)
+	authHandler := NewAuthHandler(mockClient, nil)
 	router := setupAuthRoutes(authHandler)
 
 	loginReq := LoginRequest{
@@ -118,3 +167,147 @@ func TestLogin_InvalidCredentials(t *testing.T) {
 
 	mockClient.AssertExpectations(t)
 }
+
+// TestRefresh_Success tests exchanging a refresh token for new tokens
+func TestRefresh_Success(t *testing.T) {
+	mockClient := new(mock_client.MockAuthClient)
+	authHandler := NewAuthHandler(mockClient, nil)
+	router := setupAuthRoutes(authHandler)
+
+	mockClient.On("RefreshToken", mock.Anything, &protoauth.RefreshTokenRequest{
+		RefreshToken: "refresh-token-1",
+	}).Return(&protoauth.RefreshTokenResponse{
+		Token:        "jwt-token-2",
+		RefreshToken: "refresh-token-2",
+	}, nil)
+
+	req, _ := http.NewRequest("POST", "/api/v1/auth/refresh", bytes.NewBufferString(`{"refresh_token": "refresh-token-1"}`))
+	req.Header.Set("Content-Type", "application/json")
+
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	var response LoginResponse
+	err := json.Unmarshal(w.Body.Bytes(), &response)
+	assert.NoError(t, err)
+	assert.Equal(t, "jwt-token-2", response.AccessToken)
+	assert.Equal(t, "refresh-token-2", response.RefreshToken)
+
+	mockClient.AssertExpectations(t)
+}
+
+// TestRefresh_RejectedToken tests that a reused, revoked or expired refresh token is unauthorized
+func TestRefresh_RejectedToken(t *testing.T) {
+	mockClient := new(mock_client.MockAuthClient)
+	authHandler := NewAuthHandler(mockClient, nil)
+	router := setupAuthRoutes(authHandler)
+
+	mockClient.On("RefreshToken", mock.Anything, mock.Anything).Return(nil, errors.New("refresh token reused"))
+
+	req, _ := http.NewRequest("POST", "/api/v1/auth/refresh", bytes.NewBufferString(`{"refresh_token": "refresh-token-1"}`))
+	req.Header.Set("Content-Type", "application/json")
+
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusUnauthorized, w.Code)
+
+	var response ErrorResponse
+	err := json.Unmarshal(w.Body.Bytes(), &response)
+	assert.NoError(t, err)
+	assert.Equal(t, "Invalid or expired refresh token", response.Error)
+}
+
+// TestRefresh_MissingToken tests refresh without a refresh token
+func TestRefresh_MissingToken(t *testing.T) {
+	mockClient := new(mock_client.MockAuthClient)
+	authHandler := NewAuthHandler(mockClient, nil)
+	router := setupAuthRoutes(authHandler)
+
+	req, _ := http.NewRequest("POST", "/api/v1/auth/refresh", bytes.NewBufferString(`{}`))
+	req.Header.Set("Content-Type", "application/json")
+
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	mockClient.AssertNotCalled(t, "RefreshToken", mock.Anything, mock.Anything)
+}
+
+// TestLogout_Success tests that logout revokes the token at the auth service and right away at the gateway
+func TestLogout_Success(t *testing.T) {
+	mockClient := new(mock_client.MockAuthClient)
+	revocations := auth.NewRevocationList()
+	expiresAt := time.Now().Add(10 * time.Minute).Truncate(time.Second)
+	router := setupLogoutRoutes(NewAuthHandler(mockClient, revocations), "jti-1", expiresAt)
+
+	mockClient.On("Logout", mock.Anything, mock.MatchedBy(func(req *protoauth.LogoutRequest) bool {
+		return req.TokenId == "jti-1" &&
+			req.Username == "test-admin" &&
+			req.ExpiresAt.AsTime().Equal(expiresAt) &&
+			req.RefreshToken == "refresh-token-here"
+	})).Return(&protoauth.LogoutResponse{Message: "Logged out successfully", Success: true}, nil)
+
+	req, _ := http.NewRequest("POST", "/api/v1/auth/logout", bytes.NewBufferString(`{"refresh_token": "refresh-token-here"}`))
+	req.Header.Set("Content-Type", "application/json")
+
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Contains(t, w.Body.String(), "Logged out successfully")
+	assert.True(t, revocations.IsRevoked(&auth.Claims{Username: "test-admin", RegisteredClaims: jwt.RegisteredClaims{ID: "jti-1"}}))
+	mockClient.AssertExpectations(t)
+}
+
+// TestLogout_WithoutBody tests that the refresh token is optional
+func TestLogout_WithoutBody(t *testing.T) {
+	mockClient := new(mock_client.MockAuthClient)
+	router := setupLogoutRoutes(NewAuthHandler(mockClient, nil), "jti-1", time.Now().Add(time.Minute))
+
+	mockClient.On("Logout", mock.Anything, mock.MatchedBy(func(req *protoauth.LogoutRequest) bool {
+		return req.TokenId == "jti-1" && req.RefreshToken == ""
+	})).Return(&protoauth.LogoutResponse{Message: "Logged out successfully", Success: true}, nil)
+
+	req, _ := http.NewRequest("POST", "/api/v1/auth/logout", nil)
+
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	mockClient.AssertExpectations(t)
+}
+
+// TestLogout_TokenWithoutID tests that a token issued without jti cannot be revoked
+func TestLogout_TokenWithoutID(t *testing.T) {
+	mockClient := new(mock_client.MockAuthClient)
+	router := setupLogoutRoutes(NewAuthHandler(mockClient, nil), "", time.Now().Add(time.Minute))
+
+	req, _ := http.NewRequest("POST", "/api/v1/auth/logout", nil)
+
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	mockClient.AssertNotCalled(t, "Logout", mock.Anything, mock.Anything)
+}
+
+// TestLogout_Failure tests that a failed revocation keeps the token valid at the gateway
+func TestLogout_Failure(t *testing.T) {
+	mockClient := new(mock_client.MockAuthClient)
+	revocations := auth.NewRevocationList()
+	router := setupLogoutRoutes(NewAuthHandler(mockClient, revocations), "jti-1", time.Now().Add(time.Minute))
+
+	mockClient.On("Logout", mock.Anything, mock.Anything).Return(&protoauth.LogoutResponse{Message: "Failed to log out", Success: false}, nil)
+
+	req, _ := http.NewRequest("POST", "/api/v1/auth/logout", nil)
+
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	assert.Contains(t, w.Body.String(), "Failed to log out")
+	assert.False(t, revocations.IsRevoked(&auth.Claims{Username: "test-admin", RegisteredClaims: jwt.RegisteredClaims{ID: "jti-1"}}))
+}
@@ -5,16 +5,13 @@ import (
 	protoacc "gateway-service/api/protogen/accountservice/proto"
 	"gateway-service/internal/logging"
 	"github.com/gin-gonic/gin"
+	"google.golang.org/protobuf/types/known/timestamppb"
 	"net/http"
 	"strconv"
 	"strings"
+	"time"
 )
 
-type Customer struct {
-	ID   string `json:"id"`
-	Name string `json:"name"`
-}
-
 type CreateCustomerRequest struct {
 	Name string `json:"name" binding:"required,max=50"`
 }
@@ -25,11 +22,18 @@ type CreateCustomerResponse struct {
 }
 
 type UpdateCustomerRequest struct {
-	Name string `json:"name" binding:"required"`
+	Name        string `json:"name" binding:"max=50"`
+	DateOfBirth string `json:"date_of_birth"` // YYYY-MM-DD
+	NationalID  string `json:"national_id"`
+	Address     string `json:"address" binding:"max=200"`
+	Phone       string `json:"phone"` // international format, e.g. +4915112345678
+	Email       string `json:"email"`
+	KYCStatus   string `json:"kyc_status"` // pending, verified or rejected
 }
 
 type UpdateCustomerResponse struct {
-	Message string `json:"message" binding:"message"`
+	Customer interface{} `json:"customer"`
+	Message  string      `json:"message" binding:"message"`
 }
 
 type DeleteCustomerResponse struct {
@@ -37,8 +41,8 @@ type DeleteCustomerResponse struct {
 }
 
 type GetCustomerResponse struct {
-	Customer Customer `json:"customer"`
-	Message  string   `json:"message" binding:"message"`
+	Customer interface{} `json:"customer"`
+	Message  string      `json:"message" binding:"message"`
 }
 
 type ListCustomerResponse struct {
@@ -47,6 +51,7 @@ type ListCustomerResponse struct {
 	PageSize   int         `json:"pageSize"`
 	TotalCount int         `json:"totalCount"`
 	TotalPages int         `json:"totalPages"`
+	NextCursor string      `json:"next_cursor"` // empty on the last page
 	Message    string      `json:"message" binding:"message"`
 }
 
@@ -116,6 +121,170 @@ func (h *AccountHandler) CreateCustomer(c *gin.Context) {
 	c.JSON(http.StatusCreated, res)
 }
 
+// GetCustomer fetches the profile and accounts of a customer
+// @Tags Customer
+// @Summary Get Customer
+// @Description
+// @Description **Path Parameter:**
+// @Description
+// @Description id:
+// @Description - Required
+// @Description - CustomerID of the customer
+// @Description
+// @Description **Header:**
+// @Description
+// @Description Authorization:
+// @Description - Required
+// @Description - Format: Bearer token
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer token" default(Bearer )
+// @Param id path string true "CustomerID of the customer"
+// @Success 200 {object} GetCustomerResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Router /api/v1/customer/{id} [get]
+func (h *AccountHandler) GetCustomer(c *gin.Context) {
+	un, _ := c.Get("username") // middleware
+	requester, ok := un.(string)
+	if !ok {
+		logging.Logger.Warn().Err(errors.New("unable to get requester username")).Msg("requester: " + requester)
+	}
+
+	grpcReq := &protoacc.GetCustomerRequest{
+		CustomerId: strings.TrimSpace(c.Param("id")),
+		Metadata: &protoacc.Metadata{
+			RequestId: c.GetHeader("X-Request-ID"),
+			Requester: requester,
+		},
+	}
+
+	resp, err := h.AccountClient.GetCustomer(c.Request.Context(), grpcReq)
+	if err != nil || resp == nil {
+		logging.Logger.Error().Err(err).Msg("failed to get customer")
+		c.JSON(http.StatusUnauthorized, ErrorResponse{Error: "Invalid request"})
+		return
+	}
+
+	if !resp.Response.Success {
+		logging.Logger.Error().Err(errors.New(resp.Response.Message)).Msg("unable to get customer")
+		c.JSON(customerErrorStatus(resp.Response.Message), ErrorResponse{Error: resp.Response.Message})
+		return
+	}
+
+	res := GetCustomerResponse{
+		Customer: resp.Customer,
+		Message:  resp.Response.Message,
+	}
+
+	c.JSON(http.StatusOK, res)
+}
+
+// UpdateCustomer edits the profile of a customer
+// @Tags Customer
+// @Summary Update Customer
+// @Description
+// @Description **Path Parameter:**
+// @Description
+// @Description id:
+// @Description - Required
+// @Description - CustomerID of the customer to update
+// @Description
+// @Description **Request Body:**
+// @Description
+// @Description Every field is optional, fields left empty keep their value. Each change is recorded in a
+// @Description **customer_updated** event with the previous and new value of the changed fields.
+// @Description
+// @Description name:
+// @Description - Max 50 characters, unique among customers
+// @Description
+// @Description date_of_birth:
+// @Description - Format: YYYY-MM-DD, in the past
+// @Description
+// @Description national_id:
+// @Description - 5 to 20 letters or digits, spaces and dashes are removed
+// @Description - Unique among customers
+// @Description
+// @Description address:
+// @Description - Max 200 characters
+// @Description
+// @Description phone:
+// @Description - International format, e.g. **+4915112345678**
+// @Description
+// @Description email:
+// @Description - Email address without display name
+// @Description
+// @Description kyc_status:
+// @Description - Options: **pending**, **verified**, **rejected**
+// @Description - Verifying requires date_of_birth, national_id and address and sets kyc_verified_at
+// @Description - Changing the name, date_of_birth or national_id of a verified customer returns it to **pending**
+// @Description
+// @Description **Header:**
+// @Description
+// @Description Authorization:
+// @Description - Required
+// @Description - Format: Bearer token
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer token" default(Bearer )
+// @Param id path string true "CustomerID of the customer"
+// @Param customer body UpdateCustomerRequest true "Customer profile fields to change"
+// @Success 200 {object} UpdateCustomerResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Router /api/v1/customer/{id} [put]
+func (h *AccountHandler) UpdateCustomer(c *gin.Context) {
+	var req UpdateCustomerRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		logging.Logger.Warn().Err(err).Msg("invalid request param")
+		c.JSON(http.StatusBadRequest, ErrorResponse{Error: "Invalid request payload"})
+		return
+	}
+
+	un, _ := c.Get("username") // middleware
+	requester, ok := un.(string)
+	if !ok {
+		logging.Logger.Warn().Err(errors.New("unable to get requester username")).Msg("requester: " + requester)
+	}
+
+	grpcReq := &protoacc.UpdateCustomerRequest{
+		CustomerId:  strings.TrimSpace(c.Param("id")),
+		Name:        req.Name,
+		DateOfBirth: req.DateOfBirth,
+		NationalId:  req.NationalID,
+		Address:     req.Address,
+		Phone:       req.Phone,
+		Email:       req.Email,
+		KycStatus:   req.KYCStatus,
+		Metadata: &protoacc.Metadata{
+			RequestId: c.GetHeader("X-Request-ID"),
+			Requester: requester,
+		},
+	}
+
+	resp, err := h.AccountClient.UpdateCustomer(c.Request.Context(), grpcReq)
+	if err != nil || resp == nil {
+		logging.Logger.Error().Err(err).Msg("failed to update customer")
+		c.JSON(http.StatusUnauthorized, ErrorResponse{Error: "Invalid request"})
+		return
+	}
+
+	if !resp.Response.Success {
+		logging.Logger.Error().Err(errors.New(resp.Response.Message)).Msg("unable to update customer")
+		c.JSON(customerErrorStatus(resp.Response.Message), ErrorResponse{Error: resp.Response.Message})
+		return
+	}
+
+	res := UpdateCustomerResponse{
+		Customer: resp.Customer,
+		Message:  resp.Response.Message,
+	}
+
+	c.JSON(http.StatusOK, res)
+}
+
 // DeleteCustomer deletes a customer by customer id
 // @Tags Customer
 // @Summary Delete Customer
@@ -176,7 +345,7 @@ func (h *AccountHandler) DeleteCustomer(c *gin.Context) {
 	c.JSON(http.StatusOK, res)
 }
 
-// ListCustomer fetches the customer list
+// ListCustomer fetches the customer list, optionally filtered by the search parameters
 // @Tags Customer
 // @Summary Get Customer List
 // @Description
@@ -192,11 +361,47 @@ func (h *AccountHandler) DeleteCustomer(c *gin.Context) {
 // @Description - Number of customers per page
 // @Description - Default: 50
 // @Description
+// @Description cursor:
+// @Description - Optional
+// @Description - next_cursor of the previous page; the page continues after it and page is ignored
+// @Description - Unlike page, a cursor does not skip or repeat rows added while paging
+// @Description
+// @Description include_total:
+// @Description - Optional
+// @Description - Count totalCount and totalPages, which are 0 otherwise
+// @Description - Default: true without cursor, false with cursor
+// @Description
 // @Description order:
 // @Description - Optional
 // @Description - Sort order (asc/desc)
 // @Description - Default: desc
 // @Description
+// @Description name:
+// @Description - Optional
+// @Description - Case-insensitive search, matches customers with a word of the name starting with each word given
+// @Description - Example: **jo sm** matches **John Smith**
+// @Description
+// @Description created_by:
+// @Description - Optional
+// @Description - Username of the employee who created the customer
+// @Description
+// @Description start_date, end_date:
+// @Description - Optional
+// @Description - Creation date range, both days included
+// @Description - Format: DD-MM-YYYY
+// @Description
+// @Description active_status:
+// @Description - Optional
+// @Description - Options: **active**, **deactivated**
+// @Description
+// @Description min_balance:
+// @Description - Optional
+// @Description - Customers having a valid account with a balance above it
+// @Description
+// @Description account_id:
+// @Description - Optional
+// @Description - Finds the customer owning the account
+// @Description
 // @Description **Header:**
 // @Description
 // @Description Authorization:
@@ -207,6 +412,15 @@ func (h *AccountHandler) DeleteCustomer(c *gin.Context) {
 // @Param Authorization header string true "Bearer token" default(Bearer )
 // @Param page query int false "Page number for pagination" default(1)
 // @Param pagesize query int false "Number of customers per page" default(50)
+// @Param name query string false "Name search"
+// @Param created_by query string false "Created by username"
+// @Param start_date query string false "Created on or after (format: DD-MM-YYYY)"
+// @Param end_date query string false "Created on or before (format: DD-MM-YYYY)"
+// @Param active_status query string false "Active status (active/deactivated)"
+// @Param min_balance query string false "Has an account with a balance above it"
+// @Param account_id query string false "Owns the account"
+// @Param cursor query string false "next_cursor of the previous page"
+// @Param include_total query bool false "Count totalCount and totalPages (default: true without cursor, false with cursor)"
 // @Param order query string false "Sort order (asc/desc)" default(desc)
 // @Success 200 {object} ListCustomerResponse
 // @Failure 400 {object} ErrorResponse
@@ -216,6 +430,26 @@ func (h *AccountHandler) ListCustomer(c *gin.Context) {
 	pageStr := strings.TrimSpace(c.Query("page"))
 	pageSizeStr := strings.TrimSpace(c.Query("pagesize"))
 	order := strings.TrimSpace(c.Query("order"))
+	cursor, includeTotal := cursorQuery(c)
+
+	var startDate, endDate *timestamppb.Timestamp
+	if startDateStr := strings.TrimSpace(c.Query("start_date")); startDateStr != "" {
+		t, err := time.Parse("02-01-2006", startDateStr)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, ErrorResponse{Error: "Invalid start_date format, expected DD-MM-YYYY"})
+			return
+		}
+		startDate = timestamppb.New(t)
+	}
+	if endDateStr := strings.TrimSpace(c.Query("end_date")); endDateStr != "" {
+		t, err := time.Parse("02-01-2006", endDateStr)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, ErrorResponse{Error: "Invalid end_date format, expected DD-MM-YYYY"})
+			return
+		}
+		// Set to end of day for end_date
+		endDate = timestamppb.New(t.Add(23*time.Hour + 59*time.Minute + 59*time.Second))
+	}
 
 	var pageNo int = -1
 	var err error
@@ -243,10 +477,19 @@ func (h *AccountHandler) ListCustomer(c *gin.Context) {
 	}
 
 	grpcReq := &protoacc.ListCustomersRequest{
-		SortOrder: order,
+		SortOrder:    order,
+		Name:         strings.TrimSpace(c.Query("name")),
+		CreatedBy:    strings.TrimSpace(c.Query("created_by")),
+		StartDate:    startDate,
+		EndDate:      endDate,
+		ActiveStatus: strings.TrimSpace(c.Query("active_status")),
+		MinBalance:   strings.TrimSpace(c.Query("min_balance")),
+		AccountId:    strings.TrimSpace(c.Query("account_id")),
 		Pagination: &protoacc.PaginationRequest{
-			Page:     int32(pageNo),
-			PageSize: int32(pageSize),
+			Page:         int32(pageNo),
+			PageSize:     int32(pageSize),
+			Cursor:       cursor,
+			IncludeTotal: includeTotal,
 		},
 		Metadata: &protoacc.Metadata{
 			RequestId: c.GetHeader("X-Request-ID"),
@@ -273,6 +516,7 @@ func (h *AccountHandler) ListCustomer(c *gin.Context) {
 		PageSize:   int(resp.Pagination.PageSize),
 		TotalCount: int(resp.Pagination.TotalCount),
 		TotalPages: int(resp.Pagination.TotalPages),
+		NextCursor: resp.Pagination.NextCursor,
 		Message:    resp.Response.Message,
 	}
 
@@ -353,8 +597,9 @@ func (h *AccountHandler) ListCustomerAccounts(c *gin.Context) {
 			Requester: requester,
 		},
 		Pagination: &protoacc.PaginationRequest{
-			Page:     int32(pageNo),
-			PageSize: int32(pageSize),
+			Page:         int32(pageNo),
+			PageSize:     int32(pageSize),
+			IncludeTotal: true,
 		},
 	}
 
@@ -382,3 +627,11 @@ func (h *AccountHandler) ListCustomerAccounts(c *gin.Context) {
 
 	c.JSON(http.StatusOK, res)
 }
+
+// customerErrorStatus maps the message of a failed customer lookup or update to its status code
+func customerErrorStatus(message string) int {
+	if message == "Customer not found" {
+		return http.StatusNotFound
+	}
+	return http.StatusBadRequest
+}
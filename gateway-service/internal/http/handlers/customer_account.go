@@ -1,6 +1,7 @@
 package handlers
 
 import (
+	"encoding/json"
 	"errors"
 	protoacc "gateway-service/api/protogen/accountservice/proto"
 	"gateway-service/internal/logging"
@@ -11,8 +12,10 @@ import (
 )
 
 type CreateAccountRequest struct {
-	CustomerID    string  `json:"customer_id" binding:"required"`
-	DepositAmount float64 `json:"deposit_amount" binding:"required"`
+	CustomerID    string      `json:"customer_id" binding:"required"`
+	DepositAmount json.Number `json:"deposit_amount" binding:"required" swaggertype:"string"` // decimal, e.g. "100.50"
+	Currency      string      `json:"currency"`                                               // ISO 4217 code, e.g. "USD"
+	AccountType   string      `json:"account_type"`                                           // savings or current
 }
 
 type CreateAccountResponse struct {
@@ -30,12 +33,24 @@ type ListAccountResponse struct {
 	PageSize   int         `json:"pageSize"`
 	TotalCount int         `json:"totalCount"`
 	TotalPages int         `json:"totalPages"`
+	NextCursor string      `json:"next_cursor"` // empty on the last page
 	Message    string      `json:"message" binding:"message"`
 }
 
+type SetOverdraftLimitRequest struct {
+	OverdraftLimit json.Number `json:"overdraft_limit" binding:"required" swaggertype:"string"` // decimal, e.g. "500.00"
+}
+
+type SetOverdraftLimitResponse struct {
+	Account interface{} `json:"account"`
+	Message string      `json:"message" binding:"message"`
+}
+
 type GetBalanceResponse struct {
-	Balance float64 `json:"balance"`
-	Message string  `json:"message" binding:"message"`
+	Balance          string `json:"balance"`           // same as ledger_balance
+	AvailableBalance string `json:"available_balance"` // ledger balance + overdraft limit - active holds
+	LedgerBalance    string `json:"ledger_balance"`    // the booked balance
+	Message          string `json:"message" binding:"message"`
 }
 
 // CreateAccount creates a new account
@@ -50,6 +65,18 @@ type GetBalanceResponse struct {
 // @Description Deposit Amount:
 // @Description - Required
 // @Description - Must be greater than zero
+// @Description - Decimal number or string with at most 2 decimal places (e.g. "100.50")
+// @Description
+// @Description Currency:
+// @Description - Optional
+// @Description - Options: **USD**, **EUR**, **BDT**
+// @Description - Default: USD
+// @Description
+// @Description Account Type:
+// @Description - Optional
+// @Description - Options: **savings**, **current**
+// @Description - Default: savings
+// @Description - Only current accounts can have an overdraft
 // @Description
 // @Description **Header:**
 // @Description
@@ -80,7 +107,9 @@ func (h *AccountHandler) CreateAccount(c *gin.Context) {
 
 	grpcReq := &protoacc.CreateAccountRequest{
 		CustomerId:     req.CustomerID,
-		InitialDeposit: req.DepositAmount,
+		InitialDeposit: req.DepositAmount.String(),
+		Currency:       req.Currency,
+		AccountType:    req.AccountType,
 		Metadata: &protoacc.Metadata{
 			RequestId: c.GetHeader("X-Request-ID"),
 			Requester: requester,
@@ -198,6 +227,9 @@ func (h *AccountHandler) DeleteAccount(c *gin.Context) {
 // @Description - Required
 // @Description - AccountID of a customer account
 // @Description
+// @Description **ledger_balance** is the booked balance. **available_balance** is what can be debited:
+// @Description the ledger balance plus the overdraft limit, minus the funds reserved by active holds.
+// @Description
 // @Description **Header:**
 // @Description
 // @Description Authorization:
@@ -242,8 +274,10 @@ func (h *AccountHandler) GetAccountBalance(c *gin.Context) {
 	}
 
 	res := GetBalanceResponse{
-		Balance: resp.Balance,
-		Message: resp.Response.Message,
+		Balance:          resp.Balance,
+		AvailableBalance: resp.AvailableBalance,
+		LedgerBalance:    resp.LedgerBalance,
+		Message:          resp.Response.Message,
 	}
 
 	c.JSON(http.StatusOK, res)
@@ -279,6 +313,16 @@ func (h *AccountHandler) GetAccountBalance(c *gin.Context) {
 // @Description - Number of accounts per page
 // @Description - Default: 50
 // @Description
+// @Description cursor:
+// @Description - Optional
+// @Description - next_cursor of the previous page; the page continues after it and page is ignored
+// @Description - Unlike page, a cursor does not skip or repeat rows added while paging
+// @Description
+// @Description include_total:
+// @Description - Optional
+// @Description - Count totalCount and totalPages, which are 0 otherwise
+// @Description - Default: true without cursor, false with cursor
+// @Description
 // @Description order:
 // @Description - Optional
 // @Description - Sort order (asc/desc)
@@ -297,6 +341,8 @@ func (h *AccountHandler) GetAccountBalance(c *gin.Context) {
 // @Param min_balance query string false "Minimum balance"
 // @Param page query int false "Page number for pagination" default(1)
 // @Param pagesize query int false "Number of accounts per page" default(50)
+// @Param cursor query string false "next_cursor of the previous page"
+// @Param include_total query bool false "Count totalCount and totalPages (default: true without cursor, false with cursor)"
 // @Param order query string false "Sort order (asc/desc)" default(desc)
 // @Success 200 {object} ListAccountResponse "Successfully retrieved account list"
 // @Failure 400 {object} ErrorResponse
@@ -306,6 +352,7 @@ func (h *AccountHandler) ListAccounts(c *gin.Context) {
 	pageStr := strings.TrimSpace(c.Query("page"))
 	pageSizeStr := strings.TrimSpace(c.Query("pagesize"))
 	order := strings.TrimSpace(c.Query("order"))
+	cursor, includeTotal := cursorQuery(c)
 	customerID := strings.TrimSpace(c.Query("customer_id"))
 	minBalanceStr := strings.TrimSpace(c.Query("min_balance"))
 	inTransactionStr := strings.TrimSpace(c.Query("in_transaction"))
@@ -345,8 +392,10 @@ func (h *AccountHandler) ListAccounts(c *gin.Context) {
 			Requester: requester,
 		},
 		Pagination: &protoacc.PaginationRequest{
-			Page:     int32(pageNo),
-			PageSize: int32(pageSize),
+			Page:         int32(pageNo),
+			PageSize:     int32(pageSize),
+			Cursor:       cursor,
+			IncludeTotal: includeTotal,
 		},
 	}
 
@@ -369,8 +418,91 @@ func (h *AccountHandler) ListAccounts(c *gin.Context) {
 		PageSize:   int(resp.Pagination.PageSize),
 		TotalCount: int(resp.Pagination.TotalCount),
 		TotalPages: int(resp.Pagination.TotalPages),
+		NextCursor: resp.Pagination.NextCursor,
 		Message:    resp.Response.Message,
 	}
 
 	c.JSON(http.StatusOK, res)
 }
+
+// SetOverdraftLimit sets how far a current account may go below zero
+// @Tags Account
+// @Summary Set Overdraft Limit
+// @Description
+// @Description **Path Parameter:**
+// @Description
+// @Description id:
+// @Description - Required
+// @Description - AccountID of a current account
+// @Description
+// @Description **Request Body:**
+// @Description
+// @Description Overdraft Limit:
+// @Description - Required
+// @Description - Decimal number or string with at most 2 decimal places (e.g. "500.00")
+// @Description - 0 removes the overdraft
+// @Description - Cannot be lower than the amount the account is overdrawn by
+// @Description
+// @Description **Header:**
+// @Description
+// @Description Authorization:
+// @Description - Required
+// @Description - Format: Bearer token
+// @Description - Admin only
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer token" default(Bearer )
+// @Param id path string true "AccountID of a current account"
+// @Param overdraft body SetOverdraftLimitRequest true "Overdraft limit"
+// @Success 200 {object} SetOverdraftLimitResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Router /api/v1/account/{id}/overdraft [put]
+func (h *AccountHandler) SetOverdraftLimit(c *gin.Context) {
+	var req SetOverdraftLimitRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		logging.Logger.Warn().Err(err).Msg("invalid request param")
+		c.JSON(http.StatusBadRequest, ErrorResponse{Error: "Invalid request payload"})
+		return
+	}
+
+	un, _ := c.Get("username") // middleware
+	requester, ok := un.(string)
+	if !ok {
+		logging.Logger.Warn().Err(errors.New("unable to get requester username")).Msg("requester: " + requester)
+	}
+
+	grpcReq := &protoacc.SetOverdraftLimitRequest{
+		AccountId:      strings.TrimSpace(c.Param("id")),
+		OverdraftLimit: req.OverdraftLimit.String(),
+		Metadata: &protoacc.Metadata{
+			RequestId: c.GetHeader("X-Request-ID"),
+			Requester: requester,
+		},
+	}
+
+	resp, err := h.AccountClient.SetOverdraftLimit(c.Request.Context(), grpcReq)
+	if err != nil || resp == nil {
+		logging.Logger.Error().Err(err).Msg("failed to set overdraft limit")
+		c.JSON(http.StatusUnauthorized, ErrorResponse{Error: "Invalid request"})
+		return
+	}
+
+	if !resp.Response.Success {
+		logging.Logger.Error().Err(errors.New(resp.Response.Message)).Msg("unable to set overdraft limit")
+		status := http.StatusBadRequest
+		if resp.Response.Message == "Account not found" {
+			status = http.StatusNotFound
+		}
+		c.JSON(status, ErrorResponse{Error: resp.Response.Message})
+		return
+	}
+
+	res := SetOverdraftLimitResponse{
+		Account: resp.Account,
+		Message: resp.Response.Message,
+	}
+
+	c.JSON(http.StatusOK, res)
+}
@@ -27,6 +27,7 @@ func setupAccountRoutes(handler *AccountHandler) *gin.Engine {
 	router.DELETE("/api/v1/account", handler.DeleteAccount)
 	router.GET("/api/v1/account/:id/balance", handler.GetAccountBalance)
 	router.GET("/api/v1/account", handler.ListAccounts)
+	router.PUT("/api/v1/account/:id/overdraft", handler.SetOverdraftLimit)
 
 	return router
 }
@@ -39,7 +40,7 @@ func TestCreateAccount_Success(t *testing.T) {
 
 	createReq := CreateAccountRequest{
 		CustomerID:    "cust-12345",
-		DepositAmount: 1000.0,
+		DepositAmount: json.Number("1000.00"),
 	}
 
 	expectedResponse := &protoacc.CreateAccountResponse{
@@ -52,7 +53,7 @@ func TestCreateAccount_Success(t *testing.T) {
 
 	mockClient.On("CreateAccount", mock.Anything, mock.MatchedBy(func(req *protoacc.CreateAccountRequest) bool {
 		return req.CustomerId == "cust-12345" &&
-			req.InitialDeposit == 1000.0 &&
+			req.InitialDeposit == "1000.00" &&
 			req.Metadata != nil &&
 			req.Metadata.Requester == "test-admin" && // Match the username from middleware
 			req.Metadata.RequestId == "test-request-id"
@@ -135,12 +136,12 @@ func TestCreateAccount_GrpcError(t *testing.T) {
 
 	createReq := CreateAccountRequest{
 		CustomerID:    "cust-12345",
-		DepositAmount: 1000.0,
+		DepositAmount: json.Number("1000.00"),
 	}
 
 	mockClient.On("CreateAccount", mock.Anything, mock.MatchedBy(func(req *protoacc.CreateAccountRequest) bool {
 		return req.CustomerId == "cust-12345" &&
-			req.InitialDeposit == 1000.0 &&
+			req.InitialDeposit == "1000.00" &&
 			req.Metadata != nil &&
 			req.Metadata.Requester == "test-admin" &&
 			req.Metadata.RequestId == "test-request-id"
@@ -230,7 +231,9 @@ func TestGetAccountBalance_Success(t *testing.T) {
 	router := setupAccountRoutes(accountHandler)
 
 	expectedResponse := &protoacc.GetBalanceResponse{
-		Balance: 1500.75,
+		Balance:          "1500.75",
+		AvailableBalance: "1200.75",
+		LedgerBalance:    "1500.75",
 		Response: &protoacc.Response{
 			Success: true,
 			Message: "Balance retrieved successfully",
@@ -256,7 +259,9 @@ func TestGetAccountBalance_Success(t *testing.T) {
 	var response GetBalanceResponse
 	err := json.Unmarshal(w.Body.Bytes(), &response)
 	assert.NoError(t, err, "Failed to unmarshal response: %s", w.Body.String())
-	assert.Equal(t, 1500.75, response.Balance)
+	assert.Equal(t, "1500.75", response.Balance)
+	assert.Equal(t, "1200.75", response.AvailableBalance)
+	assert.Equal(t, "1500.75", response.LedgerBalance)
 	assert.Equal(t, "Balance retrieved successfully", response.Message)
 
 	mockClient.AssertExpectations(t)
@@ -269,7 +274,7 @@ func TestGetAccountBalance_ZeroBalance(t *testing.T) {
 	router := setupAccountRoutes(accountHandler)
 
 	expectedResponse := &protoacc.GetBalanceResponse{
-		Balance: 0.0,
+		Balance: "0.00",
 		Response: &protoacc.Response{
 			Success: true,
 			Message: "Balance retrieved successfully",
@@ -295,7 +300,7 @@ func TestGetAccountBalance_ZeroBalance(t *testing.T) {
 	var response GetBalanceResponse
 	err := json.Unmarshal(w.Body.Bytes(), &response)
 	assert.NoError(t, err, "Failed to unmarshal response: %s", w.Body.String())
-	assert.Equal(t, 0.0, response.Balance)
+	assert.Equal(t, "0.00", response.Balance)
 	assert.Equal(t, "Balance retrieved successfully", response.Message)
 
 	mockClient.AssertExpectations(t)
@@ -367,3 +372,85 @@ func TestGetAccountBalance_GrpcConnectionError(t *testing.T) {
 
 	mockClient.AssertExpectations(t)
 }
+
+// TestSetOverdraftLimit_Success tests setting the overdraft limit of a current account
+func TestSetOverdraftLimit_Success(t *testing.T) {
+	mockClient := new(mock_client.MockAccountClient)
+	accountHandler := &AccountHandler{AccountClient: mockClient}
+	router := setupAccountRoutes(accountHandler)
+
+	mockClient.On("SetOverdraftLimit", mock.Anything, mock.MatchedBy(func(req *protoacc.SetOverdraftLimitRequest) bool {
+		return req.AccountId == "acc-123" &&
+			req.OverdraftLimit == "500" &&
+			req.Metadata.Requester == "test-admin" &&
+			req.Metadata.RequestId == "test-request-id"
+	})).Return(&protoacc.SetOverdraftLimitResponse{
+		Account: &protoacc.Account{Id: "acc-123", AccountType: "current", Balance: "-20.00", OverdraftLimit: "500.00", Overdrawn: true},
+		Response: &protoacc.Response{
+			Success: true,
+			Message: "Overdraft limit successfully set",
+		},
+	}, nil)
+
+	req, _ := http.NewRequest("PUT", "/api/v1/account/acc-123/overdraft", bytes.NewBufferString(`{"overdraft_limit": 500}`))
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("X-Request-ID", "test-request-id")
+
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	var response map[string]interface{}
+	err := json.Unmarshal(w.Body.Bytes(), &response)
+	assert.NoError(t, err)
+	assert.Equal(t, "Overdraft limit successfully set", response["message"])
+	account := response["account"].(map[string]interface{})
+	assert.Equal(t, "500.00", account["overdraft_limit"])
+	assert.Equal(t, true, account["overdrawn"])
+
+	mockClient.AssertExpectations(t)
+}
+
+// TestSetOverdraftLimit_SavingsAccount tests that the error from the account service is returned
+func TestSetOverdraftLimit_SavingsAccount(t *testing.T) {
+	mockClient := new(mock_client.MockAccountClient)
+	accountHandler := &AccountHandler{AccountClient: mockClient}
+	router := setupAccountRoutes(accountHandler)
+
+	mockClient.On("SetOverdraftLimit", mock.Anything, mock.Anything).Return(&protoacc.SetOverdraftLimitResponse{
+		Response: &protoacc.Response{
+			Success: false,
+			Message: "overdraft is only available on current accounts",
+		},
+	}, nil)
+
+	req, _ := http.NewRequest("PUT", "/api/v1/account/acc-123/overdraft", bytes.NewBufferString(`{"overdraft_limit": "500.00"}`))
+	req.Header.Set("Content-Type", "application/json")
+
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+
+	var response ErrorResponse
+	err := json.Unmarshal(w.Body.Bytes(), &response)
+	assert.NoError(t, err)
+	assert.Equal(t, "overdraft is only available on current accounts", response.Error)
+}
+
+// TestSetOverdraftLimit_MissingLimit tests that the overdraft limit is required
+func TestSetOverdraftLimit_MissingLimit(t *testing.T) {
+	mockClient := new(mock_client.MockAccountClient)
+	accountHandler := &AccountHandler{AccountClient: mockClient}
+	router := setupAccountRoutes(accountHandler)
+
+	req, _ := http.NewRequest("PUT", "/api/v1/account/acc-123/overdraft", bytes.NewBufferString(`{}`))
+	req.Header.Set("Content-Type", "application/json")
+
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	mockClient.AssertNotCalled(t, "SetOverdraftLimit", mock.Anything, mock.Anything)
+}
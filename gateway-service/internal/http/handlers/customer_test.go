@@ -12,6 +12,7 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
 )
 
 func setupCustomerRoutes(accountHandler *AccountHandler) *gin.Engine {
@@ -27,6 +28,8 @@ func setupCustomerRoutes(accountHandler *AccountHandler) *gin.Engine {
 	router.DELETE("/api/v1/customer/:id", accountHandler.DeleteCustomer)
 	router.GET("/api/v1/customer", accountHandler.ListCustomer)
 	router.GET("/api/v1/customer/:id/account", accountHandler.ListCustomerAccounts)
+	router.GET("/api/v1/customer/:id", accountHandler.GetCustomer)
+	router.PUT("/api/v1/customer/:id", accountHandler.UpdateCustomer)
 
 	return router
 }
@@ -338,6 +341,97 @@ func TestDeleteCustomer_UnsuccessfulResponse(t *testing.T) {
 	mockClient.AssertExpectations(t)
 }
 
+// TestListCustomer_SuccessWithCursor tests that the cursor is passed on and the next cursor returned
+func TestListCustomer_SuccessWithCursor(t *testing.T) {
+	mockClient := new(mock_client.MockAccountClient)
+	accountHandler := NewAccountHandler(mockClient)
+	router := setupCustomerRoutes(accountHandler)
+
+	mockClient.On("ListCustomer", mock.Anything, mock.MatchedBy(func(req *protoacc.ListCustomersRequest) bool {
+		return req.Pagination.Cursor == "cursor-1" && !req.Pagination.IncludeTotal
+	})).Return(&protoacc.ListCustomersResponse{
+		Customers:  []*protoacc.Customer{{Id: "cust-3"}},
+		Pagination: &protoacc.PaginationResponse{PageSize: 1, NextCursor: "cursor-2"},
+		Response:   &protoacc.Response{Success: true, Message: "Customer List"},
+	}, nil)
+
+	req, _ := http.NewRequest("GET", "/api/v1/customer?cursor=cursor-1&pagesize=1", nil)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	var response ListCustomerResponse
+	assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+	assert.Equal(t, "cursor-2", response.NextCursor)
+	mockClient.AssertExpectations(t)
+}
+
+// TestListCustomer_InvalidCursor tests that a rejected cursor returns 400
+func TestListCustomer_InvalidCursor(t *testing.T) {
+	mockClient := new(mock_client.MockAccountClient)
+	accountHandler := NewAccountHandler(mockClient)
+	router := setupCustomerRoutes(accountHandler)
+
+	mockClient.On("ListCustomer", mock.Anything, mock.Anything).Return(&protoacc.ListCustomersResponse{
+		Response: &protoacc.Response{Success: false, Message: "Invalid request - invalid cursor"},
+	}, nil)
+
+	req, _ := http.NewRequest("GET", "/api/v1/customer?cursor=bogus", nil)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	assert.Contains(t, w.Body.String(), "invalid cursor")
+}
+
+// TestListCustomer_SuccessWithSearch tests that the search parameters are passed on to the account service
+func TestListCustomer_SuccessWithSearch(t *testing.T) {
+	mockClient := new(mock_client.MockAccountClient)
+	accountHandler := NewAccountHandler(mockClient)
+	router := setupCustomerRoutes(accountHandler)
+
+	mockClient.On("ListCustomer", mock.Anything, mock.MatchedBy(func(req *protoacc.ListCustomersRequest) bool {
+		return req.Name == "jo sm" &&
+			req.CreatedBy == "teller-1" &&
+			req.StartDate.AsTime().Equal(time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)) &&
+			req.EndDate.AsTime().Equal(time.Date(2026, 3, 31, 23, 59, 59, 0, time.UTC)) &&
+			req.ActiveStatus == "active" &&
+			req.MinBalance == "100" &&
+			req.AccountId == "acc-1"
+	})).Return(&protoacc.ListCustomersResponse{
+		Customers:  []*protoacc.Customer{{Id: "cust-1", Name: "John Smith"}},
+		Pagination: &protoacc.PaginationResponse{Page: 1, PageSize: 50, TotalCount: 1, TotalPages: 1},
+		Response:   &protoacc.Response{Success: true, Message: "Customer List"},
+	}, nil)
+
+	req, _ := http.NewRequest("GET", "/api/v1/customer?name=jo+sm&created_by=teller-1&start_date=01-03-2026&end_date=31-03-2026&active_status=active&min_balance=100&account_id=acc-1", nil)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	var response ListCustomerResponse
+	assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+	assert.Equal(t, 1, response.TotalCount)
+	mockClient.AssertExpectations(t)
+}
+
+// TestListCustomer_InvalidDate tests that a malformed date is rejected before calling the account service
+func TestListCustomer_InvalidDate(t *testing.T) {
+	mockClient := new(mock_client.MockAccountClient)
+	accountHandler := NewAccountHandler(mockClient)
+	router := setupCustomerRoutes(accountHandler)
+
+	req, _ := http.NewRequest("GET", "/api/v1/customer?start_date=2026-03-01", nil)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	assert.Contains(t, w.Body.String(), "Invalid start_date format")
+	mockClient.AssertNotCalled(t, "ListCustomer", mock.Anything, mock.Anything)
+}
+
 // TestListCustomer_Success tests successful customer listing
 func TestListCustomer_Success(t *testing.T) {
 	mockClient := new(mock_client.MockAccountClient)
@@ -548,12 +642,12 @@ func TestListCustomerAccounts_Success(t *testing.T) {
 		{
 			Id:         "acc-1",
 			CustomerId: "cust-123",
-			Balance:    1000.50,
+			Balance:    "1000.50",
 		},
 		{
 			Id:         "acc-2",
 			CustomerId: "cust-123",
-			Balance:    2500.75,
+			Balance:    "2500.75",
 		},
 	}
 
@@ -740,8 +834,8 @@ func TestListAccounts_SuccessDefaultPagination(t *testing.T) {
 
 	expectedResponse := &protoacc.ListAccountsResponse{
 		Accounts: []*protoacc.Account{
-			{Id: "acc-1", CustomerId: "cust-123", Balance: 1000.0, ActiveStatus: "active"},
-			{Id: "acc-2", CustomerId: "cust-123", Balance: 2000.0, ActiveStatus: "active"},
+			{Id: "acc-1", CustomerId: "cust-123", Balance: "1000.00", ActiveStatus: "active"},
+			{Id: "acc-2", CustomerId: "cust-123", Balance: "2000.00", ActiveStatus: "active"},
 		},
 		Pagination: &protoacc.PaginationResponse{
 			Page:       1,
@@ -797,8 +891,8 @@ func TestListAccounts_SuccessWithCustomerFilter(t *testing.T) {
 
 	expectedResponse := &protoacc.ListAccountsResponse{
 		Accounts: []*protoacc.Account{
-			{Id: "acc-1", CustomerId: "cust-123", Balance: 1000.0, ActiveStatus: "active"},
-			{Id: "acc-2", CustomerId: "cust-123", Balance: 2000.0, ActiveStatus: "active"},
+			{Id: "acc-1", CustomerId: "cust-123", Balance: "1000.00", ActiveStatus: "active"},
+			{Id: "acc-2", CustomerId: "cust-123", Balance: "2000.00", ActiveStatus: "active"},
 		},
 		Pagination: &protoacc.PaginationResponse{
 			Page:       1,
@@ -876,3 +970,108 @@ func TestListAccounts_InvalidPageNumber(t *testing.T) {
 
 	mockClient.AssertExpectations(t)
 }
+
+// TestGetCustomer_Success tests that the customer profile is returned
+func TestGetCustomer_Success(t *testing.T) {
+	mockClient := new(mock_client.MockAccountClient)
+	accountHandler := NewAccountHandler(mockClient)
+	router := setupCustomerRoutes(accountHandler)
+
+	mockClient.On("GetCustomer", mock.Anything, mock.MatchedBy(func(req *protoacc.GetCustomerRequest) bool {
+		return req.CustomerId == "cust-1" && req.Metadata.Requester == "test-admin"
+	})).Return(&protoacc.GetCustomerResponse{
+		Customer: &protoacc.CustomerWithAccounts{
+			Customer: &protoacc.Customer{Id: "cust-1", Name: "Jane Doe", KycStatus: "verified"},
+		},
+		Response: &protoacc.Response{Success: true, Message: "Customer details"},
+	}, nil)
+
+	req, _ := http.NewRequest("GET", "/api/v1/customer/cust-1", nil)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Contains(t, w.Body.String(), `"kyc_status":"verified"`)
+	mockClient.AssertExpectations(t)
+}
+
+// TestGetCustomer_NotFound tests that an unknown customer returns 404
+func TestGetCustomer_NotFound(t *testing.T) {
+	mockClient := new(mock_client.MockAccountClient)
+	accountHandler := NewAccountHandler(mockClient)
+	router := setupCustomerRoutes(accountHandler)
+
+	mockClient.On("GetCustomer", mock.Anything, mock.Anything).Return(&protoacc.GetCustomerResponse{
+		Response: &protoacc.Response{Success: false, Message: "Customer not found"},
+	}, nil)
+
+	req, _ := http.NewRequest("GET", "/api/v1/customer/cust-x", nil)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusNotFound, w.Code)
+}
+
+// TestUpdateCustomer_Success tests that the profile fields are passed on and the updated customer returned
+func TestUpdateCustomer_Success(t *testing.T) {
+	mockClient := new(mock_client.MockAccountClient)
+	accountHandler := NewAccountHandler(mockClient)
+	router := setupCustomerRoutes(accountHandler)
+
+	mockClient.On("UpdateCustomer", mock.Anything, mock.MatchedBy(func(req *protoacc.UpdateCustomerRequest) bool {
+		return req.CustomerId == "cust-1" &&
+			req.Name == "" &&
+			req.DateOfBirth == "1990-07-15" &&
+			req.NationalId == "AB123456" &&
+			req.Address == "1 Main St" &&
+			req.KycStatus == "verified" &&
+			req.Metadata.Requester == "test-admin"
+	})).Return(&protoacc.UpdateCustomerResponse{
+		Customer: &protoacc.Customer{Id: "cust-1", Name: "Jane Doe", KycStatus: "verified"},
+		Response: &protoacc.Response{Success: true, Message: "Customer updated successfully"},
+	}, nil)
+
+	body := `{"date_of_birth":"1990-07-15","national_id":"AB123456","address":"1 Main St","kyc_status":"verified"}`
+	req, _ := http.NewRequest("PUT", "/api/v1/customer/cust-1", bytes.NewBufferString(body))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Contains(t, w.Body.String(), "Customer updated successfully")
+	mockClient.AssertExpectations(t)
+}
+
+// TestUpdateCustomer_ValidationError tests that a rejected profile returns the message of the account service
+func TestUpdateCustomer_ValidationError(t *testing.T) {
+	mockClient := new(mock_client.MockAccountClient)
+	accountHandler := NewAccountHandler(mockClient)
+	router := setupCustomerRoutes(accountHandler)
+
+	mockClient.On("UpdateCustomer", mock.Anything, mock.Anything).Return(&protoacc.UpdateCustomerResponse{
+		Response: &protoacc.Response{Success: false, Message: "Invalid request - invalid email address"},
+	}, nil)
+
+	req, _ := http.NewRequest("PUT", "/api/v1/customer/cust-1", bytes.NewBufferString(`{"email":"jane"}`))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	assert.Contains(t, w.Body.String(), "invalid email address")
+}
+
+// TestUpdateCustomer_InvalidPayload tests that a malformed body is rejected before calling the account service
+func TestUpdateCustomer_InvalidPayload(t *testing.T) {
+	mockClient := new(mock_client.MockAccountClient)
+	accountHandler := NewAccountHandler(mockClient)
+	router := setupCustomerRoutes(accountHandler)
+
+	req, _ := http.NewRequest("PUT", "/api/v1/customer/cust-1", bytes.NewBufferString(`{"name":`))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	mockClient.AssertNotCalled(t, "UpdateCustomer", mock.Anything, mock.Anything)
+}
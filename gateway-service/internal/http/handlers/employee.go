@@ -47,6 +47,7 @@ type ListEmployeeResponse struct {
 	PageSize   int         `json:"pageSize"`
 	TotalCount int         `json:"totalCount"`
 	TotalPages int         `json:"totalPages"`
+	NextCursor string      `json:"next_cursor"` // empty on the last page
 	Message    string      `json:"message" binding:"message"`
 }
 
@@ -199,6 +200,16 @@ func (h *AuthHandler) DeleteEmployee(c *gin.Context) {
 // @Description - Number of employees per page
 // @Description - Default: 50
 // @Description
+// @Description cursor:
+// @Description - Optional
+// @Description - next_cursor of the previous page; the page continues after it and page is ignored
+// @Description - Unlike page, a cursor does not skip or repeat rows added while paging
+// @Description
+// @Description include_total:
+// @Description - Optional
+// @Description - Count totalCount and totalPages, which are 0 otherwise
+// @Description - Default: true without cursor, false with cursor
+// @Description
 // @Description order:
 // @Description - Optional
 // @Description - Sort order (asc/desc)
@@ -214,6 +225,8 @@ func (h *AuthHandler) DeleteEmployee(c *gin.Context) {
 // @Param Authorization header string true "Bearer token" default(Bearer )
 // @Param page query int false "Page number for pagination" default(1)
 // @Param pagesize query int false "Number of employee per page" default(50)
+// @Param cursor query string false "next_cursor of the previous page"
+// @Param include_total query bool false "Count totalCount and totalPages (default: true without cursor, false with cursor)"
 // @Param order query string false "Sort order (asc/desc)" default(desc)
 // @Success 200 {object} ListEmployeeResponse "Successfully retrieved employee list"
 // @Failure 400 {object} ErrorResponse
@@ -223,6 +236,7 @@ func (h *AuthHandler) ListEmployee(c *gin.Context) {
 	pageStr := strings.TrimSpace(c.Query("page"))
 	pageSizeStr := strings.TrimSpace(c.Query("pagesize"))
 	order := strings.TrimSpace(c.Query("order"))
+	cursor, includeTotal := cursorQuery(c)
 
 	var pageNo int = -1
 	var err error
@@ -250,9 +264,11 @@ func (h *AuthHandler) ListEmployee(c *gin.Context) {
 	}
 
 	grpcReq := &protoauth.ListEmployeeRequest{
-		SortOrder: order,
-		Page:      int32(pageNo),
-		PageSize:  int32(pageSize),
+		SortOrder:    order,
+		Page:         int32(pageNo),
+		PageSize:     int32(pageSize),
+		Cursor:       cursor,
+		IncludeTotal: includeTotal,
 	}
 
 	resp, err := h.AuthClient.ListEmployee(c.Request.Context(), grpcReq)
@@ -274,6 +290,7 @@ func (h *AuthHandler) ListEmployee(c *gin.Context) {
 		PageSize:   int(resp.PageSize),
 		TotalCount: int(resp.TotalCount),
 		TotalPages: int(resp.TotalPages),
+		NextCursor: resp.NextCursor,
 		Message:    resp.Message,
 	}
 
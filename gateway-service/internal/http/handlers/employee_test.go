@@ -34,7 +34,7 @@ func setupEmployeeRoutes(authHandler *AuthHandler) *gin.Engine {
 // TestCreateEmployee_Success tests successful employee creation
 func TestCreateEmployee_Success(t *testing.T) {
 	mockClient := new(mock_client.MockAuthClient)
-	authHandler := NewAuthHandler(mockClient)
+	authHandler := NewAuthHandler(mockClient, nil)
 	router := setupEmployeeRoutes(authHandler)
 
 	createReq := CreateEmployeeRequest{
@@ -76,7 +76,7 @@ func TestCreateEmployee_Success(t *testing.T) {
 // TestCreateEmployee_InvalidPayload tests employee creation with invalid payload
 func TestCreateEmployee_InvalidPayload(t *testing.T) {
 	mockClient := new(mock_client.MockAuthClient)
-	authHandler := NewAuthHandler(mockClient)
+	authHandler := NewAuthHandler(mockClient, nil)
 	router := setupEmployeeRoutes(authHandler)
 
 	invalidJSON := `{"username": "test"}`
@@ -98,7 +98,7 @@ func TestCreateEmployee_InvalidPayload(t *testing.T) {
 // TestCreateEmployee_GRPCFailure tests employee creation when gRPC call fails
 func TestCreateEmployee_GRPCFailure(t *testing.T) {
 	mockClient := new(mock_client.MockAuthClient)
-	authHandler := NewAuthHandler(mockClient)
+	authHandler := NewAuthHandler(mockClient, nil)
 	router := setupEmployeeRoutes(authHandler)
 
 	createReq := CreateEmployeeRequest{
@@ -134,7 +134,7 @@ func TestCreateEmployee_GRPCFailure(t *testing.T) {
 // TestCreateEmployee_UnsuccessfulResponse tests employee creation with unsuccessful gRPC response
 func TestCreateEmployee_UnsuccessfulResponse(t *testing.T) {
 	mockClient := new(mock_client.MockAuthClient)
-	authHandler := NewAuthHandler(mockClient)
+	authHandler := NewAuthHandler(mockClient, nil)
 	router := setupEmployeeRoutes(authHandler)
 
 	createReq := CreateEmployeeRequest{
@@ -175,7 +175,7 @@ func TestCreateEmployee_UnsuccessfulResponse(t *testing.T) {
 // TestDeleteEmployee_Success tests successful employee deletion
 func TestDeleteEmployee_Success(t *testing.T) {
 	mockClient := new(mock_client.MockAuthClient)
-	authHandler := NewAuthHandler(mockClient)
+	authHandler := NewAuthHandler(mockClient, nil)
 	router := setupEmployeeRoutes(authHandler)
 
 	expectedResponse := &protoauth.DeleteEmployeeResponse{
@@ -207,7 +207,7 @@ func TestDeleteEmployee_Success(t *testing.T) {
 // TestDeleteEmployee_GRPCFailure tests employee deletion when gRPC call fails
 func TestDeleteEmployee_GRPCFailure(t *testing.T) {
 	mockClient := new(mock_client.MockAuthClient)
-	authHandler := NewAuthHandler(mockClient)
+	authHandler := NewAuthHandler(mockClient, nil)
 	router := setupEmployeeRoutes(authHandler)
 
 	mockClient.On("DeleteEmployee", mock.Anything, &protoauth.DeleteEmployeeRequest{
@@ -234,7 +234,7 @@ func TestDeleteEmployee_GRPCFailure(t *testing.T) {
 // TestDeleteEmployee_UnsuccessfulResponse tests employee deletion with unsuccessful gRPC response
 func TestDeleteEmployee_UnsuccessfulResponse(t *testing.T) {
 	mockClient := new(mock_client.MockAuthClient)
-	authHandler := NewAuthHandler(mockClient)
+	authHandler := NewAuthHandler(mockClient, nil)
 	router := setupEmployeeRoutes(authHandler)
 
 	expectedResponse := &protoauth.DeleteEmployeeResponse{
@@ -266,7 +266,7 @@ func TestDeleteEmployee_UnsuccessfulResponse(t *testing.T) {
 // TestListEmployee_Success tests successful employee listing
 func TestListEmployee_Success(t *testing.T) {
 	mockClient := new(mock_client.MockAuthClient)
-	authHandler := NewAuthHandler(mockClient)
+	authHandler := NewAuthHandler(mockClient, nil)
 	router := setupEmployeeRoutes(authHandler)
 
 	expectedEmployees := []*protoauth.Employee{
@@ -293,9 +293,10 @@ func TestListEmployee_Success(t *testing.T) {
 	}
 
 	mockClient.On("ListEmployee", mock.Anything, &protoauth.ListEmployeeRequest{
-		SortOrder: "desc",
-		Page:      1,
-		PageSize:  50,
+		SortOrder:    "desc",
+		Page:         1,
+		PageSize:     50,
+		IncludeTotal: true,
 	}).Return(expectedResponse, nil)
 
 	req, _ := http.NewRequest("GET", "/api/v1/employee?page=1&pagesize=50&order=desc", nil)
@@ -322,7 +323,7 @@ func TestListEmployee_Success(t *testing.T) {
 // TestListEmployee_InvalidPagination tests employee listing with invalid pagination parameters
 func TestListEmployee_InvalidPagination(t *testing.T) {
 	mockClient := new(mock_client.MockAuthClient)
-	authHandler := NewAuthHandler(mockClient)
+	authHandler := NewAuthHandler(mockClient, nil)
 	router := setupEmployeeRoutes(authHandler)
 
 	expectedResponse := &protoauth.ListEmployeeResponse{
@@ -336,9 +337,10 @@ func TestListEmployee_InvalidPagination(t *testing.T) {
 	}
 
 	mockClient.On("ListEmployee", mock.Anything, &protoauth.ListEmployeeRequest{
-		SortOrder: "",
-		Page:      -1,
-		PageSize:  -1,
+		SortOrder:    "",
+		Page:         -1,
+		PageSize:     -1,
+		IncludeTotal: true,
 	}).Return(expectedResponse, nil)
 
 	req, _ := http.NewRequest("GET", "/api/v1/employee?page=invalid&pagesize=invalid", nil)
@@ -361,13 +363,14 @@ func TestListEmployee_InvalidPagination(t *testing.T) {
 // TestListEmployee_GRPCFailure tests employee listing when gRPC call fails
 func TestListEmployee_GRPCFailure(t *testing.T) {
 	mockClient := new(mock_client.MockAuthClient)
-	authHandler := NewAuthHandler(mockClient)
+	authHandler := NewAuthHandler(mockClient, nil)
 	router := setupEmployeeRoutes(authHandler)
 
 	mockClient.On("ListEmployee", mock.Anything, &protoauth.ListEmployeeRequest{
-		SortOrder: "desc",
-		Page:      1,
-		PageSize:  50,
+		SortOrder:    "desc",
+		Page:         1,
+		PageSize:     50,
+		IncludeTotal: true,
 	}).Return(nil, errors.New("gRPC connection failed"))
 
 	req, _ := http.NewRequest("GET", "/api/v1/employee?page=1&pagesize=50&order=desc", nil)
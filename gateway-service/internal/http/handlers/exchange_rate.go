@@ -0,0 +1,154 @@
+package handlers
+
+import (
+	"errors"
+	prototx "gateway-service/api/protogen/txservice/proto"
+	"gateway-service/internal/logging"
+	"github.com/gin-gonic/gin"
+	"net/http"
+)
+
+type SetExchangeRateRequest struct {
+	BaseCurrency  string `json:"base_currency" binding:"required"`
+	QuoteCurrency string `json:"quote_currency" binding:"required"`
+	Rate          string `json:"rate" binding:"required"` // decimal, e.g. "109.50"
+}
+
+type SetExchangeRateResponse struct {
+	ExchangeRate interface{} `json:"exchange_rate"`
+	Message      string      `json:"message" binding:"message"`
+}
+
+type ListExchangeRatesResponse struct {
+	ExchangeRates interface{} `json:"exchange_rates"`
+	Message       string      `json:"message" binding:"message"`
+}
+
+// SetExchangeRate creates or updates the rate of a currency pair
+// @Tags Exchange Rate
+// @Summary Set Exchange Rate
+// @Description
+// @Description **Request Body:**
+// @Description
+// @Description Base Currency:
+// @Description - Required
+// @Description - Options: **USD**, **EUR**, **BDT**
+// @Description
+// @Description Quote Currency:
+// @Description - Required
+// @Description - Options: **USD**, **EUR**, **BDT**
+// @Description - Must differ from base currency
+// @Description
+// @Description Rate:
+// @Description - Required
+// @Description - Units of quote currency per one unit of base currency
+// @Description - Positive decimal string with at most 6 decimal places (e.g. "109.50")
+// @Description
+// @Description **Header:**
+// @Description
+// @Description Authorization:
+// @Description - Required
+// @Description - Format: Bearer token
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer token" default(Bearer )
+// @Param exchange_rate body SetExchangeRateRequest true "Exchange rate details"
+// @Success 200 {object} SetExchangeRateResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Router /api/v1/exchange-rate [put]
+func (h *TransactionHandler) SetExchangeRate(c *gin.Context) {
+	var req SetExchangeRateRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		logging.Logger.Warn().Err(err).Msg("invalid request param")
+		c.JSON(http.StatusBadRequest, ErrorResponse{Error: "Invalid request payload"})
+		return
+	}
+
+	un, _ := c.Get("username") // middleware
+	requester, ok := un.(string)
+	if !ok {
+		logging.Logger.Warn().Err(errors.New("unable to get requester username")).Msg("requester: " + requester)
+	}
+
+	grpcReq := &prototx.SetExchangeRateRequest{
+		BaseCurrency:  req.BaseCurrency,
+		QuoteCurrency: req.QuoteCurrency,
+		Rate:          req.Rate,
+		Metadata: &prototx.Metadata{
+			RequestId: c.GetHeader("X-Request-ID"),
+			Requester: requester,
+		},
+	}
+
+	resp, err := h.TransactionClient.SetExchangeRate(c.Request.Context(), grpcReq)
+	if err != nil || resp == nil {
+		logging.Logger.Error().Err(err).Msg("failed to set exchange rate")
+		c.JSON(http.StatusUnauthorized, ErrorResponse{Error: "Invalid request"})
+		return
+	}
+
+	if !resp.Response.Success {
+		logging.Logger.Error().Err(errors.New(resp.Response.Message)).Msg("unable to set exchange rate")
+		c.JSON(http.StatusBadRequest, ErrorResponse{Error: resp.Response.Message})
+		return
+	}
+
+	res := SetExchangeRateResponse{
+		ExchangeRate: resp.ExchangeRate,
+		Message:      resp.Response.Message,
+	}
+
+	c.JSON(http.StatusOK, res)
+}
+
+// ListExchangeRates lists all configured exchange rates
+// @Tags Exchange Rate
+// @Summary List Exchange Rates
+// @Description
+// @Description **Header:**
+// @Description
+// @Description Authorization:
+// @Description - Required
+// @Description - Format: Bearer token
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer token" default(Bearer )
+// @Success 200 {object} ListExchangeRatesResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Router /api/v1/exchange-rate [get]
+func (h *TransactionHandler) ListExchangeRates(c *gin.Context) {
+	un, _ := c.Get("username") // middleware
+	requester, ok := un.(string)
+	if !ok {
+		logging.Logger.Warn().Err(errors.New("unable to get requester username")).Msg("requester: " + requester)
+	}
+
+	grpcReq := &prototx.ListExchangeRatesRequest{
+		Metadata: &prototx.Metadata{
+			RequestId: c.GetHeader("X-Request-ID"),
+			Requester: requester,
+		},
+	}
+
+	resp, err := h.TransactionClient.ListExchangeRates(c.Request.Context(), grpcReq)
+	if err != nil {
+		logging.Logger.Error().Err(err).Msg("failed to get exchange rates")
+		c.JSON(http.StatusUnauthorized, ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	if !resp.Response.Success {
+		logging.Logger.Error().Err(errors.New(resp.Response.Message)).Msg("unable to get exchange rates")
+		c.JSON(http.StatusBadRequest, ErrorResponse{Error: resp.Response.Message})
+		return
+	}
+
+	res := ListExchangeRatesResponse{
+		ExchangeRates: resp.ExchangeRates,
+		Message:       resp.Response.Message,
+	}
+
+	c.JSON(http.StatusOK, res)
+}
@@ -0,0 +1,188 @@
+package handlers
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	prototx "gateway-service/api/protogen/txservice/proto"
+	mock_client "gateway-service/internal/ports/mocks/grpc_client"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func setupExchangeRateRoutes(handler *TransactionHandler) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+
+	router.Use(func(c *gin.Context) {
+		c.Set("username", "test-admin")
+		c.Next()
+	})
+
+	router.PUT("/api/v1/exchange-rate", handler.SetExchangeRate)
+	router.GET("/api/v1/exchange-rate", handler.ListExchangeRates)
+
+	return router
+}
+
+// TestSetExchangeRate_Success tests setting the rate of a currency pair
+func TestSetExchangeRate_Success(t *testing.T) {
+	mockClient := new(mock_client.MockTransactionClient)
+	handler := &TransactionHandler{TransactionClient: mockClient}
+	router := setupExchangeRateRoutes(handler)
+
+	request := SetExchangeRateRequest{
+		BaseCurrency:  "USD",
+		QuoteCurrency: "BDT",
+		Rate:          "109.50",
+	}
+
+	expectedResponse := &prototx.SetExchangeRateResponse{
+		ExchangeRate: &prototx.ExchangeRate{
+			BaseCurrency:  "USD",
+			QuoteCurrency: "BDT",
+			Rate:          "109.500000",
+			UpdatedBy:     "test-admin",
+		},
+		Response: &prototx.Response{
+			Success: true,
+			Message: "Exchange rate updated successfully",
+		},
+	}
+
+	mockClient.On("SetExchangeRate", mock.Anything, mock.MatchedBy(func(req *prototx.SetExchangeRateRequest) bool {
+		return req.BaseCurrency == "USD" &&
+			req.QuoteCurrency == "BDT" &&
+			req.Rate == "109.50" &&
+			req.Metadata != nil &&
+			req.Metadata.Requester == "test-admin" &&
+			req.Metadata.RequestId == "test-request-id"
+	})).Return(expectedResponse, nil)
+
+	body, _ := json.Marshal(request)
+	req, _ := http.NewRequest("PUT", "/api/v1/exchange-rate", bytes.NewBuffer(body))
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("X-Request-ID", "test-request-id")
+
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	var response map[string]interface{}
+	err := json.Unmarshal(w.Body.Bytes(), &response)
+	assert.NoError(t, err)
+	assert.Equal(t, "Exchange rate updated successfully", response["message"])
+	exchangeRate := response["exchange_rate"].(map[string]interface{})
+	assert.Equal(t, "109.500000", exchangeRate["rate"])
+
+	mockClient.AssertExpectations(t)
+}
+
+// TestSetExchangeRate_MissingRate tests if rate is not provided
+func TestSetExchangeRate_MissingRate(t *testing.T) {
+	mockClient := new(mock_client.MockTransactionClient)
+	handler := &TransactionHandler{TransactionClient: mockClient}
+	router := setupExchangeRateRoutes(handler)
+
+	body := []byte(`{"base_currency": "USD", "quote_currency": "EUR"}`)
+	req, _ := http.NewRequest("PUT", "/api/v1/exchange-rate", bytes.NewBuffer(body))
+	req.Header.Set("Content-Type", "application/json")
+
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	mockClient.AssertNotCalled(t, "SetExchangeRate")
+}
+
+// TestSetExchangeRate_UnsuccessfulResponse tests if the transaction service rejects the rate
+func TestSetExchangeRate_UnsuccessfulResponse(t *testing.T) {
+	mockClient := new(mock_client.MockTransactionClient)
+	handler := &TransactionHandler{TransactionClient: mockClient}
+	router := setupExchangeRateRoutes(handler)
+
+	request := SetExchangeRateRequest{
+		BaseCurrency:  "USD",
+		QuoteCurrency: "JPY",
+		Rate:          "150",
+	}
+
+	mockClient.On("SetExchangeRate", mock.Anything, mock.Anything).Return(&prototx.SetExchangeRateResponse{
+		Response: &prototx.Response{
+			Success: false,
+			Message: "Unsupported currency, supported currencies - USD, EUR, BDT",
+		},
+	}, nil)
+
+	body, _ := json.Marshal(request)
+	req, _ := http.NewRequest("PUT", "/api/v1/exchange-rate", bytes.NewBuffer(body))
+	req.Header.Set("Content-Type", "application/json")
+
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+
+	var response ErrorResponse
+	err := json.Unmarshal(w.Body.Bytes(), &response)
+	assert.NoError(t, err)
+	assert.Equal(t, "Unsupported currency, supported currencies - USD, EUR, BDT", response.Error)
+
+	mockClient.AssertExpectations(t)
+}
+
+// TestListExchangeRates_Success tests listing the configured exchange rates
+func TestListExchangeRates_Success(t *testing.T) {
+	mockClient := new(mock_client.MockTransactionClient)
+	handler := &TransactionHandler{TransactionClient: mockClient}
+	router := setupExchangeRateRoutes(handler)
+
+	expectedResponse := &prototx.ListExchangeRatesResponse{
+		ExchangeRates: []*prototx.ExchangeRate{
+			{BaseCurrency: "EUR", QuoteCurrency: "USD", Rate: "1.080000"},
+			{BaseCurrency: "USD", QuoteCurrency: "BDT", Rate: "109.500000"},
+		},
+		Response: &prototx.Response{
+			Success: true,
+			Message: "Exchange rates",
+		},
+	}
+
+	mockClient.On("ListExchangeRates", mock.Anything, mock.MatchedBy(func(req *prototx.ListExchangeRatesRequest) bool {
+		return req.Metadata != nil && req.Metadata.Requester == "test-admin"
+	})).Return(expectedResponse, nil)
+
+	req, _ := http.NewRequest("GET", "/api/v1/exchange-rate", nil)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	var response map[string]interface{}
+	err := json.Unmarshal(w.Body.Bytes(), &response)
+	assert.NoError(t, err)
+	assert.Len(t, response["exchange_rates"], 2)
+
+	mockClient.AssertExpectations(t)
+}
+
+// TestListExchangeRates_GrpcConnectionError tests if grpc call fails
+func TestListExchangeRates_GrpcConnectionError(t *testing.T) {
+	mockClient := new(mock_client.MockTransactionClient)
+	handler := &TransactionHandler{TransactionClient: mockClient}
+	router := setupExchangeRateRoutes(handler)
+
+	mockClient.On("ListExchangeRates", mock.Anything, mock.Anything).Return(nil, errors.New("connection refused"))
+
+	req, _ := http.NewRequest("GET", "/api/v1/exchange-rate", nil)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusUnauthorized, w.Code)
+	mockClient.AssertExpectations(t)
+}
@@ -0,0 +1,250 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	prototx "gateway-service/api/protogen/txservice/proto"
+	"gateway-service/internal/logging"
+	"github.com/gin-gonic/gin"
+	"net/http"
+	"strings"
+)
+
+type SetFeeRuleRequest struct {
+	TransactionType string      `json:"transaction_type" binding:"required"`
+	AccountType     string      `json:"account_type"`
+	MinAmount       json.Number `json:"min_amount" swaggertype:"string"` // decimal, e.g. "1000.00"
+	MaxAmount       json.Number `json:"max_amount" swaggertype:"string"`
+	FeeType         string      `json:"fee_type" binding:"required"`
+	FlatAmount      json.Number `json:"flat_amount" swaggertype:"string"`
+	Percentage      json.Number `json:"percentage" swaggertype:"string"` // percent, e.g. "1.25"
+	MinFee          json.Number `json:"min_fee" swaggertype:"string"`
+	MaxFee          json.Number `json:"max_fee" swaggertype:"string"`
+}
+
+type SetFeeRuleResponse struct {
+	Rule    interface{} `json:"rule"`
+	Message string      `json:"message" binding:"message"`
+}
+
+type ListFeeRulesResponse struct {
+	Rules   interface{} `json:"rules"`
+	Message string      `json:"message" binding:"message"`
+}
+
+type DeleteFeeRuleResponse struct {
+	Message string `json:"message" binding:"message"`
+}
+
+// SetFeeRule creates or overrides a transaction fee rule
+// @Tags Fee Rule
+// @Summary Set Fee Rule
+// @Description
+// @Description **Request Body:**
+// @Description
+// @Description Transaction Type:
+// @Description - Required
+// @Description - Options: **transfer**, **withdraw_amount**
+// @Description
+// @Description Account Type:
+// @Description - Optional
+// @Description - Options: **savings**, **current**; omitted matches every account type
+// @Description - A rule of the source account type wins over a rule for every account type
+// @Description
+// @Description Min Amount, Max Amount:
+// @Description - Optional
+// @Description - The inclusive amount band the rule applies to, in the source account currency
+// @Description - Omitted min amount starts the band at zero, omitted or zero max amount means no upper bound
+// @Description - A rule replaces the rule with the same transaction type, account type and min amount
+// @Description - When bands overlap the band with the highest min amount wins
+// @Description
+// @Description Fee Type:
+// @Description - Required
+// @Description - Options: **flat**, **percentage**
+// @Description
+// @Description Flat Amount:
+// @Description - The fee of a **flat** rule
+// @Description
+// @Description Percentage:
+// @Description - The percent of the amount charged by a **percentage** rule, at most 4 decimal places
+// @Description
+// @Description Min Fee, Max Fee:
+// @Description - Optional
+// @Description - Bounds of the fee; omitted or zero max fee means no cap
+// @Description
+// @Description Amounts are decimal numbers or strings with at most 2 decimal places. The fee is debited from the
+// @Description source account on top of the amount and credited to the bank income account in the same transaction.
+// @Description Full withdrawals, deposits, hold captures and reversals carry no fee, and a reversal does not refund the fee.
+// @Description
+// @Description **Header:**
+// @Description
+// @Description Authorization:
+// @Description - Required
+// @Description - Format: Bearer token
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer token" default(Bearer )
+// @Param fee_rule body SetFeeRuleRequest true "Fee rule details"
+// @Success 200 {object} SetFeeRuleResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Router /api/v1/fee-rule [put]
+func (h *TransactionHandler) SetFeeRule(c *gin.Context) {
+	var req SetFeeRuleRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		logging.Logger.Warn().Err(err).Msg("invalid request param")
+		c.JSON(http.StatusBadRequest, ErrorResponse{Error: "Invalid request payload"})
+		return
+	}
+
+	un, _ := c.Get("username") // middleware
+	requester, ok := un.(string)
+	if !ok {
+		logging.Logger.Warn().Err(errors.New("unable to get requester username")).Msg("requester: " + requester)
+	}
+
+	grpcReq := &prototx.SetFeeRuleRequest{
+		TransactionType: req.TransactionType,
+		AccountType:     req.AccountType,
+		MinAmount:       req.MinAmount.String(),
+		MaxAmount:       req.MaxAmount.String(),
+		FeeType:         req.FeeType,
+		FlatAmount:      req.FlatAmount.String(),
+		Percentage:      req.Percentage.String(),
+		MinFee:          req.MinFee.String(),
+		MaxFee:          req.MaxFee.String(),
+		Metadata: &prototx.Metadata{
+			RequestId: c.GetHeader("X-Request-ID"),
+			Requester: requester,
+		},
+	}
+
+	resp, err := h.TransactionClient.SetFeeRule(c.Request.Context(), grpcReq)
+	if err != nil || resp == nil {
+		logging.Logger.Error().Err(err).Msg("failed to set fee rule")
+		c.JSON(http.StatusUnauthorized, ErrorResponse{Error: "Invalid request"})
+		return
+	}
+
+	if !resp.Response.Success {
+		logging.Logger.Error().Err(errors.New(resp.Response.Message)).Msg("unable to set fee rule")
+		c.JSON(http.StatusBadRequest, ErrorResponse{Error: resp.Response.Message})
+		return
+	}
+
+	res := SetFeeRuleResponse{
+		Rule:    resp.Rule,
+		Message: resp.Response.Message,
+	}
+
+	c.JSON(http.StatusOK, res)
+}
+
+// ListFeeRules lists all configured fee rules
+// @Tags Fee Rule
+// @Summary List Fee Rules
+// @Description
+// @Description **Header:**
+// @Description
+// @Description Authorization:
+// @Description - Required
+// @Description - Format: Bearer token
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer token" default(Bearer )
+// @Success 200 {object} ListFeeRulesResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Router /api/v1/fee-rule [get]
+func (h *TransactionHandler) ListFeeRules(c *gin.Context) {
+	un, _ := c.Get("username") // middleware
+	requester, ok := un.(string)
+	if !ok {
+		logging.Logger.Warn().Err(errors.New("unable to get requester username")).Msg("requester: " + requester)
+	}
+
+	grpcReq := &prototx.ListFeeRulesRequest{
+		Metadata: &prototx.Metadata{
+			RequestId: c.GetHeader("X-Request-ID"),
+			Requester: requester,
+		},
+	}
+
+	resp, err := h.TransactionClient.ListFeeRules(c.Request.Context(), grpcReq)
+	if err != nil || resp == nil {
+		logging.Logger.Error().Err(err).Msg("failed to get fee rules")
+		c.JSON(http.StatusUnauthorized, ErrorResponse{Error: "Invalid request"})
+		return
+	}
+
+	if !resp.Response.Success {
+		logging.Logger.Error().Err(errors.New(resp.Response.Message)).Msg("unable to get fee rules")
+		c.JSON(http.StatusBadRequest, ErrorResponse{Error: resp.Response.Message})
+		return
+	}
+
+	res := ListFeeRulesResponse{
+		Rules:   resp.Rules,
+		Message: resp.Response.Message,
+	}
+
+	c.JSON(http.StatusOK, res)
+}
+
+// DeleteFeeRule removes a fee rule
+// @Tags Fee Rule
+// @Summary Delete Fee Rule
+// @Description
+// @Description Debits the deleted rule matched are charged by the next matching rule, or carry no fee if none matches.
+// @Description
+// @Description **Header:**
+// @Description
+// @Description Authorization:
+// @Description - Required
+// @Description - Format: Bearer token
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer token" default(Bearer )
+// @Param id path string true "ID of the fee rule"
+// @Success 200 {object} DeleteFeeRuleResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Router /api/v1/fee-rule/{id} [delete]
+func (h *TransactionHandler) DeleteFeeRule(c *gin.Context) {
+	un, _ := c.Get("username") // middleware
+	requester, ok := un.(string)
+	if !ok {
+		logging.Logger.Warn().Err(errors.New("unable to get requester username")).Msg("requester: " + requester)
+	}
+
+	grpcReq := &prototx.DeleteFeeRuleRequest{
+		Id: strings.TrimSpace(c.Param("id")),
+		Metadata: &prototx.Metadata{
+			RequestId: c.GetHeader("X-Request-ID"),
+			Requester: requester,
+		},
+	}
+
+	resp, err := h.TransactionClient.DeleteFeeRule(c.Request.Context(), grpcReq)
+	if err != nil || resp == nil {
+		logging.Logger.Error().Err(err).Msg("failed to delete fee rule")
+		c.JSON(http.StatusUnauthorized, ErrorResponse{Error: "Invalid request"})
+		return
+	}
+
+	if !resp.Response.Success {
+		logging.Logger.Error().Err(errors.New(resp.Response.Message)).Msg("unable to delete fee rule")
+		c.JSON(feeRuleErrorStatus(resp.Response.Message), ErrorResponse{Error: resp.Response.Message})
+		return
+	}
+
+	c.JSON(http.StatusOK, DeleteFeeRuleResponse{Message: resp.Response.Message})
+}
+
+func feeRuleErrorStatus(message string) int {
+	if message == "Fee rule not found" {
+		return http.StatusNotFound
+	}
+	return http.StatusBadRequest
+}
@@ -0,0 +1,153 @@
+package handlers
+
+import (
+	"bytes"
+	"encoding/json"
+	prototx "gateway-service/api/protogen/txservice/proto"
+	mock_client "gateway-service/internal/ports/mocks/grpc_client"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func setupFeeRuleRoutes(handler *TransactionHandler) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+
+	router.Use(func(c *gin.Context) {
+		c.Set("username", "test-admin")
+		c.Next()
+	})
+
+	router.PUT("/api/v1/fee-rule", handler.SetFeeRule)
+	router.GET("/api/v1/fee-rule", handler.ListFeeRules)
+	router.DELETE("/api/v1/fee-rule/:id", handler.DeleteFeeRule)
+
+	return router
+}
+
+// TestSetFeeRule_Success tests setting a percentage fee with a cap for a band of savings transfers
+func TestSetFeeRule_Success(t *testing.T) {
+	mockClient := new(mock_client.MockTransactionClient)
+	handler := &TransactionHandler{TransactionClient: mockClient}
+	router := setupFeeRuleRoutes(handler)
+
+	body := []byte(`{"transaction_type": "transfer", "account_type": "savings", "min_amount": 1000, "fee_type": "percentage", "percentage": "0.5", "max_fee": "25.00"}`)
+
+	mockClient.On("SetFeeRule", mock.Anything, mock.MatchedBy(func(req *prototx.SetFeeRuleRequest) bool {
+		return req.TransactionType == "transfer" &&
+			req.AccountType == "savings" &&
+			req.MinAmount == "1000" &&
+			req.MaxAmount == "" &&
+			req.FeeType == "percentage" &&
+			req.Percentage == "0.5" &&
+			req.MaxFee == "25.00" &&
+			req.Metadata.Requester == "test-admin"
+	})).Return(&prototx.SetFeeRuleResponse{
+		Rule: &prototx.FeeRule{
+			Id:              "rule-1",
+			TransactionType: "transfer",
+			AccountType:     "savings",
+			MinAmount:       "1000.00",
+			FeeType:         "percentage",
+			Percentage:      "0.5000",
+			MaxFee:          "25.00",
+		},
+		Response: &prototx.Response{
+			Success: true,
+			Message: "Fee rule updated successfully",
+		},
+	}, nil)
+
+	req, _ := http.NewRequest("PUT", "/api/v1/fee-rule", bytes.NewBuffer(body))
+	req.Header.Set("Content-Type", "application/json")
+
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	var response map[string]interface{}
+	err := json.Unmarshal(w.Body.Bytes(), &response)
+	assert.NoError(t, err)
+	assert.Equal(t, "Fee rule updated successfully", response["message"])
+	rule := response["rule"].(map[string]interface{})
+	assert.Equal(t, "rule-1", rule["id"])
+	assert.Equal(t, "0.5000", rule["percentage"])
+
+	mockClient.AssertExpectations(t)
+}
+
+// TestSetFeeRule_MissingFeeType tests that a rule without a fee type is rejected before reaching the service
+func TestSetFeeRule_MissingFeeType(t *testing.T) {
+	mockClient := new(mock_client.MockTransactionClient)
+	handler := &TransactionHandler{TransactionClient: mockClient}
+	router := setupFeeRuleRoutes(handler)
+
+	body := []byte(`{"transaction_type": "transfer", "flat_amount": "1.00"}`)
+
+	req, _ := http.NewRequest("PUT", "/api/v1/fee-rule", bytes.NewBuffer(body))
+	req.Header.Set("Content-Type", "application/json")
+
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	mockClient.AssertNotCalled(t, "SetFeeRule", mock.Anything, mock.Anything)
+}
+
+// TestListFeeRules_Success tests listing the configured fee rules
+func TestListFeeRules_Success(t *testing.T) {
+	mockClient := new(mock_client.MockTransactionClient)
+	handler := &TransactionHandler{TransactionClient: mockClient}
+	router := setupFeeRuleRoutes(handler)
+
+	mockClient.On("ListFeeRules", mock.Anything, mock.Anything).Return(&prototx.ListFeeRulesResponse{
+		Rules: []*prototx.FeeRule{
+			{Id: "rule-1", TransactionType: "transfer", FeeType: "flat", FlatAmount: "1.00"},
+			{Id: "rule-2", TransactionType: "withdraw_amount", FeeType: "percentage", Percentage: "1.0000"},
+		},
+		Response: &prototx.Response{
+			Success: true,
+			Message: "Fee rules",
+		},
+	}, nil)
+
+	req, _ := http.NewRequest("GET", "/api/v1/fee-rule", nil)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	var response map[string]interface{}
+	err := json.Unmarshal(w.Body.Bytes(), &response)
+	assert.NoError(t, err)
+	assert.Len(t, response["rules"], 2)
+	mockClient.AssertExpectations(t)
+}
+
+// TestDeleteFeeRule_NotFound tests that deleting an unknown rule returns not found
+func TestDeleteFeeRule_NotFound(t *testing.T) {
+	mockClient := new(mock_client.MockTransactionClient)
+	handler := &TransactionHandler{TransactionClient: mockClient}
+	router := setupFeeRuleRoutes(handler)
+
+	mockClient.On("DeleteFeeRule", mock.Anything, mock.MatchedBy(func(req *prototx.DeleteFeeRuleRequest) bool {
+		return req.Id == "rule-404"
+	})).Return(&prototx.DeleteFeeRuleResponse{
+		Response: &prototx.Response{
+			Success: false,
+			Message: "Fee rule not found",
+		},
+	}, nil)
+
+	req, _ := http.NewRequest("DELETE", "/api/v1/fee-rule/rule-404", nil)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusNotFound, w.Code)
+	mockClient.AssertExpectations(t)
+}
@@ -0,0 +1,458 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	protoacc "gateway-service/api/protogen/accountservice/proto"
+	prototx "gateway-service/api/protogen/txservice/proto"
+	"gateway-service/internal/logging"
+	"github.com/gin-gonic/gin"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+type PlaceHoldRequest struct {
+	Amount    json.Number `json:"amount" binding:"required" swaggertype:"string"` // decimal, e.g. "120.00"
+	Reason    string      `json:"reason"`
+	ExpiresAt *time.Time  `json:"expires_at"` // RFC 3339, defaults to the configured hold expiry
+}
+
+type CaptureHoldRequest struct {
+	Amount               json.Number `json:"amount" swaggertype:"string"` // decimal, full capture if empty
+	DestinationAccountID string      `json:"destination_account_id"`      // the captured funds are withdrawn if empty
+}
+
+type HoldResponse struct {
+	Hold    interface{} `json:"hold"`
+	Message string      `json:"message" binding:"message"`
+}
+
+type ListHoldsResponse struct {
+	Holds      interface{} `json:"holds"`
+	Page       int         `json:"page"`
+	PageSize   int         `json:"pageSize"`
+	TotalCount int         `json:"totalCount"`
+	TotalPages int         `json:"totalPages"`
+	Message    string      `json:"message" binding:"message"`
+}
+
+type CaptureHoldResponse struct {
+	Transaction interface{} `json:"transaction"`
+	Message     string      `json:"message" binding:"message"`
+}
+
+// PlaceHold earmarks funds of an account without moving them
+// @Tags Hold
+// @Summary Place Hold
+// @Description
+// @Description **Path Parameter:**
+// @Description
+// @Description id:
+// @Description - Required
+// @Description - AccountID
+// @Description
+// @Description **Request Body:**
+// @Description
+// @Description Amount:
+// @Description - Required
+// @Description - Must be greater than zero and covered by the available balance
+// @Description - Decimal number or string with at most 2 decimal places (e.g. "120.00")
+// @Description
+// @Description Reason:
+// @Description - Optional
+// @Description
+// @Description Expires At:
+// @Description - Optional
+// @Description - RFC 3339 time, must be in the future (e.g. "2026-01-31T09:00:00Z")
+// @Description - Default: 7 days from now
+// @Description
+// @Description An active hold reduces the available balance but not the ledger balance.
+// @Description
+// @Description **Header:**
+// @Description
+// @Description Authorization:
+// @Description - Required
+// @Description - Format: Bearer token
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer token" default(Bearer )
+// @Param id path string true "AccountID"
+// @Param hold body PlaceHoldRequest true "Hold details"
+// @Success 201 {object} HoldResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Router /api/v1/account/{id}/hold [post]
+func (h *AccountHandler) PlaceHold(c *gin.Context) {
+	var req PlaceHoldRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		logging.Logger.Warn().Err(err).Msg("invalid request param")
+		c.JSON(http.StatusBadRequest, ErrorResponse{Error: "Invalid request payload"})
+		return
+	}
+
+	un, _ := c.Get("username") // middleware
+	requester, ok := un.(string)
+	if !ok {
+		logging.Logger.Warn().Err(errors.New("unable to get requester username")).Msg("requester: " + requester)
+	}
+
+	grpcReq := &protoacc.PlaceHoldRequest{
+		AccountId: strings.TrimSpace(c.Param("id")),
+		Amount:    req.Amount.String(),
+		Reason:    req.Reason,
+		Metadata: &protoacc.Metadata{
+			RequestId: c.GetHeader("X-Request-ID"),
+			Requester: requester,
+		},
+	}
+	if req.ExpiresAt != nil {
+		grpcReq.ExpiresAt = timestamppb.New(*req.ExpiresAt)
+	}
+
+	resp, err := h.AccountClient.PlaceHold(c.Request.Context(), grpcReq)
+	if err != nil || resp == nil {
+		logging.Logger.Error().Err(err).Msg("failed to place hold")
+		c.JSON(http.StatusUnauthorized, ErrorResponse{Error: "Invalid request"})
+		return
+	}
+
+	if !resp.Response.Success {
+		logging.Logger.Error().Err(errors.New(resp.Response.Message)).Msg("unable to place hold")
+		c.JSON(holdErrorStatus(resp.Response.Message), ErrorResponse{Error: resp.Response.Message})
+		return
+	}
+
+	res := HoldResponse{
+		Hold:    resp.Hold,
+		Message: resp.Response.Message,
+	}
+
+	c.JSON(http.StatusCreated, res)
+}
+
+// ListAccountHolds lists the holds placed on an account
+// @Tags Hold
+// @Summary List Account Holds
+// @Description
+// @Description **Path Parameter:**
+// @Description
+// @Description id:
+// @Description - Required
+// @Description - AccountID
+// @Description
+// @Description **Query Parameters:**
+// @Description
+// @Description page:
+// @Description - Optional
+// @Description - Page number for pagination
+// @Description - Default: 1
+// @Description
+// @Description pagesize:
+// @Description - Optional
+// @Description - Number of holds per page
+// @Description - Default: 50
+// @Description
+// @Description Holds of every status are listed, newest first.
+// @Description
+// @Description **Header:**
+// @Description
+// @Description Authorization:
+// @Description - Required
+// @Description - Format: Bearer token
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer token" default(Bearer )
+// @Param id path string true "AccountID"
+// @Param page query int false "Page number for pagination" default(1)
+// @Param pagesize query int false "Number of holds per page" default(50)
+// @Success 200 {object} ListHoldsResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Router /api/v1/account/{id}/hold [get]
+func (h *AccountHandler) ListAccountHolds(c *gin.Context) {
+	pageStr := strings.TrimSpace(c.Query("page"))
+	pageSizeStr := strings.TrimSpace(c.Query("pagesize"))
+
+	var pageNo int = -1
+	var err error
+	if pageStr != "" {
+		pageNo, err = strconv.Atoi(pageStr)
+		if err != nil {
+			logging.Logger.Warn().Err(err).Msg("Invalid page no: " + pageStr)
+			pageNo = -1
+		}
+	}
+
+	var pageSize int = -1
+	if pageSizeStr != "" {
+		pageSize, err = strconv.Atoi(pageSizeStr)
+		if err != nil {
+			logging.Logger.Warn().Err(err).Msg("Invalid page size: " + pageSizeStr)
+			pageSize = -1
+		}
+	}
+
+	un, _ := c.Get("username") // middleware
+	requester, ok := un.(string)
+	if !ok {
+		logging.Logger.Warn().Err(errors.New("unable to get requester username")).Msg("requester: " + requester)
+	}
+
+	grpcReq := &protoacc.ListAccountHoldsRequest{
+		AccountId: strings.TrimSpace(c.Param("id")),
+		Metadata: &protoacc.Metadata{
+			RequestId: c.GetHeader("X-Request-ID"),
+			Requester: requester,
+		},
+		Pagination: &protoacc.PaginationRequest{
+			Page:     int32(pageNo),
+			PageSize: int32(pageSize),
+		},
+	}
+
+	resp, err := h.AccountClient.ListAccountHolds(c.Request.Context(), grpcReq)
+	if err != nil || resp == nil {
+		logging.Logger.Error().Err(err).Msg("failed to list account holds")
+		c.JSON(http.StatusUnauthorized, ErrorResponse{Error: "Invalid request"})
+		return
+	}
+
+	if !resp.Response.Success {
+		logging.Logger.Error().Err(errors.New(resp.Response.Message)).Msg("unable to list account holds")
+		c.JSON(holdErrorStatus(resp.Response.Message), ErrorResponse{Error: resp.Response.Message})
+		return
+	}
+
+	res := ListHoldsResponse{
+		Holds:      resp.Holds,
+		Page:       int(resp.Pagination.Page),
+		PageSize:   int(resp.Pagination.PageSize),
+		TotalCount: int(resp.Pagination.TotalCount),
+		TotalPages: int(resp.Pagination.TotalPages),
+		Message:    resp.Response.Message,
+	}
+
+	c.JSON(http.StatusOK, res)
+}
+
+// GetHold fetches a hold
+// @Tags Hold
+// @Summary Get Hold
+// @Description
+// @Description **Path Parameter:**
+// @Description
+// @Description id:
+// @Description - Required
+// @Description - Hold ID
+// @Description
+// @Description A hold is **active**, **captured**, **released** or **expired**.
+// @Description
+// @Description **Header:**
+// @Description
+// @Description Authorization:
+// @Description - Required
+// @Description - Format: Bearer token
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer token" default(Bearer )
+// @Param id path string true "Hold ID"
+// @Success 200 {object} HoldResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Router /api/v1/hold/{id} [get]
+func (h *AccountHandler) GetHold(c *gin.Context) {
+	un, _ := c.Get("username") // middleware
+	requester, ok := un.(string)
+	if !ok {
+		logging.Logger.Warn().Err(errors.New("unable to get requester username")).Msg("requester: " + requester)
+	}
+
+	grpcReq := &protoacc.GetHoldRequest{
+		HoldId: strings.TrimSpace(c.Param("id")),
+		Metadata: &protoacc.Metadata{
+			RequestId: c.GetHeader("X-Request-ID"),
+			Requester: requester,
+		},
+	}
+
+	resp, err := h.AccountClient.GetHold(c.Request.Context(), grpcReq)
+	if err != nil || resp == nil {
+		logging.Logger.Error().Err(err).Msg("failed to get hold")
+		c.JSON(http.StatusUnauthorized, ErrorResponse{Error: "Invalid request"})
+		return
+	}
+
+	if !resp.Response.Success {
+		logging.Logger.Error().Err(errors.New(resp.Response.Message)).Msg("unable to get hold")
+		c.JSON(holdErrorStatus(resp.Response.Message), ErrorResponse{Error: resp.Response.Message})
+		return
+	}
+
+	res := HoldResponse{
+		Hold:    resp.Hold,
+		Message: resp.Response.Message,
+	}
+
+	c.JSON(http.StatusOK, res)
+}
+
+// ReleaseHold frees the funds of an active hold without moving money
+// @Tags Hold
+// @Summary Release Hold
+// @Description
+// @Description **Path Parameter:**
+// @Description
+// @Description id:
+// @Description - Required
+// @Description - Hold ID, the hold must be **active**
+// @Description
+// @Description **Header:**
+// @Description
+// @Description Authorization:
+// @Description - Required
+// @Description - Format: Bearer token
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer token" default(Bearer )
+// @Param id path string true "Hold ID"
+// @Success 200 {object} HoldResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Router /api/v1/hold/{id}/release [post]
+func (h *AccountHandler) ReleaseHold(c *gin.Context) {
+	un, _ := c.Get("username") // middleware
+	requester, ok := un.(string)
+	if !ok {
+		logging.Logger.Warn().Err(errors.New("unable to get requester username")).Msg("requester: " + requester)
+	}
+
+	grpcReq := &protoacc.ReleaseHoldRequest{
+		HoldId: strings.TrimSpace(c.Param("id")),
+		Metadata: &protoacc.Metadata{
+			RequestId: c.GetHeader("X-Request-ID"),
+			Requester: requester,
+		},
+	}
+
+	resp, err := h.AccountClient.ReleaseHold(c.Request.Context(), grpcReq)
+	if err != nil || resp == nil {
+		logging.Logger.Error().Err(err).Msg("failed to release hold")
+		c.JSON(http.StatusUnauthorized, ErrorResponse{Error: "Invalid request"})
+		return
+	}
+
+	if !resp.Response.Success {
+		logging.Logger.Error().Err(errors.New(resp.Response.Message)).Msg("unable to release hold")
+		c.JSON(holdErrorStatus(resp.Response.Message), ErrorResponse{Error: resp.Response.Message})
+		return
+	}
+
+	res := HoldResponse{
+		Hold:    resp.Hold,
+		Message: resp.Response.Message,
+	}
+
+	c.JSON(http.StatusOK, res)
+}
+
+// CaptureHold settles an active hold with a transaction
+// @Tags Hold
+// @Summary Capture Hold
+// @Description
+// @Description **Path Parameter:**
+// @Description
+// @Description id:
+// @Description - Required
+// @Description - Hold ID, the hold must be **active**
+// @Description
+// @Description **Request Body:**
+// @Description
+// @Description Amount:
+// @Description - Optional
+// @Description - Must be greater than zero and not exceed the held amount
+// @Description - Decimal number or string with at most 2 decimal places (e.g. "80.00")
+// @Description - Default: the held amount; the rest of a partial capture is released
+// @Description
+// @Description Destination Account ID:
+// @Description - Optional
+// @Description - The captured funds are transferred to this account
+// @Description - Default: the captured funds are withdrawn
+// @Description
+// @Description The capture is a regular transaction with the reference **hold-capture:{hold id}:{id}**. If it fails, the hold stays active.
+// @Description
+// @Description **Header:**
+// @Description
+// @Description Authorization:
+// @Description - Required
+// @Description - Format: Bearer token
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer token" default(Bearer )
+// @Param id path string true "Hold ID"
+// @Param capture body CaptureHoldRequest false "Capture details"
+// @Success 201 {object} CaptureHoldResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Router /api/v1/hold/{id}/capture [post]
+func (h *TransactionHandler) CaptureHold(c *gin.Context) {
+	var req CaptureHoldRequest
+	if c.Request.ContentLength != 0 {
+		if err := c.ShouldBindJSON(&req); err != nil {
+			logging.Logger.Warn().Err(err).Msg("invalid request param")
+			c.JSON(http.StatusBadRequest, ErrorResponse{Error: "Invalid request payload"})
+			return
+		}
+	}
+
+	un, _ := c.Get("username") // middleware
+	requester, ok := un.(string)
+	if !ok {
+		logging.Logger.Warn().Err(errors.New("unable to get requester username")).Msg("requester: " + requester)
+	}
+
+	grpcReq := &prototx.CaptureHoldRequest{
+		HoldId:               strings.TrimSpace(c.Param("id")),
+		Amount:               req.Amount.String(),
+		DestinationAccountId: strings.TrimSpace(req.DestinationAccountID),
+		Metadata: &prototx.Metadata{
+			RequestId:     c.GetHeader("X-Request-ID"),
+			Requester:     requester,
+			RequesterRole: c.GetString("role"), // limits of the role apply
+		},
+	}
+
+	resp, err := h.TransactionClient.CaptureHold(c.Request.Context(), grpcReq)
+	if err != nil || resp == nil {
+		logging.Logger.Error().Err(err).Msg("failed to capture hold")
+		c.JSON(http.StatusUnauthorized, ErrorResponse{Error: "Invalid request"})
+		return
+	}
+
+	if !resp.Response.Success {
+		logging.Logger.Error().Err(errors.New(resp.Response.Message)).Msg("unable to capture hold")
+		c.JSON(holdErrorStatus(resp.Response.Message), ErrorResponse{Error: resp.Response.Message})
+		return
+	}
+
+	res := CaptureHoldResponse{
+		Transaction: resp.Transaction,
+		Message:     resp.Response.Message,
+	}
+
+	c.JSON(http.StatusCreated, res)
+}
+
+func holdErrorStatus(message string) int {
+	if message == "Hold not found" || message == "Account not found" {
+		return http.StatusNotFound
+	}
+	return http.StatusBadRequest
+}
@@ -0,0 +1,179 @@
+package handlers
+
+import (
+	"bytes"
+	"encoding/json"
+	protoacc "gateway-service/api/protogen/accountservice/proto"
+	prototx "gateway-service/api/protogen/txservice/proto"
+	mock_client "gateway-service/internal/ports/mocks/grpc_client"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func setupHoldRoutes(accountHandler *AccountHandler, txHandler *TransactionHandler) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+
+	router.Use(func(c *gin.Context) {
+		c.Set("username", "test-editor")
+		c.Next()
+	})
+
+	router.POST("/api/v1/account/:id/hold", accountHandler.PlaceHold)
+	router.GET("/api/v1/account/:id/hold", accountHandler.ListAccountHolds)
+	router.GET("/api/v1/hold/:id", accountHandler.GetHold)
+	router.POST("/api/v1/hold/:id/release", accountHandler.ReleaseHold)
+	router.POST("/api/v1/hold/:id/capture", txHandler.CaptureHold)
+
+	return router
+}
+
+// TestPlaceHold_Success tests placing a hold on an account
+func TestPlaceHold_Success(t *testing.T) {
+	mockClient := new(mock_client.MockAccountClient)
+	router := setupHoldRoutes(&AccountHandler{AccountClient: mockClient}, &TransactionHandler{})
+
+	body := []byte(`{"amount": "120.00", "reason": "card authorization"}`)
+
+	mockClient.On("PlaceHold", mock.Anything, mock.MatchedBy(func(req *protoacc.PlaceHoldRequest) bool {
+		return req.AccountId == "acc-123" &&
+			req.Amount == "120.00" &&
+			req.Reason == "card authorization" &&
+			req.ExpiresAt == nil &&
+			req.Metadata.Requester == "test-editor"
+	})).Return(&protoacc.PlaceHoldResponse{
+		Hold: &protoacc.Hold{
+			Id:        "hold-1",
+			AccountId: "acc-123",
+			Amount:    "120.00",
+			Status:    "active",
+		},
+		Response: &protoacc.Response{
+			Success: true,
+			Message: "Hold successfully placed",
+		},
+	}, nil)
+
+	req, _ := http.NewRequest("POST", "/api/v1/account/acc-123/hold", bytes.NewBuffer(body))
+	req.Header.Set("Content-Type", "application/json")
+
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusCreated, w.Code)
+
+	var response map[string]interface{}
+	err := json.Unmarshal(w.Body.Bytes(), &response)
+	assert.NoError(t, err)
+	assert.Equal(t, "Hold successfully placed", response["message"])
+	hold := response["hold"].(map[string]interface{})
+	assert.Equal(t, "hold-1", hold["id"])
+
+	mockClient.AssertExpectations(t)
+}
+
+// TestPlaceHold_MissingAmount tests if amount is not provided
+func TestPlaceHold_MissingAmount(t *testing.T) {
+	mockClient := new(mock_client.MockAccountClient)
+	router := setupHoldRoutes(&AccountHandler{AccountClient: mockClient}, &TransactionHandler{})
+
+	req, _ := http.NewRequest("POST", "/api/v1/account/acc-123/hold", bytes.NewBuffer([]byte(`{"reason": "test"}`)))
+	req.Header.Set("Content-Type", "application/json")
+
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	mockClient.AssertNotCalled(t, "PlaceHold", mock.Anything, mock.Anything)
+}
+
+// TestGetHold_NotFound tests if the hold does not exist
+func TestGetHold_NotFound(t *testing.T) {
+	mockClient := new(mock_client.MockAccountClient)
+	router := setupHoldRoutes(&AccountHandler{AccountClient: mockClient}, &TransactionHandler{})
+
+	mockClient.On("GetHold", mock.Anything, mock.MatchedBy(func(req *protoacc.GetHoldRequest) bool {
+		return req.HoldId == "hold-404"
+	})).Return(&protoacc.GetHoldResponse{
+		Response: &protoacc.Response{
+			Success: false,
+			Message: "Hold not found",
+		},
+	}, nil)
+
+	req, _ := http.NewRequest("GET", "/api/v1/hold/hold-404", nil)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusNotFound, w.Code)
+	mockClient.AssertExpectations(t)
+}
+
+// TestCaptureHold_Success tests a partial capture into a transfer
+func TestCaptureHold_Success(t *testing.T) {
+	mockClient := new(mock_client.MockTransactionClient)
+	router := setupHoldRoutes(&AccountHandler{}, &TransactionHandler{TransactionClient: mockClient})
+
+	body := []byte(`{"amount": "80.00", "destination_account_id": "acc-456"}`)
+
+	mockClient.On("CaptureHold", mock.Anything, mock.MatchedBy(func(req *prototx.CaptureHoldRequest) bool {
+		return req.HoldId == "hold-1" &&
+			req.Amount == "80.00" &&
+			req.DestinationAccountId == "acc-456" &&
+			req.Metadata.Requester == "test-editor"
+	})).Return(&prototx.CaptureHoldResponse{
+		Transaction: &prototx.Transaction{
+			Id:     "tx-1",
+			Amount: "80.00",
+			HoldId: "hold-1",
+		},
+		Response: &prototx.Response{
+			Success: true,
+			Message: "Hold captured successfully",
+		},
+	}, nil)
+
+	req, _ := http.NewRequest("POST", "/api/v1/hold/hold-1/capture", bytes.NewBuffer(body))
+	req.Header.Set("Content-Type", "application/json")
+
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusCreated, w.Code)
+
+	var response map[string]interface{}
+	err := json.Unmarshal(w.Body.Bytes(), &response)
+	assert.NoError(t, err)
+	assert.Equal(t, "Hold captured successfully", response["message"])
+	transaction := response["transaction"].(map[string]interface{})
+	assert.Equal(t, "hold-1", transaction["hold_id"])
+
+	mockClient.AssertExpectations(t)
+}
+
+// TestCaptureHold_EmptyBody tests that a capture without body captures the full hold
+func TestCaptureHold_EmptyBody(t *testing.T) {
+	mockClient := new(mock_client.MockTransactionClient)
+	router := setupHoldRoutes(&AccountHandler{}, &TransactionHandler{TransactionClient: mockClient})
+
+	mockClient.On("CaptureHold", mock.Anything, mock.MatchedBy(func(req *prototx.CaptureHoldRequest) bool {
+		return req.HoldId == "hold-1" && req.Amount == "" && req.DestinationAccountId == ""
+	})).Return(&prototx.CaptureHoldResponse{
+		Transaction: &prototx.Transaction{Id: "tx-1"},
+		Response: &prototx.Response{
+			Success: true,
+			Message: "Hold captured successfully",
+		},
+	}, nil)
+
+	req, _ := http.NewRequest("POST", "/api/v1/hold/hold-1/capture", nil)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusCreated, w.Code)
+	mockClient.AssertExpectations(t)
+}
@@ -0,0 +1,289 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	protoacc "gateway-service/api/protogen/accountservice/proto"
+	"gateway-service/internal/logging"
+	"github.com/gin-gonic/gin"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+type SetInterestRateRequest struct {
+	Scope      string      `json:"scope" binding:"required"`
+	Subject    string      `json:"subject" binding:"required"`
+	AnnualRate json.Number `json:"annual_rate" binding:"required" swaggertype:"string"` // percent, e.g. "2.5"
+}
+
+type SetInterestRateResponse struct {
+	InterestRate interface{} `json:"interest_rate"`
+	Message      string      `json:"message" binding:"message"`
+}
+
+type ListInterestRatesResponse struct {
+	InterestRates interface{} `json:"interest_rates"`
+	Message       string      `json:"message" binding:"message"`
+}
+
+type GetAccountInterestResponse struct {
+	AnnualRate      string      `json:"annual_rate"`      // rate the account accrues at, in percent
+	AccruedInterest string      `json:"accrued_interest"` // accrued and not yet closed into a posting
+	Postings        interface{} `json:"postings"`
+	Page            int         `json:"page"`
+	PageSize        int         `json:"pageSize"`
+	TotalCount      int         `json:"totalCount"`
+	TotalPages      int         `json:"totalPages"`
+	Message         string      `json:"message" binding:"message"`
+}
+
+// SetInterestRate creates or replaces the annual interest rate of an account type or account
+// @Tags Interest
+// @Summary Set Interest Rate
+// @Description
+// @Description **Request Body:**
+// @Description
+// @Description Scope:
+// @Description - Required
+// @Description - Options: **account_type**, **account**
+// @Description - An **account** rate replaces the rate of the account type for that account
+// @Description
+// @Description Subject:
+// @Description - Required
+// @Description - **savings** or **current** for account_type, an account ID for account
+// @Description
+// @Description Annual Rate:
+// @Description - Required
+// @Description - Percent between 0 and 100 with at most 4 decimal places (e.g. "2.5")
+// @Description - 0 stops the accrual
+// @Description
+// @Description Interest accrues daily on the end of day balance and is credited monthly as an **interest_credit** transaction.
+// @Description A new rate applies from the next accrued day.
+// @Description
+// @Description **Header:**
+// @Description
+// @Description Authorization:
+// @Description - Required
+// @Description - Format: Bearer token
+// @Description - Admin only
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer token" default(Bearer )
+// @Param interest_rate body SetInterestRateRequest true "Interest rate details"
+// @Success 200 {object} SetInterestRateResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Router /api/v1/interest-rate [put]
+func (h *AccountHandler) SetInterestRate(c *gin.Context) {
+	var req SetInterestRateRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		logging.Logger.Warn().Err(err).Msg("invalid request param")
+		c.JSON(http.StatusBadRequest, ErrorResponse{Error: "Invalid request payload"})
+		return
+	}
+
+	un, _ := c.Get("username") // middleware
+	requester, ok := un.(string)
+	if !ok {
+		logging.Logger.Warn().Err(errors.New("unable to get requester username")).Msg("requester: " + requester)
+	}
+
+	grpcReq := &protoacc.SetInterestRateRequest{
+		Scope:      req.Scope,
+		Subject:    req.Subject,
+		AnnualRate: req.AnnualRate.String(),
+		Metadata: &protoacc.Metadata{
+			RequestId: c.GetHeader("X-Request-ID"),
+			Requester: requester,
+		},
+	}
+
+	resp, err := h.AccountClient.SetInterestRate(c.Request.Context(), grpcReq)
+	if err != nil || resp == nil {
+		logging.Logger.Error().Err(err).Msg("failed to set interest rate")
+		c.JSON(http.StatusUnauthorized, ErrorResponse{Error: "Invalid request"})
+		return
+	}
+
+	if !resp.Response.Success {
+		logging.Logger.Error().Err(errors.New(resp.Response.Message)).Msg("unable to set interest rate")
+		c.JSON(interestErrorStatus(resp.Response.Message), ErrorResponse{Error: resp.Response.Message})
+		return
+	}
+
+	res := SetInterestRateResponse{
+		InterestRate: resp.InterestRate,
+		Message:      resp.Response.Message,
+	}
+
+	c.JSON(http.StatusOK, res)
+}
+
+// ListInterestRates lists all configured interest rates
+// @Tags Interest
+// @Summary List Interest Rates
+// @Description
+// @Description **Header:**
+// @Description
+// @Description Authorization:
+// @Description - Required
+// @Description - Format: Bearer token
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer token" default(Bearer )
+// @Success 200 {object} ListInterestRatesResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Router /api/v1/interest-rate [get]
+func (h *AccountHandler) ListInterestRates(c *gin.Context) {
+	un, _ := c.Get("username") // middleware
+	requester, ok := un.(string)
+	if !ok {
+		logging.Logger.Warn().Err(errors.New("unable to get requester username")).Msg("requester: " + requester)
+	}
+
+	grpcReq := &protoacc.ListInterestRatesRequest{
+		Metadata: &protoacc.Metadata{
+			RequestId: c.GetHeader("X-Request-ID"),
+			Requester: requester,
+		},
+	}
+
+	resp, err := h.AccountClient.ListInterestRates(c.Request.Context(), grpcReq)
+	if err != nil || resp == nil {
+		logging.Logger.Error().Err(err).Msg("failed to list interest rates")
+		c.JSON(http.StatusUnauthorized, ErrorResponse{Error: "Invalid request"})
+		return
+	}
+
+	if !resp.Response.Success {
+		logging.Logger.Error().Err(errors.New(resp.Response.Message)).Msg("unable to list interest rates")
+		c.JSON(http.StatusBadRequest, ErrorResponse{Error: resp.Response.Message})
+		return
+	}
+
+	res := ListInterestRatesResponse{
+		InterestRates: resp.InterestRates,
+		Message:       resp.Response.Message,
+	}
+
+	c.JSON(http.StatusOK, res)
+}
+
+// GetAccountInterest fetches the interest rate, accrued interest and monthly postings of an account
+// @Tags Interest
+// @Summary Get Account Interest
+// @Description
+// @Description **Path Parameter:**
+// @Description
+// @Description id:
+// @Description - Required
+// @Description - AccountID
+// @Description
+// @Description **Query Parameters:**
+// @Description
+// @Description page:
+// @Description - Optional
+// @Description - Page number for pagination
+// @Description - Default: 1
+// @Description
+// @Description pagesize:
+// @Description - Optional
+// @Description - Number of postings per page
+// @Description - Default: 50
+// @Description
+// @Description Accrued interest is the interest not yet closed into a monthly posting. A posting is **pending** until
+// @Description its **interest_credit** transaction completes, then **posted**. Postings are listed newest first.
+// @Description
+// @Description **Header:**
+// @Description
+// @Description Authorization:
+// @Description - Required
+// @Description - Format: Bearer token
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer token" default(Bearer )
+// @Param id path string true "AccountID"
+// @Param page query int false "Page number for pagination" default(1)
+// @Param pagesize query int false "Number of postings per page" default(50)
+// @Success 200 {object} GetAccountInterestResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Router /api/v1/account/{id}/interest [get]
+func (h *AccountHandler) GetAccountInterest(c *gin.Context) {
+	pageStr := strings.TrimSpace(c.Query("page"))
+	pageSizeStr := strings.TrimSpace(c.Query("pagesize"))
+
+	var pageNo int = -1
+	var err error
+	if pageStr != "" {
+		pageNo, err = strconv.Atoi(pageStr)
+		if err != nil {
+			logging.Logger.Warn().Err(err).Msg("Invalid page no: " + pageStr)
+			pageNo = -1
+		}
+	}
+
+	var pageSize int = -1
+	if pageSizeStr != "" {
+		pageSize, err = strconv.Atoi(pageSizeStr)
+		if err != nil {
+			logging.Logger.Warn().Err(err).Msg("Invalid page size: " + pageSizeStr)
+			pageSize = -1
+		}
+	}
+
+	un, _ := c.Get("username") // middleware
+	requester, ok := un.(string)
+	if !ok {
+		logging.Logger.Warn().Err(errors.New("unable to get requester username")).Msg("requester: " + requester)
+	}
+
+	grpcReq := &protoacc.GetAccountInterestRequest{
+		AccountId: strings.TrimSpace(c.Param("id")),
+		Metadata: &protoacc.Metadata{
+			RequestId: c.GetHeader("X-Request-ID"),
+			Requester: requester,
+		},
+		Pagination: &protoacc.PaginationRequest{
+			Page:     int32(pageNo),
+			PageSize: int32(pageSize),
+		},
+	}
+
+	resp, err := h.AccountClient.GetAccountInterest(c.Request.Context(), grpcReq)
+	if err != nil || resp == nil {
+		logging.Logger.Error().Err(err).Msg("failed to get account interest")
+		c.JSON(http.StatusUnauthorized, ErrorResponse{Error: "Invalid request"})
+		return
+	}
+
+	if !resp.Response.Success {
+		logging.Logger.Error().Err(errors.New(resp.Response.Message)).Msg("unable to get account interest")
+		c.JSON(interestErrorStatus(resp.Response.Message), ErrorResponse{Error: resp.Response.Message})
+		return
+	}
+
+	res := GetAccountInterestResponse{
+		AnnualRate:      resp.AnnualRate,
+		AccruedInterest: resp.AccruedInterest,
+		Postings:        resp.Postings,
+		Page:            int(resp.Pagination.Page),
+		PageSize:        int(resp.Pagination.PageSize),
+		TotalCount:      int(resp.Pagination.TotalCount),
+		TotalPages:      int(resp.Pagination.TotalPages),
+		Message:         resp.Response.Message,
+	}
+
+	c.JSON(http.StatusOK, res)
+}
+
+func interestErrorStatus(message string) int {
+	if message == "Account not found" {
+		return http.StatusNotFound
+	}
+	return http.StatusBadRequest
+}
@@ -0,0 +1,179 @@
+package handlers
+
+import (
+	"bytes"
+	"encoding/json"
+	protoacc "gateway-service/api/protogen/accountservice/proto"
+	mock_client "gateway-service/internal/ports/mocks/grpc_client"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func setupInterestRoutes(handler *AccountHandler) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+
+	router.Use(func(c *gin.Context) {
+		c.Set("username", "test-admin")
+		c.Next()
+	})
+
+	router.PUT("/api/v1/interest-rate", handler.SetInterestRate)
+	router.GET("/api/v1/interest-rate", handler.ListInterestRates)
+	router.GET("/api/v1/account/:id/interest", handler.GetAccountInterest)
+
+	return router
+}
+
+// TestSetInterestRate_Success tests setting the rate of an account type
+func TestSetInterestRate_Success(t *testing.T) {
+	mockClient := new(mock_client.MockAccountClient)
+	router := setupInterestRoutes(&AccountHandler{AccountClient: mockClient})
+
+	body := []byte(`{"scope": "account_type", "subject": "savings", "annual_rate": 2.5}`)
+
+	mockClient.On("SetInterestRate", mock.Anything, mock.MatchedBy(func(req *protoacc.SetInterestRateRequest) bool {
+		return req.Scope == "account_type" &&
+			req.Subject == "savings" &&
+			req.AnnualRate == "2.5" &&
+			req.Metadata.Requester == "test-admin"
+	})).Return(&protoacc.SetInterestRateResponse{
+		InterestRate: &protoacc.InterestRate{
+			Scope:      "account_type",
+			Subject:    "savings",
+			AnnualRate: "2.5000",
+		},
+		Response: &protoacc.Response{
+			Success: true,
+			Message: "Interest rate successfully set",
+		},
+	}, nil)
+
+	req, _ := http.NewRequest("PUT", "/api/v1/interest-rate", bytes.NewBuffer(body))
+	req.Header.Set("Content-Type", "application/json")
+
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	var response map[string]interface{}
+	err := json.Unmarshal(w.Body.Bytes(), &response)
+	assert.NoError(t, err)
+	assert.Equal(t, "Interest rate successfully set", response["message"])
+	rate := response["interest_rate"].(map[string]interface{})
+	assert.Equal(t, "2.5000", rate["annual_rate"])
+
+	mockClient.AssertExpectations(t)
+}
+
+// TestSetInterestRate_AccountNotFound tests that a rate for an unknown account returns not found
+func TestSetInterestRate_AccountNotFound(t *testing.T) {
+	mockClient := new(mock_client.MockAccountClient)
+	router := setupInterestRoutes(&AccountHandler{AccountClient: mockClient})
+
+	body := []byte(`{"scope": "account", "subject": "acc-404", "annual_rate": "1"}`)
+
+	mockClient.On("SetInterestRate", mock.Anything, mock.Anything).Return(&protoacc.SetInterestRateResponse{
+		Response: &protoacc.Response{
+			Success: false,
+			Message: "Account not found",
+		},
+	}, nil)
+
+	req, _ := http.NewRequest("PUT", "/api/v1/interest-rate", bytes.NewBuffer(body))
+	req.Header.Set("Content-Type", "application/json")
+
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusNotFound, w.Code)
+}
+
+// TestSetInterestRate_MissingRate tests that the annual rate is required
+func TestSetInterestRate_MissingRate(t *testing.T) {
+	mockClient := new(mock_client.MockAccountClient)
+	router := setupInterestRoutes(&AccountHandler{AccountClient: mockClient})
+
+	body := []byte(`{"scope": "account_type", "subject": "savings"}`)
+
+	req, _ := http.NewRequest("PUT", "/api/v1/interest-rate", bytes.NewBuffer(body))
+	req.Header.Set("Content-Type", "application/json")
+
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	mockClient.AssertNotCalled(t, "SetInterestRate", mock.Anything, mock.Anything)
+}
+
+// TestGetAccountInterest_Success tests fetching the accrued interest and postings of an account
+func TestGetAccountInterest_Success(t *testing.T) {
+	mockClient := new(mock_client.MockAccountClient)
+	router := setupInterestRoutes(&AccountHandler{AccountClient: mockClient})
+
+	mockClient.On("GetAccountInterest", mock.Anything, mock.MatchedBy(func(req *protoacc.GetAccountInterestRequest) bool {
+		return req.AccountId == "acc-1" && req.Pagination.Page == 2 && req.Pagination.PageSize == -1
+	})).Return(&protoacc.GetAccountInterestResponse{
+		AnnualRate:      "2.5000",
+		AccruedInterest: "0.42",
+		Postings: []*protoacc.InterestPosting{
+			{Id: "posting-1", AccountId: "acc-1", Period: "2026-01", Amount: "2.10", Currency: "USD", Status: "posted", TransactionId: "txn-1"},
+		},
+		Pagination: &protoacc.PaginationResponse{Page: 2, PageSize: 50, TotalCount: 51, TotalPages: 2},
+		Response: &protoacc.Response{
+			Success: true,
+			Message: "Account interest",
+		},
+	}, nil)
+
+	req, _ := http.NewRequest("GET", "/api/v1/account/acc-1/interest?page=2", nil)
+
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	var response map[string]interface{}
+	err := json.Unmarshal(w.Body.Bytes(), &response)
+	assert.NoError(t, err)
+	assert.Equal(t, "2.5000", response["annual_rate"])
+	assert.Equal(t, "0.42", response["accrued_interest"])
+	assert.Equal(t, float64(51), response["totalCount"])
+	postings := response["postings"].([]interface{})
+	assert.Equal(t, "txn-1", postings[0].(map[string]interface{})["transaction_id"])
+
+	mockClient.AssertExpectations(t)
+}
+
+// TestListInterestRates_Success tests listing the configured interest rates
+func TestListInterestRates_Success(t *testing.T) {
+	mockClient := new(mock_client.MockAccountClient)
+	router := setupInterestRoutes(&AccountHandler{AccountClient: mockClient})
+
+	mockClient.On("ListInterestRates", mock.Anything, mock.Anything).Return(&protoacc.ListInterestRatesResponse{
+		InterestRates: []*protoacc.InterestRate{
+			{Scope: "account_type", Subject: "savings", AnnualRate: "2.5000"},
+		},
+		Response: &protoacc.Response{
+			Success: true,
+			Message: "Interest rates",
+		},
+	}, nil)
+
+	req, _ := http.NewRequest("GET", "/api/v1/interest-rate", nil)
+
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	var response map[string]interface{}
+	err := json.Unmarshal(w.Body.Bytes(), &response)
+	assert.NoError(t, err)
+	assert.Len(t, response["interest_rates"], 1)
+}
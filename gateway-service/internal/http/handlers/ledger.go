@@ -0,0 +1,198 @@
+package handlers
+
+import (
+	"errors"
+	protoacc "gateway-service/api/protogen/accountservice/proto"
+	"gateway-service/internal/logging"
+	"github.com/gin-gonic/gin"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+type GetAccountJournalResponse struct {
+	Entries    interface{} `json:"entries"`
+	Page       int         `json:"page"`
+	PageSize   int         `json:"pageSize"`
+	TotalCount int         `json:"totalCount"`
+	TotalPages int         `json:"totalPages"`
+	Message    string      `json:"message" binding:"message"`
+}
+
+type RecomputeAccountBalanceResponse struct {
+	AccountID     string `json:"account_id"`
+	Currency      string `json:"currency"`
+	Balance       string `json:"balance"`
+	LedgerBalance string `json:"ledger_balance"`
+	Consistent    bool   `json:"consistent"`
+	Message       string `json:"message" binding:"message"`
+}
+
+// GetAccountJournal fetches the double-entry journal entries of an account
+// @Tags Account
+// @Summary Get Account Journal
+// @Description
+// @Description **Path Parameter:**
+// @Description
+// @Description id:
+// @Description - Required
+// @Description - AccountID of a customer account
+// @Description
+// @Description **Query Parameters:**
+// @Description
+// @Description page:
+// @Description - Optional
+// @Description - Page number for pagination
+// @Description - Default: 1
+// @Description
+// @Description pagesize:
+// @Description - Optional
+// @Description - Number of entries per page
+// @Description - Default: 100
+// @Description
+// @Description **Header:**
+// @Description
+// @Description Authorization:
+// @Description - Required
+// @Description - Format: Bearer token
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer token" default(Bearer )
+// @Param id path string true "AccountID of a customer account"
+// @Param page query int false "Page number for pagination" default(1)
+// @Param pagesize query int false "Number of entries per page" default(100)
+// @Success 200 {object} GetAccountJournalResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Router /api/v1/account/{id}/journal [get]
+func (h *AccountHandler) GetAccountJournal(c *gin.Context) {
+	accountId := strings.TrimSpace(c.Param("id"))
+	pageStr := strings.TrimSpace(c.Query("page"))
+	pageSizeStr := strings.TrimSpace(c.Query("pagesize"))
+
+	var pageNo int = -1
+	var err error
+	if pageStr != "" {
+		pageNo, err = strconv.Atoi(pageStr)
+		if err != nil {
+			logging.Logger.Warn().Err(err).Msg("Invalid page no: " + pageStr)
+			pageNo = -1
+		}
+	}
+
+	var pageSize int = -1
+	if pageSizeStr != "" {
+		pageSize, err = strconv.Atoi(pageSizeStr)
+		if err != nil {
+			logging.Logger.Warn().Err(err).Msg("Invalid page size: " + pageSizeStr)
+			pageSize = -1
+		}
+	}
+
+	un, _ := c.Get("username") // middleware
+	requester, ok := un.(string)
+	if !ok {
+		logging.Logger.Warn().Err(errors.New("unable to get requester username")).Msg("requester: " + requester)
+	}
+
+	grpcReq := &protoacc.GetAccountJournalRequest{
+		AccountId: accountId,
+		Metadata: &protoacc.Metadata{
+			RequestId: c.GetHeader("X-Request-ID"),
+			Requester: requester,
+		},
+		Pagination: &protoacc.PaginationRequest{
+			Page:     int32(pageNo),
+			PageSize: int32(pageSize),
+		},
+	}
+
+	resp, err := h.AccountClient.GetAccountJournal(c.Request.Context(), grpcReq)
+	if err != nil {
+		logging.Logger.Error().Err(err).Msg("failed to get account journal")
+		c.JSON(http.StatusUnauthorized, ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	if !resp.Response.Success {
+		logging.Logger.Error().Err(errors.New(resp.Response.Message)).Msg("unable to get account journal")
+		c.JSON(http.StatusBadRequest, ErrorResponse{Error: resp.Response.Message})
+		return
+	}
+
+	res := GetAccountJournalResponse{
+		Entries:    resp.Entries,
+		Page:       int(resp.Pagination.Page),
+		PageSize:   int(resp.Pagination.PageSize),
+		TotalCount: int(resp.Pagination.TotalCount),
+		TotalPages: int(resp.Pagination.TotalPages),
+		Message:    resp.Response.Message,
+	}
+
+	c.JSON(http.StatusOK, res)
+}
+
+// RecomputeAccountBalance recomputes the account balance from its journal and compares it with the stored balance
+// @Tags Account
+// @Summary Recompute Account Balance From Journal
+// @Description
+// @Description **Path Parameter:**
+// @Description
+// @Description id:
+// @Description - Required
+// @Description - AccountID of a customer account
+// @Description
+// @Description **Header:**
+// @Description
+// @Description Authorization:
+// @Description - Required
+// @Description - Format: Bearer token
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer token" default(Bearer )
+// @Param id path string true "AccountID of a customer account"
+// @Success 200 {object} RecomputeAccountBalanceResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Router /api/v1/account/{id}/ledger-balance [get]
+func (h *AccountHandler) RecomputeAccountBalance(c *gin.Context) {
+	accountId := strings.TrimSpace(c.Param("id"))
+
+	un, _ := c.Get("username") // middleware
+	requester, ok := un.(string)
+	if !ok {
+		logging.Logger.Warn().Err(errors.New("unable to get requester username")).Msg("requester: " + requester)
+	}
+
+	grpcReq := &protoacc.RecomputeAccountBalanceRequest{
+		AccountId: accountId,
+		Metadata: &protoacc.Metadata{
+			RequestId: c.GetHeader("X-Request-ID"),
+			Requester: requester,
+		},
+	}
+
+	resp, err := h.AccountClient.RecomputeAccountBalance(c.Request.Context(), grpcReq)
+	if err != nil {
+		logging.Logger.Error().Err(err).Msg("failed to recompute account balance")
+		c.JSON(http.StatusUnauthorized, ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	if !resp.Response.Success {
+		logging.Logger.Error().Err(errors.New(resp.Response.Message)).Msg("unable to recompute account balance")
+		c.JSON(http.StatusBadRequest, ErrorResponse{Error: resp.Response.Message})
+		return
+	}
+
+	res := RecomputeAccountBalanceResponse{
+		AccountID:     resp.AccountId,
+		Currency:      resp.Currency,
+		Balance:       resp.Balance,
+		LedgerBalance: resp.LedgerBalance,
+		Consistent:    resp.Consistent,
+		Message:       resp.Response.Message,
+	}
+
+	c.JSON(http.StatusOK, res)
+}
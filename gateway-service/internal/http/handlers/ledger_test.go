@@ -0,0 +1,155 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	protoacc "gateway-service/api/protogen/accountservice/proto"
+	mock_client "gateway-service/internal/ports/mocks/grpc_client"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func setupLedgerRoutes(handler *AccountHandler) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+
+	router.Use(func(c *gin.Context) {
+		c.Set("username", "test-admin")
+		c.Next()
+	})
+
+	router.GET("/api/v1/account/:id/journal", handler.GetAccountJournal)
+	router.GET("/api/v1/account/:id/ledger-balance", handler.RecomputeAccountBalance)
+
+	return router
+}
+
+// TestGetAccountJournal_Success tests fetching the journal entries of an account
+func TestGetAccountJournal_Success(t *testing.T) {
+	mockClient := new(mock_client.MockAccountClient)
+	accountHandler := &AccountHandler{AccountClient: mockClient}
+	router := setupLedgerRoutes(accountHandler)
+
+	expectedResponse := &protoacc.GetAccountJournalResponse{
+		Entries: []*protoacc.LedgerEntry{
+			{Id: "entry-2", AccountId: "acc-123", Direction: "debit", Amount: "25.00", Currency: "USD"},
+			{Id: "entry-1", AccountId: "acc-123", Direction: "credit", Amount: "100.00", Currency: "USD"},
+		},
+		Pagination: &protoacc.PaginationResponse{
+			Page:       2,
+			PageSize:   10,
+			TotalCount: 12,
+			TotalPages: 2,
+		},
+		Response: &protoacc.Response{
+			Success: true,
+			Message: "Account journal",
+		},
+	}
+
+	mockClient.On("GetAccountJournal", mock.Anything, mock.MatchedBy(func(req *protoacc.GetAccountJournalRequest) bool {
+		return req.AccountId == "acc-123" &&
+			req.Pagination.Page == 2 &&
+			req.Pagination.PageSize == 10 &&
+			req.Metadata.Requester == "test-admin"
+	})).Return(expectedResponse, nil)
+
+	req, _ := http.NewRequest("GET", "/api/v1/account/acc-123/journal?page=2&pagesize=10", nil)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	var response map[string]interface{}
+	err := json.Unmarshal(w.Body.Bytes(), &response)
+	assert.NoError(t, err)
+	assert.Equal(t, "Account journal", response["message"])
+	assert.Len(t, response["entries"], 2)
+	assert.Equal(t, float64(12), response["totalCount"])
+
+	mockClient.AssertExpectations(t)
+}
+
+// TestGetAccountJournal_AccountNotFound tests if account does not exist
+func TestGetAccountJournal_AccountNotFound(t *testing.T) {
+	mockClient := new(mock_client.MockAccountClient)
+	accountHandler := &AccountHandler{AccountClient: mockClient}
+	router := setupLedgerRoutes(accountHandler)
+
+	mockClient.On("GetAccountJournal", mock.Anything, mock.Anything).Return(&protoacc.GetAccountJournalResponse{
+		Pagination: &protoacc.PaginationResponse{},
+		Response: &protoacc.Response{
+			Success: false,
+			Message: "Account not found",
+		},
+	}, nil)
+
+	req, _ := http.NewRequest("GET", "/api/v1/account/acc-404/journal", nil)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+
+	var response ErrorResponse
+	err := json.Unmarshal(w.Body.Bytes(), &response)
+	assert.NoError(t, err)
+	assert.Equal(t, "Account not found", response.Error)
+
+	mockClient.AssertExpectations(t)
+}
+
+// TestRecomputeAccountBalance_Success tests recomputing the account balance from the journal
+func TestRecomputeAccountBalance_Success(t *testing.T) {
+	mockClient := new(mock_client.MockAccountClient)
+	accountHandler := &AccountHandler{AccountClient: mockClient}
+	router := setupLedgerRoutes(accountHandler)
+
+	mockClient.On("RecomputeAccountBalance", mock.Anything, mock.MatchedBy(func(req *protoacc.RecomputeAccountBalanceRequest) bool {
+		return req.AccountId == "acc-123" && req.Metadata.Requester == "test-admin"
+	})).Return(&protoacc.RecomputeAccountBalanceResponse{
+		AccountId:     "acc-123",
+		Currency:      "USD",
+		Balance:       "75.00",
+		LedgerBalance: "75.00",
+		Consistent:    true,
+		Response: &protoacc.Response{
+			Success: true,
+			Message: "Account balance matches journal",
+		},
+	}, nil)
+
+	req, _ := http.NewRequest("GET", "/api/v1/account/acc-123/ledger-balance", nil)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	var response RecomputeAccountBalanceResponse
+	err := json.Unmarshal(w.Body.Bytes(), &response)
+	assert.NoError(t, err)
+	assert.True(t, response.Consistent)
+	assert.Equal(t, "75.00", response.LedgerBalance)
+	assert.Equal(t, "Account balance matches journal", response.Message)
+
+	mockClient.AssertExpectations(t)
+}
+
+// TestRecomputeAccountBalance_GrpcConnectionError tests if grpc call fails
+func TestRecomputeAccountBalance_GrpcConnectionError(t *testing.T) {
+	mockClient := new(mock_client.MockAccountClient)
+	accountHandler := &AccountHandler{AccountClient: mockClient}
+	router := setupLedgerRoutes(accountHandler)
+
+	mockClient.On("RecomputeAccountBalance", mock.Anything, mock.Anything).Return(nil, errors.New("connection refused"))
+
+	req, _ := http.NewRequest("GET", "/api/v1/account/acc-123/ledger-balance", nil)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusUnauthorized, w.Code)
+	mockClient.AssertExpectations(t)
+}
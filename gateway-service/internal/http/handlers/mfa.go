@@ -0,0 +1,402 @@
+package handlers
+
+import (
+	"errors"
+	protoauth "gateway-service/api/protogen/authservice/proto"
+	"gateway-service/internal/logging"
+	"github.com/gin-gonic/gin"
+	"net/http"
+	"strings"
+)
+
+type VerifyMFARequest struct {
+	ChallengeToken string `json:"challenge_token" binding:"required"`
+	Code           string `json:"code"`
+	RecoveryCode   string `json:"recovery_code"`
+}
+
+type VerifyMFAResponse struct {
+	AccessToken   string   `json:"access_token"`
+	RefreshToken  string   `json:"refresh_token"`
+	RecoveryCodes []string `json:"recovery_codes,omitempty"` // only when the verification completed an enrollment
+}
+
+type EnrollMFAChallengeRequest struct {
+	ChallengeToken string `json:"challenge_token" binding:"required"`
+}
+
+type EnrollMFAResponse struct {
+	Secret          string `json:"secret"`
+	ProvisioningURI string `json:"provisioning_uri"`
+	Message         string `json:"message" binding:"message"`
+}
+
+type ConfirmMFARequest struct {
+	Code string `json:"code" binding:"required"`
+}
+
+type ConfirmMFAResponse struct {
+	RecoveryCodes []string `json:"recovery_codes"`
+	Message       string   `json:"message" binding:"message"`
+}
+
+type ResetMFAResponse struct {
+	Message string `json:"message" binding:"message"`
+}
+
+type SetMFAPolicyRequest struct {
+	Required *bool `json:"required" binding:"required"`
+}
+
+type SetMFAPolicyResponse struct {
+	Message string `json:"message" binding:"message"`
+}
+
+type ListMFAPoliciesResponse struct {
+	Policies interface{} `json:"policies"`
+	Message  string      `json:"message" binding:"message"`
+}
+
+// VerifyMFA exchanges the MFA challenge of a login for an access token and refresh token
+// @Tags Authentication
+// @Summary Verify MFA API
+// @Description
+// @Description **Request Body:**
+// @Description
+// @Description challenge_token:
+// @Description - Required
+// @Description - The mfa_challenge_token of the login response
+// @Description
+// @Description code:
+// @Description - 6 digit code of the authenticator app
+// @Description
+// @Description recovery_code:
+// @Description - Used instead of a code when the authenticator app is lost
+// @Description - Every recovery code can be used once
+// @Description
+// @Description Either code or recovery_code is required. A challenge expires after a few minutes and after 5 codes;
+// @Description the employee has to log in again then. After 10 wrong codes in a row the employee is locked out for
+// @Description 15 minutes. When the login required enrollment, the code enables MFA and the response carries the
+// @Description recovery codes, which are not shown again.
+// @Accept json
+// @Produce json
+// @Param verify body VerifyMFARequest true "MFA challenge and code"
+// @Success 200 {object} VerifyMFAResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Router /api/v1/auth/mfa/verify [post]
+func (h *AuthHandler) VerifyMFA(c *gin.Context) {
+	var req VerifyMFARequest
+	if err := c.ShouldBindJSON(&req); err != nil || (strings.TrimSpace(req.Code) == "" && strings.TrimSpace(req.RecoveryCode) == "") {
+		logging.Logger.Warn().Err(err).Msg("invalid request param")
+		c.JSON(http.StatusBadRequest, ErrorResponse{Error: "Invalid request payload"})
+		return
+	}
+
+	grpcReq := &protoauth.VerifyMFARequest{
+		ChallengeToken: req.ChallengeToken,
+		Code:           req.Code,
+		RecoveryCode:   req.RecoveryCode,
+	}
+
+	resp, err := h.AuthClient.VerifyMFA(c.Request.Context(), grpcReq)
+	if err != nil {
+		logging.Logger.Error().Err(err).Msg("MFA verification failed")
+		c.JSON(http.StatusUnauthorized, ErrorResponse{Error: "Invalid MFA code or expired challenge"})
+		return
+	}
+
+	verifyResp := VerifyMFAResponse{
+		AccessToken:   resp.Token,
+		RefreshToken:  resp.RefreshToken,
+		RecoveryCodes: resp.RecoveryCodes,
+	}
+
+	c.JSON(http.StatusOK, verifyResp)
+}
+
+// EnrollMFAChallenge enrolls an authenticator app during a login that requires enrollment
+// @Tags Authentication
+// @Summary Enroll MFA During Login API
+// @Description
+// @Description **Request Body:**
+// @Description
+// @Description challenge_token:
+// @Description - Required
+// @Description - The mfa_challenge_token of a login response with mfa_enrollment_required
+// @Description
+// @Description The response carries the TOTP secret and its otpauth:// URI to show as QR code. The login is completed
+// @Description at /api/v1/auth/mfa/verify with the same challenge token and the first code of the authenticator app.
+// @Accept json
+// @Produce json
+// @Param enroll body EnrollMFAChallengeRequest true "MFA challenge"
+// @Success 200 {object} EnrollMFAResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Router /api/v1/auth/mfa/challenge/enroll [post]
+func (h *AuthHandler) EnrollMFAChallenge(c *gin.Context) {
+	var req EnrollMFAChallengeRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		logging.Logger.Warn().Err(err).Msg("invalid request param")
+		c.JSON(http.StatusBadRequest, ErrorResponse{Error: "Invalid request payload"})
+		return
+	}
+
+	h.enrollMFA(c, &protoauth.EnrollMFARequest{ChallengeToken: req.ChallengeToken})
+}
+
+// EnrollMFA enrolls an authenticator app for the logged-in employee
+// @Tags Authentication
+// @Summary Enroll MFA API
+// @Description
+// @Description The response carries the TOTP secret and its otpauth:// URI to show as QR code. MFA is enabled once
+// @Description the first code of the authenticator app is confirmed at /api/v1/auth/mfa/confirm. Enrolling again
+// @Description replaces an unconfirmed enrollment; enabled MFA has to be reset by an admin first.
+// @Description
+// @Description **Header:**
+// @Description
+// @Description Authorization:
+// @Description - Required
+// @Description - Format: Bearer token
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer token" default(Bearer )
+// @Success 200 {object} EnrollMFAResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Router /api/v1/auth/mfa/enroll [post]
+func (h *AuthHandler) EnrollMFA(c *gin.Context) {
+	h.enrollMFA(c, &protoauth.EnrollMFARequest{Username: c.GetString("username")})
+}
+
+func (h *AuthHandler) enrollMFA(c *gin.Context, grpcReq *protoauth.EnrollMFARequest) {
+	resp, err := h.AuthClient.EnrollMFA(c.Request.Context(), grpcReq)
+	if err != nil || resp == nil {
+		logging.Logger.Error().Err(err).Msg("failed to enroll MFA")
+		c.JSON(http.StatusUnauthorized, ErrorResponse{Error: "Invalid request"})
+		return
+	}
+
+	if !resp.Success {
+		logging.Logger.Error().Err(errors.New(resp.Message)).Msg("unable to enroll MFA")
+		c.JSON(http.StatusBadRequest, ErrorResponse{Error: resp.Message})
+		return
+	}
+
+	res := EnrollMFAResponse{
+		Secret:          resp.Secret,
+		ProvisioningURI: resp.ProvisioningUri,
+		Message:         resp.Message,
+	}
+
+	c.JSON(http.StatusOK, res)
+}
+
+// ConfirmMFA enables the enrolled authenticator app of the logged-in employee
+// @Tags Authentication
+// @Summary Confirm MFA API
+// @Description
+// @Description **Request Body:**
+// @Description
+// @Description code:
+// @Description - Required
+// @Description - 6 digit code of the enrolled authenticator app
+// @Description
+// @Description From the next login on, a code of the authenticator app is required. The response carries the
+// @Description recovery codes, which are not shown again.
+// @Description
+// @Description **Header:**
+// @Description
+// @Description Authorization:
+// @Description - Required
+// @Description - Format: Bearer token
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer token" default(Bearer )
+// @Param confirm body ConfirmMFARequest true "Code of the authenticator app"
+// @Success 200 {object} ConfirmMFAResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Router /api/v1/auth/mfa/confirm [post]
+func (h *AuthHandler) ConfirmMFA(c *gin.Context) {
+	var req ConfirmMFARequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		logging.Logger.Warn().Err(err).Msg("invalid request param")
+		c.JSON(http.StatusBadRequest, ErrorResponse{Error: "Invalid request payload"})
+		return
+	}
+
+	grpcReq := &protoauth.ConfirmMFARequest{
+		Username: c.GetString("username"),
+		Code:     req.Code,
+	}
+
+	resp, err := h.AuthClient.ConfirmMFA(c.Request.Context(), grpcReq)
+	if err != nil || resp == nil {
+		logging.Logger.Error().Err(err).Msg("failed to confirm MFA")
+		c.JSON(http.StatusUnauthorized, ErrorResponse{Error: "Invalid request"})
+		return
+	}
+
+	if !resp.Success {
+		logging.Logger.Error().Err(errors.New(resp.Message)).Msg("unable to confirm MFA")
+		c.JSON(http.StatusBadRequest, ErrorResponse{Error: resp.Message})
+		return
+	}
+
+	res := ConfirmMFAResponse{
+		RecoveryCodes: resp.RecoveryCodes,
+		Message:       resp.Message,
+	}
+
+	c.JSON(http.StatusOK, res)
+}
+
+// ResetMFA removes the second factor of an employee
+// @Tags Employee
+// @Summary Reset Employee MFA
+// @Description
+// @Description Removes the authenticator app and the recovery codes of the employee, e.g. when both are lost. When MFA
+// @Description is required for the role of the employee, the next login requires a new enrollment.
+// @Description
+// @Description **Header:**
+// @Description
+// @Description Authorization:
+// @Description - Required
+// @Description - Format: Bearer token
+// @Description
+// @Description **Path Parameter:**
+// @Description
+// @Description username:
+// @Description - Required
+// @Description - Username of the employee
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer token" default(Bearer )
+// @Param username path string true "Username of the employee"
+// @Success 200 {object} ResetMFAResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Router /api/v1/employee/{username}/mfa [delete]
+func (h *AuthHandler) ResetMFA(c *gin.Context) {
+	grpcReq := &protoauth.ResetMFARequest{
+		Username:  c.Param("username"),
+		Requester: c.GetString("username"),
+	}
+
+	resp, err := h.AuthClient.ResetMFA(c.Request.Context(), grpcReq)
+	if err != nil || resp == nil {
+		logging.Logger.Error().Err(err).Msg("failed to reset MFA")
+		c.JSON(http.StatusUnauthorized, ErrorResponse{Error: "Invalid request"})
+		return
+	}
+
+	if !resp.Success {
+		logging.Logger.Error().Err(errors.New(resp.Message)).Msg("unable to reset MFA")
+		c.JSON(http.StatusBadRequest, ErrorResponse{Error: resp.Message})
+		return
+	}
+
+	c.JSON(http.StatusOK, ResetMFAResponse{Message: resp.Message})
+}
+
+// SetMFAPolicy makes MFA mandatory or optional for a role
+// @Tags Authentication
+// @Summary Set MFA Policy
+// @Description
+// @Description **Path Parameter:**
+// @Description
+// @Description role:
+// @Description - Required
+// @Description - Options: **admin**, **editor**, **viewer**
+// @Description
+// @Description **Request Body:**
+// @Description
+// @Description required:
+// @Description - Required
+// @Description - Whether employees of the role need MFA
+// @Description
+// @Description The policy applies from the next login. Employees of the role who have not enrolled yet have to enroll
+// @Description an authenticator app before they get tokens.
+// @Description
+// @Description **Header:**
+// @Description
+// @Description Authorization:
+// @Description - Required
+// @Description - Format: Bearer token
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer token" default(Bearer )
+// @Param role path string true "Role"
+// @Param policy body SetMFAPolicyRequest true "MFA policy"
+// @Success 200 {object} SetMFAPolicyResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Router /api/v1/auth/mfa/policies/{role} [put]
+func (h *AuthHandler) SetMFAPolicy(c *gin.Context) {
+	var req SetMFAPolicyRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		logging.Logger.Warn().Err(err).Msg("invalid request param")
+		c.JSON(http.StatusBadRequest, ErrorResponse{Error: "Invalid request payload"})
+		return
+	}
+
+	grpcReq := &protoauth.SetMFAPolicyRequest{
+		Role:      c.Param("role"),
+		Required:  *req.Required,
+		Requester: c.GetString("username"),
+	}
+
+	resp, err := h.AuthClient.SetMFAPolicy(c.Request.Context(), grpcReq)
+	if err != nil || resp == nil {
+		logging.Logger.Error().Err(err).Msg("failed to set MFA policy")
+		c.JSON(http.StatusUnauthorized, ErrorResponse{Error: "Invalid request"})
+		return
+	}
+
+	if !resp.Success {
+		logging.Logger.Error().Err(errors.New(resp.Message)).Msg("unable to set MFA policy")
+		c.JSON(http.StatusBadRequest, ErrorResponse{Error: resp.Message})
+		return
+	}
+
+	c.JSON(http.StatusOK, SetMFAPolicyResponse{Message: resp.Message})
+}
+
+// ListMFAPolicies lists whether MFA is required for each role
+// @Tags Authentication
+// @Summary List MFA Policies
+// @Description
+// @Description **Header:**
+// @Description
+// @Description Authorization:
+// @Description - Required
+// @Description - Format: Bearer token
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer token" default(Bearer )
+// @Success 200 {object} ListMFAPoliciesResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Router /api/v1/auth/mfa/policies [get]
+func (h *AuthHandler) ListMFAPolicies(c *gin.Context) {
+	resp, err := h.AuthClient.ListMFAPolicies(c.Request.Context(), &protoauth.ListMFAPoliciesRequest{})
+	if err != nil || resp == nil {
+		logging.Logger.Error().Err(err).Msg("failed to get MFA policies")
+		c.JSON(http.StatusUnauthorized, ErrorResponse{Error: "Invalid request"})
+		return
+	}
+
+	if !resp.Success {
+		logging.Logger.Error().Err(errors.New(resp.Message)).Msg("unable to get MFA policies")
+		c.JSON(http.StatusBadRequest, ErrorResponse{Error: resp.Message})
+		return
+	}
+
+	res := ListMFAPoliciesResponse{
+		Policies: resp.Policies,
+		Message:  resp.Message,
+	}
+
+	c.JSON(http.StatusOK, res)
+}
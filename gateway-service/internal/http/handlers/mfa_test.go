@@ -0,0 +1,271 @@
+package handlers
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	protoauth "gateway-service/api/protogen/authservice/proto"
+	mock_client "gateway-service/internal/ports/mocks/grpc_client"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func setupMFARoutes(authHandler *AuthHandler) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+
+	router.POST("/api/v1/auth/mfa/verify", authHandler.VerifyMFA)
+	router.POST("/api/v1/auth/mfa/challenge/enroll", authHandler.EnrollMFAChallenge)
+
+	protected := router.Group("/api/v1")
+	protected.Use(func(c *gin.Context) {
+		c.Set("username", "test-admin")
+		c.Next()
+	})
+	protected.POST("/auth/mfa/enroll", authHandler.EnrollMFA)
+	protected.POST("/auth/mfa/confirm", authHandler.ConfirmMFA)
+	protected.GET("/auth/mfa/policies", authHandler.ListMFAPolicies)
+	protected.PUT("/auth/mfa/policies/:role", authHandler.SetMFAPolicy)
+	protected.DELETE("/employee/:username/mfa", authHandler.ResetMFA)
+	return router
+}
+
+func serveMFARequest(router *gin.Engine, method, path, body string) *httptest.ResponseRecorder {
+	req, _ := http.NewRequest(method, path, bytes.NewBufferString(body))
+	req.Header.Set("Content-Type", "application/json")
+
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+	return w
+}
+
+// TestVerifyMFA_Success tests exchanging a challenge and code for tokens
+func TestVerifyMFA_Success(t *testing.T) {
+	mockClient := new(mock_client.MockAuthClient)
+	router := setupMFARoutes(NewAuthHandler(mockClient, nil))
+
+	mockClient.On("VerifyMFA", mock.Anything, &protoauth.VerifyMFARequest{
+		ChallengeToken: "challenge-token",
+		Code:           "123456",
+	}).Return(&protoauth.VerifyMFAResponse{
+		Token:         "jwt-token-here",
+		RefreshToken:  "refresh-token-here",
+		RecoveryCodes: []string{"abcde-fghij"},
+	}, nil)
+
+	w := serveMFARequest(router, "POST", "/api/v1/auth/mfa/verify", `{"challenge_token": "challenge-token", "code": "123456"}`)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	var response VerifyMFAResponse
+	err := json.Unmarshal(w.Body.Bytes(), &response)
+	assert.NoError(t, err)
+	assert.Equal(t, "jwt-token-here", response.AccessToken)
+	assert.Equal(t, "refresh-token-here", response.RefreshToken)
+	assert.Equal(t, []string{"abcde-fghij"}, response.RecoveryCodes)
+
+	mockClient.AssertExpectations(t)
+}
+
+// TestVerifyMFA_MissingCode tests that either a code or a recovery code is required
+func TestVerifyMFA_MissingCode(t *testing.T) {
+	mockClient := new(mock_client.MockAuthClient)
+	router := setupMFARoutes(NewAuthHandler(mockClient, nil))
+
+	w := serveMFARequest(router, "POST", "/api/v1/auth/mfa/verify", `{"challenge_token": "challenge-token"}`)
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	mockClient.AssertNotCalled(t, "VerifyMFA", mock.Anything, mock.Anything)
+}
+
+// TestVerifyMFA_InvalidCode tests that a wrong code or expired challenge is unauthorized
+func TestVerifyMFA_InvalidCode(t *testing.T) {
+	mockClient := new(mock_client.MockAuthClient)
+	router := setupMFARoutes(NewAuthHandler(mockClient, nil))
+
+	mockClient.On("VerifyMFA", mock.Anything, mock.Anything).Return(nil, errors.New("invalid MFA code"))
+
+	w := serveMFARequest(router, "POST", "/api/v1/auth/mfa/verify", `{"challenge_token": "challenge-token", "recovery_code": "abcde-fghij"}`)
+
+	assert.Equal(t, http.StatusUnauthorized, w.Code)
+	assert.Contains(t, w.Body.String(), "Invalid MFA code or expired challenge")
+}
+
+// TestEnrollMFAChallenge_Success tests enrolling with the challenge of a login that requires enrollment
+func TestEnrollMFAChallenge_Success(t *testing.T) {
+	mockClient := new(mock_client.MockAuthClient)
+	router := setupMFARoutes(NewAuthHandler(mockClient, nil))
+
+	mockClient.On("EnrollMFA", mock.Anything, &protoauth.EnrollMFARequest{
+		ChallengeToken: "challenge-token",
+	}).Return(&protoauth.EnrollMFAResponse{
+		Secret:          "JBSWY3DPEHPK3PXP",
+		ProvisioningUri: "otpauth://totp/BankOps%20Core:testuser?secret=JBSWY3DPEHPK3PXP",
+		Message:         "Scan the QR code",
+		Success:         true,
+	}, nil)
+
+	w := serveMFARequest(router, "POST", "/api/v1/auth/mfa/challenge/enroll", `{"challenge_token": "challenge-token"}`)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	var response EnrollMFAResponse
+	err := json.Unmarshal(w.Body.Bytes(), &response)
+	assert.NoError(t, err)
+	assert.Equal(t, "JBSWY3DPEHPK3PXP", response.Secret)
+	assert.Contains(t, response.ProvisioningURI, "otpauth://totp/")
+
+	mockClient.AssertExpectations(t)
+}
+
+// TestEnrollMFA_Success tests enrolling the logged-in employee
+func TestEnrollMFA_Success(t *testing.T) {
+	mockClient := new(mock_client.MockAuthClient)
+	router := setupMFARoutes(NewAuthHandler(mockClient, nil))
+
+	mockClient.On("EnrollMFA", mock.Anything, &protoauth.EnrollMFARequest{
+		Username: "test-admin",
+	}).Return(&protoauth.EnrollMFAResponse{Secret: "JBSWY3DPEHPK3PXP", Success: true}, nil)
+
+	w := serveMFARequest(router, "POST", "/api/v1/auth/mfa/enroll", "")
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	mockClient.AssertExpectations(t)
+}
+
+// TestEnrollMFA_AlreadyEnabled tests that enabled MFA is not replaced
+func TestEnrollMFA_AlreadyEnabled(t *testing.T) {
+	mockClient := new(mock_client.MockAuthClient)
+	router := setupMFARoutes(NewAuthHandler(mockClient, nil))
+
+	mockClient.On("EnrollMFA", mock.Anything, mock.Anything).Return(&protoauth.EnrollMFAResponse{
+		Message: "MFA is already enabled",
+		Success: false,
+	}, nil)
+
+	w := serveMFARequest(router, "POST", "/api/v1/auth/mfa/enroll", "")
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	assert.Contains(t, w.Body.String(), "MFA is already enabled")
+}
+
+// TestConfirmMFA_Success tests enabling MFA with the first code
+func TestConfirmMFA_Success(t *testing.T) {
+	mockClient := new(mock_client.MockAuthClient)
+	router := setupMFARoutes(NewAuthHandler(mockClient, nil))
+
+	mockClient.On("ConfirmMFA", mock.Anything, &protoauth.ConfirmMFARequest{
+		Username: "test-admin",
+		Code:     "123456",
+	}).Return(&protoauth.ConfirmMFAResponse{
+		RecoveryCodes: []string{"abcde-fghij", "klmno-pqrst"},
+		Message:       "MFA enabled successfully",
+		Success:       true,
+	}, nil)
+
+	w := serveMFARequest(router, "POST", "/api/v1/auth/mfa/confirm", `{"code": "123456"}`)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	var response ConfirmMFAResponse
+	err := json.Unmarshal(w.Body.Bytes(), &response)
+	assert.NoError(t, err)
+	assert.Len(t, response.RecoveryCodes, 2)
+
+	mockClient.AssertExpectations(t)
+}
+
+// TestConfirmMFA_MissingCode tests confirmation without a code
+func TestConfirmMFA_MissingCode(t *testing.T) {
+	mockClient := new(mock_client.MockAuthClient)
+	router := setupMFARoutes(NewAuthHandler(mockClient, nil))
+
+	w := serveMFARequest(router, "POST", "/api/v1/auth/mfa/confirm", `{}`)
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	mockClient.AssertNotCalled(t, "ConfirmMFA", mock.Anything, mock.Anything)
+}
+
+// TestResetMFA_Success tests resetting the MFA of an employee
+func TestResetMFA_Success(t *testing.T) {
+	mockClient := new(mock_client.MockAuthClient)
+	router := setupMFARoutes(NewAuthHandler(mockClient, nil))
+
+	mockClient.On("ResetMFA", mock.Anything, &protoauth.ResetMFARequest{
+		Username:  "testuser",
+		Requester: "test-admin",
+	}).Return(&protoauth.ResetMFAResponse{Message: "MFA reset successfully", Success: true}, nil)
+
+	w := serveMFARequest(router, "DELETE", "/api/v1/employee/testuser/mfa", "")
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Contains(t, w.Body.String(), "MFA reset successfully")
+	mockClient.AssertExpectations(t)
+}
+
+// TestSetMFAPolicy_Success tests making MFA optional for a role
+func TestSetMFAPolicy_Success(t *testing.T) {
+	mockClient := new(mock_client.MockAuthClient)
+	router := setupMFARoutes(NewAuthHandler(mockClient, nil))
+
+	mockClient.On("SetMFAPolicy", mock.Anything, &protoauth.SetMFAPolicyRequest{
+		Role:      "editor",
+		Required:  false,
+		Requester: "test-admin",
+	}).Return(&protoauth.SetMFAPolicyResponse{Message: "MFA policy updated successfully", Success: true}, nil)
+
+	w := serveMFARequest(router, "PUT", "/api/v1/auth/mfa/policies/editor", `{"required": false}`)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	mockClient.AssertExpectations(t)
+}
+
+// TestSetMFAPolicy_MissingRequired tests that the policy has to be stated explicitly
+func TestSetMFAPolicy_MissingRequired(t *testing.T) {
+	mockClient := new(mock_client.MockAuthClient)
+	router := setupMFARoutes(NewAuthHandler(mockClient, nil))
+
+	w := serveMFARequest(router, "PUT", "/api/v1/auth/mfa/policies/editor", `{}`)
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	mockClient.AssertNotCalled(t, "SetMFAPolicy", mock.Anything, mock.Anything)
+}
+
+// TestSetMFAPolicy_InvalidRole tests that the auth service rejects unknown roles
+func TestSetMFAPolicy_InvalidRole(t *testing.T) {
+	mockClient := new(mock_client.MockAuthClient)
+	router := setupMFARoutes(NewAuthHandler(mockClient, nil))
+
+	mockClient.On("SetMFAPolicy", mock.Anything, mock.Anything).Return(&protoauth.SetMFAPolicyResponse{Message: "Invalid role", Success: false}, nil)
+
+	w := serveMFARequest(router, "PUT", "/api/v1/auth/mfa/policies/guest", `{"required": true}`)
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	assert.Contains(t, w.Body.String(), "Invalid role")
+}
+
+// TestListMFAPolicies_Success tests listing the policy of each role
+func TestListMFAPolicies_Success(t *testing.T) {
+	mockClient := new(mock_client.MockAuthClient)
+	router := setupMFARoutes(NewAuthHandler(mockClient, nil))
+
+	mockClient.On("ListMFAPolicies", mock.Anything, mock.Anything).Return(&protoauth.ListMFAPoliciesResponse{
+		Policies: []*protoauth.MFAPolicy{
+			{Role: "admin", Required: true, UpdatedBy: "test-admin"},
+			{Role: "editor"},
+			{Role: "viewer"},
+		},
+		Message: "MFA policies retrieved successfully",
+		Success: true,
+	}, nil)
+
+	w := serveMFARequest(router, "GET", "/api/v1/auth/mfa/policies", "")
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Contains(t, w.Body.String(), `"role":"admin"`)
+	mockClient.AssertExpectations(t)
+}
@@ -0,0 +1,18 @@
+package handlers
+
+import (
+	"github.com/gin-gonic/gin"
+	"strconv"
+	"strings"
+)
+
+// cursorQuery reads the cursor and include_total query parameters of a listing. Offset pages count totals unless
+// include_total is false, as they did before cursors; cursor pages only count them when include_total is true.
+func cursorQuery(c *gin.Context) (string, bool) {
+	cursor := strings.TrimSpace(c.Query("cursor"))
+	includeTotal := cursor == ""
+	if v, err := strconv.ParseBool(strings.TrimSpace(c.Query("include_total"))); err == nil {
+		includeTotal = v
+	}
+	return cursor, includeTotal
+}
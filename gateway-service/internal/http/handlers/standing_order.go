@@ -0,0 +1,409 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	prototx "gateway-service/api/protogen/txservice/proto"
+	"gateway-service/internal/logging"
+	"github.com/gin-gonic/gin"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"net/http"
+	"strings"
+	"time"
+)
+
+const (
+	standingOrderActionPause  = "pause"
+	standingOrderActionResume = "resume"
+	standingOrderActionCancel = "cancel"
+)
+
+type CreateStandingOrderRequest struct {
+	SourceAccountID      string      `json:"source_account_id" binding:"required"`
+	DestinationAccountID string      `json:"destination_account_id" binding:"required"`
+	Amount               json.Number `json:"amount" binding:"required" swaggertype:"string"` // decimal, e.g. "1200.00"
+	Frequency            string      `json:"frequency" binding:"required"`
+	StartAt              *time.Time  `json:"start_at"` // RFC 3339, defaults to now
+	EndAt                *time.Time  `json:"end_at"`   // RFC 3339
+	MaxRuns              int32       `json:"max_runs"`
+	Description          string      `json:"description"`
+}
+
+type StandingOrderResponse struct {
+	StandingOrder interface{} `json:"standing_order"`
+	Message       string      `json:"message" binding:"message"`
+}
+
+type GetStandingOrderResponse struct {
+	StandingOrder interface{} `json:"standing_order"`
+	Runs          interface{} `json:"runs"`
+	Message       string      `json:"message" binding:"message"`
+}
+
+type ListStandingOrdersResponse struct {
+	StandingOrders interface{} `json:"standing_orders"`
+	Message        string      `json:"message" binding:"message"`
+}
+
+// CreateStandingOrder schedules a one-off or recurring transfer
+// @Tags Standing Order
+// @Summary Create Standing Order
+// @Description
+// @Description **Request Body:**
+// @Description
+// @Description Source Account ID / Destination Account ID:
+// @Description - Required
+// @Description - Must be different accounts
+// @Description
+// @Description Amount:
+// @Description - Required
+// @Description - Must be greater than zero
+// @Description - Decimal number or string with at most 2 decimal places (e.g. "1200.00")
+// @Description
+// @Description Frequency:
+// @Description - Required
+// @Description - Options: **once**, **daily**, **weekly**, **monthly**
+// @Description - Monthly runs keep the start day, falling back to the last day of shorter months
+// @Description
+// @Description Start At:
+// @Description - Optional
+// @Description - RFC 3339 time of the first run (e.g. "2026-01-31T09:00:00Z")
+// @Description - Must not be in the past
+// @Description - Default: now
+// @Description
+// @Description End At:
+// @Description - Optional
+// @Description - RFC 3339 time, no run is scheduled after it
+// @Description
+// @Description Max Runs:
+// @Description - Optional
+// @Description - Number of runs after which the order completes
+// @Description - Default: 0, no limit
+// @Description
+// @Description Each run initiates a transfer with the reference **standing-order:{id}:{run number}** on behalf of the creator.
+// @Description
+// @Description **Header:**
+// @Description
+// @Description Authorization:
+// @Description - Required
+// @Description - Format: Bearer token
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer token" default(Bearer )
+// @Param standing_order body CreateStandingOrderRequest true "Standing order details"
+// @Success 201 {object} StandingOrderResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Router /api/v1/standing-order [post]
+func (h *TransactionHandler) CreateStandingOrder(c *gin.Context) {
+	var req CreateStandingOrderRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		logging.Logger.Warn().Err(err).Msg("invalid request param")
+		c.JSON(http.StatusBadRequest, ErrorResponse{Error: "Invalid request payload"})
+		return
+	}
+
+	un, _ := c.Get("username") // middleware
+	requester, ok := un.(string)
+	if !ok {
+		logging.Logger.Warn().Err(errors.New("unable to get requester username")).Msg("requester: " + requester)
+	}
+
+	grpcReq := &prototx.CreateStandingOrderRequest{
+		SourceAccountId:      req.SourceAccountID,
+		DestinationAccountId: req.DestinationAccountID,
+		Amount:               req.Amount.String(),
+		Frequency:            req.Frequency,
+		MaxRuns:              req.MaxRuns,
+		Description:          req.Description,
+		Metadata: &prototx.Metadata{
+			RequestId:     c.GetHeader("X-Request-ID"),
+			Requester:     requester,
+			RequesterRole: c.GetString("role"), // limits of the role apply
+		},
+	}
+	if req.StartAt != nil {
+		grpcReq.StartAt = timestamppb.New(*req.StartAt)
+	}
+	if req.EndAt != nil {
+		grpcReq.EndAt = timestamppb.New(*req.EndAt)
+	}
+
+	resp, err := h.TransactionClient.CreateStandingOrder(c.Request.Context(), grpcReq)
+	if err != nil || resp == nil {
+		logging.Logger.Error().Err(err).Msg("failed to create standing order")
+		c.JSON(http.StatusUnauthorized, ErrorResponse{Error: "Invalid request"})
+		return
+	}
+
+	if !resp.Response.Success {
+		logging.Logger.Error().Err(errors.New(resp.Response.Message)).Msg("unable to create standing order")
+		c.JSON(http.StatusBadRequest, ErrorResponse{Error: resp.Response.Message})
+		return
+	}
+
+	res := StandingOrderResponse{
+		StandingOrder: resp.StandingOrder,
+		Message:       resp.Response.Message,
+	}
+
+	c.JSON(http.StatusCreated, res)
+}
+
+// GetStandingOrder fetches a standing order with the outcome of each of its runs
+// @Tags Standing Order
+// @Summary Get Standing Order
+// @Description
+// @Description **Path Parameter:**
+// @Description
+// @Description id:
+// @Description - Required
+// @Description - Standing order ID
+// @Description
+// @Description Runs are listed newest first, each with the initiated transaction ID and whether it succeeded.
+// @Description
+// @Description **Header:**
+// @Description
+// @Description Authorization:
+// @Description - Required
+// @Description - Format: Bearer token
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer token" default(Bearer )
+// @Param id path string true "Standing order ID"
+// @Success 200 {object} GetStandingOrderResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Router /api/v1/standing-order/{id} [get]
+func (h *TransactionHandler) GetStandingOrder(c *gin.Context) {
+	standingOrderId := strings.TrimSpace(c.Param("id"))
+
+	un, _ := c.Get("username") // middleware
+	requester, ok := un.(string)
+	if !ok {
+		logging.Logger.Warn().Err(errors.New("unable to get requester username")).Msg("requester: " + requester)
+	}
+
+	grpcReq := &prototx.GetStandingOrderRequest{
+		StandingOrderId: standingOrderId,
+		Metadata: &prototx.Metadata{
+			RequestId: c.GetHeader("X-Request-ID"),
+			Requester: requester,
+		},
+	}
+
+	resp, err := h.TransactionClient.GetStandingOrder(c.Request.Context(), grpcReq)
+	if err != nil {
+		logging.Logger.Error().Err(err).Msg("failed to get standing order")
+		c.JSON(http.StatusUnauthorized, ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	if !resp.Response.Success {
+		logging.Logger.Error().Err(errors.New(resp.Response.Message)).Msg("unable to get standing order")
+		c.JSON(standingOrderErrorStatus(resp.Response.Message), ErrorResponse{Error: resp.Response.Message})
+		return
+	}
+
+	res := GetStandingOrderResponse{
+		StandingOrder: resp.StandingOrder,
+		Runs:          resp.Runs,
+		Message:       resp.Response.Message,
+	}
+
+	c.JSON(http.StatusOK, res)
+}
+
+// ListStandingOrders lists the standing orders debiting an account
+// @Tags Standing Order
+// @Summary List Standing Orders
+// @Description
+// @Description **Query Parameters:**
+// @Description
+// @Description account_id:
+// @Description - Required
+// @Description - Source account of the standing orders
+// @Description
+// @Description **Header:**
+// @Description
+// @Description Authorization:
+// @Description - Required
+// @Description - Format: Bearer token
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer token" default(Bearer )
+// @Param account_id query string true "Account ID"
+// @Success 200 {object} ListStandingOrdersResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Router /api/v1/standing-order [get]
+func (h *TransactionHandler) ListStandingOrders(c *gin.Context) {
+	accountId := strings.TrimSpace(c.Query("account_id"))
+
+	un, _ := c.Get("username") // middleware
+	requester, ok := un.(string)
+	if !ok {
+		logging.Logger.Warn().Err(errors.New("unable to get requester username")).Msg("requester: " + requester)
+	}
+
+	grpcReq := &prototx.ListStandingOrdersRequest{
+		AccountId: accountId,
+		Metadata: &prototx.Metadata{
+			RequestId: c.GetHeader("X-Request-ID"),
+			Requester: requester,
+		},
+	}
+
+	resp, err := h.TransactionClient.ListStandingOrders(c.Request.Context(), grpcReq)
+	if err != nil {
+		logging.Logger.Error().Err(err).Msg("failed to list standing orders")
+		c.JSON(http.StatusUnauthorized, ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	if !resp.Response.Success {
+		logging.Logger.Error().Err(errors.New(resp.Response.Message)).Msg("unable to list standing orders")
+		c.JSON(http.StatusBadRequest, ErrorResponse{Error: resp.Response.Message})
+		return
+	}
+
+	res := ListStandingOrdersResponse{
+		StandingOrders: resp.StandingOrders,
+		Message:        resp.Response.Message,
+	}
+
+	c.JSON(http.StatusOK, res)
+}
+
+// PauseStandingOrder stops an active standing order from running until it is resumed
+// @Tags Standing Order
+// @Summary Pause Standing Order
+// @Description
+// @Description **Path Parameter:**
+// @Description
+// @Description id:
+// @Description - Required
+// @Description - Standing order ID, the order must be **active**
+// @Description
+// @Description **Header:**
+// @Description
+// @Description Authorization:
+// @Description - Required
+// @Description - Format: Bearer token
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer token" default(Bearer )
+// @Param id path string true "Standing order ID"
+// @Success 200 {object} StandingOrderResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Router /api/v1/standing-order/{id}/pause [post]
+func (h *TransactionHandler) PauseStandingOrder(c *gin.Context) {
+	h.updateStandingOrderStatus(c, standingOrderActionPause)
+}
+
+// ResumeStandingOrder reactivates a paused standing order
+// @Tags Standing Order
+// @Summary Resume Standing Order
+// @Description
+// @Description **Path Parameter:**
+// @Description
+// @Description id:
+// @Description - Required
+// @Description - Standing order ID, the order must be **paused**
+// @Description
+// @Description Recurring runs that fell due while the order was paused are skipped, the order continues with its next occurrence.
+// @Description
+// @Description **Header:**
+// @Description
+// @Description Authorization:
+// @Description - Required
+// @Description - Format: Bearer token
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer token" default(Bearer )
+// @Param id path string true "Standing order ID"
+// @Success 200 {object} StandingOrderResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Router /api/v1/standing-order/{id}/resume [post]
+func (h *TransactionHandler) ResumeStandingOrder(c *gin.Context) {
+	h.updateStandingOrderStatus(c, standingOrderActionResume)
+}
+
+// CancelStandingOrder stops a standing order permanently
+// @Tags Standing Order
+// @Summary Cancel Standing Order
+// @Description
+// @Description **Path Parameter:**
+// @Description
+// @Description id:
+// @Description - Required
+// @Description - Standing order ID, the order must be **active** or **paused**
+// @Description
+// @Description **Header:**
+// @Description
+// @Description Authorization:
+// @Description - Required
+// @Description - Format: Bearer token
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer token" default(Bearer )
+// @Param id path string true "Standing order ID"
+// @Success 200 {object} StandingOrderResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Router /api/v1/standing-order/{id}/cancel [post]
+func (h *TransactionHandler) CancelStandingOrder(c *gin.Context) {
+	h.updateStandingOrderStatus(c, standingOrderActionCancel)
+}
+
+func (h *TransactionHandler) updateStandingOrderStatus(c *gin.Context, action string) {
+	standingOrderId := strings.TrimSpace(c.Param("id"))
+
+	un, _ := c.Get("username") // middleware
+	requester, ok := un.(string)
+	if !ok {
+		logging.Logger.Warn().Err(errors.New("unable to get requester username")).Msg("requester: " + requester)
+	}
+
+	grpcReq := &prototx.UpdateStandingOrderStatusRequest{
+		StandingOrderId: standingOrderId,
+		Action:          action,
+		Metadata: &prototx.Metadata{
+			RequestId: c.GetHeader("X-Request-ID"),
+			Requester: requester,
+		},
+	}
+
+	resp, err := h.TransactionClient.UpdateStandingOrderStatus(c.Request.Context(), grpcReq)
+	if err != nil || resp == nil {
+		logging.Logger.Error().Err(err).Str("action", action).Msg("failed to update standing order status")
+		c.JSON(http.StatusUnauthorized, ErrorResponse{Error: "Invalid request"})
+		return
+	}
+
+	if !resp.Response.Success {
+		logging.Logger.Error().Err(errors.New(resp.Response.Message)).Str("action", action).Msg("unable to update standing order status")
+		c.JSON(standingOrderErrorStatus(resp.Response.Message), ErrorResponse{Error: resp.Response.Message})
+		return
+	}
+
+	res := StandingOrderResponse{
+		StandingOrder: resp.StandingOrder,
+		Message:       resp.Response.Message,
+	}
+
+	c.JSON(http.StatusOK, res)
+}
+
+func standingOrderErrorStatus(message string) int {
+	if message == "Standing order not found" {
+		return http.StatusNotFound
+	}
+	return http.StatusBadRequest
+}
@@ -0,0 +1,206 @@
+package handlers
+
+import (
+	"bytes"
+	"encoding/json"
+	prototx "gateway-service/api/protogen/txservice/proto"
+	mock_client "gateway-service/internal/ports/mocks/grpc_client"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func setupStandingOrderRoutes(handler *TransactionHandler) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+
+	router.Use(func(c *gin.Context) {
+		c.Set("username", "test-editor")
+		c.Next()
+	})
+
+	router.POST("/api/v1/standing-order", handler.CreateStandingOrder)
+	router.GET("/api/v1/standing-order", handler.ListStandingOrders)
+	router.GET("/api/v1/standing-order/:id", handler.GetStandingOrder)
+	router.POST("/api/v1/standing-order/:id/pause", handler.PauseStandingOrder)
+	router.POST("/api/v1/standing-order/:id/resume", handler.ResumeStandingOrder)
+	router.POST("/api/v1/standing-order/:id/cancel", handler.CancelStandingOrder)
+
+	return router
+}
+
+// TestCreateStandingOrder_Success tests scheduling a monthly transfer
+func TestCreateStandingOrder_Success(t *testing.T) {
+	mockClient := new(mock_client.MockTransactionClient)
+	handler := &TransactionHandler{TransactionClient: mockClient}
+	router := setupStandingOrderRoutes(handler)
+
+	startAt := time.Date(2026, time.January, 31, 9, 0, 0, 0, time.UTC)
+	body := []byte(`{"source_account_id": "acc-123", "destination_account_id": "acc-456", "amount": "1200.00",
+		"frequency": "monthly", "start_at": "2026-01-31T09:00:00Z", "max_runs": 12, "description": "rent"}`)
+
+	expectedResponse := &prototx.CreateStandingOrderResponse{
+		StandingOrder: &prototx.StandingOrder{
+			Id:        "so-1",
+			Amount:    "1200.00",
+			Frequency: "monthly",
+			Status:    "active",
+		},
+		Response: &prototx.Response{
+			Success: true,
+			Message: "Standing order created successfully",
+		},
+	}
+
+	mockClient.On("CreateStandingOrder", mock.Anything, mock.MatchedBy(func(req *prototx.CreateStandingOrderRequest) bool {
+		return req.SourceAccountId == "acc-123" &&
+			req.DestinationAccountId == "acc-456" &&
+			req.Amount == "1200.00" &&
+			req.Frequency == "monthly" &&
+			req.StartAt.AsTime().Equal(startAt) &&
+			req.EndAt == nil &&
+			req.MaxRuns == 12 &&
+			req.Metadata.Requester == "test-editor" &&
+			req.Metadata.RequestId == "test-request-id"
+	})).Return(expectedResponse, nil)
+
+	req, _ := http.NewRequest("POST", "/api/v1/standing-order", bytes.NewBuffer(body))
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("X-Request-ID", "test-request-id")
+
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusCreated, w.Code)
+
+	var response map[string]interface{}
+	err := json.Unmarshal(w.Body.Bytes(), &response)
+	assert.NoError(t, err)
+	assert.Equal(t, "Standing order created successfully", response["message"])
+	standingOrder := response["standing_order"].(map[string]interface{})
+	assert.Equal(t, "so-1", standingOrder["id"])
+
+	mockClient.AssertExpectations(t)
+}
+
+// TestCreateStandingOrder_MissingFrequency tests if frequency is not provided
+func TestCreateStandingOrder_MissingFrequency(t *testing.T) {
+	mockClient := new(mock_client.MockTransactionClient)
+	handler := &TransactionHandler{TransactionClient: mockClient}
+	router := setupStandingOrderRoutes(handler)
+
+	body := []byte(`{"source_account_id": "acc-123", "destination_account_id": "acc-456", "amount": "10"}`)
+	req, _ := http.NewRequest("POST", "/api/v1/standing-order", bytes.NewBuffer(body))
+	req.Header.Set("Content-Type", "application/json")
+
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	mockClient.AssertNotCalled(t, "CreateStandingOrder", mock.Anything, mock.Anything)
+}
+
+// TestGetStandingOrder_NotFound tests if the standing order does not exist
+func TestGetStandingOrder_NotFound(t *testing.T) {
+	mockClient := new(mock_client.MockTransactionClient)
+	handler := &TransactionHandler{TransactionClient: mockClient}
+	router := setupStandingOrderRoutes(handler)
+
+	mockClient.On("GetStandingOrder", mock.Anything, mock.MatchedBy(func(req *prototx.GetStandingOrderRequest) bool {
+		return req.StandingOrderId == "so-404"
+	})).Return(&prototx.GetStandingOrderResponse{
+		Response: &prototx.Response{
+			Success: false,
+			Message: "Standing order not found",
+		},
+	}, nil)
+
+	req, _ := http.NewRequest("GET", "/api/v1/standing-order/so-404", nil)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusNotFound, w.Code)
+	mockClient.AssertExpectations(t)
+}
+
+// TestListStandingOrders_Success tests listing the standing orders of an account
+func TestListStandingOrders_Success(t *testing.T) {
+	mockClient := new(mock_client.MockTransactionClient)
+	handler := &TransactionHandler{TransactionClient: mockClient}
+	router := setupStandingOrderRoutes(handler)
+
+	mockClient.On("ListStandingOrders", mock.Anything, mock.MatchedBy(func(req *prototx.ListStandingOrdersRequest) bool {
+		return req.AccountId == "acc-123"
+	})).Return(&prototx.ListStandingOrdersResponse{
+		StandingOrders: []*prototx.StandingOrder{{Id: "so-1"}, {Id: "so-2"}},
+		Response: &prototx.Response{
+			Success: true,
+			Message: "Standing orders",
+		},
+	}, nil)
+
+	req, _ := http.NewRequest("GET", "/api/v1/standing-order?account_id=acc-123", nil)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	var response map[string]interface{}
+	err := json.Unmarshal(w.Body.Bytes(), &response)
+	assert.NoError(t, err)
+	assert.Len(t, response["standing_orders"], 2)
+	mockClient.AssertExpectations(t)
+}
+
+// TestUpdateStandingOrderStatus_Actions tests that each status route sends its action
+func TestUpdateStandingOrderStatus_Actions(t *testing.T) {
+	for _, action := range []string{"pause", "resume", "cancel"} {
+		t.Run(action, func(t *testing.T) {
+			mockClient := new(mock_client.MockTransactionClient)
+			handler := &TransactionHandler{TransactionClient: mockClient}
+			router := setupStandingOrderRoutes(handler)
+
+			mockClient.On("UpdateStandingOrderStatus", mock.Anything, mock.MatchedBy(func(req *prototx.UpdateStandingOrderStatusRequest) bool {
+				return req.StandingOrderId == "so-1" && req.Action == action && req.Metadata.Requester == "test-editor"
+			})).Return(&prototx.UpdateStandingOrderStatusResponse{
+				StandingOrder: &prototx.StandingOrder{Id: "so-1"},
+				Response: &prototx.Response{
+					Success: true,
+					Message: "Standing order updated",
+				},
+			}, nil)
+
+			req, _ := http.NewRequest("POST", "/api/v1/standing-order/so-1/"+action, nil)
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, http.StatusOK, w.Code)
+			mockClient.AssertExpectations(t)
+		})
+	}
+}
+
+// TestUpdateStandingOrderStatus_InvalidTransition tests if the transaction service rejects the status change
+func TestUpdateStandingOrderStatus_InvalidTransition(t *testing.T) {
+	mockClient := new(mock_client.MockTransactionClient)
+	handler := &TransactionHandler{TransactionClient: mockClient}
+	router := setupStandingOrderRoutes(handler)
+
+	mockClient.On("UpdateStandingOrderStatus", mock.Anything, mock.Anything).Return(&prototx.UpdateStandingOrderStatusResponse{
+		Response: &prototx.Response{
+			Success: false,
+			Message: "Cannot resume a cancelled standing order",
+		},
+	}, nil)
+
+	req, _ := http.NewRequest("POST", "/api/v1/standing-order/so-1/resume", nil)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	assert.Contains(t, w.Body.String(), "Cannot resume a cancelled standing order")
+}
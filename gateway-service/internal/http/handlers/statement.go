@@ -0,0 +1,378 @@
+package handlers
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	protoacc "gateway-service/api/protogen/accountservice/proto"
+	"gateway-service/internal/logging"
+	"gateway-service/internal/pdf"
+	"github.com/gin-gonic/gin"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	statementFormatJSON = "json"
+	statementFormatCSV  = "csv"
+	statementFormatPDF  = "pdf"
+)
+
+type StatementResponse struct {
+	Statement interface{} `json:"statement"`
+	Message   string      `json:"message" binding:"message"`
+}
+
+type ListAccountStatementsResponse struct {
+	Statements interface{} `json:"statements"`
+	Page       int         `json:"page"`
+	PageSize   int         `json:"pageSize"`
+	TotalCount int         `json:"totalCount"`
+	TotalPages int         `json:"totalPages"`
+	Message    string      `json:"message" binding:"message"`
+}
+
+// GenerateAccountStatement generates the statement of an account for a date range
+// @Tags Statement
+// @Summary Generate Account Statement
+// @Description
+// @Description **Path Parameter:**
+// @Description
+// @Description id:
+// @Description - Required
+// @Description - AccountID
+// @Description
+// @Description **Query Parameters:**
+// @Description
+// @Description from, to:
+// @Description - Required
+// @Description - First and last UTC day of the statement, both inclusive (e.g. 2026-01-01 and 2026-01-31)
+// @Description - At most 366 days apart
+// @Description
+// @Description format:
+// @Description - Optional
+// @Description - Options: **json**, **csv**, **pdf**
+// @Description - Default: json
+// @Description
+// @Description The statement lists every journal entry of the account in the range, oldest first, with the balance after
+// @Description it, between the opening balance before the first day and the closing balance at the end of the last day.
+// @Description Generated statements are not stored; monthly statements are issued automatically.
+// @Description
+// @Description **Header:**
+// @Description
+// @Description Authorization:
+// @Description - Required
+// @Description - Format: Bearer token
+// @Accept json
+// @Produce json,text/csv,application/pdf
+// @Param Authorization header string true "Bearer token" default(Bearer )
+// @Param id path string true "AccountID"
+// @Param from query string true "First day (YYYY-MM-DD)"
+// @Param to query string true "Last day (YYYY-MM-DD)"
+// @Param format query string false "json, csv or pdf" default(json)
+// @Success 200 {object} StatementResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Router /api/v1/account/{id}/statement [get]
+func (h *AccountHandler) GenerateAccountStatement(c *gin.Context) {
+	format, ok := statementFormat(c)
+	if !ok {
+		return
+	}
+
+	un, _ := c.Get("username") // middleware
+	requester, ok := un.(string)
+	if !ok {
+		logging.Logger.Warn().Err(errors.New("unable to get requester username")).Msg("requester: " + requester)
+	}
+
+	grpcReq := &protoacc.GenerateAccountStatementRequest{
+		AccountId: strings.TrimSpace(c.Param("id")),
+		From:      strings.TrimSpace(c.Query("from")),
+		To:        strings.TrimSpace(c.Query("to")),
+		Metadata: &protoacc.Metadata{
+			RequestId: c.GetHeader("X-Request-ID"),
+			Requester: requester,
+		},
+	}
+
+	resp, err := h.AccountClient.GenerateAccountStatement(c.Request.Context(), grpcReq)
+	if err != nil || resp == nil {
+		logging.Logger.Error().Err(err).Msg("failed to generate account statement")
+		c.JSON(http.StatusUnauthorized, ErrorResponse{Error: "Invalid request"})
+		return
+	}
+
+	if !resp.Response.Success {
+		logging.Logger.Error().Err(errors.New(resp.Response.Message)).Msg("unable to generate account statement")
+		c.JSON(statementErrorStatus(resp.Response.Message), ErrorResponse{Error: resp.Response.Message})
+		return
+	}
+
+	writeStatement(c, format, resp.Statement, resp.Response.Message)
+}
+
+// ListAccountStatements lists the issued monthly statements of an account
+// @Tags Statement
+// @Summary List Account Statements
+// @Description
+// @Description **Path Parameter:**
+// @Description
+// @Description id:
+// @Description - Required
+// @Description - AccountID
+// @Description
+// @Description **Query Parameters:**
+// @Description
+// @Description page:
+// @Description - Optional
+// @Description - Page number for pagination
+// @Description - Default: 1
+// @Description
+// @Description pagesize:
+// @Description - Optional
+// @Description - Number of statements per page
+// @Description - Default: 50
+// @Description
+// @Description A statement is issued for every active account after each UTC month ends. Statements are listed newest
+// @Description first without their lines; fetch one through /api/v1/statement/{id}.
+// @Description
+// @Description **Header:**
+// @Description
+// @Description Authorization:
+// @Description - Required
+// @Description - Format: Bearer token
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer token" default(Bearer )
+// @Param id path string true "AccountID"
+// @Param page query int false "Page number for pagination" default(1)
+// @Param pagesize query int false "Number of statements per page" default(50)
+// @Success 200 {object} ListAccountStatementsResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Router /api/v1/account/{id}/statements [get]
+func (h *AccountHandler) ListAccountStatements(c *gin.Context) {
+	pageStr := strings.TrimSpace(c.Query("page"))
+	pageSizeStr := strings.TrimSpace(c.Query("pagesize"))
+
+	var pageNo int = -1
+	var err error
+	if pageStr != "" {
+		pageNo, err = strconv.Atoi(pageStr)
+		if err != nil {
+			logging.Logger.Warn().Err(err).Msg("Invalid page no: " + pageStr)
+			pageNo = -1
+		}
+	}
+
+	var pageSize int = -1
+	if pageSizeStr != "" {
+		pageSize, err = strconv.Atoi(pageSizeStr)
+		if err != nil {
+			logging.Logger.Warn().Err(err).Msg("Invalid page size: " + pageSizeStr)
+			pageSize = -1
+		}
+	}
+
+	un, _ := c.Get("username") // middleware
+	requester, ok := un.(string)
+	if !ok {
+		logging.Logger.Warn().Err(errors.New("unable to get requester username")).Msg("requester: " + requester)
+	}
+
+	grpcReq := &protoacc.ListAccountStatementsRequest{
+		AccountId: strings.TrimSpace(c.Param("id")),
+		Metadata: &protoacc.Metadata{
+			RequestId: c.GetHeader("X-Request-ID"),
+			Requester: requester,
+		},
+		Pagination: &protoacc.PaginationRequest{
+			Page:     int32(pageNo),
+			PageSize: int32(pageSize),
+		},
+	}
+
+	resp, err := h.AccountClient.ListAccountStatements(c.Request.Context(), grpcReq)
+	if err != nil || resp == nil {
+		logging.Logger.Error().Err(err).Msg("failed to list account statements")
+		c.JSON(http.StatusUnauthorized, ErrorResponse{Error: "Invalid request"})
+		return
+	}
+
+	if !resp.Response.Success {
+		logging.Logger.Error().Err(errors.New(resp.Response.Message)).Msg("unable to list account statements")
+		c.JSON(statementErrorStatus(resp.Response.Message), ErrorResponse{Error: resp.Response.Message})
+		return
+	}
+
+	res := ListAccountStatementsResponse{
+		Statements: resp.Statements,
+		Page:       int(resp.Pagination.Page),
+		PageSize:   int(resp.Pagination.PageSize),
+		TotalCount: int(resp.Pagination.TotalCount),
+		TotalPages: int(resp.Pagination.TotalPages),
+		Message:    resp.Response.Message,
+	}
+
+	c.JSON(http.StatusOK, res)
+}
+
+// GetStatement fetches an issued monthly statement
+// @Tags Statement
+// @Summary Get Statement
+// @Description
+// @Description **Path Parameter:**
+// @Description
+// @Description id:
+// @Description - Required
+// @Description - Statement ID
+// @Description
+// @Description **Query Parameters:**
+// @Description
+// @Description format:
+// @Description - Optional
+// @Description - Options: **json**, **csv**, **pdf**
+// @Description - Default: json
+// @Description
+// @Description **Header:**
+// @Description
+// @Description Authorization:
+// @Description - Required
+// @Description - Format: Bearer token
+// @Accept json
+// @Produce json,text/csv,application/pdf
+// @Param Authorization header string true "Bearer token" default(Bearer )
+// @Param id path string true "Statement ID"
+// @Param format query string false "json, csv or pdf" default(json)
+// @Success 200 {object} StatementResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Router /api/v1/statement/{id} [get]
+func (h *AccountHandler) GetStatement(c *gin.Context) {
+	format, ok := statementFormat(c)
+	if !ok {
+		return
+	}
+
+	un, _ := c.Get("username") // middleware
+	requester, ok := un.(string)
+	if !ok {
+		logging.Logger.Warn().Err(errors.New("unable to get requester username")).Msg("requester: " + requester)
+	}
+
+	grpcReq := &protoacc.GetStatementRequest{
+		StatementId: strings.TrimSpace(c.Param("id")),
+		Metadata: &protoacc.Metadata{
+			RequestId: c.GetHeader("X-Request-ID"),
+			Requester: requester,
+		},
+	}
+
+	resp, err := h.AccountClient.GetStatement(c.Request.Context(), grpcReq)
+	if err != nil || resp == nil {
+		logging.Logger.Error().Err(err).Msg("failed to get statement")
+		c.JSON(http.StatusUnauthorized, ErrorResponse{Error: "Invalid request"})
+		return
+	}
+
+	if !resp.Response.Success {
+		logging.Logger.Error().Err(errors.New(resp.Response.Message)).Msg("unable to get statement")
+		c.JSON(statementErrorStatus(resp.Response.Message), ErrorResponse{Error: resp.Response.Message})
+		return
+	}
+
+	writeStatement(c, format, resp.Statement, resp.Response.Message)
+}
+
+// statementFormat reads the format query parameter, responding with 400 if it is not supported
+func statementFormat(c *gin.Context) (string, bool) {
+	format := strings.ToLower(strings.TrimSpace(c.DefaultQuery("format", statementFormatJSON)))
+	switch format {
+	case statementFormatJSON, statementFormatCSV, statementFormatPDF:
+		return format, true
+	}
+	c.JSON(http.StatusBadRequest, ErrorResponse{Error: "Invalid format - must be json, csv or pdf"})
+	return "", false
+}
+
+// writeStatement renders the statement in the requested format
+func writeStatement(c *gin.Context, format string, statement *protoacc.Statement, message string) {
+	filename := fmt.Sprintf("statement-%s-%s-%s.%s", statement.GetAccountId(), statement.GetPeriodStart(), statement.GetPeriodEnd(), format)
+
+	switch format {
+	case statementFormatCSV:
+		c.Header("Content-Disposition", fmt.Sprintf(`attachment; filename="%s"`, filename))
+		c.Status(http.StatusOK)
+		c.Writer.Header().Set("Content-Type", "text/csv")
+
+		writer := csv.NewWriter(c.Writer)
+		_ = writer.Write([]string{"posted_at", "journal_type", "reference", "direction", "amount", "balance"})
+		_ = writer.Write([]string{statement.GetPeriodStart(), "opening_balance", "", "", "", statement.GetOpeningBalance()})
+		for _, line := range statement.Lines {
+			_ = writer.Write([]string{
+				line.GetPostedAt().AsTime().UTC().Format(time.RFC3339),
+				line.JournalType,
+				line.Reference,
+				line.Direction,
+				line.Amount,
+				line.Balance,
+			})
+		}
+		_ = writer.Write([]string{statement.GetPeriodEnd(), "closing_balance", "", "", "", statement.GetClosingBalance()})
+		writer.Flush()
+		if err := writer.Error(); err != nil {
+			logging.Logger.Error().Err(err).Str("account_id", statement.GetAccountId()).Msg("failed to write statement csv")
+		}
+	case statementFormatPDF:
+		c.Header("Content-Disposition", fmt.Sprintf(`attachment; filename="%s"`, filename))
+		c.Data(http.StatusOK, "application/pdf", statementPDF(statement))
+	default:
+		c.JSON(http.StatusOK, StatementResponse{
+			Statement: statement,
+			Message:   message,
+		})
+	}
+}
+
+// statementPDF lays the statement out as a summary followed by a table of its lines
+func statementPDF(statement *protoacc.Statement) []byte {
+	doc := pdf.NewDocument()
+	doc.AddLine("ACCOUNT STATEMENT")
+	doc.AddLine("")
+	doc.AddLine(fmt.Sprintf("Account:          %s", statement.GetAccountId()))
+	doc.AddLine(fmt.Sprintf("Customer:         %s", statement.GetCustomerId()))
+	doc.AddLine(fmt.Sprintf("Period:           %s to %s", statement.GetPeriodStart(), statement.GetPeriodEnd()))
+	doc.AddLine(fmt.Sprintf("Currency:         %s", statement.GetCurrency()))
+	doc.AddLine(fmt.Sprintf("Opening balance:  %s", statement.GetOpeningBalance()))
+	doc.AddLine(fmt.Sprintf("Total credits:    %s", statement.GetTotalCredits()))
+	doc.AddLine(fmt.Sprintf("Total debits:     %s", statement.GetTotalDebits()))
+	doc.AddLine(fmt.Sprintf("Closing balance:  %s", statement.GetClosingBalance()))
+	doc.AddLine("")
+
+	row := "%-10s %-15s %-36s %14s %14s"
+	doc.AddLine(fmt.Sprintf(row, "Date", "Type", "Reference", "Amount", "Balance"))
+	doc.AddLine(strings.Repeat("-", 93))
+	doc.AddLine(fmt.Sprintf(row, statement.GetPeriodStart(), "opening_balance", "", "", statement.GetOpeningBalance()))
+	for _, line := range statement.Lines {
+		amount := line.Amount
+		if line.Direction == "debit" {
+			amount = "-" + amount
+		}
+		doc.AddLine(fmt.Sprintf(row, line.GetPostedAt().AsTime().UTC().Format(time.DateOnly), line.JournalType, line.Reference, amount, line.Balance))
+	}
+	doc.AddLine(fmt.Sprintf(row, statement.GetPeriodEnd(), "closing_balance", "", "", statement.GetClosingBalance()))
+	return doc.Bytes()
+}
+
+func statementErrorStatus(message string) int {
+	if message == "Account not found" || message == "Statement not found" {
+		return http.StatusNotFound
+	}
+	return http.StatusBadRequest
+}
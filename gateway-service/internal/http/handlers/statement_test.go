@@ -0,0 +1,195 @@
+package handlers
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	protoacc "gateway-service/api/protogen/accountservice/proto"
+	mock_client "gateway-service/internal/ports/mocks/grpc_client"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func setupStatementRoutes(handler *AccountHandler) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+
+	router.Use(func(c *gin.Context) {
+		c.Set("username", "test-viewer")
+		c.Next()
+	})
+
+	router.GET("/api/v1/account/:id/statement", handler.GenerateAccountStatement)
+	router.GET("/api/v1/account/:id/statements", handler.ListAccountStatements)
+	router.GET("/api/v1/statement/:id", handler.GetStatement)
+
+	return router
+}
+
+func testStatement() *protoacc.Statement {
+	return &protoacc.Statement{
+		AccountId:      "acc-123",
+		CustomerId:     "cust-1",
+		PeriodStart:    "2026-01-01",
+		PeriodEnd:      "2026-01-31",
+		Currency:       "USD",
+		OpeningBalance: "100.00",
+		ClosingBalance: "70.00",
+		TotalCredits:   "0.00",
+		TotalDebits:    "30.00",
+		LineCount:      1,
+		Lines: []*protoacc.StatementLine{
+			{
+				Position:    1,
+				PostedAt:    timestamppb.New(time.Date(2026, time.January, 5, 10, 0, 0, 0, time.UTC)),
+				JournalType: "transaction",
+				Reference:   "tx-1",
+				Direction:   "debit",
+				Amount:      "30.00",
+				Balance:     "70.00",
+			},
+		},
+	}
+}
+
+// TestGenerateAccountStatement_JSON tests generating a statement for a date range
+func TestGenerateAccountStatement_JSON(t *testing.T) {
+	mockClient := new(mock_client.MockAccountClient)
+	router := setupStatementRoutes(&AccountHandler{AccountClient: mockClient})
+
+	mockClient.On("GenerateAccountStatement", mock.Anything, mock.MatchedBy(func(req *protoacc.GenerateAccountStatementRequest) bool {
+		return req.AccountId == "acc-123" && req.From == "2026-01-01" && req.To == "2026-01-31" && req.Metadata.Requester == "test-viewer"
+	})).Return(&protoacc.GenerateAccountStatementResponse{
+		Statement: testStatement(),
+		Response:  &protoacc.Response{Success: true, Message: "Account statement"},
+	}, nil)
+
+	req, _ := http.NewRequest("GET", "/api/v1/account/acc-123/statement?from=2026-01-01&to=2026-01-31", nil)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	var response map[string]interface{}
+	err := json.Unmarshal(w.Body.Bytes(), &response)
+	assert.NoError(t, err)
+	assert.Equal(t, "Account statement", response["message"])
+	statement := response["statement"].(map[string]interface{})
+	assert.Equal(t, "100.00", statement["opening_balance"])
+	assert.Equal(t, "70.00", statement["closing_balance"])
+
+	mockClient.AssertExpectations(t)
+}
+
+// TestGenerateAccountStatement_CSV tests that the CSV lists the lines between the opening and closing balance
+func TestGenerateAccountStatement_CSV(t *testing.T) {
+	mockClient := new(mock_client.MockAccountClient)
+	router := setupStatementRoutes(&AccountHandler{AccountClient: mockClient})
+
+	mockClient.On("GenerateAccountStatement", mock.Anything, mock.Anything).Return(&protoacc.GenerateAccountStatementResponse{
+		Statement: testStatement(),
+		Response:  &protoacc.Response{Success: true, Message: "Account statement"},
+	}, nil)
+
+	req, _ := http.NewRequest("GET", "/api/v1/account/acc-123/statement?from=2026-01-01&to=2026-01-31&format=csv", nil)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, "text/csv", w.Header().Get("Content-Type"))
+	assert.Contains(t, w.Header().Get("Content-Disposition"), "statement-acc-123-2026-01-01-2026-01-31.csv")
+
+	records, err := csv.NewReader(w.Body).ReadAll()
+	assert.NoError(t, err)
+	assert.Equal(t, [][]string{
+		{"posted_at", "journal_type", "reference", "direction", "amount", "balance"},
+		{"2026-01-01", "opening_balance", "", "", "", "100.00"},
+		{"2026-01-05T10:00:00Z", "transaction", "tx-1", "debit", "30.00", "70.00"},
+		{"2026-01-31", "closing_balance", "", "", "", "70.00"},
+	}, records)
+}
+
+// TestGetStatement_PDF tests downloading an issued statement as a PDF
+func TestGetStatement_PDF(t *testing.T) {
+	mockClient := new(mock_client.MockAccountClient)
+	router := setupStatementRoutes(&AccountHandler{AccountClient: mockClient})
+
+	mockClient.On("GetStatement", mock.Anything, mock.MatchedBy(func(req *protoacc.GetStatementRequest) bool {
+		return req.StatementId == "stmt-1"
+	})).Return(&protoacc.GetStatementResponse{
+		Statement: testStatement(),
+		Response:  &protoacc.Response{Success: true, Message: "Statement details"},
+	}, nil)
+
+	req, _ := http.NewRequest("GET", "/api/v1/statement/stmt-1?format=pdf", nil)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, "application/pdf", w.Header().Get("Content-Type"))
+	assert.True(t, bytes.HasPrefix(w.Body.Bytes(), []byte("%PDF-")))
+	assert.Contains(t, w.Body.String(), "-30.00")
+}
+
+// TestGetStatement_InvalidFormat tests that an unsupported format is rejected before calling the account service
+func TestGetStatement_InvalidFormat(t *testing.T) {
+	mockClient := new(mock_client.MockAccountClient)
+	router := setupStatementRoutes(&AccountHandler{AccountClient: mockClient})
+
+	req, _ := http.NewRequest("GET", "/api/v1/statement/stmt-1?format=xlsx", nil)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	mockClient.AssertNotCalled(t, "GetStatement", mock.Anything, mock.Anything)
+}
+
+// TestGetStatement_NotFound tests that an unknown statement returns not found
+func TestGetStatement_NotFound(t *testing.T) {
+	mockClient := new(mock_client.MockAccountClient)
+	router := setupStatementRoutes(&AccountHandler{AccountClient: mockClient})
+
+	mockClient.On("GetStatement", mock.Anything, mock.Anything).Return(&protoacc.GetStatementResponse{
+		Response: &protoacc.Response{Success: false, Message: "Statement not found"},
+	}, nil)
+
+	req, _ := http.NewRequest("GET", "/api/v1/statement/stmt-404", nil)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusNotFound, w.Code)
+}
+
+// TestListAccountStatements_Success tests listing the issued statements of an account
+func TestListAccountStatements_Success(t *testing.T) {
+	mockClient := new(mock_client.MockAccountClient)
+	router := setupStatementRoutes(&AccountHandler{AccountClient: mockClient})
+
+	mockClient.On("ListAccountStatements", mock.Anything, mock.MatchedBy(func(req *protoacc.ListAccountStatementsRequest) bool {
+		return req.AccountId == "acc-123" && req.Pagination.Page == 2 && req.Pagination.PageSize == 10
+	})).Return(&protoacc.ListAccountStatementsResponse{
+		Statements: []*protoacc.Statement{{Id: "stmt-1", AccountId: "acc-123", PeriodStart: "2026-01-01"}},
+		Pagination: &protoacc.PaginationResponse{Page: 2, PageSize: 10, TotalCount: 11, TotalPages: 2},
+		Response:   &protoacc.Response{Success: true, Message: "Account statements"},
+	}, nil)
+
+	req, _ := http.NewRequest("GET", "/api/v1/account/acc-123/statements?page=2&pagesize=10", nil)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	var response map[string]interface{}
+	err := json.Unmarshal(w.Body.Bytes(), &response)
+	assert.NoError(t, err)
+	assert.Equal(t, float64(11), response["totalCount"])
+	assert.Len(t, response["statements"], 1)
+
+	mockClient.AssertExpectations(t)
+}
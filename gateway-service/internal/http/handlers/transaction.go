@@ -1,6 +1,7 @@
 package handlers
 
 import (
+	"encoding/json"
 	"errors"
 	prototx "gateway-service/api/protogen/txservice/proto"
 	"gateway-service/internal/logging"
@@ -18,11 +19,12 @@ type TransactionHandler struct {
 }
 
 type InitTransactionRequest struct {
-	SourceAccountID      string  `json:"source_account_id" binding:"required"`
-	DestinationAccountID string  `json:"destination_account_id"`
-	TransactionType      string  `json:"transaction_type" binding:"required"`
-	Amount               float64 `json:"amount"`
-	Reference            string  `json:"reference" binding:"required"`
+	SourceAccountID      string      `json:"source_account_id" binding:"required"`
+	DestinationAccountID string      `json:"destination_account_id"`
+	TransactionType      string      `json:"transaction_type" binding:"required"`
+	Amount               json.Number `json:"amount" swaggertype:"string"` // decimal, e.g. "100.50"
+	Reference            string      `json:"reference" binding:"required"`
+	Async                bool        `json:"async"` // return 202 as soon as the transaction is queued
 }
 
 type InitTransactionResponse struct {
@@ -31,12 +33,28 @@ type InitTransactionResponse struct {
 	Message           string `json:"message" binding:"message"`
 }
 
+type ReverseTransactionRequest struct {
+	Amount json.Number `json:"amount" swaggertype:"string"` // decimal, e.g. "40.00"; the rest not yet reversed if omitted
+	Reason string      `json:"reason" binding:"required"`
+}
+
+type ReverseTransactionResponse struct {
+	Transaction interface{} `json:"transaction"`
+	Message     string      `json:"message" binding:"message"`
+}
+
+type GetTransactionResponse struct {
+	Transaction interface{} `json:"transaction"`
+	Message     string      `json:"message" binding:"message"`
+}
+
 type ListTransactionResponse struct {
 	Transactions interface{} `json:"transactions"`
 	Page         int         `json:"page"`
 	PageSize     int         `json:"pageSize"`
 	TotalCount   int         `json:"totalCount"`
 	TotalPages   int         `json:"totalPages"`
+	NextCursor   string      `json:"next_cursor"` // empty on the last page
 	Message      string      `json:"message" binding:"message"`
 }
 
@@ -59,12 +77,30 @@ func NewTransactionHandler(txClient ports.TransactionClient) *TransactionHandler
 // @Description Amount:
 // @Description - Required for all types except **withdraw_full**
 // @Description - Must be greater than zero
+// @Description - Decimal number or string with at most 2 decimal places (e.g. "100.50")
 // @Description
 // @Description Destination Account ID:
 // @Description - Required only for **transfer** type
 // @Description
 // @Description Reference:
 // @Description - Required for all transactions
+// @Description - Unique per requester; retrying with the same reference and payload returns the original transaction
+// @Description - Reusing a reference with a different payload is rejected
+// @Description
+// @Description Async:
+// @Description - Optional
+// @Description - When true the transaction is queued and **202** is returned with the pending transaction ID
+// @Description - The status moves from pending to processing once a worker picks the transaction up
+// @Description - Poll **GET /api/v1/transaction/{id}** for the final status
+// @Description - Default: false
+// @Description
+// @Description Limits:
+// @Description - Debits are checked against the per transaction, daily and monthly limits of the source account and of the requester's role
+// @Description - A breach returns **422** with the limit that was exceeded
+// @Description
+// @Description Fees:
+// @Description - **transfer** and **withdraw_amount** debits are charged the fee of the matching fee rule on top of the amount
+// @Description - The source account must cover the amount and the fee; the fee is shown in **fee_amount** of the transaction
 // @Description
 // @Description **Header:**
 // @Description
@@ -76,8 +112,10 @@ func NewTransactionHandler(txClient ports.TransactionClient) *TransactionHandler
 // @Param Authorization header string true "Bearer token" default(Bearer )
 // @Param transaction body InitTransactionRequest true "Transaction details"
 // @Success 201 {object} InitTransactionResponse
+// @Success 202 {object} InitTransactionResponse
 // @Failure 400 {object} ErrorResponse
 // @Failure 401 {object} ErrorResponse
+// @Failure 422 {object} ErrorResponse
 // @Router /api/v1/transaction/init [post]
 func (h *TransactionHandler) InitTransaction(c *gin.Context) {
 	var req InitTransactionRequest
@@ -96,12 +134,14 @@ func (h *TransactionHandler) InitTransaction(c *gin.Context) {
 	grpcReq := &prototx.InitTransactionRequest{
 		SourceAccountId:      req.SourceAccountID,
 		DestinationAccountId: req.DestinationAccountID,
-		Amount:               req.Amount,
+		Amount:               req.Amount.String(),
 		Type:                 req.TransactionType,
 		Reference:            req.Reference,
+		Async:                req.Async,
 		Metadata: &prototx.Metadata{
-			RequestId: c.GetHeader("X-Request-ID"),
-			Requester: requester,
+			RequestId:     c.GetHeader("X-Request-ID"),
+			Requester:     requester,
+			RequesterRole: c.GetString("role"), // limits of the role apply
 		},
 	}
 
@@ -114,7 +154,11 @@ func (h *TransactionHandler) InitTransaction(c *gin.Context) {
 
 	if !resp.Response.Success {
 		logging.Logger.Error().Err(errors.New(resp.Response.Message)).Msg("unable to initialize transaction")
-		c.JSON(http.StatusBadRequest, ErrorResponse{Error: resp.Response.Message})
+		status := http.StatusBadRequest
+		if resp.Response.ErrorCode == errorCodeTransactionLimitExceeded {
+			status = http.StatusUnprocessableEntity
+		}
+		c.JSON(status, ErrorResponse{Error: resp.Response.Message})
 		return
 	}
 
@@ -124,6 +168,174 @@ func (h *TransactionHandler) InitTransaction(c *gin.Context) {
 		Message:           resp.Response.Message,
 	}
 
+	if req.Async {
+		c.JSON(http.StatusAccepted, res)
+		return
+	}
+	c.JSON(http.StatusCreated, res)
+}
+
+// GetTransaction fetches a single transaction, used to poll the status of asynchronous transactions
+// @Tags Transaction
+// @Summary Get Transaction
+// @Description
+// @Description **Path Parameter:**
+// @Description
+// @Description id:
+// @Description - Required
+// @Description - Transaction ID returned when the transaction was initiated
+// @Description
+// @Description Employees read the transactions they initiated, admins any transaction; others get **404**.
+// @Description
+// @Description **Header:**
+// @Description
+// @Description Authorization:
+// @Description - Required
+// @Description - Format: Bearer token
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer token" default(Bearer )
+// @Param id path string true "Transaction ID"
+// @Success 200 {object} GetTransactionResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Router /api/v1/transaction/{id} [get]
+func (h *TransactionHandler) GetTransaction(c *gin.Context) {
+	transactionId := strings.TrimSpace(c.Param("id"))
+
+	un, _ := c.Get("username") // middleware
+	requester, ok := un.(string)
+	if !ok {
+		logging.Logger.Warn().Err(errors.New("unable to get requester username")).Msg("requester: " + requester)
+	}
+
+	grpcReq := &prototx.GetTransactionRequest{
+		TransactionId: transactionId,
+		Metadata: &prototx.Metadata{
+			RequestId:     c.GetHeader("X-Request-ID"),
+			Requester:     requester,
+			RequesterRole: c.GetString("role"), // admins read every transaction
+		},
+	}
+
+	resp, err := h.TransactionClient.GetTransaction(c.Request.Context(), grpcReq)
+	if err != nil {
+		logging.Logger.Error().Err(err).Msg("failed to get transaction")
+		c.JSON(http.StatusUnauthorized, ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	if !resp.Response.Success {
+		logging.Logger.Error().Err(errors.New(resp.Response.Message)).Msg("unable to get transaction")
+		status := http.StatusBadRequest
+		if resp.Response.Message == "Transaction not found" {
+			status = http.StatusNotFound
+		}
+		c.JSON(status, ErrorResponse{Error: resp.Response.Message})
+		return
+	}
+
+	res := GetTransactionResponse{
+		Transaction: resp.Transaction,
+		Message:     resp.Response.Message,
+	}
+
+	c.JSON(http.StatusOK, res)
+}
+
+// ReverseTransaction posts a reversal linked to a completed transaction
+// @Tags Transaction
+// @Summary Reverse Transaction
+// @Description
+// @Description **Path Parameter:**
+// @Description
+// @Description id:
+// @Description - Required
+// @Description - ID of a **completed** transaction that has not been fully reversed
+// @Description
+// @Description **Request Body:**
+// @Description
+// @Description Amount:
+// @Description - Optional, the rest not yet reversed is reversed if omitted
+// @Description - Must not exceed the amount the original transaction moved less the amount already reversed
+// @Description - In the currency of the account the reversal debits, for a transfer that is the destination account
+// @Description - Decimal number or string with at most 2 decimal places (e.g. "40.00")
+// @Description
+// @Description Reason:
+// @Description - Required
+// @Description
+// @Description A transfer is sent back from its destination, a withdrawal is added back and an addition is withdrawn again.
+// @Description A cross-currency transfer is converted back at the rate of the original, so its source is credited the share of the amount it was debited; a full reversal returns exactly that amount.
+// @Description The reversal is rejected if the debited account no longer holds the amount.
+// @Description A transaction can be reversed partially several times until its reversals have moved back the full amount; after that it is already reversed.
+// @Description The original transaction shows its latest reversal in **reversed_by_transaction_id** and the amount moved back in **reversed_amount**; a failed reversal does not count.
+// @Description A fee charged on the original transaction is refunded from the income account, a partial reversal refunds the share of the fee its amount has in the original, rounded down; the reversal of the rest refunds the rest of the fee.
+// @Description The refunded fee is shown in **fee_amount** of the reversal.
+// @Description
+// @Description **Header:**
+// @Description
+// @Description Authorization:
+// @Description - Required
+// @Description - Format: Bearer token
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer token" default(Bearer )
+// @Param id path string true "Transaction ID"
+// @Param reversal body ReverseTransactionRequest true "Reversal details"
+// @Success 201 {object} ReverseTransactionResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Router /api/v1/transaction/{id}/reverse [post]
+func (h *TransactionHandler) ReverseTransaction(c *gin.Context) {
+	transactionId := strings.TrimSpace(c.Param("id"))
+
+	var req ReverseTransactionRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		logging.Logger.Warn().Err(err).Msg("invalid request param")
+		c.JSON(http.StatusBadRequest, ErrorResponse{Error: "Invalid request payload"})
+		return
+	}
+
+	un, _ := c.Get("username") // middleware
+	requester, ok := un.(string)
+	if !ok {
+		logging.Logger.Warn().Err(errors.New("unable to get requester username")).Msg("requester: " + requester)
+	}
+
+	grpcReq := &prototx.ReverseTransactionRequest{
+		TransactionId: transactionId,
+		Amount:        req.Amount.String(),
+		Reason:        req.Reason,
+		Metadata: &prototx.Metadata{
+			RequestId: c.GetHeader("X-Request-ID"),
+			Requester: requester,
+		},
+	}
+
+	resp, err := h.TransactionClient.ReverseTransaction(c.Request.Context(), grpcReq)
+	if err != nil || resp == nil {
+		logging.Logger.Error().Err(err).Msg("failed to reverse transaction")
+		c.JSON(http.StatusUnauthorized, ErrorResponse{Error: "Invalid request"})
+		return
+	}
+
+	if !resp.Response.Success {
+		logging.Logger.Error().Err(errors.New(resp.Response.Message)).Msg("unable to reverse transaction")
+		status := http.StatusBadRequest
+		if resp.Response.Message == "Transaction not found" {
+			status = http.StatusNotFound
+		}
+		c.JSON(status, ErrorResponse{Error: resp.Response.Message})
+		return
+	}
+
+	res := ReverseTransactionResponse{
+		Transaction: resp.Transaction,
+		Message:     resp.Response.Message,
+	}
+
 	c.JSON(http.StatusCreated, res)
 }
 
@@ -144,7 +356,7 @@ func (h *TransactionHandler) InitTransaction(c *gin.Context) {
 // @Description types:
 // @Description - Optional
 // @Description - Filter by transaction types
-// @Description - Comma separated values: **transfer**, **withdraw_full**, **withdraw_amount**, **add_amount**
+// @Description - Comma separated values: **transfer**, **withdraw_full**, **withdraw_amount**, **add_amount**, **interest_credit**
 // @Description
 // @Description start_date:
 // @Description - Optional
@@ -166,6 +378,16 @@ func (h *TransactionHandler) InitTransaction(c *gin.Context) {
 // @Description - Number of transactions per page
 // @Description - Default: 50
 // @Description
+// @Description cursor:
+// @Description - Optional
+// @Description - next_cursor of the previous page; the page continues after it and page is ignored
+// @Description - Unlike page, a cursor does not skip or repeat rows added while paging
+// @Description
+// @Description include_total:
+// @Description - Optional
+// @Description - Count totalCount and totalPages, which are 0 otherwise
+// @Description - Default: true without cursor, false with cursor
+// @Description
 // @Description order:
 // @Description - Optional
 // @Description - Sort order (asc/desc)
@@ -181,11 +403,13 @@ func (h *TransactionHandler) InitTransaction(c *gin.Context) {
 // @Param Authorization header string true "Bearer token" default(Bearer )
 // @Param account_id query string false "Transaction history by account id"
 // @Param customer_id query string false "Transaction history by customer id"
-// @Param types query string false "Comma separated transaction types (transfer/withdraw_full/withdraw_amount/add_amount)"
+// @Param types query string false "Comma separated transaction types (transfer/withdraw_full/withdraw_amount/add_amount/interest_credit)"
 // @Param start_date query string false "Start date for filtering (format: DD-MM-YYYY)"
 // @Param end_date query string false "End date for filtering (format: DD-MM-YYYY)"
 // @Param page query int false "Page number for pagination" default(1)
 // @Param pagesize query int false "Number of transactions per page" default(50)
+// @Param cursor query string false "next_cursor of the previous page"
+// @Param include_total query bool false "Count totalCount and totalPages (default: true without cursor, false with cursor)"
 // @Param order query string false "Sort order (asc/desc)" default(desc)
 // @Success 200 {object} ListTransactionResponse
 // @Failure 400 {object} ErrorResponse
@@ -200,6 +424,7 @@ func (h *TransactionHandler) ListTransactions(c *gin.Context) {
 	customerId := c.Query("customer_id")
 	startDateStr := c.Query("start_date")
 	endDateStr := c.Query("end_date")
+	cursor, includeTotal := cursorQuery(c)
 
 	var startDate, endDate *timestamppb.Timestamp
 	if startDateStr != "" {
@@ -253,8 +478,10 @@ func (h *TransactionHandler) ListTransactions(c *gin.Context) {
 		SortOrder:  order,
 		Types:      strings.TrimSpace(types),
 		Pagination: &prototx.PaginationRequest{
-			Page:     int32(pageNo),
-			PageSize: int32(pageSize),
+			Page:         int32(pageNo),
+			PageSize:     int32(pageSize),
+			Cursor:       cursor,
+			IncludeTotal: includeTotal,
 		},
 		Metadata: &prototx.Metadata{
 			RequestId: c.GetHeader("X-Request-ID"),
@@ -281,6 +508,7 @@ func (h *TransactionHandler) ListTransactions(c *gin.Context) {
 		PageSize:     int(resp.Pagination.PageSize),
 		TotalCount:   int(resp.Pagination.TotalCount),
 		TotalPages:   int(resp.Pagination.TotalPages),
+		NextCursor:   resp.Pagination.NextCursor,
 		Message:      resp.Response.Message,
 	}
 
@@ -0,0 +1,395 @@
+package handlers
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	prototx "gateway-service/api/protogen/txservice/proto"
+	"gateway-service/internal/logging"
+	"github.com/gin-gonic/gin"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+// columns of an uploaded batch file, reference_id is optional
+const (
+	batchColumnDestinationAccountID = "destination_account_id"
+	batchColumnAmount               = "amount"
+	batchColumnReferenceID          = "reference_id"
+)
+
+type CreateTransactionBatchRequest struct {
+	SourceAccountID string                    `json:"source_account_id" binding:"required"`
+	Mode            string                    `json:"mode"` // all_or_nothing or best_effort
+	ReferenceID     string                    `json:"reference_id" binding:"required"`
+	Lines           []TransactionBatchLineReq `json:"lines" binding:"required"`
+}
+
+type TransactionBatchLineReq struct {
+	DestinationAccountID string      `json:"destination_account_id"`
+	Amount               json.Number `json:"amount" swaggertype:"string"` // decimal, e.g. "1200.00"
+	ReferenceID          string      `json:"reference_id"`
+}
+
+type TransactionBatchResponse struct {
+	Batch   interface{} `json:"batch"`
+	Lines   interface{} `json:"lines"`
+	Message string      `json:"message" binding:"message"`
+}
+
+// CreateTransactionBatch submits a list of transfers from one account, e.g. a payroll file
+// @Tags Transaction Batch
+// @Summary Create Transaction Batch
+// @Description
+// @Description **Request Body:**
+// @Description
+// @Description Source Account ID:
+// @Description - Required
+// @Description - Account debited by every line
+// @Description
+// @Description Mode:
+// @Description - Optional
+// @Description - Options: **all_or_nothing**, **best_effort**
+// @Description - all_or_nothing: a failed line stops the batch, lines not yet run are skipped and successful lines are reversed
+// @Description - best_effort: every line succeeds or fails on its own
+// @Description - Default: best_effort
+// @Description
+// @Description Reference ID:
+// @Description - Required
+// @Description - Resubmitting a reference returns the existing batch
+// @Description
+// @Description Lines:
+// @Description - Required
+// @Description - Each line needs a destination account ID and an amount greater than zero with at most 2 decimal places
+// @Description - Reference ID of a line is optional and returned in the results
+// @Description
+// @Description Every line is validated before the batch is accepted, an invalid line rejects the whole batch.
+// @Description The batch is processed in the background, poll **/api/v1/transaction-batch/{id}** for the outcome.
+// @Description
+// @Description **Header:**
+// @Description
+// @Description Authorization:
+// @Description - Required
+// @Description - Format: Bearer token
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer token" default(Bearer )
+// @Param batch body CreateTransactionBatchRequest true "Transaction batch"
+// @Success 202 {object} TransactionBatchResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Router /api/v1/transaction-batch [post]
+func (h *TransactionHandler) CreateTransactionBatch(c *gin.Context) {
+	var req CreateTransactionBatchRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		logging.Logger.Warn().Err(err).Msg("invalid request param")
+		c.JSON(http.StatusBadRequest, ErrorResponse{Error: "Invalid request payload"})
+		return
+	}
+
+	lines := make([]*prototx.TransactionBatchInstruction, len(req.Lines))
+	for i, line := range req.Lines {
+		lines[i] = &prototx.TransactionBatchInstruction{
+			DestinationAccountId: line.DestinationAccountID,
+			Amount:               line.Amount.String(),
+			ReferenceId:          line.ReferenceID,
+		}
+	}
+
+	h.createTransactionBatch(c, req.SourceAccountID, req.Mode, req.ReferenceID, lines)
+}
+
+// UploadTransactionBatch submits a CSV file of transfers from one account, e.g. a payroll file
+// @Tags Transaction Batch
+// @Summary Upload Transaction Batch
+// @Description
+// @Description **Form Data:**
+// @Description
+// @Description File:
+// @Description - Required
+// @Description - CSV with a header row and the columns **destination_account_id**, **amount** and optionally **reference_id**, in any order
+// @Description - Amount is a decimal with at most 2 decimal places (e.g. "1200.00")
+// @Description
+// @Description Source Account ID, Mode and Reference ID:
+// @Description - As for **/api/v1/transaction-batch**
+// @Description
+// @Description Line numbers in errors and results count the rows after the header.
+// @Description
+// @Description **Header:**
+// @Description
+// @Description Authorization:
+// @Description - Required
+// @Description - Format: Bearer token
+// @Accept mpfd
+// @Produce json
+// @Param Authorization header string true "Bearer token" default(Bearer )
+// @Param file formData file true "CSV file of transfers"
+// @Param source_account_id formData string true "Source account ID"
+// @Param mode formData string false "all_or_nothing or best_effort"
+// @Param reference_id formData string true "Batch reference ID"
+// @Success 202 {object} TransactionBatchResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Router /api/v1/transaction-batch/upload [post]
+func (h *TransactionHandler) UploadTransactionBatch(c *gin.Context) {
+	sourceAccountId := strings.TrimSpace(c.PostForm("source_account_id"))
+	referenceId := strings.TrimSpace(c.PostForm("reference_id"))
+	if sourceAccountId == "" || referenceId == "" {
+		c.JSON(http.StatusBadRequest, ErrorResponse{Error: "Invalid request payload"})
+		return
+	}
+
+	fileHeader, err := c.FormFile("file")
+	if err != nil {
+		logging.Logger.Warn().Err(err).Msg("missing batch file")
+		c.JSON(http.StatusBadRequest, ErrorResponse{Error: "CSV file required"})
+		return
+	}
+
+	file, err := fileHeader.Open()
+	if err != nil {
+		logging.Logger.Error().Err(err).Msg("unable to open batch file")
+		c.JSON(http.StatusBadRequest, ErrorResponse{Error: "CSV file required"})
+		return
+	}
+	defer file.Close()
+
+	lines, err := parseTransactionBatchCSV(file)
+	if err != nil {
+		logging.Logger.Warn().Err(err).Msg("invalid batch file")
+		c.JSON(http.StatusBadRequest, ErrorResponse{Error: "Invalid CSV file: " + err.Error()})
+		return
+	}
+
+	h.createTransactionBatch(c, sourceAccountId, c.PostForm("mode"), referenceId, lines)
+}
+
+func (h *TransactionHandler) createTransactionBatch(c *gin.Context, sourceAccountId, mode, referenceId string, lines []*prototx.TransactionBatchInstruction) {
+	un, _ := c.Get("username") // middleware
+	requester, ok := un.(string)
+	if !ok {
+		logging.Logger.Warn().Err(errors.New("unable to get requester username")).Msg("requester: " + requester)
+	}
+
+	grpcReq := &prototx.CreateTransactionBatchRequest{
+		SourceAccountId: sourceAccountId,
+		Mode:            mode,
+		ReferenceId:     referenceId,
+		Lines:           lines,
+		Metadata: &prototx.Metadata{
+			RequestId:     c.GetHeader("X-Request-ID"),
+			Requester:     requester,
+			RequesterRole: c.GetString("role"), // limits of the role apply
+		},
+	}
+
+	resp, err := h.TransactionClient.CreateTransactionBatch(c.Request.Context(), grpcReq)
+	if err != nil || resp == nil {
+		logging.Logger.Error().Err(err).Msg("failed to create transaction batch")
+		c.JSON(http.StatusUnauthorized, ErrorResponse{Error: "Invalid request"})
+		return
+	}
+
+	if !resp.Response.Success {
+		logging.Logger.Error().Err(errors.New(resp.Response.Message)).Msg("unable to create transaction batch")
+		c.JSON(http.StatusBadRequest, ErrorResponse{Error: resp.Response.Message})
+		return
+	}
+
+	res := TransactionBatchResponse{
+		Batch:   resp.Batch,
+		Lines:   resp.Lines,
+		Message: resp.Response.Message,
+	}
+
+	c.JSON(http.StatusAccepted, res)
+}
+
+// GetTransactionBatch fetches a batch with the outcome of each line
+// @Tags Transaction Batch
+// @Summary Get Transaction Batch
+// @Description
+// @Description **Path Parameter:**
+// @Description
+// @Description id:
+// @Description - Required
+// @Description - Transaction batch ID
+// @Description
+// @Description Batch status is **pending** or **processing** until every line has run, then **completed**, **partially_completed** or **failed**.
+// @Description Line status is **pending**, **successful**, **failed**, **skipped** or **reversed**.
+// @Description
+// @Description **Header:**
+// @Description
+// @Description Authorization:
+// @Description - Required
+// @Description - Format: Bearer token
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer token" default(Bearer )
+// @Param id path string true "Transaction batch ID"
+// @Success 200 {object} TransactionBatchResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Router /api/v1/transaction-batch/{id} [get]
+func (h *TransactionHandler) GetTransactionBatch(c *gin.Context) {
+	resp, ok := h.getTransactionBatch(c)
+	if !ok {
+		return
+	}
+
+	res := TransactionBatchResponse{
+		Batch:   resp.Batch,
+		Lines:   resp.Lines,
+		Message: resp.Response.Message,
+	}
+
+	c.JSON(http.StatusOK, res)
+}
+
+// DownloadTransactionBatchResults downloads the outcome of each line of a batch as CSV
+// @Tags Transaction Batch
+// @Summary Download Transaction Batch Results
+// @Description
+// @Description **Path Parameter:**
+// @Description
+// @Description id:
+// @Description - Required
+// @Description - Transaction batch ID
+// @Description
+// @Description The CSV has a header row and one row per line in file order with the columns
+// @Description **line_number**, **destination_account_id**, **amount**, **reference_id**, **status**, **transaction_id**, **reversal_transaction_id** and **error_reason**.
+// @Description
+// @Description **Header:**
+// @Description
+// @Description Authorization:
+// @Description - Required
+// @Description - Format: Bearer token
+// @Accept json
+// @Produce text/csv
+// @Param Authorization header string true "Bearer token" default(Bearer )
+// @Param id path string true "Transaction batch ID"
+// @Success 200 {string} string "CSV file"
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Router /api/v1/transaction-batch/{id}/results [get]
+func (h *TransactionHandler) DownloadTransactionBatchResults(c *gin.Context) {
+	resp, ok := h.getTransactionBatch(c)
+	if !ok {
+		return
+	}
+
+	c.Header("Content-Disposition", fmt.Sprintf(`attachment; filename="transaction-batch-%s-results.csv"`, resp.Batch.GetId()))
+	c.Status(http.StatusOK)
+	c.Writer.Header().Set("Content-Type", "text/csv")
+
+	writer := csv.NewWriter(c.Writer)
+	_ = writer.Write([]string{"line_number", "destination_account_id", "amount", "reference_id", "status", "transaction_id", "reversal_transaction_id", "error_reason"})
+	for _, line := range resp.Lines {
+		_ = writer.Write([]string{
+			strconv.Itoa(int(line.LineNumber)),
+			line.DestinationAccountId,
+			line.Amount,
+			line.ReferenceId,
+			line.Status,
+			line.TransactionId,
+			line.ReversalTransactionId,
+			line.ErrorReason,
+		})
+	}
+	writer.Flush()
+	if err := writer.Error(); err != nil {
+		logging.Logger.Error().Err(err).Str("batch_id", resp.Batch.GetId()).Msg("failed to write transaction batch results")
+	}
+}
+
+func (h *TransactionHandler) getTransactionBatch(c *gin.Context) (*prototx.GetTransactionBatchResponse, bool) {
+	batchId := strings.TrimSpace(c.Param("id"))
+
+	un, _ := c.Get("username") // middleware
+	requester, ok := un.(string)
+	if !ok {
+		logging.Logger.Warn().Err(errors.New("unable to get requester username")).Msg("requester: " + requester)
+	}
+
+	grpcReq := &prototx.GetTransactionBatchRequest{
+		BatchId: batchId,
+		Metadata: &prototx.Metadata{
+			RequestId: c.GetHeader("X-Request-ID"),
+			Requester: requester,
+		},
+	}
+
+	resp, err := h.TransactionClient.GetTransactionBatch(c.Request.Context(), grpcReq)
+	if err != nil || resp == nil {
+		logging.Logger.Error().Err(err).Msg("failed to get transaction batch")
+		c.JSON(http.StatusUnauthorized, ErrorResponse{Error: "Invalid request"})
+		return nil, false
+	}
+
+	if !resp.Response.Success {
+		logging.Logger.Error().Err(errors.New(resp.Response.Message)).Msg("unable to get transaction batch")
+		status := http.StatusBadRequest
+		if resp.Response.Message == "Transaction batch not found" {
+			status = http.StatusNotFound
+		}
+		c.JSON(status, ErrorResponse{Error: resp.Response.Message})
+		return nil, false
+	}
+
+	return resp, true
+}
+
+// parseTransactionBatchCSV reads the lines of an uploaded batch file, locating the columns by the header row
+func parseTransactionBatchCSV(r io.Reader) ([]*prototx.TransactionBatchInstruction, error) {
+	reader := csv.NewReader(r)
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if errors.Is(err, io.EOF) {
+		return nil, errors.New("file is empty")
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	columns := map[string]int{}
+	for i, name := range header {
+		columns[strings.ToLower(strings.TrimSpace(strings.TrimPrefix(name, "\ufeff")))] = i
+	}
+	destinationColumn, hasDestination := columns[batchColumnDestinationAccountID]
+	amountColumn, hasAmount := columns[batchColumnAmount]
+	referenceColumn, hasReference := columns[batchColumnReferenceID]
+	if !hasDestination || !hasAmount {
+		return nil, fmt.Errorf("header must include %s and %s", batchColumnDestinationAccountID, batchColumnAmount)
+	}
+
+	var lines []*prototx.TransactionBatchInstruction
+	for {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		line := &prototx.TransactionBatchInstruction{
+			DestinationAccountId: strings.TrimSpace(record[destinationColumn]),
+			Amount:               strings.TrimSpace(record[amountColumn]),
+		}
+		if hasReference {
+			line.ReferenceId = strings.TrimSpace(record[referenceColumn])
+		}
+		lines = append(lines, line)
+	}
+
+	if len(lines) == 0 {
+		return nil, errors.New("file has no lines")
+	}
+	return lines, nil
+}
@@ -0,0 +1,233 @@
+package handlers
+
+import (
+	"bytes"
+	"encoding/json"
+	prototx "gateway-service/api/protogen/txservice/proto"
+	mock_client "gateway-service/internal/ports/mocks/grpc_client"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func setupTransactionBatchRoutes(handler *TransactionHandler) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+
+	router.Use(func(c *gin.Context) {
+		c.Set("username", "test-editor")
+		c.Next()
+	})
+
+	router.POST("/api/v1/transaction-batch", handler.CreateTransactionBatch)
+	router.POST("/api/v1/transaction-batch/upload", handler.UploadTransactionBatch)
+	router.GET("/api/v1/transaction-batch/:id", handler.GetTransactionBatch)
+	router.GET("/api/v1/transaction-batch/:id/results", handler.DownloadTransactionBatchResults)
+
+	return router
+}
+
+func acceptedTransactionBatchResponse() *prototx.CreateTransactionBatchResponse {
+	return &prototx.CreateTransactionBatchResponse{
+		Batch: &prototx.TransactionBatch{
+			Id:        "batch-1",
+			Mode:      "all_or_nothing",
+			Status:    "pending",
+			LineCount: 2,
+		},
+		Response: &prototx.Response{
+			Success: true,
+			Message: "Transaction batch accepted for processing",
+		},
+	}
+}
+
+// TestCreateTransactionBatch_Success tests submitting a batch as JSON
+func TestCreateTransactionBatch_Success(t *testing.T) {
+	mockClient := new(mock_client.MockTransactionClient)
+	handler := &TransactionHandler{TransactionClient: mockClient}
+	router := setupTransactionBatchRoutes(handler)
+
+	body := []byte(`{"source_account_id": "acc-123", "mode": "all_or_nothing", "reference_id": "payroll-2026-10",
+		"lines": [{"destination_account_id": "acc-456", "amount": "100.00", "reference_id": "emp-1"},
+		{"destination_account_id": "acc-789", "amount": 150}]}`)
+
+	mockClient.On("CreateTransactionBatch", mock.Anything, mock.MatchedBy(func(req *prototx.CreateTransactionBatchRequest) bool {
+		return req.SourceAccountId == "acc-123" &&
+			req.Mode == "all_or_nothing" &&
+			req.ReferenceId == "payroll-2026-10" &&
+			len(req.Lines) == 2 &&
+			req.Lines[0].ReferenceId == "emp-1" &&
+			req.Lines[1].DestinationAccountId == "acc-789" &&
+			req.Lines[1].Amount == "150" &&
+			req.Metadata.Requester == "test-editor" &&
+			req.Metadata.RequestId == "test-request-id"
+	})).Return(acceptedTransactionBatchResponse(), nil)
+
+	req, _ := http.NewRequest("POST", "/api/v1/transaction-batch", bytes.NewBuffer(body))
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("X-Request-ID", "test-request-id")
+
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusAccepted, w.Code)
+
+	var response map[string]interface{}
+	err := json.Unmarshal(w.Body.Bytes(), &response)
+	assert.NoError(t, err)
+	assert.Equal(t, "Transaction batch accepted for processing", response["message"])
+	batch := response["batch"].(map[string]interface{})
+	assert.Equal(t, "batch-1", batch["id"])
+
+	mockClient.AssertExpectations(t)
+}
+
+// TestCreateTransactionBatch_InvalidLines tests that line errors from the transaction service are returned
+func TestCreateTransactionBatch_InvalidLines(t *testing.T) {
+	mockClient := new(mock_client.MockTransactionClient)
+	handler := &TransactionHandler{TransactionClient: mockClient}
+	router := setupTransactionBatchRoutes(handler)
+
+	body := []byte(`{"source_account_id": "acc-123", "reference_id": "payroll-2026-10",
+		"lines": [{"destination_account_id": "", "amount": "100.00"}]}`)
+
+	mockClient.On("CreateTransactionBatch", mock.Anything, mock.Anything).Return(&prototx.CreateTransactionBatchResponse{
+		Response: &prototx.Response{
+			Success: false,
+			Message: "Invalid transaction batch: line 1: destination account ID missing",
+		},
+	}, nil)
+
+	req, _ := http.NewRequest("POST", "/api/v1/transaction-batch", bytes.NewBuffer(body))
+	req.Header.Set("Content-Type", "application/json")
+
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+
+	var response ErrorResponse
+	err := json.Unmarshal(w.Body.Bytes(), &response)
+	assert.NoError(t, err)
+	assert.Equal(t, "Invalid transaction batch: line 1: destination account ID missing", response.Error)
+}
+
+func newBatchUploadRequest(t *testing.T, csvContent string) *http.Request {
+	var body bytes.Buffer
+	writer := multipart.NewWriter(&body)
+	assert.NoError(t, writer.WriteField("source_account_id", "acc-123"))
+	assert.NoError(t, writer.WriteField("mode", "best_effort"))
+	assert.NoError(t, writer.WriteField("reference_id", "payroll-2026-10"))
+	part, err := writer.CreateFormFile("file", "payroll.csv")
+	assert.NoError(t, err)
+	_, _ = part.Write([]byte(csvContent))
+	assert.NoError(t, writer.Close())
+
+	req, _ := http.NewRequest("POST", "/api/v1/transaction-batch/upload", &body)
+	req.Header.Set("Content-Type", writer.FormDataContentType())
+	return req
+}
+
+// TestUploadTransactionBatch_Success tests submitting a batch as a CSV file with the columns in any order
+func TestUploadTransactionBatch_Success(t *testing.T) {
+	mockClient := new(mock_client.MockTransactionClient)
+	handler := &TransactionHandler{TransactionClient: mockClient}
+	router := setupTransactionBatchRoutes(handler)
+
+	mockClient.On("CreateTransactionBatch", mock.Anything, mock.MatchedBy(func(req *prototx.CreateTransactionBatchRequest) bool {
+		return req.SourceAccountId == "acc-123" &&
+			req.Mode == "best_effort" &&
+			req.ReferenceId == "payroll-2026-10" &&
+			len(req.Lines) == 2 &&
+			req.Lines[0].DestinationAccountId == "acc-456" &&
+			req.Lines[0].Amount == "100.00" &&
+			req.Lines[0].ReferenceId == "emp-1" &&
+			req.Lines[1].DestinationAccountId == "acc-789" &&
+			req.Lines[1].Amount == "150.50"
+	})).Return(acceptedTransactionBatchResponse(), nil)
+
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, newBatchUploadRequest(t, "amount,reference_id,destination_account_id\n100.00,emp-1,acc-456\n150.50,emp-2, acc-789\n"))
+
+	assert.Equal(t, http.StatusAccepted, w.Code)
+	mockClient.AssertExpectations(t)
+}
+
+// TestUploadTransactionBatch_MissingColumn tests that a file without an amount column is rejected
+func TestUploadTransactionBatch_MissingColumn(t *testing.T) {
+	mockClient := new(mock_client.MockTransactionClient)
+	handler := &TransactionHandler{TransactionClient: mockClient}
+	router := setupTransactionBatchRoutes(handler)
+
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, newBatchUploadRequest(t, "destination_account_id\nacc-456\n"))
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+
+	var response ErrorResponse
+	err := json.Unmarshal(w.Body.Bytes(), &response)
+	assert.NoError(t, err)
+	assert.Equal(t, "Invalid CSV file: header must include destination_account_id and amount", response.Error)
+	mockClient.AssertNotCalled(t, "CreateTransactionBatch", mock.Anything, mock.Anything)
+}
+
+// TestDownloadTransactionBatchResults_Success tests downloading the outcome of each line as CSV
+func TestDownloadTransactionBatchResults_Success(t *testing.T) {
+	mockClient := new(mock_client.MockTransactionClient)
+	handler := &TransactionHandler{TransactionClient: mockClient}
+	router := setupTransactionBatchRoutes(handler)
+
+	mockClient.On("GetTransactionBatch", mock.Anything, mock.MatchedBy(func(req *prototx.GetTransactionBatchRequest) bool {
+		return req.BatchId == "batch-1"
+	})).Return(&prototx.GetTransactionBatchResponse{
+		Batch: &prototx.TransactionBatch{Id: "batch-1", Status: "failed"},
+		Lines: []*prototx.TransactionBatchLine{
+			{LineNumber: 1, DestinationAccountId: "acc-456", Amount: "100.00", ReferenceId: "emp-1", Status: "reversed",
+				TransactionId: "txn-1", ReversalTransactionId: "txn-3", ErrorReason: "Reversed because another line failed"},
+			{LineNumber: 2, DestinationAccountId: "acc-789", Amount: "150.00", Status: "failed",
+				TransactionId: "txn-2", ErrorReason: "Transaction failed: account locking failed, try again"},
+		},
+		Response: &prototx.Response{
+			Success: true,
+			Message: "Transaction batch details",
+		},
+	}, nil)
+
+	req, _ := http.NewRequest("GET", "/api/v1/transaction-batch/batch-1/results", nil)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, "text/csv", w.Header().Get("Content-Type"))
+	assert.Equal(t, `attachment; filename="transaction-batch-batch-1-results.csv"`, w.Header().Get("Content-Disposition"))
+	assert.Equal(t, "line_number,destination_account_id,amount,reference_id,status,transaction_id,reversal_transaction_id,error_reason\n"+
+		"1,acc-456,100.00,emp-1,reversed,txn-1,txn-3,Reversed because another line failed\n"+
+		"2,acc-789,150.00,,failed,txn-2,,\"Transaction failed: account locking failed, try again\"\n", w.Body.String())
+	mockClient.AssertExpectations(t)
+}
+
+// TestGetTransactionBatch_NotFound tests if the batch does not exist
+func TestGetTransactionBatch_NotFound(t *testing.T) {
+	mockClient := new(mock_client.MockTransactionClient)
+	handler := &TransactionHandler{TransactionClient: mockClient}
+	router := setupTransactionBatchRoutes(handler)
+
+	mockClient.On("GetTransactionBatch", mock.Anything, mock.Anything).Return(&prototx.GetTransactionBatchResponse{
+		Response: &prototx.Response{
+			Success: false,
+			Message: "Transaction batch not found",
+		},
+	}, nil)
+
+	req, _ := http.NewRequest("GET", "/api/v1/transaction-batch/batch-404", nil)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusNotFound, w.Code)
+	mockClient.AssertExpectations(t)
+}
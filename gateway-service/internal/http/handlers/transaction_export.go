@@ -0,0 +1,246 @@
+package handlers
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	prototx "gateway-service/api/protogen/txservice/proto"
+	"gateway-service/internal/logging"
+	"github.com/gin-gonic/gin"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	exportStatusTrailer = "X-Export-Status"
+	exportCountTrailer  = "X-Export-Count"
+)
+
+// errExportRejected stops reading an export the transaction service refused
+var errExportRejected = errors.New("export rejected")
+
+var transactionExportColumns = []string{
+	"id", "type", "transaction_status", "source_account_id", "destination_account_id", "amount", "source_currency",
+	"destination_amount", "destination_currency", "exchange_rate", "fee_amount", "fee_rule_id", "reference",
+	"reversal_of_transaction_id", "reversed_by_transaction_id", "reversed_amount", "hold_id", "interest_posting_id",
+	"error_reason", "created_by", "created_at", "updated_at",
+}
+
+// ExportTransactions streams the full transaction history matching the filters as CSV or JSON lines
+// @Tags Transaction
+// @Summary Export Transaction History
+// @Description
+// @Description **Query Parameters:**
+// @Description
+// @Description format:
+// @Description - Optional
+// @Description - Options: **csv**, **ndjson**
+// @Description - Default: csv
+// @Description
+// @Description account_id, customer_id, types, start_date, end_date, order:
+// @Description - Optional
+// @Description - Same filters as Get Transaction History, without pagination
+// @Description
+// @Description The body is streamed while the transaction service reads the history, so its size is not limited.
+// @Description The CSV has a header row and the columns
+// @Description **id**, **type**, **transaction_status**, **source_account_id**, **destination_account_id**, **amount**, **source_currency**,
+// @Description **destination_amount**, **destination_currency**, **exchange_rate**, **fee_amount**, **fee_rule_id**, **reference**,
+// @Description **reversal_of_transaction_id**, **reversed_by_transaction_id**, **reversed_amount**, **hold_id**, **interest_posting_id**,
+// @Description **error_reason**, **created_by**, **created_at** and **updated_at**, with RFC 3339 UTC times. NDJSON has one object per line
+// @Description with the columns as keys in the same order and the same string values, empty when not set.
+// @Description
+// @Description A failure after the body started cannot change the status code, so the response ends with the trailers
+// @Description **X-Export-Status** (**completed** or **failed**) and **X-Export-Count** (the number of transactions written).
+// @Description
+// @Description **Header:**
+// @Description
+// @Description Authorization:
+// @Description - Required
+// @Description - Format: Bearer token
+// @Accept json
+// @Produce text/csv
+// @Produce application/x-ndjson
+// @Param Authorization header string true "Bearer token" default(Bearer )
+// @Param format query string false "Export format (csv/ndjson)" default(csv)
+// @Param account_id query string false "Transaction history by account id"
+// @Param customer_id query string false "Transaction history by customer id"
+// @Param types query string false "Comma separated transaction types (transfer/withdraw_full/withdraw_amount/add_amount/interest_credit)"
+// @Param start_date query string false "Start date for filtering (format: DD-MM-YYYY)"
+// @Param end_date query string false "End date for filtering (format: DD-MM-YYYY)"
+// @Param order query string false "Sort order (asc/desc)" default(desc)
+// @Success 200 {string} string "CSV or NDJSON file"
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Router /api/v1/transaction/export [get]
+func (h *TransactionHandler) ExportTransactions(c *gin.Context) {
+	format := strings.ToLower(strings.TrimSpace(c.DefaultQuery("format", "csv")))
+	if format != "csv" && format != "ndjson" {
+		c.JSON(http.StatusBadRequest, ErrorResponse{Error: "Invalid format - expected csv or ndjson"})
+		return
+	}
+
+	var startDate, endDate *timestamppb.Timestamp
+	if startDateStr := c.Query("start_date"); startDateStr != "" {
+		t, err := time.Parse("02-01-2006", startDateStr)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, ErrorResponse{Error: "Invalid start_date format, expected DD-MM-YYYY"})
+			return
+		}
+		startDate = timestamppb.New(t)
+	}
+	if endDateStr := c.Query("end_date"); endDateStr != "" {
+		t, err := time.Parse("02-01-2006", endDateStr)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, ErrorResponse{Error: "Invalid end_date format, expected DD-MM-YYYY"})
+			return
+		}
+		// Set to end of day for end_date
+		endDate = timestamppb.New(t.Add(23*time.Hour + 59*time.Minute + 59*time.Second))
+	}
+
+	un, _ := c.Get("username") // middleware
+	requester, ok := un.(string)
+	if !ok {
+		logging.Logger.Warn().Err(errors.New("unable to get requester username")).Msg("requester: " + requester)
+	}
+
+	grpcReq := &prototx.ExportTransactionHistoryRequest{
+		AccountId:  c.Query("account_id"),
+		CustomerId: c.Query("customer_id"),
+		StartDate:  startDate,
+		EndDate:    endDate,
+		SortOrder:  c.Query("order"),
+		Types:      strings.TrimSpace(c.Query("types")),
+		Metadata: &prototx.Metadata{
+			RequestId: c.GetHeader("X-Request-ID"),
+			Requester: requester,
+		},
+	}
+
+	var (
+		started   bool
+		count     int
+		csvWriter *csv.Writer
+	)
+	err := h.TransactionClient.ExportTransactionHistory(c.Request.Context(), grpcReq, func(chunk *prototx.ExportTransactionHistoryResponse) error {
+		if !started {
+			// the first chunk tells whether the export could start, before anything is written
+			if chunk.GetResponse() == nil {
+				return errors.New("export response missing")
+			}
+			if !chunk.Response.Success {
+				logging.Logger.Error().Err(errors.New(chunk.Response.Message)).Msg("unable to export transactions")
+				c.JSON(http.StatusBadRequest, ErrorResponse{Error: chunk.Response.Message})
+				return errExportRejected
+			}
+			started = true
+
+			c.Header("Content-Disposition", fmt.Sprintf(`attachment; filename="transactions-%s.%s"`, time.Now().UTC().Format("20060102T150405Z"), format))
+			c.Header("Trailer", exportStatusTrailer+", "+exportCountTrailer)
+			if format == "csv" {
+				c.Header("Content-Type", "text/csv")
+				csvWriter = csv.NewWriter(c.Writer)
+				_ = csvWriter.Write(transactionExportColumns)
+			} else {
+				c.Header("Content-Type", "application/x-ndjson")
+			}
+			c.Status(http.StatusOK)
+		}
+
+		for _, tx := range chunk.Transactions {
+			if csvWriter != nil {
+				if err := csvWriter.Write(transactionExportRow(tx)); err != nil {
+					return err
+				}
+			} else if _, err := c.Writer.Write(transactionExportLine(tx)); err != nil {
+				return err
+			}
+			count++
+		}
+		if csvWriter != nil {
+			csvWriter.Flush()
+			if err := csvWriter.Error(); err != nil {
+				return err
+			}
+		}
+		c.Writer.Flush()
+		return nil
+	})
+
+	if errors.Is(err, errExportRejected) {
+		return
+	}
+	if !started {
+		logging.Logger.Error().Err(err).Msg("failed to export transactions")
+		c.JSON(http.StatusUnauthorized, ErrorResponse{Error: "Invalid request"})
+		return
+	}
+
+	status := "completed"
+	if err != nil {
+		logging.Logger.Error().Err(err).Int("exported", count).Msg("transaction export interrupted")
+		status = "failed"
+	}
+	c.Writer.Header().Set(exportStatusTrailer, status)
+	c.Writer.Header().Set(exportCountTrailer, strconv.Itoa(count))
+}
+
+// transactionExportRow formats a transaction in the order of transactionExportColumns
+func transactionExportRow(tx *prototx.Transaction) []string {
+	return []string{
+		tx.Id,
+		tx.Type,
+		tx.TransactionStatus,
+		tx.SourceAccountId,
+		tx.DestinationAccountId,
+		tx.Amount,
+		tx.SourceCurrency,
+		tx.DestinationAmount,
+		tx.DestinationCurrency,
+		tx.ExchangeRate,
+		tx.FeeAmount,
+		tx.FeeRuleId,
+		tx.Reference,
+		tx.ReversalOfTransactionId,
+		tx.ReversedByTransactionId,
+		tx.ReversedAmount,
+		tx.HoldId,
+		tx.InterestPostingId,
+		tx.ErrorReason,
+		tx.CreatedBy,
+		exportTimestamp(tx.CreatedAt),
+		exportTimestamp(tx.UpdatedAt),
+	}
+}
+
+// transactionExportLine formats a transaction as a JSON line keyed by transactionExportColumns, so both formats
+// hold the same fields
+func transactionExportLine(tx *prototx.Transaction) []byte {
+	var line bytes.Buffer
+	line.WriteByte('{')
+	for i, value := range transactionExportRow(tx) {
+		if i > 0 {
+			line.WriteByte(',')
+		}
+		// strings always encode
+		key, _ := json.Marshal(transactionExportColumns[i])
+		encoded, _ := json.Marshal(value)
+		line.Write(key)
+		line.WriteByte(':')
+		line.Write(encoded)
+	}
+	line.WriteString("}\n")
+	return line.Bytes()
+}
+
+func exportTimestamp(ts *timestamppb.Timestamp) string {
+	if ts == nil {
+		return ""
+	}
+	return ts.AsTime().UTC().Format(time.RFC3339)
+}
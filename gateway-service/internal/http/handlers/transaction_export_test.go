@@ -0,0 +1,179 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	prototx "gateway-service/api/protogen/txservice/proto"
+	mock_client "gateway-service/internal/ports/mocks/grpc_client"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func setupTransactionExportRoutes(handler *TransactionHandler) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+
+	router.Use(func(c *gin.Context) {
+		c.Set("username", "test-auditor")
+		c.Next()
+	})
+
+	router.GET("/api/v1/transaction/export", handler.ExportTransactions)
+
+	return router
+}
+
+func exportChunks() []*prototx.ExportTransactionHistoryResponse {
+	createdAt := timestamppb.New(time.Date(2026, 3, 1, 10, 30, 0, 0, time.UTC))
+	return []*prototx.ExportTransactionHistoryResponse{
+		{
+			Transactions: []*prototx.Transaction{
+				{Id: "tx-1", Type: "transfer", TransactionStatus: "completed", SourceAccountId: "acc-1", DestinationAccountId: "acc-2", Amount: "10.00", SourceCurrency: "USD", Reference: "rent, march", CreatedAt: createdAt},
+			},
+			Response: &prototx.Response{Success: true, Message: "Transaction history export"},
+		},
+		{
+			Transactions: []*prototx.Transaction{
+				{Id: "tx-2", Type: "add_amount", TransactionStatus: "completed", DestinationAccountId: "acc-1", Amount: "5.50", SourceCurrency: "USD", CreatedAt: createdAt},
+			},
+		},
+	}
+}
+
+// TestExportTransactions_CSV tests that every chunk is written as CSV rows with the completion trailers
+func TestExportTransactions_CSV(t *testing.T) {
+	mockClient := new(mock_client.MockTransactionClient)
+	handler := &TransactionHandler{TransactionClient: mockClient}
+	router := setupTransactionExportRoutes(handler)
+
+	mockClient.On("ExportTransactionHistory", mock.Anything, mock.MatchedBy(func(req *prototx.ExportTransactionHistoryRequest) bool {
+		return req.AccountId == "acc-1" &&
+			req.SortOrder == "asc" &&
+			req.Types == "transfer,add_amount" &&
+			req.StartDate.AsTime().Equal(time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)) &&
+			req.Metadata.Requester == "test-auditor"
+	})).Return(exportChunks(), nil)
+
+	req, _ := http.NewRequest("GET", "/api/v1/transaction/export?account_id=acc-1&order=asc&types=transfer,add_amount&start_date=01-03-2026", nil)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, "text/csv", w.Header().Get("Content-Type"))
+	lines := strings.Split(strings.TrimSpace(w.Body.String()), "\n")
+	assert.Len(t, lines, 3)
+	assert.True(t, strings.HasPrefix(lines[0], "id,type,transaction_status,source_account_id"))
+	assert.Contains(t, lines[1], `tx-1,transfer,completed,acc-1,acc-2,10.00,USD`)
+	assert.Contains(t, lines[1], `"rent, march"`)
+	assert.Contains(t, lines[1], "2026-03-01T10:30:00Z")
+	assert.True(t, strings.HasPrefix(lines[2], "tx-2,add_amount,completed,,acc-1,5.50,USD"))
+
+	trailer := w.Result().Trailer
+	assert.Equal(t, "completed", trailer.Get("X-Export-Status"))
+	assert.Equal(t, "2", trailer.Get("X-Export-Count"))
+	mockClient.AssertExpectations(t)
+}
+
+// TestExportTransactions_NDJSON tests that each transaction is written as one JSON object per line with the CSV columns
+func TestExportTransactions_NDJSON(t *testing.T) {
+	mockClient := new(mock_client.MockTransactionClient)
+	handler := &TransactionHandler{TransactionClient: mockClient}
+	router := setupTransactionExportRoutes(handler)
+
+	mockClient.On("ExportTransactionHistory", mock.Anything, mock.Anything).Return(exportChunks(), nil)
+
+	req, _ := http.NewRequest("GET", "/api/v1/transaction/export?format=ndjson", nil)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, "application/x-ndjson", w.Header().Get("Content-Type"))
+	lines := strings.Split(strings.TrimSpace(w.Body.String()), "\n")
+	assert.Len(t, lines, 2)
+
+	assert.True(t, strings.HasPrefix(lines[0], `{"id":"tx-1","type":"transfer","transaction_status":"completed","source_account_id":"acc-1"`))
+
+	var tx map[string]interface{}
+	assert.NoError(t, json.Unmarshal([]byte(lines[1]), &tx))
+	assert.Len(t, tx, len(transactionExportColumns))
+	for _, column := range transactionExportColumns {
+		assert.Contains(t, tx, column)
+	}
+	assert.Equal(t, "tx-2", tx["id"])
+	assert.Equal(t, "5.50", tx["amount"])
+	assert.Equal(t, "", tx["source_account_id"])
+	assert.Equal(t, "2026-03-01T10:30:00Z", tx["created_at"])
+	assert.Equal(t, "", tx["updated_at"])
+	assert.Equal(t, "completed", w.Result().Trailer.Get("X-Export-Status"))
+}
+
+// TestExportTransactions_Rejected tests that an export the service refuses returns its message
+func TestExportTransactions_Rejected(t *testing.T) {
+	mockClient := new(mock_client.MockTransactionClient)
+	handler := &TransactionHandler{TransactionClient: mockClient}
+	router := setupTransactionExportRoutes(handler)
+
+	mockClient.On("ExportTransactionHistory", mock.Anything, mock.Anything).Return([]*prototx.ExportTransactionHistoryResponse{
+		{Response: &prototx.Response{Success: false, Message: "Invalid request - start date cannot be after end date"}},
+	}, nil)
+
+	req, _ := http.NewRequest("GET", "/api/v1/transaction/export?start_date=02-03-2026&end_date=01-03-2026", nil)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	assert.Contains(t, w.Body.String(), "start date cannot be after end date")
+}
+
+// TestExportTransactions_InterruptedStream tests that a failure after rows were written is reported in the trailers
+func TestExportTransactions_InterruptedStream(t *testing.T) {
+	mockClient := new(mock_client.MockTransactionClient)
+	handler := &TransactionHandler{TransactionClient: mockClient}
+	router := setupTransactionExportRoutes(handler)
+
+	mockClient.On("ExportTransactionHistory", mock.Anything, mock.Anything).Return(exportChunks()[:1], errors.New("stream reset"))
+
+	req, _ := http.NewRequest("GET", "/api/v1/transaction/export", nil)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, "failed", w.Result().Trailer.Get("X-Export-Status"))
+	assert.Equal(t, "1", w.Result().Trailer.Get("X-Export-Count"))
+}
+
+// TestExportTransactions_InvalidFormat tests that an unknown format is rejected before calling the service
+func TestExportTransactions_InvalidFormat(t *testing.T) {
+	mockClient := new(mock_client.MockTransactionClient)
+	handler := &TransactionHandler{TransactionClient: mockClient}
+	router := setupTransactionExportRoutes(handler)
+
+	req, _ := http.NewRequest("GET", "/api/v1/transaction/export?format=xlsx", nil)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	mockClient.AssertNotCalled(t, "ExportTransactionHistory", mock.Anything, mock.Anything)
+}
+
+// TestExportTransactions_ClientError tests that an unreachable transaction service returns 401
+func TestExportTransactions_ClientError(t *testing.T) {
+	mockClient := new(mock_client.MockTransactionClient)
+	handler := &TransactionHandler{TransactionClient: mockClient}
+	router := setupTransactionExportRoutes(handler)
+
+	mockClient.On("ExportTransactionHistory", mock.Anything, mock.Anything).Return(nil, errors.New("gRPC service unavailable"))
+
+	req, _ := http.NewRequest("GET", "/api/v1/transaction/export", nil)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusUnauthorized, w.Code)
+}
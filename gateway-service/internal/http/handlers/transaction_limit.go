@@ -0,0 +1,174 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	prototx "gateway-service/api/protogen/txservice/proto"
+	"gateway-service/internal/logging"
+	"github.com/gin-gonic/gin"
+	"net/http"
+)
+
+// errorCodeTransactionLimitExceeded is set by the transaction service when a debit breaches a limit
+const errorCodeTransactionLimitExceeded = "TRANSACTION_LIMIT_EXCEEDED"
+
+type SetTransactionLimitRequest struct {
+	Scope                string      `json:"scope" binding:"required"`
+	Subject              string      `json:"subject" binding:"required"`
+	PerTransactionAmount json.Number `json:"per_transaction_amount" swaggertype:"string"` // decimal, e.g. "5000.00"
+	DailyAmount          json.Number `json:"daily_amount" swaggertype:"string"`
+	DailyCount           int32       `json:"daily_count"`
+	MonthlyAmount        json.Number `json:"monthly_amount" swaggertype:"string"`
+	MonthlyCount         int32       `json:"monthly_count"`
+}
+
+type SetTransactionLimitResponse struct {
+	Limit   interface{} `json:"limit"`
+	Message string      `json:"message" binding:"message"`
+}
+
+type ListTransactionLimitsResponse struct {
+	Limits  interface{} `json:"limits"`
+	Message string      `json:"message" binding:"message"`
+}
+
+// SetTransactionLimit creates or overrides a transaction limit
+// @Tags Transaction Limit
+// @Summary Set Transaction Limit
+// @Description
+// @Description **Request Body:**
+// @Description
+// @Description Scope:
+// @Description - Required
+// @Description - Options: **account_type**, **account**, **role**
+// @Description - An **account** limit replaces the limit of the account type for that account
+// @Description - A **role** limit applies to the transactions each employee of the role initiates
+// @Description
+// @Description Subject:
+// @Description - Required
+// @Description - **savings** or **current** for account_type, an account ID for account, **admin**, **editor** or **viewer** for role
+// @Description
+// @Description Per Transaction Amount, Daily Amount, Monthly Amount:
+// @Description - Optional
+// @Description - Decimal number or string with at most 2 decimal places, in the source account currency
+// @Description - Omitted or zero means no limit
+// @Description - Daily and monthly usage counts the debits in the same currency, including their fees
+// @Description
+// @Description Daily Count, Monthly Count:
+// @Description - Optional
+// @Description - Maximum number of debits; zero means no limit
+// @Description
+// @Description Days and months start at midnight server time. Deposits and reversals are not limited.
+// @Description
+// @Description **Header:**
+// @Description
+// @Description Authorization:
+// @Description - Required
+// @Description - Format: Bearer token
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer token" default(Bearer )
+// @Param transaction_limit body SetTransactionLimitRequest true "Transaction limit details"
+// @Success 200 {object} SetTransactionLimitResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Router /api/v1/transaction-limit [put]
+func (h *TransactionHandler) SetTransactionLimit(c *gin.Context) {
+	var req SetTransactionLimitRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		logging.Logger.Warn().Err(err).Msg("invalid request param")
+		c.JSON(http.StatusBadRequest, ErrorResponse{Error: "Invalid request payload"})
+		return
+	}
+
+	un, _ := c.Get("username") // middleware
+	requester, ok := un.(string)
+	if !ok {
+		logging.Logger.Warn().Err(errors.New("unable to get requester username")).Msg("requester: " + requester)
+	}
+
+	grpcReq := &prototx.SetTransactionLimitRequest{
+		Scope:                req.Scope,
+		Subject:              req.Subject,
+		PerTransactionAmount: req.PerTransactionAmount.String(),
+		DailyAmount:          req.DailyAmount.String(),
+		DailyCount:           req.DailyCount,
+		MonthlyAmount:        req.MonthlyAmount.String(),
+		MonthlyCount:         req.MonthlyCount,
+		Metadata: &prototx.Metadata{
+			RequestId: c.GetHeader("X-Request-ID"),
+			Requester: requester,
+		},
+	}
+
+	resp, err := h.TransactionClient.SetTransactionLimit(c.Request.Context(), grpcReq)
+	if err != nil || resp == nil {
+		logging.Logger.Error().Err(err).Msg("failed to set transaction limit")
+		c.JSON(http.StatusUnauthorized, ErrorResponse{Error: "Invalid request"})
+		return
+	}
+
+	if !resp.Response.Success {
+		logging.Logger.Error().Err(errors.New(resp.Response.Message)).Msg("unable to set transaction limit")
+		c.JSON(http.StatusBadRequest, ErrorResponse{Error: resp.Response.Message})
+		return
+	}
+
+	res := SetTransactionLimitResponse{
+		Limit:   resp.Limit,
+		Message: resp.Response.Message,
+	}
+
+	c.JSON(http.StatusOK, res)
+}
+
+// ListTransactionLimits lists all configured transaction limits
+// @Tags Transaction Limit
+// @Summary List Transaction Limits
+// @Description
+// @Description **Header:**
+// @Description
+// @Description Authorization:
+// @Description - Required
+// @Description - Format: Bearer token
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer token" default(Bearer )
+// @Success 200 {object} ListTransactionLimitsResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Router /api/v1/transaction-limit [get]
+func (h *TransactionHandler) ListTransactionLimits(c *gin.Context) {
+	un, _ := c.Get("username") // middleware
+	requester, ok := un.(string)
+	if !ok {
+		logging.Logger.Warn().Err(errors.New("unable to get requester username")).Msg("requester: " + requester)
+	}
+
+	grpcReq := &prototx.ListTransactionLimitsRequest{
+		Metadata: &prototx.Metadata{
+			RequestId: c.GetHeader("X-Request-ID"),
+			Requester: requester,
+		},
+	}
+
+	resp, err := h.TransactionClient.ListTransactionLimits(c.Request.Context(), grpcReq)
+	if err != nil {
+		logging.Logger.Error().Err(err).Msg("failed to get transaction limits")
+		c.JSON(http.StatusUnauthorized, ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	if !resp.Response.Success {
+		logging.Logger.Error().Err(errors.New(resp.Response.Message)).Msg("unable to get transaction limits")
+		c.JSON(http.StatusBadRequest, ErrorResponse{Error: resp.Response.Message})
+		return
+	}
+
+	res := ListTransactionLimitsResponse{
+		Limits:  resp.Limits,
+		Message: resp.Response.Message,
+	}
+
+	c.JSON(http.StatusOK, res)
+}
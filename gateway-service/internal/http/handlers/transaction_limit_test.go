@@ -0,0 +1,133 @@
+package handlers
+
+import (
+	"bytes"
+	"encoding/json"
+	prototx "gateway-service/api/protogen/txservice/proto"
+	mock_client "gateway-service/internal/ports/mocks/grpc_client"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func setupTransactionLimitRoutes(handler *TransactionHandler) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+
+	router.Use(func(c *gin.Context) {
+		c.Set("username", "test-admin")
+		c.Next()
+	})
+
+	router.PUT("/api/v1/transaction-limit", handler.SetTransactionLimit)
+	router.GET("/api/v1/transaction-limit", handler.ListTransactionLimits)
+
+	return router
+}
+
+// TestSetTransactionLimit_Success tests overriding the limit of an account type
+func TestSetTransactionLimit_Success(t *testing.T) {
+	mockClient := new(mock_client.MockTransactionClient)
+	handler := &TransactionHandler{TransactionClient: mockClient}
+	router := setupTransactionLimitRoutes(handler)
+
+	body := []byte(`{"scope": "account_type", "subject": "savings", "per_transaction_amount": 2000, "daily_amount": "5000.00", "daily_count": 10}`)
+
+	mockClient.On("SetTransactionLimit", mock.Anything, mock.MatchedBy(func(req *prototx.SetTransactionLimitRequest) bool {
+		return req.Scope == "account_type" &&
+			req.Subject == "savings" &&
+			req.PerTransactionAmount == "2000" &&
+			req.DailyAmount == "5000.00" &&
+			req.DailyCount == 10 &&
+			req.MonthlyAmount == "" &&
+			req.Metadata.Requester == "test-admin"
+	})).Return(&prototx.SetTransactionLimitResponse{
+		Limit: &prototx.TransactionLimit{
+			Scope:                "account_type",
+			Subject:              "savings",
+			PerTransactionAmount: "2000.00",
+			DailyAmount:          "5000.00",
+			DailyCount:           10,
+			MonthlyAmount:        "0.00",
+		},
+		Response: &prototx.Response{
+			Success: true,
+			Message: "Transaction limit updated successfully",
+		},
+	}, nil)
+
+	req, _ := http.NewRequest("PUT", "/api/v1/transaction-limit", bytes.NewBuffer(body))
+	req.Header.Set("Content-Type", "application/json")
+
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	var response map[string]interface{}
+	err := json.Unmarshal(w.Body.Bytes(), &response)
+	assert.NoError(t, err)
+	assert.Equal(t, "Transaction limit updated successfully", response["message"])
+	limit := response["limit"].(map[string]interface{})
+	assert.Equal(t, "2000.00", limit["per_transaction_amount"])
+
+	mockClient.AssertExpectations(t)
+}
+
+// TestSetTransactionLimit_InvalidScope tests that a rejected limit is returned as a bad request
+func TestSetTransactionLimit_InvalidScope(t *testing.T) {
+	mockClient := new(mock_client.MockTransactionClient)
+	handler := &TransactionHandler{TransactionClient: mockClient}
+	router := setupTransactionLimitRoutes(handler)
+
+	body := []byte(`{"scope": "customer", "subject": "cust-1", "daily_amount": "5000.00"}`)
+
+	mockClient.On("SetTransactionLimit", mock.Anything, mock.Anything).Return(&prototx.SetTransactionLimitResponse{
+		Response: &prototx.Response{
+			Success: false,
+			Message: "Invalid transaction limit, scope must be account_type (savings or current), account or role and amounts and counts must not be negative",
+		},
+	}, nil)
+
+	req, _ := http.NewRequest("PUT", "/api/v1/transaction-limit", bytes.NewBuffer(body))
+	req.Header.Set("Content-Type", "application/json")
+
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	mockClient.AssertExpectations(t)
+}
+
+// TestListTransactionLimits_Success tests listing the configured limits
+func TestListTransactionLimits_Success(t *testing.T) {
+	mockClient := new(mock_client.MockTransactionClient)
+	handler := &TransactionHandler{TransactionClient: mockClient}
+	router := setupTransactionLimitRoutes(handler)
+
+	mockClient.On("ListTransactionLimits", mock.Anything, mock.Anything).Return(&prototx.ListTransactionLimitsResponse{
+		Limits: []*prototx.TransactionLimit{
+			{Scope: "account_type", Subject: "savings", DailyAmount: "5000.00"},
+			{Scope: "role", Subject: "editor", MonthlyCount: 200},
+		},
+		Response: &prototx.Response{
+			Success: true,
+			Message: "Transaction limits",
+		},
+	}, nil)
+
+	req, _ := http.NewRequest("GET", "/api/v1/transaction-limit", nil)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	var response map[string]interface{}
+	err := json.Unmarshal(w.Body.Bytes(), &response)
+	assert.NoError(t, err)
+	assert.Len(t, response["limits"], 2)
+	mockClient.AssertExpectations(t)
+}
@@ -20,11 +20,14 @@ func setupTransactionRoutes(handler *TransactionHandler) *gin.Engine {
 
 	router.Use(func(c *gin.Context) {
 		c.Set("username", "test-admin")
+		c.Set("role", "admin")
 		c.Next()
 	})
 
 	router.POST("/api/v1/transaction/init", handler.InitTransaction)
 	router.GET("/api/v1/transaction", handler.ListTransactions)
+	router.GET("/api/v1/transaction/:id", handler.GetTransaction)
+	router.POST("/api/v1/transaction/:id/reverse", handler.ReverseTransaction)
 
 	return router
 }
@@ -39,7 +42,7 @@ func TestInitTransaction_SuccessTransfer(t *testing.T) {
 		SourceAccountID:      "acc-12345",
 		DestinationAccountID: "acc-67890",
 		TransactionType:      "transfer",
-		Amount:               1000.0,
+		Amount:               json.Number("1000.00"),
 		Reference:            "test-transfer",
 	}
 
@@ -55,7 +58,7 @@ func TestInitTransaction_SuccessTransfer(t *testing.T) {
 	mockClient.On("InitTransaction", mock.Anything, mock.MatchedBy(func(req *prototx.InitTransactionRequest) bool {
 		return req.SourceAccountId == "acc-12345" &&
 			req.DestinationAccountId == "acc-67890" &&
-			req.Amount == 1000.0 &&
+			req.Amount == "1000.00" &&
 			req.Type == "transfer" &&
 			req.Reference == "test-transfer" &&
 			req.Metadata != nil &&
@@ -93,7 +96,7 @@ func TestInitTransaction_SuccessWithdrawFull(t *testing.T) {
 	request := InitTransactionRequest{
 		SourceAccountID: "acc-12345",
 		TransactionType: "withdraw_full",
-		Amount:          0,
+		Amount:          json.Number("0"),
 		Reference:       "close-account",
 	}
 
@@ -109,7 +112,7 @@ func TestInitTransaction_SuccessWithdrawFull(t *testing.T) {
 	mockClient.On("InitTransaction", mock.Anything, mock.MatchedBy(func(req *prototx.InitTransactionRequest) bool {
 		return req.SourceAccountId == "acc-12345" &&
 			req.DestinationAccountId == "" && // Should be empty for withdraw_full
-			req.Amount == 0.0 &&
+			req.Amount == "0" &&
 			req.Type == "withdraw_full" &&
 			req.Reference == "close-account" &&
 			req.Metadata != nil
@@ -194,7 +197,7 @@ func TestInitTransaction_GrpcConnectionError(t *testing.T) {
 		SourceAccountID:      "acc-12345",
 		DestinationAccountID: "acc-67890",
 		TransactionType:      "transfer",
-		Amount:               1000.0,
+		Amount:               json.Number("1000.00"),
 		Reference:            "test-transfer",
 	}
 
@@ -230,7 +233,7 @@ func TestInitTransaction_InvalidTransactionType(t *testing.T) {
 	request := InitTransactionRequest{
 		SourceAccountID: "acc-12345",
 		TransactionType: "invalid_type",
-		Amount:          1000.0,
+		Amount:          json.Number("1000.00"),
 		Reference:       "test-invalid",
 	}
 
@@ -264,6 +267,49 @@ func TestInitTransaction_InvalidTransactionType(t *testing.T) {
 	mockClient.AssertExpectations(t)
 }
 
+// TestInitTransaction_LimitExceeded tests that a limit breach is returned as unprocessable
+func TestInitTransaction_LimitExceeded(t *testing.T) {
+	mockClient := new(mock_client.MockTransactionClient)
+	accountHandler := &TransactionHandler{TransactionClient: mockClient}
+	router := setupTransactionRoutes(accountHandler)
+
+	request := InitTransactionRequest{
+		SourceAccountID: "acc-12345",
+		TransactionType: "withdraw_amount",
+		Amount:          json.Number("3000.00"),
+		Reference:       "test-limit",
+	}
+
+	expectedResponse := &prototx.InitTransactionResponse{
+		TransactionStatus: "failed",
+		Response: &prototx.Response{
+			Success:   false,
+			Message:   "Transaction limit exceeded: per transaction amount limit of 2000.00 for savings accounts",
+			ErrorCode: "TRANSACTION_LIMIT_EXCEEDED",
+		},
+	}
+
+	mockClient.On("InitTransaction", mock.Anything, mock.MatchedBy(func(req *prototx.InitTransactionRequest) bool {
+		return req.Metadata.Requester == "test-admin" && req.Metadata.RequesterRole == "admin"
+	})).Return(expectedResponse, nil)
+
+	body, _ := json.Marshal(request)
+	req, _ := http.NewRequest("POST", "/api/v1/transaction/init", bytes.NewBuffer(body))
+	req.Header.Set("Content-Type", "application/json")
+
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusUnprocessableEntity, w.Code)
+
+	var response ErrorResponse
+	err := json.Unmarshal(w.Body.Bytes(), &response)
+	assert.NoError(t, err)
+	assert.Equal(t, "Transaction limit exceeded: per transaction amount limit of 2000.00 for savings accounts", response.Error)
+
+	mockClient.AssertExpectations(t)
+}
+
 // TestListTransactions_SuccessDefaultPagination tests transaction history list for default pagination
 func TestListTransactions_SuccessDefaultPagination(t *testing.T) {
 	mockClient := new(mock_client.MockTransactionClient)
@@ -277,7 +323,7 @@ func TestListTransactions_SuccessDefaultPagination(t *testing.T) {
 				SourceAccountId:      "acc-123",
 				DestinationAccountId: "acc-147",
 				Type:                 "transfer",
-				Amount:               1000.0,
+				Amount:               "1000.00",
 				TransactionStatus:    "completed",
 				Reference:            "test-ref-1",
 			},
@@ -286,7 +332,7 @@ func TestListTransactions_SuccessDefaultPagination(t *testing.T) {
 				SourceAccountId:      "acc-123",
 				DestinationAccountId: "acc-234",
 				Type:                 "transfer",
-				Amount:               1000.0,
+				Amount:               "1000.00",
 				TransactionStatus:    "completed",
 				Reference:            "test-ref-1",
 			},
@@ -339,6 +385,75 @@ func TestListTransactions_SuccessDefaultPagination(t *testing.T) {
 	mockClient.AssertExpectations(t)
 }
 
+// TestListTransactions_SuccessWithCursor tests that a cursor page skips totals unless asked and returns the next cursor
+func TestListTransactions_SuccessWithCursor(t *testing.T) {
+	mockClient := new(mock_client.MockTransactionClient)
+	handler := &TransactionHandler{TransactionClient: mockClient}
+	router := setupTransactionRoutes(handler)
+
+	mockClient.On("GetTransactionHistory", mock.Anything, mock.MatchedBy(func(req *prototx.GetTransactionHistoryRequest) bool {
+		return req.Pagination.Cursor == "cursor-1" &&
+			!req.Pagination.IncludeTotal &&
+			req.Pagination.PageSize == 20
+	})).Return(&prototx.GetTransactionHistoryResponse{
+		Transactions: []*prototx.Transaction{{Id: "tx-21"}},
+		Pagination: &prototx.PaginationResponse{
+			PageSize:   20,
+			NextCursor: "cursor-2",
+		},
+		Response: &prototx.Response{
+			Success: true,
+			Message: "Transaction history response",
+		},
+	}, nil)
+
+	req, _ := http.NewRequest("GET", "/api/v1/transaction?cursor=cursor-1&pagesize=20", nil)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	var response ListTransactionResponse
+	assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+	assert.Equal(t, "cursor-2", response.NextCursor)
+	assert.Equal(t, 0, response.TotalCount)
+	mockClient.AssertExpectations(t)
+}
+
+// TestListTransactions_OffsetPageCountsTotal tests that offset pages keep counting totals unless turned off
+func TestListTransactions_OffsetPageCountsTotal(t *testing.T) {
+	testCases := []struct {
+		query        string
+		includeTotal bool
+	}{
+		{"page=2", true},
+		{"page=2&include_total=false", false},
+		{"cursor=cursor-1&include_total=true", true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.query, func(t *testing.T) {
+			mockClient := new(mock_client.MockTransactionClient)
+			handler := &TransactionHandler{TransactionClient: mockClient}
+			router := setupTransactionRoutes(handler)
+
+			mockClient.On("GetTransactionHistory", mock.Anything, mock.MatchedBy(func(req *prototx.GetTransactionHistoryRequest) bool {
+				return req.Pagination.IncludeTotal == tc.includeTotal
+			})).Return(&prototx.GetTransactionHistoryResponse{
+				Pagination: &prototx.PaginationResponse{},
+				Response:   &prototx.Response{Success: true},
+			}, nil)
+
+			req, _ := http.NewRequest("GET", "/api/v1/transaction?"+tc.query, nil)
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, http.StatusOK, w.Code)
+			mockClient.AssertExpectations(t)
+		})
+	}
+}
+
 // TestListTransactions_SuccessWithPagination tests success with valid pagination
 func TestListTransactions_SuccessWithPagination(t *testing.T) {
 	mockClient := new(mock_client.MockTransactionClient)
@@ -352,7 +467,7 @@ func TestListTransactions_SuccessWithPagination(t *testing.T) {
 				SourceAccountId:      "acc-123",
 				DestinationAccountId: "acc-234",
 				Type:                 "transfer",
-				Amount:               1000.0,
+				Amount:               "1000.00",
 				TransactionStatus:    "completed",
 				Reference:            "test-ref-1",
 			},
@@ -409,7 +524,7 @@ func TestListTransactions_SuccessWithCustomerFilter(t *testing.T) {
 				SourceAccountId:      "acc-123",
 				DestinationAccountId: "acc-456",
 				Type:                 "transfer",
-				Amount:               1000.0,
+				Amount:               "1000.00",
 				TransactionStatus:    "completed",
 				Reference:            "test-ref-1",
 			},
@@ -418,7 +533,7 @@ func TestListTransactions_SuccessWithCustomerFilter(t *testing.T) {
 				SourceAccountId:      "acc-123",
 				DestinationAccountId: "acc-234",
 				Type:                 "transfer",
-				Amount:               1000.0,
+				Amount:               "1000.00",
 				TransactionStatus:    "completed",
 				Reference:            "test-ref-1",
 			},
@@ -471,7 +586,7 @@ func TestListTransactions_SuccessWithAllFilters(t *testing.T) {
 				SourceAccountId:      "acc-123",
 				DestinationAccountId: "acc-214",
 				Type:                 "transfer",
-				Amount:               1000.0,
+				Amount:               "1000.00",
 				TransactionStatus:    "completed",
 				Reference:            "test-ref-1",
 			},
@@ -543,3 +658,183 @@ func TestListTransactions_GrpcConnectionError(t *testing.T) {
 
 	mockClient.AssertExpectations(t)
 }
+
+// TestInitTransaction_Async tests that an async transaction returns 202 with the pending transaction
+func TestInitTransaction_Async(t *testing.T) {
+	mockClient := new(mock_client.MockTransactionClient)
+	transactionHandler := &TransactionHandler{TransactionClient: mockClient}
+	router := setupTransactionRoutes(transactionHandler)
+
+	request := InitTransactionRequest{
+		SourceAccountID: "acc-12345",
+		TransactionType: "add_amount",
+		Amount:          json.Number("50.00"),
+		Reference:       "test-async",
+		Async:           true,
+	}
+
+	mockClient.On("InitTransaction", mock.Anything, mock.MatchedBy(func(req *prototx.InitTransactionRequest) bool {
+		return req.Async && req.Reference == "test-async"
+	})).Return(&prototx.InitTransactionResponse{
+		TransactionId:     "txn-12345",
+		TransactionStatus: "pending",
+		Response: &prototx.Response{
+			Success: true,
+			Message: "Transaction accepted for processing",
+		},
+	}, nil)
+
+	body, _ := json.Marshal(request)
+	req, _ := http.NewRequest("POST", "/api/v1/transaction/init", bytes.NewBuffer(body))
+	req.Header.Set("Content-Type", "application/json")
+
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusAccepted, w.Code)
+
+	var response InitTransactionResponse
+	err := json.Unmarshal(w.Body.Bytes(), &response)
+	assert.NoError(t, err)
+	assert.Equal(t, "txn-12345", response.TransactionID)
+	assert.Equal(t, "pending", response.TransactionStatus)
+
+	mockClient.AssertExpectations(t)
+}
+
+// TestGetTransaction_Success tests polling the status of a transaction
+func TestGetTransaction_Success(t *testing.T) {
+	mockClient := new(mock_client.MockTransactionClient)
+	transactionHandler := &TransactionHandler{TransactionClient: mockClient}
+	router := setupTransactionRoutes(transactionHandler)
+
+	mockClient.On("GetTransaction", mock.Anything, mock.MatchedBy(func(req *prototx.GetTransactionRequest) bool {
+		return req.TransactionId == "txn-12345" && req.Metadata.Requester == "test-admin" && req.Metadata.RequesterRole == "admin"
+	})).Return(&prototx.GetTransactionResponse{
+		Transaction: &prototx.Transaction{
+			Id:                "txn-12345",
+			TransactionStatus: "failed",
+			ErrorReason:       "insufficient balance",
+		},
+		Response: &prototx.Response{
+			Success: true,
+			Message: "Transaction details",
+		},
+	}, nil)
+
+	req, _ := http.NewRequest("GET", "/api/v1/transaction/txn-12345", nil)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	var response map[string]interface{}
+	err := json.Unmarshal(w.Body.Bytes(), &response)
+	assert.NoError(t, err)
+	transaction := response["transaction"].(map[string]interface{})
+	assert.Equal(t, "failed", transaction["transaction_status"])
+	assert.Equal(t, "insufficient balance", transaction["error_reason"])
+
+	mockClient.AssertExpectations(t)
+}
+
+// TestGetTransaction_NotFound tests if transaction does not exist
+func TestGetTransaction_NotFound(t *testing.T) {
+	mockClient := new(mock_client.MockTransactionClient)
+	transactionHandler := &TransactionHandler{TransactionClient: mockClient}
+	router := setupTransactionRoutes(transactionHandler)
+
+	mockClient.On("GetTransaction", mock.Anything, mock.Anything).Return(&prototx.GetTransactionResponse{
+		Response: &prototx.Response{
+			Success: false,
+			Message: "Transaction not found",
+		},
+	}, nil)
+
+	req, _ := http.NewRequest("GET", "/api/v1/transaction/txn-404", nil)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusNotFound, w.Code)
+	mockClient.AssertExpectations(t)
+}
+
+// TestReverseTransaction_Success tests reversing part of a completed transaction
+func TestReverseTransaction_Success(t *testing.T) {
+	mockClient := new(mock_client.MockTransactionClient)
+	handler := &TransactionHandler{TransactionClient: mockClient}
+	router := setupTransactionRoutes(handler)
+
+	mockClient.On("ReverseTransaction", mock.Anything, mock.MatchedBy(func(req *prototx.ReverseTransactionRequest) bool {
+		return req.TransactionId == "txn-1" &&
+			req.Amount == "40.00" &&
+			req.Reason == "wrong beneficiary" &&
+			req.Metadata.Requester == "test-admin"
+	})).Return(&prototx.ReverseTransactionResponse{
+		Transaction: &prototx.Transaction{
+			Id:                      "txn-2",
+			Amount:                  "40.00",
+			ReversalOfTransactionId: "txn-1",
+		},
+		Response: &prototx.Response{
+			Success: true,
+			Message: "Transaction reversed successfully",
+		},
+	}, nil)
+
+	body := []byte(`{"amount": "40.00", "reason": "wrong beneficiary"}`)
+	req, _ := http.NewRequest("POST", "/api/v1/transaction/txn-1/reverse", bytes.NewBuffer(body))
+	req.Header.Set("Content-Type", "application/json")
+
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusCreated, w.Code)
+
+	var response map[string]interface{}
+	err := json.Unmarshal(w.Body.Bytes(), &response)
+	assert.NoError(t, err)
+	assert.Equal(t, "Transaction reversed successfully", response["message"])
+	transaction := response["transaction"].(map[string]interface{})
+	assert.Equal(t, "txn-1", transaction["reversal_of_transaction_id"])
+	mockClient.AssertExpectations(t)
+}
+
+// TestReverseTransaction_MissingReason tests if no reason is provided
+func TestReverseTransaction_MissingReason(t *testing.T) {
+	mockClient := new(mock_client.MockTransactionClient)
+	handler := &TransactionHandler{TransactionClient: mockClient}
+	router := setupTransactionRoutes(handler)
+
+	req, _ := http.NewRequest("POST", "/api/v1/transaction/txn-1/reverse", bytes.NewBuffer([]byte(`{"amount": "40.00"}`)))
+	req.Header.Set("Content-Type", "application/json")
+
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	mockClient.AssertNotCalled(t, "ReverseTransaction", mock.Anything, mock.Anything)
+}
+
+// TestReverseTransaction_AlreadyReversed tests if the transaction service rejects a second reversal
+func TestReverseTransaction_AlreadyReversed(t *testing.T) {
+	mockClient := new(mock_client.MockTransactionClient)
+	handler := &TransactionHandler{TransactionClient: mockClient}
+	router := setupTransactionRoutes(handler)
+
+	mockClient.On("ReverseTransaction", mock.Anything, mock.Anything).Return(&prototx.ReverseTransactionResponse{
+		Response: &prototx.Response{
+			Success: false,
+			Message: "Transaction already reversed",
+		},
+	}, nil)
+
+	req, _ := http.NewRequest("POST", "/api/v1/transaction/txn-1/reverse", bytes.NewBuffer([]byte(`{"reason": "duplicate"}`)))
+	req.Header.Set("Content-Type", "application/json")
+
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	assert.Contains(t, w.Body.String(), "Transaction already reversed")
+}
@@ -5,7 +5,6 @@ import (
 	"errors"
 	"fmt"
 	"gateway-service/internal/auth"
-	"gateway-service/internal/config"
 	"gateway-service/internal/logging"
 	"gateway-service/internal/ports"
 	"github.com/gin-gonic/gin"
@@ -14,7 +13,7 @@ import (
 	"time"
 )
 
-func AuthMiddleware(authClient *ports.AuthClient) gin.HandlerFunc {
+func AuthMiddleware(authClient *ports.AuthClient, tokenSigner *auth.TokenSigner, revocations *auth.RevocationList) gin.HandlerFunc {
 	return func(c *gin.Context) {
 		authHeader := c.GetHeader("Authorization")
 		authHeader = strings.TrimSpace(authHeader)
@@ -34,7 +33,7 @@ func AuthMiddleware(authClient *ports.AuthClient) gin.HandlerFunc {
 
 		token := parts[1]
 
-		claim, httpCode, err := validateToken(c, token)
+		claim, httpCode, err := validateToken(c, token, tokenSigner, revocations)
 		if err != nil {
 			c.JSON(httpCode, gin.H{"error": err.Error()})
 			c.Abort()
@@ -44,6 +43,8 @@ func AuthMiddleware(authClient *ports.AuthClient) gin.HandlerFunc {
 		// Set user info in context
 		c.Set("username", claim.Username)
 		c.Set("role", claim.Role)
+		c.Set("token_id", claim.ID)
+		c.Set("token_expires_at", claim.ExpiresAt.Time)
 
 		// Proceed with the request
 		c.Next()
@@ -51,8 +52,8 @@ func AuthMiddleware(authClient *ports.AuthClient) gin.HandlerFunc {
 }
 
 // validateToken validates incoming token and returns Claims obj, httpStatusCode, err
-func validateToken(c *gin.Context, token string) (*auth.Claims, int, error) {
-	claim, err := auth.NewTokenSigner(config.Current().Auth.JWTSecret).ParseJWT(token)
+func validateToken(c *gin.Context, token string, tokenSigner *auth.TokenSigner, revocations *auth.RevocationList) (*auth.Claims, int, error) {
+	claim, err := tokenSigner.ParseJWT(token)
 	if err != nil {
 		logging.Logger.Err(err).Msg("Invalid token")
 		return nil, http.StatusUnauthorized, errors.New("Invalid or expired token")
@@ -67,6 +68,12 @@ func validateToken(c *gin.Context, token string) (*auth.Claims, int, error) {
 		return nil, http.StatusUnauthorized, errors.New("Token has expired")
 	}
 
+	// Check token revocation (logout, employee deleted or role changed)
+	if revocations != nil && revocations.IsRevoked(claim) {
+		logging.Logger.Err(errors.New("token revoked")).Str("username", claim.Username).Msg("Invalid token")
+		return nil, http.StatusUnauthorized, errors.New("Token has been revoked")
+	}
+
 	permissions := getRBACPermissions()
 	fullPath := c.FullPath()
 	method := c.Request.Method
@@ -79,6 +86,21 @@ func validateToken(c *gin.Context, token string) (*auth.Claims, int, error) {
 
 func getRBACPermissions() map[string]map[string]map[string]bool {
 	return map[string]map[string]map[string]bool{
+		"/api/v1/auth/logout": {
+			"POST": {"admin": true, "editor": true, "viewer": true},
+		},
+		"/api/v1/auth/mfa/enroll": {
+			"POST": {"admin": true, "editor": true, "viewer": true},
+		},
+		"/api/v1/auth/mfa/confirm": {
+			"POST": {"admin": true, "editor": true, "viewer": true},
+		},
+		"/api/v1/auth/mfa/policies": {
+			"GET": {"admin": true, "editor": false, "viewer": false},
+		},
+		"/api/v1/auth/mfa/policies/:role": {
+			"PUT": {"admin": true, "editor": false, "viewer": false},
+		},
 		"/api/v1/employee": {
 			"GET":    {"admin": true, "editor": false, "viewer": false},
 			"POST":   {"admin": true, "editor": false, "viewer": false},
@@ -89,6 +111,9 @@ func getRBACPermissions() map[string]map[string]map[string]bool {
 			"DELETE": {"admin": true, "editor": false, "viewer": false},
 			"PUT":    {"admin": true, "editor": false, "viewer": false},
 		},
+		"/api/v1/employee/:username/mfa": {
+			"DELETE": {"admin": true, "editor": false, "viewer": false},
+		},
 		"/api/v1/customer": {
 			"POST": {"admin": true, "editor": true, "viewer": false},
 			"GET":  {"admin": true, "editor": true, "viewer": true},
@@ -114,11 +139,110 @@ func getRBACPermissions() map[string]map[string]map[string]bool {
 		"/api/v1/account/:id/balance": {
 			"GET": {"admin": true, "editor": true, "viewer": true},
 		},
+		"/api/v1/account/:id/journal": {
+			"GET": {"admin": true, "editor": true, "viewer": true},
+		},
+		"/api/v1/account/:id/ledger-balance": {
+			"GET": {"admin": true, "editor": true, "viewer": true},
+		},
+		"/api/v1/account/:id/overdraft": {
+			"PUT": {"admin": true, "editor": false, "viewer": false},
+		},
+		"/api/v1/account/:id/freeze": {
+			"POST": {"admin": true, "editor": false, "viewer": false},
+		},
+		"/api/v1/account/:id/unfreeze": {
+			"POST": {"admin": true, "editor": false, "viewer": false},
+		},
+		"/api/v1/account/:id/close": {
+			"POST": {"admin": true, "editor": false, "viewer": false},
+		},
+		"/api/v1/account/:id/hold": {
+			"POST": {"admin": true, "editor": true, "viewer": false},
+			"GET":  {"admin": true, "editor": true, "viewer": true},
+		},
+		"/api/v1/account/:id/interest": {
+			"GET": {"admin": true, "editor": true, "viewer": true},
+		},
+		"/api/v1/account/:id/statement": {
+			"GET": {"admin": true, "editor": true, "viewer": true},
+		},
+		"/api/v1/account/:id/statements": {
+			"GET": {"admin": true, "editor": true, "viewer": true},
+		},
+		"/api/v1/statement/:id": {
+			"GET": {"admin": true, "editor": true, "viewer": true},
+		},
+		"/api/v1/interest-rate": {
+			"PUT": {"admin": true, "editor": false, "viewer": false},
+			"GET": {"admin": true, "editor": true, "viewer": true},
+		},
+		"/api/v1/hold/:id": {
+			"GET": {"admin": true, "editor": true, "viewer": true},
+		},
+		"/api/v1/hold/:id/release": {
+			"POST": {"admin": true, "editor": true, "viewer": false},
+		},
+		"/api/v1/hold/:id/capture": {
+			"POST": {"admin": true, "editor": true, "viewer": false},
+		},
 		"/api/v1/transaction/init": {
 			"POST": {"admin": true, "editor": true, "viewer": false},
 		},
 		"/api/v1/transaction": {
 			"GET": {"admin": true, "editor": true, "viewer": true},
 		},
+		"/api/v1/transaction/export": {
+			"GET": {"admin": true, "editor": true, "viewer": true},
+		},
+		"/api/v1/transaction/:id": {
+			"GET": {"admin": true, "editor": true, "viewer": true},
+		},
+		"/api/v1/transaction/:id/reverse": {
+			"POST": {"admin": true, "editor": false, "viewer": false},
+		},
+		"/api/v1/exchange-rate": {
+			"PUT": {"admin": true, "editor": false, "viewer": false},
+			"GET": {"admin": true, "editor": true, "viewer": true},
+		},
+		"/api/v1/standing-order": {
+			"POST": {"admin": true, "editor": true, "viewer": false},
+			"GET":  {"admin": true, "editor": true, "viewer": true},
+		},
+		"/api/v1/standing-order/:id": {
+			"GET": {"admin": true, "editor": true, "viewer": true},
+		},
+		"/api/v1/standing-order/:id/pause": {
+			"POST": {"admin": true, "editor": true, "viewer": false},
+		},
+		"/api/v1/standing-order/:id/resume": {
+			"POST": {"admin": true, "editor": true, "viewer": false},
+		},
+		"/api/v1/standing-order/:id/cancel": {
+			"POST": {"admin": true, "editor": true, "viewer": false},
+		},
+		"/api/v1/transaction-batch": {
+			"POST": {"admin": true, "editor": true, "viewer": false},
+		},
+		"/api/v1/transaction-batch/upload": {
+			"POST": {"admin": true, "editor": true, "viewer": false},
+		},
+		"/api/v1/transaction-batch/:id": {
+			"GET": {"admin": true, "editor": true, "viewer": true},
+		},
+		"/api/v1/transaction-batch/:id/results": {
+			"GET": {"admin": true, "editor": true, "viewer": true},
+		},
+		"/api/v1/transaction-limit": {
+			"PUT": {"admin": true, "editor": false, "viewer": false},
+			"GET": {"admin": true, "editor": false, "viewer": false},
+		},
+		"/api/v1/fee-rule": {
+			"PUT": {"admin": true, "editor": false, "viewer": false},
+			"GET": {"admin": true, "editor": true, "viewer": true},
+		},
+		"/api/v1/fee-rule/:id": {
+			"DELETE": {"admin": true, "editor": false, "viewer": false},
+		},
 	}
 }
@@ -16,6 +16,19 @@ func TestPermissionLogic(t *testing.T) {
 		expected bool
 		desc     string
 	}{
+		// Auth endpoints
+		{"/api/v1/auth/logout", "POST", "admin", true, "Admin can log out"},
+		{"/api/v1/auth/logout", "POST", "editor", true, "Editor can log out"},
+		{"/api/v1/auth/logout", "POST", "viewer", true, "Viewer can log out"},
+		{"/api/v1/auth/mfa/enroll", "POST", "viewer", true, "Viewer can enroll MFA"},
+		{"/api/v1/auth/mfa/confirm", "POST", "editor", true, "Editor can confirm MFA"},
+		{"/api/v1/auth/mfa/policies", "GET", "admin", true, "Admin can list MFA policies"},
+		{"/api/v1/auth/mfa/policies", "GET", "editor", false, "Editor cannot list MFA policies"},
+		{"/api/v1/auth/mfa/policies/:role", "PUT", "admin", true, "Admin can set MFA policy"},
+		{"/api/v1/auth/mfa/policies/:role", "PUT", "viewer", false, "Viewer cannot set MFA policy"},
+		{"/api/v1/employee/:username/mfa", "DELETE", "admin", true, "Admin can reset MFA"},
+		{"/api/v1/employee/:username/mfa", "DELETE", "editor", false, "Editor cannot reset MFA"},
+
 		// Employee endpoints
 		{"/api/v1/employee", "POST", "admin", true, "Admin can create employee"},
 		{"/api/v1/employee", "POST", "editor", false, "Editor cannot create employee"},
@@ -38,6 +51,14 @@ func TestPermissionLogic(t *testing.T) {
 		{"/api/v1/customer", "GET", "editor", true, "Editor can list customers"},
 		{"/api/v1/customer", "GET", "viewer", true, "Viewer can list customers"},
 
+		{"/api/v1/customer/:id", "GET", "admin", true, "Admin can view customer"},
+		{"/api/v1/customer/:id", "GET", "editor", true, "Editor can view customer"},
+		{"/api/v1/customer/:id", "GET", "viewer", true, "Viewer can view customer"},
+
+		{"/api/v1/customer/:id", "PUT", "admin", true, "Admin can update customer"},
+		{"/api/v1/customer/:id", "PUT", "editor", true, "Editor can update customer"},
+		{"/api/v1/customer/:id", "PUT", "viewer", false, "Viewer cannot update customer"},
+
 		// Account endpoints
 		{"/api/v1/account", "POST", "admin", true, "Admin can create account"},
 		{"/api/v1/account", "POST", "editor", true, "Editor can create account"},
@@ -47,10 +68,86 @@ func TestPermissionLogic(t *testing.T) {
 		{"/api/v1/account/:id/balance", "GET", "editor", true, "Editor can view balance"},
 		{"/api/v1/account/:id/balance", "GET", "viewer", true, "Viewer can view balance"},
 
+		{"/api/v1/account/:id/journal", "GET", "admin", true, "Admin can view account journal"},
+		{"/api/v1/account/:id/journal", "GET", "editor", true, "Editor can view account journal"},
+		{"/api/v1/account/:id/journal", "GET", "viewer", true, "Viewer can view account journal"},
+
+		{"/api/v1/account/:id/ledger-balance", "GET", "admin", true, "Admin can recompute ledger balance"},
+		{"/api/v1/account/:id/ledger-balance", "GET", "editor", true, "Editor can recompute ledger balance"},
+		{"/api/v1/account/:id/ledger-balance", "GET", "viewer", true, "Viewer can recompute ledger balance"},
+		{"/api/v1/account/:id/overdraft", "PUT", "admin", true, "Admin can set overdraft limit"},
+		{"/api/v1/account/:id/overdraft", "PUT", "editor", false, "Editor cannot set overdraft limit"},
+		{"/api/v1/account/:id/overdraft", "PUT", "viewer", false, "Viewer cannot set overdraft limit"},
+		{"/api/v1/account/:id/freeze", "POST", "admin", true, "Admin can freeze account"},
+		{"/api/v1/account/:id/freeze", "POST", "editor", false, "Editor cannot freeze account"},
+		{"/api/v1/account/:id/unfreeze", "POST", "admin", true, "Admin can unfreeze account"},
+		{"/api/v1/account/:id/unfreeze", "POST", "viewer", false, "Viewer cannot unfreeze account"},
+		{"/api/v1/account/:id/close", "POST", "admin", true, "Admin can close account"},
+		{"/api/v1/account/:id/close", "POST", "editor", false, "Editor cannot close account"},
+
+		// Hold endpoints
+		{"/api/v1/account/:id/hold", "POST", "editor", true, "Editor can place hold"},
+		{"/api/v1/account/:id/hold", "POST", "viewer", false, "Viewer cannot place hold"},
+		{"/api/v1/account/:id/hold", "GET", "viewer", true, "Viewer can list account holds"},
+		{"/api/v1/hold/:id", "GET", "viewer", true, "Viewer can view hold"},
+		{"/api/v1/hold/:id/release", "POST", "editor", true, "Editor can release hold"},
+		{"/api/v1/hold/:id/release", "POST", "viewer", false, "Viewer cannot release hold"},
+		{"/api/v1/hold/:id/capture", "POST", "admin", true, "Admin can capture hold"},
+		{"/api/v1/hold/:id/capture", "POST", "viewer", false, "Viewer cannot capture hold"},
+
+		// Interest endpoints
+		{"/api/v1/interest-rate", "PUT", "admin", true, "Admin can set interest rate"},
+		{"/api/v1/interest-rate", "PUT", "editor", false, "Editor cannot set interest rate"},
+		{"/api/v1/interest-rate", "GET", "viewer", true, "Viewer can list interest rates"},
+		{"/api/v1/account/:id/interest", "GET", "viewer", true, "Viewer can view account interest"},
+		{"/api/v1/account/:id/statement", "GET", "viewer", true, "Viewer can generate account statement"},
+		{"/api/v1/account/:id/statements", "GET", "viewer", true, "Viewer can list account statements"},
+		{"/api/v1/statement/:id", "GET", "viewer", true, "Viewer can view statement"},
+		{"/api/v1/statement/:id", "DELETE", "admin", false, "Admin cannot delete statement"},
+
 		// Transaction endpoints
 		{"/api/v1/transaction/init", "POST", "admin", true, "Admin can initiate transaction"},
 		{"/api/v1/transaction/init", "POST", "editor", true, "Editor can initiate transaction"},
 		{"/api/v1/transaction/init", "POST", "viewer", false, "Viewer cannot initiate transaction"},
+
+		{"/api/v1/transaction/export", "GET", "admin", true, "Admin can export transactions"},
+		{"/api/v1/transaction/export", "GET", "editor", true, "Editor can export transactions"},
+		{"/api/v1/transaction/export", "GET", "viewer", true, "Viewer can export transactions"},
+
+		{"/api/v1/transaction/:id", "GET", "admin", true, "Admin can view transaction"},
+		{"/api/v1/transaction/:id", "GET", "editor", true, "Editor can view transaction"},
+		{"/api/v1/transaction/:id", "GET", "viewer", true, "Viewer can view transaction"},
+
+		{"/api/v1/transaction/:id/reverse", "POST", "admin", true, "Admin can reverse transaction"},
+		{"/api/v1/transaction/:id/reverse", "POST", "editor", false, "Editor cannot reverse transaction"},
+		{"/api/v1/transaction/:id/reverse", "POST", "viewer", false, "Viewer cannot reverse transaction"},
+
+		// Exchange rate endpoints
+		{"/api/v1/exchange-rate", "PUT", "admin", true, "Admin can set exchange rate"},
+		{"/api/v1/exchange-rate", "PUT", "editor", false, "Editor cannot set exchange rate"},
+		{"/api/v1/exchange-rate", "PUT", "viewer", false, "Viewer cannot set exchange rate"},
+
+		{"/api/v1/exchange-rate", "GET", "viewer", true, "Viewer can list exchange rates"},
+
+		// Standing order endpoints
+		{"/api/v1/standing-order", "POST", "editor", true, "Editor can create standing order"},
+		{"/api/v1/standing-order", "POST", "viewer", false, "Viewer cannot create standing order"},
+		{"/api/v1/standing-order", "GET", "viewer", true, "Viewer can list standing orders"},
+		{"/api/v1/standing-order/:id", "GET", "viewer", true, "Viewer can view standing order"},
+		{"/api/v1/standing-order/:id/pause", "POST", "editor", true, "Editor can pause standing order"},
+		{"/api/v1/standing-order/:id/cancel", "POST", "viewer", false, "Viewer cannot cancel standing order"},
+		{"/api/v1/transaction-batch", "POST", "editor", true, "Editor can create transaction batch"},
+		{"/api/v1/transaction-batch/upload", "POST", "viewer", false, "Viewer cannot upload transaction batch"},
+		{"/api/v1/transaction-batch/:id", "GET", "viewer", true, "Viewer can view transaction batch"},
+		{"/api/v1/transaction-batch/:id/results", "GET", "viewer", true, "Viewer can download transaction batch results"},
+		{"/api/v1/transaction-limit", "PUT", "admin", true, "Admin can set transaction limit"},
+		{"/api/v1/transaction-limit", "PUT", "editor", false, "Editor cannot set transaction limit"},
+		{"/api/v1/transaction-limit", "GET", "editor", false, "Editor cannot list transaction limits"},
+		{"/api/v1/fee-rule", "PUT", "admin", true, "Admin can set fee rule"},
+		{"/api/v1/fee-rule", "PUT", "editor", false, "Editor cannot set fee rule"},
+		{"/api/v1/fee-rule", "GET", "viewer", true, "Viewer can list fee rules"},
+		{"/api/v1/fee-rule/:id", "DELETE", "admin", true, "Admin can delete fee rule"},
+		{"/api/v1/fee-rule/:id", "DELETE", "editor", false, "Editor cannot delete fee rule"},
 	}
 
 	for _, tc := range testCases {
@@ -2,6 +2,7 @@ package http
 
 import (
 	"gateway-service/api/docs"
+	"gateway-service/internal/auth"
 	"gateway-service/internal/config"
 	"gateway-service/internal/http/handlers"
 	middleware "gateway-service/internal/http/middlewares"
@@ -13,7 +14,7 @@ import (
 )
 
 // setRoutes sets up all the routes for the API Gateway.
-func setRoutes(router *gin.Engine, gRPCClients GrpcClients) {
+func setRoutes(router *gin.Engine, gRPCClients GrpcClients, keys *auth.KeySet, revocations *auth.RevocationList) {
 	docs.SwaggerInfo.Title = "BankOps Core - API Docs"
 	docs.SwaggerInfo.Description = "API documentation for the BankOps Core"
 	docs.SwaggerInfo.Version = "1.0"
@@ -53,34 +54,91 @@ func setRoutes(router *gin.Engine, gRPCClients GrpcClients) {
 	}
 
 	// Initialize handlers with clients
-	authHandler := handlers.NewAuthHandler(*gRPCClients.AuthClient)
+	authHandler := handlers.NewAuthHandler(*gRPCClients.AuthClient, revocations)
 	accountHandler := handlers.NewAccountHandler(*gRPCClients.AccountClient)
 	txHandler := handlers.NewTransactionHandler(*gRPCClients.TransactionClient)
 
 	authGroup := router.Group("/api/v1/auth")
 	{
 		authGroup.POST("/login", authHandler.Login)
+		authGroup.POST("/refresh", authHandler.Refresh)
+		authGroup.POST("/mfa/verify", authHandler.VerifyMFA)
+		authGroup.POST("/mfa/challenge/enroll", authHandler.EnrollMFAChallenge)
 	}
 
 	protectedGroup := router.Group("/api/v1")
-	protectedGroup.Use(middleware.AuthMiddleware(gRPCClients.AuthClient), middleware.RequestID)
+	protectedGroup.Use(middleware.AuthMiddleware(gRPCClients.AuthClient, auth.NewTokenSigner(keys), revocations), middleware.RequestID)
 	{
+		// Auth API
+		protectedGroup.POST("/auth/logout", authHandler.Logout)
+		protectedGroup.POST("/auth/mfa/enroll", authHandler.EnrollMFA)
+		protectedGroup.POST("/auth/mfa/confirm", authHandler.ConfirmMFA)
+		protectedGroup.GET("/auth/mfa/policies", authHandler.ListMFAPolicies)
+		protectedGroup.PUT("/auth/mfa/policies/:role", authHandler.SetMFAPolicy)
 		// Employee API
 		protectedGroup.POST("/employee", authHandler.CreateEmployee)
 		protectedGroup.DELETE("/employee/:username", authHandler.DeleteEmployee)
+		protectedGroup.DELETE("/employee/:username/mfa", authHandler.ResetMFA)
 		protectedGroup.GET("/employee", authHandler.ListEmployee)
 		// Customer API
 		protectedGroup.POST("/customer", accountHandler.CreateCustomer)
 		protectedGroup.GET("/customer", accountHandler.ListCustomer)
 		protectedGroup.GET("/customer/:id/account", accountHandler.ListCustomerAccounts)
+		protectedGroup.GET("/customer/:id", accountHandler.GetCustomer)
+		protectedGroup.PUT("/customer/:id", accountHandler.UpdateCustomer)
 		protectedGroup.DELETE("/customer/:id", accountHandler.DeleteCustomer)
 		// Account API
 		protectedGroup.POST("/account", accountHandler.CreateAccount)
 		protectedGroup.GET("/account/:id/balance", accountHandler.GetAccountBalance)
+		protectedGroup.GET("/account/:id/journal", accountHandler.GetAccountJournal)
+		protectedGroup.GET("/account/:id/ledger-balance", accountHandler.RecomputeAccountBalance)
+		protectedGroup.PUT("/account/:id/overdraft", accountHandler.SetOverdraftLimit)
+		protectedGroup.POST("/account/:id/freeze", accountHandler.FreezeAccount)
+		protectedGroup.POST("/account/:id/unfreeze", accountHandler.UnfreezeAccount)
+		protectedGroup.POST("/account/:id/close", txHandler.CloseAccount)
+		protectedGroup.POST("/account/:id/hold", accountHandler.PlaceHold)
+		protectedGroup.GET("/account/:id/hold", accountHandler.ListAccountHolds)
+		protectedGroup.GET("/account/:id/interest", accountHandler.GetAccountInterest)
+		protectedGroup.GET("/account/:id/statement", accountHandler.GenerateAccountStatement)
+		protectedGroup.GET("/account/:id/statements", accountHandler.ListAccountStatements)
 		protectedGroup.GET("/account", accountHandler.ListAccounts)
 		protectedGroup.DELETE("/account", accountHandler.DeleteAccount)
 		//Transaction API
 		protectedGroup.POST("/transaction/init", txHandler.InitTransaction)
 		protectedGroup.GET("/transaction", txHandler.ListTransactions)
+		protectedGroup.GET("/transaction/export", txHandler.ExportTransactions)
+		protectedGroup.GET("/transaction/:id", txHandler.GetTransaction)
+		protectedGroup.POST("/transaction/:id/reverse", txHandler.ReverseTransaction)
+		// Hold API
+		protectedGroup.GET("/hold/:id", accountHandler.GetHold)
+		protectedGroup.POST("/hold/:id/release", accountHandler.ReleaseHold)
+		protectedGroup.POST("/hold/:id/capture", txHandler.CaptureHold)
+		// Statement API
+		protectedGroup.GET("/statement/:id", accountHandler.GetStatement)
+		// Interest API
+		protectedGroup.PUT("/interest-rate", accountHandler.SetInterestRate)
+		protectedGroup.GET("/interest-rate", accountHandler.ListInterestRates)
+		// Exchange Rate API
+		protectedGroup.PUT("/exchange-rate", txHandler.SetExchangeRate)
+		protectedGroup.GET("/exchange-rate", txHandler.ListExchangeRates)
+		// Standing Order API
+		protectedGroup.POST("/standing-order", txHandler.CreateStandingOrder)
+		protectedGroup.GET("/standing-order", txHandler.ListStandingOrders)
+		protectedGroup.GET("/standing-order/:id", txHandler.GetStandingOrder)
+		protectedGroup.POST("/standing-order/:id/pause", txHandler.PauseStandingOrder)
+		protectedGroup.POST("/standing-order/:id/resume", txHandler.ResumeStandingOrder)
+		protectedGroup.POST("/standing-order/:id/cancel", txHandler.CancelStandingOrder)
+		// Transaction Batch API
+		protectedGroup.POST("/transaction-batch", txHandler.CreateTransactionBatch)
+		protectedGroup.POST("/transaction-batch/upload", txHandler.UploadTransactionBatch)
+		protectedGroup.GET("/transaction-batch/:id", txHandler.GetTransactionBatch)
+		protectedGroup.GET("/transaction-batch/:id/results", txHandler.DownloadTransactionBatchResults)
+
+		protectedGroup.PUT("/transaction-limit", txHandler.SetTransactionLimit)
+		protectedGroup.GET("/transaction-limit", txHandler.ListTransactionLimits)
+		// Fee Rule API
+		protectedGroup.PUT("/fee-rule", txHandler.SetFeeRule)
+		protectedGroup.GET("/fee-rule", txHandler.ListFeeRules)
+		protectedGroup.DELETE("/fee-rule/:id", txHandler.DeleteFeeRule)
 	}
 }
@@ -2,6 +2,7 @@ package http
 
 import (
 	"fmt"
+	"gateway-service/internal/auth"
 	"gateway-service/internal/config"
 	middleware "gateway-service/internal/http/middlewares"
 	"gateway-service/internal/logging"
@@ -17,7 +18,7 @@ type GrpcClients struct {
 	TransactionClient *ports.TransactionClient
 }
 
-func StartServer(gRPCClients GrpcClients) {
+func StartServer(gRPCClients GrpcClients, keys *auth.KeySet, revocations *auth.RevocationList) {
 	gin.SetMode(gin.ReleaseMode)
 
 	r := gin.New()
@@ -40,7 +41,7 @@ func StartServer(gRPCClients GrpcClients) {
 	}
 
 	// Setup routes
-	setRoutes(r, gRPCClients)
+	setRoutes(r, gRPCClients, keys, revocations)
 
 	logging.Logger.Info().Msg(fmt.Sprintf("server listening on %s", config.Current().HTTP.Addr))
 
@@ -0,0 +1,108 @@
+// Package pdf writes simple text-only PDF documents.
+package pdf
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+)
+
+const (
+	pageWidth    = 595 // A4 in points
+	pageHeight   = 842
+	margin       = 40
+	fontSize     = 9
+	lineHeight   = 12
+	linesPerPage = (pageHeight - 2*margin) / lineHeight
+)
+
+// Document is a PDF of monospaced text lines laid out top to bottom on A4 pages
+type Document struct {
+	lines []string
+}
+
+// NewDocument creates an empty document
+func NewDocument() *Document {
+	return &Document{}
+}
+
+// AddLine appends a line of text. Characters outside printable ASCII are replaced with '?'.
+func (d *Document) AddLine(text string) {
+	d.lines = append(d.lines, text)
+}
+
+// Bytes renders the document, starting a new page every linesPerPage lines. An empty document has one blank page.
+func (d *Document) Bytes() []byte {
+	pages := make([][]string, 0, len(d.lines)/linesPerPage+1)
+	for start := 0; start < len(d.lines); start += linesPerPage {
+		end := start + linesPerPage
+		if end > len(d.lines) {
+			end = len(d.lines)
+		}
+		pages = append(pages, d.lines[start:end])
+	}
+	if len(pages) == 0 {
+		pages = append(pages, nil)
+	}
+
+	var buf bytes.Buffer
+	var offsets []int
+	writeObject := func(body string) {
+		offsets = append(offsets, buf.Len())
+		fmt.Fprintf(&buf, "%d 0 obj\n%s\nendobj\n", len(offsets), body)
+	}
+
+	buf.WriteString("%PDF-1.4\n")
+
+	// objects 1-3 are the catalog, the page tree and the font; each page is followed by its content stream
+	kids := make([]string, len(pages))
+	for i := range pages {
+		kids[i] = fmt.Sprintf("%d 0 R", 4+2*i)
+	}
+	writeObject("<< /Type /Catalog /Pages 2 0 R >>")
+	writeObject(fmt.Sprintf("<< /Type /Pages /Kids [%s] /Count %d >>", strings.Join(kids, " "), len(pages)))
+	writeObject("<< /Type /Font /Subtype /Type1 /BaseFont /Courier >>")
+
+	for i, lines := range pages {
+		content := pageContent(lines)
+		writeObject(fmt.Sprintf("<< /Type /Page /Parent 2 0 R /MediaBox [0 0 %d %d] /Resources << /Font << /F1 3 0 R >> >> /Contents %d 0 R >>",
+			pageWidth, pageHeight, 5+2*i))
+		writeObject(fmt.Sprintf("<< /Length %d >>\nstream\n%s\nendstream", len(content), content))
+	}
+
+	xrefOffset := buf.Len()
+	fmt.Fprintf(&buf, "xref\n0 %d\n0000000000 65535 f \n", len(offsets)+1)
+	for _, offset := range offsets {
+		fmt.Fprintf(&buf, "%010d 00000 n \n", offset)
+	}
+	fmt.Fprintf(&buf, "trailer\n<< /Size %d /Root 1 0 R >>\nstartxref\n%d\n%%%%EOF\n", len(offsets)+1, xrefOffset)
+	return buf.Bytes()
+}
+
+// pageContent draws the lines of one page
+func pageContent(lines []string) string {
+	var content strings.Builder
+	fmt.Fprintf(&content, "BT\n/F1 %d Tf\n%d TL\n%d %d Td\n", fontSize, lineHeight, margin, pageHeight-margin-fontSize)
+	for _, line := range lines {
+		fmt.Fprintf(&content, "(%s) '\n", escapeText(line))
+	}
+	content.WriteString("ET")
+	return content.String()
+}
+
+// escapeText escapes a line for a PDF string literal
+func escapeText(text string) string {
+	var escaped strings.Builder
+	for _, r := range text {
+		switch {
+		case r == '(' || r == ')' || r == '\\':
+			escaped.WriteRune('\\')
+			escaped.WriteRune(r)
+		case r < ' ' || r > '~':
+			escaped.WriteRune('?')
+		default:
+			escaped.WriteRune(r)
+		}
+	}
+	return escaped.String()
+}
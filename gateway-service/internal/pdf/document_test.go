@@ -0,0 +1,45 @@
+package pdf
+
+import (
+	"bytes"
+	"fmt"
+	"github.com/stretchr/testify/assert"
+	"regexp"
+	"strconv"
+	"testing"
+)
+
+// TestDocument_Bytes tests that lines are escaped and split into pages with a valid cross-reference table
+func TestDocument_Bytes(t *testing.T) {
+	doc := NewDocument()
+	doc.AddLine("Statement (USD) 100% \\ Café")
+	for i := 0; i < linesPerPage; i++ {
+		doc.AddLine(fmt.Sprintf("line %d", i))
+	}
+
+	out := doc.Bytes()
+
+	assert.True(t, bytes.HasPrefix(out, []byte("%PDF-1.4\n")))
+	assert.True(t, bytes.HasSuffix(out, []byte("%%EOF\n")))
+	assert.Contains(t, string(out), `(Statement \(USD\) 100% \\ Caf?) '`)
+	assert.Contains(t, string(out), "/Count 2")
+
+	// every xref entry points at the start of its object
+	offsets := regexp.MustCompile(`(\d{10}) 00000 n `).FindAllSubmatch(out, -1)
+	assert.Len(t, offsets, 7)
+	for i, match := range offsets {
+		offset, _ := strconv.Atoi(string(match[1]))
+		assert.True(t, bytes.HasPrefix(out[offset:], []byte(fmt.Sprintf("%d 0 obj", i+1))))
+	}
+
+	startxref := regexp.MustCompile(`startxref\n(\d+)\n`).FindSubmatch(out)
+	xrefOffset, _ := strconv.Atoi(string(startxref[1]))
+	assert.True(t, bytes.HasPrefix(out[xrefOffset:], []byte("xref\n")))
+}
+
+// TestDocument_Bytes_Empty tests that an empty document still has a page
+func TestDocument_Bytes_Empty(t *testing.T) {
+	out := NewDocument().Bytes()
+
+	assert.Contains(t, string(out), "/Count 1")
+}
@@ -20,4 +20,18 @@ type AccountClient interface {
 	DeleteAccount(ctx context.Context, req *protoacc.DeleteAccountRequest) (*protoacc.DeleteAccountResponse, error)
 	ListAccount(ctx context.Context, req *protoacc.ListAccountsRequest) (*protoacc.ListAccountsResponse, error)
 	GetBalance(ctx context.Context, req *protoacc.GetBalanceRequest) (*protoacc.GetBalanceResponse, error)
+	GetAccountJournal(ctx context.Context, req *protoacc.GetAccountJournalRequest) (*protoacc.GetAccountJournalResponse, error)
+	RecomputeAccountBalance(ctx context.Context, req *protoacc.RecomputeAccountBalanceRequest) (*protoacc.RecomputeAccountBalanceResponse, error)
+	SetOverdraftLimit(ctx context.Context, req *protoacc.SetOverdraftLimitRequest) (*protoacc.SetOverdraftLimitResponse, error)
+	UpdateAccountStatus(ctx context.Context, req *protoacc.UpdateAccountStatusRequest) (*protoacc.UpdateAccountStatusResponse, error)
+	PlaceHold(ctx context.Context, req *protoacc.PlaceHoldRequest) (*protoacc.PlaceHoldResponse, error)
+	GetHold(ctx context.Context, req *protoacc.GetHoldRequest) (*protoacc.GetHoldResponse, error)
+	ReleaseHold(ctx context.Context, req *protoacc.ReleaseHoldRequest) (*protoacc.ReleaseHoldResponse, error)
+	ListAccountHolds(ctx context.Context, req *protoacc.ListAccountHoldsRequest) (*protoacc.ListAccountHoldsResponse, error)
+	SetInterestRate(ctx context.Context, req *protoacc.SetInterestRateRequest) (*protoacc.SetInterestRateResponse, error)
+	ListInterestRates(ctx context.Context, req *protoacc.ListInterestRatesRequest) (*protoacc.ListInterestRatesResponse, error)
+	GetAccountInterest(ctx context.Context, req *protoacc.GetAccountInterestRequest) (*protoacc.GetAccountInterestResponse, error)
+	GenerateAccountStatement(ctx context.Context, req *protoacc.GenerateAccountStatementRequest) (*protoacc.GenerateAccountStatementResponse, error)
+	ListAccountStatements(ctx context.Context, req *protoacc.ListAccountStatementsRequest) (*protoacc.ListAccountStatementsResponse, error)
+	GetStatement(ctx context.Context, req *protoacc.GetStatementRequest) (*protoacc.GetStatementResponse, error)
 }
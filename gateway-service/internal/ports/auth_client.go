@@ -11,6 +11,15 @@ type AuthClient interface {
 	Close()
 	IsHealthy() bool
 	Authenticate(ctx context.Context, req *protoauth.AuthenticateRequest) (*protoauth.AuthenticateResponse, error)
+	VerifyMFA(ctx context.Context, req *protoauth.VerifyMFARequest) (*protoauth.VerifyMFAResponse, error)
+	RefreshToken(ctx context.Context, req *protoauth.RefreshTokenRequest) (*protoauth.RefreshTokenResponse, error)
+	Logout(ctx context.Context, req *protoauth.LogoutRequest) (*protoauth.LogoutResponse, error)
+	ListTokenRevocations(ctx context.Context, req *protoauth.ListTokenRevocationsRequest) (*protoauth.ListTokenRevocationsResponse, error)
+	EnrollMFA(ctx context.Context, req *protoauth.EnrollMFARequest) (*protoauth.EnrollMFAResponse, error)
+	ConfirmMFA(ctx context.Context, req *protoauth.ConfirmMFARequest) (*protoauth.ConfirmMFAResponse, error)
+	ResetMFA(ctx context.Context, req *protoauth.ResetMFARequest) (*protoauth.ResetMFAResponse, error)
+	SetMFAPolicy(ctx context.Context, req *protoauth.SetMFAPolicyRequest) (*protoauth.SetMFAPolicyResponse, error)
+	ListMFAPolicies(ctx context.Context, req *protoauth.ListMFAPoliciesRequest) (*protoauth.ListMFAPoliciesResponse, error)
 	CreateEmployee(ctx context.Context, req *protoauth.CreateEmployeeRequest) (*protoauth.CreateEmployeeResponse, error)
 	DeleteEmployee(ctx context.Context, req *protoauth.DeleteEmployeeRequest) (*protoauth.DeleteEmployeeResponse, error)
 	UpdateEmployee(ctx context.Context, req *protoauth.UpdateRoleRequest) (*protoauth.UpdateRoleResponse, error)
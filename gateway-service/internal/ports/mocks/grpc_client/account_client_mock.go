@@ -105,3 +105,115 @@ func (m *MockAccountClient) GetBalance(ctx context.Context, req *protoacc.GetBal
 	}
 	return args.Get(0).(*protoacc.GetBalanceResponse), args.Error(1)
 }
+
+func (m *MockAccountClient) GetAccountJournal(ctx context.Context, req *protoacc.GetAccountJournalRequest) (*protoacc.GetAccountJournalResponse, error) {
+	args := m.Called(ctx, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*protoacc.GetAccountJournalResponse), args.Error(1)
+}
+
+func (m *MockAccountClient) RecomputeAccountBalance(ctx context.Context, req *protoacc.RecomputeAccountBalanceRequest) (*protoacc.RecomputeAccountBalanceResponse, error) {
+	args := m.Called(ctx, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*protoacc.RecomputeAccountBalanceResponse), args.Error(1)
+}
+
+func (m *MockAccountClient) SetOverdraftLimit(ctx context.Context, req *protoacc.SetOverdraftLimitRequest) (*protoacc.SetOverdraftLimitResponse, error) {
+	args := m.Called(ctx, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*protoacc.SetOverdraftLimitResponse), args.Error(1)
+}
+
+func (m *MockAccountClient) UpdateAccountStatus(ctx context.Context, req *protoacc.UpdateAccountStatusRequest) (*protoacc.UpdateAccountStatusResponse, error) {
+	args := m.Called(ctx, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*protoacc.UpdateAccountStatusResponse), args.Error(1)
+}
+
+func (m *MockAccountClient) PlaceHold(ctx context.Context, req *protoacc.PlaceHoldRequest) (*protoacc.PlaceHoldResponse, error) {
+	args := m.Called(ctx, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*protoacc.PlaceHoldResponse), args.Error(1)
+}
+
+func (m *MockAccountClient) GetHold(ctx context.Context, req *protoacc.GetHoldRequest) (*protoacc.GetHoldResponse, error) {
+	args := m.Called(ctx, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*protoacc.GetHoldResponse), args.Error(1)
+}
+
+func (m *MockAccountClient) ReleaseHold(ctx context.Context, req *protoacc.ReleaseHoldRequest) (*protoacc.ReleaseHoldResponse, error) {
+	args := m.Called(ctx, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*protoacc.ReleaseHoldResponse), args.Error(1)
+}
+
+func (m *MockAccountClient) ListAccountHolds(ctx context.Context, req *protoacc.ListAccountHoldsRequest) (*protoacc.ListAccountHoldsResponse, error) {
+	args := m.Called(ctx, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*protoacc.ListAccountHoldsResponse), args.Error(1)
+}
+
+func (m *MockAccountClient) SetInterestRate(ctx context.Context, req *protoacc.SetInterestRateRequest) (*protoacc.SetInterestRateResponse, error) {
+	args := m.Called(ctx, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*protoacc.SetInterestRateResponse), args.Error(1)
+}
+
+func (m *MockAccountClient) ListInterestRates(ctx context.Context, req *protoacc.ListInterestRatesRequest) (*protoacc.ListInterestRatesResponse, error) {
+	args := m.Called(ctx, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*protoacc.ListInterestRatesResponse), args.Error(1)
+}
+
+func (m *MockAccountClient) GetAccountInterest(ctx context.Context, req *protoacc.GetAccountInterestRequest) (*protoacc.GetAccountInterestResponse, error) {
+	args := m.Called(ctx, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*protoacc.GetAccountInterestResponse), args.Error(1)
+}
+
+func (m *MockAccountClient) GenerateAccountStatement(ctx context.Context, req *protoacc.GenerateAccountStatementRequest) (*protoacc.GenerateAccountStatementResponse, error) {
+	args := m.Called(ctx, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*protoacc.GenerateAccountStatementResponse), args.Error(1)
+}
+
+func (m *MockAccountClient) ListAccountStatements(ctx context.Context, req *protoacc.ListAccountStatementsRequest) (*protoacc.ListAccountStatementsResponse, error) {
+	args := m.Called(ctx, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*protoacc.ListAccountStatementsResponse), args.Error(1)
+}
+
+func (m *MockAccountClient) GetStatement(ctx context.Context, req *protoacc.GetStatementRequest) (*protoacc.GetStatementResponse, error) {
+	args := m.Called(ctx, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*protoacc.GetStatementResponse), args.Error(1)
+}
@@ -37,6 +37,78 @@ func (m *MockAuthClient) Authenticate(ctx context.Context, req *protoauth.Authen
 	return args.Get(0).(*protoauth.AuthenticateResponse), args.Error(1)
 }
 
+func (m *MockAuthClient) VerifyMFA(ctx context.Context, req *protoauth.VerifyMFARequest) (*protoauth.VerifyMFAResponse, error) {
+	args := m.Called(ctx, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*protoauth.VerifyMFAResponse), args.Error(1)
+}
+
+func (m *MockAuthClient) RefreshToken(ctx context.Context, req *protoauth.RefreshTokenRequest) (*protoauth.RefreshTokenResponse, error) {
+	args := m.Called(ctx, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*protoauth.RefreshTokenResponse), args.Error(1)
+}
+
+func (m *MockAuthClient) Logout(ctx context.Context, req *protoauth.LogoutRequest) (*protoauth.LogoutResponse, error) {
+	args := m.Called(ctx, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*protoauth.LogoutResponse), args.Error(1)
+}
+
+func (m *MockAuthClient) ListTokenRevocations(ctx context.Context, req *protoauth.ListTokenRevocationsRequest) (*protoauth.ListTokenRevocationsResponse, error) {
+	args := m.Called(ctx, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*protoauth.ListTokenRevocationsResponse), args.Error(1)
+}
+
+func (m *MockAuthClient) EnrollMFA(ctx context.Context, req *protoauth.EnrollMFARequest) (*protoauth.EnrollMFAResponse, error) {
+	args := m.Called(ctx, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*protoauth.EnrollMFAResponse), args.Error(1)
+}
+
+func (m *MockAuthClient) ConfirmMFA(ctx context.Context, req *protoauth.ConfirmMFARequest) (*protoauth.ConfirmMFAResponse, error) {
+	args := m.Called(ctx, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*protoauth.ConfirmMFAResponse), args.Error(1)
+}
+
+func (m *MockAuthClient) ResetMFA(ctx context.Context, req *protoauth.ResetMFARequest) (*protoauth.ResetMFAResponse, error) {
+	args := m.Called(ctx, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*protoauth.ResetMFAResponse), args.Error(1)
+}
+
+func (m *MockAuthClient) SetMFAPolicy(ctx context.Context, req *protoauth.SetMFAPolicyRequest) (*protoauth.SetMFAPolicyResponse, error) {
+	args := m.Called(ctx, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*protoauth.SetMFAPolicyResponse), args.Error(1)
+}
+
+func (m *MockAuthClient) ListMFAPolicies(ctx context.Context, req *protoauth.ListMFAPoliciesRequest) (*protoauth.ListMFAPoliciesResponse, error) {
+	args := m.Called(ctx, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*protoauth.ListMFAPoliciesResponse), args.Error(1)
+}
+
 func (m *MockAuthClient) CreateEmployee(ctx context.Context, req *protoauth.CreateEmployeeRequest) (*protoauth.CreateEmployeeResponse, error) {
 	args := m.Called(ctx, req)
 	if args.Get(0) == nil {
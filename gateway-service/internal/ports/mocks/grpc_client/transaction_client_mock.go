@@ -48,3 +48,152 @@ func (m *MockTransactionClient) GetTransactionHistory(ctx context.Context, req *
 	}
 	return args.Get(0).(*prototx.GetTransactionHistoryResponse), args.Error(1)
 }
+
+// ExportTransactionHistory hands the chunks of the first return value to fn and then returns the second one
+func (m *MockTransactionClient) ExportTransactionHistory(ctx context.Context, req *prototx.ExportTransactionHistoryRequest, fn func(*prototx.ExportTransactionHistoryResponse) error) error {
+	args := m.Called(ctx, req)
+	if chunks, ok := args.Get(0).([]*prototx.ExportTransactionHistoryResponse); ok {
+		for _, chunk := range chunks {
+			if err := fn(chunk); err != nil {
+				return err
+			}
+		}
+	}
+	return args.Error(1)
+}
+
+func (m *MockTransactionClient) ReverseTransaction(ctx context.Context, req *prototx.ReverseTransactionRequest) (*prototx.ReverseTransactionResponse, error) {
+	args := m.Called(ctx, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*prototx.ReverseTransactionResponse), args.Error(1)
+}
+
+func (m *MockTransactionClient) CaptureHold(ctx context.Context, req *prototx.CaptureHoldRequest) (*prototx.CaptureHoldResponse, error) {
+	args := m.Called(ctx, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*prototx.CaptureHoldResponse), args.Error(1)
+}
+
+func (m *MockTransactionClient) CloseAccount(ctx context.Context, req *prototx.CloseAccountRequest) (*prototx.CloseAccountResponse, error) {
+	args := m.Called(ctx, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*prototx.CloseAccountResponse), args.Error(1)
+}
+
+func (m *MockTransactionClient) SetExchangeRate(ctx context.Context, req *prototx.SetExchangeRateRequest) (*prototx.SetExchangeRateResponse, error) {
+	args := m.Called(ctx, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*prototx.SetExchangeRateResponse), args.Error(1)
+}
+
+func (m *MockTransactionClient) ListExchangeRates(ctx context.Context, req *prototx.ListExchangeRatesRequest) (*prototx.ListExchangeRatesResponse, error) {
+	args := m.Called(ctx, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*prototx.ListExchangeRatesResponse), args.Error(1)
+}
+
+func (m *MockTransactionClient) GetTransaction(ctx context.Context, req *prototx.GetTransactionRequest) (*prototx.GetTransactionResponse, error) {
+	args := m.Called(ctx, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*prototx.GetTransactionResponse), args.Error(1)
+}
+
+func (m *MockTransactionClient) CreateStandingOrder(ctx context.Context, req *prototx.CreateStandingOrderRequest) (*prototx.CreateStandingOrderResponse, error) {
+	args := m.Called(ctx, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*prototx.CreateStandingOrderResponse), args.Error(1)
+}
+
+func (m *MockTransactionClient) GetStandingOrder(ctx context.Context, req *prototx.GetStandingOrderRequest) (*prototx.GetStandingOrderResponse, error) {
+	args := m.Called(ctx, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*prototx.GetStandingOrderResponse), args.Error(1)
+}
+
+func (m *MockTransactionClient) ListStandingOrders(ctx context.Context, req *prototx.ListStandingOrdersRequest) (*prototx.ListStandingOrdersResponse, error) {
+	args := m.Called(ctx, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*prototx.ListStandingOrdersResponse), args.Error(1)
+}
+
+func (m *MockTransactionClient) UpdateStandingOrderStatus(ctx context.Context, req *prototx.UpdateStandingOrderStatusRequest) (*prototx.UpdateStandingOrderStatusResponse, error) {
+	args := m.Called(ctx, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*prototx.UpdateStandingOrderStatusResponse), args.Error(1)
+}
+
+func (m *MockTransactionClient) CreateTransactionBatch(ctx context.Context, req *prototx.CreateTransactionBatchRequest) (*prototx.CreateTransactionBatchResponse, error) {
+	args := m.Called(ctx, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*prototx.CreateTransactionBatchResponse), args.Error(1)
+}
+
+func (m *MockTransactionClient) GetTransactionBatch(ctx context.Context, req *prototx.GetTransactionBatchRequest) (*prototx.GetTransactionBatchResponse, error) {
+	args := m.Called(ctx, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*prototx.GetTransactionBatchResponse), args.Error(1)
+}
+
+func (m *MockTransactionClient) SetTransactionLimit(ctx context.Context, req *prototx.SetTransactionLimitRequest) (*prototx.SetTransactionLimitResponse, error) {
+	args := m.Called(ctx, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*prototx.SetTransactionLimitResponse), args.Error(1)
+}
+
+func (m *MockTransactionClient) ListTransactionLimits(ctx context.Context, req *prototx.ListTransactionLimitsRequest) (*prototx.ListTransactionLimitsResponse, error) {
+	args := m.Called(ctx, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*prototx.ListTransactionLimitsResponse), args.Error(1)
+}
+
+func (m *MockTransactionClient) SetFeeRule(ctx context.Context, req *prototx.SetFeeRuleRequest) (*prototx.SetFeeRuleResponse, error) {
+	args := m.Called(ctx, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*prototx.SetFeeRuleResponse), args.Error(1)
+}
+
+func (m *MockTransactionClient) ListFeeRules(ctx context.Context, req *prototx.ListFeeRulesRequest) (*prototx.ListFeeRulesResponse, error) {
+	args := m.Called(ctx, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*prototx.ListFeeRulesResponse), args.Error(1)
+}
+
+func (m *MockTransactionClient) DeleteFeeRule(ctx context.Context, req *prototx.DeleteFeeRuleRequest) (*prototx.DeleteFeeRuleResponse, error) {
+	args := m.Called(ctx, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*prototx.DeleteFeeRuleResponse), args.Error(1)
+}
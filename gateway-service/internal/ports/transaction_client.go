@@ -12,5 +12,23 @@ type TransactionClient interface {
 	StartConnectionMonitor(ctx context.Context)
 	IsHealthy() bool
 	InitTransaction(ctx context.Context, req *prototx.InitTransactionRequest) (*prototx.InitTransactionResponse, error)
+	GetTransaction(ctx context.Context, req *prototx.GetTransactionRequest) (*prototx.GetTransactionResponse, error)
 	GetTransactionHistory(ctx context.Context, req *prototx.GetTransactionHistoryRequest) (*prototx.GetTransactionHistoryResponse, error)
+	ExportTransactionHistory(ctx context.Context, req *prototx.ExportTransactionHistoryRequest, fn func(*prototx.ExportTransactionHistoryResponse) error) error
+	ReverseTransaction(ctx context.Context, req *prototx.ReverseTransactionRequest) (*prototx.ReverseTransactionResponse, error)
+	CaptureHold(ctx context.Context, req *prototx.CaptureHoldRequest) (*prototx.CaptureHoldResponse, error)
+	CloseAccount(ctx context.Context, req *prototx.CloseAccountRequest) (*prototx.CloseAccountResponse, error)
+	SetExchangeRate(ctx context.Context, req *prototx.SetExchangeRateRequest) (*prototx.SetExchangeRateResponse, error)
+	ListExchangeRates(ctx context.Context, req *prototx.ListExchangeRatesRequest) (*prototx.ListExchangeRatesResponse, error)
+	CreateStandingOrder(ctx context.Context, req *prototx.CreateStandingOrderRequest) (*prototx.CreateStandingOrderResponse, error)
+	GetStandingOrder(ctx context.Context, req *prototx.GetStandingOrderRequest) (*prototx.GetStandingOrderResponse, error)
+	ListStandingOrders(ctx context.Context, req *prototx.ListStandingOrdersRequest) (*prototx.ListStandingOrdersResponse, error)
+	UpdateStandingOrderStatus(ctx context.Context, req *prototx.UpdateStandingOrderStatusRequest) (*prototx.UpdateStandingOrderStatusResponse, error)
+	CreateTransactionBatch(ctx context.Context, req *prototx.CreateTransactionBatchRequest) (*prototx.CreateTransactionBatchResponse, error)
+	GetTransactionBatch(ctx context.Context, req *prototx.GetTransactionBatchRequest) (*prototx.GetTransactionBatchResponse, error)
+	SetTransactionLimit(ctx context.Context, req *prototx.SetTransactionLimitRequest) (*prototx.SetTransactionLimitResponse, error)
+	ListTransactionLimits(ctx context.Context, req *prototx.ListTransactionLimitsRequest) (*prototx.ListTransactionLimitsResponse, error)
+	SetFeeRule(ctx context.Context, req *prototx.SetFeeRuleRequest) (*prototx.SetFeeRuleResponse, error)
+	ListFeeRules(ctx context.Context, req *prototx.ListFeeRulesRequest) (*prototx.ListFeeRulesResponse, error)
+	DeleteFeeRule(ctx context.Context, req *prototx.DeleteFeeRuleRequest) (*prototx.DeleteFeeRuleResponse, error)
 }
@@ -9,6 +9,7 @@ import (
 	"time"
 	"transaction-service/internal/adapter/grpc/clients"
 	repo "transaction-service/internal/adapter/repo/sqlite"
+	"transaction-service/internal/app"
 	"transaction-service/internal/config"
 	"transaction-service/internal/db"
 	"transaction-service/internal/grpc"
@@ -65,6 +66,11 @@ func main() {
 	transactionRepo := repo.NewTransactionRepo(dbInstance)
 	sagaRepo := repo.NewSagaRepo(dbInstance)
 	eventRepo := repo.NewEventRepo(dbInstance)
+	exchangeRateRepo := repo.NewExchangeRateRepo(dbInstance)
+	standingOrderRepo := repo.NewStandingOrderRepo(dbInstance)
+	batchRepo := repo.NewTransactionBatchRepo(dbInstance)
+	limitRepo := repo.NewTransactionLimitRepo(dbInstance)
+	feeRuleRepo := repo.NewFeeRuleRepo(dbInstance)
 
 	// Initiating message queues
 	messaging.SetupMessaging()
@@ -80,22 +86,69 @@ func main() {
 	ctx, stop := runtime.SignalContext(ctx)
 	defer stop()
 
+	// Worker pool for asynchronous transactions
+	workerPool := jobs.NewTransactionWorkerPool(config.Current().Worker.Count, config.Current().Worker.QueueSize)
+	workerPool.Start(ctx)
+	defer func() {
+		// let queued work stop and in-flight sagas finish before closing clients
+		cancel()
+		workerPool.Wait()
+	}()
+
+	// Resume batches interrupted by a restart before new batches are accepted; lines already run keep their outcome
+	batchProcessor := app.NewProcessTransactionBatch(batchRepo, transactionRepo, accountClient, sagaRepo, eventRepo, exchangeRateRepo, limitRepo, feeRuleRepo)
+	if err := batchProcessor.Resume(workerPool); err != nil {
+		logging.Logger.Error().Err(err).Msg("failed to resume transaction batches")
+	}
+
+	// Queue transactions accepted before a restart again; the worker claim keeps each from running twice
+	if err := app.NewInitTransaction(transactionRepo, accountClient, sagaRepo, eventRepo, exchangeRateRepo, limitRepo, feeRuleRepo, workerPool).Resume(); err != nil {
+		logging.Logger.Error().Err(err).Msg("failed to resume queued transactions")
+	}
+
 	go grpc.StartGRPCServer(ctx, grpc.ServiceRepos{
-		AccountClient:   accountClient,
-		SagaRepo:        sagaRepo,
-		TransactionRepo: transactionRepo,
-		EventRepo:       eventRepo,
+		AccountClient:     accountClient,
+		SagaRepo:          sagaRepo,
+		TransactionRepo:   transactionRepo,
+		EventRepo:         eventRepo,
+		ExchangeRateRepo:  exchangeRateRepo,
+		TransactionQueue:  workerPool,
+		StandingOrderRepo: standingOrderRepo,
+		BatchRepo:         batchRepo,
+		LimitRepo:         limitRepo,
+		FeeRuleRepo:       feeRuleRepo,
 	})
 
 	recoveryJob := jobs.NewTransactionReconciliationJob(
 		transactionRepo,
 		accountClient,
 		sagaRepo,
+		eventRepo,
+		exchangeRateRepo,
 	)
 
 	// Start recovery job
 	go recoveryJob.Start(ctx)
 
+	// Start standing order job; due orders go through the same use-case as API initiated transactions
+	if config.Current().StandingOrder.Enabled {
+		standingOrderJob := jobs.NewStandingOrderJob(
+			standingOrderRepo,
+			accountClient,
+			app.NewInitTransaction(transactionRepo, accountClient, sagaRepo, eventRepo, exchangeRateRepo, limitRepo, feeRuleRepo, workerPool),
+		)
+		go standingOrderJob.Start(ctx)
+	}
+
+	// Start interest posting job; the account service closes the postings at the end of each month
+	if config.Current().InterestPosting.Enabled {
+		interestPostingJob := jobs.NewInterestPostingJob(
+			accountClient,
+			app.NewPostInterest(transactionRepo, accountClient, sagaRepo, eventRepo, exchangeRateRepo),
+		)
+		go interestPostingJob.Start(ctx)
+	}
+
 	go accountClient.StartConnectionMonitor(ctx)
 
 	// Listener for test
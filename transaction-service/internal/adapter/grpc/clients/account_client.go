@@ -10,6 +10,7 @@ import (
 	"time"
 	protoacc "transaction-service/api/protogen/accountservice/proto"
 	"transaction-service/internal/config"
+	"transaction-service/internal/domain/money"
 	"transaction-service/internal/logging"
 	"transaction-service/internal/ports"
 )
@@ -108,11 +109,35 @@ func (c *GRPCAccountClient) ValidateAndGetAccounts(ctx context.Context, accountI
 
 	var accounts []ports.AccountInfo
 	for _, account := range resp.Accounts {
+		balance, err := money.Parse(account.Balance)
+		if err != nil {
+			return nil, "invalid account balance received", fmt.Errorf("invalid balance for account %s: %w", account.Id, err)
+		}
+		overdraftLimit := money.Zero
+		if account.OverdraftLimit != "" {
+			overdraftLimit, err = money.Parse(account.OverdraftLimit)
+			if err != nil {
+				return nil, "invalid account overdraft limit received", fmt.Errorf("invalid overdraft limit for account %s: %w", account.Id, err)
+			}
+		}
+		heldAmount := money.Zero
+		if account.HeldAmount != "" {
+			heldAmount, err = money.Parse(account.HeldAmount)
+			if err != nil {
+				return nil, "invalid account held amount received", fmt.Errorf("invalid held amount for account %s: %w", account.Id, err)
+			}
+		}
 		accounts = append(accounts, ports.AccountInfo{
-			AccountID:  account.Id,
-			CustomerID: account.CustomerId,
-			Balance:    account.Balance,
-			Version:    int(account.Version),
+			AccountID:      account.Id,
+			CustomerID:     account.CustomerId,
+			Balance:        balance,
+			OverdraftLimit: overdraftLimit,
+			HeldAmount:     heldAmount,
+			Currency:       account.Currency,
+			AccountType:    account.AccountType,
+			ActiveStatus:   account.ActiveStatus,
+			FreezeMode:     account.FreezeMode,
+			Version:        int(account.Version),
 		})
 	}
 
@@ -184,7 +209,7 @@ func (c *GRPCAccountClient) UnlockAccounts(ctx context.Context, transactionID st
 	return "", nil
 }
 
-func (c *GRPCAccountClient) UpdateAccountsBalance(ctx context.Context, updates []ports.AccountBalanceUpdate, requester, requestId string) ([]ports.AccountBalanceUpdateResponse, string, error) {
+func (c *GRPCAccountClient) UpdateAccountsBalance(ctx context.Context, transactionID string, updates []ports.AccountBalanceUpdate, compensation bool, requester, requestId string) ([]ports.AccountBalanceUpdateResponse, string, error) {
 	if c.IsHealthy() == false {
 		return nil, "connection failed", fmt.Errorf("connection failed")
 	}
@@ -199,14 +224,21 @@ func (c *GRPCAccountClient) UpdateAccountsBalance(ctx context.Context, updates [
 	protoUpdates := make([]*protoacc.AccountBalanceUpdate, len(updates))
 	for i, update := range updates {
 		protoUpdates[i] = &protoacc.AccountBalanceUpdate{
-			AccountId:  update.AccountID,
-			NewBalance: update.NewBalance,
-			Version:    int32(update.Version),
+			AccountId:         update.AccountID,
+			NewBalance:        update.NewBalance.String(),
+			Version:           int32(update.Version),
+			HoldId:            update.HoldID,
+			InterestPostingId: update.InterestPostingID,
+		}
+		if update.HoldID != "" {
+			protoUpdates[i].HoldCaptureAmount = update.HoldCaptureAmount.String()
 		}
 	}
 
 	req := &protoacc.UpdateAccountsBalanceRequest{
-		Updates: protoUpdates,
+		Updates:       protoUpdates,
+		TransactionId: transactionID,
+		Compensation:  compensation,
 		Metadata: &protoacc.Metadata{
 			RequestId: requester,
 			Requester: requestId,
@@ -232,7 +264,7 @@ func (c *GRPCAccountClient) UpdateAccountsBalance(ctx context.Context, updates [
 	return accountVersionResponse, "", nil
 }
 
-func (c *GRPCAccountClient) GetBalance(ctx context.Context, accountID string) (float64, int, error) {
+func (c *GRPCAccountClient) GetBalance(ctx context.Context, accountID string) (money.Amount, int, error) {
 	if c.IsHealthy() == false {
 		return 0, 0, fmt.Errorf("connection failed")
 	}
@@ -253,7 +285,195 @@ func (c *GRPCAccountClient) GetBalance(ctx context.Context, accountID string) (f
 		return 0, 0, fmt.Errorf("get balance RPC failed: %w", err)
 	}
 
-	return resp.Balance, int(resp.Version), nil
+	balance, err := money.Parse(resp.Balance)
+	if err != nil {
+		return 0, 0, fmt.Errorf("invalid balance received: %w", err)
+	}
+
+	return balance, int(resp.Version), nil
+}
+
+func (c *GRPCAccountClient) GetTransactionJournalStatus(ctx context.Context, transactionID string, requester, requestId string) (*ports.TransactionJournalStatus, string, error) {
+	if c.IsHealthy() == false {
+		return nil, "connection failed", fmt.Errorf("connection failed")
+	}
+
+	c.mutex.RLock()
+	client := c.client
+	c.mutex.RUnlock()
+
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+
+	req := &protoacc.GetTransactionJournalStatusRequest{
+		TransactionId: transactionID,
+		Metadata: &protoacc.Metadata{
+			RequestId: requestId,
+			Requester: requester,
+		},
+	}
+
+	resp, err := client.GetTransactionJournalStatus(ctx, req)
+	if err != nil {
+		return nil, "get transaction journal status RPC failed", err
+	}
+
+	if !resp.GetResponse().GetSuccess() {
+		return nil, resp.GetResponse().GetMessage(), fmt.Errorf("get transaction journal status failed")
+	}
+
+	status := &ports.TransactionJournalStatus{
+		BalanceApplied: resp.BalanceApplied,
+		Compensated:    resp.Compensated,
+	}
+	for _, change := range resp.Changes {
+		delta, err := money.Parse(change.Delta)
+		if err != nil {
+			return nil, "invalid balance change received", fmt.Errorf("invalid balance change received: %w", err)
+		}
+		status.Changes = append(status.Changes, ports.AccountBalanceChange{
+			AccountID: change.AccountId,
+			Currency:  change.Currency,
+			Delta:     delta,
+		})
+	}
+	return status, resp.GetResponse().GetMessage(), nil
+}
+
+func (c *GRPCAccountClient) GetHold(ctx context.Context, holdID string, requester, requestId string) (*ports.HoldInfo, string, error) {
+	if c.IsHealthy() == false {
+		return nil, "connection failed", fmt.Errorf("connection failed")
+	}
+
+	c.mutex.RLock()
+	client := c.client
+	c.mutex.RUnlock()
+
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+
+	req := &protoacc.GetHoldRequest{
+		HoldId: holdID,
+		Metadata: &protoacc.Metadata{
+			RequestId: requestId,
+			Requester: requester,
+		},
+	}
+
+	resp, err := client.GetHold(ctx, req)
+	if err != nil {
+		return nil, "get hold RPC failed", err
+	}
+
+	if !resp.GetResponse().GetSuccess() || resp.Hold == nil {
+		return nil, resp.GetResponse().GetMessage(), fmt.Errorf("get hold failed")
+	}
+
+	amount, err := money.Parse(resp.Hold.Amount)
+	if err != nil {
+		return nil, "invalid hold amount received", fmt.Errorf("invalid amount for hold %s: %w", resp.Hold.Id, err)
+	}
+
+	return &ports.HoldInfo{
+		HoldID:    resp.Hold.Id,
+		AccountID: resp.Hold.AccountId,
+		Amount:    amount,
+		Currency:  resp.Hold.Currency,
+		Status:    resp.Hold.Status,
+		ExpiresAt: resp.Hold.ExpiresAt.AsTime(),
+	}, resp.GetResponse().GetMessage(), nil
+}
+
+func (c *GRPCAccountClient) ListPendingInterestPostings(ctx context.Context, limit int, requester, requestId string) ([]ports.InterestPostingInfo, string, error) {
+	if c.IsHealthy() == false {
+		return nil, "connection failed", fmt.Errorf("connection failed")
+	}
+
+	c.mutex.RLock()
+	client := c.client
+	c.mutex.RUnlock()
+
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+
+	req := &protoacc.ListPendingInterestPostingsRequest{
+		Limit: int32(limit),
+		Metadata: &protoacc.Metadata{
+			RequestId: requestId,
+			Requester: requester,
+		},
+	}
+
+	resp, err := client.ListPendingInterestPostings(ctx, req)
+	if err != nil {
+		return nil, "list pending interest postings RPC failed", err
+	}
+
+	if !resp.GetResponse().GetSuccess() {
+		return nil, resp.GetResponse().GetMessage(), fmt.Errorf("list pending interest postings failed")
+	}
+
+	postings, msg, err := parseInterestPostings(resp.Postings)
+	if err != nil {
+		return nil, msg, err
+	}
+
+	return postings, resp.GetResponse().GetMessage(), nil
+}
+
+func (c *GRPCAccountClient) SettleAccountInterest(ctx context.Context, accountID, requester, requestId string) ([]ports.InterestPostingInfo, string, error) {
+	if c.IsHealthy() == false {
+		return nil, "connection failed", fmt.Errorf("connection failed")
+	}
+
+	c.mutex.RLock()
+	client := c.client
+	c.mutex.RUnlock()
+
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+
+	req := &protoacc.SettleAccountInterestRequest{
+		AccountId: accountID,
+		Metadata: &protoacc.Metadata{
+			RequestId: requestId,
+			Requester: requester,
+		},
+	}
+
+	resp, err := client.SettleAccountInterest(ctx, req)
+	if err != nil {
+		return nil, "settle account interest RPC failed", err
+	}
+
+	if !resp.GetResponse().GetSuccess() {
+		return nil, resp.GetResponse().GetMessage(), fmt.Errorf("settle account interest failed")
+	}
+
+	postings, msg, err := parseInterestPostings(resp.Postings)
+	if err != nil {
+		return nil, msg, err
+	}
+
+	return postings, resp.GetResponse().GetMessage(), nil
+}
+
+func parseInterestPostings(protoPostings []*protoacc.InterestPosting) ([]ports.InterestPostingInfo, string, error) {
+	postings := make([]ports.InterestPostingInfo, 0, len(protoPostings))
+	for _, posting := range protoPostings {
+		amount, err := money.Parse(posting.Amount)
+		if err != nil {
+			return nil, "invalid interest posting amount received", fmt.Errorf("invalid amount for interest posting %s: %w", posting.Id, err)
+		}
+		postings = append(postings, ports.InterestPostingInfo{
+			PostingID: posting.Id,
+			AccountID: posting.AccountId,
+			Period:    posting.Period,
+			Amount:    amount,
+			Currency:  posting.Currency,
+		})
+	}
+	return postings, "", nil
 }
 
 func (c *GRPCAccountClient) IsHealthy() bool {
@@ -291,3 +511,38 @@ func (c *GRPCAccountClient) StartConnectionMonitor(ctx context.Context) {
 		}
 	}
 }
+
+func (c *GRPCAccountClient) CloseAccount(ctx context.Context, accountID, payoutAccountID, payoutTransactionID, reason, requester, requestId string) (string, error) {
+	if c.IsHealthy() == false {
+		return "connection failed", fmt.Errorf("connection failed")
+	}
+
+	c.mutex.RLock()
+	client := c.client
+	c.mutex.RUnlock()
+
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+
+	req := &protoacc.CloseAccountRequest{
+		AccountId:           accountID,
+		PayoutAccountId:     payoutAccountID,
+		PayoutTransactionId: payoutTransactionID,
+		Reason:              reason,
+		Metadata: &protoacc.Metadata{
+			RequestId: requestId,
+			Requester: requester,
+		},
+	}
+
+	resp, err := client.CloseAccount(ctx, req)
+	if err != nil {
+		return "close account RPC failed", err
+	}
+
+	if !resp.GetResponse().GetSuccess() {
+		return resp.GetResponse().GetMessage(), fmt.Errorf("close account failed")
+	}
+
+	return resp.GetResponse().GetMessage(), nil
+}
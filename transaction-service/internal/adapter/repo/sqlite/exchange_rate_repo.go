@@ -0,0 +1,45 @@
+package sqlite
+
+import (
+	"errors"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"sync"
+	"transaction-service/internal/domain/entity"
+	"transaction-service/internal/ports"
+)
+
+type ExchangeRateRepo struct {
+	DB *gorm.DB
+	mu sync.RWMutex
+}
+
+func NewExchangeRateRepo(db *gorm.DB) ports.ExchangeRateRepo {
+	return &ExchangeRateRepo{DB: db}
+}
+
+// UpsertExchangeRate creates the rate for a currency pair or replaces the existing one
+func (r *ExchangeRateRepo) UpsertExchangeRate(rate *entity.ExchangeRate) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return r.DB.Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "base_currency"}, {Name: "quote_currency"}},
+		DoUpdates: clause.AssignmentColumns([]string{"rate", "updated_by", "updated_at"}),
+	}).Create(rate).Error
+}
+
+func (r *ExchangeRateRepo) GetExchangeRate(baseCurrency, quoteCurrency string) (*entity.ExchangeRate, error) {
+	var rate entity.ExchangeRate
+	err := r.DB.Where("base_currency = ? AND quote_currency = ?", baseCurrency, quoteCurrency).First(&rate).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	return &rate, err
+}
+
+func (r *ExchangeRateRepo) ListExchangeRates() ([]*entity.ExchangeRate, error) {
+	var rates []*entity.ExchangeRate
+	err := r.DB.Order("base_currency ASC, quote_currency ASC").Find(&rates).Error
+	return rates, err
+}
@@ -0,0 +1,75 @@
+package sqlite
+
+import (
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"sync"
+	"transaction-service/internal/domain/entity"
+	custom_err "transaction-service/internal/domain/error"
+	"transaction-service/internal/ports"
+)
+
+type FeeRuleRepo struct {
+	DB *gorm.DB
+	mu sync.RWMutex
+}
+
+func NewFeeRuleRepo(db *gorm.DB) ports.FeeRuleRepo {
+	return &FeeRuleRepo{DB: db}
+}
+
+// UpsertFeeRule creates the rule for a transaction type, account type and band start or replaces the existing one.
+// The rule is reloaded afterwards so it carries the ID of the stored rule.
+func (r *FeeRuleRepo) UpsertFeeRule(rule *entity.FeeRule) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return r.DB.Transaction(func(tx *gorm.DB) error {
+		err := tx.Clauses(clause.OnConflict{
+			Columns: []clause.Column{{Name: "transaction_type"}, {Name: "account_type"}, {Name: "min_amount"}},
+			DoUpdates: clause.AssignmentColumns([]string{
+				"max_amount",
+				"fee_type",
+				"flat_amount",
+				"percentage",
+				"min_fee",
+				"max_fee",
+				"updated_by",
+				"updated_at",
+			}),
+		}).Create(rule).Error
+		if err != nil {
+			return err
+		}
+		return tx.
+			Where("transaction_type = ? AND account_type = ? AND min_amount = ?", rule.TransactionType, rule.AccountType, rule.MinAmount).
+			First(rule).Error
+	})
+}
+
+// GetFeeRules gets the rules of a transaction type
+func (r *FeeRuleRepo) GetFeeRules(transactionType string) ([]*entity.FeeRule, error) {
+	var rules []*entity.FeeRule
+	err := r.DB.Where("transaction_type = ?", transactionType).Find(&rules).Error
+	return rules, err
+}
+
+func (r *FeeRuleRepo) ListFeeRules() ([]*entity.FeeRule, error) {
+	var rules []*entity.FeeRule
+	err := r.DB.Order("transaction_type ASC, account_type ASC, min_amount ASC").Find(&rules).Error
+	return rules, err
+}
+
+func (r *FeeRuleRepo) DeleteFeeRule(id string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	result := r.DB.Where("id = ?", id).Delete(&entity.FeeRule{})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return custom_err.ErrFeeRuleNotFound
+	}
+	return nil
+}
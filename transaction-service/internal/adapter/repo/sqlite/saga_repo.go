@@ -22,7 +22,12 @@ func (r *SagaRepo) CreateSaga(saga *entity.TransactionSaga) error {
 	r.mu.Lock()
 	defer r.mu.Unlock()
 
-	return r.DB.Create(saga).Error
+	return r.DB.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(saga).Error; err != nil {
+			return err
+		}
+		return tx.Create(entity.NewTransactionSagaStepLog(saga)).Error
+	})
 }
 
 func (r *SagaRepo) GetSagaByID(id string) (*entity.TransactionSaga, error) {
@@ -48,26 +53,37 @@ func (r *SagaRepo) UpdateSaga(saga *entity.TransactionSaga) error {
 	defer r.mu.Unlock()
 
 	currentVersion := saga.Version
-	result := r.DB.Model(saga).
-		Where("id = ? AND version = ?", saga.ID, currentVersion).
-		Updates(map[string]interface{}{
-			"current_state":         saga.CurrentState,
-			"current_step":          saga.CurrentStep,
-			"compensation_required": saga.CompensationRequired,
-			"compensation_reason":   saga.CompensationReason,
-			"retry_count":           saga.RetryCount,
-			"last_retry_at":         saga.LastRetryAt,
-			"next_retry_at":         saga.NextRetryAt,
-			"updated_at":            time.Now(),
-			"version":               currentVersion + 1,
-		})
-
-	if result.Error != nil {
-		return result.Error
-	}
+	err := r.DB.Transaction(func(tx *gorm.DB) error {
+		result := tx.Model(saga).
+			Where("id = ? AND version = ?", saga.ID, currentVersion).
+			Updates(map[string]interface{}{
+				"current_state":         saga.CurrentState,
+				"current_step":          saga.CurrentStep,
+				"compensation_required": saga.CompensationRequired,
+				"compensation_reason":   saga.CompensationReason,
+				"destination_amount":    saga.DestinationAmount,
+				"exchange_rate":         saga.ExchangeRate,
+				"retry_count":           saga.RetryCount,
+				"last_retry_at":         saga.LastRetryAt,
+				"next_retry_at":         saga.NextRetryAt,
+				"updated_at":            time.Now(),
+				"version":               currentVersion + 1,
+			})
+
+		if result.Error != nil {
+			return result.Error
+		}
+
+		if result.RowsAffected == 0 {
+			return errors.New("concurrent modification detected")
+		}
 
-	if result.RowsAffected == 0 {
-		return errors.New("concurrent modification detected")
+		stepLog := entity.NewTransactionSagaStepLog(saga)
+		stepLog.SagaVersion = currentVersion + 1
+		return tx.Create(stepLog).Error
+	})
+	if err != nil {
+		return err
 	}
 
 	saga.Version = currentVersion + 1
@@ -99,3 +115,9 @@ func (r *SagaRepo) GetSagasByState(state string) ([]*entity.TransactionSaga, err
 	err := r.DB.Where("current_state = ?", state).Find(&sagas).Error
 	return sagas, err
 }
+
+func (r *SagaRepo) GetSagaStepLogs(sagaID string) ([]*entity.TransactionSagaStepLog, error) {
+	var stepLogs []*entity.TransactionSagaStepLog
+	err := r.DB.Where("saga_id = ?", sagaID).Order("saga_version ASC, created_at ASC").Find(&stepLogs).Error
+	return stepLogs, err
+}
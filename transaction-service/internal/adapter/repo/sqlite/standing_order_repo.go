@@ -0,0 +1,107 @@
+package sqlite
+
+import (
+	"errors"
+	"gorm.io/gorm"
+	"sync"
+	"time"
+	"transaction-service/internal/domain/entity"
+	"transaction-service/internal/ports"
+)
+
+type StandingOrderRepo struct {
+	DB *gorm.DB
+	mu sync.RWMutex
+}
+
+func NewStandingOrderRepo(db *gorm.DB) ports.StandingOrderRepo {
+	return &StandingOrderRepo{DB: db}
+}
+
+func (r *StandingOrderRepo) CreateStandingOrder(order *entity.StandingOrder) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return r.DB.Create(order).Error
+}
+
+func (r *StandingOrderRepo) GetStandingOrderByID(id string) (*entity.StandingOrder, error) {
+	var order entity.StandingOrder
+	err := r.DB.Where("id = ?", id).First(&order).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	return &order, err
+}
+
+func (r *StandingOrderRepo) ListStandingOrdersByAccountID(accountID string) ([]*entity.StandingOrder, error) {
+	var orders []*entity.StandingOrder
+	err := r.DB.Where("source_account_id = ?", accountID).Order("created_at DESC").Find(&orders).Error
+	return orders, err
+}
+
+// GetDueStandingOrders returns active orders whose next run is due, earliest first
+func (r *StandingOrderRepo) GetDueStandingOrders(now time.Time, limit int) ([]*entity.StandingOrder, error) {
+	var orders []*entity.StandingOrder
+	err := r.DB.
+		Where("status = ? AND next_run_at IS NOT NULL AND next_run_at <= ?", entity.StandingOrderStatusActive, now).
+		Order("next_run_at ASC").
+		Limit(limit).
+		Find(&orders).Error
+	return orders, err
+}
+
+// UpdateStandingOrder saves the schedule and status of the order using optimistic locking on its version
+func (r *StandingOrderRepo) UpdateStandingOrder(order *entity.StandingOrder) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return updateStandingOrder(r.DB, order)
+}
+
+// RecordStandingOrderRun stores the run outcome and the advanced schedule together, so a run is never
+// recorded without its schedule moving on or the other way round
+func (r *StandingOrderRepo) RecordStandingOrderRun(order *entity.StandingOrder, run *entity.StandingOrderRun) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return r.DB.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(run).Error; err != nil {
+			return err
+		}
+		return updateStandingOrder(tx, order)
+	})
+}
+
+func (r *StandingOrderRepo) GetStandingOrderRuns(standingOrderID string) ([]*entity.StandingOrderRun, error) {
+	var runs []*entity.StandingOrderRun
+	err := r.DB.Where("standing_order_id = ?", standingOrderID).Order("run_number DESC").Find(&runs).Error
+	return runs, err
+}
+
+func updateStandingOrder(db *gorm.DB, order *entity.StandingOrder) error {
+	currentVersion := order.Version
+	result := db.Model(&entity.StandingOrder{}).
+		Where("id = ? AND version = ?", order.ID, currentVersion).
+		Updates(map[string]interface{}{
+			"status":      order.Status,
+			"run_count":   order.RunCount,
+			"occurrence":  order.Occurrence,
+			"next_run_at": order.NextRunAt,
+			"last_run_at": order.LastRunAt,
+			"updated_by":  order.UpdatedBy,
+			"updated_at":  time.Now(),
+			"version":     currentVersion + 1,
+		})
+
+	if result.Error != nil {
+		return result.Error
+	}
+
+	if result.RowsAffected == 0 {
+		return errors.New("concurrent modification detected")
+	}
+
+	order.Version = currentVersion + 1
+	return nil
+}
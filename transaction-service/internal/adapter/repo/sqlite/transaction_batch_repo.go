@@ -0,0 +1,103 @@
+package sqlite
+
+import (
+	"errors"
+	"gorm.io/gorm"
+	"sync"
+	"transaction-service/internal/domain/entity"
+	custom_err "transaction-service/internal/domain/error"
+	"transaction-service/internal/ports"
+)
+
+type TransactionBatchRepo struct {
+	DB *gorm.DB
+	mu sync.RWMutex
+}
+
+func NewTransactionBatchRepo(db *gorm.DB) ports.TransactionBatchRepo {
+	return &TransactionBatchRepo{DB: db}
+}
+
+// CreateTransactionBatch stores the batch with all of its lines in one database transaction
+func (r *TransactionBatchRepo) CreateTransactionBatch(batch *entity.TransactionBatch, lines []*entity.TransactionBatchLine) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	err := r.DB.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(batch).Error; err != nil {
+			return err
+		}
+		return tx.CreateInBatches(lines, 100).Error
+	})
+	if errors.Is(err, gorm.ErrDuplicatedKey) {
+		return custom_err.ErrDuplicateReference
+	}
+	return err
+}
+
+func (r *TransactionBatchRepo) GetTransactionBatchByID(id string) (*entity.TransactionBatch, error) {
+	var batch entity.TransactionBatch
+	err := r.DB.Where("id = ?", id).First(&batch).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	return &batch, err
+}
+
+// GetTransactionBatchByReferenceID returns the batch the requester created with the reference
+func (r *TransactionBatchRepo) GetTransactionBatchByReferenceID(requester, referenceID string) (*entity.TransactionBatch, error) {
+	var batch entity.TransactionBatch
+	err := r.DB.Where("reference_id = ? AND created_by = ?", referenceID, requester).First(&batch).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	return &batch, err
+}
+
+// GetTransactionBatchLines returns the lines of the batch in file order
+func (r *TransactionBatchRepo) GetTransactionBatchLines(batchID string) ([]*entity.TransactionBatchLine, error) {
+	var lines []*entity.TransactionBatchLine
+	err := r.DB.Where("batch_id = ?", batchID).Order("line_number ASC").Find(&lines).Error
+	return lines, err
+}
+
+// GetUnfinishedTransactionBatches returns batches that were not finished, e.g. because the service stopped
+func (r *TransactionBatchRepo) GetUnfinishedTransactionBatches() ([]*entity.TransactionBatch, error) {
+	var batches []*entity.TransactionBatch
+	err := r.DB.
+		Where("status IN ?", []string{entity.TransactionBatchStatusPending, entity.TransactionBatchStatusProcessing}).
+		Order("created_at ASC").
+		Find(&batches).Error
+	return batches, err
+}
+
+func (r *TransactionBatchRepo) UpdateTransactionBatch(batch *entity.TransactionBatch) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return r.DB.Model(&entity.TransactionBatch{}).
+		Where("id = ?", batch.ID).
+		Updates(map[string]interface{}{
+			"status":           batch.Status,
+			"successful_count": batch.SuccessfulCount,
+			"failed_count":     batch.FailedCount,
+			"error_reason":     batch.ErrorReason,
+			"updated_at":       batch.UpdatedAt,
+			"completed_at":     batch.CompletedAt,
+		}).Error
+}
+
+func (r *TransactionBatchRepo) UpdateTransactionBatchLine(line *entity.TransactionBatchLine) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return r.DB.Model(&entity.TransactionBatchLine{}).
+		Where("id = ?", line.ID).
+		Updates(map[string]interface{}{
+			"status":                  line.Status,
+			"transaction_id":          line.TransactionID,
+			"reversal_transaction_id": line.ReversalTransactionID,
+			"error_reason":            line.ErrorReason,
+			"updated_at":              line.UpdatedAt,
+		}).Error
+}
@@ -0,0 +1,53 @@
+package sqlite
+
+import (
+	"errors"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"sync"
+	"transaction-service/internal/domain/entity"
+	"transaction-service/internal/ports"
+)
+
+type TransactionLimitRepo struct {
+	DB *gorm.DB
+	mu sync.RWMutex
+}
+
+func NewTransactionLimitRepo(db *gorm.DB) ports.TransactionLimitRepo {
+	return &TransactionLimitRepo{DB: db}
+}
+
+// UpsertTransactionLimit creates the limit for a scope and subject or replaces the existing one
+func (r *TransactionLimitRepo) UpsertTransactionLimit(limit *entity.TransactionLimit) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return r.DB.Clauses(clause.OnConflict{
+		Columns: []clause.Column{{Name: "scope"}, {Name: "subject"}},
+		DoUpdates: clause.AssignmentColumns([]string{
+			"per_transaction_amount",
+			"daily_amount",
+			"daily_count",
+			"monthly_amount",
+			"monthly_count",
+			"updated_by",
+			"updated_at",
+		}),
+	}).Create(limit).Error
+}
+
+func (r *TransactionLimitRepo) GetTransactionLimit(scope, subject string) (*entity.TransactionLimit, error) {
+	var limit entity.TransactionLimit
+	err := r.DB.Where("scope = ? AND subject = ?", scope, subject).First(&limit).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	return &limit, err
+}
+
+func (r *TransactionLimitRepo) ListTransactionLimits() ([]*entity.TransactionLimit, error) {
+	var limits []*entity.TransactionLimit
+	err := r.DB.Order("scope ASC, subject ASC").Find(&limits).Error
+	return limits, err
+}
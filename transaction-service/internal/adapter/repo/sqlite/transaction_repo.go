@@ -6,6 +6,8 @@ import (
 	"sync"
 	"time"
 	"transaction-service/internal/domain/entity"
+	custom_err "transaction-service/internal/domain/error"
+	"transaction-service/internal/domain/money"
 	"transaction-service/internal/ports"
 )
 
@@ -22,7 +24,48 @@ func (r *TransactionRepo) CreateTransaction(transaction *entity.Transaction) err
 	r.mu.Lock()
 	defer r.mu.Unlock()
 
-	return r.DB.Create(transaction).Error
+	err := r.DB.Create(transaction).Error
+	if errors.Is(err, gorm.ErrDuplicatedKey) {
+		return custom_err.ErrDuplicateReference
+	}
+	return err
+}
+
+// CreateReversal links the original to the reversal, adds its amount to the reversed amount and stores the
+// reversal in one database transaction. Linking only succeeds while the reversed amount is the one the reversal
+// was calculated on, otherwise another reversal got in between and ErrConcurrentModification is returned.
+func (r *TransactionRepo) CreateReversal(original *entity.Transaction, reversal *entity.Transaction) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	reversedAmount := original.ReversedAmount.Add(reversal.Amount)
+	err := r.DB.Transaction(func(tx *gorm.DB) error {
+		result := tx.Model(&entity.Transaction{}).
+			Where("id = ? AND reversed_amount = ?", original.ID, original.ReversedAmount).
+			Updates(map[string]interface{}{
+				"reversed_by_transaction_id": reversal.ID,
+				"reversed_amount":            reversedAmount,
+				"updated_at":                 time.Now(),
+			})
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return custom_err.ErrConcurrentModification
+		}
+		return tx.Create(reversal).Error
+	})
+	if errors.Is(err, gorm.ErrDuplicatedKey) {
+		return custom_err.ErrDuplicateReference
+	}
+	if err != nil {
+		return err
+	}
+
+	reversalID := reversal.ID
+	original.ReversedByTransactionID = &reversalID
+	original.ReversedAmount = reversedAmount
+	return nil
 }
 
 func (r *TransactionRepo) GetTransactionByID(id string) (*entity.Transaction, error) {
@@ -34,15 +77,51 @@ func (r *TransactionRepo) GetTransactionByID(id string) (*entity.Transaction, er
 	return &transaction, err
 }
 
-func (r *TransactionRepo) GetTransactionByReferenceID(referenceID string) (*entity.Transaction, error) {
+// GetTransactionByReferenceID returns the transaction the requester initiated with the reference
+func (r *TransactionRepo) GetTransactionByReferenceID(requester, referenceID string) (*entity.Transaction, error) {
 	var transaction entity.Transaction
-	err := r.DB.Where("reference_id = ?", referenceID).First(&transaction).Error
+	err := r.DB.Where("reference_id = ? AND created_by = ?", referenceID, requester).First(&transaction).Error
 	if errors.Is(err, gorm.ErrRecordNotFound) {
 		return nil, nil
 	}
 	return &transaction, err
 }
 
+// GetInterestCredit gets the latest credit of an interest posting that has not failed, nil if there is none
+func (r *TransactionRepo) GetInterestCredit(interestPostingID string) (*entity.Transaction, error) {
+	var transaction entity.Transaction
+	err := r.DB.
+		Where("interest_posting_id = ? AND transaction_status <> ?", interestPostingID, entity.TransactionStatusFailed).
+		Order("created_at DESC").
+		First(&transaction).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	return &transaction, err
+}
+
+// ClaimTransaction moves a queued transaction to processing and starts its timeout. Only one caller can claim a
+// transaction, any other gets ErrTransactionAlreadyClaimed, e.g. once it was claimed or failed.
+func (r *TransactionRepo) ClaimTransaction(id string, timeoutAt time.Time) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	result := r.DB.Model(&entity.Transaction{}).
+		Where("id = ? AND transaction_status = ?", id, entity.TransactionStatusPending).
+		Updates(map[string]interface{}{
+			"transaction_status": entity.TransactionStatusProcessing,
+			"timeout_at":         timeoutAt,
+			"updated_at":         time.Now(),
+		})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return custom_err.ErrTransactionAlreadyClaimed
+	}
+	return nil
+}
+
 func (r *TransactionRepo) UpdateTransactionStatus(id string, status string, errorReason string) error {
 	r.mu.Lock()
 	defer r.mu.Unlock()
@@ -56,9 +135,69 @@ func (r *TransactionRepo) UpdateTransactionStatus(id string, status string, erro
 		updateFields["error_reason"] = errorReason
 	}
 
+	return r.DB.Transaction(func(tx *gorm.DB) error {
+		var current entity.Transaction
+		if status == entity.TransactionStatusFailed {
+			err := tx.Select("id", "transaction_status", "reversal_of_transaction_id", "amount").Where("id = ?", id).Take(&current).Error
+			if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+				return err
+			}
+		}
+
+		if err := tx.Model(&entity.Transaction{}).Where("id = ?", id).Updates(updateFields).Error; err != nil {
+			return err
+		}
+		if status != entity.TransactionStatusFailed || current.ReversalOfTransactionID == nil || current.TransactionStatus == entity.TransactionStatusFailed {
+			return nil
+		}
+		return releaseReversal(tx, *current.ReversalOfTransactionID, &current)
+	})
+}
+
+// releaseReversal takes the amount of a failed reversal off the reversed amount of the original, so that it can be
+// reversed by it again, and links the original to its latest reversal that is not failed
+func releaseReversal(tx *gorm.DB, originalID string, reversal *entity.Transaction) error {
+	latest := tx.Model(&entity.Transaction{}).
+		Select("id").
+		Where("reversal_of_transaction_id = ? AND id <> ? AND transaction_status <> ?", originalID, reversal.ID, entity.TransactionStatusFailed).
+		Order("created_at DESC").
+		Limit(1)
+
+	return tx.Model(&entity.Transaction{}).
+		Where("id = ?", originalID).
+		Updates(map[string]interface{}{
+			"reversed_amount":            gorm.Expr("reversed_amount - ?", reversal.Amount),
+			"reversed_by_transaction_id": gorm.Expr("CASE WHEN reversed_by_transaction_id = ? THEN (?) ELSE reversed_by_transaction_id END", reversal.ID, latest),
+			"updated_at":                 time.Now(),
+		}).Error
+}
+
+// UpdateTransactionExchange records the credited amount and the rate applied by the saga
+func (r *TransactionRepo) UpdateTransactionExchange(id string, destinationAmount money.Amount, exchangeRate money.Rate) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return r.DB.Model(&entity.Transaction{}).
+		Where("id = ?", id).
+		Updates(map[string]interface{}{
+			"destination_amount": destinationAmount,
+			"exchange_rate":      exchangeRate,
+			"updated_at":         time.Now(),
+		}).Error
+}
+
+// UpdateTransactionAmount records the amount a full withdrawal debits, which is only known once the saga reads the
+// balance
+func (r *TransactionRepo) UpdateTransactionAmount(id string, amount money.Amount) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
 	return r.DB.Model(&entity.Transaction{}).
 		Where("id = ?", id).
-		Updates(updateFields).Error
+		Updates(map[string]interface{}{
+			"amount":     amount,
+			"updated_at": time.Now(),
+		}).Error
 }
 
 func (r *TransactionRepo) UpdateTransaction(transaction *entity.Transaction) error {
@@ -89,11 +228,74 @@ func (r *TransactionRepo) UpdateTransaction(transaction *entity.Transaction) err
 	return nil
 }
 
-func (r *TransactionRepo) GetTransactionHistory(accountID string, customerID string, startDate, endDate *time.Time, sortOrder string, page, pageSize int, types []string) ([]*entity.Transaction, int64, error) {
+// GetTransactionHistory reads a page of the matching transactions ordered by creation time and ID. With a cursor
+// the page starts after it and page is ignored, otherwise page is used as an offset. One row more than pageSize is
+// read so the caller can tell whether another page follows. The total is only counted when withTotal is set.
+func (r *TransactionRepo) GetTransactionHistory(accountID string, customerID string, startDate, endDate *time.Time, sortOrder string, page, pageSize int, after *entity.PageCursor, withTotal bool, types []string) ([]*entity.Transaction, int64, error) {
 	var transactions []*entity.Transaction
 	var total int64
 
-	offset := (page - 1) * pageSize
+	query := r.historyQuery(accountID, customerID, startDate, endDate, types)
+
+	if withTotal {
+		if err := query.Count(&total).Error; err != nil {
+			return nil, 0, err
+		}
+	}
+
+	order, afterCond := keysetOrder(sortOrder)
+	if after != nil {
+		query = query.Where(afterCond, after.CreatedAt.Local(), after.CreatedAt.Local(), after.ID)
+	} else {
+		query = query.Offset((page - 1) * pageSize)
+	}
+
+	err := query.Limit(pageSize + 1).Order(order).Find(&transactions).Error
+	return transactions, total, err
+}
+
+// ExportTransactionHistory reads every transaction matching the history filters in batches, ordered by creation
+// time and ID, and hands each batch to fn. Batches continue after the last row of the previous one instead of
+// using an offset, so rows are neither skipped nor repeated while new transactions arrive. An error from fn stops
+// the export.
+func (r *TransactionRepo) ExportTransactionHistory(accountID string, customerID string, startDate, endDate *time.Time, sortOrder string, types []string, batchSize int, fn func([]*entity.Transaction) error) error {
+	order, after := keysetOrder(sortOrder)
+
+	var last *entity.Transaction
+	for {
+		query := r.historyQuery(accountID, customerID, startDate, endDate, types)
+		if last != nil {
+			query = query.Where(after, last.CreatedAt, last.CreatedAt, last.ID)
+		}
+
+		var transactions []*entity.Transaction
+		if err := query.Order(order).Limit(batchSize).Find(&transactions).Error; err != nil {
+			return err
+		}
+		if len(transactions) == 0 {
+			return nil
+		}
+		if err := fn(transactions); err != nil {
+			return err
+		}
+		if len(transactions) < batchSize {
+			return nil
+		}
+		last = transactions[len(transactions)-1]
+	}
+}
+
+// keysetOrder returns the creation time and ID order of a listing and the condition selecting the rows after a
+// given creation time and ID in that order
+func keysetOrder(sortOrder string) (string, string) {
+	if sortOrder == "asc" {
+		return "created_at ASC, id ASC", "created_at > ? OR (created_at = ? AND id > ?)"
+	}
+	return "created_at DESC, id DESC", "created_at < ? OR (created_at = ? AND id < ?)"
+}
+
+// historyQuery filters transactions by account, customer, creation time and type
+func (r *TransactionRepo) historyQuery(accountID string, customerID string, startDate, endDate *time.Time, types []string) *gorm.DB {
 	query := r.DB.Model(&entity.Transaction{})
 
 	// Build query - account can be source or destination
@@ -117,17 +319,7 @@ func (r *TransactionRepo) GetTransactionHistory(accountID string, customerID str
 		query = query.Where("type IN ?", types)
 	}
 
-	if err := query.Count(&total).Error; err != nil {
-		return nil, 0, err
-	}
-
-	order := "created_at DESC"
-	if sortOrder == "asc" {
-		order = "created_at ASC"
-	}
-
-	err := query.Offset(offset).Limit(pageSize).Order(order).Find(&transactions).Error
-	return transactions, total, err
+	return query
 }
 
 func (r *TransactionRepo) GetPendingTransactions() ([]*entity.Transaction, error) {
@@ -141,17 +333,62 @@ func (r *TransactionRepo) GetPendingTransactions() ([]*entity.Transaction, error
 	return transactions, err
 }
 
-// GetStuckTransactions returns transactions that are not completed/failed
+// GetStuckTransactions returns transactions that are not completed/failed past their timeout. Queued transactions
+// have no timeout before a worker claims them, so they are not stuck while they wait.
 func (r *TransactionRepo) GetStuckTransactions() ([]*entity.Transaction, error) {
 	var transactions []*entity.Transaction
 	err := r.DB.
-		Where("transaction_status IN ? AND timeout_at < ?",
+		Where("transaction_status IN ? AND timeout_at < ? AND timeout_at <> ?",
 			[]string{
 				entity.TransactionStatusPending,
+				entity.TransactionStatusProcessing,
 				entity.TransactionStatusRecovering,
 				entity.TransactionStatusSuccessful,
 			},
-			time.Now()).
+			time.Now(),
+			time.Time{}).
+		Find(&transactions).Error
+	return transactions, err
+}
+
+// GetQueuedTransactions returns pending transactions no worker has claimed yet, oldest first
+func (r *TransactionRepo) GetQueuedTransactions() ([]*entity.Transaction, error) {
+	var transactions []*entity.Transaction
+	err := r.DB.
+		Where("transaction_status = ? AND timeout_at = ?", entity.TransactionStatusPending, time.Time{}).
+		Order("created_at ASC").
 		Find(&transactions).Error
 	return transactions, err
 }
+
+// GetDebitUsage sums the debits in the currency of an account, or initiated by an employee, since the given time.
+// A debit counts with its fee. Failed transactions and reversals are not counted.
+func (r *TransactionRepo) GetDebitUsage(accountID string, createdBy string, currency string, since time.Time) (entity.TransactionUsage, error) {
+	var usage struct {
+		Amount int64
+		Count  int
+	}
+
+	query := r.DB.Model(&entity.Transaction{}).
+		Select("COALESCE(SUM(amount + fee_amount), 0) AS amount, COUNT(*) AS count").
+		Where("type IN ?", []string{
+			entity.TransactionTypeTransfer,
+			entity.TransactionTypeWithdrawFull,
+			entity.TransactionTypeWithdrawAmount,
+		}).
+		Where("transaction_status <> ?", entity.TransactionStatusFailed).
+		Where("reversal_of_transaction_id IS NULL").
+		Where("source_currency = ?", currency).
+		Where("created_at >= ?", since)
+
+	if accountID != "" {
+		query = query.Where("source_account_id = ?", accountID)
+	}
+
+	if createdBy != "" {
+		query = query.Where("created_by = ?", createdBy)
+	}
+
+	err := query.Scan(&usage).Error
+	return entity.TransactionUsage{Amount: money.Amount(usage.Amount), Count: usage.Count}, err
+}
@@ -0,0 +1,228 @@
+package sqlite
+
+import (
+	"github.com/stretchr/testify/assert"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+	"sync"
+	"testing"
+	"time"
+	"transaction-service/internal/domain/entity"
+	custom_err "transaction-service/internal/domain/error"
+	"transaction-service/internal/domain/money"
+)
+
+func newTestDB(t *testing.T) *gorm.DB {
+	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{Logger: logger.Default.LogMode(logger.Silent)})
+	assert.NoError(t, err)
+
+	sqlDB, err := db.DB()
+	assert.NoError(t, err)
+	// every connection would open its own in-memory database
+	sqlDB.SetMaxOpenConns(1)
+	t.Cleanup(func() { _ = sqlDB.Close() })
+
+	assert.NoError(t, db.AutoMigrate(&entity.Transaction{}))
+	return db
+}
+
+func newTestTransaction(t *testing.T, transactionType string, amount money.Amount, referenceID string) *entity.Transaction {
+	transaction, err := entity.NewTransaction("acc-123", nil, amount, transactionType, referenceID, "user-1")
+	assert.NoError(t, err)
+	transaction.SourceAccountCustomerID = "cust-123"
+	transaction.SourceCurrency = "USD"
+	return transaction
+}
+
+// TestTransactionRepo_ClaimTransaction_Concurrent tests that only one of concurrent workers claims a queued
+// transaction
+func TestTransactionRepo_ClaimTransaction_Concurrent(t *testing.T) {
+	repo := NewTransactionRepo(newTestDB(t))
+
+	transaction := newTestTransaction(t, entity.TransactionTypeAddAmount, money.MustParse("10.00"), "ref-1")
+	transaction.TimeoutAt = time.Time{}
+	assert.NoError(t, repo.CreateTransaction(transaction))
+
+	var wg sync.WaitGroup
+	var mu sync.Mutex
+	claimed := 0
+	timeoutAt := time.Now().Add(time.Minute)
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if err := repo.ClaimTransaction(transaction.ID, timeoutAt); err == nil {
+				mu.Lock()
+				claimed++
+				mu.Unlock()
+			} else {
+				assert.ErrorIs(t, err, custom_err.ErrTransactionAlreadyClaimed)
+			}
+		}()
+	}
+	wg.Wait()
+
+	assert.Equal(t, 1, claimed)
+	stored, err := repo.GetTransactionByID(transaction.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, entity.TransactionStatusProcessing, stored.TransactionStatus)
+	assert.WithinDuration(t, timeoutAt, stored.TimeoutAt, time.Second)
+}
+
+// TestTransactionRepo_ClaimTransaction_Failed tests that a transaction failed while queued cannot be claimed
+func TestTransactionRepo_ClaimTransaction_Failed(t *testing.T) {
+	repo := NewTransactionRepo(newTestDB(t))
+
+	transaction := newTestTransaction(t, entity.TransactionTypeAddAmount, money.MustParse("10.00"), "ref-1")
+	transaction.TimeoutAt = time.Time{}
+	assert.NoError(t, repo.CreateTransaction(transaction))
+	assert.NoError(t, repo.UpdateTransactionStatus(transaction.ID, entity.TransactionStatusFailed, "transaction queue is full"))
+
+	assert.ErrorIs(t, repo.ClaimTransaction(transaction.ID, time.Now().Add(time.Minute)), custom_err.ErrTransactionAlreadyClaimed)
+}
+
+// TestTransactionRepo_CreateReversal_Partial tests that partial reversals add up on the original, and that a reversal
+// calculated on a stale reversed amount is refused
+func TestTransactionRepo_CreateReversal_Partial(t *testing.T) {
+	db := newTestDB(t)
+	repo := NewTransactionRepo(db)
+
+	original := newTestTransaction(t, entity.TransactionTypeWithdrawAmount, money.MustParse("100.00"), "ref-1")
+	assert.NoError(t, repo.CreateTransaction(original))
+	stale, err := repo.GetTransactionByID(original.ID)
+	assert.NoError(t, err)
+
+	first, err := original.NewReversal(money.MustParse("40.00"), "refund", "user-1")
+	assert.NoError(t, err)
+	assert.NoError(t, repo.CreateReversal(original, first))
+	second, err := original.NewReversal(money.MustParse("25.00"), "refund", "user-1")
+	assert.NoError(t, err)
+	assert.NoError(t, repo.CreateReversal(original, second))
+
+	stored, err := repo.GetTransactionByID(original.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, money.MustParse("65.00"), stored.ReversedAmount)
+	assert.Equal(t, second.ID, *stored.ReversedByTransactionID)
+
+	concurrent, err := stale.NewReversal(money.MustParse("100.00"), "refund", "user-1")
+	assert.NoError(t, err)
+	assert.ErrorIs(t, repo.CreateReversal(stale, concurrent), custom_err.ErrConcurrentModification)
+}
+
+// TestTransactionRepo_UpdateTransactionStatus_ReleasesFailedReversal tests that a failed reversal takes its amount off
+// the original once and links the original to its latest reversal that is not failed
+func TestTransactionRepo_UpdateTransactionStatus_ReleasesFailedReversal(t *testing.T) {
+	db := newTestDB(t)
+	repo := NewTransactionRepo(db)
+
+	original := newTestTransaction(t, entity.TransactionTypeWithdrawAmount, money.MustParse("100.00"), "ref-1")
+	assert.NoError(t, repo.CreateTransaction(original))
+	first, err := original.NewReversal(money.MustParse("40.00"), "refund", "user-1")
+	assert.NoError(t, err)
+	assert.NoError(t, repo.CreateReversal(original, first))
+	second, err := original.NewReversal(money.MustParse("25.00"), "refund", "user-1")
+	assert.NoError(t, err)
+	second.CreatedAt = first.CreatedAt.Add(time.Second)
+	assert.NoError(t, repo.CreateReversal(original, second))
+
+	assert.NoError(t, repo.UpdateTransactionStatus(second.ID, entity.TransactionStatusFailed, "insufficient balance"))
+	assert.NoError(t, repo.UpdateTransactionStatus(second.ID, entity.TransactionStatusFailed, "insufficient balance"))
+
+	stored, err := repo.GetTransactionByID(original.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, money.MustParse("40.00"), stored.ReversedAmount)
+	assert.Equal(t, first.ID, *stored.ReversedByTransactionID)
+
+	assert.NoError(t, repo.UpdateTransactionStatus(first.ID, entity.TransactionStatusFailed, "insufficient balance"))
+
+	stored, err = repo.GetTransactionByID(original.ID)
+	assert.NoError(t, err)
+	assert.True(t, stored.ReversedAmount.IsZero())
+	assert.Nil(t, stored.ReversedByTransactionID)
+}
+
+// TestTransactionRepo_GetStuckTransactions_SkipsQueued tests that queued transactions are not stuck while claimed
+// transactions past their timeout are
+func TestTransactionRepo_GetStuckTransactions_SkipsQueued(t *testing.T) {
+	repo := NewTransactionRepo(newTestDB(t))
+
+	queued := newTestTransaction(t, entity.TransactionTypeAddAmount, money.MustParse("10.00"), "ref-1")
+	queued.TimeoutAt = time.Time{}
+	assert.NoError(t, repo.CreateTransaction(queued))
+
+	claimed := newTestTransaction(t, entity.TransactionTypeAddAmount, money.MustParse("10.00"), "ref-2")
+	claimed.TimeoutAt = time.Time{}
+	assert.NoError(t, repo.CreateTransaction(claimed))
+	assert.NoError(t, repo.ClaimTransaction(claimed.ID, time.Now().Add(-time.Second)))
+
+	running := newTestTransaction(t, entity.TransactionTypeAddAmount, money.MustParse("10.00"), "ref-3")
+	assert.NoError(t, repo.CreateTransaction(running))
+
+	stuck, err := repo.GetStuckTransactions()
+	assert.NoError(t, err)
+	assert.Len(t, stuck, 1)
+	assert.Equal(t, claimed.ID, stuck[0].ID)
+
+	queuedTransactions, err := repo.GetQueuedTransactions()
+	assert.NoError(t, err)
+	assert.Len(t, queuedTransactions, 1)
+	assert.Equal(t, queued.ID, queuedTransactions[0].ID)
+}
+
+// TestTransactionRepo_GetDebitUsage_Currency tests that only the debits in the currency of the limit are counted
+func TestTransactionRepo_GetDebitUsage_Currency(t *testing.T) {
+	repo := NewTransactionRepo(newTestDB(t))
+
+	usd := newTestTransaction(t, entity.TransactionTypeWithdrawAmount, money.MustParse("100.00"), "ref-1")
+	assert.NoError(t, repo.CreateTransaction(usd))
+	eur := newTestTransaction(t, entity.TransactionTypeWithdrawAmount, money.MustParse("40.00"), "ref-2")
+	eur.SourceCurrency = "EUR"
+	assert.NoError(t, repo.CreateTransaction(eur))
+
+	since := time.Now().Add(-time.Hour)
+	usage, err := repo.GetDebitUsage("", "user-1", "USD", since)
+	assert.NoError(t, err)
+	assert.Equal(t, entity.TransactionUsage{Amount: money.MustParse("100.00"), Count: 1}, usage)
+
+	usage, err = repo.GetDebitUsage("", "user-1", "EUR", since)
+	assert.NoError(t, err)
+	assert.Equal(t, entity.TransactionUsage{Amount: money.MustParse("40.00"), Count: 1}, usage)
+}
+
+// TestTransactionRepo_GetDebitUsage_WithdrawFull tests that a full withdrawal counts with the amount it withdrew
+func TestTransactionRepo_GetDebitUsage_WithdrawFull(t *testing.T) {
+	repo := NewTransactionRepo(newTestDB(t))
+
+	withdrawal := newTestTransaction(t, entity.TransactionTypeWithdrawFull, money.Zero, "ref-1")
+	assert.NoError(t, repo.CreateTransaction(withdrawal))
+	assert.NoError(t, repo.UpdateTransactionAmount(withdrawal.ID, money.MustParse("750.25")))
+
+	usage, err := repo.GetDebitUsage("acc-123", "", "USD", time.Now().Add(-time.Hour))
+	assert.NoError(t, err)
+	assert.Equal(t, entity.TransactionUsage{Amount: money.MustParse("750.25"), Count: 1}, usage)
+}
+
+// TestTransactionRepo_GetDebitUsage_Fees tests that fees count with the debits they were charged on, while failed
+// transactions and reversals are not counted
+func TestTransactionRepo_GetDebitUsage_Fees(t *testing.T) {
+	repo := NewTransactionRepo(newTestDB(t))
+
+	withFee := newTestTransaction(t, entity.TransactionTypeWithdrawAmount, money.MustParse("100.00"), "ref-1")
+	withFee.ApplyFee(&entity.FeeRule{ID: "rule-1"}, money.MustParse("2.50"), "acc-fees")
+	assert.NoError(t, repo.CreateTransaction(withFee))
+
+	failed := newTestTransaction(t, entity.TransactionTypeWithdrawAmount, money.MustParse("30.00"), "ref-2")
+	assert.NoError(t, repo.CreateTransaction(failed))
+	assert.NoError(t, repo.UpdateTransactionStatus(failed.ID, entity.TransactionStatusFailed, "insufficient balance"))
+
+	reversal, err := withFee.NewReversal(money.MustParse("10.00"), "refund", "user-1")
+	assert.NoError(t, err)
+	reversal.SourceAccountCustomerID = "cust-123"
+	reversal.SourceCurrency = "USD"
+	assert.NoError(t, repo.CreateReversal(withFee, reversal))
+
+	usage, err := repo.GetDebitUsage("acc-123", "", "USD", time.Now().Add(-time.Hour))
+	assert.NoError(t, err)
+	assert.Equal(t, entity.TransactionUsage{Amount: money.MustParse("102.50"), Count: 1}, usage)
+}
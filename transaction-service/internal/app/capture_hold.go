@@ -0,0 +1,147 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+	"transaction-service/internal/config"
+	"transaction-service/internal/domain/entity"
+	custom_err "transaction-service/internal/domain/error"
+	"transaction-service/internal/domain/money"
+	"transaction-service/internal/logging"
+	"transaction-service/internal/observability/metrics"
+	"transaction-service/internal/ports"
+)
+
+// CaptureHold is a use-case for settling a hold placed in the account service with a real transaction
+type CaptureHold struct {
+	transactionRepo ports.TransactionRepo
+	accountClient   ports.AccountClient
+	initTransaction *InitTransaction
+}
+
+// NewCaptureHold creates a new CaptureHold use-case
+func NewCaptureHold(
+	transactionRepo ports.TransactionRepo,
+	accountClient ports.AccountClient,
+	sagaRepo ports.SagaRepo,
+	eventRepo ports.EventRepo,
+	exchangeRateRepo ports.ExchangeRateRepo,
+	limitRepo ports.TransactionLimitRepo,
+) *CaptureHold {
+
+	return &CaptureHold{
+		transactionRepo: transactionRepo,
+		accountClient:   accountClient,
+		// captures always run synchronously, so no queue is needed
+		initTransaction: NewInitTransaction(transactionRepo, accountClient, sagaRepo, eventRepo, exchangeRateRepo, limitRepo, nil, nil),
+	}
+}
+
+// Execute captures the hold by the amount, or fully if the amount is zero, and waits for the capture saga to
+// finish. The captured funds are withdrawn, or transferred when a destination account is given. The account
+// service settles the hold with the balance update; the remainder of a partial capture is released.
+func (c *CaptureHold) Execute(
+	ctx context.Context,
+	holdID string,
+	amount money.Amount,
+	destinationAccountID *string,
+	requester,
+	requestId string,
+) (*entity.Transaction, string, error) {
+	metrics.IncRequestActive()
+	defer metrics.DecRequestActive()
+
+	var err error
+	defer func() {
+		metrics.RecordOperation("capture_hold", err)
+	}()
+
+	if strings.TrimSpace(requester) == "" {
+		err = custom_err.ErrMissingRequester
+		logging.Logger.Error().Err(err).Msg("Unknown requester")
+		return nil, "Unknown requester", err
+	}
+
+	holdID = strings.TrimSpace(holdID)
+	if holdID == "" {
+		err = fmt.Errorf("%w: hold id required", custom_err.ErrValidationFailed)
+		return nil, "Hold ID missing", err
+	}
+
+	if amount.IsNegative() {
+		err = custom_err.ErrInvalidAmount
+		return nil, "Invalid amount for capture", err
+	}
+
+	if destinationAccountID != nil && strings.TrimSpace(*destinationAccountID) == "" {
+		destinationAccountID = nil
+	}
+
+	hold, msg, err := c.accountClient.GetHold(ctx, holdID, requester, requestId)
+	if err != nil {
+		logging.Logger.Error().Err(err).Str("hold_id", holdID).Str("message", msg).Str("request_id", requestId).Msg("Failed to get hold")
+		if msg == "Hold not found" {
+			err = custom_err.ErrHoldNotFound
+			return nil, msg, err
+		}
+		return nil, "Failed to get hold", err
+	}
+
+	if !hold.IsActive() {
+		err = custom_err.ErrHoldNotActive
+		logging.Logger.Warn().Err(err).Str("hold_id", holdID).Str("hold_status", hold.Status).Msg("Hold cannot be captured")
+		return nil, "Only active holds can be captured", err
+	}
+
+	if amount.IsZero() {
+		amount = hold.Amount
+	}
+	if amount > hold.Amount {
+		err = custom_err.ErrInvalidAmount
+		logging.Logger.Warn().Err(err).
+			Str("hold_id", holdID).
+			Str("amount", amount.String()).
+			Str("hold_amount", hold.Amount.String()).
+			Msg("Capture amount exceeds the held amount")
+		return nil, fmt.Sprintf("Capture amount exceeds the held amount of %s", hold.Amount), err
+	}
+
+	if destinationAccountID != nil && *destinationAccountID == hold.AccountID {
+		err = custom_err.ErrSameAccountTransfer
+		return nil, "Cannot capture a hold to the same account", err
+	}
+
+	capture, err := entity.NewHoldCapture(hold.HoldID, hold.AccountID, destinationAccountID, amount, requester)
+	if err != nil {
+		logging.Logger.Error().Err(err).Str("hold_id", holdID).Msg("Failed to build capture")
+		return nil, "Hold cannot be captured", err
+	}
+	if config.Current().Recovery.TransactionTimeout > 0 {
+		capture.TimeoutAt = time.Now().Add(config.Current().Recovery.TransactionTimeout)
+	}
+
+	// accounts, currencies and limits are checked as for any new transaction
+	msg, err = c.initTransaction.validateTransactionConfig(ctx, capture, requester, requestId)
+	if err != nil {
+		return nil, msg, err
+	}
+
+	if err = c.transactionRepo.CreateTransaction(capture); err != nil {
+		logging.Logger.Error().Err(err).
+			Str("hold_id", holdID).
+			Str("transaction_id", capture.ID).
+			Msg("Failed to create capture")
+		err = custom_err.ErrDatabase
+		return nil, "Failed to create capture", err
+	}
+
+	// a failed capture leaves the hold active, so it can be captured again
+	processedCapture, msg, err := c.initTransaction.processTransaction(ctx, capture, requester, requestId)
+	if err != nil {
+		return nil, msg, err
+	}
+
+	return processedCapture, "Hold captured successfully", nil
+}
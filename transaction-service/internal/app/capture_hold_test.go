@@ -0,0 +1,127 @@
+package app
+
+import (
+	"context"
+	"testing"
+	"time"
+	"transaction-service/internal/domain/entity"
+	custom_err "transaction-service/internal/domain/error"
+	"transaction-service/internal/domain/money"
+	"transaction-service/internal/ports"
+	"transaction-service/internal/ports/mocks"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type captureHoldMocks struct {
+	sagaRepo        *mocks.MockSagaRepo
+	accountClient   *mocks.MockAccountClient
+	transactionRepo *mocks.MockTransactionRepo
+	eventRepo       *mocks.MockEventRepo
+}
+
+func newTestCaptureHold() (*CaptureHold, captureHoldMocks) {
+	m := captureHoldMocks{
+		sagaRepo:        new(mocks.MockSagaRepo),
+		accountClient:   new(mocks.MockAccountClient),
+		transactionRepo: new(mocks.MockTransactionRepo),
+		eventRepo:       new(mocks.MockEventRepo),
+	}
+	captureHold := NewCaptureHold(m.transactionRepo, m.accountClient, m.sagaRepo, m.eventRepo, new(mocks.MockExchangeRateRepo), nil)
+	return captureHold, m
+}
+
+func newActiveHold() *ports.HoldInfo {
+	return &ports.HoldInfo{
+		HoldID:    "hold-1",
+		AccountID: "acc-123",
+		Amount:    money.MustParse("80.00"),
+		Currency:  "USD",
+		Status:    "active",
+		ExpiresAt: time.Now().Add(time.Hour),
+	}
+}
+
+// TestCaptureHold_Execute_PartialCapture tests that a partial capture withdraws the amount and settles the hold
+func TestCaptureHold_Execute_PartialCapture(t *testing.T) {
+	captureHold, m := newTestCaptureHold()
+	ctx := context.Background()
+
+	// the held funds are not available to other transactions, but to the capture
+	accountsInfo := []ports.AccountInfo{
+		{AccountID: "acc-123", CustomerID: "cust-123", Balance: money.MustParse("100.00"), HeldAmount: money.MustParse("80.00"), Currency: "USD", Version: 2},
+	}
+
+	storedCapture := &entity.Transaction{}
+	m.accountClient.On("GetHold", ctx, "hold-1", "merchant", "req-1").Return(newActiveHold(), "Hold details", nil)
+	m.accountClient.On("ValidateAndGetAccounts", ctx, []string{"acc-123"}, "merchant", "req-1").Return(accountsInfo, "", nil)
+	m.transactionRepo.On("CreateTransaction", mock.MatchedBy(func(capture *entity.Transaction) bool {
+		return capture.IsHoldCapture() && *capture.HoldID == "hold-1" && capture.Type == entity.TransactionTypeWithdrawAmount
+	})).Run(func(args mock.Arguments) { *storedCapture = *args.Get(0).(*entity.Transaction) }).Return(nil)
+
+	// capture saga
+	m.sagaRepo.On("CreateSaga", mock.MatchedBy(func(saga *entity.TransactionSaga) bool {
+		return saga.HoldID != nil && *saga.HoldID == "hold-1"
+	})).Return(nil)
+	m.sagaRepo.On("UpdateSaga", mock.AnythingOfType("*entity.TransactionSaga")).Return(nil)
+	m.accountClient.On("LockAccounts", ctx, []string{"acc-123"}, mock.Anything, "merchant", "req-1").Return("", nil)
+	m.accountClient.On("UpdateAccountsBalance", ctx, mock.Anything,
+		mock.MatchedBy(func(updates []ports.AccountBalanceUpdate) bool {
+			return len(updates) == 1 && updates[0].NewBalance == money.MustParse("50.00") &&
+				updates[0].HoldID == "hold-1" && updates[0].HoldCaptureAmount == money.MustParse("50.00")
+		}),
+		false, "merchant", "req-1").Return([]ports.AccountBalanceUpdateResponse{}, "", nil)
+	m.transactionRepo.On("UpdateTransactionStatus", mock.Anything, entity.TransactionStatusSuccessful, "").Return(nil)
+	m.accountClient.On("UnlockAccounts", ctx, mock.Anything, "merchant", "req-1").Return("", nil)
+	m.transactionRepo.On("UpdateTransactionStatus", mock.Anything, entity.TransactionStatusCompleted, "").Return(nil)
+	m.transactionRepo.On("GetTransactionByID", mock.AnythingOfType("string")).Return(storedCapture, nil)
+	m.eventRepo.On("CreateEvent", mock.AnythingOfType("*entity.Event")).Return(nil)
+
+	capture, msg, err := captureHold.Execute(ctx, "hold-1", money.MustParse("50.00"), nil, "merchant", "req-1")
+
+	assert.NoError(t, err)
+	assert.Equal(t, "Hold captured successfully", msg)
+	assert.Equal(t, "hold-1", *capture.HoldID)
+	assert.Equal(t, money.MustParse("50.00"), capture.Amount)
+	assert.Contains(t, capture.ReferenceID, "hold-capture:hold-1:")
+	m.accountClient.AssertExpectations(t)
+	m.transactionRepo.AssertExpectations(t)
+	m.sagaRepo.AssertExpectations(t)
+}
+
+// TestCaptureHold_Execute_ExceedsHold tests that no more than the held amount can be captured
+func TestCaptureHold_Execute_ExceedsHold(t *testing.T) {
+	captureHold, m := newTestCaptureHold()
+	ctx := context.Background()
+
+	m.accountClient.On("GetHold", ctx, "hold-1", "merchant", "req-1").Return(newActiveHold(), "Hold details", nil)
+
+	capture, msg, err := captureHold.Execute(ctx, "hold-1", money.MustParse("80.01"), nil, "merchant", "req-1")
+
+	assert.ErrorIs(t, err, custom_err.ErrInvalidAmount)
+	assert.Nil(t, capture)
+	assert.Equal(t, "Capture amount exceeds the held amount of 80.00", msg)
+	m.transactionRepo.AssertNotCalled(t, "CreateTransaction", mock.Anything)
+}
+
+// TestCaptureHold_Execute_InactiveHold tests that released, captured or expired holds cannot be captured
+func TestCaptureHold_Execute_InactiveHold(t *testing.T) {
+	captureHold, m := newTestCaptureHold()
+	ctx := context.Background()
+
+	expired := newActiveHold()
+	expired.ExpiresAt = time.Now().Add(-time.Minute)
+	m.accountClient.On("GetHold", ctx, "hold-1", "merchant", "req-1").Return(expired, "Hold details", nil)
+	m.accountClient.On("GetHold", ctx, "hold-404", "merchant", "req-1").Return(nil, "Hold not found", assert.AnError)
+
+	_, msg, err := captureHold.Execute(ctx, "hold-1", money.Zero, nil, "merchant", "req-1")
+	assert.ErrorIs(t, err, custom_err.ErrHoldNotActive)
+	assert.Equal(t, "Only active holds can be captured", msg)
+
+	_, msg, err = captureHold.Execute(ctx, "hold-404", money.Zero, nil, "merchant", "req-1")
+	assert.ErrorIs(t, err, custom_err.ErrHoldNotFound)
+	assert.Equal(t, "Hold not found", msg)
+
+	m.transactionRepo.AssertNotCalled(t, "CreateTransaction", mock.Anything)
+}
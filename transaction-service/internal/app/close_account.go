@@ -0,0 +1,208 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+	"transaction-service/internal/config"
+	"transaction-service/internal/domain/entity"
+	custom_err "transaction-service/internal/domain/error"
+	"transaction-service/internal/logging"
+	"transaction-service/internal/observability/metrics"
+	"transaction-service/internal/ports"
+)
+
+// CloseAccount is a use-case for paying out the balance of an account and closing it in the account service
+type CloseAccount struct {
+	transactionRepo ports.TransactionRepo
+	accountClient   ports.AccountClient
+	initTransaction *InitTransaction
+	postInterest    *PostInterest
+}
+
+// NewCloseAccount creates a new CloseAccount use-case
+func NewCloseAccount(
+	transactionRepo ports.TransactionRepo,
+	accountClient ports.AccountClient,
+	sagaRepo ports.SagaRepo,
+	eventRepo ports.EventRepo,
+	exchangeRateRepo ports.ExchangeRateRepo,
+) *CloseAccount {
+
+	return &CloseAccount{
+		transactionRepo: transactionRepo,
+		accountClient:   accountClient,
+		// payouts always run synchronously and are neither limited nor charged, so no queue, limit or fee repo is needed
+		initTransaction: NewInitTransaction(transactionRepo, accountClient, sagaRepo, eventRepo, exchangeRateRepo, nil, nil, nil),
+		postInterest:    NewPostInterest(transactionRepo, accountClient, sagaRepo, eventRepo, exchangeRateRepo),
+	}
+}
+
+// Execute closes an active account without holds or overdraft. The interest the account accrued up to the last
+// accrued day is settled by the account service and credited first, so it is paid out with the balance. A positive
+// balance is then transferred in full to the payout account and the closure waits for the payout saga to finish;
+// the payout account is not needed for an empty account. If the account receives funds between the payout and the
+// closure, the account service refuses to close it and a repeated request pays out the rest.
+func (c *CloseAccount) Execute(
+	ctx context.Context,
+	accountID,
+	payoutAccountID,
+	reason,
+	requester,
+	requestId string,
+) (*entity.Transaction, string, error) {
+	metrics.IncRequestActive()
+	defer metrics.DecRequestActive()
+
+	var err error
+	defer func() {
+		metrics.RecordOperation("close_account", err)
+	}()
+
+	if strings.TrimSpace(requester) == "" {
+		err = custom_err.ErrMissingRequester
+		logging.Logger.Error().Err(err).Msg("Unknown requester")
+		return nil, "Unknown requester", err
+	}
+
+	accountID = strings.TrimSpace(accountID)
+	payoutAccountID = strings.TrimSpace(payoutAccountID)
+	if accountID == "" {
+		err = fmt.Errorf("%w: account id required", custom_err.ErrValidationFailed)
+		return nil, "Account ID missing", err
+	}
+	if strings.TrimSpace(reason) == "" {
+		err = fmt.Errorf("%w: reason required", custom_err.ErrValidationFailed)
+		return nil, "Closure reason missing", err
+	}
+	if payoutAccountID == accountID {
+		err = custom_err.ErrSameAccountTransfer
+		return nil, "Cannot pay out an account to itself", err
+	}
+
+	accounts, msg, err := c.accountClient.ValidateAndGetAccounts(ctx, []string{accountID}, requester, requestId)
+	if err != nil || len(accounts) != 1 {
+		logging.Logger.Error().Err(err).Str("account_id", accountID).Str("message", msg).Str("request_id", requestId).Msg("Failed to validate account")
+		err = custom_err.ErrAccountValidationFailed
+		return nil, "Failed to validate account", err
+	}
+	account := accounts[0]
+
+	if account.ActiveStatus != "active" {
+		err = fmt.Errorf("%w: account is %s", custom_err.ErrAccountNotClosable, account.ActiveStatus)
+		return nil, "Only active accounts can be closed", err
+	}
+	if account.HeldAmount.IsPositive() {
+		err = fmt.Errorf("%w: account has active holds", custom_err.ErrAccountNotClosable)
+		return nil, "Accounts with active holds cannot be closed", err
+	}
+	if account.Balance.IsNegative() {
+		err = fmt.Errorf("%w: account is overdrawn", custom_err.ErrAccountNotClosable)
+		return nil, "Overdrawn accounts cannot be closed", err
+	}
+
+	credited, msg, err := c.creditInterest(ctx, accountID, requester, requestId)
+	if err != nil {
+		return nil, msg, err
+	}
+	if credited {
+		// the payout has to include the credited interest
+		accounts, msg, err = c.accountClient.ValidateAndGetAccounts(ctx, []string{accountID}, requester, requestId)
+		if err != nil || len(accounts) != 1 {
+			logging.Logger.Error().Err(err).Str("account_id", accountID).Str("message", msg).Str("request_id", requestId).Msg("Failed to validate account")
+			err = custom_err.ErrAccountValidationFailed
+			return nil, "Failed to validate account", err
+		}
+		account = accounts[0]
+	}
+
+	var payout *entity.Transaction
+	if account.Balance.IsPositive() {
+		if payoutAccountID == "" {
+			err = fmt.Errorf("%w: payout account id required", custom_err.ErrValidationFailed)
+			return nil, "Payout account ID missing", err
+		}
+
+		payout, msg, err = c.payOut(ctx, account, payoutAccountID, requester, requestId)
+		if err != nil {
+			return nil, msg, err
+		}
+	}
+
+	payoutTransactionID := ""
+	if payout != nil {
+		payoutTransactionID = payout.ID
+	}
+	msg, err = c.accountClient.CloseAccount(ctx, accountID, payoutAccountID, payoutTransactionID, reason, requester, requestId)
+	if err != nil {
+		logging.Logger.Error().Err(err).
+			Str("account_id", accountID).
+			Str("payout_transaction_id", payoutTransactionID).
+			Str("message", msg).
+			Str("request_id", requestId).
+			Msg("Failed to close account")
+		err = fmt.Errorf("%w: %v", custom_err.ErrAccountCloseFailed, err)
+		return payout, "Failed to close account: " + msg, err
+	}
+
+	return payout, "Account closed successfully", nil
+}
+
+// creditInterest settles the accrued interest of the account and credits every pending posting of it, reporting
+// whether any interest was credited. The closure is aborted while a credit fails or is still in progress, since the
+// account service does not close an account with interest waiting to be credited.
+func (c *CloseAccount) creditInterest(ctx context.Context, accountID, requester, requestId string) (bool, string, error) {
+	postings, msg, err := c.accountClient.SettleAccountInterest(ctx, accountID, requester, requestId)
+	if err != nil {
+		logging.Logger.Error().Err(err).Str("account_id", accountID).Str("message", msg).Str("request_id", requestId).Msg("Failed to settle account interest")
+		return false, "Failed to settle account interest", fmt.Errorf("%w: %v", custom_err.ErrInterestCreditFailed, err)
+	}
+
+	for _, posting := range postings {
+		credit, msg, err := c.postInterest.Execute(ctx, posting, requestId)
+		if err != nil {
+			logging.Logger.Error().Err(err).
+				Str("account_id", accountID).
+				Str("interest_posting_id", posting.PostingID).
+				Str("message", msg).
+				Str("request_id", requestId).
+				Msg("Failed to credit interest of closing account")
+			return false, "Failed to credit interest: " + msg, fmt.Errorf("%w: %v", custom_err.ErrInterestCreditFailed, err)
+		}
+		if credit.TransactionStatus != entity.TransactionStatusSuccessful && credit.TransactionStatus != entity.TransactionStatusCompleted {
+			err = fmt.Errorf("%w: interest credit %s is %s", custom_err.ErrInterestCreditFailed, credit.ID, credit.TransactionStatus)
+			return false, "Interest credit of the account is still in progress", err
+		}
+	}
+
+	return len(postings) > 0, "", nil
+}
+
+// payOut transfers the full balance of the account to the payout account
+func (c *CloseAccount) payOut(ctx context.Context, account ports.AccountInfo, payoutAccountID, requester, requestId string) (*entity.Transaction, string, error) {
+	payout, err := entity.NewClosurePayout(account.AccountID, payoutAccountID, account.Balance, requester)
+	if err != nil {
+		logging.Logger.Error().Err(err).Str("account_id", account.AccountID).Msg("Failed to build closure payout")
+		return nil, "Balance cannot be paid out", err
+	}
+	if config.Current().Recovery.TransactionTimeout > 0 {
+		payout.TimeoutAt = time.Now().Add(config.Current().Recovery.TransactionTimeout)
+	}
+
+	// both accounts and the currencies are checked as for any new transfer
+	msg, err := c.initTransaction.validateTransactionConfig(ctx, payout, requester, requestId)
+	if err != nil {
+		return nil, msg, err
+	}
+
+	if err = c.transactionRepo.CreateTransaction(payout); err != nil {
+		logging.Logger.Error().Err(err).
+			Str("account_id", account.AccountID).
+			Str("transaction_id", payout.ID).
+			Msg("Failed to create closure payout")
+		return nil, "Failed to create closure payout", custom_err.ErrDatabase
+	}
+
+	return c.initTransaction.processTransaction(ctx, payout, requester, requestId)
+}
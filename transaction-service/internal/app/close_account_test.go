@@ -0,0 +1,221 @@
+package app
+
+import (
+	"context"
+	"testing"
+	"transaction-service/internal/domain/entity"
+	custom_err "transaction-service/internal/domain/error"
+	"transaction-service/internal/domain/money"
+	"transaction-service/internal/ports"
+	"transaction-service/internal/ports/mocks"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type closeAccountMocks struct {
+	sagaRepo        *mocks.MockSagaRepo
+	accountClient   *mocks.MockAccountClient
+	transactionRepo *mocks.MockTransactionRepo
+	eventRepo       *mocks.MockEventRepo
+}
+
+func newTestCloseAccount() (*CloseAccount, closeAccountMocks) {
+	m := closeAccountMocks{
+		sagaRepo:        new(mocks.MockSagaRepo),
+		accountClient:   new(mocks.MockAccountClient),
+		transactionRepo: new(mocks.MockTransactionRepo),
+		eventRepo:       new(mocks.MockEventRepo),
+	}
+	closeAccount := NewCloseAccount(m.transactionRepo, m.accountClient, m.sagaRepo, m.eventRepo, new(mocks.MockExchangeRateRepo))
+	return closeAccount, m
+}
+
+// TestCloseAccount_Execute_PaysOutBalance tests that the balance is transferred to the payout account before closing
+func TestCloseAccount_Execute_PaysOutBalance(t *testing.T) {
+	closeAccount, m := newTestCloseAccount()
+	ctx := context.Background()
+
+	account := ports.AccountInfo{AccountID: "acc-123", CustomerID: "cust-123", Balance: money.MustParse("42.50"), Currency: "USD", ActiveStatus: "active", Version: 3}
+	payoutAccount := ports.AccountInfo{AccountID: "acc-456", CustomerID: "cust-123", Currency: "USD", ActiveStatus: "active", Version: 1}
+
+	storedPayout := &entity.Transaction{}
+	m.accountClient.On("ValidateAndGetAccounts", ctx, []string{"acc-123"}, "teller", "req-1").Return([]ports.AccountInfo{account}, "", nil)
+	m.accountClient.On("SettleAccountInterest", ctx, "acc-123", "teller", "req-1").Return([]ports.InterestPostingInfo{}, "", nil)
+	m.accountClient.On("ValidateAndGetAccounts", ctx, []string{"acc-123", "acc-456"}, "teller", "req-1").Return([]ports.AccountInfo{account, payoutAccount}, "", nil)
+	m.transactionRepo.On("CreateTransaction", mock.MatchedBy(func(payout *entity.Transaction) bool {
+		return payout.Type == entity.TransactionTypeTransfer && payout.Amount == money.MustParse("42.50") &&
+			*payout.DestinationAccountID == "acc-456"
+	})).Run(func(args mock.Arguments) { *storedPayout = *args.Get(0).(*entity.Transaction) }).Return(nil)
+
+	// payout saga
+	m.sagaRepo.On("CreateSaga", mock.AnythingOfType("*entity.TransactionSaga")).Return(nil)
+	m.sagaRepo.On("UpdateSaga", mock.AnythingOfType("*entity.TransactionSaga")).Return(nil)
+	m.transactionRepo.On("UpdateTransactionExchange", mock.AnythingOfType("string"), money.MustParse("42.50"), money.OneRate).Return(nil)
+	m.accountClient.On("LockAccounts", ctx, []string{"acc-123", "acc-456"}, mock.Anything, "teller", "req-1").Return("", nil)
+	m.accountClient.On("UpdateAccountsBalance", ctx, mock.Anything,
+		mock.MatchedBy(func(updates []ports.AccountBalanceUpdate) bool {
+			return len(updates) == 2 && updates[0].NewBalance.IsZero() && updates[1].NewBalance == money.MustParse("42.50")
+		}),
+		false, "teller", "req-1").Return([]ports.AccountBalanceUpdateResponse{}, "", nil)
+	m.transactionRepo.On("UpdateTransactionStatus", mock.Anything, entity.TransactionStatusSuccessful, "").Return(nil)
+	m.accountClient.On("UnlockAccounts", ctx, mock.Anything, "teller", "req-1").Return("", nil)
+	m.transactionRepo.On("UpdateTransactionStatus", mock.Anything, entity.TransactionStatusCompleted, "").Return(nil)
+	m.transactionRepo.On("GetTransactionByID", mock.AnythingOfType("string")).Return(storedPayout, nil)
+	m.eventRepo.On("CreateEvent", mock.AnythingOfType("*entity.Event")).Return(nil)
+
+	m.accountClient.On("CloseAccount", ctx, "acc-123", "acc-456", mock.AnythingOfType("string"), "customer request", "teller", "req-1").
+		Return("Account closed", nil)
+
+	payout, msg, err := closeAccount.Execute(ctx, "acc-123", "acc-456", "customer request", "teller", "req-1")
+
+	assert.NoError(t, err)
+	assert.Equal(t, "Account closed successfully", msg)
+	assert.Contains(t, payout.ReferenceID, "account-closure:acc-123:")
+	m.accountClient.AssertCalled(t, "CloseAccount", ctx, "acc-123", "acc-456", payout.ID, "customer request", "teller", "req-1")
+	m.accountClient.AssertExpectations(t)
+	m.transactionRepo.AssertExpectations(t)
+}
+
+// TestCloseAccount_Execute_PaysOutAccruedInterest tests that the accrued interest is credited and paid out with the balance
+func TestCloseAccount_Execute_PaysOutAccruedInterest(t *testing.T) {
+	closeAccount, m := newTestCloseAccount()
+	ctx := context.Background()
+
+	account := ports.AccountInfo{AccountID: "acc-123", CustomerID: "cust-123", Balance: money.MustParse("42.50"), Currency: "USD", ActiveStatus: "active", Version: 3}
+	creditedAccount := ports.AccountInfo{AccountID: "acc-123", CustomerID: "cust-123", Balance: money.MustParse("43.75"), Currency: "USD", ActiveStatus: "active", Version: 4}
+	payoutAccount := ports.AccountInfo{AccountID: "acc-456", CustomerID: "cust-123", Currency: "USD", ActiveStatus: "active", Version: 1}
+	posting := ports.InterestPostingInfo{PostingID: "posting-1", AccountID: "acc-123", Period: "2026-05-09", Amount: money.MustParse("1.25"), Currency: "USD"}
+
+	m.accountClient.On("ValidateAndGetAccounts", ctx, []string{"acc-123"}, "teller", "req-1").Return([]ports.AccountInfo{account}, "", nil).Once()
+	m.accountClient.On("SettleAccountInterest", ctx, "acc-123", "teller", "req-1").Return([]ports.InterestPostingInfo{posting}, "", nil)
+
+	// interest credit saga
+	storedCredit := &entity.Transaction{}
+	m.transactionRepo.On("GetInterestCredit", "posting-1").Return(nil, nil)
+	m.accountClient.On("ValidateAndGetAccounts", ctx, []string{"acc-123"}, entity.InterestRequester, "req-1").Return([]ports.AccountInfo{account}, "", nil)
+	m.transactionRepo.On("CreateTransaction", mock.MatchedBy(func(credit *entity.Transaction) bool {
+		return credit.IsInterestCredit() && *credit.InterestPostingID == "posting-1"
+	})).Run(func(args mock.Arguments) {
+		*storedCredit = *args.Get(0).(*entity.Transaction)
+		storedCredit.TransactionStatus = entity.TransactionStatusCompleted
+	}).Return(nil)
+	m.accountClient.On("LockAccounts", ctx, []string{"acc-123"}, mock.Anything, entity.InterestRequester, "req-1").Return("", nil)
+	m.accountClient.On("UpdateAccountsBalance", ctx, mock.Anything,
+		mock.MatchedBy(func(updates []ports.AccountBalanceUpdate) bool {
+			return len(updates) == 1 && updates[0].NewBalance == money.MustParse("43.75") && updates[0].InterestPostingID == "posting-1"
+		}),
+		false, entity.InterestRequester, "req-1").Return([]ports.AccountBalanceUpdateResponse{}, "", nil)
+	m.accountClient.On("UnlockAccounts", ctx, mock.Anything, entity.InterestRequester, "req-1").Return("", nil)
+	m.transactionRepo.On("GetTransactionByID", mock.AnythingOfType("string")).Return(storedCredit, nil).Once()
+
+	// the payout reads the credited balance
+	storedPayout := &entity.Transaction{}
+	m.accountClient.On("ValidateAndGetAccounts", ctx, []string{"acc-123"}, "teller", "req-1").Return([]ports.AccountInfo{creditedAccount}, "", nil)
+	m.accountClient.On("ValidateAndGetAccounts", ctx, []string{"acc-123", "acc-456"}, "teller", "req-1").Return([]ports.AccountInfo{creditedAccount, payoutAccount}, "", nil)
+	m.transactionRepo.On("CreateTransaction", mock.MatchedBy(func(payout *entity.Transaction) bool {
+		return payout.Type == entity.TransactionTypeTransfer && payout.Amount == money.MustParse("43.75")
+	})).Run(func(args mock.Arguments) { *storedPayout = *args.Get(0).(*entity.Transaction) }).Return(nil)
+	m.accountClient.On("LockAccounts", ctx, []string{"acc-123", "acc-456"}, mock.Anything, "teller", "req-1").Return("", nil)
+	m.accountClient.On("UpdateAccountsBalance", ctx, mock.Anything,
+		mock.MatchedBy(func(updates []ports.AccountBalanceUpdate) bool {
+			return len(updates) == 2 && updates[0].NewBalance.IsZero() && updates[1].NewBalance == money.MustParse("43.75")
+		}),
+		false, "teller", "req-1").Return([]ports.AccountBalanceUpdateResponse{}, "", nil)
+	m.accountClient.On("UnlockAccounts", ctx, mock.Anything, "teller", "req-1").Return("", nil)
+	m.transactionRepo.On("GetTransactionByID", mock.AnythingOfType("string")).Return(storedPayout, nil).Once()
+
+	m.sagaRepo.On("CreateSaga", mock.AnythingOfType("*entity.TransactionSaga")).Return(nil)
+	m.sagaRepo.On("UpdateSaga", mock.AnythingOfType("*entity.TransactionSaga")).Return(nil)
+	m.transactionRepo.On("UpdateTransactionExchange", mock.AnythingOfType("string"), mock.Anything, money.OneRate).Return(nil)
+	m.transactionRepo.On("UpdateTransactionStatus", mock.Anything, entity.TransactionStatusSuccessful, "").Return(nil)
+	m.transactionRepo.On("UpdateTransactionStatus", mock.Anything, entity.TransactionStatusCompleted, "").Return(nil)
+	m.eventRepo.On("CreateEvent", mock.AnythingOfType("*entity.Event")).Return(nil)
+
+	m.accountClient.On("CloseAccount", ctx, "acc-123", "acc-456", mock.AnythingOfType("string"), "customer request", "teller", "req-1").
+		Return("Account closed", nil)
+
+	payout, msg, err := closeAccount.Execute(ctx, "acc-123", "acc-456", "customer request", "teller", "req-1")
+
+	assert.NoError(t, err)
+	assert.Equal(t, "Account closed successfully", msg)
+	assert.Equal(t, money.MustParse("43.75"), payout.Amount)
+	m.accountClient.AssertExpectations(t)
+	m.transactionRepo.AssertExpectations(t)
+}
+
+// TestCloseAccount_Execute_InterestCreditInProgress tests that the balance is not paid out while interest is still being credited
+func TestCloseAccount_Execute_InterestCreditInProgress(t *testing.T) {
+	closeAccount, m := newTestCloseAccount()
+	ctx := context.Background()
+
+	account := ports.AccountInfo{AccountID: "acc-123", Balance: money.MustParse("42.50"), Currency: "USD", ActiveStatus: "active"}
+	posting := ports.InterestPostingInfo{PostingID: "posting-1", AccountID: "acc-123", Period: "2026-04", Amount: money.MustParse("1.25"), Currency: "USD"}
+
+	m.accountClient.On("ValidateAndGetAccounts", ctx, []string{"acc-123"}, "teller", "req-1").Return([]ports.AccountInfo{account}, "", nil)
+	m.accountClient.On("SettleAccountInterest", ctx, "acc-123", "teller", "req-1").Return([]ports.InterestPostingInfo{posting}, "", nil)
+	m.transactionRepo.On("GetInterestCredit", "posting-1").
+		Return(&entity.Transaction{ID: "txn-1", Type: entity.TransactionTypeInterestCredit, TransactionStatus: entity.TransactionStatusPending}, nil)
+
+	payout, msg, err := closeAccount.Execute(ctx, "acc-123", "acc-456", "customer request", "teller", "req-1")
+
+	assert.ErrorIs(t, err, custom_err.ErrInterestCreditFailed)
+	assert.Equal(t, "Interest credit of the account is still in progress", msg)
+	assert.Nil(t, payout)
+	m.transactionRepo.AssertNotCalled(t, "CreateTransaction", mock.Anything)
+	m.accountClient.AssertNotCalled(t, "CloseAccount", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+// TestCloseAccount_Execute_EmptyAccount tests that an empty account is closed without a payout
+func TestCloseAccount_Execute_EmptyAccount(t *testing.T) {
+	closeAccount, m := newTestCloseAccount()
+	ctx := context.Background()
+
+	account := ports.AccountInfo{AccountID: "acc-123", Currency: "USD", ActiveStatus: "active"}
+	m.accountClient.On("ValidateAndGetAccounts", ctx, []string{"acc-123"}, "teller", "req-1").Return([]ports.AccountInfo{account}, "", nil)
+	m.accountClient.On("SettleAccountInterest", ctx, "acc-123", "teller", "req-1").Return([]ports.InterestPostingInfo{}, "", nil)
+	m.accountClient.On("CloseAccount", ctx, "acc-123", "", "", "customer request", "teller", "req-1").Return("Account closed", nil)
+
+	payout, msg, err := closeAccount.Execute(ctx, "acc-123", "", "customer request", "teller", "req-1")
+
+	assert.NoError(t, err)
+	assert.Nil(t, payout)
+	assert.Equal(t, "Account closed successfully", msg)
+	m.transactionRepo.AssertNotCalled(t, "CreateTransaction", mock.Anything)
+}
+
+// TestCloseAccount_Execute_NotClosable tests the accounts that are refused before any money moves
+func TestCloseAccount_Execute_NotClosable(t *testing.T) {
+	tests := []struct {
+		name    string
+		account ports.AccountInfo
+		payout  string
+		msg     string
+		err     error
+	}{
+		{"Frozen", ports.AccountInfo{ActiveStatus: "locked", FreezeMode: "both"}, "acc-456", "Only active accounts can be closed", custom_err.ErrAccountNotClosable},
+		{"Dormant", ports.AccountInfo{ActiveStatus: "inactive", Balance: money.MustParse("10.00")}, "acc-456", "Only active accounts can be closed", custom_err.ErrAccountNotClosable},
+		{"ActiveHolds", ports.AccountInfo{ActiveStatus: "active", Balance: money.MustParse("10.00"), HeldAmount: money.MustParse("5.00")}, "acc-456", "Accounts with active holds cannot be closed", custom_err.ErrAccountNotClosable},
+		{"Overdrawn", ports.AccountInfo{ActiveStatus: "active", Balance: money.MustParse("-1.00")}, "acc-456", "Overdrawn accounts cannot be closed", custom_err.ErrAccountNotClosable},
+		{"PayoutMissing", ports.AccountInfo{ActiveStatus: "active", Balance: money.MustParse("10.00")}, "", "Payout account ID missing", custom_err.ErrValidationFailed},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			closeAccount, m := newTestCloseAccount()
+			ctx := context.Background()
+
+			tt.account.AccountID = "acc-123"
+			m.accountClient.On("ValidateAndGetAccounts", ctx, []string{"acc-123"}, "teller", "req-1").Return([]ports.AccountInfo{tt.account}, "", nil)
+			m.accountClient.On("SettleAccountInterest", ctx, "acc-123", "teller", "req-1").Return([]ports.InterestPostingInfo{}, "", nil).Maybe()
+
+			payout, msg, err := closeAccount.Execute(ctx, "acc-123", tt.payout, "customer request", "teller", "req-1")
+
+			assert.ErrorIs(t, err, tt.err)
+			assert.Equal(t, tt.msg, msg)
+			assert.Nil(t, payout)
+			m.transactionRepo.AssertNotCalled(t, "CreateTransaction", mock.Anything)
+			m.accountClient.AssertNotCalled(t, "CloseAccount", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+		})
+	}
+}
@@ -0,0 +1,126 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+	"transaction-service/internal/domain/entity"
+	custom_err "transaction-service/internal/domain/error"
+	"transaction-service/internal/domain/money"
+	"transaction-service/internal/logging"
+	"transaction-service/internal/messaging"
+	"transaction-service/internal/observability/metrics"
+	"transaction-service/internal/ports"
+)
+
+// CreateStandingOrder is a use-case for scheduling a recurring or one-off transfer
+type CreateStandingOrder struct {
+	StandingOrderRepo ports.StandingOrderRepo
+	AccountClient     ports.AccountClient
+	EventRepo         ports.EventRepo
+}
+
+// NewCreateStandingOrder creates a new CreateStandingOrder use-case
+func NewCreateStandingOrder(standingOrderRepo ports.StandingOrderRepo, accountClient ports.AccountClient, eventRepo ports.EventRepo) *CreateStandingOrder {
+	return &CreateStandingOrder{
+		StandingOrderRepo: standingOrderRepo,
+		AccountClient:     accountClient,
+		EventRepo:         eventRepo,
+	}
+}
+
+// Execute validates the schedule and both accounts and stores the order. A zero startAt schedules
+// the first run right away.
+func (c *CreateStandingOrder) Execute(
+	ctx context.Context,
+	sourceAccountID,
+	destinationAccountID string,
+	amount money.Amount,
+	frequency string,
+	startAt time.Time,
+	endAt *time.Time,
+	maxRuns int,
+	description,
+	requester,
+	requestId string,
+) (*entity.StandingOrder, string, error) {
+	metrics.IncRequestActive()
+	defer metrics.DecRequestActive()
+
+	var err error
+	defer func() {
+		metrics.RecordOperation("create_standing_order", err)
+	}()
+
+	if strings.TrimSpace(requester) == "" {
+		err = custom_err.ErrMissingRequester
+		logging.Logger.Error().Err(err).Msg("Unknown requester")
+		return nil, "Unknown requester", err
+	}
+
+	sourceAccountID = strings.TrimSpace(sourceAccountID)
+	destinationAccountID = strings.TrimSpace(destinationAccountID)
+	frequency = strings.ToLower(strings.TrimSpace(frequency))
+
+	now := time.Now()
+	if startAt.IsZero() {
+		startAt = now
+	}
+	if startAt.Before(now.Add(-time.Minute)) {
+		err = custom_err.ErrInvalidSchedule
+		logging.Logger.Error().Err(err).Time("start_at", startAt).Msg("Standing order starts in the past")
+		return nil, "Start time must not be in the past", err
+	}
+
+	order, err := entity.NewStandingOrder(sourceAccountID, destinationAccountID, amount, frequency, startAt, endAt, maxRuns, strings.TrimSpace(description), requester)
+	if err != nil {
+		logging.Logger.Error().Err(err).
+			Str("source_account_id", sourceAccountID).
+			Str("destination_account_id", destinationAccountID).
+			Str("frequency", frequency).
+			Msg("Invalid standing order")
+		switch {
+		case errors.Is(err, custom_err.ErrInvalidAmount):
+			return nil, "Invalid amount for standing order", err
+		case errors.Is(err, custom_err.ErrInvalidSchedule):
+			return nil, "Invalid schedule, frequency must be once, daily, weekly or monthly and end time must be after start time", err
+		}
+		return nil, "Source and destination account must be different", err
+	}
+	order.CreatedByRole = RequesterRole(ctx)
+
+	accountsInfo, message, err := c.AccountClient.ValidateAndGetAccounts(ctx, []string{sourceAccountID, destinationAccountID}, requester, requestId)
+	if err != nil || len(accountsInfo) != 2 {
+		logging.Logger.Error().Err(err).
+			Str("message", message).
+			Str("source_account_id", sourceAccountID).
+			Str("destination_account_id", destinationAccountID).
+			Msg("Failed to validate standing order accounts")
+		err = custom_err.ErrValidationFailed
+		return nil, "Failed to validate accounts", err
+	}
+
+	if err = c.StandingOrderRepo.CreateStandingOrder(order); err != nil {
+		logging.Logger.Error().Err(err).Str("standing_order_id", order.ID).Msg("Failed to save standing order")
+		err = custom_err.ErrDatabase
+		return nil, "Failed to save standing order", err
+	}
+
+	eventData := map[string]interface{}{
+		"standing_order_id": order.ID,
+		"frequency":         order.Frequency,
+		"created_by":        requester,
+		"request_id":        requestId,
+	}
+
+	event, eventErr := entity.NewEvent(entity.EventTypeStandingOrderCreated, order.ID, entity.EventAggregateTypeStanding, requester, eventData)
+	if eventErr == nil {
+		if createErr := c.EventRepo.CreateEvent(event); createErr != nil {
+			logging.Logger.Error().Err(createErr).Str("event_type", event.Type).Msg("Failed to create event")
+		}
+		_ = messaging.GetService().PublishToDefaultTopic(messaging.Message{Content: order.ToString(), Status: true, Type: messaging.MessageTypeStandingOrderCreated})
+	}
+
+	return order, "Standing order created successfully", nil
+}
@@ -0,0 +1,324 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"transaction-service/internal/config"
+	"transaction-service/internal/domain/entity"
+	custom_err "transaction-service/internal/domain/error"
+	"transaction-service/internal/domain/money"
+	"transaction-service/internal/logging"
+	"transaction-service/internal/messaging"
+	"transaction-service/internal/observability/metrics"
+	"transaction-service/internal/ports"
+)
+
+// maxReportedBatchErrors caps the line errors listed in the response to an invalid batch
+const maxReportedBatchErrors = 20
+
+// TransactionBatchInstruction is one transfer of a batch as submitted by the client
+type TransactionBatchInstruction struct {
+	DestinationAccountID string
+	Amount               money.Amount
+	ReferenceID          string
+}
+
+// CreateTransactionBatch is a use-case for validating and queueing a bulk transfer batch, e.g. a payroll file
+type CreateTransactionBatch struct {
+	batchRepo        ports.TransactionBatchRepo
+	accountClient    ports.AccountClient
+	eventRepo        ports.EventRepo
+	exchangeRateRepo ports.ExchangeRateRepo
+	transactionQueue ports.TransactionQueue
+	processor        *ProcessTransactionBatch
+}
+
+// NewCreateTransactionBatch creates a new CreateTransactionBatch use-case
+func NewCreateTransactionBatch(
+	batchRepo ports.TransactionBatchRepo,
+	transactionRepo ports.TransactionRepo,
+	accountClient ports.AccountClient,
+	sagaRepo ports.SagaRepo,
+	eventRepo ports.EventRepo,
+	exchangeRateRepo ports.ExchangeRateRepo,
+	limitRepo ports.TransactionLimitRepo,
+	feeRuleRepo ports.FeeRuleRepo,
+	transactionQueue ports.TransactionQueue,
+) *CreateTransactionBatch {
+
+	return &CreateTransactionBatch{
+		batchRepo:        batchRepo,
+		accountClient:    accountClient,
+		eventRepo:        eventRepo,
+		exchangeRateRepo: exchangeRateRepo,
+		transactionQueue: transactionQueue,
+		processor:        NewProcessTransactionBatch(batchRepo, transactionRepo, accountClient, sagaRepo, eventRepo, exchangeRateRepo, limitRepo, feeRuleRepo),
+	}
+}
+
+// Execute validates every instruction up front, stores the batch and hands it to the worker pool. Nothing is
+// stored if any instruction is invalid. A retry with a reference already used by the requester returns the
+// existing batch.
+func (c *CreateTransactionBatch) Execute(
+	ctx context.Context,
+	sourceAccountID,
+	mode,
+	referenceID string,
+	instructions []TransactionBatchInstruction,
+	requester,
+	requestId string,
+) (*entity.TransactionBatch, []*entity.TransactionBatchLine, string, error) {
+	metrics.IncRequestActive()
+	defer metrics.DecRequestActive()
+
+	var err error
+	defer func() {
+		metrics.RecordOperation("create_transaction_batch", err)
+	}()
+
+	if strings.TrimSpace(requester) == "" {
+		err = custom_err.ErrMissingRequester
+		logging.Logger.Error().Err(err).Msg("Unknown requester")
+		return nil, nil, "Unknown requester", err
+	}
+
+	sourceAccountID = strings.TrimSpace(sourceAccountID)
+	if sourceAccountID == "" {
+		err = custom_err.ErrMissingSourceAccountId
+		return nil, nil, "Source Account ID missing", err
+	}
+
+	referenceID = strings.TrimSpace(referenceID)
+	if referenceID == "" {
+		err = custom_err.ErrMissingReferenceID
+		return nil, nil, "Reference ID missing", err
+	}
+
+	mode = strings.ToLower(strings.TrimSpace(mode))
+	if mode == "" {
+		mode = entity.TransactionBatchModeBestEffort
+	}
+	if !entity.IsValidTransactionBatchMode(mode) {
+		err = custom_err.ErrInvalidTransactionBatch
+		return nil, nil, "Invalid batch mode, mode must be all_or_nothing or best_effort", err
+	}
+
+	existingBatch, existingLines, msg, err := c.findDuplicateBatch(sourceAccountID, referenceID, len(instructions), requester)
+	if err != nil || existingBatch != nil {
+		return existingBatch, existingLines, msg, err
+	}
+
+	if len(instructions) == 0 {
+		err = custom_err.ErrInvalidTransactionBatch
+		return nil, nil, "A batch must have at least one line", err
+	}
+	if maxLines := config.Current().Batch.MaxLines; maxLines > 0 && len(instructions) > maxLines {
+		err = custom_err.ErrInvalidTransactionBatch
+		return nil, nil, fmt.Sprintf("A batch must not have more than %d lines", maxLines), err
+	}
+
+	batch := entity.NewTransactionBatch(sourceAccountID, mode, referenceID, requester)
+	batch.CreatedByRole = RequesterRole(ctx)
+	lines := make([]*entity.TransactionBatchLine, 0, len(instructions))
+	var lineErrors []string
+	for i, instruction := range instructions {
+		destinationAccountID := strings.TrimSpace(instruction.DestinationAccountID)
+		switch {
+		case destinationAccountID == "":
+			lineErrors = append(lineErrors, fmt.Sprintf("line %d: destination account ID missing", i+1))
+		case destinationAccountID == sourceAccountID:
+			lineErrors = append(lineErrors, fmt.Sprintf("line %d: cannot transfer amount to the same account", i+1))
+		case !instruction.Amount.IsPositive():
+			lineErrors = append(lineErrors, fmt.Sprintf("line %d: amount must be positive", i+1))
+		}
+		lines = append(lines, batch.AddLine(destinationAccountID, instruction.Amount, strings.TrimSpace(instruction.ReferenceID)))
+	}
+	if len(lineErrors) == 0 {
+		lineErrors = c.validateAccounts(ctx, batch, lines, requester, requestId)
+	}
+	if len(lineErrors) > 0 {
+		err = custom_err.ErrInvalidTransactionBatch
+		logging.Logger.Warn().Err(err).
+			Str("source_account_id", sourceAccountID).
+			Str("reference_id", referenceID).
+			Int("invalid_lines", len(lineErrors)).
+			Msg("Invalid transaction batch")
+		return nil, nil, "Invalid transaction batch: " + joinBatchErrors(lineErrors), err
+	}
+
+	err = c.batchRepo.CreateTransactionBatch(batch, lines)
+	if errors.Is(err, custom_err.ErrDuplicateReference) {
+		// a concurrent request with the same reference was stored first
+		existingBatch, existingLines, msg, err = c.findDuplicateBatch(sourceAccountID, referenceID, len(instructions), requester)
+		if err == nil && existingBatch == nil {
+			err = custom_err.ErrDuplicateReference
+			msg = "Duplicate batch reference"
+		}
+		return existingBatch, existingLines, msg, err
+	}
+	if err != nil {
+		logging.Logger.Error().Err(err).Str("batch_id", batch.ID).Msg("Failed to save transaction batch")
+		err = custom_err.ErrDatabase
+		return nil, nil, "Failed to save transaction batch", err
+	}
+
+	eventData := map[string]interface{}{
+		"batch_id":     batch.ID,
+		"mode":         batch.Mode,
+		"line_count":   batch.LineCount,
+		"total_amount": batch.TotalAmount.String(),
+		"created_by":   requester,
+		"request_id":   requestId,
+	}
+
+	event, eventErr := entity.NewEvent(entity.EventTypeBatchCreated, batch.ID, entity.EventAggregateTypeBatch, requester, eventData)
+	if eventErr == nil {
+		if createErr := c.eventRepo.CreateEvent(event); createErr != nil {
+			logging.Logger.Error().Err(createErr).Str("event_type", event.Type).Msg("Failed to create event")
+		}
+		_ = messaging.GetService().PublishToDefaultTopic(messaging.Message{Content: batch.ToString(), Status: true, Type: messaging.MessageTypeBatchCreated})
+	}
+
+	// the worker updates its own copies while the caller reads the pending ones
+	queuedBatch := *batch
+	queuedLines := make([]*entity.TransactionBatchLine, len(lines))
+	for i, line := range lines {
+		queuedLine := *line
+		queuedLines[i] = &queuedLine
+	}
+	err = c.transactionQueue.Submit(func(ctx context.Context) {
+		_ = c.processor.Execute(ctx, batch, requestId)
+	})
+	if err != nil {
+		// no line ran, so the batch fails as a whole instead of being left pending
+		for _, line := range lines {
+			line.SetOutcome(entity.TransactionBatchLineStatusSkipped, nil, "Transaction queue is full")
+			_ = c.batchRepo.UpdateTransactionBatchLine(line)
+		}
+		batch.Finish(lines, "transaction queue is full")
+		_ = c.batchRepo.UpdateTransactionBatch(batch)
+		logging.Logger.Error().Err(err).Str("batch_id", batch.ID).Msg("Failed to queue transaction batch")
+		return nil, nil, "Transaction queue is full, try again later", err
+	}
+
+	logging.Logger.Info().
+		Str("batch_id", batch.ID).
+		Str("batch_mode", batch.Mode).
+		Int("line_count", batch.LineCount).
+		Msg("Transaction batch queued")
+	return &queuedBatch, queuedLines, "Transaction batch accepted for processing", nil
+}
+
+// validateAccounts checks every account of the batch in one call, that cross-currency lines have an exchange
+// rate and, for all-or-nothing batches, that the source balance covers the whole batch
+func (c *CreateTransactionBatch) validateAccounts(ctx context.Context, batch *entity.TransactionBatch, lines []*entity.TransactionBatchLine, requester, requestId string) []string {
+	accountIDs := []string{batch.SourceAccountID}
+	seen := map[string]bool{batch.SourceAccountID: true}
+	for _, line := range lines {
+		if !seen[line.DestinationAccountID] {
+			seen[line.DestinationAccountID] = true
+			accountIDs = append(accountIDs, line.DestinationAccountID)
+		}
+	}
+
+	accountsInfo, message, err := c.accountClient.ValidateAndGetAccounts(ctx, accountIDs, requester, requestId)
+	if err != nil {
+		logging.Logger.Error().Err(err).
+			Str("message", message).
+			Str("source_account_id", batch.SourceAccountID).
+			Int("account_count", len(accountIDs)).
+			Msg("Failed to validate transaction batch accounts")
+		if message == "" {
+			message = "failed to validate accounts"
+		}
+		return []string{message}
+	}
+
+	accounts := make(map[string]ports.AccountInfo, len(accountsInfo))
+	for _, accountInfo := range accountsInfo {
+		accounts[accountInfo.AccountID] = accountInfo
+	}
+
+	source, ok := accounts[batch.SourceAccountID]
+	if !ok {
+		return []string{"source account not found"}
+	}
+
+	var lineErrors []string
+	checkedRates := map[string]bool{}
+	for _, line := range lines {
+		destination, ok := accounts[line.DestinationAccountID]
+		if !ok {
+			lineErrors = append(lineErrors, fmt.Sprintf("line %d: destination account not found", line.LineNumber))
+			continue
+		}
+		if destination.Currency == source.Currency {
+			continue
+		}
+
+		available, checked := checkedRates[destination.Currency]
+		if !checked {
+			exchangeRate, rateErr := c.exchangeRateRepo.GetExchangeRate(source.Currency, destination.Currency)
+			if rateErr != nil {
+				logging.Logger.Error().Err(rateErr).
+					Str("source_currency", source.Currency).
+					Str("destination_currency", destination.Currency).
+					Msg("Failed to get exchange rate")
+			}
+			available = rateErr == nil && exchangeRate != nil
+			checkedRates[destination.Currency] = available
+		}
+		if !available {
+			lineErrors = append(lineErrors, fmt.Sprintf("line %d: exchange rate not available for %s to %s", line.LineNumber, source.Currency, destination.Currency))
+		}
+	}
+
+	if batch.Mode == entity.TransactionBatchModeAllOrNothing && source.AvailableBalance() < batch.TotalAmount {
+		lineErrors = append(lineErrors, fmt.Sprintf("source account balance does not cover the batch total of %s", batch.TotalAmount))
+	}
+	return lineErrors
+}
+
+// findDuplicateBatch returns the batch the requester already created with the reference, or an error if the
+// reference was used for a different batch
+func (c *CreateTransactionBatch) findDuplicateBatch(sourceAccountID, referenceID string, lineCount int, requester string) (*entity.TransactionBatch, []*entity.TransactionBatchLine, string, error) {
+	existingBatch, err := c.batchRepo.GetTransactionBatchByReferenceID(requester, referenceID)
+	if err != nil {
+		logging.Logger.Error().Err(err).Str("reference_id", referenceID).Msg("Failed to check batch reference")
+		return nil, nil, "Failed to check batch reference", custom_err.ErrDatabase
+	}
+
+	if existingBatch == nil {
+		return nil, nil, "", nil
+	}
+
+	if existingBatch.SourceAccountID != sourceAccountID || existingBatch.LineCount != lineCount {
+		logging.Logger.Warn().Err(custom_err.ErrDuplicateReference).
+			Str("reference_id", referenceID).
+			Str("batch_id", existingBatch.ID).
+			Msg("Reference ID reused with a different batch")
+		return nil, nil, "Reference ID already used for a different batch", custom_err.ErrDuplicateReference
+	}
+
+	existingLines, err := c.batchRepo.GetTransactionBatchLines(existingBatch.ID)
+	if err != nil {
+		logging.Logger.Error().Err(err).Str("batch_id", existingBatch.ID).Msg("Failed to get transaction batch lines")
+		return nil, nil, "Failed to get transaction batch", custom_err.ErrDatabase
+	}
+
+	logging.Logger.Info().
+		Str("reference_id", referenceID).
+		Str("batch_id", existingBatch.ID).
+		Str("batch_status", existingBatch.Status).
+		Msg("Duplicate transaction batch request")
+	return existingBatch, existingLines, "Transaction batch already created with this reference", nil
+}
+
+func joinBatchErrors(lineErrors []string) string {
+	if len(lineErrors) <= maxReportedBatchErrors {
+		return strings.Join(lineErrors, "; ")
+	}
+	return fmt.Sprintf("%s; and %d more", strings.Join(lineErrors[:maxReportedBatchErrors], "; "), len(lineErrors)-maxReportedBatchErrors)
+}
@@ -0,0 +1,158 @@
+package app
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"transaction-service/internal/domain/entity"
+	custom_err "transaction-service/internal/domain/error"
+	"transaction-service/internal/domain/money"
+	"transaction-service/internal/ports"
+	"transaction-service/internal/ports/mocks"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type transactionBatchMocks struct {
+	batchRepo        *mocks.MockTransactionBatchRepo
+	transactionRepo  *mocks.MockTransactionRepo
+	accountClient    *mocks.MockAccountClient
+	sagaRepo         *mocks.MockSagaRepo
+	eventRepo        *mocks.MockEventRepo
+	exchangeRateRepo *mocks.MockExchangeRateRepo
+	transactionQueue *mocks.MockTransactionQueue
+}
+
+func newTransactionBatchMocks() transactionBatchMocks {
+	return transactionBatchMocks{
+		batchRepo:        new(mocks.MockTransactionBatchRepo),
+		transactionRepo:  new(mocks.MockTransactionRepo),
+		accountClient:    new(mocks.MockAccountClient),
+		sagaRepo:         new(mocks.MockSagaRepo),
+		eventRepo:        new(mocks.MockEventRepo),
+		exchangeRateRepo: new(mocks.MockExchangeRateRepo),
+		transactionQueue: new(mocks.MockTransactionQueue),
+	}
+}
+
+func newTestCreateTransactionBatch(m transactionBatchMocks) *CreateTransactionBatch {
+	return NewCreateTransactionBatch(m.batchRepo, m.transactionRepo, m.accountClient, m.sagaRepo, m.eventRepo, m.exchangeRateRepo, nil, nil, m.transactionQueue)
+}
+
+func payrollInstructions() []TransactionBatchInstruction {
+	return []TransactionBatchInstruction{
+		{DestinationAccountID: "acc-456", Amount: money.MustParse("100.00"), ReferenceID: "emp-1"},
+		{DestinationAccountID: "acc-789", Amount: money.MustParse("150.00"), ReferenceID: "emp-2"},
+	}
+}
+
+// TestCreateTransactionBatch_Execute_Success tests that a valid batch is stored with its lines and queued
+func TestCreateTransactionBatch_Execute_Success(t *testing.T) {
+	m := newTransactionBatchMocks()
+	createBatch := newTestCreateTransactionBatch(m)
+	ctx := context.Background()
+
+	accountsInfo := []ports.AccountInfo{
+		{AccountID: "acc-123", Balance: money.MustParse("200.00"), Currency: entity.CurrencyEUR},
+		{AccountID: "acc-456", Currency: entity.CurrencyEUR},
+		{AccountID: "acc-789", Currency: entity.CurrencyEUR},
+	}
+
+	m.batchRepo.On("GetTransactionBatchByReferenceID", "admin", "payroll-2026-10").Return(nil, nil)
+	m.accountClient.On("ValidateAndGetAccounts", ctx, []string{"acc-123", "acc-456", "acc-789"}, "admin", "req-1").Return(accountsInfo, "", nil)
+	m.batchRepo.On("CreateTransactionBatch", mock.AnythingOfType("*entity.TransactionBatch"), mock.AnythingOfType("[]*entity.TransactionBatchLine")).Return(nil)
+	m.eventRepo.On("CreateEvent", mock.AnythingOfType("*entity.Event")).Return(nil)
+	m.transactionQueue.On("Submit", mock.Anything).Return(nil)
+
+	batch, lines, msg, err := createBatch.Execute(ctx, "acc-123", "", "payroll-2026-10", payrollInstructions(), "admin", "req-1")
+
+	assert.NoError(t, err)
+	assert.Equal(t, "Transaction batch accepted for processing", msg)
+	assert.Equal(t, entity.TransactionBatchModeBestEffort, batch.Mode)
+	assert.Equal(t, entity.TransactionBatchStatusPending, batch.Status)
+	assert.Equal(t, 2, batch.LineCount)
+	assert.Equal(t, money.MustParse("250.00"), batch.TotalAmount)
+	assert.Len(t, lines, 2)
+	assert.Equal(t, 2, lines[1].LineNumber)
+	assert.Equal(t, "emp-2", lines[1].ReferenceID)
+	assert.Equal(t, entity.TransactionBatchLineStatusPending, lines[1].Status)
+	m.transactionQueue.AssertExpectations(t)
+	m.batchRepo.AssertExpectations(t)
+}
+
+// TestCreateTransactionBatch_Execute_InvalidLines tests that every invalid line is reported and nothing is stored
+func TestCreateTransactionBatch_Execute_InvalidLines(t *testing.T) {
+	m := newTransactionBatchMocks()
+	createBatch := newTestCreateTransactionBatch(m)
+
+	instructions := []TransactionBatchInstruction{
+		{DestinationAccountID: "acc-456", Amount: money.MustParse("100.00")},
+		{DestinationAccountID: "", Amount: money.MustParse("100.00")},
+		{DestinationAccountID: "acc-123", Amount: money.MustParse("100.00")},
+		{DestinationAccountID: "acc-789", Amount: money.Zero},
+	}
+	m.batchRepo.On("GetTransactionBatchByReferenceID", "admin", "payroll-2026-10").Return(nil, nil)
+
+	batch, _, msg, err := createBatch.Execute(context.Background(), "acc-123", entity.TransactionBatchModeBestEffort, "payroll-2026-10", instructions, "admin", "req-1")
+
+	assert.ErrorIs(t, err, custom_err.ErrInvalidTransactionBatch)
+	assert.Nil(t, batch)
+	assert.Equal(t, "Invalid transaction batch: line 2: destination account ID missing; "+
+		"line 3: cannot transfer amount to the same account; line 4: amount must be positive", msg)
+	m.accountClient.AssertNotCalled(t, "ValidateAndGetAccounts", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	m.batchRepo.AssertNotCalled(t, "CreateTransactionBatch", mock.Anything, mock.Anything)
+}
+
+// TestCreateTransactionBatch_Execute_AllOrNothingInsufficientBalance tests that an all-or-nothing batch must be
+// covered by the source balance and a cross-currency line needs an exchange rate
+func TestCreateTransactionBatch_Execute_AllOrNothingInsufficientBalance(t *testing.T) {
+	m := newTransactionBatchMocks()
+	createBatch := newTestCreateTransactionBatch(m)
+	ctx := context.Background()
+
+	accountsInfo := []ports.AccountInfo{
+		{AccountID: "acc-123", Balance: money.MustParse("200.00"), Currency: entity.CurrencyEUR},
+		{AccountID: "acc-456", Currency: entity.CurrencyEUR},
+		{AccountID: "acc-789", Currency: entity.CurrencyBDT},
+	}
+
+	m.batchRepo.On("GetTransactionBatchByReferenceID", "admin", "payroll-2026-10").Return(nil, nil)
+	m.accountClient.On("ValidateAndGetAccounts", ctx, []string{"acc-123", "acc-456", "acc-789"}, "admin", "req-1").Return(accountsInfo, "", nil)
+	m.exchangeRateRepo.On("GetExchangeRate", entity.CurrencyEUR, entity.CurrencyBDT).Return(nil, nil)
+
+	batch, _, msg, err := createBatch.Execute(ctx, "acc-123", entity.TransactionBatchModeAllOrNothing, "payroll-2026-10", payrollInstructions(), "admin", "req-1")
+
+	assert.ErrorIs(t, err, custom_err.ErrInvalidTransactionBatch)
+	assert.Nil(t, batch)
+	assert.Equal(t, "Invalid transaction batch: line 2: exchange rate not available for EUR to BDT; "+
+		"source account balance does not cover the batch total of 250.00", msg)
+	m.batchRepo.AssertNotCalled(t, "CreateTransactionBatch", mock.Anything, mock.Anything)
+}
+
+// TestCreateTransactionBatch_Execute_DuplicateReference tests that a retried batch returns the existing one
+func TestCreateTransactionBatch_Execute_DuplicateReference(t *testing.T) {
+	m := newTransactionBatchMocks()
+	createBatch := newTestCreateTransactionBatch(m)
+
+	existing := entity.NewTransactionBatch("acc-123", entity.TransactionBatchModeBestEffort, "payroll-2026-10", "admin")
+	existingLines := []*entity.TransactionBatchLine{
+		existing.AddLine("acc-456", money.MustParse("100.00"), "emp-1"),
+		existing.AddLine("acc-789", money.MustParse("150.00"), "emp-2"),
+	}
+	m.batchRepo.On("GetTransactionBatchByReferenceID", "admin", "payroll-2026-10").Return(existing, nil)
+	m.batchRepo.On("GetTransactionBatchLines", existing.ID).Return(existingLines, nil)
+
+	batch, lines, msg, err := createBatch.Execute(context.Background(), "acc-123", "", "payroll-2026-10", payrollInstructions(), "admin", "req-1")
+
+	assert.NoError(t, err)
+	assert.Equal(t, existing, batch)
+	assert.Equal(t, existingLines, lines)
+	assert.Equal(t, "Transaction batch already created with this reference", msg)
+	m.transactionQueue.AssertNotCalled(t, "Submit", mock.Anything)
+
+	// the same reference for another source account is rejected
+	_, _, msg, err = createBatch.Execute(context.Background(), "acc-999", "", "payroll-2026-10", payrollInstructions(), "admin", "req-1")
+	assert.ErrorIs(t, err, custom_err.ErrDuplicateReference)
+	assert.True(t, strings.HasPrefix(msg, "Reference ID already used"))
+}
@@ -0,0 +1,73 @@
+package app
+
+import (
+	"errors"
+	"strings"
+	"transaction-service/internal/domain/entity"
+	custom_err "transaction-service/internal/domain/error"
+	"transaction-service/internal/logging"
+	"transaction-service/internal/messaging"
+	"transaction-service/internal/observability/metrics"
+	"transaction-service/internal/ports"
+)
+
+// DeleteFeeRule is a use-case for removing a fee rule, debits it matched are charged by the next matching rule
+type DeleteFeeRule struct {
+	FeeRuleRepo ports.FeeRuleRepo
+	EventRepo   ports.EventRepo
+}
+
+// NewDeleteFeeRule creates a new DeleteFeeRule use-case
+func NewDeleteFeeRule(feeRuleRepo ports.FeeRuleRepo, eventRepo ports.EventRepo) *DeleteFeeRule {
+	return &DeleteFeeRule{
+		FeeRuleRepo: feeRuleRepo,
+		EventRepo:   eventRepo,
+	}
+}
+
+func (d *DeleteFeeRule) Execute(ruleID, requester, requestId string) (string, error) {
+	metrics.IncRequestActive()
+	defer metrics.DecRequestActive()
+
+	var err error
+	defer func() {
+		metrics.RecordOperation("delete_fee_rule", err)
+	}()
+
+	if strings.TrimSpace(requester) == "" {
+		err = custom_err.ErrMissingRequester
+		logging.Logger.Error().Err(err).Msg("Unknown requester")
+		return "Unknown requester", err
+	}
+
+	ruleID = strings.TrimSpace(ruleID)
+	if ruleID == "" {
+		err = custom_err.ErrInvalidRequest
+		return "Fee rule ID is required", err
+	}
+
+	if err = d.FeeRuleRepo.DeleteFeeRule(ruleID); err != nil {
+		if errors.Is(err, custom_err.ErrFeeRuleNotFound) {
+			return "Fee rule not found", err
+		}
+		logging.Logger.Error().Err(err).Str("fee_rule_id", ruleID).Msg("Failed to delete fee rule")
+		err = custom_err.ErrDatabase
+		return "Failed to delete fee rule", err
+	}
+
+	eventData := map[string]interface{}{
+		"fee_rule_id": ruleID,
+		"deleted_by":  requester,
+		"request_id":  requestId,
+	}
+
+	event, eventErr := entity.NewEvent(entity.EventTypeFeeRuleDeleted, ruleID, entity.EventAggregateTypeFeeRule, requester, eventData)
+	if eventErr == nil {
+		if createErr := d.EventRepo.CreateEvent(event); createErr != nil {
+			logging.Logger.Error().Err(createErr).Str("event_type", event.Type).Msg("Failed to create event")
+		}
+		_ = messaging.GetService().PublishToDefaultTopic(messaging.Message{Content: event.ToString(), Status: true, Type: messaging.MessageTypeFeeRuleDeleted})
+	}
+
+	return "Fee rule deleted successfully", nil
+}
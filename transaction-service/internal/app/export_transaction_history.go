@@ -0,0 +1,78 @@
+package app
+
+import (
+	"fmt"
+	"strings"
+	"time"
+	"transaction-service/internal/domain/entity"
+	custom_err "transaction-service/internal/domain/error"
+	"transaction-service/internal/logging"
+	"transaction-service/internal/observability/metrics"
+	"transaction-service/internal/ports"
+)
+
+// exportBatchSize is the number of transactions read and sent at a time
+const exportBatchSize = 500
+
+// ExportTransactionHistory is a use-case for exporting the full transaction history matching a filter
+type ExportTransactionHistory struct {
+	TransactionRepo ports.TransactionRepo
+}
+
+// NewExportTransactionHistory creates a new ExportTransactionHistory use-case
+func NewExportTransactionHistory(transactionRepo ports.TransactionRepo) *ExportTransactionHistory {
+	return &ExportTransactionHistory{
+		TransactionRepo: transactionRepo,
+	}
+}
+
+// Execute hands every transaction matching the filters to send in batches, in the sort order, without loading the
+// whole history at once. It returns the number of transactions sent. An error from send stops the export.
+func (t *ExportTransactionHistory) Execute(accountID string, customerID string, types []string, startDate, endDate *time.Time, sortOrder, requester, requestId string, send func([]*entity.Transaction) error) (int64, string, error) {
+	metrics.IncRequestActive()
+	defer metrics.DecRequestActive()
+
+	var err error
+	defer func() {
+		metrics.RecordOperation("export_transaction_history", err)
+	}()
+
+	sortOrder = strings.TrimSpace(sortOrder)
+	if sortOrder != "asc" && sortOrder != "desc" {
+		sortOrder = "desc"
+	}
+
+	if startDate != nil && endDate != nil && startDate.After(*endDate) {
+		err = fmt.Errorf("%w: start date cannot be after end date", custom_err.ErrValidationFailed)
+		return 0, "Invalid request - start date cannot be after end date", err
+	}
+
+	var exported int64
+	err = t.TransactionRepo.ExportTransactionHistory(
+		strings.TrimSpace(accountID),
+		strings.TrimSpace(customerID),
+		startDate,
+		endDate,
+		sortOrder,
+		types,
+		exportBatchSize,
+		func(transactions []*entity.Transaction) error {
+			if sendErr := send(transactions); sendErr != nil {
+				return sendErr
+			}
+			exported += int64(len(transactions))
+			return nil
+		})
+	if err != nil {
+		logging.Logger.Error().Err(err).
+			Str("account_id", accountID).
+			Str("customer_id", customerID).
+			Int64("exported", exported).
+			Str("request_id", requestId).
+			Msg("Failed to export transaction history")
+		err = fmt.Errorf("%w: failed to export transaction history", custom_err.ErrDatabase)
+		return exported, "Failed to export transaction history", err
+	}
+
+	return exported, "Transaction history exported", nil
+}
@@ -0,0 +1,78 @@
+package app
+
+import (
+	"errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"testing"
+	"time"
+	"transaction-service/internal/domain/entity"
+	custom_err "transaction-service/internal/domain/error"
+	mock_repo "transaction-service/internal/ports/mocks"
+)
+
+// TestExportTransactionHistory_Execute_Success tests that every batch read from the repo is sent in order
+func TestExportTransactionHistory_Execute_Success(t *testing.T) {
+	mockTransactionRepo := new(mock_repo.MockTransactionRepo)
+	exportHistory := NewExportTransactionHistory(mockTransactionRepo)
+
+	batches := [][]*entity.Transaction{
+		{{ID: "txn-1"}, {ID: "txn-2"}},
+		{{ID: "txn-3"}},
+	}
+	mockTransactionRepo.On("ExportTransactionHistory", "acc-123", "", (*time.Time)(nil), (*time.Time)(nil), "asc", []string{entity.TransactionTypeTransfer}, exportBatchSize, mock.Anything).
+		Run(func(args mock.Arguments) {
+			fn := args.Get(7).(func([]*entity.Transaction) error)
+			for _, batch := range batches {
+				_ = fn(batch)
+			}
+		}).Return(nil)
+
+	var sent []string
+	count, message, err := exportHistory.Execute(" acc-123 ", "", []string{entity.TransactionTypeTransfer}, nil, nil, "asc", "user123", "req-456", func(transactions []*entity.Transaction) error {
+		for _, tx := range transactions {
+			sent = append(sent, tx.ID)
+		}
+		return nil
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, int64(3), count)
+	assert.Equal(t, "Transaction history exported", message)
+	assert.Equal(t, []string{"txn-1", "txn-2", "txn-3"}, sent)
+	mockTransactionRepo.AssertExpectations(t)
+}
+
+// TestExportTransactionHistory_Execute_InvalidDateRange tests that a start date after the end date is rejected
+func TestExportTransactionHistory_Execute_InvalidDateRange(t *testing.T) {
+	mockTransactionRepo := new(mock_repo.MockTransactionRepo)
+	exportHistory := NewExportTransactionHistory(mockTransactionRepo)
+
+	startDate := time.Now()
+	endDate := startDate.Add(-24 * time.Hour)
+
+	_, _, err := exportHistory.Execute("acc-123", "", nil, &startDate, &endDate, "desc", "user123", "req-456", func([]*entity.Transaction) error {
+		return nil
+	})
+
+	assert.ErrorIs(t, err, custom_err.ErrValidationFailed)
+	mockTransactionRepo.AssertNotCalled(t, "ExportTransactionHistory", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+// TestExportTransactionHistory_Execute_SendFails tests that a failed send stops the export
+func TestExportTransactionHistory_Execute_SendFails(t *testing.T) {
+	mockTransactionRepo := new(mock_repo.MockTransactionRepo)
+	exportHistory := NewExportTransactionHistory(mockTransactionRepo)
+
+	sendErr := errors.New("client gone")
+	mockTransactionRepo.On("ExportTransactionHistory", "", "cust-1", (*time.Time)(nil), (*time.Time)(nil), "desc", []string(nil), exportBatchSize, mock.Anything).
+		Return(sendErr)
+
+	count, message, err := exportHistory.Execute("", "cust-1", nil, nil, nil, "", "user123", "req-456", func([]*entity.Transaction) error {
+		return sendErr
+	})
+
+	assert.ErrorIs(t, err, custom_err.ErrDatabase)
+	assert.Equal(t, int64(0), count)
+	assert.Equal(t, "Failed to export transaction history", message)
+}
@@ -0,0 +1,59 @@
+package app
+
+import (
+	"fmt"
+	"strings"
+	"transaction-service/internal/domain/entity"
+	custom_err "transaction-service/internal/domain/error"
+	"transaction-service/internal/logging"
+	"transaction-service/internal/observability/metrics"
+	"transaction-service/internal/ports"
+)
+
+// GetStandingOrder is a use-case for getting a standing order with the outcome of its runs
+type GetStandingOrder struct {
+	StandingOrderRepo ports.StandingOrderRepo
+}
+
+// NewGetStandingOrder creates a new GetStandingOrder use-case
+func NewGetStandingOrder(standingOrderRepo ports.StandingOrderRepo) *GetStandingOrder {
+	return &GetStandingOrder{
+		StandingOrderRepo: standingOrderRepo,
+	}
+}
+
+func (g *GetStandingOrder) Execute(standingOrderID, requester, requestId string) (*entity.StandingOrder, []*entity.StandingOrderRun, string, error) {
+	metrics.IncRequestActive()
+	defer metrics.DecRequestActive()
+
+	var err error
+	defer func() {
+		metrics.RecordOperation("get_standing_order", err)
+	}()
+
+	standingOrderID = strings.TrimSpace(standingOrderID)
+	if standingOrderID == "" {
+		err = fmt.Errorf("%w: standing order id required", custom_err.ErrValidationFailed)
+		return nil, nil, "Standing order ID missing", err
+	}
+
+	order, err := g.StandingOrderRepo.GetStandingOrderByID(standingOrderID)
+	if err != nil {
+		logging.Logger.Error().Err(err).Str("standing_order_id", standingOrderID).Str("request_id", requestId).Msg("Failed to get standing order")
+		err = fmt.Errorf("%w: failed to get standing order", custom_err.ErrDatabase)
+		return nil, nil, "Failed to get standing order", err
+	}
+
+	if order == nil {
+		err = custom_err.ErrStandingOrderNotFound
+		return nil, nil, "Standing order not found", err
+	}
+
+	runs, err := g.StandingOrderRepo.GetStandingOrderRuns(order.ID)
+	if err != nil {
+		logging.Logger.Error().Err(err).Str("standing_order_id", standingOrderID).Str("request_id", requestId).Msg("Failed to get standing order runs")
+		err = fmt.Errorf("%w: failed to get standing order runs", custom_err.ErrDatabase)
+		return nil, nil, "Failed to get standing order runs", err
+	}
+	return order, runs, "Standing order details", nil
+}
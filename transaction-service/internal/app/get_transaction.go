@@ -0,0 +1,65 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"transaction-service/internal/domain/entity"
+	custom_err "transaction-service/internal/domain/error"
+	"transaction-service/internal/logging"
+	"transaction-service/internal/observability/metrics"
+	"transaction-service/internal/ports"
+)
+
+// GetTransaction is a use-case for getting a single transaction and its current status
+type GetTransaction struct {
+	TransactionRepo ports.TransactionRepo
+}
+
+// NewGetTransaction creates a new GetTransaction use-case
+func NewGetTransaction(transactionRepo ports.TransactionRepo) *GetTransaction {
+	return &GetTransaction{
+		TransactionRepo: transactionRepo,
+	}
+}
+
+// Execute returns the transaction if the requester initiated it or the role set with WithRequesterRole is admin.
+// Other requesters get ErrTransactionNotFound, so polling does not reveal the transactions of other employees.
+func (t *GetTransaction) Execute(ctx context.Context, transactionID, requester, requestId string) (*entity.Transaction, string, error) {
+	metrics.IncRequestActive()
+	defer metrics.DecRequestActive()
+
+	var err error
+	defer func() {
+		metrics.RecordOperation("get_transaction", err)
+	}()
+
+	transactionID = strings.TrimSpace(transactionID)
+	if transactionID == "" {
+		err = fmt.Errorf("%w: transaction id required", custom_err.ErrValidationFailed)
+		return nil, "Transaction ID missing", err
+	}
+
+	transaction, err := t.TransactionRepo.GetTransactionByID(transactionID)
+	if err != nil {
+		logging.Logger.Error().Err(err).Str("transaction_id", transactionID).Str("request_id", requestId).Msg("Failed to get transaction")
+		err = fmt.Errorf("%w: failed to get transaction", custom_err.ErrDatabase)
+		return nil, "Failed to get transaction", err
+	}
+
+	if transaction == nil {
+		err = custom_err.ErrTransactionNotFound
+		return nil, "Transaction not found", err
+	}
+
+	if transaction.CreatedBy != strings.TrimSpace(requester) && RequesterRole(ctx) != RequesterRoleAdmin {
+		logging.Logger.Warn().
+			Str("transaction_id", transactionID).
+			Str("requester", requester).
+			Str("request_id", requestId).
+			Msg("Transaction requested by another employee")
+		err = custom_err.ErrTransactionNotFound
+		return nil, "Transaction not found", err
+	}
+	return transaction, "Transaction details", nil
+}
@@ -0,0 +1,57 @@
+package app
+
+import (
+	"fmt"
+	"strings"
+	"transaction-service/internal/domain/entity"
+	custom_err "transaction-service/internal/domain/error"
+	"transaction-service/internal/logging"
+	"transaction-service/internal/observability/metrics"
+	"transaction-service/internal/ports"
+)
+
+// GetTransactionBatch is a use-case for reading a batch with the outcome of each line
+type GetTransactionBatch struct {
+	batchRepo ports.TransactionBatchRepo
+}
+
+// NewGetTransactionBatch creates a new GetTransactionBatch use-case
+func NewGetTransactionBatch(batchRepo ports.TransactionBatchRepo) *GetTransactionBatch {
+	return &GetTransactionBatch{batchRepo: batchRepo}
+}
+
+func (g *GetTransactionBatch) Execute(batchID, requester, requestId string) (*entity.TransactionBatch, []*entity.TransactionBatchLine, string, error) {
+	metrics.IncRequestActive()
+	defer metrics.DecRequestActive()
+
+	var err error
+	defer func() {
+		metrics.RecordOperation("get_transaction_batch", err)
+	}()
+
+	batchID = strings.TrimSpace(batchID)
+	if batchID == "" {
+		err = fmt.Errorf("%w: batch id required", custom_err.ErrValidationFailed)
+		return nil, nil, "Batch ID missing", err
+	}
+
+	batch, err := g.batchRepo.GetTransactionBatchByID(batchID)
+	if err != nil {
+		logging.Logger.Error().Err(err).Str("batch_id", batchID).Str("request_id", requestId).Msg("Failed to get transaction batch")
+		err = fmt.Errorf("%w: failed to get transaction batch", custom_err.ErrDatabase)
+		return nil, nil, "Failed to get transaction batch", err
+	}
+
+	if batch == nil {
+		err = custom_err.ErrTransactionBatchNotFound
+		return nil, nil, "Transaction batch not found", err
+	}
+
+	lines, err := g.batchRepo.GetTransactionBatchLines(batch.ID)
+	if err != nil {
+		logging.Logger.Error().Err(err).Str("batch_id", batchID).Str("request_id", requestId).Msg("Failed to get transaction batch lines")
+		err = fmt.Errorf("%w: failed to get transaction batch lines", custom_err.ErrDatabase)
+		return nil, nil, "Failed to get transaction batch lines", err
+	}
+	return batch, lines, "Transaction batch details", nil
+}
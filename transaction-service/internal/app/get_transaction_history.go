@@ -24,7 +24,9 @@ func NewGetTransactionHistory(transactionRepo ports.TransactionRepo) *GetTransac
 	}
 }
 
-func (t *GetTransactionHistory) Execute(accountID string, customerID string, types []string, startDate, endDate *time.Time, sortOrder string, page, pageSize int, requester, requestId string) ([]*entity.Transaction, int64, error) {
+// Execute returns a page of the transaction history and the cursor of the next page, empty on the last page. With a
+// cursor the page continues after it and page is ignored. The total is only counted when includeTotal is set.
+func (t *GetTransactionHistory) Execute(accountID string, customerID string, types []string, startDate, endDate *time.Time, sortOrder string, page, pageSize int, cursor string, includeTotal bool, requester, requestId string) ([]*entity.Transaction, int64, string, error) {
 	metrics.IncRequestActive()
 	defer metrics.DecRequestActive()
 
@@ -47,7 +49,12 @@ func (t *GetTransactionHistory) Execute(accountID string, customerID string, typ
 
 	if startDate != nil && endDate != nil && startDate.After(*endDate) {
 		err = fmt.Errorf("%w: start date cannot be after end date", custom_err.ErrValidationFailed)
-		return nil, 0, err
+		return nil, 0, "", err
+	}
+
+	after, err := entity.DecodePageCursor(cursor)
+	if err != nil {
+		return nil, 0, "", err
 	}
 
 	transactions, total, err := t.TransactionRepo.GetTransactionHistory(
@@ -58,6 +65,8 @@ func (t *GetTransactionHistory) Execute(accountID string, customerID string, typ
 		sortOrder,
 		page,
 		pageSize,
+		after,
+		includeTotal,
 		types)
 	if err != nil {
 		logging.Logger.Error().Err(err).
@@ -66,8 +75,11 @@ func (t *GetTransactionHistory) Execute(accountID string, customerID string, typ
 			Int("page_size", pageSize).
 			Msg("Failed to get transaction history")
 		err = fmt.Errorf("%w: failed to get transaction history", custom_err.ErrDatabase)
-		return nil, 0, err
+		return nil, 0, "", err
 	}
 
-	return transactions, total, nil
+	transactions, nextCursor := entity.TrimPage(transactions, pageSize, func(tx *entity.Transaction) entity.PageCursor {
+		return entity.PageCursor{CreatedAt: tx.CreatedAt, ID: tx.ID}
+	})
+	return transactions, total, nextCursor, nil
 }
@@ -6,6 +6,8 @@ import (
 	"testing"
 	"time"
 	"transaction-service/internal/domain/entity"
+	custom_err "transaction-service/internal/domain/error"
+	"transaction-service/internal/domain/money"
 	mock_repo "transaction-service/internal/ports/mocks"
 )
 
@@ -25,14 +27,14 @@ func TestGetTransactionHistory_Execute_SuccessWithAccountID(t *testing.T) {
 	requestId := "req-456"
 
 	expectedTransactions := []*entity.Transaction{
-		{ID: "txn-1", SourceAccountID: accountID, Amount: 100.0},
-		{ID: "txn-2", SourceAccountID: accountID, Amount: 200.0},
+		{ID: "txn-1", SourceAccountID: accountID, Amount: money.MustParse("100.00")},
+		{ID: "txn-2", SourceAccountID: accountID, Amount: money.MustParse("200.00")},
 	}
 	expectedTotal := int64(2)
 
-	mockTransactionRepo.On("GetTransactionHistory", accountID, customerID, startDate, endDate, sortOrder, page, pageSize, types).Return(expectedTransactions, expectedTotal, nil)
+	mockTransactionRepo.On("GetTransactionHistory", accountID, customerID, startDate, endDate, sortOrder, page, pageSize, (*entity.PageCursor)(nil), true, types).Return(expectedTransactions, expectedTotal, nil)
 
-	transactions, total, err := getTransactionHistory.Execute(accountID, customerID, types, startDate, endDate, sortOrder, page, pageSize, requester, requestId)
+	transactions, total, _, err := getTransactionHistory.Execute(accountID, customerID, types, startDate, endDate, sortOrder, page, pageSize, "", true, requester, requestId)
 
 	assert.NoError(t, err)
 	assert.Equal(t, expectedTransactions, transactions)
@@ -56,14 +58,14 @@ func TestGetTransactionHistory_Execute_SuccessWithCustomerID(t *testing.T) {
 	requestId := "req-456"
 
 	expectedTransactions := []*entity.Transaction{
-		{ID: "txn-1", Amount: 100.0},
-		{ID: "txn-2", Amount: 200.0},
+		{ID: "txn-1", Amount: money.MustParse("100.00")},
+		{ID: "txn-2", Amount: money.MustParse("200.00")},
 	}
 	expectedTotal := int64(2)
 
-	mockTransactionRepo.On("GetTransactionHistory", accountID, customerID, startDate, endDate, sortOrder, page, pageSize, types).Return(expectedTransactions, expectedTotal, nil)
+	mockTransactionRepo.On("GetTransactionHistory", accountID, customerID, startDate, endDate, sortOrder, page, pageSize, (*entity.PageCursor)(nil), true, types).Return(expectedTransactions, expectedTotal, nil)
 
-	transactions, total, err := getTransactionHistory.Execute(accountID, customerID, types, startDate, endDate, sortOrder, page, pageSize, requester, requestId)
+	transactions, total, _, err := getTransactionHistory.Execute(accountID, customerID, types, startDate, endDate, sortOrder, page, pageSize, "", true, requester, requestId)
 
 	assert.NoError(t, err)
 	assert.Equal(t, expectedTransactions, transactions)
@@ -88,13 +90,13 @@ func TestGetTransactionHistory_Execute_SuccessWithDateRange(t *testing.T) {
 	requestId := "req-456"
 
 	expectedTransactions := []*entity.Transaction{
-		{ID: "txn-1", SourceAccountID: accountID, Amount: 100.0},
+		{ID: "txn-1", SourceAccountID: accountID, Amount: money.MustParse("100.00")},
 	}
 	expectedTotal := int64(1)
 
-	mockTransactionRepo.On("GetTransactionHistory", accountID, customerID, &startDate, &endDate, sortOrder, page, pageSize, types).Return(expectedTransactions, expectedTotal, nil)
+	mockTransactionRepo.On("GetTransactionHistory", accountID, customerID, &startDate, &endDate, sortOrder, page, pageSize, (*entity.PageCursor)(nil), true, types).Return(expectedTransactions, expectedTotal, nil)
 
-	transactions, total, err := getTransactionHistory.Execute(accountID, customerID, types, &startDate, &endDate, sortOrder, page, pageSize, requester, requestId)
+	transactions, total, _, err := getTransactionHistory.Execute(accountID, customerID, types, &startDate, &endDate, sortOrder, page, pageSize, "", true, requester, requestId)
 
 	assert.NoError(t, err)
 	assert.Equal(t, expectedTransactions, transactions)
@@ -118,14 +120,14 @@ func TestGetTransactionHistory_Execute_SuccessWithTransactionTypes(t *testing.T)
 	requestId := "req-456"
 
 	expectedTransactions := []*entity.Transaction{
-		{ID: "txn-1", Type: "transfer", Amount: 100.0},
-		{ID: "txn-2", Type: "withdraw_amount", Amount: 50.0},
+		{ID: "txn-1", Type: "transfer", Amount: money.MustParse("100.00")},
+		{ID: "txn-2", Type: "withdraw_amount", Amount: money.MustParse("50.00")},
 	}
 	expectedTotal := int64(2)
 
-	mockTransactionRepo.On("GetTransactionHistory", accountID, customerID, startDate, endDate, sortOrder, page, pageSize, types).Return(expectedTransactions, expectedTotal, nil)
+	mockTransactionRepo.On("GetTransactionHistory", accountID, customerID, startDate, endDate, sortOrder, page, pageSize, (*entity.PageCursor)(nil), true, types).Return(expectedTransactions, expectedTotal, nil)
 
-	transactions, total, err := getTransactionHistory.Execute(accountID, customerID, types, startDate, endDate, sortOrder, page, pageSize, requester, requestId)
+	transactions, total, _, err := getTransactionHistory.Execute(accountID, customerID, types, startDate, endDate, sortOrder, page, pageSize, "", true, requester, requestId)
 
 	assert.NoError(t, err)
 	assert.Equal(t, expectedTransactions, transactions)
@@ -149,14 +151,14 @@ func TestGetTransactionHistory_Execute_SuccessWithPagination(t *testing.T) {
 	requestId := "req-456"
 
 	expectedTransactions := []*entity.Transaction{
-		{ID: "txn-11", Amount: 100.0},
-		{ID: "txn-12", Amount: 200.0},
+		{ID: "txn-11", Amount: money.MustParse("100.00")},
+		{ID: "txn-12", Amount: money.MustParse("200.00")},
 	}
 	expectedTotal := int64(15)
 
-	mockTransactionRepo.On("GetTransactionHistory", accountID, customerID, startDate, endDate, sortOrder, page, pageSize, types).Return(expectedTransactions, expectedTotal, nil)
+	mockTransactionRepo.On("GetTransactionHistory", accountID, customerID, startDate, endDate, sortOrder, page, pageSize, (*entity.PageCursor)(nil), true, types).Return(expectedTransactions, expectedTotal, nil)
 
-	transactions, total, err := getTransactionHistory.Execute(accountID, customerID, types, startDate, endDate, sortOrder, page, pageSize, requester, requestId)
+	transactions, total, _, err := getTransactionHistory.Execute(accountID, customerID, types, startDate, endDate, sortOrder, page, pageSize, "", true, requester, requestId)
 
 	assert.NoError(t, err)
 	assert.Equal(t, expectedTransactions, transactions)
@@ -182,9 +184,9 @@ func TestGetTransactionHistory_Execute_SuccessEmptyResult(t *testing.T) {
 	expectedTransactions := []*entity.Transaction{}
 	expectedTotal := int64(0)
 
-	mockTransactionRepo.On("GetTransactionHistory", accountID, customerID, startDate, endDate, sortOrder, page, pageSize, types).Return(expectedTransactions, expectedTotal, nil)
+	mockTransactionRepo.On("GetTransactionHistory", accountID, customerID, startDate, endDate, sortOrder, page, pageSize, (*entity.PageCursor)(nil), true, types).Return(expectedTransactions, expectedTotal, nil)
 
-	transactions, total, err := getTransactionHistory.Execute(accountID, customerID, types, startDate, endDate, sortOrder, page, pageSize, requester, requestId)
+	transactions, total, _, err := getTransactionHistory.Execute(accountID, customerID, types, startDate, endDate, sortOrder, page, pageSize, "", true, requester, requestId)
 
 	assert.NoError(t, err)
 	assert.Equal(t, expectedTransactions, transactions)
@@ -211,9 +213,9 @@ func TestGetTransactionHistory_Execute_DefaultPaginationValues(t *testing.T) {
 	expectedTotal := int64(0)
 
 	// Should be called with default values: page=1, pageSize=50
-	mockTransactionRepo.On("GetTransactionHistory", accountID, customerID, startDate, endDate, sortOrder, 1, 50, types).Return(expectedTransactions, expectedTotal, nil)
+	mockTransactionRepo.On("GetTransactionHistory", accountID, customerID, startDate, endDate, sortOrder, 1, 50, (*entity.PageCursor)(nil), true, types).Return(expectedTransactions, expectedTotal, nil)
 
-	transactions, total, err := getTransactionHistory.Execute(accountID, customerID, types, startDate, endDate, sortOrder, page, pageSize, requester, requestId)
+	transactions, total, _, err := getTransactionHistory.Execute(accountID, customerID, types, startDate, endDate, sortOrder, page, pageSize, "", true, requester, requestId)
 
 	assert.NoError(t, err)
 	assert.Equal(t, expectedTransactions, transactions)
@@ -254,9 +256,9 @@ func TestGetTransactionHistory_Execute_PageSizeBoundaryValues(t *testing.T) {
 			expectedTransactions := []*entity.Transaction{}
 			expectedTotal := int64(0)
 
-			mockTransactionRepo.On("GetTransactionHistory", accountID, customerID, startDate, endDate, sortOrder, 1, tc.expectedSize, types).Return(expectedTransactions, expectedTotal, nil)
+			mockTransactionRepo.On("GetTransactionHistory", accountID, customerID, startDate, endDate, sortOrder, 1, tc.expectedSize, (*entity.PageCursor)(nil), true, types).Return(expectedTransactions, expectedTotal, nil)
 
-			transactions, total, err := getTransactionHistory.Execute(accountID, customerID, types, startDate, endDate, sortOrder, 1, tc.pageSize, requester, requestId)
+			transactions, total, _, err := getTransactionHistory.Execute(accountID, customerID, types, startDate, endDate, sortOrder, 1, tc.pageSize, "", true, requester, requestId)
 
 			assert.NoError(t, err)
 			assert.Equal(t, expectedTransactions, transactions)
@@ -296,9 +298,9 @@ func TestGetTransactionHistory_Execute_PageBoundaryValues(t *testing.T) {
 			expectedTransactions := []*entity.Transaction{}
 			expectedTotal := int64(0)
 
-			mockTransactionRepo.On("GetTransactionHistory", accountID, customerID, startDate, endDate, sortOrder, tc.expectedPage, pageSize, types).Return(expectedTransactions, expectedTotal, nil)
+			mockTransactionRepo.On("GetTransactionHistory", accountID, customerID, startDate, endDate, sortOrder, tc.expectedPage, pageSize, (*entity.PageCursor)(nil), true, types).Return(expectedTransactions, expectedTotal, nil)
 
-			transactions, total, err := getTransactionHistory.Execute(accountID, customerID, types, startDate, endDate, sortOrder, tc.page, pageSize, requester, requestId)
+			transactions, total, _, err := getTransactionHistory.Execute(accountID, customerID, types, startDate, endDate, sortOrder, tc.page, pageSize, "", true, requester, requestId)
 
 			assert.NoError(t, err)
 			assert.Equal(t, expectedTransactions, transactions)
@@ -340,9 +342,9 @@ func TestGetTransactionHistory_Execute_SortOrderValidation(t *testing.T) {
 			expectedTransactions := []*entity.Transaction{}
 			expectedTotal := int64(0)
 
-			mockTransactionRepo.On("GetTransactionHistory", accountID, customerID, startDate, endDate, tc.expectedOrder, page, pageSize, types).Return(expectedTransactions, expectedTotal, nil)
+			mockTransactionRepo.On("GetTransactionHistory", accountID, customerID, startDate, endDate, tc.expectedOrder, page, pageSize, (*entity.PageCursor)(nil), true, types).Return(expectedTransactions, expectedTotal, nil)
 
-			transactions, total, err := getTransactionHistory.Execute(accountID, customerID, types, startDate, endDate, tc.sortOrder, page, pageSize, requester, requestId)
+			transactions, total, _, err := getTransactionHistory.Execute(accountID, customerID, types, startDate, endDate, tc.sortOrder, page, pageSize, "", true, requester, requestId)
 
 			assert.NoError(t, err)
 			assert.Equal(t, expectedTransactions, transactions)
@@ -368,7 +370,7 @@ func TestGetTransactionHistory_Execute_ErrorDateRangeValidation(t *testing.T) {
 	requester := "user123"
 	requestId := "req-456"
 
-	transactions, total, err := getTransactionHistory.Execute(accountID, customerID, types, &startDate, &endDate, sortOrder, page, pageSize, requester, requestId)
+	transactions, total, _, err := getTransactionHistory.Execute(accountID, customerID, types, &startDate, &endDate, sortOrder, page, pageSize, "", true, requester, requestId)
 
 	assert.Error(t, err)
 	assert.Nil(t, transactions)
@@ -391,9 +393,9 @@ func TestGetTransactionHistory_Execute_ErrorDatabaseFailure(t *testing.T) {
 	requester := "user123"
 	requestId := "req-456"
 
-	mockTransactionRepo.On("GetTransactionHistory", accountID, customerID, startDate, endDate, sortOrder, page, pageSize, types).Return([]*entity.Transaction{}, int64(0), fmt.Errorf("database error"))
+	mockTransactionRepo.On("GetTransactionHistory", accountID, customerID, startDate, endDate, sortOrder, page, pageSize, (*entity.PageCursor)(nil), true, types).Return([]*entity.Transaction{}, int64(0), fmt.Errorf("database error"))
 
-	transactions, total, err := getTransactionHistory.Execute(accountID, customerID, types, startDate, endDate, sortOrder, page, pageSize, requester, requestId)
+	transactions, total, _, err := getTransactionHistory.Execute(accountID, customerID, types, startDate, endDate, sortOrder, page, pageSize, "", true, requester, requestId)
 
 	assert.Error(t, err)
 	assert.Contains(t, err.Error(), "failed to get transaction history")
@@ -419,17 +421,67 @@ func TestGetTransactionHistory_Execute_SuccessWithAllFilters(t *testing.T) {
 	requestId := "req-456"
 
 	expectedTransactions := []*entity.Transaction{
-		{ID: "txn-1", Type: "transfer", Amount: 100.0},
-		{ID: "txn-2", Type: "withdraw_amount", Amount: 50.0},
+		{ID: "txn-1", Type: "transfer", Amount: money.MustParse("100.00")},
+		{ID: "txn-2", Type: "withdraw_amount", Amount: money.MustParse("50.00")},
 	}
 	expectedTotal := int64(2)
 
-	mockTransactionRepo.On("GetTransactionHistory", accountID, customerID, &startDate, &endDate, sortOrder, page, pageSize, types).Return(expectedTransactions, expectedTotal, nil)
+	mockTransactionRepo.On("GetTransactionHistory", accountID, customerID, &startDate, &endDate, sortOrder, page, pageSize, (*entity.PageCursor)(nil), true, types).Return(expectedTransactions, expectedTotal, nil)
 
-	transactions, total, err := getTransactionHistory.Execute(accountID, customerID, types, &startDate, &endDate, sortOrder, page, pageSize, requester, requestId)
+	transactions, total, _, err := getTransactionHistory.Execute(accountID, customerID, types, &startDate, &endDate, sortOrder, page, pageSize, "", true, requester, requestId)
 
 	assert.NoError(t, err)
 	assert.Equal(t, expectedTransactions, transactions)
 	assert.Equal(t, expectedTotal, total)
 	mockTransactionRepo.AssertExpectations(t)
 }
+
+// TestGetTransactionHistory_Execute_CursorPages tests that a cursor continues after the last row of the previous page
+func TestGetTransactionHistory_Execute_CursorPages(t *testing.T) {
+	mockTransactionRepo := new(mock_repo.MockTransactionRepo)
+	getTransactionHistory := NewGetTransactionHistory(mockTransactionRepo)
+
+	var startDate, endDate *time.Time = nil, nil
+	createdAt := time.Date(2026, 3, 1, 10, 0, 0, 0, time.UTC)
+
+	// a page size of 2 reads 3 rows, the third tells that another page follows
+	firstPage := []*entity.Transaction{
+		{ID: "txn-3", CreatedAt: createdAt.Add(2 * time.Minute)},
+		{ID: "txn-2", CreatedAt: createdAt.Add(time.Minute)},
+		{ID: "txn-1", CreatedAt: createdAt},
+	}
+	mockTransactionRepo.On("GetTransactionHistory", "acc-123", "", startDate, endDate, "desc", 1, 2, (*entity.PageCursor)(nil), false, []string(nil)).Return(firstPage, int64(0), nil)
+
+	transactions, total, nextCursor, err := getTransactionHistory.Execute("acc-123", "", nil, startDate, endDate, "desc", 1, 2, "", false, "user123", "req-456")
+
+	assert.NoError(t, err)
+	assert.Equal(t, firstPage[:2], transactions)
+	assert.Equal(t, int64(0), total)
+	assert.NotEmpty(t, nextCursor)
+
+	after, err := entity.DecodePageCursor(nextCursor)
+	assert.NoError(t, err)
+	assert.Equal(t, "txn-2", after.ID)
+	assert.True(t, after.CreatedAt.Equal(createdAt.Add(time.Minute)))
+
+	mockTransactionRepo.On("GetTransactionHistory", "acc-123", "", startDate, endDate, "desc", 1, 2, after, false, []string(nil)).Return(firstPage[2:], int64(0), nil)
+
+	transactions, _, nextCursor, err = getTransactionHistory.Execute("acc-123", "", nil, startDate, endDate, "desc", 1, 2, nextCursor, false, "user123", "req-456")
+
+	assert.NoError(t, err)
+	assert.Equal(t, firstPage[2:], transactions)
+	assert.Empty(t, nextCursor)
+	mockTransactionRepo.AssertExpectations(t)
+}
+
+// TestGetTransactionHistory_Execute_ErrorInvalidCursor tests that a malformed cursor is rejected
+func TestGetTransactionHistory_Execute_ErrorInvalidCursor(t *testing.T) {
+	mockTransactionRepo := new(mock_repo.MockTransactionRepo)
+	getTransactionHistory := NewGetTransactionHistory(mockTransactionRepo)
+
+	transactions, _, _, err := getTransactionHistory.Execute("acc-123", "", nil, nil, nil, "desc", 1, 50, "not-a-cursor", false, "user123", "req-456")
+
+	assert.ErrorIs(t, err, custom_err.ErrInvalidPageCursor)
+	assert.Nil(t, transactions)
+	mockTransactionRepo.AssertNotCalled(t, "GetTransactionHistory")
+}
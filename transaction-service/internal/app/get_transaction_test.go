@@ -0,0 +1,96 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"transaction-service/internal/domain/entity"
+	custom_err "transaction-service/internal/domain/error"
+	mock_repo "transaction-service/internal/ports/mocks"
+)
+
+// TestGetTransaction_Execute_Success tests getting a transaction by id
+func TestGetTransaction_Execute_Success(t *testing.T) {
+	mockTransactionRepo := new(mock_repo.MockTransactionRepo)
+	getTransaction := NewGetTransaction(mockTransactionRepo)
+
+	expectedTransaction := &entity.Transaction{
+		ID:                "txn-1",
+		TransactionStatus: entity.TransactionStatusFailed,
+		ErrorReason:       "insufficient balance",
+		CreatedBy:         "user123",
+	}
+	mockTransactionRepo.On("GetTransactionByID", "txn-1").Return(expectedTransaction, nil)
+
+	transaction, msg, err := getTransaction.Execute(context.Background(), " txn-1 ", "user123", "req-456")
+
+	assert.NoError(t, err)
+	assert.Equal(t, expectedTransaction, transaction)
+	assert.Equal(t, "Transaction details", msg)
+	mockTransactionRepo.AssertExpectations(t)
+}
+
+// TestGetTransaction_Execute_MissingID tests if transaction id is empty
+func TestGetTransaction_Execute_MissingID(t *testing.T) {
+	mockTransactionRepo := new(mock_repo.MockTransactionRepo)
+	getTransaction := NewGetTransaction(mockTransactionRepo)
+
+	transaction, _, err := getTransaction.Execute(context.Background(), "", "user123", "req-456")
+
+	assert.ErrorIs(t, err, custom_err.ErrValidationFailed)
+	assert.Nil(t, transaction)
+	mockTransactionRepo.AssertNotCalled(t, "GetTransactionByID")
+}
+
+// TestGetTransaction_Execute_NotFound tests if transaction does not exist
+func TestGetTransaction_Execute_NotFound(t *testing.T) {
+	mockTransactionRepo := new(mock_repo.MockTransactionRepo)
+	getTransaction := NewGetTransaction(mockTransactionRepo)
+
+	mockTransactionRepo.On("GetTransactionByID", "txn-404").Return(nil, nil)
+
+	transaction, msg, err := getTransaction.Execute(context.Background(), "txn-404", "user123", "req-456")
+
+	assert.ErrorIs(t, err, custom_err.ErrTransactionNotFound)
+	assert.Nil(t, transaction)
+	assert.Equal(t, "Transaction not found", msg)
+}
+
+// TestGetTransaction_Execute_DatabaseError tests if reading the transaction fails
+func TestGetTransaction_Execute_DatabaseError(t *testing.T) {
+	mockTransactionRepo := new(mock_repo.MockTransactionRepo)
+	getTransaction := NewGetTransaction(mockTransactionRepo)
+
+	mockTransactionRepo.On("GetTransactionByID", "txn-1").Return(nil, errors.New("database error"))
+
+	transaction, _, err := getTransaction.Execute(context.Background(), "txn-1", "user123", "req-456")
+
+	assert.ErrorIs(t, err, custom_err.ErrDatabase)
+	assert.Nil(t, transaction)
+}
+
+// TestGetTransaction_Execute_OtherRequester tests that employees cannot read the transactions of other employees,
+// while admins can
+func TestGetTransaction_Execute_OtherRequester(t *testing.T) {
+	mockTransactionRepo := new(mock_repo.MockTransactionRepo)
+	getTransaction := NewGetTransaction(mockTransactionRepo)
+
+	storedTransaction := &entity.Transaction{
+		ID:                "txn-1",
+		TransactionStatus: entity.TransactionStatusCompleted,
+		CreatedBy:         "user123",
+	}
+	mockTransactionRepo.On("GetTransactionByID", "txn-1").Return(storedTransaction, nil)
+
+	transaction, msg, err := getTransaction.Execute(WithRequesterRole(context.Background(), "viewer"), "txn-1", "user456", "req-456")
+
+	assert.ErrorIs(t, err, custom_err.ErrTransactionNotFound)
+	assert.Nil(t, transaction)
+	assert.Equal(t, "Transaction not found", msg)
+
+	transaction, _, err = getTransaction.Execute(WithRequesterRole(context.Background(), "Admin"), "txn-1", "admin1", "req-456")
+
+	assert.NoError(t, err)
+	assert.Equal(t, storedTransaction, transaction)
+}
@@ -2,6 +2,7 @@ package app
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"strings"
 	"time"
@@ -9,6 +10,7 @@ import (
 	"transaction-service/internal/config"
 	"transaction-service/internal/domain/entity"
 	custom_err "transaction-service/internal/domain/error"
+	"transaction-service/internal/domain/money"
 	"transaction-service/internal/logging"
 	"transaction-service/internal/messaging"
 	"transaction-service/internal/observability/metrics"
@@ -16,10 +18,16 @@ import (
 )
 
 type InitTransaction struct {
-	transactionRepo ports.TransactionRepo
-	accountClient   ports.AccountClient
-	sagaRepo        ports.SagaRepo
-	eventRepo       ports.EventRepo
+	transactionRepo  ports.TransactionRepo
+	accountClient    ports.AccountClient
+	sagaRepo         ports.SagaRepo
+	eventRepo        ports.EventRepo
+	exchangeRateRepo ports.ExchangeRateRepo
+	limitRepo        ports.TransactionLimitRepo
+	feeRuleRepo      ports.FeeRuleRepo
+	transactionQueue ports.TransactionQueue
+	// feeAccountID is the bank income account fees are credited to, read from the config on creation
+	feeAccountID string
 }
 
 func NewInitTransaction(
@@ -27,25 +35,63 @@ func NewInitTransaction(
 	accountClient ports.AccountClient,
 	sagaRepo ports.SagaRepo,
 	eventRepo ports.EventRepo,
+	exchangeRateRepo ports.ExchangeRateRepo,
+	limitRepo ports.TransactionLimitRepo,
+	feeRuleRepo ports.FeeRuleRepo,
+	transactionQueue ports.TransactionQueue,
 ) *InitTransaction {
 
 	return &InitTransaction{
-		transactionRepo: transactionRepo,
-		accountClient:   accountClient,
-		sagaRepo:        sagaRepo,
-		eventRepo:       eventRepo,
+		transactionRepo:  transactionRepo,
+		accountClient:    accountClient,
+		sagaRepo:         sagaRepo,
+		eventRepo:        eventRepo,
+		exchangeRateRepo: exchangeRateRepo,
+		limitRepo:        limitRepo,
+		feeRuleRepo:      feeRuleRepo,
+		transactionQueue: transactionQueue,
+		feeAccountID:     strings.TrimSpace(config.Current().Fee.IncomeAccountID),
 	}
 }
 
+// Execute initiates the transaction and waits for the saga to finish
 func (a *InitTransaction) Execute(
 	ctx context.Context,
 	sourceAccountID string,
 	destinationAccountID *string,
-	amount float64,
+	amount money.Amount,
 	transactionType,
 	referenceID,
 	requester,
 	requestId string,
+) (*entity.Transaction, string, error) {
+	return a.execute(ctx, sourceAccountID, destinationAccountID, amount, transactionType, referenceID, requester, requestId, false)
+}
+
+// ExecuteAsync initiates the transaction and hands the saga to the worker pool, returning the pending transaction
+func (a *InitTransaction) ExecuteAsync(
+	ctx context.Context,
+	sourceAccountID string,
+	destinationAccountID *string,
+	amount money.Amount,
+	transactionType,
+	referenceID,
+	requester,
+	requestId string,
+) (*entity.Transaction, string, error) {
+	return a.execute(ctx, sourceAccountID, destinationAccountID, amount, transactionType, referenceID, requester, requestId, true)
+}
+
+func (a *InitTransaction) execute(
+	ctx context.Context,
+	sourceAccountID string,
+	destinationAccountID *string,
+	amount money.Amount,
+	transactionType,
+	referenceID,
+	requester,
+	requestId string,
+	async bool,
 ) (*entity.Transaction, string, error) {
 	metrics.IncRequestActive()
 	defer metrics.DecRequestActive()
@@ -72,11 +118,20 @@ func (a *InitTransaction) Execute(
 		destinationAccountID = nil
 	}
 	if transactionType == entity.TransactionTypeWithdrawFull {
-		amount = 0
+		amount = money.Zero
+	}
+
+	// retries with an already used reference get the original transaction back instead of moving money again
+	existingTransaction, msg, err := a.findDuplicateTransaction(sourceAccountID, destinationAccountID, amount, transactionType, referenceID, requester)
+	if err != nil || existingTransaction != nil {
+		return existingTransaction, msg, err
 	}
 
 	transaction, _ := entity.NewTransaction(sourceAccountID, destinationAccountID, amount, transactionType, referenceID, requester)
-	if config.Current().Recovery.TransactionTimeout > 0 {
+	if async {
+		// a queued transaction cannot get stuck before a worker claims it, the claim starts the timeout
+		transaction.TimeoutAt = time.Time{}
+	} else if config.Current().Recovery.TransactionTimeout > 0 {
 		transaction.TimeoutAt = time.Now().Add(config.Current().Recovery.TransactionTimeout)
 	}
 
@@ -87,6 +142,15 @@ func (a *InitTransaction) Execute(
 
 	// creating transaction to db
 	err = a.transactionRepo.CreateTransaction(transaction)
+	if errors.Is(err, custom_err.ErrDuplicateReference) {
+		// a concurrent request with the same reference was stored first
+		existingTransaction, msg, err = a.findDuplicateTransaction(sourceAccountID, destinationAccountID, amount, transactionType, referenceID, requester)
+		if err == nil && existingTransaction == nil {
+			err = custom_err.ErrDuplicateReference
+			msg = "Duplicate transaction reference"
+		}
+		return existingTransaction, msg, err
+	}
 	if err != nil {
 		logging.Logger.Error().
 			Err(custom_err.ErrDatabase).
@@ -96,12 +160,103 @@ func (a *InitTransaction) Execute(
 		err = custom_err.ErrDatabase
 		return nil, "Failed to create transaction", err
 	}
+
+	var processedTransaction *entity.Transaction
+	if async {
+		processedTransaction, msg, err = a.enqueueTransaction(transaction, requester, requestId)
+	} else {
+		processedTransaction, msg, err = a.processTransaction(ctx, transaction, requester, requestId)
+	}
+	return processedTransaction, msg, err
+}
+
+// enqueueTransaction hands the stored transaction to the worker pool. If the queue is full the transaction
+// is marked as failed so it is not left pending.
+func (a *InitTransaction) enqueueTransaction(transaction *entity.Transaction, requester, requestId string) (*entity.Transaction, string, error) {
+	// the worker updates its own copy while the caller reads the pending one
+	queuedTransaction := *transaction
+
+	err := a.transactionQueue.Submit(func(ctx context.Context) {
+		a.runQueuedTransaction(ctx, transaction, requester, requestId)
+	})
+	if err != nil {
+		_ = a.transactionRepo.UpdateTransactionStatus(transaction.ID, entity.TransactionStatusFailed, err.Error())
+		logging.Logger.Error().
+			Err(err).
+			Str("transaction_id", transaction.ID).
+			Str("transaction_type", transaction.Type).
+			Msg("Failed to queue transaction")
+		return nil, "Transaction queue is full, try again later", err
+	}
+
+	logging.Logger.Info().
+		Str("transaction_id", transaction.ID).
+		Str("transaction_type", transaction.Type).
+		Msg("Transaction queued")
+	return &queuedTransaction, "Transaction accepted for processing", nil
+}
+
+// runQueuedTransaction claims the queued transaction and runs its saga. A transaction that was claimed before,
+// e.g. when it was queued again after a restart, or that failed meanwhile is skipped.
+func (a *InitTransaction) runQueuedTransaction(ctx context.Context, transaction *entity.Transaction, requester, requestId string) {
+	timeoutAt := time.Now().Add(5 * time.Minute)
+	if config.Current().Recovery.TransactionTimeout > 0 {
+		timeoutAt = time.Now().Add(config.Current().Recovery.TransactionTimeout)
+	}
+
+	err := a.transactionRepo.ClaimTransaction(transaction.ID, timeoutAt)
+	if errors.Is(err, custom_err.ErrTransactionAlreadyClaimed) {
+		logging.Logger.Info().
+			Str("transaction_id", transaction.ID).
+			Str("transaction_type", transaction.Type).
+			Msg("Transaction already claimed, skipping")
+		return
+	}
+	if err != nil {
+		// the transaction stays queued and is queued again on the next start
+		logging.Logger.Error().
+			Err(err).
+			Str("transaction_id", transaction.ID).
+			Str("transaction_type", transaction.Type).
+			Msg("Failed to claim transaction")
+		return
+	}
+
+	transaction.TransactionStatus = entity.TransactionStatusProcessing
+	transaction.TimeoutAt = timeoutAt
+	_, _, _ = a.processTransaction(ctx, transaction, requester, requestId)
+}
+
+// Resume hands every queued transaction to the queue again, e.g. transactions whose queue was lost by a restart
+func (a *InitTransaction) Resume() error {
+	transactions, err := a.transactionRepo.GetQueuedTransactions()
+	if err != nil {
+		logging.Logger.Error().Err(err).Msg("Failed to get queued transactions")
+		return custom_err.ErrDatabase
+	}
+
+	for _, transaction := range transactions {
+		transaction := transaction
+		if err = a.transactionQueue.Submit(func(ctx context.Context) {
+			a.runQueuedTransaction(ctx, transaction, transaction.CreatedBy, "")
+		}); err != nil {
+			logging.Logger.Error().Err(err).Str("transaction_id", transaction.ID).Msg("Failed to queue transaction")
+			return err
+		}
+		logging.Logger.Info().Str("transaction_id", transaction.ID).Msg("Resuming queued transaction")
+	}
+	return nil
+}
+
+// processTransaction runs the saga for the stored transaction and publishes the outcome
+func (a *InitTransaction) processTransaction(ctx context.Context, transaction *entity.Transaction, requester, requestId string) (*entity.Transaction, string, error) {
 	// Initiating Saga Orchestrator
-	err = saga.NewTransactionSagaOrchestrator(
+	err := saga.NewTransactionSagaOrchestrator(
 		a.sagaRepo,
 		a.accountClient,
 		a.transactionRepo,
 		a.eventRepo,
+		a.exchangeRateRepo,
 	).ExecuteTransactionSync(ctx, transaction, requester, requestId)
 
 	if err != nil {
@@ -153,6 +308,35 @@ func (a *InitTransaction) Execute(
 	return updatedTransaction, "Transaction completed successfully", nil
 }
 
+// findDuplicateTransaction returns the transaction the requester already initiated with the reference, or an
+// error if the reference was used for a different payload
+func (a *InitTransaction) findDuplicateTransaction(sourceAccountID string, destinationAccountID *string, amount money.Amount, transactionType, referenceID, requester string) (*entity.Transaction, string, error) {
+	existingTransaction, err := a.transactionRepo.GetTransactionByReferenceID(requester, referenceID)
+	if err != nil {
+		logging.Logger.Error().Err(err).Str("reference_id", referenceID).Msg("Failed to check transaction reference")
+		return nil, "Failed to check transaction reference", custom_err.ErrDatabase
+	}
+
+	if existingTransaction == nil {
+		return nil, "", nil
+	}
+
+	if !existingTransaction.MatchesRequest(sourceAccountID, destinationAccountID, amount, transactionType) {
+		logging.Logger.Warn().Err(custom_err.ErrDuplicateReference).
+			Str("reference_id", referenceID).
+			Str("transaction_id", existingTransaction.ID).
+			Msg("Reference ID reused with a different payload")
+		return nil, "Reference ID already used for a different transaction", custom_err.ErrDuplicateReference
+	}
+
+	logging.Logger.Info().
+		Str("reference_id", referenceID).
+		Str("transaction_id", existingTransaction.ID).
+		Str("transaction_status", existingTransaction.TransactionStatus).
+		Msg("Duplicate transaction request")
+	return existingTransaction, "Transaction already initiated with this reference", nil
+}
+
 func (a *InitTransaction) validateInput(sourceAccountID string, destinationAccountID *string, transactionType, referenceID, requester string) (string, error) {
 	sourceAccountID = strings.TrimSpace(sourceAccountID)
 	if sourceAccountID == "" {
@@ -198,10 +382,10 @@ func (a *InitTransaction) validateInput(sourceAccountID string, destinationAccou
 }
 
 func (a *InitTransaction) validateTransactionConfig(ctx context.Context, transaction *entity.Transaction, requester, requestId string) (string, error) {
-	if transaction.Type != entity.TransactionTypeWithdrawFull && transaction.Amount <= 0 {
+	if transaction.Type != entity.TransactionTypeWithdrawFull && !transaction.Amount.IsPositive() {
 		logging.Logger.Error().Err(custom_err.ErrInvalidAmount).
 			Str("transaction_type", transaction.Type).
-			Str("transaction_amount", fmt.Sprintf("%v", transaction.Amount)).
+			Str("transaction_amount", transaction.Amount.String()).
 			Msg("Invalid transaction amount")
 
 		return "Invalid amount for transaction", custom_err.ErrInvalidAmount
@@ -240,15 +424,48 @@ func (a *InitTransaction) validateTransactionConfig(ctx context.Context, transac
 		if accountInfo.AccountID == transaction.SourceAccountID {
 			sourceAccount = accountInfo
 			transaction.SourceAccountCustomerID = accountInfo.CustomerID
+			transaction.SourceCurrency = accountInfo.Currency
 		}
 		if transaction.Type == entity.TransactionTypeTransfer && accountInfo.AccountID == *transaction.DestinationAccountID {
 			transaction.DestinationAccountCustomerID = &accountInfo.CustomerID
+			destinationCurrency := accountInfo.Currency
+			transaction.DestinationCurrency = &destinationCurrency
+		}
+	}
+
+	// cross-currency transfers need a configured rate; the saga applies it while processing. A reversal converts
+	// back at the rate of the original it carries.
+	if transaction.Type == entity.TransactionTypeTransfer && transaction.DestinationCurrency != nil &&
+		transaction.SourceCurrency != *transaction.DestinationCurrency && !transaction.DestinationAmount.IsPositive() {
+
+		exchangeRate, rateErr := a.exchangeRateRepo.GetExchangeRate(transaction.SourceCurrency, *transaction.DestinationCurrency)
+		if rateErr != nil {
+			logging.Logger.Error().Err(rateErr).
+				Str("source_currency", transaction.SourceCurrency).
+				Str("destination_currency", *transaction.DestinationCurrency).
+				Msg("Failed to get exchange rate")
+			return "Failed to get exchange rate", custom_err.ErrDatabase
+		}
+		if exchangeRate == nil {
+			logging.Logger.Error().Err(custom_err.ErrExchangeRateNotFound).
+				Str("source_currency", transaction.SourceCurrency).
+				Str("destination_currency", *transaction.DestinationCurrency).
+				Msg("Exchange rate not configured")
+			return fmt.Sprintf("Exchange rate not available for %s to %s", transaction.SourceCurrency, *transaction.DestinationCurrency), custom_err.ErrExchangeRateNotFound
 		}
 	}
 
+	msg, err := a.applyTransactionFee(ctx, transaction, sourceAccount, requester, requestId)
+	if err != nil {
+		return msg, err
+	}
+
+	// a hold capture spends funds its hold already reserved, the capture checks the amount against the hold
 	if transaction.Type != entity.TransactionTypeWithdrawFull &&
 		transaction.Type != entity.TransactionTypeAddAmount &&
-		sourceAccount.Balance < transaction.Amount {
+		!transaction.IsInterestCredit() &&
+		!transaction.IsHoldCapture() &&
+		sourceAccount.AvailableBalance() < transaction.Amount+transaction.ChargedFee() {
 
 		logging.Logger.Error().Err(custom_err.ErrInsufficientBalance).
 			Str("transaction_type", transaction.Type).
@@ -256,5 +473,138 @@ func (a *InitTransaction) validateTransactionConfig(ctx context.Context, transac
 			Msg("Accounts details not found")
 		return "Source account has insufficient balance", custom_err.ErrInsufficientBalance
 	}
+	return a.checkTransactionLimits(transaction, sourceAccount, RequesterRole(ctx))
+}
+
+// applyTransactionFee charges the fee of the most specific rule matching the transaction type, source account type
+// and amount into the configured income account. The fee is debited from the source account on top of the amount
+// and in its currency, so the income account must hold the same currency. Full withdrawals, deposits, hold
+// captures, reversals and interest credits carry no fee, and neither do transactions of the income account itself.
+// Fees are not charged while no income account is configured or when no fee rule repo is set.
+func (a *InitTransaction) applyTransactionFee(ctx context.Context, transaction *entity.Transaction, sourceAccount ports.AccountInfo, requester, requestId string) (string, error) {
+	incomeAccountID := a.feeAccountID
+	if a.feeRuleRepo == nil || incomeAccountID == "" ||
+		(transaction.Type != entity.TransactionTypeTransfer && transaction.Type != entity.TransactionTypeWithdrawAmount) ||
+		transaction.IsHoldCapture() || transaction.IsReversal() ||
+		transaction.SourceAccountID == incomeAccountID ||
+		(transaction.DestinationAccountID != nil && *transaction.DestinationAccountID == incomeAccountID) {
+		return "", nil
+	}
+
+	rules, err := a.feeRuleRepo.GetFeeRules(transaction.Type)
+	if err != nil {
+		logging.Logger.Error().Err(err).
+			Str("transaction_type", transaction.Type).
+			Msg("Failed to get fee rules")
+		return "Failed to calculate transaction fee", custom_err.ErrDatabase
+	}
+
+	rule := entity.MatchFeeRule(rules, sourceAccount.AccountType, transaction.Amount)
+	if rule == nil {
+		return "", nil
+	}
+	fee := rule.Calculate(transaction.Amount)
+	if !fee.IsPositive() {
+		return "", nil
+	}
+
+	accountsInfo, message, err := a.accountClient.ValidateAndGetAccounts(ctx, []string{incomeAccountID}, requester, requestId)
+	if err != nil || len(accountsInfo) != 1 {
+		logging.Logger.Error().Err(err).
+			Str("message", message).
+			Str("fee_account_id", incomeAccountID).
+			Msg("Failed to validate fee income account")
+		return "Fee income account is not available", custom_err.ErrValidationFailed
+	}
+	if accountsInfo[0].Currency != transaction.SourceCurrency {
+		logging.Logger.Error().Err(custom_err.ErrCurrencyMismatch).
+			Str("fee_account_id", incomeAccountID).
+			Str("fee_currency", accountsInfo[0].Currency).
+			Str("source_currency", transaction.SourceCurrency).
+			Msg("Fee income account currency differs from source account")
+		return fmt.Sprintf("Fees cannot be charged in %s, the fee income account holds %s", transaction.SourceCurrency, accountsInfo[0].Currency), custom_err.ErrCurrencyMismatch
+	}
+
+	transaction.ApplyFee(rule, fee, incomeAccountID)
 	return "", nil
 }
+
+// checkTransactionLimits applies the limit of the source account, or else of its account type, and the limit of
+// the requester's role. Usage is counted from the start of the day and month. Deposits, interest credits and
+// reversals are not limited, and limits are not checked when no limit repo is set.
+func (a *InitTransaction) checkTransactionLimits(transaction *entity.Transaction, sourceAccount ports.AccountInfo, role string) (string, error) {
+	if a.limitRepo == nil || transaction.Type == entity.TransactionTypeAddAmount || transaction.IsInterestCredit() || transaction.IsReversal() {
+		return "", nil
+	}
+
+	// limits cap what leaves the account, including the fee
+	amount := transaction.Amount + transaction.ChargedFee()
+	if transaction.Type == entity.TransactionTypeWithdrawFull {
+		amount = sourceAccount.Balance - sourceAccount.HeldAmount
+	}
+
+	accountLimit, err := a.limitRepo.GetTransactionLimit(entity.TransactionLimitScopeAccount, transaction.SourceAccountID)
+	if err == nil && accountLimit == nil && sourceAccount.AccountType != "" {
+		accountLimit, err = a.limitRepo.GetTransactionLimit(entity.TransactionLimitScopeAccountType, sourceAccount.AccountType)
+	}
+
+	var roleLimit *entity.TransactionLimit
+	if err == nil && role != "" {
+		roleLimit, err = a.limitRepo.GetTransactionLimit(entity.TransactionLimitScopeRole, role)
+	}
+
+	if err != nil {
+		logging.Logger.Error().Err(err).
+			Str("source_account_id", transaction.SourceAccountID).
+			Str("role", role).
+			Msg("Failed to get transaction limits")
+		return "Failed to check transaction limits", custom_err.ErrDatabase
+	}
+
+	// the account limit counts the debits of the account, the role limit those initiated by the requester
+	msg, err := a.checkTransactionLimit(accountLimit, amount, transaction.SourceCurrency, transaction.SourceAccountID, "")
+	if err != nil || msg != "" {
+		return msg, err
+	}
+	return a.checkTransactionLimit(roleLimit, amount, transaction.SourceCurrency, "", transaction.CreatedBy)
+}
+
+// checkTransactionLimit checks the amount against the limit. Limit amounts are in the currency of the debited
+// account, so usage only counts the debits in the same currency.
+func (a *InitTransaction) checkTransactionLimit(limit *entity.TransactionLimit, amount money.Amount, currency, accountID, createdBy string) (string, error) {
+	if limit == nil {
+		return "", nil
+	}
+
+	var daily, monthly entity.TransactionUsage
+	if limit.HasPeriodLimits() {
+		now := time.Now()
+		dayStart := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
+		monthStart := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, now.Location())
+
+		var err error
+		daily, err = a.transactionRepo.GetDebitUsage(accountID, createdBy, currency, dayStart)
+		if err == nil {
+			monthly, err = a.transactionRepo.GetDebitUsage(accountID, createdBy, currency, monthStart)
+		}
+		if err != nil {
+			logging.Logger.Error().Err(err).
+				Str("account_id", accountID).
+				Str("created_by", createdBy).
+				Msg("Failed to get transaction usage")
+			return "Failed to check transaction limits", custom_err.ErrDatabase
+		}
+	}
+
+	breach := limit.Check(amount, daily, monthly)
+	if breach == "" {
+		return "", nil
+	}
+
+	logging.Logger.Warn().Err(custom_err.ErrTransactionLimitExceeded).
+		Str("limit_scope", limit.Scope).
+		Str("limit_subject", limit.Subject).
+		Str("transaction_amount", amount.String()).
+		Msg("Transaction limit exceeded")
+	return fmt.Sprintf("Transaction limit exceeded: %s for %s", breach, limit.Describe()), custom_err.ErrTransactionLimitExceeded
+}
@@ -6,6 +6,8 @@ import (
 	"testing"
 	"transaction-service/internal/app/saga"
 	"transaction-service/internal/domain/entity"
+	custom_err "transaction-service/internal/domain/error"
+	"transaction-service/internal/domain/money"
 	"transaction-service/internal/ports"
 	"transaction-service/internal/ports/mocks"
 
@@ -19,12 +21,14 @@ func TestTransactionSagaOrchestrator_ExecuteTransactionSync_SuccessfulTransfer(t
 	mockAccountClient := new(mocks.MockAccountClient)
 	mockTransactionRepo := new(mocks.MockTransactionRepo)
 	mockEventRepo := new(mocks.MockEventRepo)
+	mockExchangeRateRepo := new(mocks.MockExchangeRateRepo)
 
 	orchestrator := saga.NewTransactionSagaOrchestrator(
 		mockSagaRepo,
 		mockAccountClient,
 		mockTransactionRepo,
 		mockEventRepo,
+		mockExchangeRateRepo,
 	)
 
 	ctx := context.Background()
@@ -32,7 +36,7 @@ func TestTransactionSagaOrchestrator_ExecuteTransactionSync_SuccessfulTransfer(t
 		ID:                   "txn-123",
 		SourceAccountID:      "acc-123",
 		DestinationAccountID: stringPtr("acc-456"),
-		Amount:               100.0,
+		Amount:               money.MustParse("100.00"),
 		Type:                 entity.TransactionTypeTransfer,
 		ReferenceID:          "ref-123",
 	}
@@ -40,8 +44,8 @@ func TestTransactionSagaOrchestrator_ExecuteTransactionSync_SuccessfulTransfer(t
 	requestID := "req-123"
 
 	accountsInfo := []ports.AccountInfo{
-		{AccountID: "acc-123", CustomerID: "cust-123", Balance: 500.0, Version: 1},
-		{AccountID: "acc-456", CustomerID: "cust-456", Balance: 200.0, Version: 1},
+		{AccountID: "acc-123", CustomerID: "cust-123", Balance: money.MustParse("500.00"), Version: 1},
+		{AccountID: "acc-456", CustomerID: "cust-456", Balance: money.MustParse("200.00"), Version: 1},
 	}
 
 	mockSagaRepo.On("CreateSaga", mock.AnythingOfType("*entity.TransactionSaga")).Return(nil)
@@ -68,15 +72,19 @@ func TestTransactionSagaOrchestrator_ExecuteTransactionSync_SuccessfulTransfer(t
 	// Process step
 	mockAccountClient.On("UpdateAccountsBalance",
 		ctx,
+		"txn-123",
 		mock.MatchedBy(func(updates []ports.AccountBalanceUpdate) bool {
 			return len(updates) == 2 &&
-				updates[0].AccountID == "acc-123" && updates[0].NewBalance == 400.0 &&
-				updates[1].AccountID == "acc-456" && updates[1].NewBalance == 300.0
+				updates[0].AccountID == "acc-123" && updates[0].NewBalance == money.MustParse("400.00") &&
+				updates[1].AccountID == "acc-456" && updates[1].NewBalance == money.MustParse("300.00")
 		}),
+		false,
 		requester,
 		requestID,
 	).Return([]ports.AccountBalanceUpdateResponse{}, "", nil)
 
+	mockTransactionRepo.On("UpdateTransactionExchange", "txn-123", money.MustParse("100.00"), money.OneRate).Return(nil)
+
 	// Complete step
 	mockTransactionRepo.On("UpdateTransactionStatus",
 		"txn-123",
@@ -112,31 +120,274 @@ func TestTransactionSagaOrchestrator_ExecuteTransactionSync_SuccessfulTransfer(t
 	mockTransactionRepo.AssertExpectations(t)
 }
 
+// TestTransactionSagaOrchestrator_ExecuteTransactionSync_CrossCurrencyTransfer tests that the destination is credited in its own currency
+func TestTransactionSagaOrchestrator_ExecuteTransactionSync_CrossCurrencyTransfer(t *testing.T) {
+	mockSagaRepo := new(mocks.MockSagaRepo)
+	mockAccountClient := new(mocks.MockAccountClient)
+	mockTransactionRepo := new(mocks.MockTransactionRepo)
+	mockEventRepo := new(mocks.MockEventRepo)
+	mockExchangeRateRepo := new(mocks.MockExchangeRateRepo)
+
+	orchestrator := saga.NewTransactionSagaOrchestrator(
+		mockSagaRepo,
+		mockAccountClient,
+		mockTransactionRepo,
+		mockEventRepo,
+		mockExchangeRateRepo,
+	)
+
+	ctx := context.Background()
+	transaction := &entity.Transaction{
+		ID:                   "txn-123",
+		SourceAccountID:      "acc-123",
+		DestinationAccountID: stringPtr("acc-456"),
+		Amount:               money.MustParse("100.00"),
+		Type:                 entity.TransactionTypeTransfer,
+		ReferenceID:          "ref-123",
+	}
+	requester := "user-1"
+	requestID := "req-123"
+
+	accountsInfo := []ports.AccountInfo{
+		{AccountID: "acc-123", CustomerID: "cust-123", Balance: money.MustParse("500.00"), Currency: entity.CurrencyUSD, Version: 1},
+		{AccountID: "acc-456", CustomerID: "cust-456", Balance: money.MustParse("200.00"), Currency: entity.CurrencyBDT, Version: 1},
+	}
+	rate := money.MustParseRate("109.5")
+
+	mockSagaRepo.On("CreateSaga", mock.AnythingOfType("*entity.TransactionSaga")).Return(nil)
+	mockSagaRepo.On("UpdateSaga", mock.AnythingOfType("*entity.TransactionSaga")).Return(nil)
+	mockAccountClient.On("ValidateAndGetAccounts", ctx, []string{"acc-123", "acc-456"}, requester, requestID).
+		Return(accountsInfo, "", nil)
+	mockAccountClient.On("LockAccounts", ctx, []string{"acc-123", "acc-456"}, "txn-123", requester, requestID).
+		Return("", nil)
+	mockExchangeRateRepo.On("GetExchangeRate", entity.CurrencyUSD, entity.CurrencyBDT).
+		Return(&entity.ExchangeRate{BaseCurrency: entity.CurrencyUSD, QuoteCurrency: entity.CurrencyBDT, Rate: rate}, nil)
+	mockTransactionRepo.On("UpdateTransactionExchange", "txn-123", money.MustParse("10950.00"), rate).Return(nil)
+
+	// Source is debited in USD, destination is credited in BDT
+	mockAccountClient.On("UpdateAccountsBalance",
+		ctx,
+		"txn-123",
+		mock.MatchedBy(func(updates []ports.AccountBalanceUpdate) bool {
+			return len(updates) == 2 &&
+				updates[0].AccountID == "acc-123" && updates[0].NewBalance == money.MustParse("400.00") &&
+				updates[1].AccountID == "acc-456" && updates[1].NewBalance == money.MustParse("11150.00")
+		}),
+		false,
+		requester,
+		requestID,
+	).Return([]ports.AccountBalanceUpdateResponse{}, "", nil)
+
+	mockTransactionRepo.On("UpdateTransactionStatus", "txn-123", entity.TransactionStatusSuccessful, "").Return(nil)
+	mockAccountClient.On("UnlockAccounts", ctx, "txn-123", requester, requestID).Return("", nil)
+	mockTransactionRepo.On("UpdateTransactionStatus", "txn-123", entity.TransactionStatusCompleted, "").Return(nil)
+
+	// Execute
+	err := orchestrator.ExecuteTransactionSync(ctx, transaction, requester, requestID)
+
+	// Assert
+	assert.NoError(t, err)
+	mockAccountClient.AssertExpectations(t)
+	mockTransactionRepo.AssertExpectations(t)
+	mockExchangeRateRepo.AssertExpectations(t)
+}
+
+// TestTransactionSagaOrchestrator_ExecuteTransactionSync_OverdraftTransfer tests that a transfer may take the source
+// balance negative within its overdraft limit
+func TestTransactionSagaOrchestrator_ExecuteTransactionSync_OverdraftTransfer(t *testing.T) {
+	mockSagaRepo := new(mocks.MockSagaRepo)
+	mockAccountClient := new(mocks.MockAccountClient)
+	mockTransactionRepo := new(mocks.MockTransactionRepo)
+	mockEventRepo := new(mocks.MockEventRepo)
+	mockExchangeRateRepo := new(mocks.MockExchangeRateRepo)
+
+	orchestrator := saga.NewTransactionSagaOrchestrator(
+		mockSagaRepo,
+		mockAccountClient,
+		mockTransactionRepo,
+		mockEventRepo,
+		mockExchangeRateRepo,
+	)
+
+	ctx := context.Background()
+	transaction := &entity.Transaction{
+		ID:                   "txn-123",
+		SourceAccountID:      "acc-123",
+		DestinationAccountID: stringPtr("acc-456"),
+		Amount:               money.MustParse("100.00"),
+		Type:                 entity.TransactionTypeTransfer,
+		ReferenceID:          "ref-123",
+	}
+
+	accountsInfo := []ports.AccountInfo{
+		{AccountID: "acc-123", CustomerID: "cust-123", Balance: money.MustParse("50.00"), OverdraftLimit: money.MustParse("100.00"),
+			AccountType: "current", Version: 1},
+		{AccountID: "acc-456", CustomerID: "cust-456", Balance: money.MustParse("200.00"), Version: 1},
+	}
+
+	mockSagaRepo.On("CreateSaga", mock.AnythingOfType("*entity.TransactionSaga")).Return(nil)
+	mockSagaRepo.On("UpdateSaga", mock.AnythingOfType("*entity.TransactionSaga")).Return(nil)
+	mockAccountClient.On("ValidateAndGetAccounts", ctx, []string{"acc-123", "acc-456"}, "user-1", "req-123").Return(accountsInfo, "", nil)
+	mockAccountClient.On("LockAccounts", ctx, []string{"acc-123", "acc-456"}, "txn-123", "user-1", "req-123").Return("", nil)
+	mockAccountClient.On("UpdateAccountsBalance",
+		ctx,
+		"txn-123",
+		mock.MatchedBy(func(updates []ports.AccountBalanceUpdate) bool {
+			return len(updates) == 2 &&
+				updates[0].AccountID == "acc-123" && updates[0].NewBalance == money.MustParse("-50.00") &&
+				updates[1].AccountID == "acc-456" && updates[1].NewBalance == money.MustParse("300.00")
+		}),
+		false,
+		"user-1",
+		"req-123",
+	).Return([]ports.AccountBalanceUpdateResponse{}, "", nil)
+	mockTransactionRepo.On("UpdateTransactionExchange", "txn-123", money.MustParse("100.00"), money.OneRate).Return(nil)
+	mockTransactionRepo.On("UpdateTransactionStatus", "txn-123", mock.Anything, "").Return(nil)
+	mockAccountClient.On("UnlockAccounts", ctx, "txn-123", "user-1", "req-123").Return("", nil)
+
+	err := orchestrator.ExecuteTransactionSync(ctx, transaction, "user-1", "req-123")
+
+	assert.NoError(t, err)
+	mockAccountClient.AssertExpectations(t)
+}
+
+// TestTransactionSagaOrchestrator_ExecuteTransactionSync_WithdrawFullKeepsHeldFunds tests that a full withdrawal
+// leaves the funds reserved by holds on the account
+func TestTransactionSagaOrchestrator_ExecuteTransactionSync_WithdrawFullKeepsHeldFunds(t *testing.T) {
+	mockSagaRepo := new(mocks.MockSagaRepo)
+	mockAccountClient := new(mocks.MockAccountClient)
+	mockTransactionRepo := new(mocks.MockTransactionRepo)
+
+	orchestrator := saga.NewTransactionSagaOrchestrator(
+		mockSagaRepo,
+		mockAccountClient,
+		mockTransactionRepo,
+		new(mocks.MockEventRepo),
+		new(mocks.MockExchangeRateRepo),
+	)
+
+	ctx := context.Background()
+	transaction := &entity.Transaction{
+		ID:              "txn-123",
+		SourceAccountID: "acc-123",
+		Type:            entity.TransactionTypeWithdrawFull,
+		ReferenceID:     "ref-123",
+	}
+
+	accountsInfo := []ports.AccountInfo{
+		{AccountID: "acc-123", CustomerID: "cust-123", Balance: money.MustParse("250.00"), HeldAmount: money.MustParse("75.00"), Version: 1},
+	}
+
+	mockSagaRepo.On("CreateSaga", mock.AnythingOfType("*entity.TransactionSaga")).Return(nil)
+	mockSagaRepo.On("UpdateSaga", mock.AnythingOfType("*entity.TransactionSaga")).Return(nil)
+	mockAccountClient.On("ValidateAndGetAccounts", ctx, []string{"acc-123"}, "user-1", "req-123").Return(accountsInfo, "", nil)
+	mockAccountClient.On("LockAccounts", ctx, []string{"acc-123"}, "txn-123", "user-1", "req-123").Return("", nil)
+	mockAccountClient.On("UpdateAccountsBalance",
+		ctx,
+		"txn-123",
+		mock.MatchedBy(func(updates []ports.AccountBalanceUpdate) bool {
+			return len(updates) == 1 && updates[0].NewBalance == money.MustParse("75.00") && updates[0].HoldID == ""
+		}),
+		false,
+		"user-1",
+		"req-123",
+	).Return([]ports.AccountBalanceUpdateResponse{}, "", nil)
+	// the withdrawn amount is recorded for limits and history
+	mockTransactionRepo.On("UpdateTransactionAmount", "txn-123", money.MustParse("175.00")).Return(nil)
+	mockTransactionRepo.On("UpdateTransactionStatus", "txn-123", mock.Anything, "").Return(nil)
+	mockAccountClient.On("UnlockAccounts", ctx, "txn-123", "user-1", "req-123").Return("", nil)
+
+	err := orchestrator.ExecuteTransactionSync(ctx, transaction, "user-1", "req-123")
+
+	assert.NoError(t, err)
+	mockAccountClient.AssertExpectations(t)
+	mockTransactionRepo.AssertExpectations(t)
+}
+
+// TestInitTransaction_Execute_ExchangeRateNotFound tests that a cross-currency transfer without a rate is rejected
+func TestInitTransaction_Execute_ExchangeRateNotFound(t *testing.T) {
+	mockTransactionRepo := new(mocks.MockTransactionRepo)
+	mockAccountClient := new(mocks.MockAccountClient)
+	mockSagaRepo := new(mocks.MockSagaRepo)
+	mockEventRepo := new(mocks.MockEventRepo)
+	mockExchangeRateRepo := new(mocks.MockExchangeRateRepo)
+	mockTransactionQueue := new(mocks.MockTransactionQueue)
+
+	initTransaction := NewInitTransaction(
+		mockTransactionRepo,
+		mockAccountClient,
+		mockSagaRepo,
+		mockEventRepo,
+		mockExchangeRateRepo,
+		nil,
+		nil,
+		mockTransactionQueue,
+	)
+	mockTransactionRepo.On("GetTransactionByReferenceID", mock.Anything, mock.Anything).Return(nil, nil)
+
+	ctx := context.Background()
+	destAccountID := "acc-456"
+	accountsInfo := []ports.AccountInfo{
+		{AccountID: "acc-123", CustomerID: "cust-123", Balance: money.MustParse("500.00"), Currency: entity.CurrencyEUR},
+		{AccountID: destAccountID, CustomerID: "cust-456", Balance: money.MustParse("200.00"), Currency: entity.CurrencyBDT},
+	}
+
+	mockAccountClient.On("ValidateAndGetAccounts", ctx, []string{"acc-123", destAccountID}, "user-1", "req-123").
+		Return(accountsInfo, "", nil)
+	mockExchangeRateRepo.On("GetExchangeRate", entity.CurrencyEUR, entity.CurrencyBDT).Return(nil, nil)
+
+	// Execute
+	transaction, msg, err := initTransaction.Execute(
+		ctx,
+		"acc-123",
+		&destAccountID,
+		money.MustParse("100.00"),
+		entity.TransactionTypeTransfer,
+		"ref-123",
+		"user-1",
+		"req-123",
+	)
+
+	// Assert
+	assert.ErrorIs(t, err, custom_err.ErrExchangeRateNotFound)
+	assert.Nil(t, transaction)
+	assert.Equal(t, "Exchange rate not available for EUR to BDT", msg)
+	mockTransactionRepo.AssertNotCalled(t, "CreateTransaction", mock.Anything)
+	mockExchangeRateRepo.AssertExpectations(t)
+}
+
 // TestInitTransaction_Execute_SuccessfulWithdrawFull tests successful withdraw full transaction
 func TestInitTransaction_Execute_SuccessfulWithdrawFull(t *testing.T) {
 	mockTransactionRepo := new(mocks.MockTransactionRepo)
 	mockAccountClient := new(mocks.MockAccountClient)
 	mockSagaRepo := new(mocks.MockSagaRepo)
 	mockEventRepo := new(mocks.MockEventRepo)
+	mockExchangeRateRepo := new(mocks.MockExchangeRateRepo)
+	mockTransactionQueue := new(mocks.MockTransactionQueue)
 
 	initTransaction := NewInitTransaction(
 		mockTransactionRepo,
 		mockAccountClient,
 		mockSagaRepo,
 		mockEventRepo,
+		mockExchangeRateRepo,
+		nil,
+		nil,
+		mockTransactionQueue,
 	)
+	mockTransactionRepo.On("GetTransactionByReferenceID", mock.Anything, mock.Anything).Return(nil, nil)
 
 	ctx := context.Background()
 	sourceAccountID := "acc-123"
 	var destAccountID *string = nil
-	amount := 100.0 // This should be set to 0 for withdraw full
+	amount := money.MustParse("100.00") // This should be set to 0 for withdraw full
 	transactionType := entity.TransactionTypeWithdrawFull
 	referenceID := "ref-123"
 	requester := "user-1"
 	requestID := "req-123"
 
 	accountsInfo := []ports.AccountInfo{
-		{AccountID: sourceAccountID, CustomerID: "cust-123", Balance: 500.0},
+		{AccountID: sourceAccountID, CustomerID: "cust-123", Balance: money.MustParse("500.00")},
 	}
 
 	expectedTransaction := &entity.Transaction{
@@ -161,7 +412,7 @@ func TestInitTransaction_Execute_SuccessfulWithdrawFull(t *testing.T) {
 			tx := args.Get(0).(*entity.Transaction)
 			assert.Equal(t, sourceAccountID, tx.SourceAccountID)
 			assert.Nil(t, tx.DestinationAccountID)
-			assert.Equal(t, 0.0, tx.Amount) // Should be 0 for withdraw full
+			assert.Equal(t, money.Zero, tx.Amount) // Should be 0 for withdraw full
 			assert.Equal(t, transactionType, tx.Type)
 		}).
 		Return(nil)
@@ -172,10 +423,12 @@ func TestInitTransaction_Execute_SuccessfulWithdrawFull(t *testing.T) {
 		Return(accountsInfo, "", nil)
 	mockAccountClient.On("LockAccounts", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
 		Return("", nil)
-	mockAccountClient.On("UpdateAccountsBalance", mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+	mockAccountClient.On("UpdateAccountsBalance", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
 		Return([]ports.AccountBalanceUpdateResponse{}, "", nil)
 	mockAccountClient.On("UnlockAccounts", mock.Anything, mock.Anything, mock.Anything, mock.Anything).
 		Return("", nil)
+	// the saga records the withdrawn balance as the amount
+	mockTransactionRepo.On("UpdateTransactionAmount", mock.Anything, money.MustParse("500.00")).Return(nil)
 	mockSagaRepo.On("UpdateSaga", mock.AnythingOfType("*entity.TransactionSaga")).Return(nil)
 	mockTransactionRepo.On("UpdateTransactionStatus", mock.Anything, mock.Anything, mock.Anything).Return(nil)
 
@@ -211,18 +464,24 @@ func TestInitTransaction_Execute_ValidationFailure_MissingSourceAccount(t *testi
 	mockAccountClient := new(mocks.MockAccountClient)
 	mockSagaRepo := new(mocks.MockSagaRepo)
 	mockEventRepo := new(mocks.MockEventRepo)
+	mockExchangeRateRepo := new(mocks.MockExchangeRateRepo)
+	mockTransactionQueue := new(mocks.MockTransactionQueue)
 
 	initTransaction := NewInitTransaction(
 		mockTransactionRepo,
 		mockAccountClient,
 		mockSagaRepo,
 		mockEventRepo,
+		mockExchangeRateRepo,
+		nil,
+		nil,
+		mockTransactionQueue,
 	)
 
 	ctx := context.Background()
 	sourceAccountID := ""
 	destAccountID := "acc-456"
-	amount := 100.0
+	amount := money.MustParse("100.00")
 	transactionType := entity.TransactionTypeTransfer
 	referenceID := "ref-123"
 	requester := "user-1"
@@ -252,18 +511,24 @@ func TestInitTransaction_Execute_ValidationFailure_MissingReferenceID(t *testing
 	mockAccountClient := new(mocks.MockAccountClient)
 	mockSagaRepo := new(mocks.MockSagaRepo)
 	mockEventRepo := new(mocks.MockEventRepo)
+	mockExchangeRateRepo := new(mocks.MockExchangeRateRepo)
+	mockTransactionQueue := new(mocks.MockTransactionQueue)
 
 	initTransaction := NewInitTransaction(
 		mockTransactionRepo,
 		mockAccountClient,
 		mockSagaRepo,
 		mockEventRepo,
+		mockExchangeRateRepo,
+		nil,
+		nil,
+		mockTransactionQueue,
 	)
 
 	ctx := context.Background()
 	sourceAccountID := "acc-123"
 	destAccountID := "acc-456"
-	amount := 100.0
+	amount := money.MustParse("100.00")
 	transactionType := entity.TransactionTypeTransfer
 	referenceID := ""
 	requester := "user-1"
@@ -293,18 +558,24 @@ func TestInitTransaction_Execute_ValidationFailure_MissingRequester(t *testing.T
 	mockAccountClient := new(mocks.MockAccountClient)
 	mockSagaRepo := new(mocks.MockSagaRepo)
 	mockEventRepo := new(mocks.MockEventRepo)
+	mockExchangeRateRepo := new(mocks.MockExchangeRateRepo)
+	mockTransactionQueue := new(mocks.MockTransactionQueue)
 
 	initTransaction := NewInitTransaction(
 		mockTransactionRepo,
 		mockAccountClient,
 		mockSagaRepo,
 		mockEventRepo,
+		mockExchangeRateRepo,
+		nil,
+		nil,
+		mockTransactionQueue,
 	)
 
 	ctx := context.Background()
 	sourceAccountID := "acc-123"
 	destAccountID := "acc-456"
-	amount := 100.0
+	amount := money.MustParse("100.00")
 	transactionType := entity.TransactionTypeTransfer
 	referenceID := "ref-123"
 	requester := ""
@@ -334,18 +605,24 @@ func TestInitTransaction_Execute_ValidationFailure_InvalidTransactionType(t *tes
 	mockAccountClient := new(mocks.MockAccountClient)
 	mockSagaRepo := new(mocks.MockSagaRepo)
 	mockEventRepo := new(mocks.MockEventRepo)
+	mockExchangeRateRepo := new(mocks.MockExchangeRateRepo)
+	mockTransactionQueue := new(mocks.MockTransactionQueue)
 
 	initTransaction := NewInitTransaction(
 		mockTransactionRepo,
 		mockAccountClient,
 		mockSagaRepo,
 		mockEventRepo,
+		mockExchangeRateRepo,
+		nil,
+		nil,
+		mockTransactionQueue,
 	)
 
 	ctx := context.Background()
 	sourceAccountID := "acc-123"
 	destAccountID := "acc-456"
-	amount := 100.0
+	amount := money.MustParse("100.00")
 	transactionType := "INVALID_TYPE"
 	referenceID := "ref-123"
 	requester := "user-1"
@@ -375,18 +652,24 @@ func TestInitTransaction_Execute_ValidationFailure_SameSourceDestination(t *test
 	mockAccountClient := new(mocks.MockAccountClient)
 	mockSagaRepo := new(mocks.MockSagaRepo)
 	mockEventRepo := new(mocks.MockEventRepo)
+	mockExchangeRateRepo := new(mocks.MockExchangeRateRepo)
+	mockTransactionQueue := new(mocks.MockTransactionQueue)
 
 	initTransaction := NewInitTransaction(
 		mockTransactionRepo,
 		mockAccountClient,
 		mockSagaRepo,
 		mockEventRepo,
+		mockExchangeRateRepo,
+		nil,
+		nil,
+		mockTransactionQueue,
 	)
 
 	ctx := context.Background()
 	sourceAccountID := "acc-123"
 	destAccountID := "acc-123" // Same as source
-	amount := 100.0
+	amount := money.MustParse("100.00")
 	transactionType := entity.TransactionTypeTransfer
 	referenceID := "ref-123"
 	requester := "user-1"
@@ -416,18 +699,25 @@ func TestInitTransaction_Execute_AccountValidationFailure(t *testing.T) {
 	mockAccountClient := new(mocks.MockAccountClient)
 	mockSagaRepo := new(mocks.MockSagaRepo)
 	mockEventRepo := new(mocks.MockEventRepo)
+	mockExchangeRateRepo := new(mocks.MockExchangeRateRepo)
+	mockTransactionQueue := new(mocks.MockTransactionQueue)
 
 	initTransaction := NewInitTransaction(
 		mockTransactionRepo,
 		mockAccountClient,
 		mockSagaRepo,
 		mockEventRepo,
+		mockExchangeRateRepo,
+		nil,
+		nil,
+		mockTransactionQueue,
 	)
+	mockTransactionRepo.On("GetTransactionByReferenceID", mock.Anything, mock.Anything).Return(nil, nil)
 
 	ctx := context.Background()
 	sourceAccountID := "acc-123"
 	destAccountID := "acc-456"
-	amount := 100.0
+	amount := money.MustParse("100.00")
 	transactionType := entity.TransactionTypeTransfer
 	referenceID := "ref-123"
 	requester := "user-1"
@@ -466,18 +756,25 @@ func TestInitTransaction_Execute_AccountDetailsNotFound(t *testing.T) {
 	mockAccountClient := new(mocks.MockAccountClient)
 	mockSagaRepo := new(mocks.MockSagaRepo)
 	mockEventRepo := new(mocks.MockEventRepo)
+	mockExchangeRateRepo := new(mocks.MockExchangeRateRepo)
+	mockTransactionQueue := new(mocks.MockTransactionQueue)
 
 	initTransaction := NewInitTransaction(
 		mockTransactionRepo,
 		mockAccountClient,
 		mockSagaRepo,
 		mockEventRepo,
+		mockExchangeRateRepo,
+		nil,
+		nil,
+		mockTransactionQueue,
 	)
+	mockTransactionRepo.On("GetTransactionByReferenceID", mock.Anything, mock.Anything).Return(nil, nil)
 
 	ctx := context.Background()
 	sourceAccountID := "acc-123"
 	destAccountID := "acc-456"
-	amount := 100.0
+	amount := money.MustParse("100.00")
 	transactionType := entity.TransactionTypeTransfer
 	referenceID := "ref-123"
 	requester := "user-1"
@@ -516,26 +813,33 @@ func TestInitTransaction_Execute_InsufficientBalance(t *testing.T) {
 	mockAccountClient := new(mocks.MockAccountClient)
 	mockSagaRepo := new(mocks.MockSagaRepo)
 	mockEventRepo := new(mocks.MockEventRepo)
+	mockExchangeRateRepo := new(mocks.MockExchangeRateRepo)
+	mockTransactionQueue := new(mocks.MockTransactionQueue)
 
 	initTransaction := NewInitTransaction(
 		mockTransactionRepo,
 		mockAccountClient,
 		mockSagaRepo,
 		mockEventRepo,
+		mockExchangeRateRepo,
+		nil,
+		nil,
+		mockTransactionQueue,
 	)
+	mockTransactionRepo.On("GetTransactionByReferenceID", mock.Anything, mock.Anything).Return(nil, nil)
 
 	ctx := context.Background()
 	sourceAccountID := "acc-123"
 	destAccountID := "acc-456"
-	amount := 600.0 // More than available balance
+	amount := money.MustParse("600.00") // More than available balance
 	transactionType := entity.TransactionTypeTransfer
 	referenceID := "ref-123"
 	requester := "user-1"
 	requestID := "req-123"
 
 	accountsInfo := []ports.AccountInfo{
-		{AccountID: sourceAccountID, CustomerID: "cust-123", Balance: 500.0}, // Only 500 available
-		{AccountID: destAccountID, CustomerID: "cust-456", Balance: 200.0},
+		{AccountID: sourceAccountID, CustomerID: "cust-123", Balance: money.MustParse("500.00")}, // Only 500 available
+		{AccountID: destAccountID, CustomerID: "cust-456", Balance: money.MustParse("200.00")},
 	}
 
 	// Mock expectations
@@ -571,26 +875,33 @@ func TestInitTransaction_Execute_GetTransactionStatusFailure(t *testing.T) {
 	mockAccountClient := new(mocks.MockAccountClient)
 	mockSagaRepo := new(mocks.MockSagaRepo)
 	mockEventRepo := new(mocks.MockEventRepo)
+	mockExchangeRateRepo := new(mocks.MockExchangeRateRepo)
+	mockTransactionQueue := new(mocks.MockTransactionQueue)
 
 	initTransaction := NewInitTransaction(
 		mockTransactionRepo,
 		mockAccountClient,
 		mockSagaRepo,
 		mockEventRepo,
+		mockExchangeRateRepo,
+		nil,
+		nil,
+		mockTransactionQueue,
 	)
+	mockTransactionRepo.On("GetTransactionByReferenceID", mock.Anything, mock.Anything).Return(nil, nil)
 
 	ctx := context.Background()
 	sourceAccountID := "acc-123"
 	destAccountID := "acc-456"
-	amount := 100.0
+	amount := money.MustParse("100.00")
 	transactionType := entity.TransactionTypeTransfer
 	referenceID := "ref-123"
 	requester := "user-1"
 	requestID := "req-123"
 
 	accountsInfo := []ports.AccountInfo{
-		{AccountID: sourceAccountID, CustomerID: "cust-123", Balance: 500.0},
-		{AccountID: destAccountID, CustomerID: "cust-456", Balance: 200.0},
+		{AccountID: sourceAccountID, CustomerID: "cust-123", Balance: money.MustParse("500.00")},
+		{AccountID: destAccountID, CustomerID: "cust-456", Balance: money.MustParse("200.00")},
 	}
 
 	// Mock expectations
@@ -610,11 +921,12 @@ func TestInitTransaction_Execute_GetTransactionStatusFailure(t *testing.T) {
 		Return(accountsInfo, "", nil)
 	mockAccountClient.On("LockAccounts", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
 		Return("", nil)
-	mockAccountClient.On("UpdateAccountsBalance", mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+	mockAccountClient.On("UpdateAccountsBalance", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
 		Return([]ports.AccountBalanceUpdateResponse{}, "", nil)
 	mockAccountClient.On("UnlockAccounts", mock.Anything, mock.Anything, mock.Anything, mock.Anything).
 		Return("", nil)
 	mockSagaRepo.On("UpdateSaga", mock.AnythingOfType("*entity.TransactionSaga")).Return(nil)
+	mockTransactionRepo.On("UpdateTransactionExchange", mock.Anything, amount, money.OneRate).Return(nil)
 	mockTransactionRepo.On("UpdateTransactionStatus", mock.Anything, mock.Anything, mock.Anything).Return(nil)
 
 	// Transaction status retrieval fails
@@ -646,3 +958,603 @@ func TestInitTransaction_Execute_GetTransactionStatusFailure(t *testing.T) {
 func stringPtr(s string) *string {
 	return &s
 }
+
+// TestInitTransaction_Execute_DuplicateReference tests that a retried request returns the original transaction
+func TestInitTransaction_Execute_DuplicateReference(t *testing.T) {
+	mockTransactionRepo := new(mocks.MockTransactionRepo)
+	mockAccountClient := new(mocks.MockAccountClient)
+	mockSagaRepo := new(mocks.MockSagaRepo)
+	mockEventRepo := new(mocks.MockEventRepo)
+	mockExchangeRateRepo := new(mocks.MockExchangeRateRepo)
+	mockTransactionQueue := new(mocks.MockTransactionQueue)
+
+	initTransaction := NewInitTransaction(
+		mockTransactionRepo,
+		mockAccountClient,
+		mockSagaRepo,
+		mockEventRepo,
+		mockExchangeRateRepo,
+		nil,
+		nil,
+		mockTransactionQueue,
+	)
+
+	ctx := context.Background()
+	destAccountID := "acc-456"
+	existingTransaction := &entity.Transaction{
+		ID:                   "txn-123",
+		SourceAccountID:      "acc-123",
+		DestinationAccountID: stringPtr(destAccountID),
+		Amount:               money.MustParse("100.00"),
+		Type:                 entity.TransactionTypeTransfer,
+		TransactionStatus:    entity.TransactionStatusCompleted,
+		ReferenceID:          "ref-123",
+		CreatedBy:            "user-1",
+	}
+
+	mockTransactionRepo.On("GetTransactionByReferenceID", "user-1", "ref-123").Return(existingTransaction, nil)
+
+	// Execute
+	transaction, msg, err := initTransaction.Execute(
+		ctx,
+		"acc-123",
+		&destAccountID,
+		money.MustParse("100.00"),
+		entity.TransactionTypeTransfer,
+		" ref-123 ",
+		"user-1",
+		"req-456",
+	)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, existingTransaction, transaction)
+	assert.Equal(t, "Transaction already initiated with this reference", msg)
+	mockTransactionRepo.AssertNotCalled(t, "CreateTransaction", mock.Anything)
+	mockAccountClient.AssertNotCalled(t, "ValidateAndGetAccounts", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	mockTransactionRepo.AssertExpectations(t)
+}
+
+// TestInitTransaction_Execute_DuplicateReferenceDifferentPayload tests that a reused reference with a different payload is rejected
+func TestInitTransaction_Execute_DuplicateReferenceDifferentPayload(t *testing.T) {
+	mockTransactionRepo := new(mocks.MockTransactionRepo)
+	mockAccountClient := new(mocks.MockAccountClient)
+	mockSagaRepo := new(mocks.MockSagaRepo)
+	mockEventRepo := new(mocks.MockEventRepo)
+	mockExchangeRateRepo := new(mocks.MockExchangeRateRepo)
+	mockTransactionQueue := new(mocks.MockTransactionQueue)
+
+	initTransaction := NewInitTransaction(
+		mockTransactionRepo,
+		mockAccountClient,
+		mockSagaRepo,
+		mockEventRepo,
+		mockExchangeRateRepo,
+		nil,
+		nil,
+		mockTransactionQueue,
+	)
+
+	ctx := context.Background()
+	destAccountID := "acc-456"
+	existingTransaction := &entity.Transaction{
+		ID:                   "txn-123",
+		SourceAccountID:      "acc-123",
+		DestinationAccountID: stringPtr(destAccountID),
+		Amount:               money.MustParse("100.00"),
+		Type:                 entity.TransactionTypeTransfer,
+		TransactionStatus:    entity.TransactionStatusCompleted,
+		ReferenceID:          "ref-123",
+		CreatedBy:            "user-1",
+	}
+
+	mockTransactionRepo.On("GetTransactionByReferenceID", "user-1", "ref-123").Return(existingTransaction, nil)
+
+	// Execute
+	transaction, msg, err := initTransaction.Execute(
+		ctx,
+		"acc-123",
+		&destAccountID,
+		money.MustParse("150.00"),
+		entity.TransactionTypeTransfer,
+		"ref-123",
+		"user-1",
+		"req-456",
+	)
+
+	// Assert
+	assert.ErrorIs(t, err, custom_err.ErrDuplicateReference)
+	assert.Nil(t, transaction)
+	assert.Equal(t, "Reference ID already used for a different transaction", msg)
+	mockTransactionRepo.AssertNotCalled(t, "CreateTransaction", mock.Anything)
+}
+
+// TestInitTransaction_Execute_ConcurrentDuplicateReference tests that a duplicate caught by the unique index returns the stored transaction
+func TestInitTransaction_Execute_ConcurrentDuplicateReference(t *testing.T) {
+	mockTransactionRepo := new(mocks.MockTransactionRepo)
+	mockAccountClient := new(mocks.MockAccountClient)
+	mockSagaRepo := new(mocks.MockSagaRepo)
+	mockEventRepo := new(mocks.MockEventRepo)
+	mockExchangeRateRepo := new(mocks.MockExchangeRateRepo)
+	mockTransactionQueue := new(mocks.MockTransactionQueue)
+
+	initTransaction := NewInitTransaction(
+		mockTransactionRepo,
+		mockAccountClient,
+		mockSagaRepo,
+		mockEventRepo,
+		mockExchangeRateRepo,
+		nil,
+		nil,
+		mockTransactionQueue,
+	)
+
+	ctx := context.Background()
+	storedTransaction := &entity.Transaction{
+		ID:                "txn-999",
+		SourceAccountID:   "acc-123",
+		Amount:            money.MustParse("50.00"),
+		Type:              entity.TransactionTypeAddAmount,
+		TransactionStatus: entity.TransactionStatusPending,
+		ReferenceID:       "ref-123",
+		CreatedBy:         "user-1",
+	}
+
+	mockTransactionRepo.On("GetTransactionByReferenceID", "user-1", "ref-123").Return(nil, nil).Once()
+	mockAccountClient.On("ValidateAndGetAccounts", ctx, []string{"acc-123"}, "user-1", "req-123").
+		Return([]ports.AccountInfo{{AccountID: "acc-123", CustomerID: "cust-123", Balance: money.MustParse("10.00")}}, "", nil)
+	mockTransactionRepo.On("CreateTransaction", mock.AnythingOfType("*entity.Transaction")).Return(custom_err.ErrDuplicateReference)
+	mockTransactionRepo.On("GetTransactionByReferenceID", "user-1", "ref-123").Return(storedTransaction, nil).Once()
+
+	// Execute
+	transaction, msg, err := initTransaction.Execute(
+		ctx,
+		"acc-123",
+		nil,
+		money.MustParse("50.00"),
+		entity.TransactionTypeAddAmount,
+		"ref-123",
+		"user-1",
+		"req-123",
+	)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, "txn-999", transaction.ID)
+	assert.Equal(t, entity.TransactionStatusPending, transaction.TransactionStatus)
+	assert.Equal(t, "Transaction already initiated with this reference", msg)
+	mockSagaRepo.AssertNotCalled(t, "CreateSaga", mock.Anything)
+	mockTransactionRepo.AssertExpectations(t)
+}
+
+// TestInitTransaction_ExecuteAsync_Queued tests that an async transaction is stored as pending and handed to the worker pool
+func TestInitTransaction_ExecuteAsync_Queued(t *testing.T) {
+	mockTransactionRepo := new(mocks.MockTransactionRepo)
+	mockAccountClient := new(mocks.MockAccountClient)
+	mockSagaRepo := new(mocks.MockSagaRepo)
+	mockEventRepo := new(mocks.MockEventRepo)
+	mockExchangeRateRepo := new(mocks.MockExchangeRateRepo)
+	mockTransactionQueue := new(mocks.MockTransactionQueue)
+
+	initTransaction := NewInitTransaction(
+		mockTransactionRepo,
+		mockAccountClient,
+		mockSagaRepo,
+		mockEventRepo,
+		mockExchangeRateRepo,
+		nil,
+		nil,
+		mockTransactionQueue,
+	)
+
+	ctx := context.Background()
+	mockTransactionRepo.On("GetTransactionByReferenceID", "user-1", "ref-123").Return(nil, nil)
+	mockAccountClient.On("ValidateAndGetAccounts", ctx, []string{"acc-123"}, "user-1", "req-123").
+		Return([]ports.AccountInfo{{AccountID: "acc-123", CustomerID: "cust-123", Balance: money.MustParse("10.00")}}, "", nil)
+	mockTransactionRepo.On("CreateTransaction", mock.AnythingOfType("*entity.Transaction")).Return(nil)
+	mockTransactionQueue.On("Submit", mock.Anything).Return(nil)
+
+	// Execute
+	transaction, msg, err := initTransaction.ExecuteAsync(
+		ctx,
+		"acc-123",
+		nil,
+		money.MustParse("50.00"),
+		entity.TransactionTypeAddAmount,
+		"ref-123",
+		"user-1",
+		"req-123",
+	)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.NotEmpty(t, transaction.ID)
+	assert.Equal(t, entity.TransactionStatusPending, transaction.TransactionStatus)
+	assert.True(t, transaction.TimeoutAt.IsZero())
+	assert.Equal(t, "Transaction accepted for processing", msg)
+	mockTransactionQueue.AssertExpectations(t)
+	mockSagaRepo.AssertNotCalled(t, "CreateSaga", mock.Anything)
+}
+
+// TestInitTransaction_ExecuteAsync_QueueFull tests that a transaction which cannot be queued is marked as failed
+func TestInitTransaction_ExecuteAsync_QueueFull(t *testing.T) {
+	mockTransactionRepo := new(mocks.MockTransactionRepo)
+	mockAccountClient := new(mocks.MockAccountClient)
+	mockSagaRepo := new(mocks.MockSagaRepo)
+	mockEventRepo := new(mocks.MockEventRepo)
+	mockExchangeRateRepo := new(mocks.MockExchangeRateRepo)
+	mockTransactionQueue := new(mocks.MockTransactionQueue)
+
+	initTransaction := NewInitTransaction(
+		mockTransactionRepo,
+		mockAccountClient,
+		mockSagaRepo,
+		mockEventRepo,
+		mockExchangeRateRepo,
+		nil,
+		nil,
+		mockTransactionQueue,
+	)
+
+	ctx := context.Background()
+	mockTransactionRepo.On("GetTransactionByReferenceID", "user-1", "ref-123").Return(nil, nil)
+	mockAccountClient.On("ValidateAndGetAccounts", ctx, []string{"acc-123"}, "user-1", "req-123").
+		Return([]ports.AccountInfo{{AccountID: "acc-123", CustomerID: "cust-123", Balance: money.MustParse("10.00")}}, "", nil)
+	mockTransactionRepo.On("CreateTransaction", mock.AnythingOfType("*entity.Transaction")).Return(nil)
+	mockTransactionQueue.On("Submit", mock.Anything).Return(custom_err.ErrTransactionQueueFull)
+	mockTransactionRepo.On("UpdateTransactionStatus", mock.Anything, entity.TransactionStatusFailed, custom_err.ErrTransactionQueueFull.Error()).Return(nil)
+
+	// Execute
+	transaction, msg, err := initTransaction.ExecuteAsync(
+		ctx,
+		"acc-123",
+		nil,
+		money.MustParse("50.00"),
+		entity.TransactionTypeAddAmount,
+		"ref-123",
+		"user-1",
+		"req-123",
+	)
+
+	// Assert
+	assert.ErrorIs(t, err, custom_err.ErrTransactionQueueFull)
+	assert.Nil(t, transaction)
+	assert.Equal(t, "Transaction queue is full, try again later", msg)
+	mockTransactionRepo.AssertExpectations(t)
+}
+
+// TestInitTransaction_ExecuteAsync_SkipsClaimed tests that the worker does not run the saga of a transaction it
+// cannot claim, e.g. one claimed by another worker or failed meanwhile
+func TestInitTransaction_ExecuteAsync_SkipsClaimed(t *testing.T) {
+	mockTransactionRepo := new(mocks.MockTransactionRepo)
+	mockAccountClient := new(mocks.MockAccountClient)
+	mockSagaRepo := new(mocks.MockSagaRepo)
+	mockTransactionQueue := new(mocks.MockTransactionQueue)
+
+	initTransaction := NewInitTransaction(
+		mockTransactionRepo,
+		mockAccountClient,
+		mockSagaRepo,
+		new(mocks.MockEventRepo),
+		new(mocks.MockExchangeRateRepo),
+		nil,
+		nil,
+		mockTransactionQueue,
+	)
+
+	ctx := context.Background()
+	var task func(ctx context.Context)
+	mockTransactionRepo.On("GetTransactionByReferenceID", "user-1", "ref-123").Return(nil, nil)
+	mockAccountClient.On("ValidateAndGetAccounts", ctx, []string{"acc-123"}, "user-1", "req-123").
+		Return([]ports.AccountInfo{{AccountID: "acc-123", CustomerID: "cust-123", Balance: money.MustParse("10.00")}}, "", nil)
+	mockTransactionRepo.On("CreateTransaction", mock.AnythingOfType("*entity.Transaction")).Return(nil)
+	mockTransactionQueue.On("Submit", mock.Anything).Run(func(args mock.Arguments) {
+		task = args.Get(0).(func(ctx context.Context))
+	}).Return(nil)
+
+	transaction, _, err := initTransaction.ExecuteAsync(
+		ctx,
+		"acc-123",
+		nil,
+		money.MustParse("50.00"),
+		entity.TransactionTypeAddAmount,
+		"ref-123",
+		"user-1",
+		"req-123",
+	)
+	assert.NoError(t, err)
+
+	// Execute
+	mockTransactionRepo.On("ClaimTransaction", transaction.ID, mock.AnythingOfType("time.Time")).Return(custom_err.ErrTransactionAlreadyClaimed)
+	task(ctx)
+
+	// Assert
+	mockTransactionRepo.AssertExpectations(t)
+	mockSagaRepo.AssertNotCalled(t, "CreateSaga", mock.Anything)
+	mockTransactionRepo.AssertNotCalled(t, "UpdateTransactionStatus", mock.Anything, mock.Anything, mock.Anything)
+}
+
+// TestInitTransaction_Resume tests that queued transactions are handed to the worker pool again
+func TestInitTransaction_Resume(t *testing.T) {
+	mockTransactionRepo := new(mocks.MockTransactionRepo)
+	mockTransactionQueue := new(mocks.MockTransactionQueue)
+
+	initTransaction := NewInitTransaction(
+		mockTransactionRepo,
+		new(mocks.MockAccountClient),
+		new(mocks.MockSagaRepo),
+		new(mocks.MockEventRepo),
+		new(mocks.MockExchangeRateRepo),
+		nil,
+		nil,
+		mockTransactionQueue,
+	)
+
+	queued := []*entity.Transaction{{ID: "txn-1"}, {ID: "txn-2"}}
+	mockTransactionRepo.On("GetQueuedTransactions").Return(queued, nil)
+	mockTransactionQueue.On("Submit", mock.Anything).Return(nil).Twice()
+
+	err := initTransaction.Resume()
+
+	assert.NoError(t, err)
+	mockTransactionQueue.AssertExpectations(t)
+}
+
+// TestInitTransaction_Execute_AccountTypeLimitExceeded tests that the daily amount limit of the account type
+// counts the debits already made today
+func TestInitTransaction_Execute_AccountTypeLimitExceeded(t *testing.T) {
+	mockTransactionRepo := new(mocks.MockTransactionRepo)
+	mockAccountClient := new(mocks.MockAccountClient)
+	mockLimitRepo := new(mocks.MockTransactionLimitRepo)
+
+	initTransaction := NewInitTransaction(
+		mockTransactionRepo,
+		mockAccountClient,
+		new(mocks.MockSagaRepo),
+		new(mocks.MockEventRepo),
+		new(mocks.MockExchangeRateRepo),
+		mockLimitRepo,
+		nil,
+		new(mocks.MockTransactionQueue),
+	)
+
+	ctx := WithRequesterRole(context.Background(), "Editor")
+	accountsInfo := []ports.AccountInfo{
+		{AccountID: "acc-123", CustomerID: "cust-123", Balance: money.MustParse("5000.00"), Currency: "USD", AccountType: entity.AccountTypeSavings},
+	}
+	savingsLimit := &entity.TransactionLimit{
+		Scope:       entity.TransactionLimitScopeAccountType,
+		Subject:     entity.AccountTypeSavings,
+		DailyAmount: money.MustParse("1000.00"),
+	}
+
+	mockTransactionRepo.On("GetTransactionByReferenceID", "user-1", "ref-123").Return(nil, nil)
+	mockAccountClient.On("ValidateAndGetAccounts", ctx, []string{"acc-123"}, "user-1", "req-123").Return(accountsInfo, "", nil)
+	mockLimitRepo.On("GetTransactionLimit", entity.TransactionLimitScopeAccount, "acc-123").Return(nil, nil)
+	mockLimitRepo.On("GetTransactionLimit", entity.TransactionLimitScopeAccountType, entity.AccountTypeSavings).Return(savingsLimit, nil)
+	mockLimitRepo.On("GetTransactionLimit", entity.TransactionLimitScopeRole, "editor").Return(nil, nil)
+	mockTransactionRepo.On("GetDebitUsage", "acc-123", "", "USD", mock.AnythingOfType("time.Time")).
+		Return(entity.TransactionUsage{Amount: money.MustParse("800.00"), Count: 2}, nil)
+
+	transaction, msg, err := initTransaction.Execute(ctx, "acc-123", nil, money.MustParse("300.00"),
+		entity.TransactionTypeWithdrawAmount, "ref-123", "user-1", "req-123")
+
+	assert.ErrorIs(t, err, custom_err.ErrTransactionLimitExceeded)
+	assert.Nil(t, transaction)
+	assert.Equal(t, "Transaction limit exceeded: daily amount limit of 1000.00, 800.00 already used for savings accounts", msg)
+	mockTransactionRepo.AssertNotCalled(t, "CreateTransaction", mock.Anything)
+	mockLimitRepo.AssertExpectations(t)
+}
+
+// TestInitTransaction_Execute_RoleLimitExceeded tests that an account override replaces the account type limit
+// and the role limit counts the transactions initiated by the requester
+func TestInitTransaction_Execute_RoleLimitExceeded(t *testing.T) {
+	mockTransactionRepo := new(mocks.MockTransactionRepo)
+	mockAccountClient := new(mocks.MockAccountClient)
+	mockLimitRepo := new(mocks.MockTransactionLimitRepo)
+
+	initTransaction := NewInitTransaction(
+		mockTransactionRepo,
+		mockAccountClient,
+		new(mocks.MockSagaRepo),
+		new(mocks.MockEventRepo),
+		new(mocks.MockExchangeRateRepo),
+		mockLimitRepo,
+		nil,
+		new(mocks.MockTransactionQueue),
+	)
+
+	ctx := WithRequesterRole(context.Background(), "editor")
+	accountsInfo := []ports.AccountInfo{
+		{AccountID: "acc-123", CustomerID: "cust-123", Balance: money.MustParse("5000.00"), Currency: "USD", AccountType: entity.AccountTypeCurrent},
+	}
+	accountLimit := &entity.TransactionLimit{
+		Scope:                entity.TransactionLimitScopeAccount,
+		Subject:              "acc-123",
+		PerTransactionAmount: money.MustParse("2000.00"),
+	}
+	editorLimit := &entity.TransactionLimit{
+		Scope:        entity.TransactionLimitScopeRole,
+		Subject:      "editor",
+		MonthlyCount: 20,
+	}
+
+	mockTransactionRepo.On("GetTransactionByReferenceID", "user-1", "ref-123").Return(nil, nil)
+	mockAccountClient.On("ValidateAndGetAccounts", ctx, []string{"acc-123"}, "user-1", "req-123").Return(accountsInfo, "", nil)
+	mockLimitRepo.On("GetTransactionLimit", entity.TransactionLimitScopeAccount, "acc-123").Return(accountLimit, nil)
+	mockLimitRepo.On("GetTransactionLimit", entity.TransactionLimitScopeRole, "editor").Return(editorLimit, nil)
+	mockTransactionRepo.On("GetDebitUsage", "", "user-1", "USD", mock.AnythingOfType("time.Time")).
+		Return(entity.TransactionUsage{Amount: money.MustParse("9000.00"), Count: 20}, nil)
+
+	transaction, msg, err := initTransaction.Execute(ctx, "acc-123", nil, money.Zero,
+		entity.TransactionTypeWithdrawFull, "ref-123", "user-1", "req-123")
+
+	assert.ErrorIs(t, err, custom_err.ErrTransactionLimitExceeded)
+	assert.Nil(t, transaction)
+	assert.Equal(t, "Transaction limit exceeded: per transaction amount limit of 2000.00 for account acc-123", msg)
+
+	// within the account limit the monthly count of the role applies
+	accountLimit.PerTransactionAmount = money.MustParse("6000.00")
+	_, msg, err = initTransaction.Execute(ctx, "acc-123", nil, money.Zero,
+		entity.TransactionTypeWithdrawFull, "ref-123", "user-1", "req-123")
+
+	assert.ErrorIs(t, err, custom_err.ErrTransactionLimitExceeded)
+	assert.Equal(t, "Transaction limit exceeded: monthly limit of 20 transactions for role editor", msg)
+	mockLimitRepo.AssertNotCalled(t, "GetTransactionLimit", entity.TransactionLimitScopeAccountType, mock.Anything)
+	mockTransactionRepo.AssertNotCalled(t, "GetDebitUsage", "acc-123", mock.Anything, mock.Anything, mock.Anything)
+}
+
+// TestTransactionSagaOrchestrator_ExecuteTransactionSync_TransferWithFee tests that the fee is debited with the amount
+// and credited to the income account in the same balance update
+func TestTransactionSagaOrchestrator_ExecuteTransactionSync_TransferWithFee(t *testing.T) {
+	mockSagaRepo := new(mocks.MockSagaRepo)
+	mockAccountClient := new(mocks.MockAccountClient)
+	mockTransactionRepo := new(mocks.MockTransactionRepo)
+
+	orchestrator := saga.NewTransactionSagaOrchestrator(
+		mockSagaRepo,
+		mockAccountClient,
+		mockTransactionRepo,
+		new(mocks.MockEventRepo),
+		new(mocks.MockExchangeRateRepo),
+	)
+
+	ctx := context.Background()
+	transaction := &entity.Transaction{
+		ID:                   "txn-123",
+		SourceAccountID:      "acc-123",
+		DestinationAccountID: stringPtr("acc-456"),
+		Amount:               money.MustParse("100.00"),
+		Type:                 entity.TransactionTypeTransfer,
+		ReferenceID:          "ref-123",
+		FeeAmount:            money.MustParse("1.50"),
+		FeeAccountID:         stringPtr("acc-income"),
+	}
+
+	accountIDs := []string{"acc-123", "acc-456", "acc-income"}
+	accountsInfo := []ports.AccountInfo{
+		{AccountID: "acc-123", Balance: money.MustParse("500.00"), Version: 1},
+		{AccountID: "acc-456", Balance: money.MustParse("200.00"), Version: 1},
+		{AccountID: "acc-income", Balance: money.MustParse("50.00"), Version: 7},
+	}
+
+	mockSagaRepo.On("CreateSaga", mock.AnythingOfType("*entity.TransactionSaga")).Return(nil)
+	mockSagaRepo.On("UpdateSaga", mock.AnythingOfType("*entity.TransactionSaga")).Return(nil)
+	mockAccountClient.On("ValidateAndGetAccounts", ctx, accountIDs, "user-1", "req-123").Return(accountsInfo, "", nil)
+	mockAccountClient.On("LockAccounts", ctx, accountIDs, "txn-123", "user-1", "req-123").Return("", nil)
+	mockAccountClient.On("UpdateAccountsBalance",
+		ctx,
+		"txn-123",
+		mock.MatchedBy(func(updates []ports.AccountBalanceUpdate) bool {
+			return len(updates) == 3 &&
+				updates[0].AccountID == "acc-123" && updates[0].NewBalance == money.MustParse("398.50") &&
+				updates[1].AccountID == "acc-456" && updates[1].NewBalance == money.MustParse("300.00") &&
+				updates[2].AccountID == "acc-income" && updates[2].NewBalance == money.MustParse("51.50") &&
+				updates[2].Version == 7
+		}),
+		false,
+		"user-1",
+		"req-123",
+	).Return([]ports.AccountBalanceUpdateResponse{}, "", nil)
+	mockTransactionRepo.On("UpdateTransactionExchange", "txn-123", money.MustParse("100.00"), money.OneRate).Return(nil)
+	mockTransactionRepo.On("UpdateTransactionStatus", "txn-123", mock.Anything, "").Return(nil)
+	mockAccountClient.On("UnlockAccounts", ctx, "txn-123", "user-1", "req-123").Return("", nil)
+
+	err := orchestrator.ExecuteTransactionSync(ctx, transaction, "user-1", "req-123")
+
+	assert.NoError(t, err)
+	mockAccountClient.AssertExpectations(t)
+}
+
+// TestInitTransaction_ExecuteAsync_FeeCharged tests that the rule of the account type wins over the rule for every
+// account type and its fee is recorded on the transaction
+func TestInitTransaction_ExecuteAsync_FeeCharged(t *testing.T) {
+	mockTransactionRepo := new(mocks.MockTransactionRepo)
+	mockAccountClient := new(mocks.MockAccountClient)
+	mockFeeRuleRepo := new(mocks.MockFeeRuleRepo)
+	mockTransactionQueue := new(mocks.MockTransactionQueue)
+
+	initTransaction := NewInitTransaction(
+		mockTransactionRepo,
+		mockAccountClient,
+		new(mocks.MockSagaRepo),
+		new(mocks.MockEventRepo),
+		new(mocks.MockExchangeRateRepo),
+		nil,
+		mockFeeRuleRepo,
+		mockTransactionQueue,
+	)
+	initTransaction.feeAccountID = "acc-income"
+
+	ctx := context.Background()
+	rules := []*entity.FeeRule{
+		{ID: "rule-any", TransactionType: entity.TransactionTypeWithdrawAmount, FeeType: entity.FeeTypePercentage,
+			Percentage: money.MustParsePercent("1"), MinFee: money.MustParse("0.50")},
+		{ID: "rule-savings", TransactionType: entity.TransactionTypeWithdrawAmount, AccountType: entity.AccountTypeSavings,
+			FeeType: entity.FeeTypePercentage, Percentage: money.MustParsePercent("2"), MaxFee: money.MustParse("3.00")},
+		{ID: "rule-savings-large", TransactionType: entity.TransactionTypeWithdrawAmount, AccountType: entity.AccountTypeSavings,
+			MinAmount: money.MustParse("1000.00"), FeeType: entity.FeeTypeFlat, FlatAmount: money.MustParse("10.00")},
+	}
+
+	mockTransactionRepo.On("GetTransactionByReferenceID", "user-1", "ref-123").Return(nil, nil)
+	mockAccountClient.On("ValidateAndGetAccounts", ctx, []string{"acc-123"}, "user-1", "req-123").
+		Return([]ports.AccountInfo{{AccountID: "acc-123", Balance: money.MustParse("500.00"), Currency: entity.CurrencyEUR, AccountType: entity.AccountTypeSavings}}, "", nil)
+	mockFeeRuleRepo.On("GetFeeRules", entity.TransactionTypeWithdrawAmount).Return(rules, nil)
+	mockAccountClient.On("ValidateAndGetAccounts", ctx, []string{"acc-income"}, "user-1", "req-123").
+		Return([]ports.AccountInfo{{AccountID: "acc-income", Balance: money.MustParse("50.00"), Currency: entity.CurrencyEUR}}, "", nil)
+	mockTransactionRepo.On("CreateTransaction", mock.AnythingOfType("*entity.Transaction")).Return(nil)
+	mockTransactionQueue.On("Submit", mock.Anything).Return(nil)
+
+	transaction, _, err := initTransaction.ExecuteAsync(ctx, "acc-123", nil, money.MustParse("250.00"),
+		entity.TransactionTypeWithdrawAmount, "ref-123", "user-1", "req-123")
+
+	// 2% of 250.00 is capped at 3.00 by the savings rule
+	assert.NoError(t, err)
+	assert.Equal(t, money.MustParse("3.00"), transaction.FeeAmount)
+	assert.Equal(t, "rule-savings", *transaction.FeeRuleID)
+	assert.Equal(t, "acc-income", *transaction.FeeAccountID)
+	mockAccountClient.AssertExpectations(t)
+}
+
+// TestInitTransaction_Execute_FeeInsufficientBalance tests that the balance has to cover the amount and the fee
+func TestInitTransaction_Execute_FeeInsufficientBalance(t *testing.T) {
+	mockTransactionRepo := new(mocks.MockTransactionRepo)
+	mockAccountClient := new(mocks.MockAccountClient)
+	mockFeeRuleRepo := new(mocks.MockFeeRuleRepo)
+
+	initTransaction := NewInitTransaction(
+		mockTransactionRepo,
+		mockAccountClient,
+		new(mocks.MockSagaRepo),
+		new(mocks.MockEventRepo),
+		new(mocks.MockExchangeRateRepo),
+		nil,
+		mockFeeRuleRepo,
+		new(mocks.MockTransactionQueue),
+	)
+	initTransaction.feeAccountID = "acc-income"
+
+	ctx := context.Background()
+	rules := []*entity.FeeRule{
+		{ID: "rule-any", TransactionType: entity.TransactionTypeTransfer, FeeType: entity.FeeTypeFlat, FlatAmount: money.MustParse("0.25")},
+	}
+
+	mockTransactionRepo.On("GetTransactionByReferenceID", "user-1", "ref-123").Return(nil, nil)
+	mockAccountClient.On("ValidateAndGetAccounts", ctx, []string{"acc-123", "acc-456"}, "user-1", "req-123").
+		Return([]ports.AccountInfo{
+			{AccountID: "acc-123", Balance: money.MustParse("100.00"), Currency: entity.CurrencyEUR, AccountType: entity.AccountTypeCurrent},
+			{AccountID: "acc-456", Balance: money.MustParse("0.00"), Currency: entity.CurrencyEUR},
+		}, "", nil)
+	mockFeeRuleRepo.On("GetFeeRules", entity.TransactionTypeTransfer).Return(rules, nil)
+	mockAccountClient.On("ValidateAndGetAccounts", ctx, []string{"acc-income"}, "user-1", "req-123").
+		Return([]ports.AccountInfo{{AccountID: "acc-income", Currency: entity.CurrencyEUR}}, "", nil)
+
+	destAccountID := "acc-456"
+	transaction, msg, err := initTransaction.Execute(ctx, "acc-123", &destAccountID, money.MustParse("100.00"),
+		entity.TransactionTypeTransfer, "ref-123", "user-1", "req-123")
+
+	assert.ErrorIs(t, err, custom_err.ErrInsufficientBalance)
+	assert.Nil(t, transaction)
+	assert.Equal(t, "Source account has insufficient balance", msg)
+	mockTransactionRepo.AssertNotCalled(t, "CreateTransaction", mock.Anything)
+}
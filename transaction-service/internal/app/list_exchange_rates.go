@@ -0,0 +1,40 @@
+package app
+
+import (
+	"fmt"
+	"transaction-service/internal/domain/entity"
+	custom_err "transaction-service/internal/domain/error"
+	"transaction-service/internal/logging"
+	"transaction-service/internal/observability/metrics"
+	"transaction-service/internal/ports"
+)
+
+// ListExchangeRates is a use-case for getting the configured exchange rates
+type ListExchangeRates struct {
+	ExchangeRateRepo ports.ExchangeRateRepo
+}
+
+// NewListExchangeRates creates a new ListExchangeRates use-case
+func NewListExchangeRates(exchangeRateRepo ports.ExchangeRateRepo) *ListExchangeRates {
+	return &ListExchangeRates{
+		ExchangeRateRepo: exchangeRateRepo,
+	}
+}
+
+func (l *ListExchangeRates) Execute(requester, requestId string) ([]*entity.ExchangeRate, error) {
+	metrics.IncRequestActive()
+	defer metrics.DecRequestActive()
+
+	var err error
+	defer func() {
+		metrics.RecordOperation("list_exchange_rates", err)
+	}()
+
+	rates, err := l.ExchangeRateRepo.ListExchangeRates()
+	if err != nil {
+		logging.Logger.Error().Err(err).Str("request_id", requestId).Msg("Failed to list exchange rates")
+		err = fmt.Errorf("%w: failed to list exchange rates", custom_err.ErrDatabase)
+		return nil, err
+	}
+	return rates, nil
+}
@@ -0,0 +1,40 @@
+package app
+
+import (
+	"fmt"
+	"transaction-service/internal/domain/entity"
+	custom_err "transaction-service/internal/domain/error"
+	"transaction-service/internal/logging"
+	"transaction-service/internal/observability/metrics"
+	"transaction-service/internal/ports"
+)
+
+// ListFeeRules is a use-case for getting the configured fee rules
+type ListFeeRules struct {
+	FeeRuleRepo ports.FeeRuleRepo
+}
+
+// NewListFeeRules creates a new ListFeeRules use-case
+func NewListFeeRules(feeRuleRepo ports.FeeRuleRepo) *ListFeeRules {
+	return &ListFeeRules{
+		FeeRuleRepo: feeRuleRepo,
+	}
+}
+
+func (l *ListFeeRules) Execute(requester, requestId string) ([]*entity.FeeRule, error) {
+	metrics.IncRequestActive()
+	defer metrics.DecRequestActive()
+
+	var err error
+	defer func() {
+		metrics.RecordOperation("list_fee_rules", err)
+	}()
+
+	rules, err := l.FeeRuleRepo.ListFeeRules()
+	if err != nil {
+		logging.Logger.Error().Err(err).Str("request_id", requestId).Msg("Failed to list fee rules")
+		err = fmt.Errorf("%w: failed to list fee rules", custom_err.ErrDatabase)
+		return nil, err
+	}
+	return rules, nil
+}
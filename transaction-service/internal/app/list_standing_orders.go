@@ -0,0 +1,47 @@
+package app
+
+import (
+	"fmt"
+	"strings"
+	"transaction-service/internal/domain/entity"
+	custom_err "transaction-service/internal/domain/error"
+	"transaction-service/internal/logging"
+	"transaction-service/internal/observability/metrics"
+	"transaction-service/internal/ports"
+)
+
+// ListStandingOrders is a use-case for listing the standing orders debiting an account
+type ListStandingOrders struct {
+	StandingOrderRepo ports.StandingOrderRepo
+}
+
+// NewListStandingOrders creates a new ListStandingOrders use-case
+func NewListStandingOrders(standingOrderRepo ports.StandingOrderRepo) *ListStandingOrders {
+	return &ListStandingOrders{
+		StandingOrderRepo: standingOrderRepo,
+	}
+}
+
+func (l *ListStandingOrders) Execute(accountID, requester, requestId string) ([]*entity.StandingOrder, string, error) {
+	metrics.IncRequestActive()
+	defer metrics.DecRequestActive()
+
+	var err error
+	defer func() {
+		metrics.RecordOperation("list_standing_orders", err)
+	}()
+
+	accountID = strings.TrimSpace(accountID)
+	if accountID == "" {
+		err = fmt.Errorf("%w: account id required", custom_err.ErrValidationFailed)
+		return nil, "Account ID missing", err
+	}
+
+	orders, err := l.StandingOrderRepo.ListStandingOrdersByAccountID(accountID)
+	if err != nil {
+		logging.Logger.Error().Err(err).Str("account_id", accountID).Str("request_id", requestId).Msg("Failed to list standing orders")
+		err = fmt.Errorf("%w: failed to list standing orders", custom_err.ErrDatabase)
+		return nil, "Failed to list standing orders", err
+	}
+	return orders, "Standing orders", nil
+}
@@ -0,0 +1,40 @@
+package app
+
+import (
+	"fmt"
+	"transaction-service/internal/domain/entity"
+	custom_err "transaction-service/internal/domain/error"
+	"transaction-service/internal/logging"
+	"transaction-service/internal/observability/metrics"
+	"transaction-service/internal/ports"
+)
+
+// ListTransactionLimits is a use-case for getting the configured transaction limits
+type ListTransactionLimits struct {
+	LimitRepo ports.TransactionLimitRepo
+}
+
+// NewListTransactionLimits creates a new ListTransactionLimits use-case
+func NewListTransactionLimits(limitRepo ports.TransactionLimitRepo) *ListTransactionLimits {
+	return &ListTransactionLimits{
+		LimitRepo: limitRepo,
+	}
+}
+
+func (l *ListTransactionLimits) Execute(requester, requestId string) ([]*entity.TransactionLimit, error) {
+	metrics.IncRequestActive()
+	defer metrics.DecRequestActive()
+
+	var err error
+	defer func() {
+		metrics.RecordOperation("list_transaction_limits", err)
+	}()
+
+	limits, err := l.LimitRepo.ListTransactionLimits()
+	if err != nil {
+		logging.Logger.Error().Err(err).Str("request_id", requestId).Msg("Failed to list transaction limits")
+		err = fmt.Errorf("%w: failed to list transaction limits", custom_err.ErrDatabase)
+		return nil, err
+	}
+	return limits, nil
+}
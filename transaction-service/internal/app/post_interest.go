@@ -0,0 +1,107 @@
+package app
+
+import (
+	"context"
+	"time"
+	"transaction-service/internal/config"
+	"transaction-service/internal/domain/entity"
+	custom_err "transaction-service/internal/domain/error"
+	"transaction-service/internal/logging"
+	"transaction-service/internal/observability/metrics"
+	"transaction-service/internal/ports"
+)
+
+// PostInterest is a use-case for crediting an interest posting of the account service with a system transaction
+type PostInterest struct {
+	transactionRepo ports.TransactionRepo
+	initTransaction *InitTransaction
+}
+
+// NewPostInterest creates a new PostInterest use-case
+func NewPostInterest(
+	transactionRepo ports.TransactionRepo,
+	accountClient ports.AccountClient,
+	sagaRepo ports.SagaRepo,
+	eventRepo ports.EventRepo,
+	exchangeRateRepo ports.ExchangeRateRepo,
+) *PostInterest {
+
+	return &PostInterest{
+		transactionRepo: transactionRepo,
+		// interest credits always run synchronously and are not limited, so no queue or limit repo is needed
+		initTransaction: NewInitTransaction(transactionRepo, accountClient, sagaRepo, eventRepo, exchangeRateRepo, nil, nil, nil),
+	}
+}
+
+// Execute credits the posting to its account and waits for the credit saga to finish. The account service marks
+// the posting as posted with the balance update, so a posting is never credited twice; a posting whose credit is
+// still in progress is left to it.
+func (p *PostInterest) Execute(ctx context.Context, posting ports.InterestPostingInfo, requestId string) (*entity.Transaction, string, error) {
+	metrics.IncRequestActive()
+	defer metrics.DecRequestActive()
+
+	var err error
+	defer func() {
+		metrics.RecordOperation("post_interest", err)
+	}()
+
+	if !posting.Amount.IsPositive() {
+		err = custom_err.ErrInvalidAmount
+		return nil, "Invalid amount for interest credit", err
+	}
+
+	existingCredit, err := p.transactionRepo.GetInterestCredit(posting.PostingID)
+	if err != nil {
+		logging.Logger.Error().Err(err).Str("interest_posting_id", posting.PostingID).Msg("Failed to check interest credit")
+		err = custom_err.ErrDatabase
+		return nil, "Failed to check interest credit", err
+	}
+	if existingCredit != nil {
+		logging.Logger.Info().
+			Str("interest_posting_id", posting.PostingID).
+			Str("transaction_id", existingCredit.ID).
+			Str("transaction_status", existingCredit.TransactionStatus).
+			Msg("Interest posting already credited")
+		return existingCredit, "Interest already credited", nil
+	}
+
+	credit, err := entity.NewInterestCredit(posting.PostingID, posting.AccountID, posting.Amount)
+	if err != nil {
+		logging.Logger.Error().Err(err).Str("interest_posting_id", posting.PostingID).Msg("Failed to build interest credit")
+		return nil, "Interest cannot be credited", err
+	}
+	if config.Current().Recovery.TransactionTimeout > 0 {
+		credit.TimeoutAt = time.Now().Add(config.Current().Recovery.TransactionTimeout)
+	}
+
+	msg, err := p.initTransaction.validateTransactionConfig(ctx, credit, entity.InterestRequester, requestId)
+	if err != nil {
+		return nil, msg, err
+	}
+	if credit.SourceCurrency != posting.Currency {
+		err = custom_err.ErrCurrencyMismatch
+		logging.Logger.Error().Err(err).
+			Str("interest_posting_id", posting.PostingID).
+			Str("account_currency", credit.SourceCurrency).
+			Str("posting_currency", posting.Currency).
+			Msg("Interest posting currency differs from the account")
+		return nil, "Interest posting currency differs from the account", err
+	}
+
+	if err = p.transactionRepo.CreateTransaction(credit); err != nil {
+		logging.Logger.Error().Err(err).
+			Str("interest_posting_id", posting.PostingID).
+			Str("transaction_id", credit.ID).
+			Msg("Failed to create interest credit")
+		err = custom_err.ErrDatabase
+		return nil, "Failed to create interest credit", err
+	}
+
+	// a failed credit leaves the posting pending, so it is credited again on the next run
+	processedCredit, msg, err := p.initTransaction.processTransaction(ctx, credit, entity.InterestRequester, requestId)
+	if err != nil {
+		return nil, msg, err
+	}
+
+	return processedCredit, "Interest credited successfully", nil
+}
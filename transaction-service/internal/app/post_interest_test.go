@@ -0,0 +1,106 @@
+package app
+
+import (
+	"context"
+	"testing"
+	"transaction-service/internal/domain/entity"
+	custom_err "transaction-service/internal/domain/error"
+	"transaction-service/internal/domain/money"
+	"transaction-service/internal/ports"
+	"transaction-service/internal/ports/mocks"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func newPendingInterestPosting() ports.InterestPostingInfo {
+	return ports.InterestPostingInfo{
+		PostingID: "posting-1",
+		AccountID: "acc-123",
+		Period:    "2026-01",
+		Amount:    money.MustParse("1.25"),
+		Currency:  "USD",
+	}
+}
+
+// TestPostInterest_Execute_Success tests that a posting is credited with an interest credit posting it
+func TestPostInterest_Execute_Success(t *testing.T) {
+	sagaRepo := new(mocks.MockSagaRepo)
+	accountClient := new(mocks.MockAccountClient)
+	transactionRepo := new(mocks.MockTransactionRepo)
+	eventRepo := new(mocks.MockEventRepo)
+	postInterest := NewPostInterest(transactionRepo, accountClient, sagaRepo, eventRepo, new(mocks.MockExchangeRateRepo))
+	ctx := context.Background()
+
+	accountsInfo := []ports.AccountInfo{
+		{AccountID: "acc-123", CustomerID: "cust-123", Balance: money.MustParse("500.00"), Currency: "USD", Version: 4},
+	}
+
+	storedCredit := &entity.Transaction{}
+	transactionRepo.On("GetInterestCredit", "posting-1").Return(nil, nil)
+	accountClient.On("ValidateAndGetAccounts", ctx, []string{"acc-123"}, entity.InterestRequester, "req-1").Return(accountsInfo, "", nil)
+	transactionRepo.On("CreateTransaction", mock.MatchedBy(func(credit *entity.Transaction) bool {
+		return credit.IsInterestCredit() && *credit.InterestPostingID == "posting-1" && credit.CreatedBy == entity.InterestRequester
+	})).Run(func(args mock.Arguments) { *storedCredit = *args.Get(0).(*entity.Transaction) }).Return(nil)
+
+	// credit saga
+	sagaRepo.On("CreateSaga", mock.MatchedBy(func(saga *entity.TransactionSaga) bool {
+		return saga.InterestPostingID != nil && *saga.InterestPostingID == "posting-1"
+	})).Return(nil)
+	sagaRepo.On("UpdateSaga", mock.AnythingOfType("*entity.TransactionSaga")).Return(nil)
+	accountClient.On("LockAccounts", ctx, []string{"acc-123"}, mock.Anything, entity.InterestRequester, "req-1").Return("", nil)
+	accountClient.On("UpdateAccountsBalance", ctx, mock.Anything,
+		mock.MatchedBy(func(updates []ports.AccountBalanceUpdate) bool {
+			return len(updates) == 1 && updates[0].NewBalance == money.MustParse("501.25") && updates[0].InterestPostingID == "posting-1"
+		}),
+		false, entity.InterestRequester, "req-1").Return([]ports.AccountBalanceUpdateResponse{}, "", nil)
+	transactionRepo.On("UpdateTransactionStatus", mock.Anything, entity.TransactionStatusSuccessful, "").Return(nil)
+	accountClient.On("UnlockAccounts", ctx, mock.Anything, entity.InterestRequester, "req-1").Return("", nil)
+	transactionRepo.On("UpdateTransactionStatus", mock.Anything, entity.TransactionStatusCompleted, "").Return(nil)
+	transactionRepo.On("GetTransactionByID", mock.AnythingOfType("string")).Return(storedCredit, nil)
+	eventRepo.On("CreateEvent", mock.AnythingOfType("*entity.Event")).Return(nil)
+
+	credit, message, err := postInterest.Execute(ctx, newPendingInterestPosting(), "req-1")
+
+	assert.NoError(t, err)
+	assert.Equal(t, "Interest credited successfully", message)
+	assert.Equal(t, entity.TransactionTypeInterestCredit, credit.Type)
+	assert.Equal(t, money.MustParse("1.25"), credit.Amount)
+	accountClient.AssertExpectations(t)
+	sagaRepo.AssertExpectations(t)
+}
+
+// TestPostInterest_Execute_AlreadyCredited tests that a posting with a credit in progress is not credited again
+func TestPostInterest_Execute_AlreadyCredited(t *testing.T) {
+	accountClient := new(mocks.MockAccountClient)
+	transactionRepo := new(mocks.MockTransactionRepo)
+	postInterest := NewPostInterest(transactionRepo, accountClient, new(mocks.MockSagaRepo), new(mocks.MockEventRepo), new(mocks.MockExchangeRateRepo))
+
+	existingCredit := &entity.Transaction{ID: "txn-1", Type: entity.TransactionTypeInterestCredit, TransactionStatus: entity.TransactionStatusPending}
+	transactionRepo.On("GetInterestCredit", "posting-1").Return(existingCredit, nil)
+
+	credit, message, err := postInterest.Execute(context.Background(), newPendingInterestPosting(), "req-1")
+
+	assert.NoError(t, err)
+	assert.Equal(t, "Interest already credited", message)
+	assert.Equal(t, existingCredit, credit)
+	transactionRepo.AssertNotCalled(t, "CreateTransaction", mock.Anything)
+	accountClient.AssertNotCalled(t, "ValidateAndGetAccounts", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+// TestPostInterest_Execute_CurrencyMismatch tests that a posting is only credited in the currency of its account
+func TestPostInterest_Execute_CurrencyMismatch(t *testing.T) {
+	accountClient := new(mocks.MockAccountClient)
+	transactionRepo := new(mocks.MockTransactionRepo)
+	postInterest := NewPostInterest(transactionRepo, accountClient, new(mocks.MockSagaRepo), new(mocks.MockEventRepo), new(mocks.MockExchangeRateRepo))
+	ctx := context.Background()
+
+	transactionRepo.On("GetInterestCredit", "posting-1").Return(nil, nil)
+	accountClient.On("ValidateAndGetAccounts", ctx, []string{"acc-123"}, entity.InterestRequester, "req-1").
+		Return([]ports.AccountInfo{{AccountID: "acc-123", Currency: "EUR"}}, "", nil)
+
+	_, _, err := postInterest.Execute(ctx, newPendingInterestPosting(), "req-1")
+
+	assert.ErrorIs(t, err, custom_err.ErrCurrencyMismatch)
+	transactionRepo.AssertNotCalled(t, "CreateTransaction", mock.Anything)
+}
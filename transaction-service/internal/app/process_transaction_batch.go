@@ -0,0 +1,361 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+	"transaction-service/internal/config"
+	"transaction-service/internal/domain/entity"
+	custom_err "transaction-service/internal/domain/error"
+	"transaction-service/internal/domain/money"
+	"transaction-service/internal/logging"
+	"transaction-service/internal/messaging"
+	"transaction-service/internal/observability/metrics"
+	"transaction-service/internal/ports"
+)
+
+// batchTransactionPollInterval is how often a line waits on a transaction that is still being recovered
+const batchTransactionPollInterval = time.Second
+
+// ProcessTransactionBatch runs the lines of a stored batch through the saga with bounded concurrency
+type ProcessTransactionBatch struct {
+	batchRepo          ports.TransactionBatchRepo
+	transactionRepo    ports.TransactionRepo
+	eventRepo          ports.EventRepo
+	initTransaction    *InitTransaction
+	reverseTransaction *ReverseTransaction
+}
+
+// NewProcessTransactionBatch creates a new ProcessTransactionBatch use-case
+func NewProcessTransactionBatch(
+	batchRepo ports.TransactionBatchRepo,
+	transactionRepo ports.TransactionRepo,
+	accountClient ports.AccountClient,
+	sagaRepo ports.SagaRepo,
+	eventRepo ports.EventRepo,
+	exchangeRateRepo ports.ExchangeRateRepo,
+	limitRepo ports.TransactionLimitRepo,
+	feeRuleRepo ports.FeeRuleRepo,
+) *ProcessTransactionBatch {
+
+	return &ProcessTransactionBatch{
+		batchRepo:       batchRepo,
+		transactionRepo: transactionRepo,
+		eventRepo:       eventRepo,
+		// lines run synchronously inside the batch, so no queue is needed
+		initTransaction:    NewInitTransaction(transactionRepo, accountClient, sagaRepo, eventRepo, exchangeRateRepo, limitRepo, feeRuleRepo, nil),
+		reverseTransaction: NewReverseTransaction(transactionRepo, accountClient, sagaRepo, eventRepo, exchangeRateRepo),
+	}
+}
+
+// Execute processes the pending lines of the batch and finishes it. In all-or-nothing mode the first failed
+// line stops the batch, lines not yet run are skipped and successful lines are reversed. If the context is
+// cancelled the batch is left processing and picked up again by Resume.
+func (p *ProcessTransactionBatch) Execute(ctx context.Context, batch *entity.TransactionBatch, requestId string) error {
+	metrics.IncRequestActive()
+	defer metrics.DecRequestActive()
+
+	var err error
+	defer func() {
+		metrics.RecordOperation("process_transaction_batch", err)
+	}()
+
+	if batch.IsFinished() {
+		return nil
+	}
+
+	lines, err := p.batchRepo.GetTransactionBatchLines(batch.ID)
+	if err != nil {
+		logging.Logger.Error().Err(err).Str("batch_id", batch.ID).Msg("Failed to get transaction batch lines")
+		err = custom_err.ErrDatabase
+		return err
+	}
+
+	if batch.Status == entity.TransactionBatchStatusPending {
+		batch.Status = entity.TransactionBatchStatusProcessing
+		batch.UpdatedAt = time.Now()
+		if err = p.batchRepo.UpdateTransactionBatch(batch); err != nil {
+			logging.Logger.Error().Err(err).Str("batch_id", batch.ID).Msg("Failed to update transaction batch status")
+			err = custom_err.ErrDatabase
+			return err
+		}
+	}
+
+	p.runLines(WithRequesterRole(ctx, batch.CreatedByRole), batch, lines, requestId)
+	if ctx.Err() != nil {
+		err = ctx.Err()
+		logging.Logger.Warn().Err(err).Str("batch_id", batch.ID).Msg("Transaction batch interrupted")
+		return err
+	}
+
+	var errorReason string
+	if batch.Mode == entity.TransactionBatchModeAllOrNothing {
+		errorReason = p.rollback(ctx, batch, lines, requestId)
+		if ctx.Err() != nil {
+			err = ctx.Err()
+			logging.Logger.Warn().Err(err).Str("batch_id", batch.ID).Msg("Transaction batch rollback interrupted")
+			return err
+		}
+	}
+
+	batch.Finish(lines, errorReason)
+	if err = p.batchRepo.UpdateTransactionBatch(batch); err != nil {
+		logging.Logger.Error().Err(err).Str("batch_id", batch.ID).Msg("Failed to finish transaction batch")
+		err = custom_err.ErrDatabase
+		return err
+	}
+
+	logging.Logger.Info().
+		Str("batch_id", batch.ID).
+		Str("batch_status", batch.Status).
+		Int("successful_count", batch.SuccessfulCount).
+		Int("failed_count", batch.FailedCount).
+		Msg("Transaction batch finished")
+
+	eventData := map[string]interface{}{
+		"batch_id":         batch.ID,
+		"status":           batch.Status,
+		"successful_count": batch.SuccessfulCount,
+		"failed_count":     batch.FailedCount,
+		"created_by":       batch.CreatedBy,
+		"request_id":       requestId,
+	}
+
+	event, eventErr := entity.NewEvent(entity.EventTypeBatchFinished, batch.ID, entity.EventAggregateTypeBatch, batch.CreatedBy, eventData)
+	if eventErr == nil {
+		if createErr := p.eventRepo.CreateEvent(event); createErr != nil {
+			logging.Logger.Error().Err(createErr).Str("event_type", event.Type).Msg("Failed to create event")
+		}
+		_ = messaging.GetService().PublishToDefaultTopic(messaging.Message{Content: batch.ToString(), Status: true, Type: messaging.MessageTypeBatchFinished})
+	}
+	return nil
+}
+
+// Resume hands every unfinished batch to the queue, e.g. batches interrupted by a restart
+func (p *ProcessTransactionBatch) Resume(queue ports.TransactionQueue) error {
+	batches, err := p.batchRepo.GetUnfinishedTransactionBatches()
+	if err != nil {
+		logging.Logger.Error().Err(err).Msg("Failed to get unfinished transaction batches")
+		return custom_err.ErrDatabase
+	}
+
+	for _, batch := range batches {
+		batch := batch
+		if err = queue.Submit(func(ctx context.Context) {
+			_ = p.Execute(ctx, batch, "")
+		}); err != nil {
+			logging.Logger.Error().Err(err).Str("batch_id", batch.ID).Msg("Failed to queue unfinished transaction batch")
+			return err
+		}
+		logging.Logger.Info().Str("batch_id", batch.ID).Msg("Resuming transaction batch")
+	}
+	return nil
+}
+
+// runLines runs the pending lines, at most the configured number at a time. In all-or-nothing mode no new
+// line is started once one has failed, including lines that failed before a restart.
+func (p *ProcessTransactionBatch) runLines(ctx context.Context, batch *entity.TransactionBatch, lines []*entity.TransactionBatchLine, requestId string) {
+	stopOnFailure := batch.Mode == entity.TransactionBatchModeAllOrNothing
+
+	var mu sync.Mutex
+	failed := false
+	for _, line := range lines {
+		if line.Status == entity.TransactionBatchLineStatusFailed {
+			failed = true
+		}
+	}
+
+	concurrency := config.Current().Batch.Concurrency
+	if concurrency < 1 {
+		concurrency = 1
+	}
+	sem := make(chan struct{}, concurrency)
+	var wg sync.WaitGroup
+
+	for _, line := range lines {
+		if line.Status != entity.TransactionBatchLineStatusPending {
+			continue
+		}
+
+		select {
+		case <-ctx.Done():
+		case sem <- struct{}{}:
+		}
+		if ctx.Err() != nil {
+			break
+		}
+
+		mu.Lock()
+		stop := stopOnFailure && failed
+		mu.Unlock()
+		if stop {
+			<-sem
+			break
+		}
+
+		wg.Add(1)
+		go func(line *entity.TransactionBatchLine) {
+			defer wg.Done()
+			defer func() { <-sem }()
+
+			if ok := p.runLine(ctx, batch, line, requestId); !ok {
+				mu.Lock()
+				failed = true
+				mu.Unlock()
+			}
+		}(line)
+	}
+	wg.Wait()
+}
+
+// runLine initiates the transfer of the line and records its outcome. The line reference makes a line
+// that ran before a restart return its existing transaction instead of moving money again.
+func (p *ProcessTransactionBatch) runLine(ctx context.Context, batch *entity.TransactionBatch, line *entity.TransactionBatchLine, requestId string) bool {
+	referenceID := batch.LineReference(line.LineNumber)
+	destinationAccountID := line.DestinationAccountID
+
+	transaction, msg, err := p.initTransaction.Execute(
+		ctx,
+		batch.SourceAccountID,
+		&destinationAccountID,
+		line.Amount,
+		entity.TransactionTypeTransfer,
+		referenceID,
+		batch.CreatedBy,
+		requestId,
+	)
+	if err == nil && transaction != nil && transaction.TransactionStatus != entity.TransactionStatusCompleted {
+		transaction, err = p.awaitTransaction(ctx, transaction.ID)
+		if err != nil {
+			msg = "Transaction failed: " + err.Error()
+		}
+	}
+	if ctx.Err() != nil {
+		// the line is run again when the batch resumes
+		return true
+	}
+
+	if err != nil {
+		var transactionID *string
+		if failedTransaction, getErr := p.transactionRepo.GetTransactionByReferenceID(batch.CreatedBy, referenceID); getErr == nil && failedTransaction != nil {
+			transactionID = &failedTransaction.ID
+		}
+		line.SetOutcome(entity.TransactionBatchLineStatusFailed, transactionID, msg)
+		logging.Logger.Warn().Err(err).
+			Str("batch_id", batch.ID).
+			Int("line_number", line.LineNumber).
+			Str("message", msg).
+			Msg("Transaction batch line failed")
+	} else {
+		line.SetOutcome(entity.TransactionBatchLineStatusSuccessful, &transaction.ID, "")
+	}
+
+	if updateErr := p.batchRepo.UpdateTransactionBatchLine(line); updateErr != nil {
+		logging.Logger.Error().Err(updateErr).Str("batch_id", batch.ID).Int("line_number", line.LineNumber).Msg("Failed to update transaction batch line")
+	}
+	return err == nil
+}
+
+// awaitTransaction waits for a transaction initiated before a restart, which the recovery job finishes
+func (p *ProcessTransactionBatch) awaitTransaction(ctx context.Context, transactionID string) (*entity.Transaction, error) {
+	ticker := time.NewTicker(batchTransactionPollInterval)
+	defer ticker.Stop()
+
+	for {
+		transaction, err := p.transactionRepo.GetTransactionByID(transactionID)
+		if err != nil {
+			return nil, fmt.Errorf("%w: failed to get transaction", custom_err.ErrDatabase)
+		}
+		if transaction == nil {
+			return nil, custom_err.ErrTransactionNotFound
+		}
+		switch transaction.TransactionStatus {
+		case entity.TransactionStatusCompleted:
+			return transaction, nil
+		case entity.TransactionStatusFailed:
+			return nil, custom_err.ErrTransactionFailed
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// rollback skips the lines that did not run and reverses the successful ones if any line failed. It returns
+// the error reason of the batch.
+func (p *ProcessTransactionBatch) rollback(ctx context.Context, batch *entity.TransactionBatch, lines []*entity.TransactionBatchLine, requestId string) string {
+	failedLines := 0
+	for _, line := range lines {
+		if line.Status == entity.TransactionBatchLineStatusFailed {
+			failedLines++
+		}
+	}
+	if failedLines == 0 {
+		return ""
+	}
+
+	reason := fmt.Sprintf("transaction batch %s failed", batch.ID)
+	rollbackFailures := 0
+	for _, line := range lines {
+		switch line.Status {
+		case entity.TransactionBatchLineStatusPending:
+			line.SetOutcome(entity.TransactionBatchLineStatusSkipped, nil, "Skipped because another line failed")
+		case entity.TransactionBatchLineStatusSuccessful:
+			if ctx.Err() != nil {
+				return ""
+			}
+			if !p.reverseLine(ctx, batch, line, reason, requestId) {
+				rollbackFailures++
+			}
+			continue
+		default:
+			continue
+		}
+
+		if err := p.batchRepo.UpdateTransactionBatchLine(line); err != nil {
+			logging.Logger.Error().Err(err).Str("batch_id", batch.ID).Int("line_number", line.LineNumber).Msg("Failed to update transaction batch line")
+		}
+	}
+
+	if rollbackFailures > 0 {
+		return fmt.Sprintf("%d line(s) failed and %d successful line(s) could not be reversed", failedLines, rollbackFailures)
+	}
+	return fmt.Sprintf("%d line(s) failed, successful lines were reversed", failedLines)
+}
+
+// reverseLine reverses the transaction of a successful line. A line whose transaction was already reversed,
+// e.g. before a restart, only records the existing reversal.
+func (p *ProcessTransactionBatch) reverseLine(ctx context.Context, batch *entity.TransactionBatch, line *entity.TransactionBatchLine, reason, requestId string) bool {
+	reversal, msg, err := p.reverseTransaction.Execute(ctx, *line.TransactionID, money.Zero, reason, batch.CreatedBy, requestId)
+	if errors.Is(err, custom_err.ErrTransactionAlreadyReversed) {
+		original, getErr := p.transactionRepo.GetTransactionByID(*line.TransactionID)
+		if getErr == nil && original != nil && original.ReversedByTransactionID != nil {
+			reversal, err = p.awaitTransaction(ctx, *original.ReversedByTransactionID)
+			if err != nil {
+				msg = "Reversal failed: " + err.Error()
+			}
+		}
+	}
+
+	if err != nil || reversal == nil {
+		logging.Logger.Error().Err(err).
+			Str("batch_id", batch.ID).
+			Int("line_number", line.LineNumber).
+			Str("message", msg).
+			Msg("Failed to reverse transaction batch line")
+		line.ErrorReason = "Reversal failed: " + msg
+	} else {
+		line.ReversalTransactionID = &reversal.ID
+		line.SetOutcome(entity.TransactionBatchLineStatusReversed, nil, "Reversed because another line failed")
+	}
+
+	if updateErr := p.batchRepo.UpdateTransactionBatchLine(line); updateErr != nil {
+		logging.Logger.Error().Err(updateErr).Str("batch_id", batch.ID).Int("line_number", line.LineNumber).Msg("Failed to update transaction batch line")
+	}
+	return err == nil && reversal != nil
+}
@@ -0,0 +1,93 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"transaction-service/internal/domain/entity"
+	"transaction-service/internal/domain/money"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func newTestProcessTransactionBatch(m transactionBatchMocks) *ProcessTransactionBatch {
+	return NewProcessTransactionBatch(m.batchRepo, m.transactionRepo, m.accountClient, m.sagaRepo, m.eventRepo, m.exchangeRateRepo, nil, nil)
+}
+
+// TestProcessTransactionBatch_Execute_BestEffortFailedLines tests that failed lines are recorded and a batch
+// without a successful line fails
+func TestProcessTransactionBatch_Execute_BestEffortFailedLines(t *testing.T) {
+	m := newTransactionBatchMocks()
+	processor := newTestProcessTransactionBatch(m)
+	ctx := context.Background()
+
+	batch := entity.NewTransactionBatch("acc-123", entity.TransactionBatchModeBestEffort, "payroll-2026-10", "admin")
+	lines := []*entity.TransactionBatchLine{
+		batch.AddLine("acc-456", money.MustParse("100.00"), "emp-1"),
+		batch.AddLine("acc-789", money.MustParse("150.00"), "emp-2"),
+	}
+
+	m.batchRepo.On("GetTransactionBatchLines", batch.ID).Return(lines, nil)
+	m.batchRepo.On("UpdateTransactionBatch", batch).Return(nil)
+	m.batchRepo.On("UpdateTransactionBatchLine", mock.AnythingOfType("*entity.TransactionBatchLine")).Return(nil)
+	m.transactionRepo.On("GetTransactionByReferenceID", "admin", mock.AnythingOfType("string")).Return(nil, nil)
+	m.accountClient.On("ValidateAndGetAccounts", ctx, mock.Anything, "admin", "req-1").Return(nil, "account is frozen", errors.New("account validation failed"))
+	m.eventRepo.On("CreateEvent", mock.AnythingOfType("*entity.Event")).Return(nil)
+
+	err := processor.Execute(ctx, batch, "req-1")
+
+	assert.NoError(t, err)
+	assert.Equal(t, entity.TransactionBatchStatusFailed, batch.Status)
+	assert.Equal(t, 0, batch.SuccessfulCount)
+	assert.Equal(t, 2, batch.FailedCount)
+	assert.NotNil(t, batch.CompletedAt)
+	for _, line := range lines {
+		assert.Equal(t, entity.TransactionBatchLineStatusFailed, line.Status)
+		assert.Equal(t, "Failed to validate accounts", line.ErrorReason)
+	}
+	m.transactionRepo.AssertNotCalled(t, "CreateTransaction", mock.Anything)
+}
+
+// TestProcessTransactionBatch_Execute_AllOrNothingResumedAfterFailure tests that a resumed all-or-nothing batch
+// with a failed line skips the lines that did not run
+func TestProcessTransactionBatch_Execute_AllOrNothingResumedAfterFailure(t *testing.T) {
+	m := newTransactionBatchMocks()
+	processor := newTestProcessTransactionBatch(m)
+
+	batch := entity.NewTransactionBatch("acc-123", entity.TransactionBatchModeAllOrNothing, "payroll-2026-10", "admin")
+	batch.Status = entity.TransactionBatchStatusProcessing
+	lines := []*entity.TransactionBatchLine{
+		batch.AddLine("acc-456", money.MustParse("100.00"), "emp-1"),
+		batch.AddLine("acc-789", money.MustParse("150.00"), "emp-2"),
+	}
+	lines[0].SetOutcome(entity.TransactionBatchLineStatusFailed, stringPtr("txn-1"), "Source account has insufficient balance")
+
+	m.batchRepo.On("GetTransactionBatchLines", batch.ID).Return(lines, nil)
+	m.batchRepo.On("UpdateTransactionBatch", batch).Return(nil)
+	m.batchRepo.On("UpdateTransactionBatchLine", lines[1]).Return(nil)
+	m.eventRepo.On("CreateEvent", mock.AnythingOfType("*entity.Event")).Return(nil)
+
+	err := processor.Execute(context.Background(), batch, "")
+
+	assert.NoError(t, err)
+	assert.Equal(t, entity.TransactionBatchStatusFailed, batch.Status)
+	assert.Equal(t, "1 line(s) failed, successful lines were reversed", batch.ErrorReason)
+	assert.Equal(t, entity.TransactionBatchLineStatusSkipped, lines[1].Status)
+	m.accountClient.AssertNotCalled(t, "ValidateAndGetAccounts", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	m.batchRepo.AssertExpectations(t)
+}
+
+// TestProcessTransactionBatch_Execute_Finished tests that a finished batch is not processed again
+func TestProcessTransactionBatch_Execute_Finished(t *testing.T) {
+	m := newTransactionBatchMocks()
+	processor := newTestProcessTransactionBatch(m)
+
+	batch := entity.NewTransactionBatch("acc-123", entity.TransactionBatchModeBestEffort, "payroll-2026-10", "admin")
+	batch.Status = entity.TransactionBatchStatusCompleted
+
+	err := processor.Execute(context.Background(), batch, "")
+
+	assert.NoError(t, err)
+	m.batchRepo.AssertNotCalled(t, "GetTransactionBatchLines", mock.Anything)
+}
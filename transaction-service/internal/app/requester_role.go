@@ -0,0 +1,27 @@
+package app
+
+import (
+	"context"
+	"strings"
+)
+
+// RequesterRoleAdmin is the role of employees who may read every transaction
+const RequesterRoleAdmin = "admin"
+
+type requesterRoleKey struct{}
+
+// WithRequesterRole returns a context carrying the role of the employee a transaction is initiated for,
+// used to apply the transaction limits of the role. An empty role leaves the context unchanged.
+func WithRequesterRole(ctx context.Context, role string) context.Context {
+	role = strings.ToLower(strings.TrimSpace(role))
+	if role == "" {
+		return ctx
+	}
+	return context.WithValue(ctx, requesterRoleKey{}, role)
+}
+
+// RequesterRole returns the role set with WithRequesterRole, or an empty string
+func RequesterRole(ctx context.Context) string {
+	role, _ := ctx.Value(requesterRoleKey{}).(string)
+	return role
+}
@@ -0,0 +1,228 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+	"transaction-service/internal/config"
+	"transaction-service/internal/domain/entity"
+	custom_err "transaction-service/internal/domain/error"
+	"transaction-service/internal/domain/money"
+	"transaction-service/internal/logging"
+	"transaction-service/internal/messaging"
+	"transaction-service/internal/observability/metrics"
+	"transaction-service/internal/ports"
+)
+
+// ReverseTransaction is a use-case for moving the money of a completed transaction back through a linked reversal
+type ReverseTransaction struct {
+	transactionRepo ports.TransactionRepo
+	accountClient   ports.AccountClient
+	eventRepo       ports.EventRepo
+	initTransaction *InitTransaction
+}
+
+// NewReverseTransaction creates a new ReverseTransaction use-case
+func NewReverseTransaction(
+	transactionRepo ports.TransactionRepo,
+	accountClient ports.AccountClient,
+	sagaRepo ports.SagaRepo,
+	eventRepo ports.EventRepo,
+	exchangeRateRepo ports.ExchangeRateRepo,
+) *ReverseTransaction {
+
+	return &ReverseTransaction{
+		transactionRepo: transactionRepo,
+		accountClient:   accountClient,
+		eventRepo:       eventRepo,
+		// reversals always run synchronously, so no queue is needed
+		initTransaction: NewInitTransaction(transactionRepo, accountClient, sagaRepo, eventRepo, exchangeRateRepo, nil, nil, nil),
+	}
+}
+
+// Execute reverses the transaction by the amount, or by the rest not yet reversed if the amount is zero, and
+// waits for the reversal saga to finish. The amount is in the currency of the account the reversal debits, for a
+// cross-currency transfer that is the destination account. A transaction can be reversed partially until its
+// reversals have moved back the full amount.
+func (r *ReverseTransaction) Execute(
+	ctx context.Context,
+	transactionID string,
+	amount money.Amount,
+	reason,
+	requester,
+	requestId string,
+) (*entity.Transaction, string, error) {
+	metrics.IncRequestActive()
+	defer metrics.DecRequestActive()
+
+	var err error
+	defer func() {
+		metrics.RecordOperation("reverse_transaction", err)
+	}()
+
+	if strings.TrimSpace(requester) == "" {
+		err = custom_err.ErrMissingRequester
+		logging.Logger.Error().Err(err).Msg("Unknown requester")
+		return nil, "Unknown requester", err
+	}
+
+	transactionID = strings.TrimSpace(transactionID)
+	if transactionID == "" {
+		err = fmt.Errorf("%w: transaction id required", custom_err.ErrValidationFailed)
+		return nil, "Transaction ID missing", err
+	}
+
+	reason = strings.TrimSpace(reason)
+	if reason == "" {
+		err = fmt.Errorf("%w: reversal reason required", custom_err.ErrValidationFailed)
+		return nil, "Reversal reason required", err
+	}
+
+	if amount.IsNegative() {
+		err = custom_err.ErrInvalidAmount
+		return nil, "Invalid amount for reversal", err
+	}
+
+	original, err := r.transactionRepo.GetTransactionByID(transactionID)
+	if err != nil {
+		logging.Logger.Error().Err(err).Str("transaction_id", transactionID).Str("request_id", requestId).Msg("Failed to get transaction")
+		err = fmt.Errorf("%w: failed to get transaction", custom_err.ErrDatabase)
+		return nil, "Failed to get transaction", err
+	}
+
+	if original == nil {
+		err = custom_err.ErrTransactionNotFound
+		return nil, "Transaction not found", err
+	}
+
+	msg, err := r.validateReversible(original)
+	if err != nil {
+		logging.Logger.Warn().Err(err).
+			Str("transaction_id", original.ID).
+			Str("transaction_status", original.TransactionStatus).
+			Msg("Transaction cannot be reversed")
+		return nil, msg, err
+	}
+
+	reversibleAmount, msg, err := r.reversibleAmount(ctx, original, requester, requestId)
+	if err != nil {
+		return nil, msg, err
+	}
+
+	remainingAmount := reversibleAmount.Sub(original.ReversedAmount)
+	if !remainingAmount.IsPositive() {
+		err = custom_err.ErrTransactionAlreadyReversed
+		return nil, "Transaction already reversed", err
+	}
+
+	if amount.IsZero() {
+		amount = remainingAmount
+	}
+	if amount > remainingAmount {
+		err = custom_err.ErrInvalidAmount
+		logging.Logger.Warn().Err(err).
+			Str("transaction_id", original.ID).
+			Str("amount", amount.String()).
+			Str("reversed_amount", original.ReversedAmount.String()).
+			Str("remaining_amount", remainingAmount.String()).
+			Msg("Reversal amount exceeds the amount not yet reversed")
+		return nil, fmt.Sprintf("Reversal amount exceeds the amount not yet reversed of %s", remainingAmount), err
+	}
+
+	reversal, err := original.NewReversal(amount, reason, requester)
+	if errors.Is(err, custom_err.ErrInvalidAmount) {
+		return nil, "Reversal amount is too small to convert", err
+	}
+	if err != nil {
+		logging.Logger.Error().Err(err).Str("transaction_id", original.ID).Str("transaction_type", original.Type).Msg("Failed to build reversal")
+		return nil, "Transaction cannot be reversed", err
+	}
+	if config.Current().Recovery.TransactionTimeout > 0 {
+		reversal.TimeoutAt = time.Now().Add(config.Current().Recovery.TransactionTimeout)
+	}
+
+	// accounts, currencies and the balance the reversal debits are checked as for any new transaction
+	msg, err = r.initTransaction.validateTransactionConfig(ctx, reversal, requester, requestId)
+	if err != nil {
+		return nil, msg, err
+	}
+
+	err = r.transactionRepo.CreateReversal(original, reversal)
+	if errors.Is(err, custom_err.ErrConcurrentModification) {
+		return nil, "Transaction was reversed concurrently, please retry", err
+	}
+	if err != nil {
+		logging.Logger.Error().Err(err).
+			Str("transaction_id", original.ID).
+			Str("reversal_transaction_id", reversal.ID).
+			Msg("Failed to create reversal")
+		err = custom_err.ErrDatabase
+		return nil, "Failed to create reversal", err
+	}
+
+	// a failed reversal is marked failed by the saga, which releases its amount on the original for another attempt
+	processedReversal, msg, err := r.initTransaction.processTransaction(ctx, reversal, requester, requestId)
+	if err != nil {
+		return nil, msg, err
+	}
+
+	eventData := map[string]interface{}{
+		"transaction_id":          original.ID,
+		"reversal_transaction_id": processedReversal.ID,
+		"amount":                  processedReversal.Amount.String(),
+		"reason":                  reason,
+		"created_by":              requester,
+		"request_id":              requestId,
+	}
+
+	event, eventErr := entity.NewEvent(entity.EventTypeTransactionReversed, original.ID, entity.EventAggregateTypeTransaction, requester, eventData)
+	if eventErr == nil {
+		if createErr := r.eventRepo.CreateEvent(event); createErr != nil {
+			logging.Logger.Error().Err(createErr).Str("transaction_id", original.ID).
+				Str("event_type", event.Type).Msg("Failed to create event")
+		}
+		_ = messaging.GetService().PublishToDefaultTopic(messaging.Message{Content: event.ToString(), Status: true, Type: messaging.MessageTypeTransactionReversed})
+	}
+
+	return processedReversal, "Transaction reversed successfully", nil
+}
+
+func (r *ReverseTransaction) validateReversible(original *entity.Transaction) (string, error) {
+	if original.IsReversal() {
+		return "A reversal cannot be reversed", custom_err.ErrTransactionNotReversible
+	}
+	if original.TransactionStatus != entity.TransactionStatusCompleted {
+		return "Only completed transactions can be reversed", custom_err.ErrTransactionNotReversible
+	}
+	return "", nil
+}
+
+// reversibleAmount reads the amount of a full withdrawal from the account journal, which also knows it for full
+// withdrawals stored before their amount was recorded
+func (r *ReverseTransaction) reversibleAmount(ctx context.Context, original *entity.Transaction, requester, requestId string) (money.Amount, string, error) {
+	if original.Type != entity.TransactionTypeWithdrawFull {
+		return original.ReversibleAmount(), "", nil
+	}
+
+	journalStatus, message, err := r.accountClient.GetTransactionJournalStatus(ctx, original.ID, requester, requestId)
+	if err != nil || journalStatus == nil {
+		logging.Logger.Error().Err(err).
+			Str("transaction_id", original.ID).
+			Str("message", message).
+			Msg("Failed to get transaction journal status")
+		return money.Zero, "Failed to get withdrawn amount", custom_err.ErrJournalStatusUnavailable
+	}
+
+	withdrawn := money.Zero
+	for _, change := range journalStatus.Changes {
+		if change.AccountID == original.SourceAccountID {
+			withdrawn = withdrawn.Sub(change.Delta)
+		}
+	}
+	if !withdrawn.IsPositive() {
+		return money.Zero, "Withdrawal moved no money to reverse", custom_err.ErrTransactionNotReversible
+	}
+	return withdrawn, "", nil
+}
@@ -0,0 +1,412 @@
+package app
+
+import (
+	"context"
+	"testing"
+	"transaction-service/internal/domain/entity"
+	custom_err "transaction-service/internal/domain/error"
+	"transaction-service/internal/domain/money"
+	"transaction-service/internal/ports"
+	"transaction-service/internal/ports/mocks"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type reverseTransactionMocks struct {
+	sagaRepo        *mocks.MockSagaRepo
+	accountClient   *mocks.MockAccountClient
+	transactionRepo *mocks.MockTransactionRepo
+	eventRepo       *mocks.MockEventRepo
+}
+
+func newTestReverseTransaction() (*ReverseTransaction, reverseTransactionMocks) {
+	m := reverseTransactionMocks{
+		sagaRepo:        new(mocks.MockSagaRepo),
+		accountClient:   new(mocks.MockAccountClient),
+		transactionRepo: new(mocks.MockTransactionRepo),
+		eventRepo:       new(mocks.MockEventRepo),
+	}
+	reverseTransaction := NewReverseTransaction(m.transactionRepo, m.accountClient, m.sagaRepo, m.eventRepo, new(mocks.MockExchangeRateRepo))
+	return reverseTransaction, m
+}
+
+func newCompletedTransfer() *entity.Transaction {
+	return &entity.Transaction{
+		ID:                   "txn-1",
+		SourceAccountID:      "acc-123",
+		DestinationAccountID: stringPtr("acc-456"),
+		Amount:               money.MustParse("100.00"),
+		Type:                 entity.TransactionTypeTransfer,
+		TransactionStatus:    entity.TransactionStatusCompleted,
+		ReferenceID:          "ref-1",
+	}
+}
+
+// TestReverseTransaction_Execute_PartialTransferReversal tests that a partial reversal sends the amount back from the destination
+func TestReverseTransaction_Execute_PartialTransferReversal(t *testing.T) {
+	reverseTransaction, m := newTestReverseTransaction()
+	ctx := context.Background()
+	original := newCompletedTransfer()
+
+	accountsInfo := []ports.AccountInfo{
+		{AccountID: "acc-456", CustomerID: "cust-456", Balance: money.MustParse("150.00"), Version: 2},
+		{AccountID: "acc-123", CustomerID: "cust-123", Balance: money.MustParse("400.00"), Version: 2},
+	}
+
+	storedReversal := &entity.Transaction{}
+	m.transactionRepo.On("GetTransactionByID", "txn-1").Return(original, nil)
+	m.accountClient.On("ValidateAndGetAccounts", ctx, []string{"acc-456", "acc-123"}, "admin", "req-1").Return(accountsInfo, "", nil)
+	m.transactionRepo.On("CreateReversal", original, mock.AnythingOfType("*entity.Transaction")).
+		Run(func(args mock.Arguments) { *storedReversal = *args.Get(1).(*entity.Transaction) }).
+		Return(nil)
+
+	// reversal saga
+	m.sagaRepo.On("CreateSaga", mock.AnythingOfType("*entity.TransactionSaga")).Return(nil)
+	m.sagaRepo.On("UpdateSaga", mock.AnythingOfType("*entity.TransactionSaga")).Return(nil)
+	m.accountClient.On("LockAccounts", ctx, []string{"acc-456", "acc-123"}, mock.Anything, "admin", "req-1").Return("", nil)
+	m.accountClient.On("UpdateAccountsBalance", ctx, mock.Anything,
+		mock.MatchedBy(func(updates []ports.AccountBalanceUpdate) bool {
+			return len(updates) == 2 &&
+				updates[0].AccountID == "acc-456" && updates[0].NewBalance == money.MustParse("110.00") &&
+				updates[1].AccountID == "acc-123" && updates[1].NewBalance == money.MustParse("440.00")
+		}),
+		false, "admin", "req-1").Return([]ports.AccountBalanceUpdateResponse{}, "", nil)
+	m.transactionRepo.On("UpdateTransactionExchange", mock.Anything, money.MustParse("40.00"), money.OneRate).Return(nil)
+	m.transactionRepo.On("UpdateTransactionStatus", mock.Anything, entity.TransactionStatusSuccessful, "").Return(nil)
+	m.accountClient.On("UnlockAccounts", ctx, mock.Anything, "admin", "req-1").Return("", nil)
+	m.transactionRepo.On("UpdateTransactionStatus", mock.Anything, entity.TransactionStatusCompleted, "").Return(nil)
+	m.transactionRepo.On("GetTransactionByID", mock.AnythingOfType("string")).Return(storedReversal, nil)
+	m.eventRepo.On("CreateEvent", mock.AnythingOfType("*entity.Event")).Return(nil)
+
+	reversal, msg, err := reverseTransaction.Execute(ctx, "txn-1", money.MustParse("40.00"), " wrong beneficiary ", "admin", "req-1")
+
+	assert.NoError(t, err)
+	assert.Equal(t, "Transaction reversed successfully", msg)
+	assert.Equal(t, "txn-1", *reversal.ReversalOfTransactionID)
+	assert.Equal(t, "acc-456", reversal.SourceAccountID)
+	assert.Equal(t, "acc-123", *reversal.DestinationAccountID)
+	assert.Equal(t, money.MustParse("40.00"), reversal.Amount)
+	assert.Equal(t, entity.TransactionTypeTransfer, reversal.Type)
+	assert.Equal(t, "wrong beneficiary", reversal.ReversalReason)
+	m.accountClient.AssertExpectations(t)
+	m.transactionRepo.AssertExpectations(t)
+}
+
+// TestReverseTransaction_Execute_FullWithdrawalUsesJournal tests that a full withdrawal is reversed by the amount in the account journal
+func TestReverseTransaction_Execute_FullWithdrawalUsesJournal(t *testing.T) {
+	reverseTransaction, m := newTestReverseTransaction()
+	ctx := context.Background()
+	original := &entity.Transaction{
+		ID:                "txn-2",
+		SourceAccountID:   "acc-123",
+		Amount:            money.Zero,
+		Type:              entity.TransactionTypeWithdrawFull,
+		TransactionStatus: entity.TransactionStatusCompleted,
+	}
+
+	m.transactionRepo.On("GetTransactionByID", "txn-2").Return(original, nil)
+	m.accountClient.On("GetTransactionJournalStatus", ctx, "txn-2", "admin", "req-1").Return(&ports.TransactionJournalStatus{
+		BalanceApplied: true,
+		Changes:        []ports.AccountBalanceChange{{AccountID: "acc-123", Delta: money.MustParse("-75.50")}},
+	}, "", nil)
+	m.accountClient.On("ValidateAndGetAccounts", ctx, []string{"acc-123"}, "admin", "req-1").
+		Return([]ports.AccountInfo{{AccountID: "acc-123", CustomerID: "cust-123", Balance: money.Zero, Version: 3}}, "", nil)
+	// another reversal linked the original first
+	m.transactionRepo.On("CreateReversal", original, mock.MatchedBy(func(reversal *entity.Transaction) bool {
+		return reversal.Type == entity.TransactionTypeAddAmount && reversal.Amount == money.MustParse("75.50")
+	})).Return(custom_err.ErrConcurrentModification)
+
+	reversal, msg, err := reverseTransaction.Execute(ctx, "txn-2", money.Zero, "cash not dispensed", "admin", "req-1")
+
+	assert.ErrorIs(t, err, custom_err.ErrConcurrentModification)
+	assert.Nil(t, reversal)
+	assert.Equal(t, "Transaction was reversed concurrently, please retry", msg)
+	m.transactionRepo.AssertExpectations(t)
+	m.sagaRepo.AssertNotCalled(t, "CreateSaga", mock.Anything)
+}
+
+// TestReverseTransaction_Execute_CrossCurrencyUsesOriginalRate tests that a cross-currency transfer is reversed at the
+// rate of the original, crediting the source exactly what was debited without looking up a rate
+func TestReverseTransaction_Execute_CrossCurrencyUsesOriginalRate(t *testing.T) {
+	reverseTransaction, m := newTestReverseTransaction()
+	ctx := context.Background()
+	original := newCompletedTransfer()
+	original.SourceCurrency = "USD"
+	original.DestinationCurrency = stringPtr("EUR")
+	original.DestinationAmount = money.MustParse("92.12")
+	original.ExchangeRate = money.MustParseRate("0.921234")
+
+	accountsInfo := []ports.AccountInfo{
+		{AccountID: "acc-456", CustomerID: "cust-456", Currency: "EUR", Balance: money.MustParse("150.00"), Version: 2},
+		{AccountID: "acc-123", CustomerID: "cust-123", Currency: "USD", Balance: money.MustParse("400.00"), Version: 2},
+	}
+
+	storedReversal := &entity.Transaction{}
+	m.transactionRepo.On("GetTransactionByID", "txn-1").Return(original, nil)
+	m.accountClient.On("ValidateAndGetAccounts", ctx, []string{"acc-456", "acc-123"}, "admin", "req-1").Return(accountsInfo, "", nil)
+	m.transactionRepo.On("CreateReversal", original, mock.AnythingOfType("*entity.Transaction")).
+		Run(func(args mock.Arguments) { *storedReversal = *args.Get(1).(*entity.Transaction) }).
+		Return(nil)
+
+	// reversal saga
+	m.sagaRepo.On("CreateSaga", mock.AnythingOfType("*entity.TransactionSaga")).Return(nil)
+	m.sagaRepo.On("UpdateSaga", mock.AnythingOfType("*entity.TransactionSaga")).Return(nil)
+	m.accountClient.On("LockAccounts", ctx, []string{"acc-456", "acc-123"}, mock.Anything, "admin", "req-1").Return("", nil)
+	m.accountClient.On("UpdateAccountsBalance", ctx, mock.Anything,
+		mock.MatchedBy(func(updates []ports.AccountBalanceUpdate) bool {
+			return len(updates) == 2 &&
+				updates[0].AccountID == "acc-456" && updates[0].NewBalance == money.MustParse("57.88") &&
+				updates[1].AccountID == "acc-123" && updates[1].NewBalance == money.MustParse("500.00")
+		}),
+		false, "admin", "req-1").Return([]ports.AccountBalanceUpdateResponse{}, "", nil)
+	m.transactionRepo.On("UpdateTransactionStatus", mock.Anything, entity.TransactionStatusSuccessful, "").Return(nil)
+	m.accountClient.On("UnlockAccounts", ctx, mock.Anything, "admin", "req-1").Return("", nil)
+	m.transactionRepo.On("UpdateTransactionStatus", mock.Anything, entity.TransactionStatusCompleted, "").Return(nil)
+	m.transactionRepo.On("GetTransactionByID", mock.AnythingOfType("string")).Return(storedReversal, nil)
+	m.eventRepo.On("CreateEvent", mock.AnythingOfType("*entity.Event")).Return(nil)
+
+	reversal, msg, err := reverseTransaction.Execute(ctx, "txn-1", money.Zero, "wrong beneficiary", "admin", "req-1")
+
+	assert.NoError(t, err)
+	assert.Equal(t, "Transaction reversed successfully", msg)
+	assert.Equal(t, money.MustParse("92.12"), reversal.Amount)
+	assert.Equal(t, money.MustParse("100.00"), reversal.DestinationAmount)
+	assert.Equal(t, money.MustParseRate("1.085501"), reversal.ExchangeRate)
+	m.accountClient.AssertExpectations(t)
+	m.transactionRepo.AssertExpectations(t)
+	m.transactionRepo.AssertNotCalled(t, "UpdateTransactionExchange", mock.Anything, mock.Anything, mock.Anything)
+}
+
+// TestReverseTransaction_Execute_PartialCrossCurrencyShare tests that a partial cross-currency reversal credits the
+// source its share of the debited amount
+func TestReverseTransaction_Execute_PartialCrossCurrencyShare(t *testing.T) {
+	reverseTransaction, m := newTestReverseTransaction()
+	ctx := context.Background()
+	original := newCompletedTransfer()
+	original.DestinationAmount = money.MustParse("92.12")
+	original.ExchangeRate = money.MustParseRate("0.921234")
+
+	m.transactionRepo.On("GetTransactionByID", "txn-1").Return(original, nil)
+	m.accountClient.On("ValidateAndGetAccounts", ctx, []string{"acc-456", "acc-123"}, "admin", "req-1").Return([]ports.AccountInfo{
+		{AccountID: "acc-456", CustomerID: "cust-456", Currency: "EUR", Balance: money.MustParse("150.00"), Version: 2},
+		{AccountID: "acc-123", CustomerID: "cust-123", Currency: "USD", Balance: money.MustParse("400.00"), Version: 2},
+	}, "", nil)
+	// another reversal linked the original first
+	m.transactionRepo.On("CreateReversal", original, mock.MatchedBy(func(reversal *entity.Transaction) bool {
+		return reversal.Amount == money.MustParse("46.06") && reversal.DestinationAmount == money.MustParse("50.00")
+	})).Return(custom_err.ErrConcurrentModification)
+
+	_, _, err := reverseTransaction.Execute(ctx, "txn-1", money.MustParse("46.06"), "partial refund", "admin", "req-1")
+
+	assert.ErrorIs(t, err, custom_err.ErrConcurrentModification)
+	m.transactionRepo.AssertExpectations(t)
+}
+
+// TestReverseTransaction_Execute_RefundsFee tests that a full reversal refunds the fee from the income account to the
+// account it was charged from, in the same balance update as the amount
+func TestReverseTransaction_Execute_RefundsFee(t *testing.T) {
+	reverseTransaction, m := newTestReverseTransaction()
+	ctx := context.Background()
+	original := newCompletedTransfer()
+	original.ApplyFee(&entity.FeeRule{ID: "rule-1"}, money.MustParse("2.00"), "acc-fees")
+
+	accountsInfo := []ports.AccountInfo{
+		{AccountID: "acc-456", CustomerID: "cust-456", Balance: money.MustParse("150.00"), Version: 2},
+		{AccountID: "acc-123", CustomerID: "cust-123", Balance: money.MustParse("400.00"), Version: 2},
+	}
+	feeAccountInfo := ports.AccountInfo{AccountID: "acc-fees", CustomerID: "bank", Balance: money.MustParse("50.00"), Version: 7}
+
+	storedReversal := &entity.Transaction{}
+	m.transactionRepo.On("GetTransactionByID", "txn-1").Return(original, nil)
+	m.accountClient.On("ValidateAndGetAccounts", ctx, []string{"acc-456", "acc-123"}, "admin", "req-1").Return(accountsInfo, "", nil)
+	m.transactionRepo.On("CreateReversal", original, mock.AnythingOfType("*entity.Transaction")).
+		Run(func(args mock.Arguments) { *storedReversal = *args.Get(1).(*entity.Transaction) }).
+		Return(nil)
+
+	// reversal saga
+	m.sagaRepo.On("CreateSaga", mock.AnythingOfType("*entity.TransactionSaga")).Return(nil)
+	m.sagaRepo.On("UpdateSaga", mock.AnythingOfType("*entity.TransactionSaga")).Return(nil)
+	m.accountClient.On("ValidateAndGetAccounts", ctx, []string{"acc-456", "acc-123", "acc-fees"}, "admin", "req-1").
+		Return(append(accountsInfo, feeAccountInfo), "", nil)
+	m.accountClient.On("LockAccounts", ctx, []string{"acc-456", "acc-123", "acc-fees"}, mock.Anything, "admin", "req-1").Return("", nil)
+	m.accountClient.On("UpdateAccountsBalance", ctx, mock.Anything,
+		mock.MatchedBy(func(updates []ports.AccountBalanceUpdate) bool {
+			return len(updates) == 3 &&
+				updates[0].AccountID == "acc-456" && updates[0].NewBalance == money.MustParse("50.00") &&
+				updates[1].AccountID == "acc-123" && updates[1].NewBalance == money.MustParse("502.00") &&
+				updates[2].AccountID == "acc-fees" && updates[2].NewBalance == money.MustParse("48.00")
+		}),
+		false, "admin", "req-1").Return([]ports.AccountBalanceUpdateResponse{}, "", nil)
+	m.transactionRepo.On("UpdateTransactionExchange", mock.Anything, money.MustParse("100.00"), money.OneRate).Return(nil)
+	m.transactionRepo.On("UpdateTransactionStatus", mock.Anything, entity.TransactionStatusSuccessful, "").Return(nil)
+	m.accountClient.On("UnlockAccounts", ctx, mock.Anything, "admin", "req-1").Return("", nil)
+	m.transactionRepo.On("UpdateTransactionStatus", mock.Anything, entity.TransactionStatusCompleted, "").Return(nil)
+	m.transactionRepo.On("GetTransactionByID", mock.AnythingOfType("string")).Return(storedReversal, nil)
+	m.eventRepo.On("CreateEvent", mock.AnythingOfType("*entity.Event")).Return(nil)
+
+	reversal, msg, err := reverseTransaction.Execute(ctx, "txn-1", money.Zero, "duplicate payment", "admin", "req-1")
+
+	assert.NoError(t, err)
+	assert.Equal(t, "Transaction reversed successfully", msg)
+	assert.Equal(t, money.MustParse("100.00"), reversal.Amount)
+	assert.Equal(t, money.MustParse("2.00"), reversal.FeeAmount)
+	assert.Equal(t, "acc-fees", *reversal.FeeAccountID)
+	assert.Nil(t, reversal.FeeRuleID)
+	m.accountClient.AssertExpectations(t)
+	m.transactionRepo.AssertExpectations(t)
+}
+
+// TestReverseTransaction_Execute_PartialRefundsFeeShare tests that a partial reversal refunds the share of the fee
+// its amount has in the original, rounded down
+func TestReverseTransaction_Execute_PartialRefundsFeeShare(t *testing.T) {
+	reverseTransaction, m := newTestReverseTransaction()
+	ctx := context.Background()
+	original := newCompletedTransfer()
+	original.ApplyFee(&entity.FeeRule{ID: "rule-1"}, money.MustParse("2.99"), "acc-fees")
+
+	m.transactionRepo.On("GetTransactionByID", "txn-1").Return(original, nil)
+	m.accountClient.On("ValidateAndGetAccounts", ctx, []string{"acc-456", "acc-123"}, "admin", "req-1").Return([]ports.AccountInfo{
+		{AccountID: "acc-456", CustomerID: "cust-456", Balance: money.MustParse("150.00"), Version: 2},
+		{AccountID: "acc-123", CustomerID: "cust-123", Balance: money.MustParse("400.00"), Version: 2},
+	}, "", nil)
+	// another reversal linked the original first
+	m.transactionRepo.On("CreateReversal", original, mock.MatchedBy(func(reversal *entity.Transaction) bool {
+		return reversal.Amount == money.MustParse("40.00") && reversal.FeeAmount == money.MustParse("1.19") &&
+			*reversal.FeeAccountID == "acc-fees"
+	})).Return(custom_err.ErrConcurrentModification)
+
+	_, _, err := reverseTransaction.Execute(ctx, "txn-1", money.MustParse("40.00"), "partial refund", "admin", "req-1")
+
+	assert.ErrorIs(t, err, custom_err.ErrConcurrentModification)
+	m.transactionRepo.AssertExpectations(t)
+}
+
+// TestReverseTransaction_Execute_RestAfterPartial tests that a partially reversed transaction is reversed by the rest,
+// which refunds the rest of the fee and credits the rest of the debited amount
+func TestReverseTransaction_Execute_RestAfterPartial(t *testing.T) {
+	reverseTransaction, m := newTestReverseTransaction()
+	ctx := context.Background()
+	original := newCompletedTransfer()
+	original.DestinationAmount = money.MustParse("92.12")
+	original.ExchangeRate = money.MustParseRate("0.921234")
+	original.ApplyFee(&entity.FeeRule{ID: "rule-1"}, money.MustParse("2.99"), "acc-fees")
+	// the first reversal refunded 1.49 of the fee and credited 50.00
+	original.ReversedAmount = money.MustParse("46.06")
+	original.ReversedByTransactionID = stringPtr("txn-9")
+
+	m.transactionRepo.On("GetTransactionByID", "txn-1").Return(original, nil)
+	m.accountClient.On("ValidateAndGetAccounts", ctx, []string{"acc-456", "acc-123"}, "admin", "req-1").Return([]ports.AccountInfo{
+		{AccountID: "acc-456", CustomerID: "cust-456", Currency: "EUR", Balance: money.MustParse("150.00"), Version: 2},
+		{AccountID: "acc-123", CustomerID: "cust-123", Currency: "USD", Balance: money.MustParse("400.00"), Version: 2},
+	}, "", nil)
+	// another reversal linked the original first
+	m.transactionRepo.On("CreateReversal", original, mock.MatchedBy(func(reversal *entity.Transaction) bool {
+		return reversal.Amount == money.MustParse("46.06") && reversal.DestinationAmount == money.MustParse("50.00") &&
+			reversal.FeeAmount == money.MustParse("1.50")
+	})).Return(custom_err.ErrConcurrentModification)
+
+	_, _, err := reverseTransaction.Execute(ctx, "txn-1", money.Zero, "refund the rest", "admin", "req-1")
+
+	assert.ErrorIs(t, err, custom_err.ErrConcurrentModification)
+	m.transactionRepo.AssertExpectations(t)
+}
+
+// TestReverseTransaction_Execute_AlreadyReversed tests that a transaction cannot be reversed once its reversals
+// moved back the full amount
+func TestReverseTransaction_Execute_AlreadyReversed(t *testing.T) {
+	reverseTransaction, m := newTestReverseTransaction()
+	original := newCompletedTransfer()
+	original.ReversedByTransactionID = stringPtr("txn-9")
+	original.ReversedAmount = money.MustParse("100.00")
+
+	m.transactionRepo.On("GetTransactionByID", "txn-1").Return(original, nil)
+
+	_, msg, err := reverseTransaction.Execute(context.Background(), "txn-1", money.Zero, "duplicate", "admin", "req-1")
+
+	assert.ErrorIs(t, err, custom_err.ErrTransactionAlreadyReversed)
+	assert.Equal(t, "Transaction already reversed", msg)
+	m.transactionRepo.AssertNotCalled(t, "CreateReversal", mock.Anything, mock.Anything)
+}
+
+// TestReverseTransaction_Execute_NotReversible tests that pending transactions and reversals cannot be reversed
+func TestReverseTransaction_Execute_NotReversible(t *testing.T) {
+	pending := newCompletedTransfer()
+	pending.TransactionStatus = entity.TransactionStatusPending
+	reversal := newCompletedTransfer()
+	reversal.ReversalOfTransactionID = stringPtr("txn-0")
+
+	testCases := []struct {
+		transaction *entity.Transaction
+		message     string
+	}{
+		{pending, "Only completed transactions can be reversed"},
+		{reversal, "A reversal cannot be reversed"},
+	}
+
+	for _, tc := range testCases {
+		reverseTransaction, m := newTestReverseTransaction()
+		m.transactionRepo.On("GetTransactionByID", "txn-1").Return(tc.transaction, nil)
+
+		_, msg, err := reverseTransaction.Execute(context.Background(), "txn-1", money.Zero, "mistake", "admin", "req-1")
+
+		assert.ErrorIs(t, err, custom_err.ErrTransactionNotReversible)
+		assert.Equal(t, tc.message, msg)
+		m.transactionRepo.AssertNotCalled(t, "CreateReversal", mock.Anything, mock.Anything)
+	}
+}
+
+// TestReverseTransaction_Execute_AmountExceedsOriginal tests that a reversal cannot move back more than the original moved
+func TestReverseTransaction_Execute_AmountExceedsOriginal(t *testing.T) {
+	reverseTransaction, m := newTestReverseTransaction()
+	m.transactionRepo.On("GetTransactionByID", "txn-1").Return(newCompletedTransfer(), nil)
+
+	_, msg, err := reverseTransaction.Execute(context.Background(), "txn-1", money.MustParse("100.01"), "mistake", "admin", "req-1")
+
+	assert.ErrorIs(t, err, custom_err.ErrInvalidAmount)
+	assert.Equal(t, "Reversal amount exceeds the amount not yet reversed of 100.00", msg)
+}
+
+// TestReverseTransaction_Execute_AmountExceedsRest tests that a partially reversed transaction cannot be reversed by
+// more than the rest
+func TestReverseTransaction_Execute_AmountExceedsRest(t *testing.T) {
+	reverseTransaction, m := newTestReverseTransaction()
+	original := newCompletedTransfer()
+	original.ReversedByTransactionID = stringPtr("txn-9")
+	original.ReversedAmount = money.MustParse("60.00")
+	m.transactionRepo.On("GetTransactionByID", "txn-1").Return(original, nil)
+
+	_, msg, err := reverseTransaction.Execute(context.Background(), "txn-1", money.MustParse("50.00"), "mistake", "admin", "req-1")
+
+	assert.ErrorIs(t, err, custom_err.ErrInvalidAmount)
+	assert.Equal(t, "Reversal amount exceeds the amount not yet reversed of 40.00", msg)
+	m.transactionRepo.AssertNotCalled(t, "CreateReversal", mock.Anything, mock.Anything)
+}
+
+// TestReverseTransaction_Execute_InsufficientBalance tests that the reversal respects the current balance of the debited account
+func TestReverseTransaction_Execute_InsufficientBalance(t *testing.T) {
+	reverseTransaction, m := newTestReverseTransaction()
+	ctx := context.Background()
+	m.transactionRepo.On("GetTransactionByID", "txn-1").Return(newCompletedTransfer(), nil)
+	m.accountClient.On("ValidateAndGetAccounts", ctx, []string{"acc-456", "acc-123"}, "admin", "req-1").Return([]ports.AccountInfo{
+		{AccountID: "acc-456", CustomerID: "cust-456", Balance: money.MustParse("20.00")},
+		{AccountID: "acc-123", CustomerID: "cust-123", Balance: money.MustParse("400.00")},
+	}, "", nil)
+
+	_, msg, err := reverseTransaction.Execute(ctx, "txn-1", money.Zero, "mistake", "admin", "req-1")
+
+	assert.ErrorIs(t, err, custom_err.ErrInsufficientBalance)
+	assert.Equal(t, "Source account has insufficient balance", msg)
+	m.transactionRepo.AssertNotCalled(t, "CreateReversal", mock.Anything, mock.Anything)
+}
+
+// TestReverseTransaction_Execute_MissingReason tests that a reason is mandatory
+func TestReverseTransaction_Execute_MissingReason(t *testing.T) {
+	reverseTransaction, m := newTestReverseTransaction()
+
+	_, msg, err := reverseTransaction.Execute(context.Background(), "txn-1", money.Zero, "  ", "admin", "req-1")
+
+	assert.ErrorIs(t, err, custom_err.ErrValidationFailed)
+	assert.Equal(t, "Reversal reason required", msg)
+	m.transactionRepo.AssertNotCalled(t, "GetTransactionByID", mock.Anything)
+}
@@ -7,17 +7,26 @@ import (
 	"time"
 	"transaction-service/internal/domain/entity"
 	custom_err "transaction-service/internal/domain/error"
+	"transaction-service/internal/domain/money"
 	"transaction-service/internal/logging"
 	"transaction-service/internal/ports"
 )
 
+const (
+	lockAttempts     = 10
+	lockRetryBackoff = 50 * time.Millisecond // grows linearly, about 2s in total
+)
+
 type TransactionSagaOrchestrator struct {
 	sagaRepo               ports.SagaRepo
 	accountClient          ports.AccountClient
 	transactionRepo        ports.TransactionRepo
 	eventRepo              ports.EventRepo
+	exchangeRateRepo       ports.ExchangeRateRepo
 	sourceAccountInfo      ports.AccountInfo
 	destinationAccountInfo *ports.AccountInfo
+	feeAccountInfo         *ports.AccountInfo
+	withdrawnAmount        money.Amount    // debited by a full withdrawal, funds reserved by holds stay on the account
 	successfulStepMap      map[string]bool // step type == boolean
 }
 
@@ -26,6 +35,7 @@ func NewTransactionSagaOrchestrator(
 	accountClient ports.AccountClient,
 	transactionRepo ports.TransactionRepo,
 	eventRepo ports.EventRepo,
+	exchangeRateRepo ports.ExchangeRateRepo,
 ) *TransactionSagaOrchestrator {
 
 	return &TransactionSagaOrchestrator{
@@ -33,6 +43,7 @@ func NewTransactionSagaOrchestrator(
 		accountClient:          accountClient,
 		transactionRepo:        transactionRepo,
 		eventRepo:              eventRepo,
+		exchangeRateRepo:       exchangeRateRepo,
 		successfulStepMap:      make(map[string]bool),
 		sourceAccountInfo:      ports.AccountInfo{},
 		destinationAccountInfo: nil,
@@ -52,6 +63,16 @@ func (o *TransactionSagaOrchestrator) ExecuteTransactionSync(
 		transaction.Type,
 		transaction.ReferenceID,
 	)
+	saga.HoldID = transaction.HoldID
+	saga.InterestPostingID = transaction.InterestPostingID
+	// a reversal carries the amount credited back at the rate of the original
+	saga.DestinationAmount = transaction.DestinationAmount
+	saga.ExchangeRate = transaction.ExchangeRate
+	if transaction.HasFee() {
+		saga.FeeAmount = transaction.FeeAmount
+		saga.FeeAccountID = transaction.FeeAccountID
+		saga.FeeRefund = transaction.IsReversal()
+	}
 
 	if err := o.sagaRepo.CreateSaga(saga); err != nil {
 		logging.Logger.Error().
@@ -101,6 +122,62 @@ func (o *TransactionSagaOrchestrator) ExecuteTransactionSync(
 	return transactionErr
 }
 
+// ResumeSaga finishes a saga interrupted mid-flight, e.g. by a restart. The journals committed by the
+// account service decide the direction: a balance update that is not being compensated is rolled forward
+// to completion, anything else is compensated so that no money stays moved for a failed transaction.
+func (o *TransactionSagaOrchestrator) ResumeSaga(
+	ctx context.Context,
+	saga *entity.TransactionSaga,
+	journalStatus *ports.TransactionJournalStatus,
+	requester, requestId string,
+) error {
+	o.restoreSagaProgress(saga, journalStatus)
+
+	if journalStatus.BalanceApplied && !journalStatus.Compensated && !saga.IsCompensating() {
+		logging.Logger.Info().
+			Str("transaction_id", saga.TransactionID).
+			Str("transaction_saga_id", saga.ID).
+			Str("saga_step", saga.CurrentStep).
+			Str("saga_state", saga.CurrentState).
+			Msg("Resume: balance update committed, rolling saga forward")
+		return o.sagaCompleteStep(ctx, saga, requester, requestId)
+	}
+
+	logging.Logger.Info().
+		Str("transaction_id", saga.TransactionID).
+		Str("transaction_saga_id", saga.ID).
+		Str("saga_step", saga.CurrentStep).
+		Str("saga_state", saga.CurrentState).
+		Bool("balance_applied", journalStatus.BalanceApplied).
+		Bool("compensated", journalStatus.Compensated).
+		Msg("Resume: compensating interrupted saga")
+
+	interruptErr := fmt.Errorf("%w at step %s", custom_err.ErrSagaInterrupted, saga.CurrentStep)
+	saga.CompensationRequired = true
+	saga.CompensationReason = interruptErr.Error()
+	return o.compensateSaga(ctx, saga, interruptErr, requester, requestId)
+}
+
+// restoreSagaProgress rebuilds the in-memory step progress of an interrupted saga. Whether money moved
+// is taken from the account-side journals rather than the saga state, which may lag behind them.
+func (o *TransactionSagaOrchestrator) restoreSagaProgress(saga *entity.TransactionSaga, journalStatus *ports.TransactionJournalStatus) {
+	o.successfulStepMap[entity.TransactionSagaStepInitiate] = true
+	o.successfulStepMap[entity.TransactionSagaStepValidateAccounts] = true
+	// unlocking is keyed by transaction ID and safe to repeat, so accounts are always treated as locked
+	o.successfulStepMap[entity.TransactionSagaStepLockAccounts] = true
+	o.successfulStepMap[entity.TransactionSagaStepProcessTransfer] = journalStatus.BalanceApplied
+	o.successfulStepMap[entity.TransactionSagaStepCompensateFundRollback] = journalStatus.Compensated
+
+	// a full withdrawal records no amount, how much it debited only the journal still knows
+	if saga.TransactionType == entity.TransactionTypeWithdrawFull {
+		for _, change := range journalStatus.Changes {
+			if change.AccountID == saga.SourceAccountID {
+				o.withdrawnAmount = money.Zero.Sub(change.Delta)
+			}
+		}
+	}
+}
+
 func (o *TransactionSagaOrchestrator) executeSagaSteps(
 	ctx context.Context,
 	saga *entity.TransactionSaga,
@@ -120,6 +197,15 @@ func (o *TransactionSagaOrchestrator) executeSagaSteps(
 		return err
 	}
 
+	// balances read during validation are stale if another transaction on the same account finished
+	// before the lock was taken, so they are read again while the accounts are locked
+	if !o.IsStepCompletedSuccessfully(entity.TransactionSagaStepProcessTransfer) {
+		err = o.loadAccountsInfo(ctx, accountIDs, saga, requester, requestId)
+		if err != nil {
+			return err
+		}
+	}
+
 	// processing step (transaction will be processed in this step)
 	err = o.sagaProcessStep(ctx, saga, requester, requestId)
 	if err != nil {
@@ -168,6 +254,18 @@ func (o *TransactionSagaOrchestrator) sagaValidationStep(
 	saga.CurrentState = entity.TransactionSagaStateValidating
 	_ = o.updateSaga(saga)
 
+	err = o.loadAccountsInfo(ctx, accountIDs, saga, requester, requestId)
+	return err
+}
+
+// loadAccountsInfo reads the accounts of the saga and keeps their balances and versions for the process step
+func (o *TransactionSagaOrchestrator) loadAccountsInfo(
+	ctx context.Context,
+	accountIDs []string,
+	saga *entity.TransactionSaga,
+	requester string,
+	requestId string) error {
+
 	accountsInfo, message, err := o.accountClient.ValidateAndGetAccounts(ctx, accountIDs, requester, requestId)
 	if err != nil {
 		logging.Logger.Error().
@@ -178,8 +276,7 @@ func (o *TransactionSagaOrchestrator) sagaValidationStep(
 			Str("accountIds", fmt.Sprintf("%v", accountIDs)).
 			Str("message", message).
 			Msg("Unable to validate accounts saga")
-		err = custom_err.ErrAccountValidationFailed
-		return err
+		return custom_err.ErrAccountValidationFailed
 	}
 
 	if accountsInfo == nil || len(accountsInfo) == 0 || len(accountsInfo) != len(accountIDs) {
@@ -189,12 +286,12 @@ func (o *TransactionSagaOrchestrator) sagaValidationStep(
 			Str("transaction_type", saga.TransactionType).
 			Str("accountIds", fmt.Sprintf("%v", accountIDs)).
 			Msg("Accounts details not found")
-		err = custom_err.ErrAccountDetailsMissing
-		return err
+		return custom_err.ErrAccountDetailsMissing
 	}
 
 	var sourceAccount ports.AccountInfo
 	var destinationAccount *ports.AccountInfo
+	var feeAccount *ports.AccountInfo
 	for _, accountInfo := range accountsInfo {
 		if accountInfo.AccountID == saga.SourceAccountID {
 			sourceAccount = accountInfo
@@ -205,12 +302,16 @@ func (o *TransactionSagaOrchestrator) sagaValidationStep(
 
 			destinationAccount = &accountInfo
 		}
+
+		if saga.HasFee() && accountInfo.AccountID == *saga.FeeAccountID {
+			feeAccount = &accountInfo
+		}
 	}
 
 	o.sourceAccountInfo = sourceAccount
 	o.destinationAccountInfo = destinationAccount
-	err = nil
-	return err
+	o.feeAccountInfo = feeAccount
+	return nil
 }
 
 func (o *TransactionSagaOrchestrator) sagaLockingStep(
@@ -249,7 +350,7 @@ func (o *TransactionSagaOrchestrator) sagaLockingStep(
 	saga.CurrentState = entity.TransactionSagaStateLocking
 	_ = o.updateSaga(saga)
 
-	message, err := o.accountClient.LockAccounts(ctx, accountIDs, saga.TransactionID, requester, requestId)
+	message, err := o.lockAccounts(ctx, accountIDs, saga, requester, requestId)
 	if err != nil {
 		logging.Logger.Error().
 			Err(err).
@@ -266,6 +367,32 @@ func (o *TransactionSagaOrchestrator) sagaLockingStep(
 	return err
 }
 
+// lockAccounts retries while the accounts are held by other transactions, e.g. the lines of a batch
+// that share one source account
+func (o *TransactionSagaOrchestrator) lockAccounts(
+	ctx context.Context,
+	accountIDs []string,
+	saga *entity.TransactionSaga,
+	requester string,
+	requestId string) (string, error) {
+
+	var message string
+	var err error
+	for attempt := 1; attempt <= lockAttempts; attempt++ {
+		message, err = o.accountClient.LockAccounts(ctx, accountIDs, saga.TransactionID, requester, requestId)
+		if err == nil || attempt == lockAttempts {
+			break
+		}
+
+		select {
+		case <-ctx.Done():
+			return message, err
+		case <-time.After(time.Duration(attempt) * lockRetryBackoff):
+		}
+	}
+	return message, err
+}
+
 func (o *TransactionSagaOrchestrator) sagaProcessStep(
 	ctx context.Context,
 	saga *entity.TransactionSaga,
@@ -300,6 +427,19 @@ func (o *TransactionSagaOrchestrator) sagaProcessStep(
 	saga.CurrentState = entity.TransactionSagaStateProcessing
 	_ = o.updateSaga(saga)
 
+	if saga.TransactionType == entity.TransactionTypeTransfer {
+		err = o.applyExchangeRate(saga)
+		if err != nil {
+			logging.Logger.Warn().
+				Err(err).
+				Str("transaction_id", saga.TransactionID).
+				Str("transaction_saga_id", saga.ID).
+				Str("transaction_type", saga.TransactionType).
+				Msg("failed to resolve exchange rate for transfer")
+			return err
+		}
+	}
+
 	updates, err := o.calculateAndValidateBalanceUpdates(saga)
 	if err != nil {
 		logging.Logger.Warn().
@@ -312,7 +452,22 @@ func (o *TransactionSagaOrchestrator) sagaProcessStep(
 		return err
 	}
 
-	_, message, err := o.accountClient.UpdateAccountsBalance(ctx, updates, requester, requestId)
+	// a full withdrawal learns its amount from the balance, it is recorded before the debit so limits count it
+	if saga.TransactionType == entity.TransactionTypeWithdrawFull {
+		err = o.transactionRepo.UpdateTransactionAmount(saga.TransactionID, o.withdrawnAmount)
+		if err != nil {
+			logging.Logger.Error().
+				Err(err).
+				Str("transaction_id", saga.TransactionID).
+				Str("transaction_saga_id", saga.ID).
+				Str("transaction_type", saga.TransactionType).
+				Msg("failed to record withdrawn amount")
+			err = custom_err.ErrDatabase
+			return err
+		}
+	}
+
+	_, message, err := o.accountClient.UpdateAccountsBalance(ctx, saga.TransactionID, updates, false, requester, requestId)
 	if err != nil {
 		logging.Logger.Warn().
 			Err(err).
@@ -403,6 +558,100 @@ func (o *TransactionSagaOrchestrator) sagaCompleteStep(
 	return err
 }
 
+// applyExchangeRate converts the debited amount into the destination account currency and records
+// the applied rate and the credited amount on both the saga and the transaction. A credited amount the
+// saga already carries, e.g. that of a reversal, is kept.
+func (o *TransactionSagaOrchestrator) applyExchangeRate(saga *entity.TransactionSaga) error {
+	if o.destinationAccountInfo == nil {
+		return fmt.Errorf("destination account required for transfer")
+	}
+	if saga.DestinationAmount.IsPositive() {
+		return nil
+	}
+
+	sourceCurrency := o.sourceAccountInfo.Currency
+	destinationCurrency := o.destinationAccountInfo.Currency
+
+	rate := money.OneRate
+	if sourceCurrency != destinationCurrency {
+		exchangeRate, err := o.exchangeRateRepo.GetExchangeRate(sourceCurrency, destinationCurrency)
+		if err != nil {
+			return custom_err.ErrDatabase
+		}
+		if exchangeRate == nil {
+			return fmt.Errorf("%w: %s to %s", custom_err.ErrExchangeRateNotFound, sourceCurrency, destinationCurrency)
+		}
+		rate = exchangeRate.Rate
+	}
+
+	destinationAmount, err := rate.Convert(saga.Amount)
+	if err != nil || !destinationAmount.IsPositive() {
+		return fmt.Errorf("%w: converted amount is too small", custom_err.ErrInvalidAmount)
+	}
+
+	saga.DestinationAmount = destinationAmount
+	saga.ExchangeRate = rate
+	_ = o.updateSaga(saga)
+
+	if err := o.transactionRepo.UpdateTransactionExchange(saga.TransactionID, destinationAmount, rate); err != nil {
+		return custom_err.ErrDatabase
+	}
+	return nil
+}
+
+// creditedAmount is the amount credited to the destination account in its own currency
+func (o *TransactionSagaOrchestrator) creditedAmount(saga *entity.TransactionSaga) money.Amount {
+	if saga.DestinationAmount.IsPositive() {
+		return saga.DestinationAmount
+	}
+	return saga.Amount
+}
+
+// debitableBalance is the most the saga may debit from the source account. A hold capture spends funds its
+// hold reserved, so holds are not deducted; the account service checks it against the other holds.
+func (o *TransactionSagaOrchestrator) debitableBalance(saga *entity.TransactionSaga) money.Amount {
+	if saga.HoldID != nil {
+		return o.sourceAccountInfo.Balance + o.sourceAccountInfo.OverdraftLimit
+	}
+	return o.sourceAccountInfo.AvailableBalance()
+}
+
+// sourceDebitUpdate builds the balance update of the source account, capturing the hold of a hold capture
+func (o *TransactionSagaOrchestrator) sourceDebitUpdate(saga *entity.TransactionSaga, newBalance money.Amount, version int) ports.AccountBalanceUpdate {
+	update := ports.AccountBalanceUpdate{
+		AccountID:  saga.SourceAccountID,
+		NewBalance: newBalance,
+		Version:    version,
+	}
+	if saga.HoldID != nil {
+		update.HoldID = *saga.HoldID
+		update.HoldCaptureAmount = saga.Amount
+	}
+	return update
+}
+
+// feeCreditUpdate builds the balance update of the income account the fee of the saga is credited to
+func (o *TransactionSagaOrchestrator) feeCreditUpdate(saga *entity.TransactionSaga, newBalance money.Amount, version int) ports.AccountBalanceUpdate {
+	return ports.AccountBalanceUpdate{
+		AccountID:  *saga.FeeAccountID,
+		NewBalance: newBalance,
+		Version:    version,
+	}
+}
+
+// interestCreditUpdate builds the balance update of an interest credit, posting its interest posting
+func (o *TransactionSagaOrchestrator) interestCreditUpdate(saga *entity.TransactionSaga, newBalance money.Amount, version int) ports.AccountBalanceUpdate {
+	update := ports.AccountBalanceUpdate{
+		AccountID:  saga.SourceAccountID,
+		NewBalance: newBalance,
+		Version:    version,
+	}
+	if saga.InterestPostingID != nil {
+		update.InterestPostingID = *saga.InterestPostingID
+	}
+	return update
+}
+
 func (o *TransactionSagaOrchestrator) calculateAndValidateBalanceUpdates(
 	saga *entity.TransactionSaga,
 ) ([]ports.AccountBalanceUpdate, error) {
@@ -417,29 +666,28 @@ func (o *TransactionSagaOrchestrator) calculateAndValidateBalanceUpdates(
 		if saga.Amount <= 0 {
 			return nil, fmt.Errorf("invalid amount for transfer")
 		}
-		if o.sourceAccountInfo.Balance < saga.Amount {
+		if o.debitableBalance(saga) < saga.Amount+saga.ChargedFee() {
 			return nil, fmt.Errorf("insufficient balance in source account")
 		}
 
-		updates = append(updates, ports.AccountBalanceUpdate{
-			AccountID:  saga.SourceAccountID,
-			NewBalance: o.sourceAccountInfo.Balance - saga.Amount,
-			Version:    o.sourceAccountInfo.Version,
-		})
+		updates = append(updates, o.sourceDebitUpdate(saga, o.sourceAccountInfo.Balance-saga.Amount-saga.ChargedFee(), o.sourceAccountInfo.Version))
 
+		// the reversal of a transfer refunds the fee to its destination, the source of the original
 		updates = append(updates, ports.AccountBalanceUpdate{
 			AccountID:  *saga.DestinationAccountID,
-			NewBalance: o.destinationAccountInfo.Balance + saga.Amount,
+			NewBalance: o.destinationAccountInfo.Balance + o.creditedAmount(saga) + saga.RefundedFee(),
 			Version:    o.destinationAccountInfo.Version,
 		})
 
 	case entity.TransactionTypeWithdrawFull:
-		if o.sourceAccountInfo.Balance <= 0 {
+		// funds reserved by holds stay on the account
+		if o.sourceAccountInfo.Balance <= o.sourceAccountInfo.HeldAmount {
 			return nil, fmt.Errorf("account already empty")
 		}
+		o.withdrawnAmount = o.sourceAccountInfo.Balance - o.sourceAccountInfo.HeldAmount
 		updates = append(updates, ports.AccountBalanceUpdate{
 			AccountID:  saga.SourceAccountID,
-			NewBalance: 0,
+			NewBalance: o.sourceAccountInfo.HeldAmount,
 			Version:    o.sourceAccountInfo.Version,
 		})
 
@@ -447,14 +695,10 @@ func (o *TransactionSagaOrchestrator) calculateAndValidateBalanceUpdates(
 		if saga.Amount <= 0 {
 			return nil, fmt.Errorf("invalid amount for withdrawal")
 		}
-		if o.sourceAccountInfo.Balance < saga.Amount {
+		if o.debitableBalance(saga) < saga.Amount+saga.ChargedFee() {
 			return nil, fmt.Errorf("insufficient balance")
 		}
-		updates = append(updates, ports.AccountBalanceUpdate{
-			AccountID:  saga.SourceAccountID,
-			NewBalance: o.sourceAccountInfo.Balance - saga.Amount,
-			Version:    o.sourceAccountInfo.Version,
-		})
+		updates = append(updates, o.sourceDebitUpdate(saga, o.sourceAccountInfo.Balance-saga.Amount-saga.ChargedFee(), o.sourceAccountInfo.Version))
 
 	case entity.TransactionTypeAddAmount:
 		if saga.Amount <= 0 {
@@ -462,14 +706,32 @@ func (o *TransactionSagaOrchestrator) calculateAndValidateBalanceUpdates(
 		}
 		updates = append(updates, ports.AccountBalanceUpdate{
 			AccountID:  saga.SourceAccountID,
-			NewBalance: o.sourceAccountInfo.Balance + saga.Amount,
+			NewBalance: o.sourceAccountInfo.Balance + saga.Amount + saga.RefundedFee(),
 			Version:    o.sourceAccountInfo.Version,
 		})
 
+	case entity.TransactionTypeInterestCredit:
+		if saga.Amount <= 0 {
+			return nil, fmt.Errorf("invalid amount for interest credit")
+		}
+		updates = append(updates, o.interestCreditUpdate(saga, o.sourceAccountInfo.Balance+saga.Amount, o.sourceAccountInfo.Version))
+
 	default:
 		return nil, fmt.Errorf("invalid transaction type: %s", saga.TransactionType)
 	}
 
+	// the fee is credited in the same balance update, so it is charged only if the transaction succeeds; a refund
+	// is debited from the income account the same way
+	if saga.HasFee() {
+		if o.feeAccountInfo == nil {
+			return nil, fmt.Errorf("fee account required for fee")
+		}
+		if o.feeAccountInfo.AvailableBalance() < saga.RefundedFee() {
+			return nil, fmt.Errorf("insufficient balance in fee account")
+		}
+		updates = append(updates, o.feeCreditUpdate(saga, o.feeAccountInfo.Balance+saga.ChargedFee()-saga.RefundedFee(), o.feeAccountInfo.Version))
+	}
+
 	return updates, nil
 }
 
@@ -591,7 +853,7 @@ func (o *TransactionSagaOrchestrator) compensateStepFundRollback(
 		return err
 	}
 
-	var destBalance float64
+	var destBalance money.Amount
 	var destVersion int
 	if saga.DestinationAccountID != nil {
 		destBalance, destVersion, err = o.accountClient.GetBalance(ctx, *saga.DestinationAccountID)
@@ -609,8 +871,29 @@ func (o *TransactionSagaOrchestrator) compensateStepFundRollback(
 		}
 	}
 
+	var feeBalance money.Amount
+	var feeVersion int
+	if saga.HasFee() {
+		feeBalance, feeVersion, err = o.accountClient.GetBalance(ctx, *saga.FeeAccountID)
+		if err != nil {
+			logging.Logger.Error().
+				Err(err).
+				Str("transaction_id", saga.TransactionID).
+				Str("transaction_saga_id", saga.ID).
+				Str("saga_step", saga.CurrentStep).
+				Str("fee_account_id", *saga.FeeAccountID).
+				Str("transaction_type", saga.TransactionType).
+				Msg("Compensation: Failed to get current balance and version for fee account")
+			err = custom_err.ErrFailedCompensationFundRollback
+			return err
+		}
+	}
+
 	// calculate rollback amount
 	updates, err := o.calculateRollbackUpdates(saga, sourceBalance, sourceVersion, destBalance, destVersion)
+	if err == nil && saga.HasFee() {
+		updates = append(updates, o.feeCreditUpdate(saga, feeBalance-saga.ChargedFee()+saga.RefundedFee(), feeVersion))
+	}
 	if err != nil {
 		logging.Logger.Error().
 			Err(err).
@@ -624,7 +907,7 @@ func (o *TransactionSagaOrchestrator) compensateStepFundRollback(
 		return err
 	}
 
-	_, message, err := o.accountClient.UpdateAccountsBalance(ctx, updates, requester, requestId)
+	_, message, err := o.accountClient.UpdateAccountsBalance(ctx, saga.TransactionID, updates, true, requester, requestId)
 	if err != nil {
 		logging.Logger.Warn().
 			Err(err).
@@ -684,44 +967,41 @@ func (o *TransactionSagaOrchestrator) compensateStepAccountUnlock(
 // Enhanced calculateRollbackUpdates method
 func (o *TransactionSagaOrchestrator) calculateRollbackUpdates(
 	saga *entity.TransactionSaga,
-	currentSourceBalance float64, currentSourceAccountVersion int, currentDestBalance float64, currentDestAccountVersion int,
+	currentSourceBalance money.Amount, currentSourceAccountVersion int, currentDestBalance money.Amount, currentDestAccountVersion int,
 ) ([]ports.AccountBalanceUpdate, error) {
 
 	var updates []ports.AccountBalanceUpdate
 
 	switch saga.TransactionType {
 	case entity.TransactionTypeTransfer:
-		updates = append(updates, ports.AccountBalanceUpdate{
-			AccountID:  saga.SourceAccountID,
-			NewBalance: currentSourceBalance + saga.Amount,
-			Version:    currentSourceAccountVersion,
-		})
+		// rolling back a capture makes its hold active again
+		updates = append(updates, o.sourceDebitUpdate(saga, currentSourceBalance+saga.Amount+saga.ChargedFee(), currentSourceAccountVersion))
 		updates = append(updates, ports.AccountBalanceUpdate{
 			AccountID:  *saga.DestinationAccountID,
-			NewBalance: currentDestBalance - saga.Amount,
+			NewBalance: currentDestBalance - o.creditedAmount(saga) - saga.RefundedFee(),
 			Version:    currentDestAccountVersion,
 		})
 
 	case entity.TransactionTypeWithdrawFull:
 		updates = append(updates, ports.AccountBalanceUpdate{
 			AccountID:  saga.SourceAccountID,
-			NewBalance: o.sourceAccountInfo.Balance,
+			NewBalance: currentSourceBalance + o.withdrawnAmount,
 			Version:    currentSourceAccountVersion,
 		})
 
 	case entity.TransactionTypeWithdrawAmount:
-		updates = append(updates, ports.AccountBalanceUpdate{
-			AccountID:  saga.SourceAccountID,
-			NewBalance: currentSourceBalance + saga.Amount,
-			Version:    currentSourceAccountVersion,
-		})
+		updates = append(updates, o.sourceDebitUpdate(saga, currentSourceBalance+saga.Amount+saga.ChargedFee(), currentSourceAccountVersion))
 
 	case entity.TransactionTypeAddAmount:
 		updates = append(updates, ports.AccountBalanceUpdate{
 			AccountID:  saga.SourceAccountID,
-			NewBalance: currentSourceBalance - saga.Amount,
+			NewBalance: currentSourceBalance - saga.Amount - saga.RefundedFee(),
 			Version:    currentSourceAccountVersion,
 		})
+
+	case entity.TransactionTypeInterestCredit:
+		// rolling back a credit makes its posting pending again
+		updates = append(updates, o.interestCreditUpdate(saga, currentSourceBalance-saga.Amount, currentSourceAccountVersion))
 	default:
 		return nil, fmt.Errorf("unknown transaction type for rollback: %s", saga.TransactionType)
 	}
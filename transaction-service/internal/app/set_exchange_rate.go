@@ -0,0 +1,88 @@
+package app
+
+import (
+	"strings"
+	"transaction-service/internal/domain/entity"
+	custom_err "transaction-service/internal/domain/error"
+	"transaction-service/internal/domain/money"
+	"transaction-service/internal/logging"
+	"transaction-service/internal/messaging"
+	"transaction-service/internal/observability/metrics"
+	"transaction-service/internal/ports"
+)
+
+// SetExchangeRate is a use-case for creating or updating the rate of a currency pair
+type SetExchangeRate struct {
+	ExchangeRateRepo ports.ExchangeRateRepo
+	EventRepo        ports.EventRepo
+}
+
+// NewSetExchangeRate creates a new SetExchangeRate use-case
+func NewSetExchangeRate(exchangeRateRepo ports.ExchangeRateRepo, eventRepo ports.EventRepo) *SetExchangeRate {
+	return &SetExchangeRate{
+		ExchangeRateRepo: exchangeRateRepo,
+		EventRepo:        eventRepo,
+	}
+}
+
+// Execute sets the rate for converting one unit of baseCurrency into quoteCurrency
+func (s *SetExchangeRate) Execute(baseCurrency, quoteCurrency, rate, requester, requestId string) (*entity.ExchangeRate, string, error) {
+	metrics.IncRequestActive()
+	defer metrics.DecRequestActive()
+
+	var err error
+	defer func() {
+		metrics.RecordOperation("set_exchange_rate", err)
+	}()
+
+	if strings.TrimSpace(requester) == "" {
+		err = custom_err.ErrMissingRequester
+		logging.Logger.Error().Err(err).Msg("Unknown requester")
+		return nil, "Unknown requester", err
+	}
+
+	baseCurrency = strings.ToUpper(strings.TrimSpace(baseCurrency))
+	quoteCurrency = strings.ToUpper(strings.TrimSpace(quoteCurrency))
+	if !entity.IsSupportedCurrency(baseCurrency) || !entity.IsSupportedCurrency(quoteCurrency) {
+		err = custom_err.ErrUnsupportedCurrency
+		logging.Logger.Error().Err(err).Str("base_currency", baseCurrency).Str("quote_currency", quoteCurrency).Msg("Invalid request")
+		return nil, "Unsupported currency, supported currencies - " + strings.Join(entity.SupportedCurrencies, ", "), err
+	}
+
+	parsedRate, err := money.ParseRate(rate)
+	if err != nil {
+		logging.Logger.Error().Err(err).Str("rate", rate).Msg("Invalid exchange rate")
+		err = custom_err.ErrInvalidExchangeRate
+		return nil, "Invalid exchange rate, rate must be a positive decimal with at most 6 decimal places", err
+	}
+
+	exchangeRate, err := entity.NewExchangeRate(baseCurrency, quoteCurrency, parsedRate, requester)
+	if err != nil {
+		logging.Logger.Error().Err(err).Str("base_currency", baseCurrency).Str("quote_currency", quoteCurrency).Msg("Invalid exchange rate")
+		return nil, "Invalid exchange rate, currencies must differ and rate must be positive", err
+	}
+
+	if err = s.ExchangeRateRepo.UpsertExchangeRate(exchangeRate); err != nil {
+		logging.Logger.Error().Err(err).Str("base_currency", baseCurrency).Str("quote_currency", quoteCurrency).Msg("Failed to save exchange rate")
+		err = custom_err.ErrDatabase
+		return nil, "Failed to save exchange rate", err
+	}
+
+	eventData := map[string]interface{}{
+		"base_currency":  baseCurrency,
+		"quote_currency": quoteCurrency,
+		"rate":           parsedRate.String(),
+		"updated_by":     requester,
+		"request_id":     requestId,
+	}
+
+	event, eventErr := entity.NewEvent(entity.EventTypeExchangeRateUpdated, baseCurrency+"/"+quoteCurrency, entity.EventAggregateTypeExchange, requester, eventData)
+	if eventErr == nil {
+		if createErr := s.EventRepo.CreateEvent(event); createErr != nil {
+			logging.Logger.Error().Err(createErr).Str("event_type", event.Type).Msg("Failed to create event")
+		}
+		_ = messaging.GetService().PublishToDefaultTopic(messaging.Message{Content: event.ToString(), Status: true, Type: messaging.MessageTypeExchangeRateUpdated})
+	}
+
+	return exchangeRate, "Exchange rate updated successfully", nil
+}
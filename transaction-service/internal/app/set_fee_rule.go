@@ -0,0 +1,129 @@
+package app
+
+import (
+	"strings"
+	"transaction-service/internal/domain/entity"
+	custom_err "transaction-service/internal/domain/error"
+	"transaction-service/internal/domain/money"
+	"transaction-service/internal/logging"
+	"transaction-service/internal/messaging"
+	"transaction-service/internal/observability/metrics"
+	"transaction-service/internal/ports"
+)
+
+// FeeRuleInput is a fee rule as submitted by an admin. Empty amounts mean zero: no upper bound for the band
+// and no cap for the fee.
+type FeeRuleInput struct {
+	TransactionType string
+	AccountType     string
+	MinAmount       string
+	MaxAmount       string
+	FeeType         string
+	FlatAmount      string
+	Percentage      string
+	MinFee          string
+	MaxFee          string
+}
+
+// SetFeeRule is a use-case for creating or overriding the fee rule of a transaction type, account type and band
+type SetFeeRule struct {
+	FeeRuleRepo ports.FeeRuleRepo
+	EventRepo   ports.EventRepo
+}
+
+// NewSetFeeRule creates a new SetFeeRule use-case
+func NewSetFeeRule(feeRuleRepo ports.FeeRuleRepo, eventRepo ports.EventRepo) *SetFeeRule {
+	return &SetFeeRule{
+		FeeRuleRepo: feeRuleRepo,
+		EventRepo:   eventRepo,
+	}
+}
+
+// Execute replaces the rule of the transaction type, account type and band start
+func (s *SetFeeRule) Execute(input FeeRuleInput, requester, requestId string) (*entity.FeeRule, string, error) {
+	metrics.IncRequestActive()
+	defer metrics.DecRequestActive()
+
+	var err error
+	defer func() {
+		metrics.RecordOperation("set_fee_rule", err)
+	}()
+
+	if strings.TrimSpace(requester) == "" {
+		err = custom_err.ErrMissingRequester
+		logging.Logger.Error().Err(err).Msg("Unknown requester")
+		return nil, "Unknown requester", err
+	}
+
+	var amounts [5]money.Amount
+	for i, value := range []string{input.MinAmount, input.MaxAmount, input.FlatAmount, input.MinFee, input.MaxFee} {
+		if strings.TrimSpace(value) == "" {
+			continue
+		}
+		amounts[i], err = money.Parse(value)
+		if err != nil {
+			logging.Logger.Error().Err(err).Str("amount", value).Msg("Invalid fee rule amount")
+			err = custom_err.ErrInvalidFeeRule
+			return nil, "Invalid fee rule amount, amounts must be decimals with at most 2 decimal places", err
+		}
+	}
+
+	var percentage money.Percent
+	if strings.TrimSpace(input.Percentage) != "" {
+		percentage, err = money.ParsePercent(input.Percentage)
+		if err != nil {
+			logging.Logger.Error().Err(err).Str("percentage", input.Percentage).Msg("Invalid fee rule percentage")
+			err = custom_err.ErrInvalidFeeRule
+			return nil, "Invalid fee percentage, it must be between 0 and 100 with at most 4 decimal places", err
+		}
+	}
+
+	rule, err := entity.NewFeeRule(
+		input.TransactionType,
+		input.AccountType,
+		amounts[0],
+		amounts[1],
+		input.FeeType,
+		amounts[2],
+		percentage,
+		amounts[3],
+		amounts[4],
+		requester,
+	)
+	if err != nil {
+		logging.Logger.Error().Err(err).Str("transaction_type", input.TransactionType).Str("account_type", input.AccountType).Msg("Invalid fee rule")
+		return nil, "Invalid fee rule, transaction type must be transfer or withdraw_amount, account type savings, current " +
+			"or empty, fee type flat or percentage, amounts must not be negative and maximums must not be below minimums", err
+	}
+
+	if err = s.FeeRuleRepo.UpsertFeeRule(rule); err != nil {
+		logging.Logger.Error().Err(err).Str("transaction_type", rule.TransactionType).Str("account_type", rule.AccountType).Msg("Failed to save fee rule")
+		err = custom_err.ErrDatabase
+		return nil, "Failed to save fee rule", err
+	}
+
+	eventData := map[string]interface{}{
+		"fee_rule_id":      rule.ID,
+		"transaction_type": rule.TransactionType,
+		"account_type":     rule.AccountType,
+		"min_amount":       rule.MinAmount.String(),
+		"max_amount":       rule.MaxAmount.String(),
+		"fee_type":         rule.FeeType,
+		"flat_amount":      rule.FlatAmount.String(),
+		"percentage":       rule.Percentage.String(),
+		"min_fee":          rule.MinFee.String(),
+		"max_fee":          rule.MaxFee.String(),
+		"updated_by":       requester,
+		"request_id":       requestId,
+	}
+
+	event, eventErr := entity.NewEvent(entity.EventTypeFeeRuleUpdated, rule.ID, entity.EventAggregateTypeFeeRule, requester, eventData)
+	if eventErr == nil {
+		if createErr := s.EventRepo.CreateEvent(event); createErr != nil {
+			logging.Logger.Error().Err(createErr).Str("event_type", event.Type).Msg("Failed to create event")
+		}
+		_ = messaging.GetService().PublishToDefaultTopic(messaging.Message{Content: event.ToString(), Status: true, Type: messaging.MessageTypeFeeRuleUpdated})
+	}
+
+	return rule, "Fee rule updated successfully", nil
+}
@@ -0,0 +1,111 @@
+package app
+
+import (
+	"strings"
+	"transaction-service/internal/domain/entity"
+	custom_err "transaction-service/internal/domain/error"
+	"transaction-service/internal/domain/money"
+	"transaction-service/internal/logging"
+	"transaction-service/internal/messaging"
+	"transaction-service/internal/observability/metrics"
+	"transaction-service/internal/ports"
+)
+
+// TransactionLimitInput is a limit as submitted by an admin. Empty amounts and zero counts mean no limit.
+type TransactionLimitInput struct {
+	Scope                string
+	Subject              string
+	PerTransactionAmount string
+	DailyAmount          string
+	DailyCount           int
+	MonthlyAmount        string
+	MonthlyCount         int
+}
+
+// SetTransactionLimit is a use-case for creating or overriding the limit of an account type, account or role
+type SetTransactionLimit struct {
+	LimitRepo ports.TransactionLimitRepo
+	EventRepo ports.EventRepo
+}
+
+// NewSetTransactionLimit creates a new SetTransactionLimit use-case
+func NewSetTransactionLimit(limitRepo ports.TransactionLimitRepo, eventRepo ports.EventRepo) *SetTransactionLimit {
+	return &SetTransactionLimit{
+		LimitRepo: limitRepo,
+		EventRepo: eventRepo,
+	}
+}
+
+// Execute replaces the limit of the scope and subject
+func (s *SetTransactionLimit) Execute(input TransactionLimitInput, requester, requestId string) (*entity.TransactionLimit, string, error) {
+	metrics.IncRequestActive()
+	defer metrics.DecRequestActive()
+
+	var err error
+	defer func() {
+		metrics.RecordOperation("set_transaction_limit", err)
+	}()
+
+	if strings.TrimSpace(requester) == "" {
+		err = custom_err.ErrMissingRequester
+		logging.Logger.Error().Err(err).Msg("Unknown requester")
+		return nil, "Unknown requester", err
+	}
+
+	var amounts [3]money.Amount
+	for i, value := range []string{input.PerTransactionAmount, input.DailyAmount, input.MonthlyAmount} {
+		if strings.TrimSpace(value) == "" {
+			continue
+		}
+		amounts[i], err = money.Parse(value)
+		if err != nil {
+			logging.Logger.Error().Err(err).Str("amount", value).Msg("Invalid transaction limit amount")
+			err = custom_err.ErrInvalidTransactionLimit
+			return nil, "Invalid limit amount, amounts must be decimals with at most 2 decimal places", err
+		}
+	}
+
+	limit, err := entity.NewTransactionLimit(
+		input.Scope,
+		input.Subject,
+		amounts[0],
+		amounts[1],
+		input.DailyCount,
+		amounts[2],
+		input.MonthlyCount,
+		requester,
+	)
+	if err != nil {
+		logging.Logger.Error().Err(err).Str("scope", input.Scope).Str("subject", input.Subject).Msg("Invalid transaction limit")
+		return nil, "Invalid transaction limit, scope must be account_type (savings or current), account or role " +
+			"and amounts and counts must not be negative", err
+	}
+
+	if err = s.LimitRepo.UpsertTransactionLimit(limit); err != nil {
+		logging.Logger.Error().Err(err).Str("scope", limit.Scope).Str("subject", limit.Subject).Msg("Failed to save transaction limit")
+		err = custom_err.ErrDatabase
+		return nil, "Failed to save transaction limit", err
+	}
+
+	eventData := map[string]interface{}{
+		"scope":                  limit.Scope,
+		"subject":                limit.Subject,
+		"per_transaction_amount": limit.PerTransactionAmount.String(),
+		"daily_amount":           limit.DailyAmount.String(),
+		"daily_count":            limit.DailyCount,
+		"monthly_amount":         limit.MonthlyAmount.String(),
+		"monthly_count":          limit.MonthlyCount,
+		"updated_by":             requester,
+		"request_id":             requestId,
+	}
+
+	event, eventErr := entity.NewEvent(entity.EventTypeLimitUpdated, limit.Scope+"/"+limit.Subject, entity.EventAggregateTypeLimit, requester, eventData)
+	if eventErr == nil {
+		if createErr := s.EventRepo.CreateEvent(event); createErr != nil {
+			logging.Logger.Error().Err(createErr).Str("event_type", event.Type).Msg("Failed to create event")
+		}
+		_ = messaging.GetService().PublishToDefaultTopic(messaging.Message{Content: event.ToString(), Status: true, Type: messaging.MessageTypeLimitUpdated})
+	}
+
+	return limit, "Transaction limit updated successfully", nil
+}
@@ -0,0 +1,116 @@
+package app
+
+import (
+	"fmt"
+	"strings"
+	"time"
+	"transaction-service/internal/domain/entity"
+	custom_err "transaction-service/internal/domain/error"
+	"transaction-service/internal/logging"
+	"transaction-service/internal/messaging"
+	"transaction-service/internal/observability/metrics"
+	"transaction-service/internal/ports"
+)
+
+const (
+	StandingOrderActionPause  = "pause"
+	StandingOrderActionResume = "resume"
+	StandingOrderActionCancel = "cancel"
+)
+
+var standingOrderActionMessages = map[string]string{
+	StandingOrderActionPause:  "Standing order paused",
+	StandingOrderActionResume: "Standing order resumed",
+	StandingOrderActionCancel: "Standing order cancelled",
+}
+
+// UpdateStandingOrderStatus is a use-case for pausing, resuming or cancelling a standing order
+type UpdateStandingOrderStatus struct {
+	StandingOrderRepo ports.StandingOrderRepo
+	EventRepo         ports.EventRepo
+}
+
+// NewUpdateStandingOrderStatus creates a new UpdateStandingOrderStatus use-case
+func NewUpdateStandingOrderStatus(standingOrderRepo ports.StandingOrderRepo, eventRepo ports.EventRepo) *UpdateStandingOrderStatus {
+	return &UpdateStandingOrderStatus{
+		StandingOrderRepo: standingOrderRepo,
+		EventRepo:         eventRepo,
+	}
+}
+
+func (u *UpdateStandingOrderStatus) Execute(standingOrderID, action, requester, requestId string) (*entity.StandingOrder, string, error) {
+	metrics.IncRequestActive()
+	defer metrics.DecRequestActive()
+
+	var err error
+	defer func() {
+		metrics.RecordOperation("update_standing_order_status", err)
+	}()
+
+	if strings.TrimSpace(requester) == "" {
+		err = custom_err.ErrMissingRequester
+		logging.Logger.Error().Err(err).Msg("Unknown requester")
+		return nil, "Unknown requester", err
+	}
+
+	standingOrderID = strings.TrimSpace(standingOrderID)
+	if standingOrderID == "" {
+		err = fmt.Errorf("%w: standing order id required", custom_err.ErrValidationFailed)
+		return nil, "Standing order ID missing", err
+	}
+
+	order, err := u.StandingOrderRepo.GetStandingOrderByID(standingOrderID)
+	if err != nil {
+		logging.Logger.Error().Err(err).Str("standing_order_id", standingOrderID).Str("request_id", requestId).Msg("Failed to get standing order")
+		err = fmt.Errorf("%w: failed to get standing order", custom_err.ErrDatabase)
+		return nil, "Failed to get standing order", err
+	}
+
+	if order == nil {
+		err = custom_err.ErrStandingOrderNotFound
+		return nil, "Standing order not found", err
+	}
+
+	previousStatus := order.Status
+	action = strings.ToLower(strings.TrimSpace(action))
+	switch action {
+	case StandingOrderActionPause:
+		err = order.Pause(requester)
+	case StandingOrderActionResume:
+		err = order.Resume(time.Now(), requester)
+	case StandingOrderActionCancel:
+		err = order.Cancel(requester)
+	default:
+		err = fmt.Errorf("%w: unknown action %q", custom_err.ErrValidationFailed, action)
+		return nil, "Invalid action, action must be pause, resume or cancel", err
+	}
+
+	if err != nil {
+		logging.Logger.Warn().Err(err).Str("standing_order_id", order.ID).Str("status", order.Status).Str("action", action).Msg("Invalid standing order status change")
+		return nil, fmt.Sprintf("Cannot %s a %s standing order", action, previousStatus), err
+	}
+
+	if err = u.StandingOrderRepo.UpdateStandingOrder(order); err != nil {
+		logging.Logger.Error().Err(err).Str("standing_order_id", order.ID).Msg("Failed to update standing order")
+		err = custom_err.ErrDatabase
+		return nil, "Failed to update standing order", err
+	}
+
+	eventData := map[string]interface{}{
+		"standing_order_id": order.ID,
+		"previous_status":   previousStatus,
+		"status":            order.Status,
+		"updated_by":        requester,
+		"request_id":        requestId,
+	}
+
+	event, eventErr := entity.NewEvent(entity.EventTypeStandingOrderUpdated, order.ID, entity.EventAggregateTypeStanding, requester, eventData)
+	if eventErr == nil {
+		if createErr := u.EventRepo.CreateEvent(event); createErr != nil {
+			logging.Logger.Error().Err(createErr).Str("event_type", event.Type).Msg("Failed to create event")
+		}
+		_ = messaging.GetService().PublishToDefaultTopic(messaging.Message{Content: order.ToString(), Status: true, Type: messaging.MessageTypeStandingOrderUpdated})
+	}
+
+	return order, standingOrderActionMessages[action], nil
+}
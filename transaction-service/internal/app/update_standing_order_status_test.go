@@ -0,0 +1,116 @@
+package app
+
+import (
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"testing"
+	"time"
+	"transaction-service/internal/domain/entity"
+	custom_err "transaction-service/internal/domain/error"
+	"transaction-service/internal/domain/money"
+	mock_repo "transaction-service/internal/ports/mocks"
+)
+
+func newStandingOrderForStatusTest(t *testing.T, startAt time.Time) *entity.StandingOrder {
+	order, err := entity.NewStandingOrder("acc-123", "acc-456", money.MustParse("100.00"), entity.StandingOrderFrequencyDaily, startAt, nil, 0, "", "user123")
+	assert.NoError(t, err)
+	return order
+}
+
+// TestUpdateStandingOrderStatus_Execute_Pause tests pausing an active standing order
+func TestUpdateStandingOrderStatus_Execute_Pause(t *testing.T) {
+	mockStandingOrderRepo := new(mock_repo.MockStandingOrderRepo)
+	mockEventRepo := new(mock_repo.MockEventRepo)
+	updateStatus := NewUpdateStandingOrderStatus(mockStandingOrderRepo, mockEventRepo)
+
+	order := newStandingOrderForStatusTest(t, time.Now().Add(time.Hour))
+	mockStandingOrderRepo.On("GetStandingOrderByID", order.ID).Return(order, nil)
+	mockStandingOrderRepo.On("UpdateStandingOrder", order).Return(nil)
+	mockEventRepo.On("CreateEvent", mock.AnythingOfType("*entity.Event")).Return(nil)
+
+	updated, msg, err := updateStatus.Execute(order.ID, " Pause ", "admin", "req-1")
+
+	assert.NoError(t, err)
+	assert.Equal(t, "Standing order paused", msg)
+	assert.Equal(t, entity.StandingOrderStatusPaused, updated.Status)
+	assert.Equal(t, "admin", updated.UpdatedBy)
+	mockStandingOrderRepo.AssertExpectations(t)
+	mockEventRepo.AssertExpectations(t)
+}
+
+// TestUpdateStandingOrderStatus_Execute_ResumeSkipsMissedRuns tests that resuming skips occurrences missed while paused
+func TestUpdateStandingOrderStatus_Execute_ResumeSkipsMissedRuns(t *testing.T) {
+	mockStandingOrderRepo := new(mock_repo.MockStandingOrderRepo)
+	mockEventRepo := new(mock_repo.MockEventRepo)
+	updateStatus := NewUpdateStandingOrderStatus(mockStandingOrderRepo, mockEventRepo)
+
+	order := newStandingOrderForStatusTest(t, time.Now().AddDate(0, 0, -3).Add(time.Hour))
+	order.Status = entity.StandingOrderStatusPaused
+	mockStandingOrderRepo.On("GetStandingOrderByID", order.ID).Return(order, nil)
+	mockStandingOrderRepo.On("UpdateStandingOrder", order).Return(nil)
+	mockEventRepo.On("CreateEvent", mock.AnythingOfType("*entity.Event")).Return(nil)
+
+	updated, msg, err := updateStatus.Execute(order.ID, StandingOrderActionResume, "admin", "req-1")
+
+	assert.NoError(t, err)
+	assert.Equal(t, "Standing order resumed", msg)
+	assert.Equal(t, entity.StandingOrderStatusActive, updated.Status)
+	assert.True(t, updated.NextRunAt.After(time.Now()))
+	assert.Equal(t, order.ScheduledAt(3), *updated.NextRunAt)
+
+	// the job runs the next occurrence once, the skipped ones are not replayed
+	ranAt := *updated.NextRunAt
+	runs := 0
+	for updated.IsDue(ranAt) && runs < 5 {
+		updated.AdvanceSchedule(ranAt)
+		runs++
+	}
+	assert.Equal(t, 1, runs)
+	assert.Equal(t, 1, updated.RunCount)
+	assert.Equal(t, order.ScheduledAt(4), *updated.NextRunAt)
+}
+
+// TestUpdateStandingOrderStatus_Execute_CancelCancelledOrder tests that a cancelled order cannot be changed
+func TestUpdateStandingOrderStatus_Execute_CancelCancelledOrder(t *testing.T) {
+	mockStandingOrderRepo := new(mock_repo.MockStandingOrderRepo)
+	updateStatus := NewUpdateStandingOrderStatus(mockStandingOrderRepo, new(mock_repo.MockEventRepo))
+
+	order := newStandingOrderForStatusTest(t, time.Now().Add(time.Hour))
+	assert.NoError(t, order.Cancel("user123"))
+	mockStandingOrderRepo.On("GetStandingOrderByID", order.ID).Return(order, nil)
+
+	updated, msg, err := updateStatus.Execute(order.ID, StandingOrderActionCancel, "admin", "req-1")
+
+	assert.ErrorIs(t, err, custom_err.ErrInvalidStandingOrderStatus)
+	assert.Nil(t, updated)
+	assert.Equal(t, "Cannot cancel a cancelled standing order", msg)
+	mockStandingOrderRepo.AssertNotCalled(t, "UpdateStandingOrder", mock.Anything)
+}
+
+// TestUpdateStandingOrderStatus_Execute_InvalidAction tests that unknown actions are rejected
+func TestUpdateStandingOrderStatus_Execute_InvalidAction(t *testing.T) {
+	mockStandingOrderRepo := new(mock_repo.MockStandingOrderRepo)
+	updateStatus := NewUpdateStandingOrderStatus(mockStandingOrderRepo, new(mock_repo.MockEventRepo))
+
+	order := newStandingOrderForStatusTest(t, time.Now().Add(time.Hour))
+	mockStandingOrderRepo.On("GetStandingOrderByID", order.ID).Return(order, nil)
+
+	_, msg, err := updateStatus.Execute(order.ID, "delete", "admin", "req-1")
+
+	assert.ErrorIs(t, err, custom_err.ErrValidationFailed)
+	assert.Equal(t, "Invalid action, action must be pause, resume or cancel", msg)
+	mockStandingOrderRepo.AssertNotCalled(t, "UpdateStandingOrder", mock.Anything)
+}
+
+// TestUpdateStandingOrderStatus_Execute_NotFound tests if the standing order does not exist
+func TestUpdateStandingOrderStatus_Execute_NotFound(t *testing.T) {
+	mockStandingOrderRepo := new(mock_repo.MockStandingOrderRepo)
+	updateStatus := NewUpdateStandingOrderStatus(mockStandingOrderRepo, new(mock_repo.MockEventRepo))
+
+	mockStandingOrderRepo.On("GetStandingOrderByID", "so-404").Return(nil, nil)
+
+	_, msg, err := updateStatus.Execute("so-404", StandingOrderActionPause, "admin", "req-1")
+
+	assert.ErrorIs(t, err, custom_err.ErrStandingOrderNotFound)
+	assert.Equal(t, "Standing order not found", msg)
+}
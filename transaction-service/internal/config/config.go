@@ -59,6 +59,11 @@ type Config struct {
 	Observability    ObservabilityCfg       `koanf:"observability" validate:"required"`
 	Recovery         RecoveryConfig         `koanf:"recovery" validate:"required"`
 	Cleanup          CleanupConfig          `koanf:"cleanup" validate:"required"`
+	Worker           WorkerConfig           `koanf:"worker" validate:"required"`
+	StandingOrder    StandingOrderConfig    `koanf:"standing_order" validate:"required"`
+	InterestPosting  InterestPostingConfig  `koanf:"interest_posting" validate:"required"`
+	Batch            BatchConfig            `koanf:"batch" validate:"required"`
+	Fee              FeeConfig              `koanf:"fee"`
 	DB               DBConfig               `koanf:"db" validate:"required"`
 	MessagePublisher MessagePublisherConfig `koanf:"message_publisher" validate:"required"`
 }
@@ -125,6 +130,37 @@ type CleanupConfig struct {
 	StaleThreshold time.Duration `koanf:"stale_threshold"`
 }
 
+// WorkerConfig sizes the pool that processes asynchronous transactions
+type WorkerConfig struct {
+	Count     int `koanf:"count"      validate:"gte=1,lte=256"`
+	QueueSize int `koanf:"queue_size" validate:"gte=1"`
+}
+
+// StandingOrderConfig controls the job that initiates due standing orders
+type StandingOrderConfig struct {
+	Enabled   bool          `koanf:"enabled"`
+	Interval  time.Duration `koanf:"interval"`
+	BatchSize int           `koanf:"batch_size" validate:"gte=1"`
+}
+
+// InterestPostingConfig controls the job that credits the interest postings of the account service
+type InterestPostingConfig struct {
+	Enabled   bool          `koanf:"enabled"`
+	Interval  time.Duration `koanf:"interval"`
+	BatchSize int           `koanf:"batch_size" validate:"gte=1"`
+}
+
+// BatchConfig limits bulk transfer batches
+type BatchConfig struct {
+	Concurrency int `koanf:"concurrency" validate:"gte=1,lte=64"`
+	MaxLines    int `koanf:"max_lines"   validate:"gte=1"`
+}
+
+// FeeConfig sets where transaction fees are credited. Fees are not charged while no income account is set.
+type FeeConfig struct {
+	IncomeAccountID string `koanf:"income_account_id"`
+}
+
 var (
 	global     Config
 	globalOnce sync.Once
@@ -305,6 +341,27 @@ func defaults() map[string]any {
 			"interval":        1 * time.Hour,
 			"stale_threshold": 24 * time.Hour,
 		},
+		"worker": map[string]any{
+			"count":      4,
+			"queue_size": 100,
+		},
+		"standing_order": map[string]any{
+			"enabled":    true,
+			"interval":   1 * time.Minute,
+			"batch_size": 100,
+		},
+		"interest_posting": map[string]any{
+			"enabled":    true,
+			"interval":   10 * time.Minute,
+			"batch_size": 100,
+		},
+		"batch": map[string]any{
+			"concurrency": 4,
+			"max_lines":   1000,
+		},
+		"fee": map[string]any{
+			"income_account_id": "",
+		},
 		"message_publisher": map[string]any{
 			"enabled":       DefaultMessageBrokerMessageEnabled,
 			"broker_addr":   "",
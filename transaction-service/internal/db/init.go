@@ -5,6 +5,7 @@ import (
 	"gorm.io/driver/sqlite"
 	"gorm.io/gorm"
 	"gorm.io/gorm/logger"
+	"strings"
 	"time"
 	"transaction-service/internal/config"
 	"transaction-service/internal/domain/entity"
@@ -23,7 +24,8 @@ func InitDB() (*gorm.DB, error) {
 	)
 
 	db, err := gorm.Open(sqlite.Open(config.Current().DB.DSN), &gorm.Config{
-		Logger: gormLogger,
+		Logger:         gormLogger,
+		TranslateError: true,
 	})
 	if err != nil {
 		return nil, fmt.Errorf("failed to connect to database: %w", err)
@@ -47,9 +49,135 @@ func InitDB() (*gorm.DB, error) {
 }
 
 func runMigrations(db *gorm.DB) error {
-	return db.AutoMigrate(
+	if err := migrateAmountToMinorUnits(db, &entity.Transaction{}, "transactions"); err != nil {
+		return err
+	}
+	if err := migrateAmountToMinorUnits(db, &entity.TransactionSaga{}, "transaction_sagas"); err != nil {
+		return err
+	}
+	if err := dedupeTransactionReferences(db); err != nil {
+		return err
+	}
+
+	// orders stored before occurrences were counted separately ran every occurrence so far
+	backfillOccurrences := db.Migrator().HasTable(&entity.StandingOrder{}) &&
+		!db.Migrator().HasColumn(&entity.StandingOrder{}, "Occurrence")
+	// transactions stored before reversed amounts were tracked had at most one reversal that is not failed
+	backfillReversedAmounts := db.Migrator().HasTable(&entity.Transaction{}) &&
+		!db.Migrator().HasColumn(&entity.Transaction{}, "ReversedAmount")
+
+	err := db.AutoMigrate(
 		&entity.TransactionSaga{},
+		&entity.TransactionSagaStepLog{},
 		&entity.Transaction{},
 		&entity.Event{},
+		&entity.ExchangeRate{},
+		&entity.StandingOrder{},
+		&entity.StandingOrderRun{},
+		&entity.TransactionBatch{},
+		&entity.TransactionBatchLine{},
+		&entity.TransactionLimit{},
+		&entity.FeeRule{},
 	)
+	if err != nil {
+		return err
+	}
+
+	if backfillReversedAmounts {
+		if err = backfillTransactionReversedAmounts(db); err != nil {
+			return err
+		}
+	}
+	if backfillOccurrences {
+		return backfillStandingOrderOccurrences(db)
+	}
+	return nil
+}
+
+// backfillTransactionReversedAmounts sets the reversed amount of stored transactions to the amount of their
+// reversals that are not failed
+func backfillTransactionReversedAmounts(db *gorm.DB) error {
+	err := db.Exec(`UPDATE transactions SET reversed_amount = (
+			SELECT COALESCE(SUM(reversals.amount), 0) FROM transactions reversals
+			WHERE reversals.reversal_of_transaction_id = transactions.id AND reversals.transaction_status <> ?
+		)
+		WHERE reversed_by_transaction_id IS NOT NULL`, entity.TransactionStatusFailed).Error
+	if err != nil {
+		return fmt.Errorf("failed to backfill transaction reversed amounts: %w", err)
+	}
+	return nil
+}
+
+// backfillStandingOrderOccurrences sets the occurrence of stored standing orders to the one their next run is
+// scheduled at, which is past their run count when occurrences were skipped while paused
+func backfillStandingOrderOccurrences(db *gorm.DB) error {
+	var orders []*entity.StandingOrder
+	if err := db.Find(&orders).Error; err != nil {
+		return fmt.Errorf("failed to backfill standing order occurrences: %w", err)
+	}
+
+	for _, order := range orders {
+		occurrence := order.RunCount
+		if order.NextRunAt != nil && order.Frequency != entity.StandingOrderFrequencyOnce {
+			for order.ScheduledAt(occurrence).Before(*order.NextRunAt) {
+				occurrence++
+			}
+		}
+		err := db.Model(&entity.StandingOrder{}).Where("id = ?", order.ID).Update("occurrence", occurrence).Error
+		if err != nil {
+			return fmt.Errorf("failed to backfill standing order occurrences: %w", err)
+		}
+	}
+	return nil
+}
+
+// dedupeTransactionReferences suffixes reference IDs reused by the same requester with the transaction ID,
+// keeping the earliest one intact, so the unique reference index can be created. It is a no-op once the index exists.
+func dedupeTransactionReferences(db *gorm.DB) error {
+	if !db.Migrator().HasTable(&entity.Transaction{}) || db.Migrator().HasIndex(&entity.Transaction{}, "idx_transactions_reference") {
+		return nil
+	}
+
+	err := db.Exec(`UPDATE transactions SET reference_id = reference_id || '#' || id
+		WHERE EXISTS (
+			SELECT 1 FROM transactions earlier
+			WHERE earlier.reference_id = transactions.reference_id
+			AND earlier.created_by = transactions.created_by
+			AND (earlier.created_at < transactions.created_at OR (earlier.created_at = transactions.created_at AND earlier.id < transactions.id))
+		)`).Error
+	if err != nil {
+		return fmt.Errorf("failed to dedupe transaction references: %w", err)
+	}
+	return nil
+}
+
+// migrateAmountToMinorUnits converts amounts stored as floating point (REAL) into integer
+// minor units and changes the column type. It is a no-op on fresh or migrated databases.
+func migrateAmountToMinorUnits(db *gorm.DB, model interface{}, table string) error {
+	if !db.Migrator().HasTable(model) {
+		return nil
+	}
+
+	columnTypes, err := db.Migrator().ColumnTypes(model)
+	if err != nil {
+		return fmt.Errorf("failed to read %s columns: %w", table, err)
+	}
+
+	for _, column := range columnTypes {
+		if column.Name() != "amount" || !strings.EqualFold(column.DatabaseTypeName(), "real") {
+			continue
+		}
+
+		logging.Logger.Info().Str("table", table).Msg("migrating amounts to minor units")
+		err = db.Transaction(func(tx *gorm.DB) error {
+			if err := tx.Exec("UPDATE " + table + " SET amount = CAST(ROUND(amount * 100) AS INTEGER)").Error; err != nil {
+				return err
+			}
+			return tx.Migrator().AlterColumn(model, "Amount")
+		})
+		if err != nil {
+			return fmt.Errorf("failed to migrate %s amounts: %w", table, err)
+		}
+	}
+	return nil
 }
@@ -18,9 +18,23 @@ const (
 	EventTypeTransactionCompleted = "transaction_completed"
 	EventTypeTransactionRollback  = "transaction_rollback"
 	EventTypeTransactionFailed    = "transaction_failed"
+	EventTypeTransactionReversed  = "transaction_reversed"
+	EventTypeExchangeRateUpdated  = "exchange_rate_updated"
+	EventTypeStandingOrderCreated = "standing_order_created"
+	EventTypeStandingOrderUpdated = "standing_order_updated"
+	EventTypeBatchCreated         = "transaction_batch_created"
+	EventTypeBatchFinished        = "transaction_batch_finished"
+	EventTypeLimitUpdated         = "transaction_limit_updated"
+	EventTypeFeeRuleUpdated       = "fee_rule_updated"
+	EventTypeFeeRuleDeleted       = "fee_rule_deleted"
 	EventAggregateTypeCustomer    = "customer"
 	EventAggregateTypeAccount     = "account"
 	EventAggregateTypeTransaction = "transaction"
+	EventAggregateTypeExchange    = "exchange_rate"
+	EventAggregateTypeStanding    = "standing_order"
+	EventAggregateTypeBatch       = "transaction_batch"
+	EventAggregateTypeLimit       = "transaction_limit"
+	EventAggregateTypeFeeRule     = "fee_rule"
 )
 
 type Event struct {
@@ -0,0 +1,69 @@
+package entity
+
+import (
+	"encoding/json"
+	"github.com/google/uuid"
+	"time"
+	custom_err "transaction-service/internal/domain/error"
+	"transaction-service/internal/domain/money"
+)
+
+const (
+	CurrencyUSD = "USD"
+	CurrencyEUR = "EUR"
+	CurrencyBDT = "BDT"
+)
+
+// SupportedCurrencies lists the ISO 4217 currency codes accounts can be held in
+var SupportedCurrencies = []string{CurrencyUSD, CurrencyEUR, CurrencyBDT}
+
+// IsSupportedCurrency checks if the currency code is supported by the bank
+func IsSupportedCurrency(currency string) bool {
+	for _, c := range SupportedCurrencies {
+		if c == currency {
+			return true
+		}
+	}
+	return false
+}
+
+// ExchangeRate is the rate to convert one unit of BaseCurrency into QuoteCurrency
+type ExchangeRate struct {
+	ID            string     `gorm:"primaryKey"`
+	BaseCurrency  string     `gorm:"not null;uniqueIndex:idx_exchange_rate_pair"`
+	QuoteCurrency string     `gorm:"not null;uniqueIndex:idx_exchange_rate_pair"`
+	Rate          money.Rate `gorm:"not null;check:rate > 0"` // scaled by 10^money.RateScale
+	CreatedBy     string     `gorm:"not null"`
+	UpdatedBy     string     `gorm:"null"`
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
+}
+
+func NewExchangeRate(baseCurrency, quoteCurrency string, rate money.Rate, createdBy string) (*ExchangeRate, error) {
+	if !IsSupportedCurrency(baseCurrency) || !IsSupportedCurrency(quoteCurrency) {
+		return nil, custom_err.ErrUnsupportedCurrency
+	}
+	if baseCurrency == quoteCurrency {
+		return nil, custom_err.ErrInvalidExchangeRate
+	}
+	if !rate.IsPositive() {
+		return nil, custom_err.ErrInvalidExchangeRate
+	}
+
+	now := time.Now()
+	return &ExchangeRate{
+		ID:            uuid.New().String(),
+		BaseCurrency:  baseCurrency,
+		QuoteCurrency: quoteCurrency,
+		Rate:          rate,
+		CreatedBy:     createdBy,
+		UpdatedBy:     createdBy,
+		CreatedAt:     now,
+		UpdatedAt:     now,
+	}, nil
+}
+
+func (e *ExchangeRate) ToString() string {
+	jsonData, _ := json.Marshal(&e)
+	return string(jsonData)
+}
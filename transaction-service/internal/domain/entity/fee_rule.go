@@ -0,0 +1,140 @@
+package entity
+
+import (
+	"encoding/json"
+	"github.com/google/uuid"
+	"strings"
+	"time"
+	custom_err "transaction-service/internal/domain/error"
+	"transaction-service/internal/domain/money"
+)
+
+const (
+	FeeTypeFlat       = "flat"       // the same fee for every amount of the band
+	FeeTypePercentage = "percentage" // a percentage of the amount, clamped to the minimum and maximum fee
+)
+
+// FeeRule is the fee charged on debits of a transaction type, optionally of one account type, whose amount falls
+// in the band starting at MinAmount. Fees are in minor units of the source account currency.
+type FeeRule struct {
+	ID              string        `gorm:"primaryKey"`
+	TransactionType string        `gorm:"not null;uniqueIndex:idx_fee_rule_band"`
+	AccountType     string        `gorm:"not null;default:'';uniqueIndex:idx_fee_rule_band"` // empty matches every account type
+	MinAmount       money.Amount  `gorm:"not null;default:0;uniqueIndex:idx_fee_rule_band"`  // inclusive
+	MaxAmount       money.Amount  `gorm:"not null;default:0"`                                // inclusive, zero means no upper bound
+	FeeType         string        `gorm:"not null"`
+	FlatAmount      money.Amount  `gorm:"not null;default:0"`
+	Percentage      money.Percent `gorm:"not null;default:0"`
+	MinFee          money.Amount  `gorm:"not null;default:0"`
+	MaxFee          money.Amount  `gorm:"not null;default:0"` // zero means no cap
+	CreatedBy       string        `gorm:"not null"`
+	UpdatedBy       string        `gorm:"null"`
+	CreatedAt       time.Time
+	UpdatedAt       time.Time
+}
+
+func NewFeeRule(
+	transactionType,
+	accountType string,
+	minAmount,
+	maxAmount money.Amount,
+	feeType string,
+	flatAmount money.Amount,
+	percentage money.Percent,
+	minFee,
+	maxFee money.Amount,
+	createdBy string,
+) (*FeeRule, error) {
+	transactionType = strings.ToLower(strings.TrimSpace(transactionType))
+	accountType = strings.ToLower(strings.TrimSpace(accountType))
+	feeType = strings.ToLower(strings.TrimSpace(feeType))
+
+	if transactionType != TransactionTypeTransfer && transactionType != TransactionTypeWithdrawAmount {
+		return nil, custom_err.ErrInvalidFeeRule
+	}
+	if accountType != "" && accountType != AccountTypeSavings && accountType != AccountTypeCurrent {
+		return nil, custom_err.ErrInvalidFeeRule
+	}
+	if minAmount.IsNegative() || maxAmount.IsNegative() || (maxAmount.IsPositive() && maxAmount < minAmount) {
+		return nil, custom_err.ErrInvalidFeeRule
+	}
+	if flatAmount.IsNegative() || percentage < 0 || minFee.IsNegative() || maxFee.IsNegative() ||
+		(maxFee.IsPositive() && maxFee < minFee) {
+		return nil, custom_err.ErrInvalidFeeRule
+	}
+
+	switch feeType {
+	case FeeTypeFlat:
+		percentage = 0
+	case FeeTypePercentage:
+		flatAmount = money.Zero
+	default:
+		return nil, custom_err.ErrInvalidFeeRule
+	}
+
+	now := time.Now()
+	return &FeeRule{
+		ID:              uuid.New().String(),
+		TransactionType: transactionType,
+		AccountType:     accountType,
+		MinAmount:       minAmount,
+		MaxAmount:       maxAmount,
+		FeeType:         feeType,
+		FlatAmount:      flatAmount,
+		Percentage:      percentage,
+		MinFee:          minFee,
+		MaxFee:          maxFee,
+		CreatedBy:       createdBy,
+		UpdatedBy:       createdBy,
+		CreatedAt:       now,
+		UpdatedAt:       now,
+	}, nil
+}
+
+// Matches reports whether the rule applies to a debit of the account type and amount
+func (r *FeeRule) Matches(accountType string, amount money.Amount) bool {
+	if r.AccountType != "" && r.AccountType != accountType {
+		return false
+	}
+	return amount >= r.MinAmount && (r.MaxAmount == 0 || amount <= r.MaxAmount)
+}
+
+// Calculate returns the fee for the amount, never less than MinFee nor more than MaxFee when it is set
+func (r *FeeRule) Calculate(amount money.Amount) money.Amount {
+	fee := r.FlatAmount
+	if r.FeeType == FeeTypePercentage {
+		fee = r.Percentage.Of(amount)
+	}
+	if fee < r.MinFee {
+		fee = r.MinFee
+	}
+	if r.MaxFee.IsPositive() && fee > r.MaxFee {
+		fee = r.MaxFee
+	}
+	return fee
+}
+
+// MatchFeeRule picks the rule of a debit among the rules of its transaction type: a rule of the account type
+// wins over one for every account type, then the band with the highest start. It returns nil if none matches.
+func MatchFeeRule(rules []*FeeRule, accountType string, amount money.Amount) *FeeRule {
+	var match *FeeRule
+	for _, rule := range rules {
+		if !rule.Matches(accountType, amount) {
+			continue
+		}
+		if match == nil {
+			match = rule
+			continue
+		}
+		ruleSpecific, matchSpecific := rule.AccountType != "", match.AccountType != ""
+		if (ruleSpecific && !matchSpecific) || (ruleSpecific == matchSpecific && rule.MinAmount > match.MinAmount) {
+			match = rule
+		}
+	}
+	return match
+}
+
+func (r *FeeRule) ToString() string {
+	jsonData, _ := json.Marshal(&r)
+	return string(jsonData)
+}
@@ -0,0 +1,225 @@
+package entity
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/google/uuid"
+	"time"
+	custom_err "transaction-service/internal/domain/error"
+	"transaction-service/internal/domain/money"
+)
+
+const (
+	StandingOrderFrequencyOnce    = "once"
+	StandingOrderFrequencyDaily   = "daily"
+	StandingOrderFrequencyWeekly  = "weekly"
+	StandingOrderFrequencyMonthly = "monthly"
+
+	StandingOrderStatusActive    = "active"
+	StandingOrderStatusPaused    = "paused"
+	StandingOrderStatusCancelled = "cancelled"
+	StandingOrderStatusCompleted = "completed" // no further run is scheduled
+
+	StandingOrderRunStatusSuccessful = "successful"
+	StandingOrderRunStatusFailed     = "failed"
+)
+
+// StandingOrder is a transfer that is initiated on a schedule until its end date or run count is reached
+type StandingOrder struct {
+	ID                   string       `gorm:"primaryKey"`
+	SourceAccountID      string       `gorm:"not null;index"`
+	DestinationAccountID string       `gorm:"not null"`
+	Amount               money.Amount `gorm:"not null;check:amount > 0"` // minor units, in the source account currency
+	Frequency            string       `gorm:"not null"`
+	StartAt              time.Time    `gorm:"not null"`
+	EndAt                *time.Time   `gorm:"null"`
+	MaxRuns              int          `gorm:"not null;default:0"` // 0 means no limit
+	RunCount             int          `gorm:"not null;default:0"`
+	Occurrence           int          `gorm:"not null;default:0"` // zero-based occurrence NextRunAt is scheduled at, counting the ones skipped while paused
+	NextRunAt            *time.Time   `gorm:"index"`              // nil once no further run is scheduled
+	LastRunAt            *time.Time   `gorm:"null"`
+	Status               string       `gorm:"not null;index"`
+	Description          string       `gorm:"null"`
+	CreatedBy            string       `gorm:"not null"`
+	CreatedByRole        string       `gorm:"null"` // role of the requester, its transaction limits apply to the runs
+	UpdatedBy            string       `gorm:"null"`
+	CreatedAt            time.Time
+	UpdatedAt            time.Time
+	Version              int `gorm:"default:1"`
+}
+
+// StandingOrderRun records the outcome of one scheduled run of a standing order
+type StandingOrderRun struct {
+	ID              string    `gorm:"primaryKey"`
+	StandingOrderID string    `gorm:"not null;uniqueIndex:idx_standing_order_run"`
+	RunNumber       int       `gorm:"not null;uniqueIndex:idx_standing_order_run"`
+	ScheduledAt     time.Time `gorm:"not null"`
+	TransactionID   *string   `gorm:"null"`
+	Status          string    `gorm:"not null"`
+	Message         string    `gorm:"null"`
+	CreatedAt       time.Time
+}
+
+func NewStandingOrder(sourceAccountID, destinationAccountID string, amount money.Amount, frequency string, startAt time.Time, endAt *time.Time, maxRuns int, description, createdBy string) (*StandingOrder, error) {
+	if sourceAccountID == "" || destinationAccountID == "" || sourceAccountID == destinationAccountID {
+		return nil, custom_err.ErrInvalidRequest
+	}
+	if !amount.IsPositive() {
+		return nil, custom_err.ErrInvalidAmount
+	}
+	if !IsValidStandingOrderFrequency(frequency) || maxRuns < 0 || (endAt != nil && endAt.Before(startAt)) {
+		return nil, custom_err.ErrInvalidSchedule
+	}
+	if frequency == StandingOrderFrequencyOnce {
+		maxRuns = 1
+	}
+
+	now := time.Now()
+	nextRunAt := startAt
+	return &StandingOrder{
+		ID:                   uuid.New().String(),
+		SourceAccountID:      sourceAccountID,
+		DestinationAccountID: destinationAccountID,
+		Amount:               amount,
+		Frequency:            frequency,
+		StartAt:              startAt,
+		EndAt:                endAt,
+		MaxRuns:              maxRuns,
+		NextRunAt:            &nextRunAt,
+		Status:               StandingOrderStatusActive,
+		Description:          description,
+		CreatedBy:            createdBy,
+		UpdatedBy:            createdBy,
+		CreatedAt:            now,
+		UpdatedAt:            now,
+		Version:              1,
+	}, nil
+}
+
+func NewStandingOrderRun(order *StandingOrder, transactionID *string, status, message string) *StandingOrderRun {
+	return &StandingOrderRun{
+		ID:              uuid.New().String(),
+		StandingOrderID: order.ID,
+		RunNumber:       order.RunCount + 1,
+		ScheduledAt:     *order.NextRunAt,
+		TransactionID:   transactionID,
+		Status:          status,
+		Message:         message,
+		CreatedAt:       time.Now(),
+	}
+}
+
+func IsValidStandingOrderFrequency(frequency string) bool {
+	switch frequency {
+	case StandingOrderFrequencyOnce, StandingOrderFrequencyDaily, StandingOrderFrequencyWeekly, StandingOrderFrequencyMonthly:
+		return true
+	}
+	return false
+}
+
+// ScheduledAt returns the time of the zero-based occurrence. Occurrences are derived from StartAt
+// rather than the previous run so that delays never drift the schedule; monthly runs keep the start
+// day and fall back to the last day of shorter months.
+func (o *StandingOrder) ScheduledAt(occurrence int) time.Time {
+	switch o.Frequency {
+	case StandingOrderFrequencyDaily:
+		return o.StartAt.AddDate(0, 0, occurrence)
+	case StandingOrderFrequencyWeekly:
+		return o.StartAt.AddDate(0, 0, 7*occurrence)
+	case StandingOrderFrequencyMonthly:
+		return addMonthsClamped(o.StartAt, occurrence)
+	}
+	return o.StartAt
+}
+
+// IsDue reports whether the order is active and its next run is not in the future
+func (o *StandingOrder) IsDue(now time.Time) bool {
+	return o.Status == StandingOrderStatusActive && o.NextRunAt != nil && !o.NextRunAt.After(now)
+}
+
+// RunReference is the transaction reference of a run, making a re-fired run idempotent
+func (o *StandingOrder) RunReference(runNumber int) string {
+	return fmt.Sprintf("standing-order:%s:%d", o.ID, runNumber)
+}
+
+// AdvanceSchedule counts the run that was due and moves NextRunAt to the following occurrence,
+// completing the order when its run count or end date is reached
+func (o *StandingOrder) AdvanceSchedule(ranAt time.Time) {
+	o.RunCount++
+	o.Occurrence++
+	o.LastRunAt = &ranAt
+	o.UpdatedAt = time.Now()
+	o.scheduleFrom(o.Occurrence)
+}
+
+// Pause stops an active order from running until it is resumed
+func (o *StandingOrder) Pause(requester string) error {
+	if o.Status != StandingOrderStatusActive {
+		return custom_err.ErrInvalidStandingOrderStatus
+	}
+	o.Status = StandingOrderStatusPaused
+	o.touch(requester)
+	return nil
+}
+
+// Resume reactivates a paused order. Recurring occurrences that fell due while it was paused are
+// skipped; a one-off order that fell due runs on the next job pass.
+func (o *StandingOrder) Resume(now time.Time, requester string) error {
+	if o.Status != StandingOrderStatusPaused {
+		return custom_err.ErrInvalidStandingOrderStatus
+	}
+	o.Status = StandingOrderStatusActive
+	o.touch(requester)
+
+	if o.NextRunAt != nil {
+		for o.ScheduledAt(o.Occurrence).Before(now) && o.Frequency != StandingOrderFrequencyOnce {
+			o.Occurrence++
+		}
+	}
+	o.scheduleFrom(o.Occurrence)
+	return nil
+}
+
+// Cancel stops the order permanently
+func (o *StandingOrder) Cancel(requester string) error {
+	if o.Status == StandingOrderStatusCancelled || o.Status == StandingOrderStatusCompleted {
+		return custom_err.ErrInvalidStandingOrderStatus
+	}
+	o.Status = StandingOrderStatusCancelled
+	o.NextRunAt = nil
+	o.touch(requester)
+	return nil
+}
+
+func (o *StandingOrder) scheduleFrom(occurrence int) {
+	next := o.ScheduledAt(occurrence)
+	if (o.MaxRuns > 0 && o.RunCount >= o.MaxRuns) ||
+		(o.Frequency == StandingOrderFrequencyOnce && o.RunCount > 0) ||
+		(o.EndAt != nil && next.After(*o.EndAt)) {
+		o.NextRunAt = nil
+		o.Status = StandingOrderStatusCompleted
+		return
+	}
+	o.NextRunAt = &next
+}
+
+func (o *StandingOrder) touch(requester string) {
+	o.UpdatedBy = requester
+	o.UpdatedAt = time.Now()
+}
+
+func (o *StandingOrder) ToString() string {
+	jsonData, _ := json.Marshal(&o)
+	return string(jsonData)
+}
+
+// addMonthsClamped adds months keeping the day of month, clamped to the last day of the target month
+func addMonthsClamped(t time.Time, months int) time.Time {
+	firstOfMonth := time.Date(t.Year(), t.Month()+time.Month(months), 1, t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), t.Location())
+	lastDay := firstOfMonth.AddDate(0, 1, -1).Day()
+	day := t.Day()
+	if day > lastDay {
+		day = lastDay
+	}
+	return firstOfMonth.AddDate(0, 0, day-1)
+}
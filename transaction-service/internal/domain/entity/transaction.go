@@ -2,12 +2,17 @@ package entity
 
 import (
 	"encoding/json"
+	"fmt"
 	"github.com/google/uuid"
+	"math/big"
 	"time"
+	custom_err "transaction-service/internal/domain/error"
+	"transaction-service/internal/domain/money"
 )
 
 const (
 	TransactionStatusPending    = "pending"
+	TransactionStatusProcessing = "processing" // if a worker claimed the queued transaction
 	TransactionStatusSuccessful = "successful" // if amounts are transferred
 	TransactionStatusCompleted  = "completed"  // if accounts are unlocked
 	TransactionStatusFailed     = "failed"
@@ -17,29 +22,46 @@ const (
 	TransactionTypeWithdrawFull   = "withdraw_full"
 	TransactionTypeWithdrawAmount = "withdraw_amount"
 	TransactionTypeAddAmount      = "add_amount"
+	TransactionTypeInterestCredit = "interest_credit" // system credit of the interest posted by the account service
+
+	// InterestRequester is recorded as the creator of interest credits
+	InterestRequester = "system"
 )
 
 type Transaction struct {
-	ID                           string     `gorm:"primaryKey"`
-	SourceAccountCustomerID      string     `gorm:"not null;index"`
-	SourceAccountID              string     `gorm:"not null;index"`
-	DestinationAccountID         *string    `gorm:"index"`
-	DestinationAccountCustomerID *string    `gorm:"index"`
-	Amount                       float64    `gorm:"not null;check:amount >= 0"`
-	Type                         string     `gorm:"not null;index"`
-	TransactionStatus            string     `gorm:"not null;index"`
-	ReferenceID                  string     `gorm:"index"`
-	TimeoutAt                    time.Time  `gorm:"index"`
-	Version                      int        `gorm:"default:1"`
-	LastRetryAt                  *time.Time `gorm:"null"`
-	RetryCount                   int        `gorm:"default:0"`
-	ErrorReason                  string     `gorm:"null"`
-	CreatedBy                    string     `gorm:"not null"`
-	CreatedAt                    time.Time
+	ID                           string       `gorm:"primaryKey;index:idx_transactions_created_id,priority:2"`
+	SourceAccountCustomerID      string       `gorm:"not null;index"`
+	SourceAccountID              string       `gorm:"not null;index"`
+	DestinationAccountID         *string      `gorm:"index"`
+	DestinationAccountCustomerID *string      `gorm:"index"`
+	Amount                       money.Amount `gorm:"not null;check:amount >= 0"` // minor units, in SourceCurrency
+	SourceCurrency               string       `gorm:"not null;default:'USD'"`     // ISO 4217 code
+	DestinationCurrency          *string      `gorm:"null"`
+	DestinationAmount            money.Amount `gorm:"not null;default:0"` // minor units credited, in DestinationCurrency
+	ExchangeRate                 money.Rate   `gorm:"not null;default:0"` // applied SourceCurrency->DestinationCurrency rate
+	Type                         string       `gorm:"not null;index"`
+	TransactionStatus            string       `gorm:"not null;index"`
+	ReferenceID                  string       `gorm:"uniqueIndex:idx_transactions_reference"` // unique per requester
+	TimeoutAt                    time.Time    `gorm:"index"`
+	Version                      int          `gorm:"default:1"`
+	LastRetryAt                  *time.Time   `gorm:"null"`
+	RetryCount                   int          `gorm:"default:0"`
+	ErrorReason                  string       `gorm:"null"`
+	ReversalOfTransactionID      *string      `gorm:"index"`              // set on a reversal, the transaction it reverses
+	ReversedByTransactionID      *string      `gorm:"uniqueIndex"`        // set on the original, its latest reversal that is not failed
+	ReversedAmount               money.Amount `gorm:"not null;default:0"` // minor units the reversals not failed move back, in the currency of ReversibleAmount
+	ReversalReason               string       `gorm:"null"`
+	HoldID                       *string      `gorm:"index"`              // set on a capture, the hold of the source account it settles
+	InterestPostingID            *string      `gorm:"index"`              // set on an interest credit, the posting it credits
+	FeeAmount                    money.Amount `gorm:"not null;default:0"` // minor units debited on top of Amount, in SourceCurrency; on a reversal the fee refunded to the original source
+	FeeRuleID                    *string      `gorm:"index"`              // the fee rule the fee was calculated with
+	FeeAccountID                 *string      `gorm:"null"`               // the bank income account credited with the fee, or debited with a refund
+	CreatedBy                    string       `gorm:"not null;uniqueIndex:idx_transactions_reference"`
+	CreatedAt                    time.Time    `gorm:"index:idx_transactions_created_id,priority:1"` // keyset pagination order
 	UpdatedAt                    time.Time
 }
 
-func NewTransaction(sourceAccountID string, destinationAccountID *string, amount float64, transactionType, referenceID, createdBy string) (*Transaction, error) {
+func NewTransaction(sourceAccountID string, destinationAccountID *string, amount money.Amount, transactionType, referenceID, createdBy string) (*Transaction, error) {
 	now := time.Now()
 	return &Transaction{
 		ID:                   uuid.New().String(),
@@ -65,10 +87,197 @@ func (t *Transaction) GetAccountsToLock() []string {
 	return accounts
 }
 
+// HasFee reports whether the transaction charges a fee into a bank income account, or refunds one on a reversal
+func (t *Transaction) HasFee() bool {
+	return t.FeeAmount.IsPositive() && t.FeeAccountID != nil
+}
+
+// ChargedFee is the fee debited from the source account on top of the amount, a reversal refunds its fee instead
+func (t *Transaction) ChargedFee() money.Amount {
+	if !t.HasFee() || t.IsReversal() {
+		return money.Zero
+	}
+	return t.FeeAmount
+}
+
+// ApplyFee charges the fee of the rule into the income account on top of the amount
+func (t *Transaction) ApplyFee(rule *FeeRule, fee money.Amount, feeAccountID string) {
+	ruleID := rule.ID
+	t.FeeAmount = fee
+	t.FeeRuleID = &ruleID
+	t.FeeAccountID = &feeAccountID
+}
+
 func (t *Transaction) RequiresDestinationAccount() bool {
 	return t.Type == TransactionTypeTransfer
 }
 
+// IsQueued reports whether the transaction waits for a worker, it has no timeout until a worker claims it
+func (t *Transaction) IsQueued() bool {
+	return t.TransactionStatus == TransactionStatusPending && t.TimeoutAt.IsZero()
+}
+
+// IsReversal reports whether the transaction reverses another transaction
+func (t *Transaction) IsReversal() bool {
+	return t.ReversalOfTransactionID != nil
+}
+
+// IsHoldCapture reports whether the transaction settles a hold on the source account
+func (t *Transaction) IsHoldCapture() bool {
+	return t.HoldID != nil
+}
+
+// IsInterestCredit reports whether the transaction credits an interest posting of the account service
+func (t *Transaction) IsInterestCredit() bool {
+	return t.Type == TransactionTypeInterestCredit
+}
+
+// ReversibleAmount is the most a reversal may move back, in the currency of the account the reversal debits.
+// A full withdrawal only records its amount once it ran, so reversals read it from the account journal.
+func (t *Transaction) ReversibleAmount() money.Amount {
+	if t.Type == TransactionTypeTransfer && t.DestinationAmount.IsPositive() {
+		return t.DestinationAmount
+	}
+	return t.Amount
+}
+
+// RefundedFee is the share of the fee the next reversal of amount refunds, in proportion to the reversible amount.
+// Shares are rounded down on the amount reversed so far, so the reversal using up the reversible amount refunds
+// the rest of the fee.
+func (t *Transaction) RefundedFee(amount money.Amount) money.Amount {
+	if !t.HasFee() {
+		return money.Zero
+	}
+	return t.reversalShare(t.FeeAmount, amount, t.ReversibleAmount())
+}
+
+// reversalShare is the part of value the next reversal of amount moves back, the share of the amount reversed
+// with it less the share of the amount reversed before
+func (t *Transaction) reversalShare(value, amount, whole money.Amount) money.Amount {
+	return share(value, t.ReversedAmount.Add(amount), whole).Sub(share(value, t.ReversedAmount, whole))
+}
+
+// share is the part of whole in proportion to value, rounded down to the minor unit; all of value once part
+// reaches whole
+func share(value, part, whole money.Amount) money.Amount {
+	if part >= whole {
+		return value
+	}
+	product := new(big.Int).Mul(big.NewInt(value.Minor()), big.NewInt(part.Minor()))
+	return money.FromMinor(product.Quo(product, big.NewInt(whole.Minor())).Int64())
+}
+
+// NewReversal creates the transaction moving amount back after the amount reversed so far: a transfer is sent back
+// from its destination, a withdrawal is added back and an addition is withdrawn again. A transfer credits its
+// source the share of the debited amount at the rate of the original, so its reversals together return exactly
+// what was debited. The fee share of amount is refunded from the income account to the account the fee was
+// charged from.
+func (t *Transaction) NewReversal(amount money.Amount, reason, createdBy string) (*Transaction, error) {
+	sourceAccountID := t.SourceAccountID
+	var destinationAccountID *string
+	var transactionType string
+
+	switch t.Type {
+	case TransactionTypeTransfer:
+		if t.DestinationAccountID == nil {
+			return nil, custom_err.ErrMissingDestinationAccount
+		}
+		originalSourceAccountID := t.SourceAccountID
+		sourceAccountID = *t.DestinationAccountID
+		destinationAccountID = &originalSourceAccountID
+		transactionType = TransactionTypeTransfer
+	case TransactionTypeWithdrawFull, TransactionTypeWithdrawAmount:
+		transactionType = TransactionTypeAddAmount
+	case TransactionTypeAddAmount, TransactionTypeInterestCredit:
+		transactionType = TransactionTypeWithdrawAmount
+	default:
+		return nil, custom_err.ErrInvalidTransactionType
+	}
+
+	reversal, err := NewTransaction(sourceAccountID, destinationAccountID, amount, transactionType, "", createdBy)
+	if err != nil {
+		return nil, err
+	}
+	// the reversal ID keeps the reference unique, so a failed reversal can be attempted again
+	reversal.ReferenceID = fmt.Sprintf("reversal:%s:%s", t.ID, reversal.ID)
+	originalID := t.ID
+	reversal.ReversalOfTransactionID = &originalID
+	reversal.ReversalReason = reason
+	if t.Type == TransactionTypeTransfer && t.DestinationAmount.IsPositive() {
+		credited := t.reversalShare(t.Amount, amount, t.DestinationAmount)
+		if !credited.IsPositive() {
+			return nil, fmt.Errorf("%w: converted amount is too small", custom_err.ErrInvalidAmount)
+		}
+		rate, err := t.ExchangeRate.Inverse()
+		if err != nil {
+			return nil, err
+		}
+		reversal.DestinationAmount = credited
+		reversal.ExchangeRate = rate
+	}
+	if refund := t.RefundedFee(amount); refund.IsPositive() {
+		feeAccountID := *t.FeeAccountID
+		reversal.FeeAmount = refund
+		reversal.FeeAccountID = &feeAccountID
+	}
+	return reversal, nil
+}
+
+// NewHoldCapture creates the transaction settling a hold on the source account: a withdrawal, or a transfer
+// when the captured funds go to a destination account
+func NewHoldCapture(holdID, sourceAccountID string, destinationAccountID *string, amount money.Amount, createdBy string) (*Transaction, error) {
+	transactionType := TransactionTypeWithdrawAmount
+	if destinationAccountID != nil {
+		transactionType = TransactionTypeTransfer
+	}
+
+	capture, err := NewTransaction(sourceAccountID, destinationAccountID, amount, transactionType, "", createdBy)
+	if err != nil {
+		return nil, err
+	}
+	// the capture ID keeps the reference unique, so a failed capture can be attempted again
+	capture.ReferenceID = fmt.Sprintf("hold-capture:%s:%s", holdID, capture.ID)
+	capture.HoldID = &holdID
+	return capture, nil
+}
+
+// NewInterestCredit creates the system transaction crediting an interest posting to its account
+func NewInterestCredit(postingID, accountID string, amount money.Amount) (*Transaction, error) {
+	credit, err := NewTransaction(accountID, nil, amount, TransactionTypeInterestCredit, "", InterestRequester)
+	if err != nil {
+		return nil, err
+	}
+	// the credit ID keeps the reference unique, so a failed credit can be attempted again
+	credit.ReferenceID = fmt.Sprintf("interest:%s:%s", postingID, credit.ID)
+	credit.InterestPostingID = &postingID
+	return credit, nil
+}
+
+// NewClosurePayout creates the transfer paying the remaining balance of an account that is being closed out
+// to the payout account
+func NewClosurePayout(accountID, payoutAccountID string, amount money.Amount, createdBy string) (*Transaction, error) {
+	payout, err := NewTransaction(accountID, &payoutAccountID, amount, TransactionTypeTransfer, "", createdBy)
+	if err != nil {
+		return nil, err
+	}
+	// the payout ID keeps the reference unique, so a failed closure can be attempted again
+	payout.ReferenceID = fmt.Sprintf("account-closure:%s:%s", accountID, payout.ID)
+	return payout, nil
+}
+
+// MatchesRequest checks if a repeated initiation request carries the same payload as the transaction. A full
+// withdrawal is requested without an amount and records the withdrawn one, so its amount is not compared.
+func (t *Transaction) MatchesRequest(sourceAccountID string, destinationAccountID *string, amount money.Amount, transactionType string) bool {
+	if t.SourceAccountID != sourceAccountID || t.Type != transactionType ||
+		(t.Type != TransactionTypeWithdrawFull && t.Amount != amount) {
+		return false
+	}
+	if t.DestinationAccountID == nil || destinationAccountID == nil {
+		return t.DestinationAccountID == nil && destinationAccountID == nil
+	}
+	return *t.DestinationAccountID == *destinationAccountID
+}
+
 func (e *Transaction) ToString() string {
 	jsonData, _ := json.Marshal(&e)
 	return string(jsonData)
@@ -0,0 +1,146 @@
+package entity
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/google/uuid"
+	"time"
+	"transaction-service/internal/domain/money"
+)
+
+const (
+	TransactionBatchModeAllOrNothing = "all_or_nothing" // a failed line rolls back every line that succeeded
+	TransactionBatchModeBestEffort   = "best_effort"    // lines succeed or fail on their own
+
+	TransactionBatchStatusPending            = "pending"
+	TransactionBatchStatusProcessing         = "processing"
+	TransactionBatchStatusCompleted          = "completed"           // every line succeeded
+	TransactionBatchStatusPartiallyCompleted = "partially_completed" // best effort batch with failed lines
+	TransactionBatchStatusFailed             = "failed"              // no line moved money or the batch was rolled back
+
+	TransactionBatchLineStatusPending    = "pending"
+	TransactionBatchLineStatusSuccessful = "successful"
+	TransactionBatchLineStatusFailed     = "failed"
+	TransactionBatchLineStatusSkipped    = "skipped"  // not run because an all-or-nothing batch failed
+	TransactionBatchLineStatusReversed   = "reversed" // rolled back because an all-or-nothing batch failed
+)
+
+// TransactionBatch is a set of transfers from one source account, e.g. a payroll file
+type TransactionBatch struct {
+	ID              string       `gorm:"primaryKey"`
+	SourceAccountID string       `gorm:"not null;index"`
+	Mode            string       `gorm:"not null"`
+	Status          string       `gorm:"not null;index"`
+	ReferenceID     string       `gorm:"uniqueIndex:idx_transaction_batches_reference"` // unique per requester
+	LineCount       int          `gorm:"not null"`
+	SuccessfulCount int          `gorm:"not null;default:0"`
+	FailedCount     int          `gorm:"not null;default:0"`
+	TotalAmount     money.Amount `gorm:"not null"` // minor units, in the source account currency
+	ErrorReason     string       `gorm:"null"`
+	CreatedBy       string       `gorm:"not null;uniqueIndex:idx_transaction_batches_reference"`
+	CreatedByRole   string       `gorm:"null"` // role of the requester, its transaction limits apply to the lines
+	CreatedAt       time.Time
+	UpdatedAt       time.Time
+	CompletedAt     *time.Time `gorm:"null"`
+}
+
+// TransactionBatchLine is one transfer instruction of a batch and its outcome
+type TransactionBatchLine struct {
+	ID                    string       `gorm:"primaryKey"`
+	BatchID               string       `gorm:"not null;uniqueIndex:idx_transaction_batch_line"`
+	LineNumber            int          `gorm:"not null;uniqueIndex:idx_transaction_batch_line"` // 1-based position in the file
+	DestinationAccountID  string       `gorm:"not null"`
+	Amount                money.Amount `gorm:"not null;check:amount > 0"`
+	ReferenceID           string       `gorm:"null"` // optional reference of the line in the employer's file
+	TransactionID         *string      `gorm:"null"`
+	ReversalTransactionID *string      `gorm:"null"`
+	Status                string       `gorm:"not null"`
+	ErrorReason           string       `gorm:"null"`
+	UpdatedAt             time.Time
+}
+
+func NewTransactionBatch(sourceAccountID, mode, referenceID, createdBy string) *TransactionBatch {
+	now := time.Now()
+	return &TransactionBatch{
+		ID:              uuid.New().String(),
+		SourceAccountID: sourceAccountID,
+		Mode:            mode,
+		Status:          TransactionBatchStatusPending,
+		ReferenceID:     referenceID,
+		CreatedBy:       createdBy,
+		CreatedAt:       now,
+		UpdatedAt:       now,
+	}
+}
+
+// AddLine appends a pending line and adds its amount to the batch total
+func (b *TransactionBatch) AddLine(destinationAccountID string, amount money.Amount, referenceID string) *TransactionBatchLine {
+	b.LineCount++
+	b.TotalAmount = b.TotalAmount.Add(amount)
+	return &TransactionBatchLine{
+		ID:                   uuid.New().String(),
+		BatchID:              b.ID,
+		LineNumber:           b.LineCount,
+		DestinationAccountID: destinationAccountID,
+		Amount:               amount,
+		ReferenceID:          referenceID,
+		Status:               TransactionBatchLineStatusPending,
+		UpdatedAt:            b.CreatedAt,
+	}
+}
+
+func IsValidTransactionBatchMode(mode string) bool {
+	return mode == TransactionBatchModeAllOrNothing || mode == TransactionBatchModeBestEffort
+}
+
+// IsFinished reports whether the batch reached a final status
+func (b *TransactionBatch) IsFinished() bool {
+	return b.Status != TransactionBatchStatusPending && b.Status != TransactionBatchStatusProcessing
+}
+
+// LineReference is the transaction reference of a line, making a re-run line idempotent
+func (b *TransactionBatch) LineReference(lineNumber int) string {
+	return fmt.Sprintf("batch:%s:%d", b.ID, lineNumber)
+}
+
+// Finish counts the line outcomes and sets the final status of the batch
+func (b *TransactionBatch) Finish(lines []*TransactionBatchLine, errorReason string) {
+	b.SuccessfulCount = 0
+	b.FailedCount = 0
+	for _, line := range lines {
+		switch line.Status {
+		case TransactionBatchLineStatusSuccessful:
+			b.SuccessfulCount++
+		case TransactionBatchLineStatusFailed:
+			b.FailedCount++
+		}
+	}
+
+	switch {
+	case b.SuccessfulCount == b.LineCount:
+		b.Status = TransactionBatchStatusCompleted
+	case b.SuccessfulCount == 0 || b.Mode == TransactionBatchModeAllOrNothing:
+		b.Status = TransactionBatchStatusFailed
+	default:
+		b.Status = TransactionBatchStatusPartiallyCompleted
+	}
+
+	now := time.Now()
+	b.ErrorReason = errorReason
+	b.UpdatedAt = now
+	b.CompletedAt = &now
+}
+
+func (b *TransactionBatch) ToString() string {
+	jsonData, _ := json.Marshal(&b)
+	return string(jsonData)
+}
+
+func (l *TransactionBatchLine) SetOutcome(status string, transactionID *string, errorReason string) {
+	l.Status = status
+	if transactionID != nil {
+		l.TransactionID = transactionID
+	}
+	l.ErrorReason = errorReason
+	l.UpdatedAt = time.Now()
+}
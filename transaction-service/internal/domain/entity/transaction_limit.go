@@ -0,0 +1,131 @@
+package entity
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/google/uuid"
+	"strings"
+	"time"
+	custom_err "transaction-service/internal/domain/error"
+	"transaction-service/internal/domain/money"
+)
+
+const (
+	TransactionLimitScopeAccountType = "account_type" // subject is an account type, e.g. savings
+	TransactionLimitScopeAccount     = "account"      // subject is an account ID, replaces the limit of its account type
+	TransactionLimitScopeRole        = "role"         // subject is an employee role, applies to each employee on their own
+
+	AccountTypeSavings = "savings"
+	AccountTypeCurrent = "current"
+)
+
+// TransactionLimit caps the debits of an account or of an employee. A zero amount or count means no limit.
+type TransactionLimit struct {
+	ID                   string       `gorm:"primaryKey"`
+	Scope                string       `gorm:"not null;uniqueIndex:idx_transaction_limit_subject"`
+	Subject              string       `gorm:"not null;uniqueIndex:idx_transaction_limit_subject"`
+	PerTransactionAmount money.Amount `gorm:"not null;default:0"` // minor units, in the source account currency
+	DailyAmount          money.Amount `gorm:"not null;default:0"`
+	DailyCount           int          `gorm:"not null;default:0"`
+	MonthlyAmount        money.Amount `gorm:"not null;default:0"`
+	MonthlyCount         int          `gorm:"not null;default:0"`
+	CreatedBy            string       `gorm:"not null"`
+	UpdatedBy            string       `gorm:"null"`
+	CreatedAt            time.Time
+	UpdatedAt            time.Time
+}
+
+// TransactionUsage is the amount and number of debits made in a period
+type TransactionUsage struct {
+	Amount money.Amount
+	Count  int
+}
+
+func NewTransactionLimit(
+	scope,
+	subject string,
+	perTransactionAmount,
+	dailyAmount money.Amount,
+	dailyCount int,
+	monthlyAmount money.Amount,
+	monthlyCount int,
+	createdBy string,
+) (*TransactionLimit, error) {
+	scope = strings.ToLower(strings.TrimSpace(scope))
+	subject = strings.TrimSpace(subject)
+	if scope != TransactionLimitScopeAccount {
+		subject = strings.ToLower(subject)
+	}
+
+	switch scope {
+	case TransactionLimitScopeAccountType:
+		if subject != AccountTypeSavings && subject != AccountTypeCurrent {
+			return nil, custom_err.ErrInvalidTransactionLimit
+		}
+	case TransactionLimitScopeAccount, TransactionLimitScopeRole:
+		if subject == "" {
+			return nil, custom_err.ErrInvalidTransactionLimit
+		}
+	default:
+		return nil, custom_err.ErrInvalidTransactionLimit
+	}
+
+	if perTransactionAmount.IsNegative() || dailyAmount.IsNegative() || monthlyAmount.IsNegative() ||
+		dailyCount < 0 || monthlyCount < 0 {
+		return nil, custom_err.ErrInvalidTransactionLimit
+	}
+
+	now := time.Now()
+	return &TransactionLimit{
+		ID:                   uuid.New().String(),
+		Scope:                scope,
+		Subject:              subject,
+		PerTransactionAmount: perTransactionAmount,
+		DailyAmount:          dailyAmount,
+		DailyCount:           dailyCount,
+		MonthlyAmount:        monthlyAmount,
+		MonthlyCount:         monthlyCount,
+		CreatedBy:            createdBy,
+		UpdatedBy:            createdBy,
+		CreatedAt:            now,
+		UpdatedAt:            now,
+	}, nil
+}
+
+// Check returns the limit the amount would breach on top of the daily and monthly usage, or an empty string
+func (l *TransactionLimit) Check(amount money.Amount, daily, monthly TransactionUsage) string {
+	switch {
+	case l.PerTransactionAmount > 0 && amount > l.PerTransactionAmount:
+		return fmt.Sprintf("per transaction amount limit of %s", l.PerTransactionAmount)
+	case l.DailyAmount > 0 && daily.Amount.Add(amount) > l.DailyAmount:
+		return fmt.Sprintf("daily amount limit of %s, %s already used", l.DailyAmount, daily.Amount)
+	case l.DailyCount > 0 && daily.Count >= l.DailyCount:
+		return fmt.Sprintf("daily limit of %d transactions", l.DailyCount)
+	case l.MonthlyAmount > 0 && monthly.Amount.Add(amount) > l.MonthlyAmount:
+		return fmt.Sprintf("monthly amount limit of %s, %s already used", l.MonthlyAmount, monthly.Amount)
+	case l.MonthlyCount > 0 && monthly.Count >= l.MonthlyCount:
+		return fmt.Sprintf("monthly limit of %d transactions", l.MonthlyCount)
+	}
+	return ""
+}
+
+// HasPeriodLimits reports whether the limit needs the usage of the day or month to be checked
+func (l *TransactionLimit) HasPeriodLimits() bool {
+	return l.DailyAmount > 0 || l.DailyCount > 0 || l.MonthlyAmount > 0 || l.MonthlyCount > 0
+}
+
+// Describe names what the limit applies to, e.g. "savings accounts"
+func (l *TransactionLimit) Describe() string {
+	switch l.Scope {
+	case TransactionLimitScopeAccountType:
+		return l.Subject + " accounts"
+	case TransactionLimitScopeAccount:
+		return "account " + l.Subject
+	}
+	return "role " + l.Subject
+}
+
+func (l *TransactionLimit) ToString() string {
+	jsonData, _ := json.Marshal(&l)
+	return string(jsonData)
+}
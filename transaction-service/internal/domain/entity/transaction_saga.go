@@ -3,6 +3,7 @@ package entity
 import (
 	"github.com/google/uuid"
 	"time"
+	"transaction-service/internal/domain/money"
 )
 
 const (
@@ -34,28 +35,35 @@ const (
 )
 
 type TransactionSaga struct {
-	ID                   string     `gorm:"primaryKey"`
-	TransactionID        string     `gorm:"not null;uniqueIndex"`
-	CurrentState         string     `gorm:"not null;index"`
-	CurrentStep          string     `gorm:"not null"`
-	SourceAccountID      string     `gorm:"not null"`
-	DestinationAccountID *string    `gorm:"null"`
-	Amount               float64    `gorm:"not null"`
-	TransactionType      string     `gorm:"not null"`
-	ReferenceID          string     `gorm:"not null"`
-	CompensationRequired bool       `gorm:"default:false"`
-	CompensationReason   string     `gorm:"null"`
-	RetryCount           int        `gorm:"default:0"`
-	MaxRetries           int        `gorm:"default:3"`
-	LastRetryAt          *time.Time `gorm:"null"`
-	NextRetryAt          *time.Time `gorm:"null"`
-	TimeoutAt            time.Time  `gorm:"index"`
+	ID                   string       `gorm:"primaryKey"`
+	TransactionID        string       `gorm:"not null;uniqueIndex"`
+	CurrentState         string       `gorm:"not null;index"`
+	CurrentStep          string       `gorm:"not null"`
+	SourceAccountID      string       `gorm:"not null"`
+	DestinationAccountID *string      `gorm:"null"`
+	Amount               money.Amount `gorm:"not null"` // minor units
+	DestinationAmount    money.Amount `gorm:"not null;default:0"`
+	ExchangeRate         money.Rate   `gorm:"not null;default:0"`
+	TransactionType      string       `gorm:"not null"`
+	ReferenceID          string       `gorm:"not null"`
+	HoldID               *string      `gorm:"null"`               // hold of the source account the transaction captures
+	InterestPostingID    *string      `gorm:"null"`               // interest posting of the account service the transaction credits
+	FeeAmount            money.Amount `gorm:"not null;default:0"` // debited from the source on top of Amount
+	FeeAccountID         *string      `gorm:"null"`               // bank income account credited with the fee
+	FeeRefund            bool         `gorm:"default:false"`      // a reversal refunds the fee from the income account instead
+	CompensationRequired bool         `gorm:"default:false"`
+	CompensationReason   string       `gorm:"null"`
+	RetryCount           int          `gorm:"default:0"`
+	MaxRetries           int          `gorm:"default:3"`
+	LastRetryAt          *time.Time   `gorm:"null"`
+	NextRetryAt          *time.Time   `gorm:"null"`
+	TimeoutAt            time.Time    `gorm:"index"`
 	CreatedAt            time.Time
 	UpdatedAt            time.Time
 	Version              int `gorm:"default:1"`
 }
 
-func NewTransactionSaga(transactionID, sourceAccountID string, destinationAccountID *string, amount float64, transactionType, referenceID string) *TransactionSaga {
+func NewTransactionSaga(transactionID, sourceAccountID string, destinationAccountID *string, amount money.Amount, transactionType, referenceID string) *TransactionSaga {
 	now := time.Now()
 	return &TransactionSaga{
 		ID:                   uuid.New().String(),
@@ -102,9 +110,51 @@ func (s *TransactionSaga) GetAccountsToLock() []string {
 	if s.DestinationAccountID != nil {
 		accounts = append(accounts, *s.DestinationAccountID)
 	}
+	if s.HasFee() {
+		accounts = append(accounts, *s.FeeAccountID)
+	}
 	return accounts
 }
 
+// HasFee reports whether the saga credits a fee to a bank income account, or refunds one from it
+func (s *TransactionSaga) HasFee() bool {
+	return s.FeeAmount.IsPositive() && s.FeeAccountID != nil
+}
+
+// ChargedFee is the fee debited from the source account on top of the amount
+func (s *TransactionSaga) ChargedFee() money.Amount {
+	if !s.HasFee() || s.FeeRefund {
+		return money.Zero
+	}
+	return s.FeeAmount
+}
+
+// RefundedFee is the fee a reversal refunds from the income account to the account the fee was charged from
+func (s *TransactionSaga) RefundedFee() money.Amount {
+	if !s.HasFee() || !s.FeeRefund {
+		return money.Zero
+	}
+	return s.FeeAmount
+}
+
 func (s *TransactionSaga) RequiresDestinationAccount() bool {
 	return s.TransactionType == TransactionTypeTransfer
 }
+
+// IsCompensating reports whether the saga had already started rolling back
+func (s *TransactionSaga) IsCompensating() bool {
+	switch s.CurrentStep {
+	case TransactionSagaStepCompensate,
+		TransactionSagaStepCompensateFundRollback,
+		TransactionSagaStepCompensateUnlockAccount,
+		TransactionSagaStepCompensateComplete:
+		return true
+	}
+	switch s.CurrentState {
+	case TransactionSagaStateCompensating,
+		TransactionSagaStateCompensateFailed,
+		TransactionSagaStateCompensated:
+		return true
+	}
+	return false
+}
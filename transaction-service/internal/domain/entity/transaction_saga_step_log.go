@@ -0,0 +1,34 @@
+package entity
+
+import (
+	"github.com/google/uuid"
+	"time"
+)
+
+// TransactionSagaStepLog records every step and state a saga passes through, including
+// retries and recovery attempts, so an interrupted saga can be traced after a restart
+type TransactionSagaStepLog struct {
+	ID            string    `gorm:"primaryKey"`
+	SagaID        string    `gorm:"not null;index"`
+	TransactionID string    `gorm:"not null;index"`
+	Step          string    `gorm:"not null"`
+	State         string    `gorm:"not null"`
+	Attempt       int       `gorm:"not null;default:0"` // saga retry count when the step was recorded
+	Detail        string    `gorm:"null"`
+	SagaVersion   int       `gorm:"not null"`
+	CreatedAt     time.Time `gorm:"index"`
+}
+
+func NewTransactionSagaStepLog(saga *TransactionSaga) *TransactionSagaStepLog {
+	return &TransactionSagaStepLog{
+		ID:            uuid.New().String(),
+		SagaID:        saga.ID,
+		TransactionID: saga.TransactionID,
+		Step:          saga.CurrentStep,
+		State:         saga.CurrentState,
+		Attempt:       saga.RetryCount,
+		Detail:        saga.CompensationReason,
+		SagaVersion:   saga.Version,
+		CreatedAt:     time.Now(),
+	}
+}
@@ -46,4 +46,29 @@ var (
 	ErrAccountUnlockingFailed             = errors.New("account unlocking failed")
 	ErrProcessTransactionFailed           = errors.New("process transaction failed")
 	ErrProcessTransactionCompletionFailed = errors.New("transaction completion failed")
+	ErrUnsupportedCurrency                = errors.New("unsupported currency")
+	ErrInvalidExchangeRate                = errors.New("invalid exchange rate")
+	ErrExchangeRateNotFound               = errors.New("exchange rate not found")
+	ErrTransactionQueueFull               = errors.New("transaction queue is full")
+	ErrTransactionAlreadyClaimed          = errors.New("transaction already claimed")
+	ErrSagaInterrupted                    = errors.New("saga interrupted")
+	ErrJournalStatusUnavailable           = errors.New("transaction journal status unavailable")
+	ErrInvalidSchedule                    = errors.New("invalid standing order schedule")
+	ErrStandingOrderNotFound              = errors.New("standing order not found")
+	ErrInvalidStandingOrderStatus         = errors.New("invalid standing order status change")
+	ErrTransactionNotReversible           = errors.New("transaction cannot be reversed")
+	ErrTransactionAlreadyReversed         = errors.New("transaction already reversed")
+	ErrInvalidTransactionBatch            = errors.New("invalid transaction batch")
+	ErrTransactionBatchNotFound           = errors.New("transaction batch not found")
+	ErrInvalidTransactionLimit            = errors.New("invalid transaction limit")
+	ErrTransactionLimitExceeded           = errors.New("transaction limit exceeded")
+	ErrHoldNotFound                       = errors.New("hold not found")
+	ErrHoldNotActive                      = errors.New("hold is not active")
+	ErrCurrencyMismatch                   = errors.New("currency mismatch")
+	ErrInvalidFeeRule                     = errors.New("invalid fee rule")
+	ErrFeeRuleNotFound                    = errors.New("fee rule not found")
+	ErrInvalidPageCursor                  = errors.New("invalid page cursor")
+	ErrAccountNotClosable                 = errors.New("account cannot be closed")
+	ErrAccountCloseFailed                 = errors.New("account close failed")
+	ErrInterestCreditFailed               = errors.New("interest credit failed")
 )
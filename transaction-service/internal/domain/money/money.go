@@ -0,0 +1,166 @@
+package money
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Scale is the number of decimal places (minor units) kept for an amount.
+const Scale = 2
+
+const minorUnitsPerMajor = 100
+
+var (
+	ErrInvalidAmount = errors.New("invalid money amount")
+	ErrPrecision     = errors.New("money amount has more than 2 decimal places")
+)
+
+// Amount is a fixed-point monetary value held as integer minor units (e.g. cents),
+// so that arithmetic on balances never drifts the way binary floating point does.
+type Amount int64
+
+// Zero is the zero amount.
+const Zero Amount = 0
+
+// FromMinor creates an Amount from minor units.
+func FromMinor(minor int64) Amount {
+	return Amount(minor)
+}
+
+// Parse converts a decimal string (e.g. "100", "100.5", "-0.25") into an Amount.
+// It rejects values with more than Scale decimal places instead of rounding them.
+func Parse(s string) (Amount, error) {
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return 0, ErrInvalidAmount
+	}
+
+	negative := false
+	switch s[0] {
+	case '-':
+		negative = true
+		s = s[1:]
+	case '+':
+		s = s[1:]
+	}
+
+	whole, frac, hasPoint := strings.Cut(s, ".")
+	if whole == "" && (!hasPoint || frac == "") {
+		return 0, ErrInvalidAmount
+	}
+	if len(frac) > Scale {
+		if strings.TrimRight(frac[Scale:], "0") != "" {
+			return 0, ErrPrecision
+		}
+		frac = frac[:Scale]
+	}
+	if !isDigits(whole) || !isDigits(frac) {
+		return 0, ErrInvalidAmount
+	}
+
+	frac += strings.Repeat("0", Scale-len(frac))
+	if whole == "" {
+		whole = "0"
+	}
+
+	major, err := strconv.ParseInt(whole, 10, 64)
+	if err != nil {
+		return 0, ErrInvalidAmount
+	}
+	minor, _ := strconv.ParseInt(frac, 10, 64)
+	// major*minorUnitsPerMajor + minor must not wrap around
+	if major > (1<<63-1-minor)/minorUnitsPerMajor {
+		return 0, ErrInvalidAmount
+	}
+
+	value := major*minorUnitsPerMajor + minor
+	if negative {
+		value = -value
+	}
+	return Amount(value), nil
+}
+
+// MustParse is like Parse but panics if the value cannot be parsed.
+func MustParse(s string) Amount {
+	a, err := Parse(s)
+	if err != nil {
+		panic(fmt.Sprintf("money: cannot parse %q: %v", s, err))
+	}
+	return a
+}
+
+// Minor returns the amount in minor units.
+func (a Amount) Minor() int64 {
+	return int64(a)
+}
+
+// IsZero reports whether the amount is zero.
+func (a Amount) IsZero() bool {
+	return a == 0
+}
+
+// IsNegative reports whether the amount is below zero.
+func (a Amount) IsNegative() bool {
+	return a < 0
+}
+
+// IsPositive reports whether the amount is above zero.
+func (a Amount) IsPositive() bool {
+	return a > 0
+}
+
+// Add returns a + b.
+func (a Amount) Add(b Amount) Amount {
+	return a + b
+}
+
+// Sub returns a - b.
+func (a Amount) Sub(b Amount) Amount {
+	return a - b
+}
+
+// String formats the amount as a decimal string with exactly Scale decimal places.
+func (a Amount) String() string {
+	v := int64(a)
+	sign := ""
+	if v < 0 {
+		sign = "-"
+		v = -v
+	}
+	return fmt.Sprintf("%s%d.%02d", sign, v/minorUnitsPerMajor, v%minorUnitsPerMajor)
+}
+
+// MarshalJSON encodes the amount as a decimal string to keep it exact for consumers.
+func (a Amount) MarshalJSON() ([]byte, error) {
+	return json.Marshal(a.String())
+}
+
+// UnmarshalJSON accepts both a decimal string and a bare JSON number.
+func (a *Amount) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		var n json.Number
+		if err := json.Unmarshal(data, &n); err != nil {
+			return ErrInvalidAmount
+		}
+		s = n.String()
+	}
+	parsed, err := Parse(s)
+	if err != nil {
+		return err
+	}
+	*a = parsed
+	return nil
+}
+
+func isDigits(s string) bool {
+	for _, r := range s {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+	return true
+}
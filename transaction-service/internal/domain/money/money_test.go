@@ -0,0 +1,191 @@
+package money
+
+import (
+	"encoding/json"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+// TestParse tests parsing decimal strings into minor units
+func TestParse(t *testing.T) {
+	testCases := []struct {
+		input    string
+		expected Amount
+		err      error
+	}{
+		{"100", 10000, nil},
+		{"100.5", 10050, nil},
+		{"0.10", 10, nil},
+		{".25", 25, nil},
+		{"-500.25", -50025, nil},
+		{"+1.00", 100, nil},
+		{"1.230", 123, nil},
+		{"1.234", 0, ErrPrecision},
+		{"", 0, ErrInvalidAmount},
+		{"abc", 0, ErrInvalidAmount},
+		{"1e3", 0, ErrInvalidAmount},
+		{"99999999999999999999", 0, ErrInvalidAmount},
+		{"92233720368547758.07", 1<<63 - 1, nil},
+		{"-92233720368547758.07", -(1<<63 - 1), nil},
+		{"92233720368547758.08", 0, ErrInvalidAmount},
+		{"92233720368547759", 0, ErrInvalidAmount},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.input, func(t *testing.T) {
+			amount, err := Parse(tc.input)
+			assert.ErrorIs(t, err, tc.err)
+			assert.Equal(t, tc.expected, amount)
+		})
+	}
+}
+
+// TestAmount_String tests formatting amounts with fixed decimal places
+func TestAmount_String(t *testing.T) {
+	assert.Equal(t, "0.00", Zero.String())
+	assert.Equal(t, "1234.56", FromMinor(123456).String())
+	assert.Equal(t, "-0.05", FromMinor(-5).String())
+}
+
+// TestAmount_Arithmetic tests that repeated additions stay exact
+func TestAmount_Arithmetic(t *testing.T) {
+	total := Zero
+	for i := 0; i < 10; i++ {
+		total = total.Add(MustParse("0.10"))
+	}
+	assert.Equal(t, MustParse("1.00"), total)
+	assert.Equal(t, MustParse("0.70"), total.Sub(MustParse("0.30")))
+}
+
+// TestAmount_JSON tests json encoding and decoding from strings and numbers
+func TestAmount_JSON(t *testing.T) {
+	data, err := json.Marshal(MustParse("10.5"))
+	assert.NoError(t, err)
+	assert.Equal(t, `"10.50"`, string(data))
+
+	var fromString, fromNumber Amount
+	assert.NoError(t, json.Unmarshal([]byte(`"42.42"`), &fromString))
+	assert.NoError(t, json.Unmarshal([]byte(`42.42`), &fromNumber))
+	assert.Equal(t, FromMinor(4242), fromString)
+	assert.Equal(t, fromString, fromNumber)
+
+	var invalid Amount
+	assert.Error(t, json.Unmarshal([]byte(`true`), &invalid))
+}
+
+// TestParseRate tests parsing exchange rates
+func TestParseRate(t *testing.T) {
+	rate, err := ParseRate("109.5")
+	assert.NoError(t, err)
+	assert.Equal(t, "109.500000", rate.String())
+
+	rate, err = ParseRate("0.009132")
+	assert.NoError(t, err)
+	assert.Equal(t, Rate(9132), rate)
+
+	_, err = ParseRate("1.0000001")
+	assert.ErrorIs(t, err, ErrPrecision)
+
+	_, err = ParseRate("-1")
+	assert.ErrorIs(t, err, ErrInvalidRate)
+
+	_, err = ParseRate("")
+	assert.ErrorIs(t, err, ErrInvalidRate)
+}
+
+// TestRate_Convert tests conversion with rounding to minor units
+func TestRate_Convert(t *testing.T) {
+	testCases := []struct {
+		amount   string
+		rate     string
+		expected string
+	}{
+		{"100.00", "1", "100.00"},
+		{"100.00", "109.5", "10950.00"},
+		{"10.00", "0.921234", "9.21"},
+		{"0.01", "0.5", "0.01"},
+		{"0.01", "0.49", "0.00"},
+		{"12345678.90", "0.009132", "112740.74"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.amount+"x"+tc.rate, func(t *testing.T) {
+			converted, err := MustParseRate(tc.rate).Convert(MustParse(tc.amount))
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expected, converted.String())
+		})
+	}
+}
+
+// TestRate_Inverse tests inverting rates with rounding to RateScale decimal places
+func TestRate_Inverse(t *testing.T) {
+	testCases := []struct {
+		rate     string
+		expected string
+	}{
+		{"1", "1.000000"},
+		{"0.8", "1.250000"},
+		{"109.5", "0.009132"},
+		{"3", "0.333333"},
+		{"1.5", "0.666667"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.rate, func(t *testing.T) {
+			inverse, err := MustParseRate(tc.rate).Inverse()
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expected, inverse.String())
+		})
+	}
+
+	_, err := Rate(0).Inverse()
+	assert.ErrorIs(t, err, ErrInvalidRate)
+}
+
+// TestParsePercent tests parsing percentages into fixed-point values
+func TestParsePercent(t *testing.T) {
+	testCases := []struct {
+		input    string
+		expected Percent
+		err      error
+	}{
+		{"1.25", 12500, nil},
+		{"0.5", 5000, nil},
+		{"0", 0, nil},
+		{"100", 1000000, nil},
+		{"2.50000", 25000, nil},
+		{"100.01", 0, ErrInvalidPercent},
+		{"1.23456", 0, ErrInvalidPercent},
+		{"-1", 0, ErrInvalidPercent},
+		{"", 0, ErrInvalidPercent},
+	}
+
+	for _, tc := range testCases {
+		percent, err := ParsePercent(tc.input)
+		assert.ErrorIs(t, err, tc.err, tc.input)
+		assert.Equal(t, tc.expected, percent, tc.input)
+	}
+	assert.Equal(t, "1.2500", MustParsePercent("1.25").String())
+}
+
+// TestPercent_Of tests taking a percentage of an amount with rounding to minor units
+func TestPercent_Of(t *testing.T) {
+	testCases := []struct {
+		percent  string
+		amount   string
+		expected string
+	}{
+		{"1", "100.00", "1.00"},
+		{"1.5", "10.00", "0.15"},
+		{"0.5", "0.99", "0.00"},
+		{"0.5", "1.00", "0.01"},
+		{"2.75", "1234.56", "33.95"},
+		{"1", "-100.00", "0.00"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.percent+"of"+tc.amount, func(t *testing.T) {
+			assert.Equal(t, tc.expected, MustParsePercent(tc.percent).Of(MustParse(tc.amount)).String())
+		})
+	}
+}
@@ -0,0 +1,91 @@
+package money
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+)
+
+// PercentScale is the number of decimal places kept for a percentage.
+const PercentScale = 4
+
+const percentUnitsPerPercent = 10_000
+
+// maxPercent caps percentages at 100%
+const maxPercent = 100 * percentUnitsPerPercent
+
+// ErrInvalidPercent is returned when a percentage cannot be parsed or is out of range.
+var ErrInvalidPercent = errors.New("invalid percentage")
+
+// Percent is a fixed-point percentage with PercentScale decimal places, e.g. 1.25% is stored as 12500.
+type Percent int64
+
+// ParsePercent converts a decimal percentage (e.g. "1.25", "0.5") into a Percent.
+// Percentages must be between 0 and 100.
+func ParsePercent(s string) (Percent, error) {
+	s = strings.TrimSpace(s)
+	whole, frac, _ := strings.Cut(s, ".")
+	if s == "" || (whole == "" && frac == "") || !isDigits(whole) || !isDigits(frac) {
+		return 0, ErrInvalidPercent
+	}
+	if len(frac) > PercentScale {
+		if strings.TrimRight(frac[PercentScale:], "0") != "" {
+			return 0, ErrInvalidPercent
+		}
+		frac = frac[:PercentScale]
+	}
+	frac += strings.Repeat("0", PercentScale-len(frac))
+	if whole == "" {
+		whole = "0"
+	}
+
+	percent, err := strconv.ParseInt(whole, 10, 64)
+	if err != nil || percent > 100 {
+		return 0, ErrInvalidPercent
+	}
+	fraction, _ := strconv.ParseInt(frac, 10, 64)
+
+	p := Percent(percent*percentUnitsPerPercent + fraction)
+	if p > maxPercent {
+		return 0, ErrInvalidPercent
+	}
+	return p, nil
+}
+
+// MustParsePercent is like ParsePercent but panics if the value cannot be parsed.
+func MustParsePercent(s string) Percent {
+	p, err := ParsePercent(s)
+	if err != nil {
+		panic(fmt.Sprintf("money: cannot parse percentage %q: %v", s, err))
+	}
+	return p
+}
+
+// Of is the percentage of an amount, rounded half up to the nearest minor unit. Amounts at or below zero give zero.
+func (p Percent) Of(a Amount) Amount {
+	if a <= 0 || p <= 0 {
+		return Zero
+	}
+	// a * p / (100 * 10^4), rounded half up
+	divisor := big.NewInt(100 * percentUnitsPerPercent)
+	product := new(big.Int).Mul(big.NewInt(int64(a)), big.NewInt(int64(p)))
+	product.Add(product, new(big.Int).Quo(divisor, big.NewInt(2)))
+	result := product.Quo(product, divisor)
+	if !result.IsInt64() {
+		return Zero
+	}
+	return Amount(result.Int64())
+}
+
+// String formats the percentage with exactly PercentScale decimal places.
+func (p Percent) String() string {
+	return fmt.Sprintf("%d.%04d", int64(p)/percentUnitsPerPercent, int64(p)%percentUnitsPerPercent)
+}
+
+// MarshalJSON encodes the percentage as a decimal string.
+func (p Percent) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.String())
+}
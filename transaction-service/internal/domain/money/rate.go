@@ -0,0 +1,120 @@
+package money
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+)
+
+// RateScale is the number of decimal places kept for an exchange rate.
+const RateScale = 6
+
+const rateUnitsPerOne = 1_000_000
+
+// ErrInvalidRate is returned when an exchange rate cannot be parsed.
+var ErrInvalidRate = errors.New("invalid exchange rate")
+
+// Rate is a fixed-point exchange rate with RateScale decimal places,
+// e.g. 1 USD = 109.500000 BDT is stored as 109500000.
+type Rate int64
+
+// OneRate is the identity rate used for same-currency transfers.
+const OneRate Rate = rateUnitsPerOne
+
+// ParseRate converts a decimal string (e.g. "109.5", "0.009132") into a Rate.
+func ParseRate(s string) (Rate, error) {
+	s = strings.TrimSpace(s)
+	whole, frac, _ := strings.Cut(s, ".")
+	if s == "" || (whole == "" && frac == "") || !isDigits(whole) || !isDigits(frac) {
+		return 0, ErrInvalidRate
+	}
+	if len(frac) > RateScale {
+		if strings.TrimRight(frac[RateScale:], "0") != "" {
+			return 0, ErrPrecision
+		}
+		frac = frac[:RateScale]
+	}
+	frac += strings.Repeat("0", RateScale-len(frac))
+	if whole == "" {
+		whole = "0"
+	}
+
+	major, err := strconv.ParseInt(whole, 10, 64)
+	if err != nil || major > (1<<63-1)/rateUnitsPerOne {
+		return 0, ErrInvalidRate
+	}
+	minor, _ := strconv.ParseInt(frac, 10, 64)
+	return Rate(major*rateUnitsPerOne + minor), nil
+}
+
+// MustParseRate is like ParseRate but panics if the value cannot be parsed.
+func MustParseRate(s string) Rate {
+	r, err := ParseRate(s)
+	if err != nil {
+		panic(fmt.Sprintf("money: cannot parse rate %q: %v", s, err))
+	}
+	return r
+}
+
+// IsPositive reports whether the rate is above zero.
+func (r Rate) IsPositive() bool {
+	return r > 0
+}
+
+// Convert applies the rate to an amount, rounding half away from zero to the nearest minor unit.
+func (r Rate) Convert(a Amount) (Amount, error) {
+	product := new(big.Int).Mul(big.NewInt(int64(a)), big.NewInt(int64(r)))
+	quotient, remainder := new(big.Int).QuoRem(product, big.NewInt(rateUnitsPerOne), new(big.Int))
+
+	// round half away from zero
+	if new(big.Int).Abs(remainder).Cmp(big.NewInt(rateUnitsPerOne/2)) >= 0 {
+		if product.Sign() < 0 {
+			quotient.Sub(quotient, big.NewInt(1))
+		} else {
+			quotient.Add(quotient, big.NewInt(1))
+		}
+	}
+
+	if !quotient.IsInt64() {
+		return 0, ErrInvalidAmount
+	}
+	return Amount(quotient.Int64()), nil
+}
+
+// Inverse is the rate of the opposite direction, rounding half away from zero to RateScale decimal places.
+func (r Rate) Inverse() (Rate, error) {
+	if !r.IsPositive() {
+		return 0, ErrInvalidRate
+	}
+	units := big.NewInt(rateUnitsPerOne)
+	product := new(big.Int).Mul(units, units)
+	quotient, remainder := new(big.Int).QuoRem(product, big.NewInt(int64(r)), new(big.Int))
+
+	// round half away from zero
+	if new(big.Int).Mul(remainder, big.NewInt(2)).Cmp(big.NewInt(int64(r))) >= 0 {
+		quotient.Add(quotient, big.NewInt(1))
+	}
+	if !quotient.IsInt64() || quotient.Sign() == 0 {
+		return 0, ErrInvalidRate
+	}
+	return Rate(quotient.Int64()), nil
+}
+
+// String formats the rate with exactly RateScale decimal places.
+func (r Rate) String() string {
+	v := int64(r)
+	sign := ""
+	if v < 0 {
+		sign = "-"
+		v = -v
+	}
+	return fmt.Sprintf("%s%d.%06d", sign, v/rateUnitsPerOne, v%rateUnitsPerOne)
+}
+
+// MarshalJSON encodes the rate as a decimal string.
+func (r Rate) MarshalJSON() ([]byte, error) {
+	return json.Marshal(r.String())
+}
@@ -7,8 +7,26 @@ import (
 
 type TransactionHandlerService struct {
 	prototx.UnimplementedTransactionServiceServer
-	InitTransactionService       *apptx.InitTransaction
-	GetTransactionHistoryService *apptx.GetTransactionHistory
+	InitTransactionService           *apptx.InitTransaction
+	GetTransactionService            *apptx.GetTransaction
+	GetTransactionHistoryService     *apptx.GetTransactionHistory
+	ExportTransactionHistoryService  *apptx.ExportTransactionHistory
+	ReverseTransactionService        *apptx.ReverseTransaction
+	CaptureHoldService               *apptx.CaptureHold
+	CloseAccountService              *apptx.CloseAccount
+	SetExchangeRateService           *apptx.SetExchangeRate
+	ListExchangeRatesService         *apptx.ListExchangeRates
+	CreateStandingOrderService       *apptx.CreateStandingOrder
+	GetStandingOrderService          *apptx.GetStandingOrder
+	ListStandingOrdersService        *apptx.ListStandingOrders
+	UpdateStandingOrderStatusService *apptx.UpdateStandingOrderStatus
+	CreateTransactionBatchService    *apptx.CreateTransactionBatch
+	GetTransactionBatchService       *apptx.GetTransactionBatch
+	SetTransactionLimitService       *apptx.SetTransactionLimit
+	ListTransactionLimitsService     *apptx.ListTransactionLimits
+	SetFeeRuleService                *apptx.SetFeeRule
+	ListFeeRulesService              *apptx.ListFeeRules
+	DeleteFeeRuleService             *apptx.DeleteFeeRule
 }
 
 // NewAggregatedHandler creates a new AccountHandler.
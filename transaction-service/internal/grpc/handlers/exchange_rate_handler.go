@@ -0,0 +1,69 @@
+package handlers
+
+import (
+	"context"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	prototx "transaction-service/api/protogen/txservice/proto"
+	"transaction-service/internal/domain/entity"
+)
+
+func (h *TransactionHandlerService) SetExchangeRate(ctx context.Context, req *prototx.SetExchangeRateRequest) (*prototx.SetExchangeRateResponse, error) {
+	exchangeRate, message, err := h.SetExchangeRateService.Execute(
+		req.BaseCurrency,
+		req.QuoteCurrency,
+		req.Rate,
+		req.GetMetadata().GetRequester(),
+		req.GetMetadata().GetRequestId(),
+	)
+	if err != nil {
+		return &prototx.SetExchangeRateResponse{
+			Response: &prototx.Response{
+				Message: message,
+				Success: false,
+			},
+		}, nil
+	}
+
+	return &prototx.SetExchangeRateResponse{
+		ExchangeRate: toProtoExchangeRate(exchangeRate),
+		Response: &prototx.Response{
+			Message: message,
+			Success: true,
+		},
+	}, nil
+}
+
+func (h *TransactionHandlerService) ListExchangeRates(ctx context.Context, req *prototx.ListExchangeRatesRequest) (*prototx.ListExchangeRatesResponse, error) {
+	exchangeRates, err := h.ListExchangeRatesService.Execute(req.GetMetadata().GetRequester(), req.GetMetadata().GetRequestId())
+	if err != nil {
+		return &prototx.ListExchangeRatesResponse{
+			Response: &prototx.Response{
+				Message: "Failed to get exchange rates",
+				Success: false,
+			},
+		}, nil
+	}
+
+	protoRates := make([]*prototx.ExchangeRate, len(exchangeRates))
+	for i, exchangeRate := range exchangeRates {
+		protoRates[i] = toProtoExchangeRate(exchangeRate)
+	}
+
+	return &prototx.ListExchangeRatesResponse{
+		ExchangeRates: protoRates,
+		Response: &prototx.Response{
+			Message: "Exchange rates",
+			Success: true,
+		},
+	}, nil
+}
+
+func toProtoExchangeRate(exchangeRate *entity.ExchangeRate) *prototx.ExchangeRate {
+	return &prototx.ExchangeRate{
+		BaseCurrency:  exchangeRate.BaseCurrency,
+		QuoteCurrency: exchangeRate.QuoteCurrency,
+		Rate:          exchangeRate.Rate.String(),
+		UpdatedBy:     exchangeRate.UpdatedBy,
+		UpdatedAt:     timestamppb.New(exchangeRate.UpdatedAt),
+	}
+}
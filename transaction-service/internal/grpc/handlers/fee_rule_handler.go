@@ -0,0 +1,95 @@
+package handlers
+
+import (
+	"context"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	prototx "transaction-service/api/protogen/txservice/proto"
+	apptx "transaction-service/internal/app"
+	"transaction-service/internal/domain/entity"
+)
+
+func (h *TransactionHandlerService) SetFeeRule(ctx context.Context, req *prototx.SetFeeRuleRequest) (*prototx.SetFeeRuleResponse, error) {
+	rule, message, err := h.SetFeeRuleService.Execute(
+		apptx.FeeRuleInput{
+			TransactionType: req.TransactionType,
+			AccountType:     req.AccountType,
+			MinAmount:       req.MinAmount,
+			MaxAmount:       req.MaxAmount,
+			FeeType:         req.FeeType,
+			FlatAmount:      req.FlatAmount,
+			Percentage:      req.Percentage,
+			MinFee:          req.MinFee,
+			MaxFee:          req.MaxFee,
+		},
+		req.GetMetadata().GetRequester(),
+		req.GetMetadata().GetRequestId(),
+	)
+	if err != nil {
+		return &prototx.SetFeeRuleResponse{
+			Response: &prototx.Response{
+				Message: message,
+				Success: false,
+			},
+		}, nil
+	}
+
+	return &prototx.SetFeeRuleResponse{
+		Rule: toProtoFeeRule(rule),
+		Response: &prototx.Response{
+			Message: message,
+			Success: true,
+		},
+	}, nil
+}
+
+func (h *TransactionHandlerService) ListFeeRules(ctx context.Context, req *prototx.ListFeeRulesRequest) (*prototx.ListFeeRulesResponse, error) {
+	rules, err := h.ListFeeRulesService.Execute(req.GetMetadata().GetRequester(), req.GetMetadata().GetRequestId())
+	if err != nil {
+		return &prototx.ListFeeRulesResponse{
+			Response: &prototx.Response{
+				Message: "Failed to get fee rules",
+				Success: false,
+			},
+		}, nil
+	}
+
+	protoRules := make([]*prototx.FeeRule, len(rules))
+	for i, rule := range rules {
+		protoRules[i] = toProtoFeeRule(rule)
+	}
+
+	return &prototx.ListFeeRulesResponse{
+		Rules: protoRules,
+		Response: &prototx.Response{
+			Message: "Fee rules",
+			Success: true,
+		},
+	}, nil
+}
+
+func (h *TransactionHandlerService) DeleteFeeRule(ctx context.Context, req *prototx.DeleteFeeRuleRequest) (*prototx.DeleteFeeRuleResponse, error) {
+	message, err := h.DeleteFeeRuleService.Execute(req.Id, req.GetMetadata().GetRequester(), req.GetMetadata().GetRequestId())
+	return &prototx.DeleteFeeRuleResponse{
+		Response: &prototx.Response{
+			Message: message,
+			Success: err == nil,
+		},
+	}, nil
+}
+
+func toProtoFeeRule(rule *entity.FeeRule) *prototx.FeeRule {
+	return &prototx.FeeRule{
+		Id:              rule.ID,
+		TransactionType: rule.TransactionType,
+		AccountType:     rule.AccountType,
+		MinAmount:       rule.MinAmount.String(),
+		MaxAmount:       rule.MaxAmount.String(),
+		FeeType:         rule.FeeType,
+		FlatAmount:      rule.FlatAmount.String(),
+		Percentage:      rule.Percentage.String(),
+		MinFee:          rule.MinFee.String(),
+		MaxFee:          rule.MaxFee.String(),
+		UpdatedBy:       rule.UpdatedBy,
+		UpdatedAt:       timestamppb.New(rule.UpdatedAt),
+	}
+}
@@ -0,0 +1,184 @@
+package handlers
+
+import (
+	"context"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"time"
+	prototx "transaction-service/api/protogen/txservice/proto"
+	apptx "transaction-service/internal/app"
+	"transaction-service/internal/domain/entity"
+	"transaction-service/internal/domain/money"
+)
+
+func (h *TransactionHandlerService) CreateStandingOrder(ctx context.Context, req *prototx.CreateStandingOrderRequest) (*prototx.CreateStandingOrderResponse, error) {
+	amount, err := money.Parse(req.Amount)
+	if err != nil {
+		return &prototx.CreateStandingOrderResponse{
+			Response: &prototx.Response{
+				Message: "Invalid amount for standing order",
+				Success: false,
+			},
+		}, nil
+	}
+
+	var startAt time.Time
+	if req.StartAt != nil {
+		startAt = req.StartAt.AsTime()
+	}
+	var endAt *time.Time
+	if req.EndAt != nil {
+		ea := req.EndAt.AsTime()
+		endAt = &ea
+	}
+
+	order, message, err := h.CreateStandingOrderService.Execute(
+		apptx.WithRequesterRole(ctx, req.GetMetadata().GetRequesterRole()),
+		req.SourceAccountId,
+		req.DestinationAccountId,
+		amount,
+		req.Frequency,
+		startAt,
+		endAt,
+		int(req.MaxRuns),
+		req.Description,
+		req.GetMetadata().GetRequester(),
+		req.GetMetadata().GetRequestId(),
+	)
+	if err != nil {
+		return &prototx.CreateStandingOrderResponse{
+			Response: &prototx.Response{
+				Message: message,
+				Success: false,
+			},
+		}, nil
+	}
+
+	return &prototx.CreateStandingOrderResponse{
+		StandingOrder: toProtoStandingOrder(order),
+		Response: &prototx.Response{
+			Message: message,
+			Success: true,
+		},
+	}, nil
+}
+
+func (h *TransactionHandlerService) GetStandingOrder(ctx context.Context, req *prototx.GetStandingOrderRequest) (*prototx.GetStandingOrderResponse, error) {
+	order, runs, message, err := h.GetStandingOrderService.Execute(req.StandingOrderId, req.GetMetadata().GetRequester(), req.GetMetadata().GetRequestId())
+	if err != nil {
+		return &prototx.GetStandingOrderResponse{
+			Response: &prototx.Response{
+				Message: message,
+				Success: false,
+			},
+		}, nil
+	}
+
+	protoRuns := make([]*prototx.StandingOrderRun, len(runs))
+	for i, run := range runs {
+		protoRuns[i] = toProtoStandingOrderRun(run)
+	}
+
+	return &prototx.GetStandingOrderResponse{
+		StandingOrder: toProtoStandingOrder(order),
+		Runs:          protoRuns,
+		Response: &prototx.Response{
+			Message: message,
+			Success: true,
+		},
+	}, nil
+}
+
+func (h *TransactionHandlerService) ListStandingOrders(ctx context.Context, req *prototx.ListStandingOrdersRequest) (*prototx.ListStandingOrdersResponse, error) {
+	orders, message, err := h.ListStandingOrdersService.Execute(req.AccountId, req.GetMetadata().GetRequester(), req.GetMetadata().GetRequestId())
+	if err != nil {
+		return &prototx.ListStandingOrdersResponse{
+			Response: &prototx.Response{
+				Message: message,
+				Success: false,
+			},
+		}, nil
+	}
+
+	protoOrders := make([]*prototx.StandingOrder, len(orders))
+	for i, order := range orders {
+		protoOrders[i] = toProtoStandingOrder(order)
+	}
+
+	return &prototx.ListStandingOrdersResponse{
+		StandingOrders: protoOrders,
+		Response: &prototx.Response{
+			Message: message,
+			Success: true,
+		},
+	}, nil
+}
+
+func (h *TransactionHandlerService) UpdateStandingOrderStatus(ctx context.Context, req *prototx.UpdateStandingOrderStatusRequest) (*prototx.UpdateStandingOrderStatusResponse, error) {
+	order, message, err := h.UpdateStandingOrderStatusService.Execute(
+		req.StandingOrderId,
+		req.Action,
+		req.GetMetadata().GetRequester(),
+		req.GetMetadata().GetRequestId(),
+	)
+	if err != nil {
+		return &prototx.UpdateStandingOrderStatusResponse{
+			Response: &prototx.Response{
+				Message: message,
+				Success: false,
+			},
+		}, nil
+	}
+
+	return &prototx.UpdateStandingOrderStatusResponse{
+		StandingOrder: toProtoStandingOrder(order),
+		Response: &prototx.Response{
+			Message: message,
+			Success: true,
+		},
+	}, nil
+}
+
+func toProtoStandingOrder(order *entity.StandingOrder) *prototx.StandingOrder {
+	protoOrder := &prototx.StandingOrder{
+		Id:                   order.ID,
+		SourceAccountId:      order.SourceAccountID,
+		DestinationAccountId: order.DestinationAccountID,
+		Amount:               order.Amount.String(),
+		Frequency:            order.Frequency,
+		StartAt:              timestamppb.New(order.StartAt),
+		MaxRuns:              int32(order.MaxRuns),
+		RunCount:             int32(order.RunCount),
+		Status:               order.Status,
+		Description:          order.Description,
+		CreatedBy:            order.CreatedBy,
+		CreatedAt:            timestamppb.New(order.CreatedAt),
+		UpdatedAt:            timestamppb.New(order.UpdatedAt),
+	}
+
+	if order.EndAt != nil {
+		protoOrder.EndAt = timestamppb.New(*order.EndAt)
+	}
+	if order.NextRunAt != nil {
+		protoOrder.NextRunAt = timestamppb.New(*order.NextRunAt)
+	}
+	if order.LastRunAt != nil {
+		protoOrder.LastRunAt = timestamppb.New(*order.LastRunAt)
+	}
+
+	return protoOrder
+}
+
+func toProtoStandingOrderRun(run *entity.StandingOrderRun) *prototx.StandingOrderRun {
+	protoRun := &prototx.StandingOrderRun{
+		RunNumber:   int32(run.RunNumber),
+		ScheduledAt: timestamppb.New(run.ScheduledAt),
+		Status:      run.Status,
+		Message:     run.Message,
+		CreatedAt:   timestamppb.New(run.CreatedAt),
+	}
+	if run.TransactionID != nil {
+		protoRun.TransactionId = *run.TransactionID
+	}
+
+	return protoRun
+}
@@ -0,0 +1,125 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	prototx "transaction-service/api/protogen/txservice/proto"
+	apptx "transaction-service/internal/app"
+	"transaction-service/internal/domain/entity"
+	"transaction-service/internal/domain/money"
+)
+
+func (h *TransactionHandlerService) CreateTransactionBatch(ctx context.Context, req *prototx.CreateTransactionBatchRequest) (*prototx.CreateTransactionBatchResponse, error) {
+	instructions := make([]apptx.TransactionBatchInstruction, len(req.Lines))
+	for i, line := range req.Lines {
+		amount, err := money.Parse(line.Amount)
+		if err != nil {
+			return &prototx.CreateTransactionBatchResponse{
+				Response: &prototx.Response{
+					Message: fmt.Sprintf("Invalid transaction batch: line %d: invalid amount", i+1),
+					Success: false,
+				},
+			}, nil
+		}
+		instructions[i] = apptx.TransactionBatchInstruction{
+			DestinationAccountID: line.DestinationAccountId,
+			Amount:               amount,
+			ReferenceID:          line.ReferenceId,
+		}
+	}
+
+	batch, lines, message, err := h.CreateTransactionBatchService.Execute(
+		apptx.WithRequesterRole(ctx, req.GetMetadata().GetRequesterRole()),
+		req.SourceAccountId,
+		req.Mode,
+		req.ReferenceId,
+		instructions,
+		req.GetMetadata().GetRequester(),
+		req.GetMetadata().GetRequestId(),
+	)
+	if err != nil {
+		return &prototx.CreateTransactionBatchResponse{
+			Response: &prototx.Response{
+				Message: message,
+				Success: false,
+			},
+		}, nil
+	}
+
+	return &prototx.CreateTransactionBatchResponse{
+		Batch: toProtoTransactionBatch(batch),
+		Lines: toProtoTransactionBatchLines(lines),
+		Response: &prototx.Response{
+			Message: message,
+			Success: true,
+		},
+	}, nil
+}
+
+func (h *TransactionHandlerService) GetTransactionBatch(ctx context.Context, req *prototx.GetTransactionBatchRequest) (*prototx.GetTransactionBatchResponse, error) {
+	batch, lines, message, err := h.GetTransactionBatchService.Execute(req.BatchId, req.GetMetadata().GetRequester(), req.GetMetadata().GetRequestId())
+	if err != nil {
+		return &prototx.GetTransactionBatchResponse{
+			Response: &prototx.Response{
+				Message: message,
+				Success: false,
+			},
+		}, nil
+	}
+
+	return &prototx.GetTransactionBatchResponse{
+		Batch: toProtoTransactionBatch(batch),
+		Lines: toProtoTransactionBatchLines(lines),
+		Response: &prototx.Response{
+			Message: message,
+			Success: true,
+		},
+	}, nil
+}
+
+func toProtoTransactionBatch(batch *entity.TransactionBatch) *prototx.TransactionBatch {
+	protoBatch := &prototx.TransactionBatch{
+		Id:              batch.ID,
+		SourceAccountId: batch.SourceAccountID,
+		Mode:            batch.Mode,
+		Status:          batch.Status,
+		ReferenceId:     batch.ReferenceID,
+		LineCount:       int32(batch.LineCount),
+		SuccessfulCount: int32(batch.SuccessfulCount),
+		FailedCount:     int32(batch.FailedCount),
+		TotalAmount:     batch.TotalAmount.String(),
+		ErrorReason:     batch.ErrorReason,
+		CreatedBy:       batch.CreatedBy,
+		CreatedAt:       timestamppb.New(batch.CreatedAt),
+		UpdatedAt:       timestamppb.New(batch.UpdatedAt),
+	}
+
+	if batch.CompletedAt != nil {
+		protoBatch.CompletedAt = timestamppb.New(*batch.CompletedAt)
+	}
+
+	return protoBatch
+}
+
+func toProtoTransactionBatchLines(lines []*entity.TransactionBatchLine) []*prototx.TransactionBatchLine {
+	protoLines := make([]*prototx.TransactionBatchLine, len(lines))
+	for i, line := range lines {
+		protoLines[i] = &prototx.TransactionBatchLine{
+			LineNumber:           int32(line.LineNumber),
+			DestinationAccountId: line.DestinationAccountID,
+			Amount:               line.Amount.String(),
+			ReferenceId:          line.ReferenceID,
+			Status:               line.Status,
+			ErrorReason:          line.ErrorReason,
+		}
+		if line.TransactionID != nil {
+			protoLines[i].TransactionId = *line.TransactionID
+		}
+		if line.ReversalTransactionID != nil {
+			protoLines[i].ReversalTransactionId = *line.ReversalTransactionID
+		}
+	}
+
+	return protoLines
+}
@@ -2,11 +2,18 @@ package handlers
 
 import (
 	"context"
+	"errors"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/timestamppb"
 	"strings"
 	"time"
 	prototx "transaction-service/api/protogen/txservice/proto"
+	apptx "transaction-service/internal/app"
 	"transaction-service/internal/domain/entity"
+	custom_err "transaction-service/internal/domain/error"
+	"transaction-service/internal/domain/money"
 )
 
 func (s *TransactionHandlerService) InitTransaction(ctx context.Context, req *prototx.InitTransactionRequest) (*prototx.InitTransactionResponse, error) {
@@ -14,12 +21,35 @@ func (s *TransactionHandlerService) InitTransaction(ctx context.Context, req *pr
 	if req.DestinationAccountId != "" {
 		destId = &req.DestinationAccountId
 	}
-	// Execute the transaction
-	transaction, message, err := s.InitTransactionService.Execute(
-		ctx,
+
+	// amount is optional for full withdrawal
+	amount := money.Zero
+	if strings.TrimSpace(req.Amount) != "" {
+		parsedAmount, err := money.Parse(req.Amount)
+		if err != nil {
+			return &prototx.InitTransactionResponse{
+				TransactionId:     "",
+				TransactionStatus: entity.TransactionStatusFailed,
+				Response: &prototx.Response{
+					Message: "Invalid amount for transaction",
+					Success: false,
+				},
+			}, nil
+		}
+		amount = parsedAmount
+	}
+
+	// Execute the transaction, asynchronous requests return as soon as the transaction is queued
+	execute := s.InitTransactionService.Execute
+	if req.Async {
+		execute = s.InitTransactionService.ExecuteAsync
+	}
+
+	transaction, message, err := execute(
+		apptx.WithRequesterRole(ctx, req.GetMetadata().GetRequesterRole()),
 		req.SourceAccountId,
 		destId,
-		req.Amount,
+		amount,
 		req.Type,
 		req.Reference,
 		req.Metadata.Requester,
@@ -31,8 +61,9 @@ func (s *TransactionHandlerService) InitTransaction(ctx context.Context, req *pr
 			TransactionId:     "",
 			TransactionStatus: entity.TransactionStatusFailed,
 			Response: &prototx.Response{
-				Message: message,
-				Success: false,
+				Message:   message,
+				Success:   false,
+				ErrorCode: errorCode(err),
 			},
 		}, nil
 	}
@@ -82,7 +113,7 @@ func (h *TransactionHandlerService) GetTransactionHistory(ctx context.Context, r
 	}
 
 	// Call service
-	transactions, total, err := h.GetTransactionHistoryService.Execute(
+	transactions, total, nextCursor, err := h.GetTransactionHistoryService.Execute(
 		req.AccountId,
 		req.CustomerId,
 		transactionTypes,
@@ -91,10 +122,16 @@ func (h *TransactionHandlerService) GetTransactionHistory(ctx context.Context, r
 		sortOrder,
 		page,
 		pageSize,
+		req.GetPagination().GetCursor(),
+		req.GetPagination().GetIncludeTotal(),
 		req.GetMetadata().GetRequester(),
 		req.GetMetadata().GetRequestId())
 
 	if err != nil {
+		message := "Failed to get transaction history"
+		if errors.Is(err, custom_err.ErrInvalidPageCursor) {
+			message = "Invalid request - invalid cursor"
+		}
 		return &prototx.GetTransactionHistoryResponse{
 			Transactions: nil,
 			Pagination: &prototx.PaginationResponse{
@@ -104,7 +141,7 @@ func (h *TransactionHandlerService) GetTransactionHistory(ctx context.Context, r
 				TotalPages: 0,
 			},
 			Response: &prototx.Response{
-				Message: "Failed to get transaction history",
+				Message: message,
 				Success: false,
 			},
 		}, nil
@@ -113,31 +150,7 @@ func (h *TransactionHandlerService) GetTransactionHistory(ctx context.Context, r
 	// Convert to proto response
 	protoTransactions := make([]*prototx.Transaction, len(transactions))
 	for i, tx := range transactions {
-		protoTx := &prototx.Transaction{
-			Id:                   tx.ID,
-			SourceAccountId:      tx.SourceAccountID,
-			DestinationAccountId: h.toString(tx.DestinationAccountID),
-			Amount:               tx.Amount,
-			Type:                 tx.Type,
-			TransactionStatus:    tx.TransactionStatus,
-			Reference:            tx.ReferenceID,
-			CreatedAt:            timestamppb.New(tx.CreatedAt),
-			UpdatedAt:            timestamppb.New(tx.UpdatedAt),
-			CreatedBy:            tx.CreatedBy,
-			ErrorReason:          tx.ErrorReason,
-			RetryCount:           int32(tx.RetryCount),
-			Version:              int32(tx.Version),
-		}
-
-		// Add optional fields if they exist
-		if tx.LastRetryAt != nil {
-			protoTx.LastRetryAt = timestamppb.New(*tx.LastRetryAt)
-		}
-		if !tx.TimeoutAt.IsZero() {
-			protoTx.TimeoutAt = timestamppb.New(tx.TimeoutAt)
-		}
-
-		protoTransactions[i] = protoTx
+		protoTransactions[i] = h.toProtoTransaction(tx)
 	}
 
 	// Calculate pagination info
@@ -146,6 +159,11 @@ func (h *TransactionHandlerService) GetTransactionHistory(ctx context.Context, r
 		totalPages = int32((total + int64(pageSize) - 1) / int64(pageSize))
 	}
 
+	// a cursor page has no page number
+	if req.GetPagination().GetCursor() != "" {
+		page = 0
+	}
+
 	return &prototx.GetTransactionHistoryResponse{
 		Transactions: protoTransactions,
 		Pagination: &prototx.PaginationResponse{
@@ -153,6 +171,7 @@ func (h *TransactionHandlerService) GetTransactionHistory(ctx context.Context, r
 			PageSize:   int32(pageSize),
 			TotalCount: int32(total),
 			TotalPages: totalPages,
+			NextCursor: nextCursor,
 		},
 		Response: &prototx.Response{
 			Message: "Transaction history response",
@@ -161,6 +180,268 @@ func (h *TransactionHandlerService) GetTransactionHistory(ctx context.Context, r
 	}, nil
 }
 
+// ExportTransactionHistory streams the matching transactions in chunks. Failures before the first chunk are
+// reported in an unsuccessful response; once transactions were sent, a failure ends the stream with an error.
+func (h *TransactionHandlerService) ExportTransactionHistory(req *prototx.ExportTransactionHistoryRequest, stream grpc.ServerStreamingServer[prototx.ExportTransactionHistoryResponse]) error {
+	var startDate, endDate *time.Time
+	if req.StartDate != nil {
+		sd := req.StartDate.AsTime()
+		startDate = &sd
+	}
+	if req.EndDate != nil {
+		ed := req.EndDate.AsTime()
+		endDate = &ed
+	}
+
+	var transactionTypes []string
+	if strings.TrimSpace(req.Types) != "" {
+		transactionTypes = strings.Split(strings.TrimSpace(req.Types), ",")
+	}
+
+	started := false
+	send := func(transactions []*entity.Transaction) error {
+		chunk := &prototx.ExportTransactionHistoryResponse{
+			Transactions: make([]*prototx.Transaction, len(transactions)),
+		}
+		for i, tx := range transactions {
+			chunk.Transactions[i] = h.toProtoTransaction(tx)
+		}
+		if !started {
+			chunk.Response = &prototx.Response{
+				Message: "Transaction history export",
+				Success: true,
+			}
+		}
+		started = true
+		return stream.Send(chunk)
+	}
+
+	_, message, err := h.ExportTransactionHistoryService.Execute(
+		req.AccountId,
+		req.CustomerId,
+		transactionTypes,
+		startDate,
+		endDate,
+		req.SortOrder,
+		req.GetMetadata().GetRequester(),
+		req.GetMetadata().GetRequestId(),
+		send)
+	if err != nil {
+		if started {
+			return status.Error(codes.Internal, message)
+		}
+		return stream.Send(&prototx.ExportTransactionHistoryResponse{
+			Response: &prototx.Response{
+				Message: message,
+				Success: false,
+			},
+		})
+	}
+
+	if !started {
+		return send(nil)
+	}
+	return nil
+}
+
+func (h *TransactionHandlerService) GetTransaction(ctx context.Context, req *prototx.GetTransactionRequest) (*prototx.GetTransactionResponse, error) {
+	transaction, message, err := h.GetTransactionService.Execute(
+		apptx.WithRequesterRole(ctx, req.GetMetadata().GetRequesterRole()),
+		req.TransactionId,
+		req.GetMetadata().GetRequester(),
+		req.GetMetadata().GetRequestId(),
+	)
+	if err != nil {
+		return &prototx.GetTransactionResponse{
+			Response: &prototx.Response{
+				Message: message,
+				Success: false,
+			},
+		}, nil
+	}
+
+	return &prototx.GetTransactionResponse{
+		Transaction: h.toProtoTransaction(transaction),
+		Response: &prototx.Response{
+			Message: message,
+			Success: true,
+		},
+	}, nil
+}
+
+func (h *TransactionHandlerService) ReverseTransaction(ctx context.Context, req *prototx.ReverseTransactionRequest) (*prototx.ReverseTransactionResponse, error) {
+	// amount is optional for full reversal
+	amount := money.Zero
+	if strings.TrimSpace(req.Amount) != "" {
+		parsedAmount, err := money.Parse(req.Amount)
+		if err != nil {
+			return &prototx.ReverseTransactionResponse{
+				Response: &prototx.Response{
+					Message: "Invalid amount for reversal",
+					Success: false,
+				},
+			}, nil
+		}
+		amount = parsedAmount
+	}
+
+	reversal, message, err := h.ReverseTransactionService.Execute(
+		ctx,
+		req.TransactionId,
+		amount,
+		req.Reason,
+		req.GetMetadata().GetRequester(),
+		req.GetMetadata().GetRequestId(),
+	)
+	if err != nil {
+		return &prototx.ReverseTransactionResponse{
+			Response: &prototx.Response{
+				Message: message,
+				Success: false,
+			},
+		}, nil
+	}
+
+	return &prototx.ReverseTransactionResponse{
+		Transaction: h.toProtoTransaction(reversal),
+		Response: &prototx.Response{
+			Message: message,
+			Success: true,
+		},
+	}, nil
+}
+
+func (h *TransactionHandlerService) CaptureHold(ctx context.Context, req *prototx.CaptureHoldRequest) (*prototx.CaptureHoldResponse, error) {
+	// amount is optional for full capture
+	amount := money.Zero
+	if strings.TrimSpace(req.Amount) != "" {
+		parsedAmount, err := money.Parse(req.Amount)
+		if err != nil {
+			return &prototx.CaptureHoldResponse{
+				Response: &prototx.Response{
+					Message: "Invalid amount for capture",
+					Success: false,
+				},
+			}, nil
+		}
+		amount = parsedAmount
+	}
+
+	var destinationAccountID *string
+	if strings.TrimSpace(req.DestinationAccountId) != "" {
+		destinationAccountID = &req.DestinationAccountId
+	}
+
+	capture, message, err := h.CaptureHoldService.Execute(
+		ctx,
+		req.HoldId,
+		amount,
+		destinationAccountID,
+		req.GetMetadata().GetRequester(),
+		req.GetMetadata().GetRequestId(),
+	)
+	if err != nil {
+		return &prototx.CaptureHoldResponse{
+			Response: &prototx.Response{
+				Message: message,
+				Success: false,
+			},
+		}, nil
+	}
+
+	return &prototx.CaptureHoldResponse{
+		Transaction: h.toProtoTransaction(capture),
+		Response: &prototx.Response{
+			Message: message,
+			Success: true,
+		},
+	}, nil
+}
+
+func (h *TransactionHandlerService) CloseAccount(ctx context.Context, req *prototx.CloseAccountRequest) (*prototx.CloseAccountResponse, error) {
+	payout, message, err := h.CloseAccountService.Execute(
+		ctx,
+		req.AccountId,
+		req.PayoutAccountId,
+		req.Reason,
+		req.GetMetadata().GetRequester(),
+		req.GetMetadata().GetRequestId(),
+	)
+	if err != nil {
+		return &prototx.CloseAccountResponse{
+			Response: &prototx.Response{
+				Message: message,
+				Success: false,
+			},
+		}, nil
+	}
+
+	response := &prototx.CloseAccountResponse{
+		Response: &prototx.Response{
+			Message: message,
+			Success: true,
+		},
+	}
+	if payout != nil {
+		response.Transaction = h.toProtoTransaction(payout)
+	}
+	return response, nil
+}
+
+func (h *TransactionHandlerService) toProtoTransaction(tx *entity.Transaction) *prototx.Transaction {
+	protoTx := &prototx.Transaction{
+		Id:                   tx.ID,
+		SourceAccountId:      tx.SourceAccountID,
+		DestinationAccountId: h.toString(tx.DestinationAccountID),
+		Amount:               tx.Amount.String(),
+		SourceCurrency:       tx.SourceCurrency,
+		DestinationCurrency:  h.toString(tx.DestinationCurrency),
+		Type:                 tx.Type,
+		TransactionStatus:    tx.TransactionStatus,
+		Reference:            tx.ReferenceID,
+		CreatedAt:            timestamppb.New(tx.CreatedAt),
+		UpdatedAt:            timestamppb.New(tx.UpdatedAt),
+		CreatedBy:            tx.CreatedBy,
+		ErrorReason:          tx.ErrorReason,
+		RetryCount:           int32(tx.RetryCount),
+		Version:              int32(tx.Version),
+		ReversalReason:       tx.ReversalReason,
+		FeeAmount:            tx.FeeAmount.String(),
+	}
+
+	// Add optional fields if they exist
+	if tx.ExchangeRate.IsPositive() {
+		protoTx.DestinationAmount = tx.DestinationAmount.String()
+		protoTx.ExchangeRate = tx.ExchangeRate.String()
+	}
+	if tx.ReversalOfTransactionID != nil {
+		protoTx.ReversalOfTransactionId = *tx.ReversalOfTransactionID
+	}
+	if tx.ReversedByTransactionID != nil {
+		protoTx.ReversedByTransactionId = *tx.ReversedByTransactionID
+	}
+	if tx.ReversedAmount.IsPositive() {
+		protoTx.ReversedAmount = tx.ReversedAmount.String()
+	}
+	if tx.HoldID != nil {
+		protoTx.HoldId = *tx.HoldID
+	}
+	if tx.InterestPostingID != nil {
+		protoTx.InterestPostingId = *tx.InterestPostingID
+	}
+	if tx.FeeRuleID != nil {
+		protoTx.FeeRuleId = *tx.FeeRuleID
+	}
+	if tx.LastRetryAt != nil {
+		protoTx.LastRetryAt = timestamppb.New(*tx.LastRetryAt)
+	}
+	if !tx.TimeoutAt.IsZero() {
+		protoTx.TimeoutAt = timestamppb.New(tx.TimeoutAt)
+	}
+
+	return protoTx
+}
+
 func (h *TransactionHandlerService) toString(ptr *string) string {
 	if ptr == nil {
 		return ""
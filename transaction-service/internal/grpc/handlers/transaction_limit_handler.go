@@ -0,0 +1,92 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	prototx "transaction-service/api/protogen/txservice/proto"
+	apptx "transaction-service/internal/app"
+	"transaction-service/internal/domain/entity"
+	custom_err "transaction-service/internal/domain/error"
+)
+
+// errorCodeTransactionLimitExceeded lets clients tell a limit breach apart from other failed requests
+const errorCodeTransactionLimitExceeded = "TRANSACTION_LIMIT_EXCEEDED"
+
+func errorCode(err error) string {
+	if errors.Is(err, custom_err.ErrTransactionLimitExceeded) {
+		return errorCodeTransactionLimitExceeded
+	}
+	return ""
+}
+
+func (h *TransactionHandlerService) SetTransactionLimit(ctx context.Context, req *prototx.SetTransactionLimitRequest) (*prototx.SetTransactionLimitResponse, error) {
+	limit, message, err := h.SetTransactionLimitService.Execute(
+		apptx.TransactionLimitInput{
+			Scope:                req.Scope,
+			Subject:              req.Subject,
+			PerTransactionAmount: req.PerTransactionAmount,
+			DailyAmount:          req.DailyAmount,
+			DailyCount:           int(req.DailyCount),
+			MonthlyAmount:        req.MonthlyAmount,
+			MonthlyCount:         int(req.MonthlyCount),
+		},
+		req.GetMetadata().GetRequester(),
+		req.GetMetadata().GetRequestId(),
+	)
+	if err != nil {
+		return &prototx.SetTransactionLimitResponse{
+			Response: &prototx.Response{
+				Message: message,
+				Success: false,
+			},
+		}, nil
+	}
+
+	return &prototx.SetTransactionLimitResponse{
+		Limit: toProtoTransactionLimit(limit),
+		Response: &prototx.Response{
+			Message: message,
+			Success: true,
+		},
+	}, nil
+}
+
+func (h *TransactionHandlerService) ListTransactionLimits(ctx context.Context, req *prototx.ListTransactionLimitsRequest) (*prototx.ListTransactionLimitsResponse, error) {
+	limits, err := h.ListTransactionLimitsService.Execute(req.GetMetadata().GetRequester(), req.GetMetadata().GetRequestId())
+	if err != nil {
+		return &prototx.ListTransactionLimitsResponse{
+			Response: &prototx.Response{
+				Message: "Failed to get transaction limits",
+				Success: false,
+			},
+		}, nil
+	}
+
+	protoLimits := make([]*prototx.TransactionLimit, len(limits))
+	for i, limit := range limits {
+		protoLimits[i] = toProtoTransactionLimit(limit)
+	}
+
+	return &prototx.ListTransactionLimitsResponse{
+		Limits: protoLimits,
+		Response: &prototx.Response{
+			Message: "Transaction limits",
+			Success: true,
+		},
+	}, nil
+}
+
+func toProtoTransactionLimit(limit *entity.TransactionLimit) *prototx.TransactionLimit {
+	return &prototx.TransactionLimit{
+		Scope:                limit.Scope,
+		Subject:              limit.Subject,
+		PerTransactionAmount: limit.PerTransactionAmount.String(),
+		DailyAmount:          limit.DailyAmount.String(),
+		DailyCount:           int32(limit.DailyCount),
+		MonthlyAmount:        limit.MonthlyAmount.String(),
+		MonthlyCount:         int32(limit.MonthlyCount),
+		UpdatedBy:            limit.UpdatedBy,
+		UpdatedAt:            timestamppb.New(limit.UpdatedAt),
+	}
+}
@@ -17,10 +17,16 @@ import (
 )
 
 type ServiceRepos struct {
-	AccountClient   ports.AccountClient
-	SagaRepo        ports.SagaRepo
-	TransactionRepo ports.TransactionRepo
-	EventRepo       ports.EventRepo
+	AccountClient     ports.AccountClient
+	SagaRepo          ports.SagaRepo
+	TransactionRepo   ports.TransactionRepo
+	EventRepo         ports.EventRepo
+	ExchangeRateRepo  ports.ExchangeRateRepo
+	TransactionQueue  ports.TransactionQueue
+	StandingOrderRepo ports.StandingOrderRepo
+	BatchRepo         ports.TransactionBatchRepo
+	LimitRepo         ports.TransactionLimitRepo
+	FeeRuleRepo       ports.FeeRuleRepo
 }
 
 func StartGRPCServer(ctx context.Context, repos ServiceRepos) {
@@ -76,11 +82,115 @@ func generateAggregatedHandlers(repos ServiceRepos) *handlers.TransactionHandler
 		repos.AccountClient,
 		repos.SagaRepo,
 		repos.EventRepo,
+		repos.ExchangeRateRepo,
+		repos.LimitRepo,
+		repos.FeeRuleRepo,
+		repos.TransactionQueue,
+	)
+
+	accountAggregatedHandler.GetTransactionService = apptx.NewGetTransaction(
+		repos.TransactionRepo,
 	)
 
 	accountAggregatedHandler.GetTransactionHistoryService = apptx.NewGetTransactionHistory(
 		repos.TransactionRepo,
 	)
 
+	accountAggregatedHandler.ExportTransactionHistoryService = apptx.NewExportTransactionHistory(
+		repos.TransactionRepo,
+	)
+
+	accountAggregatedHandler.ReverseTransactionService = apptx.NewReverseTransaction(
+		repos.TransactionRepo,
+		repos.AccountClient,
+		repos.SagaRepo,
+		repos.EventRepo,
+		repos.ExchangeRateRepo,
+	)
+
+	accountAggregatedHandler.CaptureHoldService = apptx.NewCaptureHold(
+		repos.TransactionRepo,
+		repos.AccountClient,
+		repos.SagaRepo,
+		repos.EventRepo,
+		repos.ExchangeRateRepo,
+		repos.LimitRepo,
+	)
+
+	accountAggregatedHandler.CloseAccountService = apptx.NewCloseAccount(
+		repos.TransactionRepo,
+		repos.AccountClient,
+		repos.SagaRepo,
+		repos.EventRepo,
+		repos.ExchangeRateRepo,
+	)
+
+	accountAggregatedHandler.SetExchangeRateService = apptx.NewSetExchangeRate(
+		repos.ExchangeRateRepo,
+		repos.EventRepo,
+	)
+
+	accountAggregatedHandler.ListExchangeRatesService = apptx.NewListExchangeRates(
+		repos.ExchangeRateRepo,
+	)
+
+	accountAggregatedHandler.CreateStandingOrderService = apptx.NewCreateStandingOrder(
+		repos.StandingOrderRepo,
+		repos.AccountClient,
+		repos.EventRepo,
+	)
+
+	accountAggregatedHandler.GetStandingOrderService = apptx.NewGetStandingOrder(
+		repos.StandingOrderRepo,
+	)
+
+	accountAggregatedHandler.ListStandingOrdersService = apptx.NewListStandingOrders(
+		repos.StandingOrderRepo,
+	)
+
+	accountAggregatedHandler.UpdateStandingOrderStatusService = apptx.NewUpdateStandingOrderStatus(
+		repos.StandingOrderRepo,
+		repos.EventRepo,
+	)
+
+	accountAggregatedHandler.CreateTransactionBatchService = apptx.NewCreateTransactionBatch(
+		repos.BatchRepo,
+		repos.TransactionRepo,
+		repos.AccountClient,
+		repos.SagaRepo,
+		repos.EventRepo,
+		repos.ExchangeRateRepo,
+		repos.LimitRepo,
+		repos.FeeRuleRepo,
+		repos.TransactionQueue,
+	)
+
+	accountAggregatedHandler.GetTransactionBatchService = apptx.NewGetTransactionBatch(
+		repos.BatchRepo,
+	)
+
+	accountAggregatedHandler.SetTransactionLimitService = apptx.NewSetTransactionLimit(
+		repos.LimitRepo,
+		repos.EventRepo,
+	)
+
+	accountAggregatedHandler.ListTransactionLimitsService = apptx.NewListTransactionLimits(
+		repos.LimitRepo,
+	)
+
+	accountAggregatedHandler.SetFeeRuleService = apptx.NewSetFeeRule(
+		repos.FeeRuleRepo,
+		repos.EventRepo,
+	)
+
+	accountAggregatedHandler.ListFeeRulesService = apptx.NewListFeeRules(
+		repos.FeeRuleRepo,
+	)
+
+	accountAggregatedHandler.DeleteFeeRuleService = apptx.NewDeleteFeeRule(
+		repos.FeeRuleRepo,
+		repos.EventRepo,
+	)
+
 	return accountAggregatedHandler
 }
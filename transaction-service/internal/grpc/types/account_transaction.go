@@ -1,8 +1,10 @@
 package types
 
+import "transaction-service/internal/domain/money"
+
 type AccountBalance struct {
 	AccountID string
-	Balance   float64
+	Balance   money.Amount
 	Version   int
 }
 
@@ -0,0 +1,72 @@
+package jobs
+
+import (
+	"context"
+	"fmt"
+	"time"
+	"transaction-service/internal/config"
+	"transaction-service/internal/domain/entity"
+	"transaction-service/internal/logging"
+	"transaction-service/internal/ports"
+)
+
+// InterestPoster credits an interest posting through the same saga as any other transaction
+type InterestPoster interface {
+	Execute(ctx context.Context, posting ports.InterestPostingInfo, requestId string) (*entity.Transaction, string, error)
+}
+
+// InterestPostingJob credits the interest postings the account service closed at the end of each month
+type InterestPostingJob struct {
+	accountClient  ports.AccountClient
+	interestPoster InterestPoster
+}
+
+func NewInterestPostingJob(accountClient ports.AccountClient, interestPoster InterestPoster) *InterestPostingJob {
+	return &InterestPostingJob{
+		accountClient:  accountClient,
+		interestPoster: interestPoster,
+	}
+}
+
+func (j *InterestPostingJob) Start(ctx context.Context) {
+	ticker := time.NewTicker(config.Current().InterestPosting.Interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			if err := j.RunPendingPostings(ctx); err != nil {
+				logging.Logger.Warn().Err(err).Str("job_type", "interest_posting").Msg("Interest posting run failed")
+			}
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// RunPendingPostings credits a batch of pending postings. A posting whose credit fails stays pending and is
+// credited again on the next run.
+func (j *InterestPostingJob) RunPendingPostings(ctx context.Context) error {
+	if !j.accountClient.IsHealthy() {
+		return fmt.Errorf("account service unavailable, interest postings postponed")
+	}
+
+	postings, message, err := j.accountClient.ListPendingInterestPostings(ctx, config.Current().InterestPosting.BatchSize, entity.InterestRequester, "")
+	if err != nil {
+		return fmt.Errorf("failed to get pending interest postings: %s: %w", message, err)
+	}
+
+	for _, posting := range postings {
+		requestId := "interest:" + posting.PostingID
+		if _, message, err := j.interestPoster.Execute(ctx, posting, requestId); err != nil {
+			logging.Logger.Warn().
+				Err(err).
+				Str("interest_posting_id", posting.PostingID).
+				Str("account_id", posting.AccountID).
+				Str("message", message).
+				Str("job_type", "interest_posting").
+				Msg("Failed to credit interest posting")
+		}
+	}
+	return nil
+}
@@ -0,0 +1,135 @@
+package jobs
+
+import (
+	"context"
+	"fmt"
+	"time"
+	"transaction-service/internal/app"
+	"transaction-service/internal/config"
+	"transaction-service/internal/domain/entity"
+	"transaction-service/internal/domain/money"
+	"transaction-service/internal/logging"
+	"transaction-service/internal/ports"
+)
+
+// TransactionInitiator initiates a transaction through the same use-case as API requests
+type TransactionInitiator interface {
+	Execute(
+		ctx context.Context,
+		sourceAccountID string,
+		destinationAccountID *string,
+		amount money.Amount,
+		transactionType,
+		referenceID,
+		requester,
+		requestId string,
+	) (*entity.Transaction, string, error)
+}
+
+type StandingOrderJob struct {
+	standingOrderRepo    ports.StandingOrderRepo
+	accountClient        ports.AccountClient
+	transactionInitiator TransactionInitiator
+}
+
+func NewStandingOrderJob(
+	standingOrderRepo ports.StandingOrderRepo,
+	accountClient ports.AccountClient,
+	transactionInitiator TransactionInitiator,
+) *StandingOrderJob {
+
+	return &StandingOrderJob{
+		standingOrderRepo:    standingOrderRepo,
+		accountClient:        accountClient,
+		transactionInitiator: transactionInitiator,
+	}
+}
+
+func (j *StandingOrderJob) Start(ctx context.Context) {
+	ticker := time.NewTicker(config.Current().StandingOrder.Interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			if err := j.RunDueStandingOrders(ctx, time.Now()); err != nil {
+				logging.Logger.Warn().Err(err).Str("job_type", "standing_order").Msg("Standing order run failed")
+			}
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// RunDueStandingOrders initiates one run of every order due at now. Orders that missed several
+// occurrences, e.g. while the service was down, catch up one occurrence per pass.
+func (j *StandingOrderJob) RunDueStandingOrders(ctx context.Context, now time.Time) error {
+	// a run failing only because the account service is down would still be recorded and skipped
+	if !j.accountClient.IsHealthy() {
+		return fmt.Errorf("account service unavailable, due standing orders postponed")
+	}
+
+	orders, err := j.standingOrderRepo.GetDueStandingOrders(now, config.Current().StandingOrder.BatchSize)
+	if err != nil {
+		return fmt.Errorf("failed to get due standing orders: %w", err)
+	}
+
+	for _, order := range orders {
+		if err := j.RunStandingOrder(ctx, order, now); err != nil {
+			logging.Logger.Warn().
+				Err(err).
+				Str("standing_order_id", order.ID).
+				Str("job_type", "standing_order").
+				Msg("Failed to run standing order")
+		}
+	}
+	return nil
+}
+
+// RunStandingOrder initiates the due transfer of the order and records the outcome together with the
+// advanced schedule. The run reference makes the transfer idempotent, so a run that is fired again
+// after its outcome failed to be recorded resolves to the original transaction.
+func (j *StandingOrderJob) RunStandingOrder(ctx context.Context, order *entity.StandingOrder, now time.Time) error {
+	if !order.IsDue(now) {
+		return nil
+	}
+
+	reference := order.RunReference(order.RunCount + 1)
+	destinationAccountID := order.DestinationAccountID
+	transaction, message, err := j.transactionInitiator.Execute(
+		app.WithRequesterRole(ctx, order.CreatedByRole),
+		order.SourceAccountID,
+		&destinationAccountID,
+		order.Amount,
+		entity.TransactionTypeTransfer,
+		reference,
+		order.CreatedBy,
+		reference,
+	)
+
+	runStatus := entity.StandingOrderRunStatusSuccessful
+	if err != nil || (transaction != nil && transaction.TransactionStatus == entity.TransactionStatusFailed) {
+		runStatus = entity.StandingOrderRunStatusFailed
+	}
+
+	var transactionID *string
+	if transaction != nil {
+		id := transaction.ID
+		transactionID = &id
+	}
+
+	run := entity.NewStandingOrderRun(order, transactionID, runStatus, message)
+	order.AdvanceSchedule(now)
+	if err := j.standingOrderRepo.RecordStandingOrderRun(order, run); err != nil {
+		return fmt.Errorf("failed to record standing order run %d: %w", run.RunNumber, err)
+	}
+
+	logging.Logger.Info().
+		Str("standing_order_id", order.ID).
+		Int("run_number", run.RunNumber).
+		Str("run_status", run.Status).
+		Str("message", message).
+		Str("job_type", "standing_order").
+		Msg("Standing order run recorded")
+	return nil
+}
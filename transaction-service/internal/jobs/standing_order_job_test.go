@@ -0,0 +1,147 @@
+package jobs
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+	"transaction-service/internal/domain/entity"
+	"transaction-service/internal/domain/money"
+	"transaction-service/internal/ports/mocks"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// fakeTransactionInitiator records the references it was called with and returns a fixed outcome
+type fakeTransactionInitiator struct {
+	references  []string
+	transaction *entity.Transaction
+	message     string
+	err         error
+}
+
+func (f *fakeTransactionInitiator) Execute(ctx context.Context, sourceAccountID string, destinationAccountID *string, amount money.Amount,
+	transactionType, referenceID, requester, requestId string) (*entity.Transaction, string, error) {
+	f.references = append(f.references, referenceID)
+	return f.transaction, f.message, f.err
+}
+
+func newTestStandingOrder(t *testing.T, frequency string, startAt time.Time, maxRuns int) *entity.StandingOrder {
+	order, err := entity.NewStandingOrder("acc-123", "acc-456", money.MustParse("250.00"), frequency, startAt, nil, maxRuns, "rent", "user-1")
+	assert.NoError(t, err)
+	return order
+}
+
+// TestRunStandingOrder_RecordsSuccessfulRun tests that a due run initiates a transfer and schedules the next occurrence
+func TestRunStandingOrder_RecordsSuccessfulRun(t *testing.T) {
+	standingOrderRepo := new(mocks.MockStandingOrderRepo)
+	initiator := &fakeTransactionInitiator{
+		transaction: &entity.Transaction{ID: "txn-1", TransactionStatus: entity.TransactionStatusCompleted},
+		message:     "Transaction completed successfully",
+	}
+	job := NewStandingOrderJob(standingOrderRepo, new(mocks.MockAccountClient), initiator)
+
+	startAt := time.Date(2026, time.January, 31, 9, 0, 0, 0, time.UTC)
+	order := newTestStandingOrder(t, entity.StandingOrderFrequencyMonthly, startAt, 0)
+
+	var recordedRun *entity.StandingOrderRun
+	standingOrderRepo.On("RecordStandingOrderRun", order, mock.AnythingOfType("*entity.StandingOrderRun")).
+		Run(func(args mock.Arguments) { recordedRun = args.Get(1).(*entity.StandingOrderRun) }).
+		Return(nil)
+
+	err := job.RunStandingOrder(context.Background(), order, startAt.Add(time.Minute))
+
+	assert.NoError(t, err)
+	assert.Equal(t, []string{order.RunReference(1)}, initiator.references)
+	assert.Equal(t, 1, recordedRun.RunNumber)
+	assert.Equal(t, startAt, recordedRun.ScheduledAt)
+	assert.Equal(t, entity.StandingOrderRunStatusSuccessful, recordedRun.Status)
+	assert.Equal(t, "txn-1", *recordedRun.TransactionID)
+	assert.Equal(t, 1, order.RunCount)
+	assert.Equal(t, entity.StandingOrderStatusActive, order.Status)
+	// January 31st falls back to the last day of February
+	assert.Equal(t, time.Date(2026, time.February, 28, 9, 0, 0, 0, time.UTC), *order.NextRunAt)
+	assert.Equal(t, time.Date(2026, time.March, 31, 9, 0, 0, 0, time.UTC), order.ScheduledAt(2))
+	standingOrderRepo.AssertExpectations(t)
+}
+
+// TestRunStandingOrder_RecordsFailedRun tests that a rejected transfer is recorded as failed and the schedule still advances
+func TestRunStandingOrder_RecordsFailedRun(t *testing.T) {
+	standingOrderRepo := new(mocks.MockStandingOrderRepo)
+	initiator := &fakeTransactionInitiator{
+		message: "Insufficient balance",
+		err:     errors.New("insufficient balance"),
+	}
+	job := NewStandingOrderJob(standingOrderRepo, new(mocks.MockAccountClient), initiator)
+
+	startAt := time.Date(2026, time.March, 2, 9, 0, 0, 0, time.UTC)
+	order := newTestStandingOrder(t, entity.StandingOrderFrequencyWeekly, startAt, 2)
+
+	var recordedRun *entity.StandingOrderRun
+	standingOrderRepo.On("RecordStandingOrderRun", order, mock.AnythingOfType("*entity.StandingOrderRun")).
+		Run(func(args mock.Arguments) { recordedRun = args.Get(1).(*entity.StandingOrderRun) }).
+		Return(nil)
+
+	err := job.RunStandingOrder(context.Background(), order, startAt)
+
+	assert.NoError(t, err)
+	assert.Equal(t, entity.StandingOrderRunStatusFailed, recordedRun.Status)
+	assert.Equal(t, "Insufficient balance", recordedRun.Message)
+	assert.Nil(t, recordedRun.TransactionID)
+	assert.Equal(t, startAt.AddDate(0, 0, 7), *order.NextRunAt)
+}
+
+// TestRunStandingOrder_CompletesAfterMaxRuns tests that the order completes once its run count is reached
+func TestRunStandingOrder_CompletesAfterMaxRuns(t *testing.T) {
+	standingOrderRepo := new(mocks.MockStandingOrderRepo)
+	initiator := &fakeTransactionInitiator{
+		transaction: &entity.Transaction{ID: "txn-1", TransactionStatus: entity.TransactionStatusCompleted},
+	}
+	job := NewStandingOrderJob(standingOrderRepo, new(mocks.MockAccountClient), initiator)
+
+	startAt := time.Date(2026, time.March, 2, 9, 0, 0, 0, time.UTC)
+	order := newTestStandingOrder(t, entity.StandingOrderFrequencyDaily, startAt, 2)
+	standingOrderRepo.On("RecordStandingOrderRun", order, mock.AnythingOfType("*entity.StandingOrderRun")).Return(nil)
+
+	assert.NoError(t, job.RunStandingOrder(context.Background(), order, startAt))
+	assert.NoError(t, job.RunStandingOrder(context.Background(), order, startAt.AddDate(0, 0, 1)))
+	// a third pass finds nothing to run
+	assert.NoError(t, job.RunStandingOrder(context.Background(), order, startAt.AddDate(0, 0, 2)))
+
+	assert.Equal(t, []string{order.RunReference(1), order.RunReference(2)}, initiator.references)
+	assert.Equal(t, entity.StandingOrderStatusCompleted, order.Status)
+	assert.Nil(t, order.NextRunAt)
+	standingOrderRepo.AssertNumberOfCalls(t, "RecordStandingOrderRun", 2)
+}
+
+// TestRunStandingOrder_SkipsPausedOrder tests that a paused order does not run
+func TestRunStandingOrder_SkipsPausedOrder(t *testing.T) {
+	standingOrderRepo := new(mocks.MockStandingOrderRepo)
+	initiator := &fakeTransactionInitiator{}
+	job := NewStandingOrderJob(standingOrderRepo, new(mocks.MockAccountClient), initiator)
+
+	startAt := time.Date(2026, time.March, 2, 9, 0, 0, 0, time.UTC)
+	order := newTestStandingOrder(t, entity.StandingOrderFrequencyDaily, startAt, 0)
+	assert.NoError(t, order.Pause("user-1"))
+
+	err := job.RunStandingOrder(context.Background(), order, startAt.Add(time.Hour))
+
+	assert.NoError(t, err)
+	assert.Empty(t, initiator.references)
+	standingOrderRepo.AssertNotCalled(t, "RecordStandingOrderRun", mock.Anything, mock.Anything)
+}
+
+// TestRunDueStandingOrders_AccountServiceUnavailable tests that due orders are postponed while the account service is down
+func TestRunDueStandingOrders_AccountServiceUnavailable(t *testing.T) {
+	standingOrderRepo := new(mocks.MockStandingOrderRepo)
+	accountClient := new(mocks.MockAccountClient)
+	job := NewStandingOrderJob(standingOrderRepo, accountClient, &fakeTransactionInitiator{})
+
+	accountClient.On("IsHealthy").Return(false)
+
+	err := job.RunDueStandingOrders(context.Background(), time.Now())
+
+	assert.Error(t, err)
+	standingOrderRepo.AssertNotCalled(t, "GetDueStandingOrders", mock.Anything, mock.Anything)
+}
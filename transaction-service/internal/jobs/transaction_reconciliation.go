@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"time"
+	"transaction-service/internal/app/saga"
 	"transaction-service/internal/config"
 	"transaction-service/internal/domain/entity"
 	custom_err "transaction-service/internal/domain/error"
@@ -12,21 +13,27 @@ import (
 )
 
 type TransactionReconciliationJob struct {
-	transactionRepo ports.TransactionRepo
-	sageRepo        ports.SagaRepo
-	accountClient   ports.AccountClient
+	transactionRepo  ports.TransactionRepo
+	sageRepo         ports.SagaRepo
+	accountClient    ports.AccountClient
+	eventRepo        ports.EventRepo
+	exchangeRateRepo ports.ExchangeRateRepo
 }
 
 func NewTransactionReconciliationJob(
 	transactionRepo ports.TransactionRepo,
 	accountClient ports.AccountClient,
 	sageRepo ports.SagaRepo,
+	eventRepo ports.EventRepo,
+	exchangeRateRepo ports.ExchangeRateRepo,
 ) *TransactionReconciliationJob {
 
 	return &TransactionReconciliationJob{
-		transactionRepo: transactionRepo,
-		accountClient:   accountClient,
-		sageRepo:        sageRepo,
+		transactionRepo:  transactionRepo,
+		accountClient:    accountClient,
+		sageRepo:         sageRepo,
+		eventRepo:        eventRepo,
+		exchangeRateRepo: exchangeRateRepo,
 	}
 }
 
@@ -64,7 +71,76 @@ func (j *TransactionReconciliationJob) RecoverStuckTransactions(ctx context.Cont
 	return nil
 }
 
+// RecoverSingleTransaction resumes the saga of a stuck transaction. The account-side journal status tells
+// whether the balance update was committed before the interruption, so the saga is either rolled forward
+// or compensated instead of being failed with money already moved.
 func (j *TransactionReconciliationJob) RecoverSingleTransaction(ctx context.Context, transaction *entity.Transaction) error {
+	// a queued transaction has not started yet, the worker that claims it runs the saga
+	if transaction.IsQueued() {
+		return nil
+	}
+
+	transactionSaga, err := j.sageRepo.GetSagaByTransactionID(transaction.ID)
+	if err != nil {
+		logging.Logger.Error().
+			Err(err).
+			Str("transaction_id", transaction.ID).
+			Str("job_type", "transaction_recovery").
+			Msg("Failed to get saga for transaction")
+		return custom_err.ErrDatabase
+	}
+
+	// without a saga nothing was locked or moved for the transaction
+	if transactionSaga == nil {
+		return j.failTransaction(ctx, transaction)
+	}
+
+	journalStatus, message, err := j.accountClient.GetTransactionJournalStatus(ctx, transaction.ID, "system", "")
+	if err != nil {
+		logging.Logger.Warn().
+			Err(err).
+			Str("transaction_id", transaction.ID).
+			Str("transaction_type", transaction.Type).
+			Str("message", message).
+			Str("job_type", "transaction_recovery").
+			Msg("Failed to get journal status for transaction; retrying on next run")
+		return custom_err.ErrJournalStatusUnavailable
+	}
+
+	// recording the attempt also claims the saga; a concurrent recovery fails on the version check
+	transactionSaga.MarkForRetry()
+	if err := j.sageRepo.UpdateSaga(transactionSaga); err != nil {
+		logging.Logger.Warn().
+			Err(err).
+			Str("transaction_id", transaction.ID).
+			Str("transaction_saga_id", transactionSaga.ID).
+			Str("job_type", "transaction_recovery").
+			Msg("Failed to record recovery attempt for saga")
+		return custom_err.ErrDatabase
+	}
+
+	err = saga.NewTransactionSagaOrchestrator(
+		j.sageRepo,
+		j.accountClient,
+		j.transactionRepo,
+		j.eventRepo,
+		j.exchangeRateRepo,
+	).ResumeSaga(ctx, transactionSaga, journalStatus, "system", "")
+	if err != nil {
+		logging.Logger.Warn().
+			Err(err).
+			Str("transaction_id", transaction.ID).
+			Str("transaction_saga_id", transactionSaga.ID).
+			Int("attempt", transactionSaga.RetryCount).
+			Str("job_type", "transaction_recovery").
+			Msg("Failed to resume saga; retrying on next run")
+		return err
+	}
+	return nil
+}
+
+// failTransaction releases any account lock held for the transaction and marks it failed
+func (j *TransactionReconciliationJob) failTransaction(ctx context.Context, transaction *entity.Transaction) error {
 	message, err := j.accountClient.UnlockAccounts(ctx, transaction.ID, "system", "")
 	if err != nil {
 		logging.Logger.Warn().
@@ -77,13 +153,6 @@ func (j *TransactionReconciliationJob) RecoverSingleTransaction(ctx context.Cont
 		return custom_err.ErrAccountUnlockingFailed
 	}
 
-	saga, err := j.sageRepo.GetSagaByTransactionID(transaction.ID)
-	if err == nil && saga != nil {
-		saga.CurrentState = entity.TransactionSagaStateFailed
-		saga.CurrentStep = entity.TransactionSagaStepComplete
-		_ = j.sageRepo.UpdateSaga(saga)
-	}
-
 	return j.transactionRepo.UpdateTransactionStatus(
 		transaction.ID,
 		entity.TransactionStatusFailed,
@@ -0,0 +1,281 @@
+package jobs
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+	"transaction-service/internal/domain/entity"
+	custom_err "transaction-service/internal/domain/error"
+	"transaction-service/internal/domain/money"
+	"transaction-service/internal/ports"
+	"transaction-service/internal/ports/mocks"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type reconciliationMocks struct {
+	sagaRepo        *mocks.MockSagaRepo
+	accountClient   *mocks.MockAccountClient
+	transactionRepo *mocks.MockTransactionRepo
+}
+
+func newTestReconciliationJob() (*TransactionReconciliationJob, reconciliationMocks) {
+	m := reconciliationMocks{
+		sagaRepo:        new(mocks.MockSagaRepo),
+		accountClient:   new(mocks.MockAccountClient),
+		transactionRepo: new(mocks.MockTransactionRepo),
+	}
+	job := NewTransactionReconciliationJob(
+		m.transactionRepo,
+		m.accountClient,
+		m.sagaRepo,
+		new(mocks.MockEventRepo),
+		new(mocks.MockExchangeRateRepo),
+	)
+	return job, m
+}
+
+func newStuckTransfer(step, state string) (*entity.Transaction, *entity.TransactionSaga) {
+	destinationAccountID := "acc-456"
+	transaction := &entity.Transaction{
+		ID:                   "txn-123",
+		SourceAccountID:      "acc-123",
+		DestinationAccountID: &destinationAccountID,
+		Amount:               money.MustParse("100.00"),
+		Type:                 entity.TransactionTypeTransfer,
+		TransactionStatus:    entity.TransactionStatusPending,
+		TimeoutAt:            time.Now().Add(-time.Minute),
+	}
+	transactionSaga := entity.NewTransactionSaga(transaction.ID, transaction.SourceAccountID, transaction.DestinationAccountID,
+		transaction.Amount, transaction.Type, "ref-123")
+	transactionSaga.CurrentStep = step
+	transactionSaga.CurrentState = state
+	return transaction, transactionSaga
+}
+
+// TestRecoverSingleTransaction_RollsForwardCommittedTransfer tests that a saga interrupted after the balance update completes
+func TestRecoverSingleTransaction_RollsForwardCommittedTransfer(t *testing.T) {
+	job, m := newTestReconciliationJob()
+	ctx := context.Background()
+	transaction, transactionSaga := newStuckTransfer(entity.TransactionSagaStepProcessTransfer, entity.TransactionSagaStateProcessing)
+
+	m.sagaRepo.On("GetSagaByTransactionID", transaction.ID).Return(transactionSaga, nil)
+	m.sagaRepo.On("UpdateSaga", mock.AnythingOfType("*entity.TransactionSaga")).Return(nil)
+	m.accountClient.On("GetTransactionJournalStatus", ctx, transaction.ID, "system", "").
+		Return(&ports.TransactionJournalStatus{BalanceApplied: true}, "", nil)
+	m.transactionRepo.On("UpdateTransactionStatus", transaction.ID, entity.TransactionStatusSuccessful, "").Return(nil)
+	m.accountClient.On("UnlockAccounts", ctx, transaction.ID, "system", "").Return("", nil)
+	m.transactionRepo.On("UpdateTransactionStatus", transaction.ID, entity.TransactionStatusCompleted, "").Return(nil)
+
+	err := job.RecoverSingleTransaction(ctx, transaction)
+
+	assert.NoError(t, err)
+	assert.Equal(t, entity.TransactionSagaStateCompleted, transactionSaga.CurrentState)
+	assert.Equal(t, entity.TransactionSagaStepComplete, transactionSaga.CurrentStep)
+	assert.Equal(t, 1, transactionSaga.RetryCount)
+	m.accountClient.AssertNotCalled(t, "UpdateAccountsBalance", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	m.transactionRepo.AssertNotCalled(t, "UpdateTransactionStatus", transaction.ID, entity.TransactionStatusFailed, mock.Anything)
+	m.accountClient.AssertExpectations(t)
+	m.transactionRepo.AssertExpectations(t)
+}
+
+// TestRecoverSingleTransaction_CompensatesUncommittedTransfer tests that a saga interrupted before the balance update only unlocks and fails
+func TestRecoverSingleTransaction_CompensatesUncommittedTransfer(t *testing.T) {
+	job, m := newTestReconciliationJob()
+	ctx := context.Background()
+	transaction, transactionSaga := newStuckTransfer(entity.TransactionSagaStepProcessTransfer, entity.TransactionSagaStateProcessing)
+
+	m.sagaRepo.On("GetSagaByTransactionID", transaction.ID).Return(transactionSaga, nil)
+	m.sagaRepo.On("UpdateSaga", mock.AnythingOfType("*entity.TransactionSaga")).Return(nil)
+	m.accountClient.On("GetTransactionJournalStatus", ctx, transaction.ID, "system", "").
+		Return(&ports.TransactionJournalStatus{}, "", nil)
+	m.accountClient.On("UnlockAccounts", ctx, transaction.ID, "system", "").Return("", nil)
+	m.transactionRepo.On("UpdateTransactionStatus", transaction.ID, entity.TransactionStatusFailed,
+		"compensated: saga interrupted at step process_transfer").Return(nil)
+
+	err := job.RecoverSingleTransaction(ctx, transaction)
+
+	assert.NoError(t, err)
+	assert.Equal(t, entity.TransactionSagaStateCompensated, transactionSaga.CurrentState)
+	assert.True(t, transactionSaga.CompensationRequired)
+	m.accountClient.AssertNotCalled(t, "UpdateAccountsBalance", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	m.accountClient.AssertExpectations(t)
+	m.transactionRepo.AssertExpectations(t)
+}
+
+// TestRecoverSingleTransaction_RollsBackCommittedTransferDuringCompensation tests that an unfinished compensation reverses the committed update
+func TestRecoverSingleTransaction_RollsBackCommittedTransferDuringCompensation(t *testing.T) {
+	job, m := newTestReconciliationJob()
+	ctx := context.Background()
+	transaction, transactionSaga := newStuckTransfer(entity.TransactionSagaStepCompensateFundRollback, entity.TransactionSagaStateCompensating)
+
+	m.sagaRepo.On("GetSagaByTransactionID", transaction.ID).Return(transactionSaga, nil)
+	m.sagaRepo.On("UpdateSaga", mock.AnythingOfType("*entity.TransactionSaga")).Return(nil)
+	m.accountClient.On("GetTransactionJournalStatus", ctx, transaction.ID, "system", "").
+		Return(&ports.TransactionJournalStatus{BalanceApplied: true}, "", nil)
+	m.accountClient.On("GetBalance", ctx, "acc-123").Return(money.MustParse("400.00"), 2, nil)
+	m.accountClient.On("GetBalance", ctx, "acc-456").Return(money.MustParse("300.00"), 2, nil)
+	m.accountClient.On("UpdateAccountsBalance", ctx, transaction.ID, []ports.AccountBalanceUpdate{
+		{AccountID: "acc-123", NewBalance: money.MustParse("500.00"), Version: 2},
+		{AccountID: "acc-456", NewBalance: money.MustParse("200.00"), Version: 2},
+	}, true, "system", "").Return([]ports.AccountBalanceUpdateResponse{}, "", nil)
+	m.accountClient.On("UnlockAccounts", ctx, transaction.ID, "system", "").Return("", nil)
+	m.transactionRepo.On("UpdateTransactionStatus", transaction.ID, entity.TransactionStatusFailed,
+		"compensated: saga interrupted at step compensate_fund_rollback").Return(nil)
+
+	err := job.RecoverSingleTransaction(ctx, transaction)
+
+	assert.NoError(t, err)
+	assert.Equal(t, entity.TransactionSagaStateCompensated, transactionSaga.CurrentState)
+	m.accountClient.AssertExpectations(t)
+	m.transactionRepo.AssertExpectations(t)
+}
+
+// TestRecoverSingleTransaction_SkipsCommittedRollback tests that an already committed compensation is not applied twice
+func TestRecoverSingleTransaction_SkipsCommittedRollback(t *testing.T) {
+	job, m := newTestReconciliationJob()
+	ctx := context.Background()
+	transaction, transactionSaga := newStuckTransfer(entity.TransactionSagaStepCompensateFundRollback, entity.TransactionSagaStateCompensating)
+
+	m.sagaRepo.On("GetSagaByTransactionID", transaction.ID).Return(transactionSaga, nil)
+	m.sagaRepo.On("UpdateSaga", mock.AnythingOfType("*entity.TransactionSaga")).Return(nil)
+	m.accountClient.On("GetTransactionJournalStatus", ctx, transaction.ID, "system", "").
+		Return(&ports.TransactionJournalStatus{BalanceApplied: true, Compensated: true}, "", nil)
+	m.accountClient.On("UnlockAccounts", ctx, transaction.ID, "system", "").Return("", nil)
+	m.transactionRepo.On("UpdateTransactionStatus", transaction.ID, entity.TransactionStatusFailed, mock.Anything).Return(nil)
+
+	err := job.RecoverSingleTransaction(ctx, transaction)
+
+	assert.NoError(t, err)
+	m.accountClient.AssertNotCalled(t, "GetBalance", mock.Anything, mock.Anything)
+	m.accountClient.AssertNotCalled(t, "UpdateAccountsBalance", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	m.transactionRepo.AssertExpectations(t)
+}
+
+// TestRecoverSingleTransaction_RestoresFullWithdrawal tests that a full withdrawal is rolled back to the balance recorded in the journal
+func TestRecoverSingleTransaction_RestoresFullWithdrawal(t *testing.T) {
+	job, m := newTestReconciliationJob()
+	ctx := context.Background()
+	transaction := &entity.Transaction{
+		ID:                "txn-123",
+		SourceAccountID:   "acc-123",
+		Type:              entity.TransactionTypeWithdrawFull,
+		TransactionStatus: entity.TransactionStatusPending,
+		TimeoutAt:         time.Now().Add(-time.Minute),
+	}
+	transactionSaga := entity.NewTransactionSaga(transaction.ID, transaction.SourceAccountID, nil, money.Zero, transaction.Type, "ref-123")
+	transactionSaga.CurrentStep = entity.TransactionSagaStepCompensate
+	transactionSaga.CurrentState = entity.TransactionSagaStateCompensating
+
+	m.sagaRepo.On("GetSagaByTransactionID", transaction.ID).Return(transactionSaga, nil)
+	m.sagaRepo.On("UpdateSaga", mock.AnythingOfType("*entity.TransactionSaga")).Return(nil)
+	m.accountClient.On("GetTransactionJournalStatus", ctx, transaction.ID, "system", "").
+		Return(&ports.TransactionJournalStatus{
+			BalanceApplied: true,
+			Changes: []ports.AccountBalanceChange{
+				{AccountID: "acc-123", Currency: "USD", Delta: money.MustParse("-750.25")},
+			},
+		}, "", nil)
+	m.accountClient.On("GetBalance", ctx, "acc-123").Return(money.Zero, 3, nil)
+	m.accountClient.On("UpdateAccountsBalance", ctx, transaction.ID, []ports.AccountBalanceUpdate{
+		{AccountID: "acc-123", NewBalance: money.MustParse("750.25"), Version: 3},
+	}, true, "system", "").Return([]ports.AccountBalanceUpdateResponse{}, "", nil)
+	m.accountClient.On("UnlockAccounts", ctx, transaction.ID, "system", "").Return("", nil)
+	m.transactionRepo.On("UpdateTransactionStatus", transaction.ID, entity.TransactionStatusFailed, mock.Anything).Return(nil)
+
+	err := job.RecoverSingleTransaction(ctx, transaction)
+
+	assert.NoError(t, err)
+	m.accountClient.AssertExpectations(t)
+}
+
+// TestRecoverSingleTransaction_RestoresHeldFullWithdrawal tests that a full withdrawal which left held funds on the
+// account is rolled back by the withdrawn amount on top of the current balance
+func TestRecoverSingleTransaction_RestoresHeldFullWithdrawal(t *testing.T) {
+	job, m := newTestReconciliationJob()
+	ctx := context.Background()
+	transaction := &entity.Transaction{
+		ID:                "txn-123",
+		SourceAccountID:   "acc-123",
+		Type:              entity.TransactionTypeWithdrawFull,
+		TransactionStatus: entity.TransactionStatusPending,
+		TimeoutAt:         time.Now().Add(-time.Minute),
+	}
+	transactionSaga := entity.NewTransactionSaga(transaction.ID, transaction.SourceAccountID, nil, money.Zero, transaction.Type, "ref-123")
+	transactionSaga.CurrentStep = entity.TransactionSagaStepCompensate
+	transactionSaga.CurrentState = entity.TransactionSagaStateCompensating
+
+	m.sagaRepo.On("GetSagaByTransactionID", transaction.ID).Return(transactionSaga, nil)
+	m.sagaRepo.On("UpdateSaga", mock.AnythingOfType("*entity.TransactionSaga")).Return(nil)
+	m.accountClient.On("GetTransactionJournalStatus", ctx, transaction.ID, "system", "").
+		Return(&ports.TransactionJournalStatus{
+			BalanceApplied: true,
+			Changes: []ports.AccountBalanceChange{
+				{AccountID: "acc-123", Currency: "USD", Delta: money.MustParse("-750.25")},
+			},
+		}, "", nil)
+	// 200.00 reserved by a hold stayed on the account
+	m.accountClient.On("GetBalance", ctx, "acc-123").Return(money.MustParse("200.00"), 3, nil)
+	m.accountClient.On("UpdateAccountsBalance", ctx, transaction.ID, []ports.AccountBalanceUpdate{
+		{AccountID: "acc-123", NewBalance: money.MustParse("950.25"), Version: 3},
+	}, true, "system", "").Return([]ports.AccountBalanceUpdateResponse{}, "", nil)
+	m.accountClient.On("UnlockAccounts", ctx, transaction.ID, "system", "").Return("", nil)
+	m.transactionRepo.On("UpdateTransactionStatus", transaction.ID, entity.TransactionStatusFailed, mock.Anything).Return(nil)
+
+	err := job.RecoverSingleTransaction(ctx, transaction)
+
+	assert.NoError(t, err)
+	m.accountClient.AssertExpectations(t)
+}
+
+// TestRecoverSingleTransaction_JournalStatusUnavailable tests that nothing is changed while the account side can not be inspected
+func TestRecoverSingleTransaction_JournalStatusUnavailable(t *testing.T) {
+	job, m := newTestReconciliationJob()
+	ctx := context.Background()
+	transaction, transactionSaga := newStuckTransfer(entity.TransactionSagaStepProcessTransfer, entity.TransactionSagaStateProcessing)
+
+	m.sagaRepo.On("GetSagaByTransactionID", transaction.ID).Return(transactionSaga, nil)
+	m.accountClient.On("GetTransactionJournalStatus", ctx, transaction.ID, "system", "").
+		Return(nil, "connection failed", errors.New("connection failed"))
+
+	err := job.RecoverSingleTransaction(ctx, transaction)
+
+	assert.ErrorIs(t, err, custom_err.ErrJournalStatusUnavailable)
+	m.sagaRepo.AssertNotCalled(t, "UpdateSaga", mock.Anything)
+	m.accountClient.AssertNotCalled(t, "UnlockAccounts", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	m.transactionRepo.AssertNotCalled(t, "UpdateTransactionStatus", mock.Anything, mock.Anything, mock.Anything)
+}
+
+// TestRecoverSingleTransaction_WithoutSaga tests that a transaction whose saga never started is unlocked and failed
+func TestRecoverSingleTransaction_WithoutSaga(t *testing.T) {
+	job, m := newTestReconciliationJob()
+	ctx := context.Background()
+	transaction, _ := newStuckTransfer(entity.TransactionSagaStepInitiate, entity.TransactionSagaStateInitiated)
+
+	m.sagaRepo.On("GetSagaByTransactionID", transaction.ID).Return(nil, nil)
+	m.accountClient.On("UnlockAccounts", ctx, transaction.ID, "system", "").Return("", nil)
+	m.transactionRepo.On("UpdateTransactionStatus", transaction.ID, entity.TransactionStatusFailed, "recovery: transaction timeout").Return(nil)
+
+	err := job.RecoverSingleTransaction(ctx, transaction)
+
+	assert.NoError(t, err)
+	m.accountClient.AssertNotCalled(t, "GetTransactionJournalStatus", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	m.transactionRepo.AssertExpectations(t)
+}
+
+// TestRecoverSingleTransaction_SkipsQueued tests that a queued transaction no worker has claimed yet is not failed
+func TestRecoverSingleTransaction_SkipsQueued(t *testing.T) {
+	job, m := newTestReconciliationJob()
+	ctx := context.Background()
+	transaction, _ := newStuckTransfer(entity.TransactionSagaStepInitiate, entity.TransactionSagaStateInitiated)
+	transaction.TimeoutAt = time.Time{}
+
+	err := job.RecoverSingleTransaction(ctx, transaction)
+
+	assert.NoError(t, err)
+	m.sagaRepo.AssertNotCalled(t, "GetSagaByTransactionID", mock.Anything)
+	m.accountClient.AssertNotCalled(t, "UnlockAccounts", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	m.transactionRepo.AssertNotCalled(t, "UpdateTransactionStatus", mock.Anything, mock.Anything, mock.Anything)
+}
@@ -0,0 +1,72 @@
+package jobs
+
+import (
+	"context"
+	"sync"
+	custom_err "transaction-service/internal/domain/error"
+	"transaction-service/internal/logging"
+	"transaction-service/internal/ports"
+)
+
+// TransactionWorkerPool runs asynchronous transactions on a bounded number of workers
+type TransactionWorkerPool struct {
+	workers int
+	tasks   chan func(ctx context.Context)
+	wg      sync.WaitGroup
+}
+
+func NewTransactionWorkerPool(workers, queueSize int) *TransactionWorkerPool {
+	return &TransactionWorkerPool{
+		workers: workers,
+		tasks:   make(chan func(ctx context.Context), queueSize),
+	}
+}
+
+var _ ports.TransactionQueue = (*TransactionWorkerPool)(nil)
+
+// Start launches the workers. Workers stop taking new tasks once ctx is cancelled, but a task already
+// running is finished so a saga is not interrupted halfway.
+func (p *TransactionWorkerPool) Start(ctx context.Context) {
+	for i := 0; i < p.workers; i++ {
+		p.wg.Add(1)
+		go p.work(ctx, i)
+	}
+}
+
+// Wait blocks until all workers have stopped
+func (p *TransactionWorkerPool) Wait() {
+	p.wg.Wait()
+}
+
+// Submit queues the task without blocking, failing if the queue is full
+func (p *TransactionWorkerPool) Submit(task func(ctx context.Context)) error {
+	select {
+	case p.tasks <- task:
+		return nil
+	default:
+		return custom_err.ErrTransactionQueueFull
+	}
+}
+
+func (p *TransactionWorkerPool) work(ctx context.Context, id int) {
+	defer p.wg.Done()
+	taskCtx := context.WithoutCancel(ctx)
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case task := <-p.tasks:
+			p.run(taskCtx, id, task)
+		}
+	}
+}
+
+func (p *TransactionWorkerPool) run(ctx context.Context, id int, task func(ctx context.Context)) {
+	defer func() {
+		if r := recover(); r != nil {
+			logging.Logger.Error().Interface("panic", r).Int("worker", id).Msg("transaction worker recovered from panic")
+		}
+	}()
+	task(ctx)
+}
@@ -0,0 +1,67 @@
+package jobs
+
+import (
+	"context"
+	"github.com/stretchr/testify/assert"
+	"sync/atomic"
+	"testing"
+	"time"
+	custom_err "transaction-service/internal/domain/error"
+)
+
+// TestTransactionWorkerPool_RunsSubmittedTasks tests that queued tasks are processed by the workers
+func TestTransactionWorkerPool_RunsSubmittedTasks(t *testing.T) {
+	pool := NewTransactionWorkerPool(2, 10)
+	ctx, cancel := context.WithCancel(context.Background())
+	pool.Start(ctx)
+
+	var processed atomic.Int32
+	done := make(chan struct{}, 5)
+	for i := 0; i < 5; i++ {
+		err := pool.Submit(func(ctx context.Context) {
+			processed.Add(1)
+			done <- struct{}{}
+		})
+		assert.NoError(t, err)
+	}
+
+	for i := 0; i < 5; i++ {
+		select {
+		case <-done:
+		case <-time.After(time.Second):
+			t.Fatal("task was not processed")
+		}
+	}
+
+	cancel()
+	pool.Wait()
+	assert.Equal(t, int32(5), processed.Load())
+}
+
+// TestTransactionWorkerPool_QueueFull tests that submitting to a full queue fails instead of blocking
+func TestTransactionWorkerPool_QueueFull(t *testing.T) {
+	pool := NewTransactionWorkerPool(1, 1)
+
+	assert.NoError(t, pool.Submit(func(ctx context.Context) {}))
+	assert.ErrorIs(t, pool.Submit(func(ctx context.Context) {}), custom_err.ErrTransactionQueueFull)
+}
+
+// TestTransactionWorkerPool_RunningTaskOutlivesShutdown tests that a running task is not cancelled on shutdown
+func TestTransactionWorkerPool_RunningTaskOutlivesShutdown(t *testing.T) {
+	pool := NewTransactionWorkerPool(1, 1)
+	ctx, cancel := context.WithCancel(context.Background())
+	pool.Start(ctx)
+
+	started := make(chan struct{})
+	var taskErr error
+	_ = pool.Submit(func(ctx context.Context) {
+		close(started)
+		time.Sleep(20 * time.Millisecond)
+		taskErr = ctx.Err()
+	})
+
+	<-started
+	cancel()
+	pool.Wait()
+	assert.NoError(t, taskErr)
+}
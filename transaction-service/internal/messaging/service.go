@@ -20,6 +20,15 @@ const (
 
 	MessageTypeTransactionCompleted = "TransactionCompleted"
 	MessageTypeTransactionFailed    = "TransactionFailed"
+	MessageTypeTransactionReversed  = "TransactionReversed"
+	MessageTypeExchangeRateUpdated  = "ExchangeRateUpdated"
+	MessageTypeStandingOrderCreated = "StandingOrderCreated"
+	MessageTypeStandingOrderUpdated = "StandingOrderUpdated"
+	MessageTypeBatchCreated         = "TransactionBatchCreated"
+	MessageTypeBatchFinished        = "TransactionBatchFinished"
+	MessageTypeLimitUpdated         = "TransactionLimitUpdated"
+	MessageTypeFeeRuleUpdated       = "FeeRuleUpdated"
+	MessageTypeFeeRuleDeleted       = "FeeRuleDeleted"
 )
 
 type Service struct {
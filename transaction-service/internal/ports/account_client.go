@@ -2,19 +2,60 @@ package ports
 
 import (
 	"context"
+	"time"
+	"transaction-service/internal/domain/money"
 )
 
 type AccountInfo struct {
-	AccountID  string
-	CustomerID string
-	Balance    float64
-	Version    int
+	AccountID      string
+	CustomerID     string
+	Balance        money.Amount
+	OverdraftLimit money.Amount
+	HeldAmount     money.Amount // sum of the active holds
+	Currency       string
+	AccountType    string
+	ActiveStatus   string // active, inactive (dormant), locked (frozen) or closed
+	FreezeMode     string // movements blocked while the account is frozen: debit, credit or both
+	Version        int
+}
+
+// AvailableBalance is the amount that can be debited from the account, including its overdraft and
+// excluding the funds reserved by holds
+func (a AccountInfo) AvailableBalance() money.Amount {
+	return a.Balance + a.OverdraftLimit - a.HeldAmount
 }
 
 type AccountBalanceUpdate struct {
-	AccountID  string
-	NewBalance float64
-	Version    int
+	AccountID         string
+	NewBalance        money.Amount
+	Version           int
+	HoldID            string       // hold captured by the update, if any
+	HoldCaptureAmount money.Amount // part of the hold the update captures
+	InterestPostingID string       // interest posting credited by the update, if any
+}
+
+// HoldInfo is a hold earmarking funds of an account
+type HoldInfo struct {
+	HoldID    string
+	AccountID string
+	Amount    money.Amount
+	Currency  string
+	Status    string
+	ExpiresAt time.Time
+}
+
+// IsActive reports whether the hold still reserves funds and can be captured
+func (h HoldInfo) IsActive() bool {
+	return h.Status == "active" && h.ExpiresAt.After(time.Now())
+}
+
+// InterestPostingInfo is the interest an account accrued in a month, or up to its closure, waiting to be credited
+type InterestPostingInfo struct {
+	PostingID string
+	AccountID string
+	Period    string
+	Amount    money.Amount
+	Currency  string
 }
 
 type AccountBalanceUpdateResponse struct {
@@ -22,6 +63,20 @@ type AccountBalanceUpdateResponse struct {
 	Version   int
 }
 
+// AccountBalanceChange is the signed change a journal made to an account balance in its own currency
+type AccountBalanceChange struct {
+	AccountID string
+	Currency  string
+	Delta     money.Amount
+}
+
+// TransactionJournalStatus tells which balance updates of a transaction the account service has committed
+type TransactionJournalStatus struct {
+	BalanceApplied bool
+	Compensated    bool
+	Changes        []AccountBalanceChange
+}
+
 type AccountClient interface {
 	Connect() error
 	EnsureConnection() error
@@ -30,7 +85,12 @@ type AccountClient interface {
 	ValidateAndGetAccounts(ctx context.Context, accountIDs []string, requester, requestId string) ([]AccountInfo, string, error)
 	LockAccounts(ctx context.Context, accountIDs []string, transactionID string, requester, requestId string) (string, error)
 	UnlockAccounts(ctx context.Context, transactionID string, requester, requestId string) (string, error)
-	UpdateAccountsBalance(ctx context.Context, updates []AccountBalanceUpdate, requester, requestId string) ([]AccountBalanceUpdateResponse, string, error)
-	GetBalance(ctx context.Context, accountID string) (float64, int, error)
+	UpdateAccountsBalance(ctx context.Context, transactionID string, updates []AccountBalanceUpdate, compensation bool, requester, requestId string) ([]AccountBalanceUpdateResponse, string, error)
+	GetBalance(ctx context.Context, accountID string) (money.Amount, int, error)
+	GetTransactionJournalStatus(ctx context.Context, transactionID string, requester, requestId string) (*TransactionJournalStatus, string, error)
+	GetHold(ctx context.Context, holdID string, requester, requestId string) (*HoldInfo, string, error)
+	ListPendingInterestPostings(ctx context.Context, limit int, requester, requestId string) ([]InterestPostingInfo, string, error)
+	SettleAccountInterest(ctx context.Context, accountID, requester, requestId string) ([]InterestPostingInfo, string, error)
+	CloseAccount(ctx context.Context, accountID, payoutAccountID, payoutTransactionID, reason, requester, requestId string) (string, error)
 	IsHealthy() bool
 }
@@ -0,0 +1,9 @@
+package ports
+
+import "transaction-service/internal/domain/entity"
+
+type ExchangeRateRepo interface {
+	UpsertExchangeRate(rate *entity.ExchangeRate) error
+	GetExchangeRate(baseCurrency, quoteCurrency string) (*entity.ExchangeRate, error)
+	ListExchangeRates() ([]*entity.ExchangeRate, error)
+}
@@ -0,0 +1,10 @@
+package ports
+
+import "transaction-service/internal/domain/entity"
+
+type FeeRuleRepo interface {
+	UpsertFeeRule(rule *entity.FeeRule) error
+	GetFeeRules(transactionType string) ([]*entity.FeeRule, error)
+	ListFeeRules() ([]*entity.FeeRule, error)
+	DeleteFeeRule(id string) error
+}
@@ -3,6 +3,7 @@ package mocks
 import (
 	"context"
 	"github.com/stretchr/testify/mock"
+	"transaction-service/internal/domain/money"
 	"transaction-service/internal/ports"
 )
 
@@ -31,17 +32,49 @@ func (m *MockAccountClient) UnlockAccounts(ctx context.Context, transactionID st
 	return args.String(0), args.Error(1)
 }
 
-func (m *MockAccountClient) UpdateAccountsBalance(ctx context.Context, updates []ports.AccountBalanceUpdate, requester, requestId string) ([]ports.AccountBalanceUpdateResponse, string, error) {
-	args := m.Called(ctx, updates, requester, requestId)
+func (m *MockAccountClient) UpdateAccountsBalance(ctx context.Context, transactionID string, updates []ports.AccountBalanceUpdate, compensation bool, requester, requestId string) ([]ports.AccountBalanceUpdateResponse, string, error) {
+	args := m.Called(ctx, transactionID, updates, compensation, requester, requestId)
 	if args.Get(0) == nil {
 		return []ports.AccountBalanceUpdateResponse{}, args.String(1), args.Error(2)
 	}
 	return args.Get(0).([]ports.AccountBalanceUpdateResponse), args.String(1), args.Error(2)
 }
 
-func (m *MockAccountClient) GetBalance(ctx context.Context, accountID string) (float64, int, error) {
+func (m *MockAccountClient) GetBalance(ctx context.Context, accountID string) (money.Amount, int, error) {
 	args := m.Called(ctx, accountID)
-	return args.Get(0).(float64), args.Get(1).(int), args.Error(2)
+	return args.Get(0).(money.Amount), args.Get(1).(int), args.Error(2)
+}
+
+func (m *MockAccountClient) GetTransactionJournalStatus(ctx context.Context, transactionID string, requester, requestId string) (*ports.TransactionJournalStatus, string, error) {
+	args := m.Called(ctx, transactionID, requester, requestId)
+	if args.Get(0) == nil {
+		return nil, args.String(1), args.Error(2)
+	}
+	return args.Get(0).(*ports.TransactionJournalStatus), args.String(1), args.Error(2)
+}
+
+func (m *MockAccountClient) GetHold(ctx context.Context, holdID string, requester, requestId string) (*ports.HoldInfo, string, error) {
+	args := m.Called(ctx, holdID, requester, requestId)
+	if args.Get(0) == nil {
+		return nil, args.String(1), args.Error(2)
+	}
+	return args.Get(0).(*ports.HoldInfo), args.String(1), args.Error(2)
+}
+
+func (m *MockAccountClient) ListPendingInterestPostings(ctx context.Context, limit int, requester, requestId string) ([]ports.InterestPostingInfo, string, error) {
+	args := m.Called(ctx, limit, requester, requestId)
+	if args.Get(0) == nil {
+		return nil, args.String(1), args.Error(2)
+	}
+	return args.Get(0).([]ports.InterestPostingInfo), args.String(1), args.Error(2)
+}
+
+func (m *MockAccountClient) SettleAccountInterest(ctx context.Context, accountID, requester, requestId string) ([]ports.InterestPostingInfo, string, error) {
+	args := m.Called(ctx, accountID, requester, requestId)
+	if args.Get(0) == nil {
+		return nil, args.String(1), args.Error(2)
+	}
+	return args.Get(0).([]ports.InterestPostingInfo), args.String(1), args.Error(2)
 }
 
 func (m *MockAccountClient) Connect() error {
@@ -66,3 +99,8 @@ func (m *MockAccountClient) IsHealthy() bool {
 	args := m.Called()
 	return args.Bool(0)
 }
+
+func (m *MockAccountClient) CloseAccount(ctx context.Context, accountID, payoutAccountID, payoutTransactionID, reason, requester, requestId string) (string, error) {
+	args := m.Called(ctx, accountID, payoutAccountID, payoutTransactionID, reason, requester, requestId)
+	return args.String(0), args.Error(1)
+}
@@ -0,0 +1,32 @@
+package mocks
+
+import (
+	"github.com/stretchr/testify/mock"
+	"transaction-service/internal/domain/entity"
+)
+
+// MockExchangeRateRepo implements ports.ExchangeRateRepo for testing
+type MockExchangeRateRepo struct {
+	mock.Mock
+}
+
+func (m *MockExchangeRateRepo) UpsertExchangeRate(rate *entity.ExchangeRate) error {
+	args := m.Called(rate)
+	return args.Error(0)
+}
+
+func (m *MockExchangeRateRepo) GetExchangeRate(baseCurrency, quoteCurrency string) (*entity.ExchangeRate, error) {
+	args := m.Called(baseCurrency, quoteCurrency)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.ExchangeRate), args.Error(1)
+}
+
+func (m *MockExchangeRateRepo) ListExchangeRates() ([]*entity.ExchangeRate, error) {
+	args := m.Called()
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*entity.ExchangeRate), args.Error(1)
+}
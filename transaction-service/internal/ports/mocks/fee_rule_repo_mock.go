@@ -0,0 +1,37 @@
+package mocks
+
+import (
+	"github.com/stretchr/testify/mock"
+	"transaction-service/internal/domain/entity"
+)
+
+// MockFeeRuleRepo implements ports.FeeRuleRepo for testing
+type MockFeeRuleRepo struct {
+	mock.Mock
+}
+
+func (m *MockFeeRuleRepo) UpsertFeeRule(rule *entity.FeeRule) error {
+	args := m.Called(rule)
+	return args.Error(0)
+}
+
+func (m *MockFeeRuleRepo) GetFeeRules(transactionType string) ([]*entity.FeeRule, error) {
+	args := m.Called(transactionType)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*entity.FeeRule), args.Error(1)
+}
+
+func (m *MockFeeRuleRepo) ListFeeRules() ([]*entity.FeeRule, error) {
+	args := m.Called()
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*entity.FeeRule), args.Error(1)
+}
+
+func (m *MockFeeRuleRepo) DeleteFeeRule(id string) error {
+	args := m.Called(id)
+	return args.Error(0)
+}
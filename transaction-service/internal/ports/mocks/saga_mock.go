@@ -59,3 +59,11 @@ func (m *MockSagaRepo) GetSagasByState(state string) ([]*entity.TransactionSaga,
 	}
 	return args.Get(0).([]*entity.TransactionSaga), args.Error(1)
 }
+
+func (m *MockSagaRepo) GetSagaStepLogs(sagaID string) ([]*entity.TransactionSagaStepLog, error) {
+	args := m.Called(sagaID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*entity.TransactionSagaStepLog), args.Error(1)
+}
@@ -0,0 +1,59 @@
+package mocks
+
+import (
+	"github.com/stretchr/testify/mock"
+	"time"
+	"transaction-service/internal/domain/entity"
+)
+
+// MockStandingOrderRepo implements ports.StandingOrderRepo for testing
+type MockStandingOrderRepo struct {
+	mock.Mock
+}
+
+func (m *MockStandingOrderRepo) CreateStandingOrder(order *entity.StandingOrder) error {
+	args := m.Called(order)
+	return args.Error(0)
+}
+
+func (m *MockStandingOrderRepo) GetStandingOrderByID(id string) (*entity.StandingOrder, error) {
+	args := m.Called(id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.StandingOrder), args.Error(1)
+}
+
+func (m *MockStandingOrderRepo) ListStandingOrdersByAccountID(accountID string) ([]*entity.StandingOrder, error) {
+	args := m.Called(accountID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*entity.StandingOrder), args.Error(1)
+}
+
+func (m *MockStandingOrderRepo) GetDueStandingOrders(now time.Time, limit int) ([]*entity.StandingOrder, error) {
+	args := m.Called(now, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*entity.StandingOrder), args.Error(1)
+}
+
+func (m *MockStandingOrderRepo) UpdateStandingOrder(order *entity.StandingOrder) error {
+	args := m.Called(order)
+	return args.Error(0)
+}
+
+func (m *MockStandingOrderRepo) RecordStandingOrderRun(order *entity.StandingOrder, run *entity.StandingOrderRun) error {
+	args := m.Called(order, run)
+	return args.Error(0)
+}
+
+func (m *MockStandingOrderRepo) GetStandingOrderRuns(standingOrderID string) ([]*entity.StandingOrderRun, error) {
+	args := m.Called(standingOrderID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*entity.StandingOrderRun), args.Error(1)
+}
@@ -0,0 +1,58 @@
+package mocks
+
+import (
+	"github.com/stretchr/testify/mock"
+	"transaction-service/internal/domain/entity"
+)
+
+// MockTransactionBatchRepo implements ports.TransactionBatchRepo for testing
+type MockTransactionBatchRepo struct {
+	mock.Mock
+}
+
+func (m *MockTransactionBatchRepo) CreateTransactionBatch(batch *entity.TransactionBatch, lines []*entity.TransactionBatchLine) error {
+	args := m.Called(batch, lines)
+	return args.Error(0)
+}
+
+func (m *MockTransactionBatchRepo) GetTransactionBatchByID(id string) (*entity.TransactionBatch, error) {
+	args := m.Called(id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.TransactionBatch), args.Error(1)
+}
+
+func (m *MockTransactionBatchRepo) GetTransactionBatchByReferenceID(requester, referenceID string) (*entity.TransactionBatch, error) {
+	args := m.Called(requester, referenceID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.TransactionBatch), args.Error(1)
+}
+
+func (m *MockTransactionBatchRepo) GetTransactionBatchLines(batchID string) ([]*entity.TransactionBatchLine, error) {
+	args := m.Called(batchID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*entity.TransactionBatchLine), args.Error(1)
+}
+
+func (m *MockTransactionBatchRepo) GetUnfinishedTransactionBatches() ([]*entity.TransactionBatch, error) {
+	args := m.Called()
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*entity.TransactionBatch), args.Error(1)
+}
+
+func (m *MockTransactionBatchRepo) UpdateTransactionBatch(batch *entity.TransactionBatch) error {
+	args := m.Called(batch)
+	return args.Error(0)
+}
+
+func (m *MockTransactionBatchRepo) UpdateTransactionBatchLine(line *entity.TransactionBatchLine) error {
+	args := m.Called(line)
+	return args.Error(0)
+}
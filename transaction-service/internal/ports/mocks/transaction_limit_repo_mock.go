@@ -0,0 +1,32 @@
+package mocks
+
+import (
+	"github.com/stretchr/testify/mock"
+	"transaction-service/internal/domain/entity"
+)
+
+// MockTransactionLimitRepo implements ports.TransactionLimitRepo for testing
+type MockTransactionLimitRepo struct {
+	mock.Mock
+}
+
+func (m *MockTransactionLimitRepo) UpsertTransactionLimit(limit *entity.TransactionLimit) error {
+	args := m.Called(limit)
+	return args.Error(0)
+}
+
+func (m *MockTransactionLimitRepo) GetTransactionLimit(scope, subject string) (*entity.TransactionLimit, error) {
+	args := m.Called(scope, subject)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.TransactionLimit), args.Error(1)
+}
+
+func (m *MockTransactionLimitRepo) ListTransactionLimits() ([]*entity.TransactionLimit, error) {
+	args := m.Called()
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*entity.TransactionLimit), args.Error(1)
+}
@@ -0,0 +1,16 @@
+package mocks
+
+import (
+	"context"
+	"github.com/stretchr/testify/mock"
+)
+
+// MockTransactionQueue implements ports.TransactionQueue for testing
+type MockTransactionQueue struct {
+	mock.Mock
+}
+
+func (m *MockTransactionQueue) Submit(task func(ctx context.Context)) error {
+	args := m.Called(task)
+	return args.Error(0)
+}
@@ -4,6 +4,7 @@ import (
 	"github.com/stretchr/testify/mock"
 	"time"
 	"transaction-service/internal/domain/entity"
+	"transaction-service/internal/domain/money"
 )
 
 // MockTransactionRepo implements ports.TransactionRepo for testing
@@ -16,6 +17,11 @@ func (m *MockTransactionRepo) CreateTransaction(transaction *entity.Transaction)
 	return args.Error(0)
 }
 
+func (m *MockTransactionRepo) CreateReversal(original *entity.Transaction, reversal *entity.Transaction) error {
+	args := m.Called(original, reversal)
+	return args.Error(0)
+}
+
 func (m *MockTransactionRepo) GetTransactionByID(id string) (*entity.Transaction, error) {
 	args := m.Called(id)
 	if args.Get(0) == nil {
@@ -24,29 +30,57 @@ func (m *MockTransactionRepo) GetTransactionByID(id string) (*entity.Transaction
 	return args.Get(0).(*entity.Transaction), args.Error(1)
 }
 
-func (m *MockTransactionRepo) GetTransactionByReferenceID(referenceID string) (*entity.Transaction, error) {
-	args := m.Called(referenceID)
+func (m *MockTransactionRepo) GetTransactionByReferenceID(requester, referenceID string) (*entity.Transaction, error) {
+	args := m.Called(requester, referenceID)
 	if args.Get(0) == nil {
 		return nil, args.Error(1)
 	}
 	return args.Get(0).(*entity.Transaction), args.Error(1)
 }
 
+func (m *MockTransactionRepo) GetInterestCredit(interestPostingID string) (*entity.Transaction, error) {
+	args := m.Called(interestPostingID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.Transaction), args.Error(1)
+}
+
+func (m *MockTransactionRepo) ClaimTransaction(id string, timeoutAt time.Time) error {
+	args := m.Called(id, timeoutAt)
+	return args.Error(0)
+}
+
 func (m *MockTransactionRepo) UpdateTransactionStatus(id string, transactionStatus string, errorReason string) error {
 	args := m.Called(id, transactionStatus, errorReason)
 	return args.Error(0)
 }
 
+func (m *MockTransactionRepo) UpdateTransactionExchange(id string, destinationAmount money.Amount, exchangeRate money.Rate) error {
+	args := m.Called(id, destinationAmount, exchangeRate)
+	return args.Error(0)
+}
+
+func (m *MockTransactionRepo) UpdateTransactionAmount(id string, amount money.Amount) error {
+	args := m.Called(id, amount)
+	return args.Error(0)
+}
+
 func (m *MockTransactionRepo) UpdateTransaction(transaction *entity.Transaction) error {
 	args := m.Called(transaction)
 	return args.Error(0)
 }
 
-func (m *MockTransactionRepo) GetTransactionHistory(accountID string, customerID string, startDate, endDate *time.Time, sortOrder string, page, pageSize int, types []string) ([]*entity.Transaction, int64, error) {
-	args := m.Called(accountID, customerID, startDate, endDate, sortOrder, page, pageSize, types)
+func (m *MockTransactionRepo) GetTransactionHistory(accountID string, customerID string, startDate, endDate *time.Time, sortOrder string, page, pageSize int, after *entity.PageCursor, withTotal bool, types []string) ([]*entity.Transaction, int64, error) {
+	args := m.Called(accountID, customerID, startDate, endDate, sortOrder, page, pageSize, after, withTotal, types)
 	return args.Get(0).([]*entity.Transaction), args.Get(1).(int64), args.Error(2)
 }
 
+func (m *MockTransactionRepo) ExportTransactionHistory(accountID string, customerID string, startDate, endDate *time.Time, sortOrder string, types []string, batchSize int, fn func([]*entity.Transaction) error) error {
+	args := m.Called(accountID, customerID, startDate, endDate, sortOrder, types, batchSize, fn)
+	return args.Error(0)
+}
+
 func (m *MockTransactionRepo) GetPendingTransactions() ([]*entity.Transaction, error) {
 	args := m.Called()
 	if args.Get(0) == nil {
@@ -62,3 +96,16 @@ func (m *MockTransactionRepo) GetStuckTransactions() ([]*entity.Transaction, err
 	}
 	return args.Get(0).([]*entity.Transaction), args.Error(1)
 }
+
+func (m *MockTransactionRepo) GetQueuedTransactions() ([]*entity.Transaction, error) {
+	args := m.Called()
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*entity.Transaction), args.Error(1)
+}
+
+func (m *MockTransactionRepo) GetDebitUsage(accountID string, createdBy string, currency string, since time.Time) (entity.TransactionUsage, error) {
+	args := m.Called(accountID, createdBy, currency, since)
+	return args.Get(0).(entity.TransactionUsage), args.Error(1)
+}
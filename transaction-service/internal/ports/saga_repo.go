@@ -10,4 +10,5 @@ type SagaRepo interface {
 	GetStuckSagas() ([]*entity.TransactionSaga, error)
 	GetSagasForRetry() ([]*entity.TransactionSaga, error)
 	GetSagasByState(state string) ([]*entity.TransactionSaga, error)
+	GetSagaStepLogs(sagaID string) ([]*entity.TransactionSagaStepLog, error)
 }
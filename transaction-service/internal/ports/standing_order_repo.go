@@ -0,0 +1,16 @@
+package ports
+
+import (
+	"time"
+	"transaction-service/internal/domain/entity"
+)
+
+type StandingOrderRepo interface {
+	CreateStandingOrder(order *entity.StandingOrder) error
+	GetStandingOrderByID(id string) (*entity.StandingOrder, error)
+	ListStandingOrdersByAccountID(accountID string) ([]*entity.StandingOrder, error)
+	GetDueStandingOrders(now time.Time, limit int) ([]*entity.StandingOrder, error)
+	UpdateStandingOrder(order *entity.StandingOrder) error
+	RecordStandingOrderRun(order *entity.StandingOrder, run *entity.StandingOrderRun) error
+	GetStandingOrderRuns(standingOrderID string) ([]*entity.StandingOrderRun, error)
+}
@@ -0,0 +1,13 @@
+package ports
+
+import "transaction-service/internal/domain/entity"
+
+type TransactionBatchRepo interface {
+	CreateTransactionBatch(batch *entity.TransactionBatch, lines []*entity.TransactionBatchLine) error
+	GetTransactionBatchByID(id string) (*entity.TransactionBatch, error)
+	GetTransactionBatchByReferenceID(requester, referenceID string) (*entity.TransactionBatch, error)
+	GetTransactionBatchLines(batchID string) ([]*entity.TransactionBatchLine, error)
+	GetUnfinishedTransactionBatches() ([]*entity.TransactionBatch, error)
+	UpdateTransactionBatch(batch *entity.TransactionBatch) error
+	UpdateTransactionBatchLine(line *entity.TransactionBatchLine) error
+}
@@ -0,0 +1,9 @@
+package ports
+
+import "transaction-service/internal/domain/entity"
+
+type TransactionLimitRepo interface {
+	UpsertTransactionLimit(limit *entity.TransactionLimit) error
+	GetTransactionLimit(scope, subject string) (*entity.TransactionLimit, error)
+	ListTransactionLimits() ([]*entity.TransactionLimit, error)
+}
@@ -0,0 +1,7 @@
+package ports
+
+import "context"
+
+type TransactionQueue interface {
+	Submit(task func(ctx context.Context)) error
+}
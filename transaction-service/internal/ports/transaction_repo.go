@@ -3,15 +3,24 @@ package ports
 import (
 	"time"
 	"transaction-service/internal/domain/entity"
+	"transaction-service/internal/domain/money"
 )
 
 type TransactionRepo interface {
 	CreateTransaction(transaction *entity.Transaction) error
+	CreateReversal(original *entity.Transaction, reversal *entity.Transaction) error
 	GetTransactionByID(id string) (*entity.Transaction, error)
-	GetTransactionByReferenceID(referenceID string) (*entity.Transaction, error)
+	GetTransactionByReferenceID(requester, referenceID string) (*entity.Transaction, error)
+	GetInterestCredit(interestPostingID string) (*entity.Transaction, error)
+	ClaimTransaction(id string, timeoutAt time.Time) error
 	UpdateTransactionStatus(id string, transactionStatus string, errorReason string) error
 	UpdateTransaction(transaction *entity.Transaction) error
-	GetTransactionHistory(accountID string, customerID string, startDate, endDate *time.Time, sortOrder string, page, pageSize int, types []string) ([]*entity.Transaction, int64, error)
+	UpdateTransactionExchange(id string, destinationAmount money.Amount, exchangeRate money.Rate) error
+	UpdateTransactionAmount(id string, amount money.Amount) error
+	GetTransactionHistory(accountID string, customerID string, startDate, endDate *time.Time, sortOrder string, page, pageSize int, after *entity.PageCursor, withTotal bool, types []string) ([]*entity.Transaction, int64, error)
+	ExportTransactionHistory(accountID string, customerID string, startDate, endDate *time.Time, sortOrder string, types []string, batchSize int, fn func([]*entity.Transaction) error) error
 	GetPendingTransactions() ([]*entity.Transaction, error)
 	GetStuckTransactions() ([]*entity.Transaction, error)
+	GetQueuedTransactions() ([]*entity.Transaction, error)
+	GetDebitUsage(accountID string, createdBy string, currency string, since time.Time) (entity.TransactionUsage, error)
 }